@@ -38,8 +38,8 @@ func GetScores(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 	resp := new(api.GetScoresResponse)
-	resp.Scores = pack.BuildScoreList(scores)
-	pack.RespList(c, resp.Scores)
+	resp.Scores = pack.BuildScoreList(scores.Scores)
+	pack.RespListWithSnapshot(c, resp.Scores, scores.GetStale(), scores.GetSnapshotTime())
 }
 
 // GetGPA .
@@ -39,6 +39,7 @@ func TestGetScores(t *testing.T) {
 		name           string
 		url            string
 		mockRPCError   error
+		mockStale      bool
 		expectContains string
 	}
 
@@ -48,6 +49,12 @@ func TestGetScores(t *testing.T) {
 			url:            "/api/v1/jwch/academic/scores",
 			expectContains: `{"code":"10000","message":"ok","data":[]}`,
 		},
+		{
+			name:           "stale snapshot",
+			url:            "/api/v1/jwch/academic/scores",
+			mockStale:      true,
+			expectContains: `{"code":"10000","message":"ok","data":[],"stale":true,"snapshot_time":1725148800000}`,
+		},
 		{
 			name:           "rpc error",
 			url:            "/api/v1/jwch/academic/scores",
@@ -62,11 +69,16 @@ func TestGetScores(t *testing.T) {
 	defer mockey.UnPatchAll()
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
-			mockey.Mock(rpc.GetScoresRPC).To(func(ctx context.Context, req *academic.GetScoresRequest) ([]*model.Score, error) {
+			mockey.Mock(rpc.GetScoresRPC).To(func(ctx context.Context, req *academic.GetScoresRequest) (*academic.GetScoresResponse, error) {
 				if tc.mockRPCError != nil {
 					return nil, tc.mockRPCError
 				}
-				return []*model.Score{}, nil
+				resp := &academic.GetScoresResponse{Scores: []*model.Score{}}
+				if tc.mockStale {
+					stale, snapshotTime := true, int64(1725148800000)
+					resp.Stale, resp.SnapshotTime = &stale, &snapshotTime
+				}
+				return resp, nil
 			}).Build()
 
 			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
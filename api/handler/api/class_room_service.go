@@ -27,7 +27,6 @@ import (
 	"github.com/west2-online/fzuhelper-server/api/pack"
 	"github.com/west2-online/fzuhelper-server/api/rpc"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
-	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
 )
 
@@ -43,18 +42,22 @@ func GetEmptyClassrooms(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 	res, err := rpc.GetEmptyRoomRPC(ctx, &classroom.EmptyRoomRequest{
-		Date:      req.Date,
-		StartTime: req.StartTime,
-		EndTime:   req.EndTime,
-		Campus:    req.Campus,
+		Date:        req.Date,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Campus:      req.Campus,
+		Build:       req.Build,
+		MinCapacity: req.MinCapacity,
+		SortBy:      req.SortBy,
 	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
 	}
 	resp := new(api.EmptyClassroomResponse)
-	resp.Classrooms = pack.BuildClassroomList(res)
-	pack.RespList(c, resp.Classrooms)
+	resp.Classrooms = pack.BuildClassroomList(res.Rooms)
+	resp.SyncStatus = pack.BuildEmptyRoomSyncStatus(res.SyncStatus)
+	pack.RespListWithSyncStatus(c, resp.Classrooms, resp.SyncStatus)
 }
 
 // GetExamRoomInfo .
@@ -67,8 +70,7 @@ func GetExamRoomInfo(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.ParamError.WithError(err))
 		return
 	}
-	var rooms []*model.ExamRoomInfo
-	rooms, err = rpc.GetExamRoomInfoRPC(ctx, &classroom.ExamRoomInfoRequest{
+	res, err := rpc.GetExamRoomInfoRPC(ctx, &classroom.ExamRoomInfoRequest{
 		Term: req.Term,
 	})
 	if err != nil {
@@ -76,6 +78,52 @@ func GetExamRoomInfo(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 	resp := new(api.ExamRoomInfoResponse)
-	resp.ExamRoomInfos = pack.BuildExamRoomInfo(rooms)
-	pack.RespList(c, resp.ExamRoomInfos)
+	resp.ExamRoomInfos = pack.BuildExamRoomInfo(res.Rooms)
+	pack.RespListWithSnapshot(c, resp.ExamRoomInfos, res.GetStale(), res.GetSnapshotTime())
+}
+
+// GetRoomSchedule .
+// @router /api/v1/common/classroom/schedule [GET]
+func GetRoomSchedule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RoomScheduleRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+	res, err := rpc.GetRoomScheduleRPC(ctx, &classroom.RoomScheduleRequest{
+		Room: req.Room,
+		Date: req.Date,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	resp := new(api.RoomScheduleResponse)
+	resp.Schedule = pack.BuildRoomSchedule(res.Schedule)
+	resp.SyncStatus = pack.BuildEmptyRoomSyncStatus(res.SyncStatus)
+	pack.RespDataWithSyncStatus(c, resp.Schedule, resp.SyncStatus)
+}
+
+// GetExamRoomChanges .
+// @router /api/v1/jwch/classroom/exam/changes [GET]
+func GetExamRoomChanges(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ExamRoomChangesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+	changes, err := rpc.GetExamRoomChangesRPC(ctx, &classroom.ExamRoomChangesRequest{
+		Term: req.Term,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	resp := new(api.ExamRoomChangesResponse)
+	resp.Changes = pack.BuildExamRoomChanges(changes)
+	pack.RespList(c, resp.Changes)
 }
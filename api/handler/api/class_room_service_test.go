@@ -39,6 +39,7 @@ func TestGetEmptyClassrooms(t *testing.T) {
 		name           string
 		url            string
 		mockRPCError   error
+		mockStatus     *model.EmptyRoomSyncStatus
 		expectContains string
 	}
 
@@ -48,6 +49,31 @@ func TestGetEmptyClassrooms(t *testing.T) {
 			url:            "/api/v1/common/classroom/empty?date=2025-01-01&startTime=1&endTime=2&campus=qishan",
 			expectContains: `{"code":"10000","message":"ok","data":[]}`,
 		},
+		{
+			name: "fallback to previous day",
+			url:  "/api/v1/common/classroom/empty?date=2025-01-01&startTime=1&endTime=2&campus=qishan",
+			mockStatus: &model.EmptyRoomSyncStatus{
+				Date:          "2024-12-31",
+				LastSuccess:   1735574400000,
+				FailedPeriods: []int64{},
+				Fallback:      true,
+			},
+			expectContains: `{"code":"10000","message":"ok","data":[],"stale":true,"snapshot_time":1735574400000,` +
+				`"sync_status":{"date":"2024-12-31","lastSuccess":1735574400000,"lastAttempt":0,"failedPeriods":[],"fallback":true}}`,
+		},
+		{
+			name: "sync error without fallback",
+			url:  "/api/v1/common/classroom/empty?date=2025-01-01&startTime=1&endTime=2&campus=qishan",
+			mockStatus: &model.EmptyRoomSyncStatus{
+				Date:          "2025-01-01",
+				LastSuccess:   1735660800000,
+				LastAttempt:   1735664400000,
+				Error:         new("timeout"),
+				FailedPeriods: []int64{},
+			},
+			expectContains: `{"code":"10000","message":"ok","data":[],` +
+				`"sync_status":{"date":"2025-01-01","lastSuccess":1735660800000,"lastAttempt":1735664400000,"error":"timeout","failedPeriods":[],"fallback":false}}`,
+		},
 		{
 			name:           "rpc error",
 			url:            "/api/v1/common/classroom/empty?date=2025-01-01&startTime=1&endTime=2&campus=qishan",
@@ -67,11 +93,11 @@ func TestGetEmptyClassrooms(t *testing.T) {
 	defer mockey.UnPatchAll()
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
-			mockey.Mock(rpc.GetEmptyRoomRPC).To(func(ctx context.Context, req *classroom.EmptyRoomRequest) ([]*model.Classroom, error) {
+			mockey.Mock(rpc.GetEmptyRoomRPC).To(func(ctx context.Context, req *classroom.EmptyRoomRequest) (*classroom.EmptyRoomResponse, error) {
 				if tc.mockRPCError != nil {
 					return nil, tc.mockRPCError
 				}
-				return []*model.Classroom{}, nil
+				return &classroom.EmptyRoomResponse{Rooms: []*model.Classroom{}, SyncStatus: tc.mockStatus}, nil
 			}).Build()
 
 			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
@@ -86,15 +112,24 @@ func TestGetExamRoomInfo(t *testing.T) {
 		name           string
 		url            string
 		mockRPCError   error
+		mockResp       *classroom.ExamRoomInfoResponse
 		expectContains string
 	}
 
+	stale, snapshotTime := true, int64(1725148800000)
 	testCases := []testCase{
 		{
 			name:           "success",
 			url:            "/api/v1/jwch/classroom/exam?term=2024-2025-1",
+			mockResp:       &classroom.ExamRoomInfoResponse{Rooms: []*model.ExamRoomInfo{}},
 			expectContains: `{"code":"10000","message":"ok","data":[]}`,
 		},
+		{
+			name:           "stale snapshot",
+			url:            "/api/v1/jwch/classroom/exam?term=2024-2025-1",
+			mockResp:       &classroom.ExamRoomInfoResponse{Rooms: []*model.ExamRoomInfo{}, Stale: &stale, SnapshotTime: &snapshotTime},
+			expectContains: `{"code":"10000","message":"ok","data":[],"stale":true,"snapshot_time":1725148800000}`,
+		},
 		{
 			name:           "rpc error",
 			url:            "/api/v1/jwch/classroom/exam?term=2024-2025-1",
@@ -114,11 +149,132 @@ func TestGetExamRoomInfo(t *testing.T) {
 	defer mockey.UnPatchAll()
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
-			mockey.Mock(rpc.GetExamRoomInfoRPC).To(func(ctx context.Context, req *classroom.ExamRoomInfoRequest) ([]*model.ExamRoomInfo, error) {
+			mockey.Mock(rpc.GetExamRoomInfoRPC).To(func(ctx context.Context, req *classroom.ExamRoomInfoRequest) (*classroom.ExamRoomInfoResponse, error) {
+				if tc.mockRPCError != nil {
+					return nil, tc.mockRPCError
+				}
+				return tc.mockResp, nil
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestGetRoomSchedule(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockRPCError   error
+		mockStatus     *model.EmptyRoomSyncStatus
+		expectContains string
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			url:            "/api/v1/common/classroom/schedule?room=西3-206&date=2025-01-01",
+			expectContains: `"freePeriods":[1,2],"occupiedPeriods":[3]}}`,
+		},
+		{
+			name: "partial failure",
+			url:  "/api/v1/common/classroom/schedule?room=西3-206&date=2025-01-01",
+			mockStatus: &model.EmptyRoomSyncStatus{
+				Date:          "2025-01-01",
+				LastSuccess:   1735660800000,
+				FailedPeriods: []int64{3},
+			},
+			expectContains: `"occupiedPeriods":[3]},"stale":true,"snapshot_time":1735660800000,` +
+				`"sync_status":{"date":"2025-01-01","lastSuccess":1735660800000,"lastAttempt":0,"failedPeriods":[3],"fallback":false}}`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v1/common/classroom/schedule?room=西3-206&date=2025-01-01",
+			mockRPCError:   errno.NewErrNo(errno.BizNotExist, "room does not exist"),
+			expectContains: `{"code":"40005","message":"room does not exist"`,
+		},
+		{
+			name:           "bind error",
+			url:            "/api/v1/common/classroom/schedule?date=2025-01-01",
+			expectContains: `{"code":"20001","message":"参数错误`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v1/common/classroom/schedule", GetRoomSchedule)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.GetRoomScheduleRPC).To(func(ctx context.Context, req *classroom.RoomScheduleRequest) (*classroom.RoomScheduleResponse, error) {
+				if tc.mockRPCError != nil {
+					return nil, tc.mockRPCError
+				}
+				return &classroom.RoomScheduleResponse{
+					Schedule: &model.RoomSchedule{
+						Room:            &model.Classroom{Build: "西3", Location: "旗山西3-206"},
+						Date:            req.Date,
+						FreePeriods:     []int64{1, 2},
+						OccupiedPeriods: []int64{3},
+					},
+					SyncStatus: tc.mockStatus,
+				}, nil
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestGetExamRoomChanges(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockRPCError   error
+		expectContains string
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			url:            "/api/v1/jwch/classroom/exam/changes?term=202401",
+			expectContains: `"changeType":"changed","prevLocation":"旗山东1-101","location":"旗山东1-201"`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v1/jwch/classroom/exam/changes?term=202401",
+			mockRPCError:   errno.InternalServiceError,
+			expectContains: `{"code":"50001","message":"内部服务错误"`,
+		},
+		{
+			name:           "bind error",
+			url:            "/api/v1/jwch/classroom/exam/changes",
+			expectContains: `{"code":"20001","message":"参数错误`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v1/jwch/classroom/exam/changes", GetExamRoomChanges)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.GetExamRoomChangesRPC).To(func(ctx context.Context, req *classroom.ExamRoomChangesRequest) ([]*model.ExamRoomChange, error) {
 				if tc.mockRPCError != nil {
 					return nil, tc.mockRPCError
 				}
-				return []*model.ExamRoomInfo{}, nil
+				return []*model.ExamRoomChange{
+					{
+						Name:         "数据结构",
+						ChangeType:   "changed",
+						PrevLocation: new("旗山东1-101"),
+						Location:     new("旗山东1-201"),
+					},
+				}, nil
 			}).Build()
 
 			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
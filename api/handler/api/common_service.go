@@ -120,7 +120,7 @@ func GetNotice(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 	resp := new(api.GetNoticeResponse)
-	notices, total, err := rpc.GetNoticesRPC(ctx, &common.NoticeRequest{PageNum: req.PageNum})
+	notices, total, err := rpc.GetNoticesRPC(ctx, &common.NoticeRequest{PageNum: req.PageNum, Source: req.Source})
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -366,3 +366,166 @@ func DeleteToolboxConfig(ctx context.Context, c *app.RequestContext) {
 	}
 	pack.RespSuccess(c)
 }
+
+// SearchNotices .
+// @router /api/v1/common/notice/search [GET]
+func SearchNotices(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SearchNoticesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+	resp := new(api.SearchNoticesResponse)
+	hits, total, err := rpc.SearchNoticesRPC(ctx, &common.SearchNoticesRequest{
+		Query:     req.Query,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		PageNum:   req.PageNum,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	resp.Results = pack.BuildNoticeSearchHits(hits)
+	resp.Total = total
+	pack.RespList(c, resp)
+}
+
+// ListNoticeSubscriptions .
+// @router /api/v1/jwch/notice/subscriptions [GET]
+func ListNoticeSubscriptions(ctx context.Context, c *app.RequestContext) {
+	resp := new(api.ListNoticeSubscriptionsResponse)
+	subs, err := rpc.ListNoticeSubscriptionsRPC(ctx, &common.ListNoticeSubscriptionsRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	resp.Subscriptions = pack.BuildNoticeSubscriptions(subs)
+	pack.RespList(c, resp)
+}
+
+// SubscribeNotice .
+// @router /api/v1/jwch/notice/subscriptions [POST]
+func SubscribeNotice(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SubscribeNoticeRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+	sub, err := rpc.SubscribeNoticeRPC(ctx, &common.SubscribeNoticeRequest{Keyword: req.Keyword})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, pack.BuildNoticeSubscription(sub))
+}
+
+// UnsubscribeNotice .
+// @router /api/v1/jwch/notice/subscriptions [DELETE]
+func UnsubscribeNotice(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UnsubscribeNoticeRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+	if err = rpc.UnsubscribeNoticeRPC(ctx, &common.UnsubscribeNoticeRequest{Keyword: req.Keyword}); err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetNoticeDetail .
+// @router /api/v1/common/notice/detail [GET]
+func GetNoticeDetail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetNoticeDetailRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+	detail, err := rpc.GetNoticeDetailRPC(ctx, &common.GetNoticeDetailRequest{Url: req.URL})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, pack.BuildNoticeDetail(detail))
+}
+
+// ListNoticeSources .
+// @router /api/v1/common/notice/sources [GET]
+func ListNoticeSources(ctx context.Context, c *app.RequestContext) {
+	resp := new(api.ListNoticeSourcesResponse)
+	sources, err := rpc.ListNoticeSourcesRPC(ctx, &common.ListNoticeSourcesRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	resp.Sources = pack.BuildNoticeSources(sources)
+	pack.RespList(c, resp)
+}
+
+// ListNotifications .
+// @router /api/v1/jwch/notifications [GET]
+func ListNotifications(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListNotificationsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+
+	resp := new(api.ListNotificationsResponse)
+	notifications, total, err := rpc.ListNotificationsRPC(ctx, &common.ListNotificationsRequest{
+		PageNum:    req.PageNum,
+		UnreadOnly: req.UnreadOnly,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	resp.Notifications = pack.BuildNotifications(notifications)
+	resp.Total = total
+	pack.RespList(c, resp)
+}
+
+// MarkNotificationsRead .
+// @router /api/v1/jwch/notifications/read [POST]
+func MarkNotificationsRead(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.MarkNotificationsReadRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+
+	resp := new(api.MarkNotificationsReadResponse)
+	resp.Marked, err = rpc.MarkNotificationsReadRPC(ctx, &common.MarkNotificationsReadRequest{Ids: req.Ids})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// GetUnreadNotificationCount .
+// @router /api/v1/jwch/notifications/unread-count [GET]
+func GetUnreadNotificationCount(ctx context.Context, c *app.RequestContext) {
+	var err error
+	resp := new(api.GetUnreadNotificationCountResponse)
+	resp.Count, err = rpc.GetUnreadNotificationCountRPC(ctx, &common.GetUnreadNotificationCountRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
@@ -182,6 +182,13 @@ func TestGetNotice(t *testing.T) {
 			mockTotal:      1,
 			expectContains: `{"code":"10000","message":"ok","data":`,
 		},
+		{
+			name:           "with source",
+			url:            "/api/v1/common/notice?pageNum=1&source=library",
+			mockNotices:    []*model.NoticeInfo{{Title: new("闭馆通知"), Source: new("library")}},
+			mockTotal:      1,
+			expectContains: `"source":"library"`,
+		},
 		{
 			name:           "rpc error",
 			url:            "/api/v1/common/notice?pageNum=1",
@@ -212,6 +219,147 @@ func TestGetNotice(t *testing.T) {
 	}
 }
 
+func TestSearchNotices(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockHits       []*model.NoticeSearchHit
+		mockTotal      int64
+		mockErr        error
+		expectContains string
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			url:  "/api/v1/common/notice/search?query=%E8%80%83%E8%AF%95&startDate=2024-09-01",
+			mockHits: []*model.NoticeSearchHit{
+				{Title: "考试安排", Url: "u1", Date: "2024-09-02", HighlightTitle: new("<em>考试</em>安排"), Highlights: []string{}},
+			},
+			mockTotal:      1,
+			expectContains: `"highlightTitle":"\u003cem\u003e考试\u003c/em\u003e安排"`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v1/common/notice/search?query=test",
+			mockErr:        errno.ParamError.WithMessage("query is too long"),
+			expectContains: `{"code":"20001","message":"query is too long"`,
+		},
+		{
+			name:           "bind error",
+			url:            "/api/v1/common/notice/search",
+			expectContains: `{"code":"20001","message":"参数错误`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v1/common/notice/search", SearchNotices)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.SearchNoticesRPC).To(func(ctx context.Context, req *common.SearchNoticesRequest) ([]*model.NoticeSearchHit, int64, error) {
+				return tc.mockHits, tc.mockTotal, tc.mockErr
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestListNoticeSources(t *testing.T) {
+	type testCase struct {
+		name           string
+		mockSources    []*model.NoticeSource
+		mockErr        error
+		expectContains string
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			mockSources: []*model.NoticeSource{
+				{Name: "jwch", DisplayName: "教务处"},
+				{Name: "library", DisplayName: "图书馆"},
+			},
+			expectContains: `"sources":[{"name":"jwch","displayName":"教务处"},{"name":"library","displayName":"图书馆"}]`,
+		},
+		{
+			name:           "rpc error",
+			mockErr:        errno.InternalServiceError,
+			expectContains: `{"code":"50001","message":"内部服务错误"`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v1/common/notice/sources", ListNoticeSources)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.ListNoticeSourcesRPC).Return(tc.mockSources, tc.mockErr).Build()
+
+			res := ut.PerformRequest(router, consts.MethodGet, "/api/v1/common/notice/sources", nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestGetNoticeDetail(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockDetail     *model.NoticeDetail
+		mockErr        error
+		expectContains string
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			url:  "/api/v1/common/notice/detail?url=https%3A%2F%2Fjwch.fzu.edu.cn%2Finfo%2F1.htm",
+			mockDetail: &model.NoticeDetail{
+				Title:       "考试安排",
+				Url:         "https://jwch.fzu.edu.cn/info/1.htm",
+				Date:        "2024-12-01",
+				Content:     "<p>考场安排见附件</p>",
+				Attachments: []*model.NoticeAttachment{{Name: "考场.xls", Url: "https://oss/notice/1/a.xls", Mirrored: true}},
+			},
+			expectContains: `"attachments":[{"name":"考场.xls","url":"https://oss/notice/1/a.xls","mirrored":true}]`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v1/common/notice/detail?url=u1",
+			mockErr:        errno.NewErrNo(errno.BizNotExist, "notice not found"),
+			expectContains: `"message":"notice not found"`,
+		},
+		{
+			name:           "bind error",
+			url:            "/api/v1/common/notice/detail",
+			expectContains: `{"code":"20001","message":"参数错误`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v1/common/notice/detail", GetNoticeDetail)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.GetNoticeDetailRPC).To(func(ctx context.Context, req *common.GetNoticeDetailRequest) (*model.NoticeDetail, error) {
+				return tc.mockDetail, tc.mockErr
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
 func TestGetContributorInfo(t *testing.T) {
 	type testCase struct {
 		name           string
@@ -810,3 +958,128 @@ func TestGetSignedLocationApiUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestNoticeSubscriptions(t *testing.T) {
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v1/jwch/notice/subscriptions", ListNoticeSubscriptions)
+	router.POST("/api/v1/jwch/notice/subscriptions", SubscribeNotice)
+	router.DELETE("/api/v1/jwch/notice/subscriptions", UnsubscribeNotice)
+	type testCase struct {
+		name string
+		test func(*testing.T)
+	}
+	testCases := []testCase{
+		{name: "list success", test: func(t *testing.T) {
+			mockey.Mock(rpc.ListNoticeSubscriptionsRPC).Return([]*model.NoticeSubscription{{Keyword: "考试", Tag: "notice-sub-x"}}, nil).Build()
+			res := ut.PerformRequest(router, consts.MethodGet, "/api/v1/jwch/notice/subscriptions", nil)
+			assert.Contains(t, string(res.Result().Body()), `"subscriptions":[{"keyword":"考试","tag":"notice-sub-x"}]`)
+		}},
+		{name: "list rpc error", test: func(t *testing.T) {
+			mockey.Mock(rpc.ListNoticeSubscriptionsRPC).Return(nil, errno.InternalServiceError).Build()
+			res := ut.PerformRequest(router, consts.MethodGet, "/api/v1/jwch/notice/subscriptions", nil)
+			assert.Contains(t, string(res.Result().Body()), `{"code":"50001","message":"内部服务错误"}`)
+		}},
+		{name: "subscribe success", test: func(t *testing.T) {
+			mockey.Mock(rpc.SubscribeNoticeRPC).To(
+				func(_ context.Context, req *common.SubscribeNoticeRequest) (*model.NoticeSubscription, error) {
+					assert.Equal(t, "奖学金", req.Keyword)
+					return &model.NoticeSubscription{Keyword: req.Keyword, Tag: "notice-sub-y"}, nil
+				},
+			).Build()
+			res := ut.PerformRequest(router, consts.MethodPost, "/api/v1/jwch/notice/subscriptions?keyword=%E5%A5%96%E5%AD%A6%E9%87%91", nil)
+			assert.Contains(t, string(res.Result().Body()), `"data":{"keyword":"奖学金","tag":"notice-sub-y"}`)
+		}},
+		{name: "subscribe bind error", test: func(t *testing.T) {
+			res := ut.PerformRequest(router, consts.MethodPost, "/api/v1/jwch/notice/subscriptions", nil)
+			assert.Contains(t, string(res.Result().Body()), `{"code":"20001","message":"参数错误`)
+		}},
+		{name: "subscribe limit", test: func(t *testing.T) {
+			mockey.Mock(rpc.SubscribeNoticeRPC).Return(nil, errno.NewErrNo(errno.BizLimitCode, "at most 20 subscriptions are allowed")).Build()
+			res := ut.PerformRequest(router, consts.MethodPost, "/api/v1/jwch/notice/subscriptions?keyword=a", nil)
+			assert.Contains(t, string(res.Result().Body()), `{"code":"40003","message":"at most 20 subscriptions are allowed"}`)
+		}},
+		{name: "unsubscribe success", test: func(t *testing.T) {
+			mockey.Mock(rpc.UnsubscribeNoticeRPC).Return(nil).Build()
+			res := ut.PerformRequest(router, consts.MethodDelete, "/api/v1/jwch/notice/subscriptions?keyword=a", nil)
+			assert.Contains(t, string(res.Result().Body()), `{"code":"10000","message":"ok"}`)
+		}},
+		{name: "unsubscribe rpc error", test: func(t *testing.T) {
+			mockey.Mock(rpc.UnsubscribeNoticeRPC).Return(errno.InternalServiceError).Build()
+			res := ut.PerformRequest(router, consts.MethodDelete, "/api/v1/jwch/notice/subscriptions?keyword=a", nil)
+			assert.Contains(t, string(res.Result().Body()), `{"code":"50001","message":"内部服务错误"}`)
+		}},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		t.Run(tc.name, tc.test)
+		mockey.UnPatchAll()
+	}
+}
+
+func TestNotifications(t *testing.T) {
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v1/jwch/notifications", ListNotifications)
+	router.POST("/api/v1/jwch/notifications/read", MarkNotificationsRead)
+	router.GET("/api/v1/jwch/notifications/unread-count", GetUnreadNotificationCount)
+	type testCase struct {
+		name string
+		test func(*testing.T)
+	}
+	testCases := []testCase{
+		{name: "list success", test: func(t *testing.T) {
+			mockey.Mock(rpc.ListNotificationsRPC).To(
+				func(_ context.Context, req *common.ListNotificationsRequest) ([]*model.Notification, int64, error) {
+					assert.Equal(t, int64(2), req.GetPageNum())
+					assert.True(t, req.GetUnreadOnly())
+					return []*model.Notification{{Id: 1, Type: "exam", Title: "考试更新啦", Content: "数据结构考试已更新", CreatedAt: 1700000000000}}, 21, nil
+				},
+			).Build()
+			res := ut.PerformRequest(router, consts.MethodGet, "/api/v1/jwch/notifications?pageNum=2&unreadOnly=true", nil)
+			body := string(res.Result().Body())
+			assert.Contains(t, body, `"notifications":[{"id":1,"type":"exam","title":"考试更新啦","content":"数据结构考试已更新","isRead":false,"createdAt":1700000000000}]`)
+			assert.Contains(t, body, `"total":21`)
+		}},
+		{name: "list rpc error", test: func(t *testing.T) {
+			mockey.Mock(rpc.ListNotificationsRPC).Return(nil, int64(0), errno.InternalServiceError).Build()
+			res := ut.PerformRequest(router, consts.MethodGet, "/api/v1/jwch/notifications", nil)
+			assert.Contains(t, string(res.Result().Body()), `{"code":"50001","message":"内部服务错误"}`)
+		}},
+		{name: "mark read success", test: func(t *testing.T) {
+			mockey.Mock(rpc.MarkNotificationsReadRPC).To(
+				func(_ context.Context, req *common.MarkNotificationsReadRequest) (int64, error) {
+					assert.Equal(t, []int64{1, 2}, req.Ids)
+					return 2, nil
+				},
+			).Build()
+			body := `{"ids":[1,2]}`
+			res := ut.PerformRequest(router, consts.MethodPost, "/api/v1/jwch/notifications/read",
+				&ut.Body{Body: strings.NewReader(body), Len: len(body)},
+				ut.Header{Key: "Content-Type", Value: "application/json"})
+			assert.Contains(t, string(res.Result().Body()), `"data":{"marked":2}`)
+		}},
+		{name: "mark read bind error", test: func(t *testing.T) {
+			body := `{"ids":"x"}`
+			res := ut.PerformRequest(router, consts.MethodPost, "/api/v1/jwch/notifications/read",
+				&ut.Body{Body: strings.NewReader(body), Len: len(body)},
+				ut.Header{Key: "Content-Type", Value: "application/json"})
+			assert.Contains(t, string(res.Result().Body()), `{"code":"20001","message":"参数错误`)
+		}},
+		{name: "unread count success", test: func(t *testing.T) {
+			mockey.Mock(rpc.GetUnreadNotificationCountRPC).Return(int64(3), nil).Build()
+			res := ut.PerformRequest(router, consts.MethodGet, "/api/v1/jwch/notifications/unread-count", nil)
+			assert.Contains(t, string(res.Result().Body()), `"data":{"count":3}`)
+		}},
+		{name: "unread count rpc error", test: func(t *testing.T) {
+			mockey.Mock(rpc.GetUnreadNotificationCountRPC).Return(int64(0), errno.InternalServiceError).Build()
+			res := ut.PerformRequest(router, consts.MethodGet, "/api/v1/jwch/notifications/unread-count", nil)
+			assert.Contains(t, string(res.Result().Body()), `{"code":"50001","message":"内部服务错误"}`)
+		}},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		t.Run(tc.name, tc.test)
+		mockey.UnPatchAll()
+	}
+}
@@ -57,8 +57,8 @@ func GetCourseList(ctx context.Context, c *app.RequestContext) {
 	}
 
 	resp := new(api.CourseListResponse)
-	resp.Data = pack.BuildCourseList(res)
-	pack.RespList(c, resp.Data)
+	resp.Data = pack.BuildCourseList(res.Data)
+	pack.RespListWithSnapshot(c, resp.Data, res.GetStale(), res.GetSnapshotTime())
 }
 
 // GetTermList .
@@ -72,7 +72,7 @@ func GetTermList(ctx context.Context, c *app.RequestContext) {
 
 	resp := new(api.CourseTermListResponse)
 	resp.Data = res.Data
-	pack.RespList(c, resp.Data)
+	pack.RespListWithSnapshot(c, resp.Data, res.GetStale(), res.GetSnapshotTime())
 }
 
 // GetLocateDate .
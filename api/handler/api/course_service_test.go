@@ -55,18 +55,25 @@ func TestGetCourseList(t *testing.T) {
 	type testCase struct {
 		name           string
 		url            string
-		mockResp       []*model.Course
+		mockResp       *course.CourseListResponse
 		mockErr        error
 		expectContains string
 	}
 
+	stale, snapshotTime := true, int64(1725148800000)
 	testCases := []testCase{
 		{
 			name:           "success",
 			url:            "/api/v1/jwch/course/list?term=202401",
-			mockResp:       []*model.Course{},
+			mockResp:       &course.CourseListResponse{Data: []*model.Course{}},
 			expectContains: `{"code":"10000","message":"ok","data":[]}`,
 		},
+		{
+			name:           "stale snapshot",
+			url:            "/api/v1/jwch/course/list?term=202401",
+			mockResp:       &course.CourseListResponse{Data: []*model.Course{}, Stale: &stale, SnapshotTime: &snapshotTime},
+			expectContains: `{"code":"10000","message":"ok","data":[],"stale":true,"snapshot_time":1725148800000}`,
+		},
 		{
 			name:           "rpc error",
 			url:            "/api/v1/jwch/course/list?term=202401",
@@ -86,7 +93,7 @@ func TestGetCourseList(t *testing.T) {
 	defer mockey.UnPatchAll()
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
-			mockey.Mock(rpc.GetCourseListRPC).To(func(ctx context.Context, req *course.CourseListRequest) ([]*model.Course, error) {
+			mockey.Mock(rpc.GetCourseListRPC).To(func(ctx context.Context, req *course.CourseListRequest) (*course.CourseListResponse, error) {
 				return tc.mockResp, tc.mockErr
 			}).Build()
 
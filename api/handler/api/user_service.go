@@ -69,7 +69,7 @@ func RefreshToken(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.AuthMissing)
 		return
 	}
-	tokenType, _, err := mw.CheckToken(token)
+	tokenType, stuId, err := mw.CheckToken(token)
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -78,7 +78,7 @@ func RefreshToken(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.AuthMissing.WithMessage("token type is access token, need refresh token"))
 		return
 	}
-	access, refresh, err := mw.CreateAllToken()
+	access, refresh, err := mw.CreateAllToken(stuId)
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -116,7 +116,7 @@ func GetToken(ctx context.Context, c *app.RequestContext) {
 		}
 	}
 
-	access, refresh, err := mw.CreateAllToken()
+	access, refresh, err := mw.CreateAllToken(id)
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -283,3 +283,79 @@ func ReorderFriendList(ctx context.Context, c *app.RequestContext) {
 	}
 	pack.RespSuccess(c)
 }
+
+// RegisterDevice .
+// @router /api/v1/jwch/user/device [POST]
+func RegisterDevice(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RegisterDeviceRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+	err = rpc.RegisterDeviceRPC(ctx, &user.RegisterDeviceRequest{
+		DeviceToken: req.DeviceToken,
+		Platform:    req.Platform,
+		AppVersion:  req.AppVersion,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// UnregisterDevice .
+// @router /api/v1/jwch/user/device [DELETE]
+func UnregisterDevice(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UnregisterDeviceRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+	err = rpc.UnregisterDeviceRPC(ctx, &user.UnregisterDeviceRequest{
+		DeviceToken: req.DeviceToken,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetNotificationPreference .
+// @router /api/v1/jwch/user/notification/preference [GET]
+func GetNotificationPreference(ctx context.Context, c *app.RequestContext) {
+	data, err := rpc.GetNotificationPreferenceRPC(ctx, &user.GetNotificationPreferenceRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, data)
+}
+
+// UpdateNotificationPreference .
+// @router /api/v1/jwch/user/notification/preference [PUT]
+func UpdateNotificationPreference(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdateNotificationPreferenceRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+	err = rpc.UpdateNotificationPreferenceRPC(ctx, &user.UpdateNotificationPreferenceRequest{
+		DisabledTypes: req.DisabledTypes,
+		Channel:       req.Channel,
+		QuietStart:    req.QuietStart,
+		QuietEnd:      req.QuietEnd,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
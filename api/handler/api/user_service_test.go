@@ -695,3 +695,187 @@ func TestReorderFriendList(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterDevice(t *testing.T) {
+	type testCase struct {
+		name           string
+		body           string
+		mockRPCError   error
+		expectContains string
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			body:           `{"device_token":"token-a","platform":"android","app_version":"8.0.0"}`,
+			expectContains: `{"code":"10000","message":"ok"`,
+		},
+		{
+			name:           "bind error - missing platform",
+			body:           `{"device_token":"token-a"}`,
+			expectContains: `{"code":"20001","message":"参数错误,`,
+		},
+		{
+			name:           "rpc error",
+			body:           `{"device_token":"token-a","platform":"ios"}`,
+			mockRPCError:   errno.InternalServiceError,
+			expectContains: `{"code":"50001","message":"内部服务错误"}`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.POST("/api/v1/jwch/user/device", RegisterDevice)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.RegisterDeviceRPC).To(func(ctx context.Context, req *user.RegisterDeviceRequest) error {
+				return tc.mockRPCError
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodPost, "/api/v1/jwch/user/device", &ut.Body{
+				Body: strings.NewReader(tc.body),
+				Len:  len(tc.body),
+			}, ut.Header{
+				Key:   "Content-Type",
+				Value: "application/json",
+			})
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestUnregisterDevice(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockRPCError   error
+		expectContains string
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			url:            "/api/v1/jwch/user/device?device_token=token-a",
+			expectContains: `{"code":"10000","message":"ok"`,
+		},
+		{
+			name:           "bind error - missing device token",
+			url:            "/api/v1/jwch/user/device",
+			expectContains: `{"code":"20001","message":"参数错误,`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v1/jwch/user/device?device_token=token-a",
+			mockRPCError:   errno.InternalServiceError,
+			expectContains: `{"code":"50001","message":"内部服务错误"}`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.DELETE("/api/v1/jwch/user/device", UnregisterDevice)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.UnregisterDeviceRPC).To(func(ctx context.Context, req *user.UnregisterDeviceRequest) error {
+				return tc.mockRPCError
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodDelete, tc.url, nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestGetNotificationPreference(t *testing.T) {
+	type testCase struct {
+		name           string
+		mockData       *model.NotificationPreference
+		mockRPCError   error
+		expectContains string
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			mockData:       &model.NotificationPreference{DisabledTypes: []string{"score"}, Channel: "all"},
+			expectContains: `"disabled_types":["score"],"channel":"all"`,
+		},
+		{
+			name:           "rpc error",
+			mockRPCError:   errno.InternalServiceError,
+			expectContains: `{"code":"50001","message":"内部服务错误"}`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v1/jwch/user/notification/preference", GetNotificationPreference)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.GetNotificationPreferenceRPC).To(func(ctx context.Context,
+				req *user.GetNotificationPreferenceRequest,
+			) (*model.NotificationPreference, error) {
+				return tc.mockData, tc.mockRPCError
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodGet, "/api/v1/jwch/user/notification/preference", nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestUpdateNotificationPreference(t *testing.T) {
+	type testCase struct {
+		name           string
+		body           string
+		mockRPCError   error
+		expectContains string
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			body:           `{"disabled_types":["score"],"channel":"all","quiet_start":"23:00","quiet_end":"07:00"}`,
+			expectContains: `{"code":"10000","message":"ok"`,
+		},
+		{
+			name:           "bind error - missing channel",
+			body:           `{"disabled_types":[]}`,
+			expectContains: `{"code":"20001","message":"参数错误,`,
+		},
+		{
+			name:           "rpc error",
+			body:           `{"disabled_types":[],"channel":"inbox"}`,
+			mockRPCError:   errno.InternalServiceError,
+			expectContains: `{"code":"50001","message":"内部服务错误"}`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.PUT("/api/v1/jwch/user/notification/preference", UpdateNotificationPreference)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.UpdateNotificationPreferenceRPC).To(func(ctx context.Context, req *user.UpdateNotificationPreferenceRequest) error {
+				return tc.mockRPCError
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodPut, "/api/v1/jwch/user/notification/preference", &ut.Body{
+				Body: strings.NewReader(tc.body),
+				Len:  len(tc.body),
+			}, ut.Header{
+				Key:   "Content-Type",
+				Value: "application/json",
+			})
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
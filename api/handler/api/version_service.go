@@ -65,13 +65,16 @@ func UploadVersion(ctx context.Context, c *app.RequestContext) {
 	// resp := new(api.UploadResponse)
 
 	err = rpc.UploadVersionRPC(ctx, &version.UploadRequest{
-		Version:  req.Version,
-		Code:     req.Code,
-		Url:      req.URL,
-		Feature:  req.Feature,
-		Type:     req.Type,
-		Password: req.Password,
-		Force:    req.Force,
+		Version:          req.Version,
+		Code:             req.Code,
+		Url:              req.URL,
+		Feature:          req.Feature,
+		Type:             req.Type,
+		Password:         req.Password,
+		Force:            req.Force,
+		Platform:         req.Platform,
+		RolloutPercent:   req.RolloutPercent,
+		MinSupportedCode: req.MinSupportedCode,
 	})
 	if err != nil {
 		pack.RespError(c, err)
@@ -131,9 +134,20 @@ func DownloadBetaApk(ctx context.Context, c *app.RequestContext) {
 // GetReleaseVersion .
 // @router /api/v2/url/version.json [GET]
 func GetReleaseVersion(ctx context.Context, c *app.RequestContext) {
-	resp := new(api.GetReleaseVersionResponse)
+	var err error
+	var req api.GetReleaseVersionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
 
-	rpcResp, err := rpc.GetReleaseVersionRPC(ctx, &version.GetReleaseVersionRequest{})
+	resp := new(api.GetReleaseVersionResponse)
+	rpcResp, err := rpc.GetReleaseVersionRPC(ctx, &version.GetReleaseVersionRequest{
+		DeviceId: req.DeviceID,
+		StuId:    req.StuID,
+		Code:     req.Code,
+	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -149,9 +163,20 @@ func GetReleaseVersion(ctx context.Context, c *app.RequestContext) {
 // GetBetaVersion .
 // @router /api/v2/url/versionbeta.json [GET]
 func GetBetaVersion(ctx context.Context, c *app.RequestContext) {
-	resp := new(api.GetBetaVersionResponse)
+	var err error
+	var req api.GetBetaVersionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
 
-	rpcResp, err := rpc.GetBetaVersionRPC(ctx, &version.GetBetaVersionRequest{})
+	resp := new(api.GetBetaVersionResponse)
+	rpcResp, err := rpc.GetBetaVersionRPC(ctx, &version.GetBetaVersionRequest{
+		DeviceId: req.DeviceID,
+		StuId:    req.StuID,
+		Code:     req.Code,
+	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -278,9 +303,20 @@ func GetDump(ctx context.Context, c *app.RequestContext) {
 // AndroidGetVersion .
 // @router /api/v2/version/android [GET]
 func AndroidGetVersion(ctx context.Context, c *app.RequestContext) {
-	resp := new(api.AndroidGetVersionResponse)
+	var err error
+	var req api.AndroidGetVersioneRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
 
-	rpcResp, err := rpc.AndroidVersionRPC(ctx, &version.AndroidGetVersioneRequest{})
+	resp := new(api.AndroidGetVersionResponse)
+	rpcResp, err := rpc.AndroidVersionRPC(ctx, &version.AndroidGetVersioneRequest{
+		DeviceId: req.DeviceID,
+		StuId:    req.StuID,
+		Code:     req.Code,
+	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -289,3 +325,153 @@ func AndroidGetVersion(ctx context.Context, c *app.RequestContext) {
 	resp.Beta = pack.BuildVersion(rpcResp.Beta)
 	pack.RespList(c, resp)
 }
+
+// RollbackVersion .
+// @router /api/v2/url/rollback [POST]
+func RollbackVersion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RollbackVersionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+
+	data, err := rpc.RollbackVersionRPC(ctx, &version.RollbackVersionRequest{
+		Password: req.Password,
+		Type:     req.Type,
+		Platform: req.Platform,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, pack.BuildVersion(data))
+}
+
+// ListVersions .
+// @router /api/v2/version/list [GET]
+func ListVersions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListVersionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+
+	versions, total, err := rpc.ListVersionsRPC(ctx, &version.ListVersionsRequest{
+		Channel:  req.Channel,
+		Platform: req.Platform,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespList(c, &api.ListVersionsResponse{
+		Data:  pack.BuildVersionList(versions),
+		Total: total,
+	})
+}
+
+// GetVersionChangelog .
+// @router /api/v2/version/changelog [GET]
+func GetVersionChangelog(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetVersionChangelogRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+
+	changelog, err := rpc.GetVersionChangelogRPC(ctx, &version.GetVersionChangelogRequest{
+		Channel:  req.Channel,
+		Code:     req.Code,
+		Platform: req.Platform,
+		DeviceId: req.DeviceID,
+		StuId:    req.StuID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, pack.BuildVersionChangelog(changelog))
+}
+
+// GetLatestVersion .
+// @router /api/v2/version/latest [GET]
+func GetLatestVersion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetLatestVersionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+
+	latest, err := rpc.GetLatestVersionRPC(ctx, &version.GetLatestVersionRequest{
+		Platform: req.Platform,
+		Channel:  req.Channel,
+		DeviceId: req.DeviceID,
+		StuId:    req.StuID,
+		Code:     req.Code,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, pack.BuildLatestVersion(latest))
+}
+
+// DryRunCloudSetting .
+// @router /api/v2/url/dryrun [POST]
+func DryRunCloudSetting(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.DryRunCloudSettingRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+
+	dryRun, err := rpc.DryRunCloudSettingRPC(ctx, &version.DryRunCloudSettingRequest{
+		Account:   req.Account,
+		Version:   req.Version,
+		Beta:      req.Beta,
+		Phone:     req.Phone,
+		IsLogin:   req.IsLogin,
+		LoginType: req.LoginType,
+		Setting:   req.Setting,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, pack.BuildCloudSettingDryRun(dryRun))
+}
+
+// GetCloudSettingCoverage .
+// @router /api/v2/url/coverage [POST]
+func GetCloudSettingCoverage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetCloudSettingCoverageRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamError.WithError(err))
+		return
+	}
+
+	coverage, err := rpc.GetCloudSettingCoverageRPC(ctx, &version.GetCloudSettingCoverageRequest{
+		Password: req.Password,
+		Setting:  req.Setting,
+		Samples:  req.Samples,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, pack.BuildCloudSettingCoverage(coverage))
+}
@@ -637,3 +637,350 @@ func TestAndroidGetVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestRollbackVersion(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockResp       *model.Version
+		mockRPCErr     error
+		expectContains string
+	}
+
+	previous := &model.Version{
+		VersionCode: ptrStr("1"),
+		VersionName: ptrStr("1.0.0"),
+		Url:         ptrStr("http://example.com/release.apk"),
+		Changelog:   ptrStr("release feature"),
+		Force:       ptrBool(false),
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			url:            "/api/v2/url/rollback?password=pass&type=release",
+			mockResp:       previous,
+			expectContains: `"version_name":"1.0.0"`,
+		},
+		{
+			name:           "param error - missing password",
+			url:            "/api/v2/url/rollback?type=release",
+			expectContains: `"code":"20001","message":"参数错误,`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v2/url/rollback?password=pass&type=release&platform=ios",
+			mockRPCErr:     errno.InternalServiceError,
+			expectContains: `"code":"50001","message":"内部服务错误"`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.POST("/api/v2/url/rollback", RollbackVersion)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.RollbackVersionRPC).To(func(ctx context.Context, req *version.RollbackVersionRequest) (*model.Version, error) {
+				return tc.mockResp, tc.mockRPCErr
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodPost, tc.url, nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestListVersions(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockResp       []*model.Version
+		mockTotal      int64
+		mockRPCErr     error
+		expectContains string
+	}
+
+	versions := []*model.Version{
+		{
+			VersionCode: ptrStr("2"),
+			VersionName: ptrStr("2.0.0"),
+			Changelog:   ptrStr("release feature"),
+		},
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			url:            "/api/v2/version/list?channel=release&page_num=1",
+			mockResp:       versions,
+			mockTotal:      1,
+			expectContains: `"total":1`,
+		},
+		{
+			name:           "param error - missing channel",
+			url:            "/api/v2/version/list",
+			expectContains: `"code":"20001","message":"参数错误,`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v2/version/list?channel=release",
+			mockRPCErr:     errno.InternalServiceError,
+			expectContains: `"code":"50001","message":"内部服务错误"`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v2/version/list", ListVersions)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.ListVersionsRPC).To(func(ctx context.Context, req *version.ListVersionsRequest) ([]*model.Version, int64, error) {
+				return tc.mockResp, tc.mockTotal, tc.mockRPCErr
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestGetVersionChangelog(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockResp       *model.VersionChangelog
+		mockRPCErr     error
+		expectContains string
+	}
+
+	changelog := &model.VersionChangelog{
+		Latest: &model.Version{
+			VersionCode: ptrStr("3"),
+			VersionName: ptrStr("3.0.0"),
+			Force:       ptrBool(false),
+		},
+		Changelog: "3.0.0\nnew feature",
+		Versions:  []*model.Version{{VersionCode: ptrStr("3")}},
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			url:            "/api/v2/version/changelog?channel=release&code=1&device_id=device",
+			mockResp:       changelog,
+			expectContains: `"changelog":"3.0.0\nnew feature"`,
+		},
+		{
+			name:           "already latest",
+			url:            "/api/v2/version/changelog?channel=release&code=3",
+			mockResp:       &model.VersionChangelog{},
+			expectContains: `"versions":[]`,
+		},
+		{
+			name:           "param error - missing code",
+			url:            "/api/v2/version/changelog?channel=release",
+			expectContains: `"code":"20001","message":"参数错误,`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v2/version/changelog?channel=release&code=1",
+			mockRPCErr:     errno.InternalServiceError,
+			expectContains: `"code":"50001","message":"内部服务错误"`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v2/version/changelog", GetVersionChangelog)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.GetVersionChangelogRPC).To(func(ctx context.Context, req *version.GetVersionChangelogRequest) (*model.VersionChangelog, error) {
+				return tc.mockResp, tc.mockRPCErr
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestGetLatestVersion(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockResp       *model.LatestVersion
+		mockRPCErr     error
+		expectContains string
+	}
+
+	latest := &model.LatestVersion{
+		Version: &model.Version{
+			VersionCode: ptrStr("30"),
+			VersionName: ptrStr("3.0.0"),
+		},
+		StoreUrl:         ptrStr("https://apps.apple.com/cn/app/id1"),
+		Force:            true,
+		MinSupportedCode: 20,
+	}
+
+	testCases := []testCase{
+		{
+			name:           "success",
+			url:            "/api/v2/version/latest?platform=ios&code=10",
+			mockResp:       latest,
+			expectContains: `"store_url":"https://apps.apple.com/cn/app/id1","force":true,"min_supported_code":20`,
+		},
+		{
+			name:           "no version",
+			url:            "/api/v2/version/latest?platform=harmony&channel=beta",
+			mockResp:       &model.LatestVersion{},
+			expectContains: `"data":{"force":false,"min_supported_code":0}`,
+		},
+		{
+			name:           "param error - missing platform",
+			url:            "/api/v2/version/latest",
+			expectContains: `"code":"20001","message":"参数错误,`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v2/version/latest?platform=ios",
+			mockRPCErr:     errno.InternalServiceError,
+			expectContains: `"code":"50001","message":"内部服务错误"`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.GET("/api/v2/version/latest", GetLatestVersion)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.GetLatestVersionRPC).To(func(ctx context.Context, req *version.GetLatestVersionRequest) (*model.LatestVersion, error) {
+				return tc.mockResp, tc.mockRPCErr
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestDryRunCloudSetting(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockResp       *model.CloudSettingDryRun
+		mockRPCErr     error
+		expectContains string
+	}
+
+	matched := int64(1)
+	dryRun := &model.CloudSettingDryRun{
+		MatchedIndex: &matched,
+		Plan:         ptrStr(`{"key":"release"}`),
+		Plans: []*model.CloudSettingPlanMatch{
+			{Index: 0, Name: ptrStr("beta"), Matched: false, MismatchedFields: []string{"Beta"}},
+			{Index: 1, Matched: true, MismatchedFields: []string{}},
+		},
+	}
+
+	testCases := []testCase{
+		{
+			name:     "success",
+			url:      "/api/v2/url/dryrun?beta=false&version=5.1.0",
+			mockResp: dryRun,
+			expectContains: `"data":{"matched_index":1,"plan":"{\"key\":\"release\"}","plans":[` +
+				`{"index":0,"name":"beta","matched":false,"mismatched_fields":["Beta"]},` +
+				`{"index":1,"matched":true,"mismatched_fields":[]}]}`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v2/url/dryrun?setting=invalid",
+			mockRPCErr:     errno.ParamError.WithMessage("invalid json"),
+			expectContains: `"code":"20001","message":"invalid json"`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.POST("/api/v2/url/dryrun", DryRunCloudSetting)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.DryRunCloudSettingRPC).To(func(ctx context.Context, req *version.DryRunCloudSettingRequest) (*model.CloudSettingDryRun, error) {
+				return tc.mockResp, tc.mockRPCErr
+			}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodPost, tc.url, nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
+
+func TestGetCloudSettingCoverage(t *testing.T) {
+	type testCase struct {
+		name           string
+		url            string
+		mockResp       *model.CloudSettingCoverage
+		mockRPCErr     error
+		expectContains string
+	}
+
+	shadowedBy := int64(0)
+	coverage := &model.CloudSettingCoverage{
+		Samples: 3,
+		Plans: []*model.CloudSettingPlanCoverage{
+			{Index: 0, Name: ptrStr("beta"), Hits: 2, Eligible: 2},
+			{Index: 1, Hits: 0, Eligible: 1, ShadowedBy: &shadowedBy},
+		},
+		Unmatched: []*model.CloudSettingUnmatched{
+			{Criteria: &model.CloudSettingCriteria{Version: ptrStr("4.0.0")}, Count: 1},
+		},
+	}
+
+	testCases := []testCase{
+		{
+			name:     "success",
+			url:      "/api/v2/url/coverage?password=pass&samples=100",
+			mockResp: coverage,
+			expectContains: `"data":{"samples":3,"plans":[{"index":0,"name":"beta","hits":2,"eligible":2},` +
+				`{"index":1,"hits":0,"eligible":1,"shadowed_by":0}],"unmatched":[{"criteria":{"version":"4.0.0"},"count":1}]}`,
+		},
+		{
+			name:           "param error - missing password",
+			url:            "/api/v2/url/coverage",
+			expectContains: `"code":"20001","message":"参数错误,`,
+		},
+		{
+			name:           "rpc error",
+			url:            "/api/v2/url/coverage?password=wrong",
+			mockRPCErr:     errno.NewErrNo(401, "authorization failed"),
+			expectContains: `"code":"401","message":"authorization failed"`,
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.POST("/api/v2/url/coverage", GetCloudSettingCoverage)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(rpc.GetCloudSettingCoverageRPC).To(
+				func(ctx context.Context, req *version.GetCloudSettingCoverageRequest) (*model.CloudSettingCoverage, error) {
+					return tc.mockResp, tc.mockRPCErr
+				}).Build()
+
+			res := ut.PerformRequest(router, consts.MethodPost, tc.url, nil)
+			assert.Equal(t, consts.StatusOK, res.Result().StatusCode())
+			assert.Contains(t, string(res.Result().Body()), tc.expectContains)
+		})
+	}
+}
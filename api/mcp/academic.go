@@ -90,7 +90,8 @@ func handleGetScores(ctx context.Context, request mcp.CallToolRequest) (*mcp.Cal
 	}
 
 	resp := map[string]any{
-		"scores": scores,
+		"scores": scores.Scores,
+		"stale":  scores.GetStale(),
 	}
 
 	return mcp.NewToolResultJSON(resp)
@@ -18,6 +18,7 @@ package mcp
 
 import (
 	"context"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpgoserver "github.com/mark3labs/mcp-go/server"
@@ -65,17 +66,9 @@ func handleGetExamRoom(ctx context.Context, request mcp.CallToolRequest) (*mcp.C
 	}
 	ctx = WithLoginData(ctx, auth)
 
-	term := request.GetString("term", "")
-
-	if term == "" {
-		locateDate, err := rpc.GetLocateDateRPC(ctx, course.NewGetLocateDateRequest())
-		if err != nil {
-			return mcp.NewToolResultError("failed to determine default term: " + err.Error()), err
-		}
-		if locateDate == nil || locateDate.Year == "" || locateDate.Term == "" {
-			return mcp.NewToolResultError("failed to determine default term: locate date is empty"), nil
-		}
-		term = locateDate.Year + locateDate.Term
+	term, errResult, err := examTerm(ctx, request)
+	if errResult != nil {
+		return errResult, err
 	}
 
 	examRooms, err := rpc.GetExamRoomInfoRPC(ctx, &classroom.ExamRoomInfoRequest{
@@ -87,6 +80,129 @@ func handleGetExamRoom(ctx context.Context, request mcp.CallToolRequest) (*mcp.C
 
 	return mcp.NewToolResultJSON(map[string]any{
 		"term":       term,
-		"exam_rooms": examRooms,
+		"exam_rooms": examRooms.Rooms,
+		"stale":      examRooms.GetStale(),
+	})
+}
+
+// examTerm 返回请求中的学期，未指定时使用当前学期
+func examTerm(ctx context.Context, request mcp.CallToolRequest) (string, *mcp.CallToolResult, error) {
+	term := request.GetString("term", "")
+	if term != "" {
+		return term, nil, nil
+	}
+	locateDate, err := rpc.GetLocateDateRPC(ctx, course.NewGetLocateDateRequest())
+	if err != nil {
+		return "", mcp.NewToolResultError("failed to determine default term: " + err.Error()), err
+	}
+	if locateDate == nil || locateDate.Year == "" || locateDate.Term == "" {
+		return "", mcp.NewToolResultError("failed to determine default term: locate date is empty"), nil
+	}
+	return locateDate.Year + locateDate.Term, nil, nil
+}
+
+func GetExamRoomChangesTool() mcpgoserver.ServerTool {
+	return mcpgoserver.ServerTool{
+		Tool: mcp.NewTool(
+			"get_exam_room_changes",
+			mcp.WithDescription(
+				"Fetch the history of exam room changes detected for the user in a term. "+
+					"Use this when the user asks whether their exam location, date or time has changed. "+
+					"Returns a list of changes with change_type (added, changed, removed), the previous and current location/date/time, "+
+					"and detected_at in milliseconds. Changes are only detected after the user has queried exam rooms before.",
+			),
+			mcp.WithString("user_id",
+				mcp.Required(),
+				mcp.Description(
+					"user_id data comes from the login method response (user_id field).",
+				)),
+			mcp.WithString("user_cookies",
+				mcp.Required(),
+				mcp.Description(
+					"user_cookies data comes from the login method response (user_cookies field).",
+				)),
+			mcp.WithString("term",
+				mcp.Description(
+					"Academic term code in the form yyyymm. "+
+						"Examples: 202401 means 2024 Autumn term, 202402 means 2025 Spring term. "+
+						"Optional: defaults to current term",
+				)),
+		),
+		Handler: handleGetExamRoomChanges,
+	}
+}
+
+func handleGetExamRoomChanges(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	auth, errResult := ValidateAuthParams(request)
+	if errResult != nil {
+		return errResult, nil
+	}
+	ctx = WithLoginData(ctx, auth)
+
+	term, errResult, err := examTerm(ctx, request)
+	if errResult != nil {
+		return errResult, err
+	}
+
+	changes, err := rpc.GetExamRoomChangesRPC(ctx, &classroom.ExamRoomChangesRequest{
+		Term: term,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultJSON(map[string]any{
+		"term":    term,
+		"changes": changes,
+	})
+}
+
+func GetRoomScheduleTool() mcpgoserver.ServerTool {
+	return mcpgoserver.ServerTool{
+		Tool: mcp.NewTool(
+			"get_room_schedule",
+			mcp.WithDescription(
+				"Fetch the occupied and free class periods of a specific classroom on a given day. "+
+					"Use this when the user asks what is happening in a classroom or when a classroom is free, "+
+					"e.g. \"what's happening in 西3-206 today\". No login is required. "+
+					"Returns the room information, free_periods and occupied_periods (periods range from 1 to 11), "+
+					"and sync_status; when sync_status.fallback is true the data is from the previous day.",
+			),
+			mcp.WithString("room",
+				mcp.Required(),
+				mcp.Description(
+					"Classroom name, e.g. 旗山西3-206 or 晋江A102. The 旗山 prefix may be omitted for Qishan campus rooms, e.g. 西3-206.",
+				)),
+			mcp.WithString("date",
+				mcp.Description(
+					"Date in the form YYYY-MM-DD, within the next 7 days. Optional: defaults to today",
+				)),
+		),
+		Handler: handleGetRoomSchedule,
+	}
+}
+
+func handleGetRoomSchedule(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	room := request.GetString("room", "")
+	if room == "" {
+		return mcp.NewToolResultError("room is required"), nil
+	}
+	date := request.GetString("date", time.Now().Format(time.DateOnly))
+
+	res, err := rpc.GetRoomScheduleRPC(ctx, &classroom.RoomScheduleRequest{
+		Room: room,
+		Date: date,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	schedule := res.Schedule
+	return mcp.NewToolResultJSON(map[string]any{
+		"room":             schedule.Room,
+		"date":             schedule.Date,
+		"free_periods":     schedule.FreePeriods,
+		"occupied_periods": schedule.OccupiedPeriods,
+		"sync_status":      res.SyncStatus,
 	})
 }
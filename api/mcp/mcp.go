@@ -46,7 +46,12 @@ func CreateMCPProxy() *Proxy {
 		GetGPATool(),
 		GetUserInfoTool(),
 		GetExamRoomTool(),
+		GetExamRoomChangesTool(),
+		GetRoomScheduleTool(),
 		GetNoticesTool(),
+		SearchNoticesTool(),
+		GetNoticeDetailTool(),
+		GetNotificationsTool(),
 		GetCalendarTool(),
 	)
 
@@ -45,6 +45,10 @@ func GetNoticesTool() mcpgoserver.ServerTool {
 				mcp.Description(
 					"Number of notices per page. Optional: defaults to 10",
 				)),
+			mcp.WithString("source",
+				mcp.Description(
+					"Notice source such as jwch (educational administration office) or library. Optional: defaults to jwch",
+				)),
 		),
 		Handler: handleGetNotices,
 	}
@@ -61,9 +65,13 @@ func handleGetNotices(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 		pageSize = defaultNoticePageSize
 	}
 
-	notices, total, err := rpc.GetNoticesRPC(ctx, &common.NoticeRequest{
+	req := &common.NoticeRequest{
 		PageNum: page,
-	})
+	}
+	if source := request.GetString("source", ""); source != "" {
+		req.Source = &source
+	}
+	notices, total, err := rpc.GetNoticesRPC(ctx, req)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -75,3 +83,158 @@ func handleGetNotices(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 		"page_size": pageSize,
 	})
 }
+
+func SearchNoticesTool() mcpgoserver.ServerTool {
+	return mcpgoserver.ServerTool{
+		Tool: mcp.NewTool(
+			"search_notices",
+			mcp.WithDescription(
+				"Full-text search over notices from the educational administration office by title and content. "+
+					"Use this when the user looks for a specific notice, e.g. \"exam arrangement notice for this term\". No login required. "+
+					"Returns matched notices with highlighted snippets (keywords wrapped in <em>), 20 per page.",
+			),
+			mcp.WithString("query",
+				mcp.Required(),
+				mcp.Description(
+					"Keywords to search, at most 64 characters",
+				)),
+			mcp.WithString("start_date",
+				mcp.Description(
+					"Earliest publish date in the form YYYY-MM-DD. Optional",
+				)),
+			mcp.WithString("end_date",
+				mcp.Description(
+					"Latest publish date in the form YYYY-MM-DD. Optional",
+				)),
+			mcp.WithNumber("page",
+				mcp.Description(
+					"Page number for pagination. Optional: defaults to 1",
+				)),
+		),
+		Handler: handleSearchNotices,
+	}
+}
+
+func handleSearchNotices(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	query := request.GetString("query", "")
+	if query == "" {
+		return mcp.NewToolResultError("query is required"), nil
+	}
+	page := int64(request.GetInt("page", 1))
+	if page < 1 {
+		page = 1
+	}
+	req := &common.SearchNoticesRequest{
+		Query:   query,
+		PageNum: &page,
+	}
+	if startDate := request.GetString("start_date", ""); startDate != "" {
+		req.StartDate = &startDate
+	}
+	if endDate := request.GetString("end_date", ""); endDate != "" {
+		req.EndDate = &endDate
+	}
+
+	results, total, err := rpc.SearchNoticesRPC(ctx, req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultJSON(map[string]any{
+		"results": results,
+		"total":   total,
+		"page":    page,
+	})
+}
+
+func GetNoticeDetailTool() mcpgoserver.ServerTool {
+	return mcpgoserver.ServerTool{
+		Tool: mcp.NewTool(
+			"get_notice_detail",
+			mcp.WithDescription(
+				"Fetch the full content of a notice from the educational administration office, including attachment download links "+
+					"(e.g. exam room lists in xls/pdf). Use this after get_notices or search_notices when the user wants to read a notice. "+
+					"Returns sanitized HTML content and attachments. No login required.",
+			),
+			mcp.WithString("url",
+				mcp.Required(),
+				mcp.Description(
+					"Notice url returned by get_notices or search_notices",
+				)),
+		),
+		Handler: handleGetNoticeDetail,
+	}
+}
+
+func handleGetNoticeDetail(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	url := request.GetString("url", "")
+	if url == "" {
+		return mcp.NewToolResultError("url is required"), nil
+	}
+
+	detail, err := rpc.GetNoticeDetailRPC(ctx, &common.GetNoticeDetailRequest{Url: url})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultJSON(detail)
+}
+
+func GetNotificationsTool() mcpgoserver.ServerTool {
+	return mcpgoserver.ServerTool{
+		Tool: mcp.NewTool(
+			"get_notifications",
+			mcp.WithDescription(
+				"Fetch the user's notification inbox, which keeps every score, exam and exam room update pushed to the user "+
+					"even if the device missed the push. Use this when the user asks what has changed recently or whether they missed any notification. "+
+					"Returns notifications in reverse chronological order, 20 per page, with isRead and createdAt in milliseconds.",
+			),
+			mcp.WithString("user_id",
+				mcp.Required(),
+				mcp.Description(
+					"user_id data comes from the login method response (user_id field).",
+				)),
+			mcp.WithString("user_cookies",
+				mcp.Required(),
+				mcp.Description(
+					"user_cookies data comes from the login method response (user_cookies field).",
+				)),
+			mcp.WithNumber("page",
+				mcp.Description(
+					"Page number for pagination. Optional: defaults to 1",
+				)),
+			mcp.WithBoolean("unread_only",
+				mcp.Description(
+					"Only return unread notifications. Optional: defaults to false",
+				)),
+		),
+		Handler: handleGetNotifications,
+	}
+}
+
+func handleGetNotifications(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	auth, errResult := ValidateAuthParams(request)
+	if errResult != nil {
+		return errResult, nil
+	}
+	ctx = WithLoginData(ctx, auth)
+
+	page := int64(request.GetInt("page", 1))
+	if page < 1 {
+		page = 1
+	}
+	unreadOnly := request.GetBool("unread_only", false)
+	notifications, total, err := rpc.ListNotificationsRPC(ctx, &common.ListNotificationsRequest{
+		PageNum:    &page,
+		UnreadOnly: &unreadOnly,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultJSON(map[string]any{
+		"notifications": notifications,
+		"total":         total,
+		"page":          page,
+	})
+}
@@ -35,6 +35,12 @@ type EmptyClassroomRequest struct {
 	Campus    string `thrift:"campus,2,required" form:"campus,required" json:"campus,required" query:"campus,required"`
 	StartTime string `thrift:"startTime,3,required" form:"startTime,required" json:"startTime,required" query:"startTime,required"`
 	EndTime   string `thrift:"endTime,4,required" form:"endTime,required" json:"endTime,required" query:"endTime,required"`
+	// 按楼过滤，例 西3
+	Build *string `thrift:"build,5,optional" form:"build" json:"build,omitempty" query:"build"`
+	// 最少可容纳人数
+	MinCapacity *int64 `thrift:"minCapacity,6,optional" form:"minCapacity" json:"minCapacity,omitempty" query:"minCapacity"`
+	// 排序方式，free_longest 表示按请求节次之后的连续空闲时长倒序
+	SortBy *string `thrift:"sortBy,7,optional" form:"sortBy" json:"sortBy,omitempty" query:"sortBy"`
 }
 
 func NewEmptyClassroomRequest() *EmptyClassroomRequest {
@@ -60,6 +66,45 @@ func (p *EmptyClassroomRequest) GetEndTime() (v string) {
 	return p.EndTime
 }
 
+var EmptyClassroomRequest_Build_DEFAULT string
+
+func (p *EmptyClassroomRequest) GetBuild() (v string) {
+	if !p.IsSetBuild() {
+		return EmptyClassroomRequest_Build_DEFAULT
+	}
+	return *p.Build
+}
+
+var EmptyClassroomRequest_MinCapacity_DEFAULT int64
+
+func (p *EmptyClassroomRequest) GetMinCapacity() (v int64) {
+	if !p.IsSetMinCapacity() {
+		return EmptyClassroomRequest_MinCapacity_DEFAULT
+	}
+	return *p.MinCapacity
+}
+
+var EmptyClassroomRequest_SortBy_DEFAULT string
+
+func (p *EmptyClassroomRequest) GetSortBy() (v string) {
+	if !p.IsSetSortBy() {
+		return EmptyClassroomRequest_SortBy_DEFAULT
+	}
+	return *p.SortBy
+}
+
+func (p *EmptyClassroomRequest) IsSetBuild() bool {
+	return p.Build != nil
+}
+
+func (p *EmptyClassroomRequest) IsSetMinCapacity() bool {
+	return p.MinCapacity != nil
+}
+
+func (p *EmptyClassroomRequest) IsSetSortBy() bool {
+	return p.SortBy != nil
+}
+
 func (p *EmptyClassroomRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -68,7 +113,8 @@ func (p *EmptyClassroomRequest) String() string {
 }
 
 type EmptyClassroomResponse struct {
-	Classrooms []*model.Classroom `thrift:"classrooms,1,optional,list<model.Classroom>" form:"classrooms" json:"classrooms,omitempty" query:"classrooms"`
+	Classrooms []*model.Classroom         `thrift:"classrooms,1,optional,list<model.Classroom>" form:"classrooms" json:"classrooms,omitempty" query:"classrooms"`
+	SyncStatus *model.EmptyRoomSyncStatus `thrift:"syncStatus,2,optional" form:"syncStatus" json:"syncStatus,omitempty" query:"syncStatus"`
 }
 
 func NewEmptyClassroomResponse() *EmptyClassroomResponse {
@@ -87,10 +133,23 @@ func (p *EmptyClassroomResponse) GetClassrooms() (v []*model.Classroom) {
 	return p.Classrooms
 }
 
+var EmptyClassroomResponse_SyncStatus_DEFAULT *model.EmptyRoomSyncStatus
+
+func (p *EmptyClassroomResponse) GetSyncStatus() (v *model.EmptyRoomSyncStatus) {
+	if !p.IsSetSyncStatus() {
+		return EmptyClassroomResponse_SyncStatus_DEFAULT
+	}
+	return p.SyncStatus
+}
+
 func (p *EmptyClassroomResponse) IsSetClassrooms() bool {
 	return p.Classrooms != nil
 }
 
+func (p *EmptyClassroomResponse) IsSetSyncStatus() bool {
+	return p.SyncStatus != nil
+}
+
 func (p *EmptyClassroomResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -98,6 +157,78 @@ func (p *EmptyClassroomResponse) String() string {
 	return fmt.Sprintf("EmptyClassroomResponse(%+v)", *p)
 }
 
+type RoomScheduleRequest struct {
+	Room string `thrift:"room,1,required" form:"room,required" json:"room,required" query:"room,required"`
+	Date string `thrift:"date,2,required" form:"date,required" json:"date,required" query:"date,required"`
+}
+
+func NewRoomScheduleRequest() *RoomScheduleRequest {
+	return &RoomScheduleRequest{}
+}
+
+func (p *RoomScheduleRequest) InitDefault() {
+}
+
+func (p *RoomScheduleRequest) GetRoom() (v string) {
+	return p.Room
+}
+
+func (p *RoomScheduleRequest) GetDate() (v string) {
+	return p.Date
+}
+
+func (p *RoomScheduleRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoomScheduleRequest(%+v)", *p)
+}
+
+type RoomScheduleResponse struct {
+	Schedule   *model.RoomSchedule        `thrift:"schedule,1,optional" form:"schedule" json:"schedule,omitempty" query:"schedule"`
+	SyncStatus *model.EmptyRoomSyncStatus `thrift:"syncStatus,2,optional" form:"syncStatus" json:"syncStatus,omitempty" query:"syncStatus"`
+}
+
+func NewRoomScheduleResponse() *RoomScheduleResponse {
+	return &RoomScheduleResponse{}
+}
+
+func (p *RoomScheduleResponse) InitDefault() {
+}
+
+var RoomScheduleResponse_Schedule_DEFAULT *model.RoomSchedule
+
+func (p *RoomScheduleResponse) GetSchedule() (v *model.RoomSchedule) {
+	if !p.IsSetSchedule() {
+		return RoomScheduleResponse_Schedule_DEFAULT
+	}
+	return p.Schedule
+}
+
+var RoomScheduleResponse_SyncStatus_DEFAULT *model.EmptyRoomSyncStatus
+
+func (p *RoomScheduleResponse) GetSyncStatus() (v *model.EmptyRoomSyncStatus) {
+	if !p.IsSetSyncStatus() {
+		return RoomScheduleResponse_SyncStatus_DEFAULT
+	}
+	return p.SyncStatus
+}
+
+func (p *RoomScheduleResponse) IsSetSchedule() bool {
+	return p.Schedule != nil
+}
+
+func (p *RoomScheduleResponse) IsSetSyncStatus() bool {
+	return p.SyncStatus != nil
+}
+
+func (p *RoomScheduleResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoomScheduleResponse(%+v)", *p)
+}
+
 type ExamRoomInfoRequest struct {
 	Term string `thrift:"term,1,required" form:"term,required" json:"term,required" query:"term,required"`
 }
@@ -122,6 +253,8 @@ func (p *ExamRoomInfoRequest) String() string {
 
 type ExamRoomInfoResponse struct {
 	ExamRoomInfos []*model.ExamRoomInfo `thrift:"examRoomInfos,1,optional,list<model.ExamRoomInfo>" form:"examRoomInfos" json:"examRoomInfos,omitempty" query:"examRoomInfos"`
+	Stale         *bool                 `thrift:"stale,2,optional" form:"stale" json:"stale,omitempty" query:"stale"`
+	SnapshotTime  *int64                `thrift:"snapshot_time,3,optional" form:"snapshot_time" json:"snapshot_time,omitempty" query:"snapshot_time"`
 }
 
 func NewExamRoomInfoResponse() *ExamRoomInfoResponse {
@@ -140,10 +273,36 @@ func (p *ExamRoomInfoResponse) GetExamRoomInfos() (v []*model.ExamRoomInfo) {
 	return p.ExamRoomInfos
 }
 
+var ExamRoomInfoResponse_Stale_DEFAULT bool
+
+func (p *ExamRoomInfoResponse) GetStale() (v bool) {
+	if !p.IsSetStale() {
+		return ExamRoomInfoResponse_Stale_DEFAULT
+	}
+	return *p.Stale
+}
+
+var ExamRoomInfoResponse_SnapshotTime_DEFAULT int64
+
+func (p *ExamRoomInfoResponse) GetSnapshotTime() (v int64) {
+	if !p.IsSetSnapshotTime() {
+		return ExamRoomInfoResponse_SnapshotTime_DEFAULT
+	}
+	return *p.SnapshotTime
+}
+
 func (p *ExamRoomInfoResponse) IsSetExamRoomInfos() bool {
 	return p.ExamRoomInfos != nil
 }
 
+func (p *ExamRoomInfoResponse) IsSetStale() bool {
+	return p.Stale != nil
+}
+
+func (p *ExamRoomInfoResponse) IsSetSnapshotTime() bool {
+	return p.SnapshotTime != nil
+}
+
 func (p *ExamRoomInfoResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -151,6 +310,59 @@ func (p *ExamRoomInfoResponse) String() string {
 	return fmt.Sprintf("ExamRoomInfoResponse(%+v)", *p)
 }
 
+type ExamRoomChangesRequest struct {
+	Term string `thrift:"term,1,required" form:"term,required" json:"term,required" query:"term,required"`
+}
+
+func NewExamRoomChangesRequest() *ExamRoomChangesRequest {
+	return &ExamRoomChangesRequest{}
+}
+
+func (p *ExamRoomChangesRequest) InitDefault() {
+}
+
+func (p *ExamRoomChangesRequest) GetTerm() (v string) {
+	return p.Term
+}
+
+func (p *ExamRoomChangesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExamRoomChangesRequest(%+v)", *p)
+}
+
+type ExamRoomChangesResponse struct {
+	Changes []*model.ExamRoomChange `thrift:"changes,1,optional,list<model.ExamRoomChange>" form:"changes" json:"changes,omitempty" query:"changes"`
+}
+
+func NewExamRoomChangesResponse() *ExamRoomChangesResponse {
+	return &ExamRoomChangesResponse{}
+}
+
+func (p *ExamRoomChangesResponse) InitDefault() {
+}
+
+var ExamRoomChangesResponse_Changes_DEFAULT []*model.ExamRoomChange
+
+func (p *ExamRoomChangesResponse) GetChanges() (v []*model.ExamRoomChange) {
+	if !p.IsSetChanges() {
+		return ExamRoomChangesResponse_Changes_DEFAULT
+	}
+	return p.Changes
+}
+
+func (p *ExamRoomChangesResponse) IsSetChanges() bool {
+	return p.Changes != nil
+}
+
+func (p *ExamRoomChangesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExamRoomChangesResponse(%+v)", *p)
+}
+
 // # ----------------------------------------------------------------------------
 // # user 用户（如登录、鉴权）
 // # ----------------------------------------------------------------------------
@@ -832,3070 +1044,4805 @@ func (p *ReorderFriendListResponse) String() string {
 	return fmt.Sprintf("ReorderFriendListResponse(%+v)", *p)
 }
 
-// # ----------------------------------------------------------------------------
-// # course 课表
-// # ----------------------------------------------------------------------------
-type CourseListRequest struct {
-	Term      string `thrift:"term,1,required" form:"term,required" json:"term,required" query:"term,required"`
-	IsRefresh *bool  `thrift:"is_refresh,2,optional" form:"is_refresh" json:"is_refresh,omitempty" query:"is_refresh"`
+type RegisterDeviceRequest struct {
+	// 友盟 device token
+	DeviceToken string `thrift:"device_token,1,required" form:"device_token,required" json:"device_token,required" query:"device_token,required"`
+	// android / ios / harmony
+	Platform   string  `thrift:"platform,2,required" form:"platform,required" json:"platform,required" query:"platform,required"`
+	AppVersion *string `thrift:"app_version,3,optional" form:"app_version" json:"app_version,omitempty" query:"app_version"`
 }
 
-func NewCourseListRequest() *CourseListRequest {
-	return &CourseListRequest{}
+func NewRegisterDeviceRequest() *RegisterDeviceRequest {
+	return &RegisterDeviceRequest{}
 }
 
-func (p *CourseListRequest) InitDefault() {
+func (p *RegisterDeviceRequest) InitDefault() {
 }
 
-func (p *CourseListRequest) GetTerm() (v string) {
-	return p.Term
+func (p *RegisterDeviceRequest) GetDeviceToken() (v string) {
+	return p.DeviceToken
 }
 
-var CourseListRequest_IsRefresh_DEFAULT bool
+func (p *RegisterDeviceRequest) GetPlatform() (v string) {
+	return p.Platform
+}
 
-func (p *CourseListRequest) GetIsRefresh() (v bool) {
-	if !p.IsSetIsRefresh() {
-		return CourseListRequest_IsRefresh_DEFAULT
+var RegisterDeviceRequest_AppVersion_DEFAULT string
+
+func (p *RegisterDeviceRequest) GetAppVersion() (v string) {
+	if !p.IsSetAppVersion() {
+		return RegisterDeviceRequest_AppVersion_DEFAULT
 	}
-	return *p.IsRefresh
+	return *p.AppVersion
 }
 
-func (p *CourseListRequest) IsSetIsRefresh() bool {
-	return p.IsRefresh != nil
+func (p *RegisterDeviceRequest) IsSetAppVersion() bool {
+	return p.AppVersion != nil
 }
 
-func (p *CourseListRequest) String() string {
+func (p *RegisterDeviceRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseListRequest(%+v)", *p)
+	return fmt.Sprintf("RegisterDeviceRequest(%+v)", *p)
 }
 
-type CourseListResponse struct {
+type RegisterDeviceResponse struct {
 	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
-	Data []*model.Course `thrift:"data,2,required,list<model.Course>" form:"data,required" json:"data,required" query:"data,required"`
 }
 
-func NewCourseListResponse() *CourseListResponse {
-	return &CourseListResponse{}
+func NewRegisterDeviceResponse() *RegisterDeviceResponse {
+	return &RegisterDeviceResponse{}
 }
 
-func (p *CourseListResponse) InitDefault() {
+func (p *RegisterDeviceResponse) InitDefault() {
 }
 
-var CourseListResponse_Base_DEFAULT *model.BaseResp
+var RegisterDeviceResponse_Base_DEFAULT *model.BaseResp
 
-func (p *CourseListResponse) GetBase() (v *model.BaseResp) {
+func (p *RegisterDeviceResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return CourseListResponse_Base_DEFAULT
+		return RegisterDeviceResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *CourseListResponse) GetData() (v []*model.Course) {
-	return p.Data
-}
-
-func (p *CourseListResponse) IsSetBase() bool {
+func (p *RegisterDeviceResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *CourseListResponse) String() string {
+func (p *RegisterDeviceResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseListResponse(%+v)", *p)
+	return fmt.Sprintf("RegisterDeviceResponse(%+v)", *p)
 }
 
-type CourseTermListRequest struct {
+type UnregisterDeviceRequest struct {
+	DeviceToken string `thrift:"device_token,1,required" form:"device_token,required" json:"device_token,required" query:"device_token,required"`
 }
 
-func NewCourseTermListRequest() *CourseTermListRequest {
-	return &CourseTermListRequest{}
+func NewUnregisterDeviceRequest() *UnregisterDeviceRequest {
+	return &UnregisterDeviceRequest{}
 }
 
-func (p *CourseTermListRequest) InitDefault() {
+func (p *UnregisterDeviceRequest) InitDefault() {
 }
 
-func (p *CourseTermListRequest) String() string {
+func (p *UnregisterDeviceRequest) GetDeviceToken() (v string) {
+	return p.DeviceToken
+}
+
+func (p *UnregisterDeviceRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseTermListRequest(%+v)", *p)
+	return fmt.Sprintf("UnregisterDeviceRequest(%+v)", *p)
 }
 
-type CourseTermListResponse struct {
+type UnregisterDeviceResponse struct {
 	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
-	Data []string        `thrift:"data,2,required,list<string>" form:"data,required" json:"data,required" query:"data,required"`
 }
 
-func NewCourseTermListResponse() *CourseTermListResponse {
-	return &CourseTermListResponse{}
+func NewUnregisterDeviceResponse() *UnregisterDeviceResponse {
+	return &UnregisterDeviceResponse{}
 }
 
-func (p *CourseTermListResponse) InitDefault() {
+func (p *UnregisterDeviceResponse) InitDefault() {
 }
 
-var CourseTermListResponse_Base_DEFAULT *model.BaseResp
+var UnregisterDeviceResponse_Base_DEFAULT *model.BaseResp
 
-func (p *CourseTermListResponse) GetBase() (v *model.BaseResp) {
+func (p *UnregisterDeviceResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return CourseTermListResponse_Base_DEFAULT
+		return UnregisterDeviceResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *CourseTermListResponse) GetData() (v []string) {
-	return p.Data
-}
-
-func (p *CourseTermListResponse) IsSetBase() bool {
+func (p *UnregisterDeviceResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *CourseTermListResponse) String() string {
+func (p *UnregisterDeviceResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseTermListResponse(%+v)", *p)
+	return fmt.Sprintf("UnregisterDeviceResponse(%+v)", *p)
 }
 
-type GetCalendarTokenRequest struct {
+type GetNotificationPreferenceRequest struct {
 }
 
-func NewGetCalendarTokenRequest() *GetCalendarTokenRequest {
-	return &GetCalendarTokenRequest{}
+func NewGetNotificationPreferenceRequest() *GetNotificationPreferenceRequest {
+	return &GetNotificationPreferenceRequest{}
 }
 
-func (p *GetCalendarTokenRequest) InitDefault() {
+func (p *GetNotificationPreferenceRequest) InitDefault() {
 }
 
-func (p *GetCalendarTokenRequest) String() string {
+func (p *GetNotificationPreferenceRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetCalendarTokenRequest(%+v)", *p)
+	return fmt.Sprintf("GetNotificationPreferenceRequest(%+v)", *p)
 }
 
-type GetCalendarTokenResponse struct {
-	Token string `thrift:"token,1,required" form:"token,required" json:"token,required" query:"token,required"`
+type GetNotificationPreferenceResponse struct {
+	Base *model.BaseResp               `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
+	Data *model.NotificationPreference `thrift:"data,2,required" form:"data,required" json:"data,required" query:"data,required"`
 }
 
-func NewGetCalendarTokenResponse() *GetCalendarTokenResponse {
-	return &GetCalendarTokenResponse{}
+func NewGetNotificationPreferenceResponse() *GetNotificationPreferenceResponse {
+	return &GetNotificationPreferenceResponse{}
 }
 
-func (p *GetCalendarTokenResponse) InitDefault() {
+func (p *GetNotificationPreferenceResponse) InitDefault() {
 }
 
-func (p *GetCalendarTokenResponse) GetToken() (v string) {
-	return p.Token
-}
+var GetNotificationPreferenceResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetCalendarTokenResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetNotificationPreferenceResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetNotificationPreferenceResponse_Base_DEFAULT
 	}
-	return fmt.Sprintf("GetCalendarTokenResponse(%+v)", *p)
+	return p.Base
 }
 
-type SubscribeCalendarRequest struct {
-	Token string `thrift:"token,1,required" form:"token,required" json:"token,required" query:"token,required"`
-}
+var GetNotificationPreferenceResponse_Data_DEFAULT *model.NotificationPreference
 
-func NewSubscribeCalendarRequest() *SubscribeCalendarRequest {
-	return &SubscribeCalendarRequest{}
+func (p *GetNotificationPreferenceResponse) GetData() (v *model.NotificationPreference) {
+	if !p.IsSetData() {
+		return GetNotificationPreferenceResponse_Data_DEFAULT
+	}
+	return p.Data
 }
 
-func (p *SubscribeCalendarRequest) InitDefault() {
+func (p *GetNotificationPreferenceResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *SubscribeCalendarRequest) GetToken() (v string) {
-	return p.Token
+func (p *GetNotificationPreferenceResponse) IsSetData() bool {
+	return p.Data != nil
 }
 
-func (p *SubscribeCalendarRequest) String() string {
+func (p *GetNotificationPreferenceResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SubscribeCalendarRequest(%+v)", *p)
+	return fmt.Sprintf("GetNotificationPreferenceResponse(%+v)", *p)
 }
 
-type SubscribeCalendarResponse struct {
-	Ics []byte `thrift:"ics,1" form:"ics" json:"ics" query:"ics"`
+type UpdateNotificationPreferenceRequest struct {
+	DisabledTypes []string `thrift:"disabled_types,1,required,list<string>" form:"disabled_types,required" json:"disabled_types,required" query:"disabled_types,required"`
+	Channel       string   `thrift:"channel,2,required" form:"channel,required" json:"channel,required" query:"channel,required"`
+	QuietStart    *string  `thrift:"quiet_start,3,optional" form:"quiet_start" json:"quiet_start,omitempty" query:"quiet_start"`
+	QuietEnd      *string  `thrift:"quiet_end,4,optional" form:"quiet_end" json:"quiet_end,omitempty" query:"quiet_end"`
 }
 
-func NewSubscribeCalendarResponse() *SubscribeCalendarResponse {
-	return &SubscribeCalendarResponse{}
+func NewUpdateNotificationPreferenceRequest() *UpdateNotificationPreferenceRequest {
+	return &UpdateNotificationPreferenceRequest{}
 }
 
-func (p *SubscribeCalendarResponse) InitDefault() {
+func (p *UpdateNotificationPreferenceRequest) InitDefault() {
 }
 
-func (p *SubscribeCalendarResponse) GetIcs() (v []byte) {
-	return p.Ics
+func (p *UpdateNotificationPreferenceRequest) GetDisabledTypes() (v []string) {
+	return p.DisabledTypes
 }
 
-func (p *SubscribeCalendarResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UpdateNotificationPreferenceRequest) GetChannel() (v string) {
+	return p.Channel
+}
+
+var UpdateNotificationPreferenceRequest_QuietStart_DEFAULT string
+
+func (p *UpdateNotificationPreferenceRequest) GetQuietStart() (v string) {
+	if !p.IsSetQuietStart() {
+		return UpdateNotificationPreferenceRequest_QuietStart_DEFAULT
 	}
-	return fmt.Sprintf("SubscribeCalendarResponse(%+v)", *p)
+	return *p.QuietStart
 }
 
-type GetLocateDateRequest struct {
+var UpdateNotificationPreferenceRequest_QuietEnd_DEFAULT string
+
+func (p *UpdateNotificationPreferenceRequest) GetQuietEnd() (v string) {
+	if !p.IsSetQuietEnd() {
+		return UpdateNotificationPreferenceRequest_QuietEnd_DEFAULT
+	}
+	return *p.QuietEnd
 }
 
-func NewGetLocateDateRequest() *GetLocateDateRequest {
-	return &GetLocateDateRequest{}
+func (p *UpdateNotificationPreferenceRequest) IsSetQuietStart() bool {
+	return p.QuietStart != nil
 }
 
-func (p *GetLocateDateRequest) InitDefault() {
+func (p *UpdateNotificationPreferenceRequest) IsSetQuietEnd() bool {
+	return p.QuietEnd != nil
 }
 
-func (p *GetLocateDateRequest) String() string {
+func (p *UpdateNotificationPreferenceRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetLocateDateRequest(%+v)", *p)
+	return fmt.Sprintf("UpdateNotificationPreferenceRequest(%+v)", *p)
 }
 
-type GetLocateDateResponse struct {
-	LocateDate *model.LocateDate `thrift:"locateDate,1,optional" form:"locateDate" json:"locateDate,omitempty" query:"locateDate"`
+type UpdateNotificationPreferenceResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
 }
 
-func NewGetLocateDateResponse() *GetLocateDateResponse {
-	return &GetLocateDateResponse{}
+func NewUpdateNotificationPreferenceResponse() *UpdateNotificationPreferenceResponse {
+	return &UpdateNotificationPreferenceResponse{}
 }
 
-func (p *GetLocateDateResponse) InitDefault() {
+func (p *UpdateNotificationPreferenceResponse) InitDefault() {
 }
 
-var GetLocateDateResponse_LocateDate_DEFAULT *model.LocateDate
+var UpdateNotificationPreferenceResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetLocateDateResponse) GetLocateDate() (v *model.LocateDate) {
-	if !p.IsSetLocateDate() {
-		return GetLocateDateResponse_LocateDate_DEFAULT
+func (p *UpdateNotificationPreferenceResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateNotificationPreferenceResponse_Base_DEFAULT
 	}
-	return p.LocateDate
+	return p.Base
 }
 
-func (p *GetLocateDateResponse) IsSetLocateDate() bool {
-	return p.LocateDate != nil
+func (p *UpdateNotificationPreferenceResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *GetLocateDateResponse) String() string {
+func (p *UpdateNotificationPreferenceResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetLocateDateResponse(%+v)", *p)
+	return fmt.Sprintf("UpdateNotificationPreferenceResponse(%+v)", *p)
 }
 
-type GetFriendCourseRequest struct {
+// # ----------------------------------------------------------------------------
+// # course 课表
+// # ----------------------------------------------------------------------------
+type CourseListRequest struct {
 	Term      string `thrift:"term,1,required" form:"term,required" json:"term,required" query:"term,required"`
-	StudentID string `thrift:"student_id,2,required" form:"student_id,required" json:"student_id,required" query:"student_id,required"`
+	IsRefresh *bool  `thrift:"is_refresh,2,optional" form:"is_refresh" json:"is_refresh,omitempty" query:"is_refresh"`
 }
 
-func NewGetFriendCourseRequest() *GetFriendCourseRequest {
-	return &GetFriendCourseRequest{}
+func NewCourseListRequest() *CourseListRequest {
+	return &CourseListRequest{}
 }
 
-func (p *GetFriendCourseRequest) InitDefault() {
+func (p *CourseListRequest) InitDefault() {
 }
 
-func (p *GetFriendCourseRequest) GetTerm() (v string) {
+func (p *CourseListRequest) GetTerm() (v string) {
 	return p.Term
 }
 
-func (p *GetFriendCourseRequest) GetStudentID() (v string) {
-	return p.StudentID
+var CourseListRequest_IsRefresh_DEFAULT bool
+
+func (p *CourseListRequest) GetIsRefresh() (v bool) {
+	if !p.IsSetIsRefresh() {
+		return CourseListRequest_IsRefresh_DEFAULT
+	}
+	return *p.IsRefresh
 }
 
-func (p *GetFriendCourseRequest) String() string {
+func (p *CourseListRequest) IsSetIsRefresh() bool {
+	return p.IsRefresh != nil
+}
+
+func (p *CourseListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetFriendCourseRequest(%+v)", *p)
+	return fmt.Sprintf("CourseListRequest(%+v)", *p)
 }
 
-type GetFriendCourseResponse struct {
-	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
-	Data []*model.Course `thrift:"data,2,required,list<model.Course>" form:"data,required" json:"data,required" query:"data,required"`
+type CourseListResponse struct {
+	Base         *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
+	Data         []*model.Course `thrift:"data,2,required,list<model.Course>" form:"data,required" json:"data,required" query:"data,required"`
+	Stale        *bool           `thrift:"stale,3,optional" form:"stale" json:"stale,omitempty" query:"stale"`
+	SnapshotTime *int64          `thrift:"snapshot_time,4,optional" form:"snapshot_time" json:"snapshot_time,omitempty" query:"snapshot_time"`
 }
 
-func NewGetFriendCourseResponse() *GetFriendCourseResponse {
-	return &GetFriendCourseResponse{}
+func NewCourseListResponse() *CourseListResponse {
+	return &CourseListResponse{}
 }
 
-func (p *GetFriendCourseResponse) InitDefault() {
+func (p *CourseListResponse) InitDefault() {
 }
 
-var GetFriendCourseResponse_Base_DEFAULT *model.BaseResp
+var CourseListResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetFriendCourseResponse) GetBase() (v *model.BaseResp) {
+func (p *CourseListResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return GetFriendCourseResponse_Base_DEFAULT
+		return CourseListResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *GetFriendCourseResponse) GetData() (v []*model.Course) {
+func (p *CourseListResponse) GetData() (v []*model.Course) {
 	return p.Data
 }
 
-func (p *GetFriendCourseResponse) IsSetBase() bool {
+var CourseListResponse_Stale_DEFAULT bool
+
+func (p *CourseListResponse) GetStale() (v bool) {
+	if !p.IsSetStale() {
+		return CourseListResponse_Stale_DEFAULT
+	}
+	return *p.Stale
+}
+
+var CourseListResponse_SnapshotTime_DEFAULT int64
+
+func (p *CourseListResponse) GetSnapshotTime() (v int64) {
+	if !p.IsSetSnapshotTime() {
+		return CourseListResponse_SnapshotTime_DEFAULT
+	}
+	return *p.SnapshotTime
+}
+
+func (p *CourseListResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetFriendCourseResponse) String() string {
+func (p *CourseListResponse) IsSetStale() bool {
+	return p.Stale != nil
+}
+
+func (p *CourseListResponse) IsSetSnapshotTime() bool {
+	return p.SnapshotTime != nil
+}
+
+func (p *CourseListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetFriendCourseResponse(%+v)", *p)
-}
-
-type GetAutoAdjustCourseListRequest struct {
-	Term string `thrift:"term,1,required" form:"term,required" json:"term,required" query:"term,required"`
+	return fmt.Sprintf("CourseListResponse(%+v)", *p)
 }
 
-func NewGetAutoAdjustCourseListRequest() *GetAutoAdjustCourseListRequest {
-	return &GetAutoAdjustCourseListRequest{}
+type CourseTermListRequest struct {
 }
 
-func (p *GetAutoAdjustCourseListRequest) InitDefault() {
+func NewCourseTermListRequest() *CourseTermListRequest {
+	return &CourseTermListRequest{}
 }
 
-func (p *GetAutoAdjustCourseListRequest) GetTerm() (v string) {
-	return p.Term
+func (p *CourseTermListRequest) InitDefault() {
 }
 
-func (p *GetAutoAdjustCourseListRequest) String() string {
+func (p *CourseTermListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetAutoAdjustCourseListRequest(%+v)", *p)
+	return fmt.Sprintf("CourseTermListRequest(%+v)", *p)
 }
 
-type GetAutoAdjustCourseListResponse struct {
-	Base *model.BaseResp       `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
-	Data []*model.AdjustCourse `thrift:"data,2,required,list<model.AdjustCourse>" form:"data,required" json:"data,required" query:"data,required"`
+type CourseTermListResponse struct {
+	Base         *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
+	Data         []string        `thrift:"data,2,required,list<string>" form:"data,required" json:"data,required" query:"data,required"`
+	Stale        *bool           `thrift:"stale,3,optional" form:"stale" json:"stale,omitempty" query:"stale"`
+	SnapshotTime *int64          `thrift:"snapshot_time,4,optional" form:"snapshot_time" json:"snapshot_time,omitempty" query:"snapshot_time"`
 }
 
-func NewGetAutoAdjustCourseListResponse() *GetAutoAdjustCourseListResponse {
-	return &GetAutoAdjustCourseListResponse{}
+func NewCourseTermListResponse() *CourseTermListResponse {
+	return &CourseTermListResponse{}
 }
 
-func (p *GetAutoAdjustCourseListResponse) InitDefault() {
+func (p *CourseTermListResponse) InitDefault() {
 }
 
-var GetAutoAdjustCourseListResponse_Base_DEFAULT *model.BaseResp
+var CourseTermListResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetAutoAdjustCourseListResponse) GetBase() (v *model.BaseResp) {
+func (p *CourseTermListResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return GetAutoAdjustCourseListResponse_Base_DEFAULT
+		return CourseTermListResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *GetAutoAdjustCourseListResponse) GetData() (v []*model.AdjustCourse) {
+func (p *CourseTermListResponse) GetData() (v []string) {
 	return p.Data
 }
 
-func (p *GetAutoAdjustCourseListResponse) IsSetBase() bool {
-	return p.Base != nil
-}
+var CourseTermListResponse_Stale_DEFAULT bool
 
-func (p *GetAutoAdjustCourseListResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *CourseTermListResponse) GetStale() (v bool) {
+	if !p.IsSetStale() {
+		return CourseTermListResponse_Stale_DEFAULT
 	}
-	return fmt.Sprintf("GetAutoAdjustCourseListResponse(%+v)", *p)
+	return *p.Stale
 }
 
-type UpdateAdjustCourseRequest struct {
-	ID       int64   `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	Secret   string  `thrift:"secret,2,required" form:"secret,required" json:"secret,required" query:"secret,required"`
-	Enabled  *bool   `thrift:"enabled,3,optional" form:"enabled" json:"enabled,omitempty" query:"enabled"`
-	FromDate *string `thrift:"from_date,4,optional" form:"from_date" json:"from_date,omitempty" query:"from_date"`
-	ToDate   *string `thrift:"to_date,5,optional" form:"to_date" json:"to_date,omitempty" query:"to_date"`
-}
+var CourseTermListResponse_SnapshotTime_DEFAULT int64
 
-func NewUpdateAdjustCourseRequest() *UpdateAdjustCourseRequest {
-	return &UpdateAdjustCourseRequest{}
+func (p *CourseTermListResponse) GetSnapshotTime() (v int64) {
+	if !p.IsSetSnapshotTime() {
+		return CourseTermListResponse_SnapshotTime_DEFAULT
+	}
+	return *p.SnapshotTime
 }
 
-func (p *UpdateAdjustCourseRequest) InitDefault() {
+func (p *CourseTermListResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *UpdateAdjustCourseRequest) GetID() (v int64) {
-	return p.ID
+func (p *CourseTermListResponse) IsSetStale() bool {
+	return p.Stale != nil
 }
 
-func (p *UpdateAdjustCourseRequest) GetSecret() (v string) {
-	return p.Secret
+func (p *CourseTermListResponse) IsSetSnapshotTime() bool {
+	return p.SnapshotTime != nil
 }
 
-var UpdateAdjustCourseRequest_Enabled_DEFAULT bool
-
-func (p *UpdateAdjustCourseRequest) GetEnabled() (v bool) {
-	if !p.IsSetEnabled() {
-		return UpdateAdjustCourseRequest_Enabled_DEFAULT
+func (p *CourseTermListResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Enabled
+	return fmt.Sprintf("CourseTermListResponse(%+v)", *p)
 }
 
-var UpdateAdjustCourseRequest_FromDate_DEFAULT string
+type GetCalendarTokenRequest struct {
+}
 
-func (p *UpdateAdjustCourseRequest) GetFromDate() (v string) {
-	if !p.IsSetFromDate() {
-		return UpdateAdjustCourseRequest_FromDate_DEFAULT
-	}
-	return *p.FromDate
+func NewGetCalendarTokenRequest() *GetCalendarTokenRequest {
+	return &GetCalendarTokenRequest{}
 }
 
-var UpdateAdjustCourseRequest_ToDate_DEFAULT string
+func (p *GetCalendarTokenRequest) InitDefault() {
+}
 
-func (p *UpdateAdjustCourseRequest) GetToDate() (v string) {
-	if !p.IsSetToDate() {
-		return UpdateAdjustCourseRequest_ToDate_DEFAULT
+func (p *GetCalendarTokenRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.ToDate
+	return fmt.Sprintf("GetCalendarTokenRequest(%+v)", *p)
 }
 
-func (p *UpdateAdjustCourseRequest) IsSetEnabled() bool {
-	return p.Enabled != nil
+type GetCalendarTokenResponse struct {
+	Token string `thrift:"token,1,required" form:"token,required" json:"token,required" query:"token,required"`
 }
 
-func (p *UpdateAdjustCourseRequest) IsSetFromDate() bool {
-	return p.FromDate != nil
+func NewGetCalendarTokenResponse() *GetCalendarTokenResponse {
+	return &GetCalendarTokenResponse{}
 }
 
-func (p *UpdateAdjustCourseRequest) IsSetToDate() bool {
-	return p.ToDate != nil
+func (p *GetCalendarTokenResponse) InitDefault() {
 }
 
-func (p *UpdateAdjustCourseRequest) String() string {
+func (p *GetCalendarTokenResponse) GetToken() (v string) {
+	return p.Token
+}
+
+func (p *GetCalendarTokenResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateAdjustCourseRequest(%+v)", *p)
-}
-
-type UpdateAdjustCourseResponse struct {
-	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
+	return fmt.Sprintf("GetCalendarTokenResponse(%+v)", *p)
 }
 
-func NewUpdateAdjustCourseResponse() *UpdateAdjustCourseResponse {
-	return &UpdateAdjustCourseResponse{}
+type SubscribeCalendarRequest struct {
+	Token string `thrift:"token,1,required" form:"token,required" json:"token,required" query:"token,required"`
 }
 
-func (p *UpdateAdjustCourseResponse) InitDefault() {
+func NewSubscribeCalendarRequest() *SubscribeCalendarRequest {
+	return &SubscribeCalendarRequest{}
 }
 
-var UpdateAdjustCourseResponse_Base_DEFAULT *model.BaseResp
-
-func (p *UpdateAdjustCourseResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return UpdateAdjustCourseResponse_Base_DEFAULT
-	}
-	return p.Base
+func (p *SubscribeCalendarRequest) InitDefault() {
 }
 
-func (p *UpdateAdjustCourseResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *SubscribeCalendarRequest) GetToken() (v string) {
+	return p.Token
 }
 
-func (p *UpdateAdjustCourseResponse) String() string {
+func (p *SubscribeCalendarRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateAdjustCourseResponse(%+v)", *p)
+	return fmt.Sprintf("SubscribeCalendarRequest(%+v)", *p)
 }
 
-// # ----------------------------------------------------------------------------
-// # launch_screen 开屏页
-// # ----------------------------------------------------------------------------
-type CreateImageRequest struct {
-	PicType   int64  `thrift:"pic_type,1,required" form:"pic_type,required" json:"pic_type,required" query:"pic_type,required"`
-	Duration  *int64 `thrift:"duration,2,optional" form:"duration" json:"duration,omitempty" query:"duration"`
-	Href      string `thrift:"href,3" form:"href" json:"href" query:"href"`
-	Image     []byte `thrift:"image,4" form:"image" json:"image" query:"image"`
-	StartAt   int64  `thrift:"start_at,5,required" form:"start_at,required" json:"start_at,required" query:"start_at,required"`
-	EndAt     int64  `thrift:"end_at,6,required" form:"end_at,required" json:"end_at,required" query:"end_at,required"`
-	SType     int64  `thrift:"s_type,7,required" form:"s_type,required" json:"s_type,required" query:"s_type,required"`
-	Frequency int64  `thrift:"frequency,8,required" form:"frequency,required" json:"frequency,required" query:"frequency,required"`
-	StartTime int64  `thrift:"start_time,9,required" form:"start_time,required" json:"start_time,required" query:"start_time,required"`
-	EndTime   int64  `thrift:"end_time,10,required" form:"end_time,required" json:"end_time,required" query:"end_time,required"`
-	Text      string `thrift:"text,11,required" form:"text,required" json:"text,required" query:"text,required"`
-	Regex     string `thrift:"regex,12,required" form:"regex,required" json:"regex,required" query:"regex,required"`
-	Secret    string `thrift:"secret,13,required" form:"secret,required" json:"secret,required" query:"secret,required"`
+type SubscribeCalendarResponse struct {
+	Ics []byte `thrift:"ics,1" form:"ics" json:"ics" query:"ics"`
 }
 
-func NewCreateImageRequest() *CreateImageRequest {
-	return &CreateImageRequest{}
+func NewSubscribeCalendarResponse() *SubscribeCalendarResponse {
+	return &SubscribeCalendarResponse{}
 }
 
-func (p *CreateImageRequest) InitDefault() {
+func (p *SubscribeCalendarResponse) InitDefault() {
 }
 
-func (p *CreateImageRequest) GetPicType() (v int64) {
-	return p.PicType
+func (p *SubscribeCalendarResponse) GetIcs() (v []byte) {
+	return p.Ics
 }
 
-var CreateImageRequest_Duration_DEFAULT int64
-
-func (p *CreateImageRequest) GetDuration() (v int64) {
-	if !p.IsSetDuration() {
-		return CreateImageRequest_Duration_DEFAULT
+func (p *SubscribeCalendarResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Duration
+	return fmt.Sprintf("SubscribeCalendarResponse(%+v)", *p)
 }
 
-func (p *CreateImageRequest) GetHref() (v string) {
-	return p.Href
+type GetLocateDateRequest struct {
 }
 
-func (p *CreateImageRequest) GetImage() (v []byte) {
-	return p.Image
+func NewGetLocateDateRequest() *GetLocateDateRequest {
+	return &GetLocateDateRequest{}
 }
 
-func (p *CreateImageRequest) GetStartAt() (v int64) {
-	return p.StartAt
+func (p *GetLocateDateRequest) InitDefault() {
 }
 
-func (p *CreateImageRequest) GetEndAt() (v int64) {
-	return p.EndAt
+func (p *GetLocateDateRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetLocateDateRequest(%+v)", *p)
 }
 
-func (p *CreateImageRequest) GetSType() (v int64) {
-	return p.SType
+type GetLocateDateResponse struct {
+	LocateDate *model.LocateDate `thrift:"locateDate,1,optional" form:"locateDate" json:"locateDate,omitempty" query:"locateDate"`
 }
 
-func (p *CreateImageRequest) GetFrequency() (v int64) {
-	return p.Frequency
+func NewGetLocateDateResponse() *GetLocateDateResponse {
+	return &GetLocateDateResponse{}
 }
 
-func (p *CreateImageRequest) GetStartTime() (v int64) {
-	return p.StartTime
+func (p *GetLocateDateResponse) InitDefault() {
 }
 
-func (p *CreateImageRequest) GetEndTime() (v int64) {
-	return p.EndTime
+var GetLocateDateResponse_LocateDate_DEFAULT *model.LocateDate
+
+func (p *GetLocateDateResponse) GetLocateDate() (v *model.LocateDate) {
+	if !p.IsSetLocateDate() {
+		return GetLocateDateResponse_LocateDate_DEFAULT
+	}
+	return p.LocateDate
 }
 
-func (p *CreateImageRequest) GetText() (v string) {
-	return p.Text
+func (p *GetLocateDateResponse) IsSetLocateDate() bool {
+	return p.LocateDate != nil
 }
 
-func (p *CreateImageRequest) GetRegex() (v string) {
-	return p.Regex
+func (p *GetLocateDateResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetLocateDateResponse(%+v)", *p)
 }
 
-func (p *CreateImageRequest) GetSecret() (v string) {
-	return p.Secret
+type GetFriendCourseRequest struct {
+	Term      string `thrift:"term,1,required" form:"term,required" json:"term,required" query:"term,required"`
+	StudentID string `thrift:"student_id,2,required" form:"student_id,required" json:"student_id,required" query:"student_id,required"`
 }
 
-func (p *CreateImageRequest) IsSetDuration() bool {
-	return p.Duration != nil
+func NewGetFriendCourseRequest() *GetFriendCourseRequest {
+	return &GetFriendCourseRequest{}
 }
 
-func (p *CreateImageRequest) String() string {
+func (p *GetFriendCourseRequest) InitDefault() {
+}
+
+func (p *GetFriendCourseRequest) GetTerm() (v string) {
+	return p.Term
+}
+
+func (p *GetFriendCourseRequest) GetStudentID() (v string) {
+	return p.StudentID
+}
+
+func (p *GetFriendCourseRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateImageRequest(%+v)", *p)
+	return fmt.Sprintf("GetFriendCourseRequest(%+v)", *p)
 }
 
-type CreateImageResponse struct {
-	Base    *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
-	Picture *model.Picture  `thrift:"picture,2,optional" form:"picture" json:"picture,omitempty" query:"picture"`
+type GetFriendCourseResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
+	Data []*model.Course `thrift:"data,2,required,list<model.Course>" form:"data,required" json:"data,required" query:"data,required"`
 }
 
-func NewCreateImageResponse() *CreateImageResponse {
-	return &CreateImageResponse{}
+func NewGetFriendCourseResponse() *GetFriendCourseResponse {
+	return &GetFriendCourseResponse{}
 }
 
-func (p *CreateImageResponse) InitDefault() {
+func (p *GetFriendCourseResponse) InitDefault() {
 }
 
-var CreateImageResponse_Base_DEFAULT *model.BaseResp
+var GetFriendCourseResponse_Base_DEFAULT *model.BaseResp
 
-func (p *CreateImageResponse) GetBase() (v *model.BaseResp) {
+func (p *GetFriendCourseResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return CreateImageResponse_Base_DEFAULT
+		return GetFriendCourseResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var CreateImageResponse_Picture_DEFAULT *model.Picture
-
-func (p *CreateImageResponse) GetPicture() (v *model.Picture) {
-	if !p.IsSetPicture() {
-		return CreateImageResponse_Picture_DEFAULT
-	}
-	return p.Picture
+func (p *GetFriendCourseResponse) GetData() (v []*model.Course) {
+	return p.Data
 }
 
-func (p *CreateImageResponse) IsSetBase() bool {
+func (p *GetFriendCourseResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *CreateImageResponse) IsSetPicture() bool {
-	return p.Picture != nil
-}
-
-func (p *CreateImageResponse) String() string {
+func (p *GetFriendCourseResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateImageResponse(%+v)", *p)
+	return fmt.Sprintf("GetFriendCourseResponse(%+v)", *p)
 }
 
-type GetImageRequest struct {
-	PictureID int64 `thrift:"picture_id,1,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
+type GetAutoAdjustCourseListRequest struct {
+	Term string `thrift:"term,1,required" form:"term,required" json:"term,required" query:"term,required"`
 }
 
-func NewGetImageRequest() *GetImageRequest {
-	return &GetImageRequest{}
+func NewGetAutoAdjustCourseListRequest() *GetAutoAdjustCourseListRequest {
+	return &GetAutoAdjustCourseListRequest{}
 }
 
-func (p *GetImageRequest) InitDefault() {
+func (p *GetAutoAdjustCourseListRequest) InitDefault() {
 }
 
-func (p *GetImageRequest) GetPictureID() (v int64) {
-	return p.PictureID
+func (p *GetAutoAdjustCourseListRequest) GetTerm() (v string) {
+	return p.Term
 }
 
-func (p *GetImageRequest) String() string {
+func (p *GetAutoAdjustCourseListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetImageRequest(%+v)", *p)
+	return fmt.Sprintf("GetAutoAdjustCourseListRequest(%+v)", *p)
 }
 
-type GetImageResponse struct {
-	Base    *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
-	Picture *model.Picture  `thrift:"picture,2,optional" form:"picture" json:"picture,omitempty" query:"picture"`
+type GetAutoAdjustCourseListResponse struct {
+	Base *model.BaseResp       `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
+	Data []*model.AdjustCourse `thrift:"data,2,required,list<model.AdjustCourse>" form:"data,required" json:"data,required" query:"data,required"`
 }
 
-func NewGetImageResponse() *GetImageResponse {
-	return &GetImageResponse{}
+func NewGetAutoAdjustCourseListResponse() *GetAutoAdjustCourseListResponse {
+	return &GetAutoAdjustCourseListResponse{}
 }
 
-func (p *GetImageResponse) InitDefault() {
+func (p *GetAutoAdjustCourseListResponse) InitDefault() {
 }
 
-var GetImageResponse_Base_DEFAULT *model.BaseResp
+var GetAutoAdjustCourseListResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetImageResponse) GetBase() (v *model.BaseResp) {
+func (p *GetAutoAdjustCourseListResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return GetImageResponse_Base_DEFAULT
+		return GetAutoAdjustCourseListResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var GetImageResponse_Picture_DEFAULT *model.Picture
-
-func (p *GetImageResponse) GetPicture() (v *model.Picture) {
-	if !p.IsSetPicture() {
-		return GetImageResponse_Picture_DEFAULT
-	}
-	return p.Picture
+func (p *GetAutoAdjustCourseListResponse) GetData() (v []*model.AdjustCourse) {
+	return p.Data
 }
 
-func (p *GetImageResponse) IsSetBase() bool {
+func (p *GetAutoAdjustCourseListResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetImageResponse) IsSetPicture() bool {
-	return p.Picture != nil
-}
-
-func (p *GetImageResponse) String() string {
+func (p *GetAutoAdjustCourseListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetImageResponse(%+v)", *p)
+	return fmt.Sprintf("GetAutoAdjustCourseListResponse(%+v)", *p)
 }
 
-type ChangeImagePropertyRequest struct {
-	// 1 为空，2 为页面跳转，3 为 APP 跳转
-	PicType  int64  `thrift:"pic_type,1,required" form:"pic_type,required" json:"pic_type,required" query:"pic_type,required"`
-	Duration *int64 `thrift:"duration,2,optional" form:"duration" json:"duration,omitempty" query:"duration"`
-	// 链接
-	Href      *string `thrift:"href,3,optional" form:"href" json:"href,omitempty" query:"href"`
-	StartAt   int64   `thrift:"start_at,4,required" form:"start_at,required" json:"start_at,required" query:"start_at,required"`
-	EndAt     int64   `thrift:"end_at,5,required" form:"end_at,required" json:"end_at,required" query:"end_at,required"`
-	SType     int64   `thrift:"s_type,6,required" form:"s_type,required" json:"s_type,required" query:"s_type,required"`
-	Frequency int64   `thrift:"frequency,7,required" form:"frequency,required" json:"frequency,required" query:"frequency,required"`
-	// 例：6 表示 6点
-	StartTime int64 `thrift:"start_time,8,required" form:"start_time,required" json:"start_time,required" query:"start_time,required"`
-	EndTime   int64 `thrift:"end_time,9,required" form:"end_time,required" json:"end_time,required" query:"end_time,required"`
-	// 描述图片
-	Text      string `thrift:"text,10,required" form:"text,required" json:"text,required" query:"text,required"`
-	PictureID int64  `thrift:"picture_id,11,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
-	Regex     string `thrift:"regex,12,required" form:"regex,required" json:"regex,required" query:"regex,required"`
-	Secret    string `thrift:"secret,13,required" form:"secret,required" json:"secret,required" query:"secret,required"`
+type UpdateAdjustCourseRequest struct {
+	ID       int64   `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	Secret   string  `thrift:"secret,2,required" form:"secret,required" json:"secret,required" query:"secret,required"`
+	Enabled  *bool   `thrift:"enabled,3,optional" form:"enabled" json:"enabled,omitempty" query:"enabled"`
+	FromDate *string `thrift:"from_date,4,optional" form:"from_date" json:"from_date,omitempty" query:"from_date"`
+	ToDate   *string `thrift:"to_date,5,optional" form:"to_date" json:"to_date,omitempty" query:"to_date"`
 }
 
-func NewChangeImagePropertyRequest() *ChangeImagePropertyRequest {
-	return &ChangeImagePropertyRequest{}
+func NewUpdateAdjustCourseRequest() *UpdateAdjustCourseRequest {
+	return &UpdateAdjustCourseRequest{}
 }
 
-func (p *ChangeImagePropertyRequest) InitDefault() {
+func (p *UpdateAdjustCourseRequest) InitDefault() {
 }
 
-func (p *ChangeImagePropertyRequest) GetPicType() (v int64) {
-	return p.PicType
+func (p *UpdateAdjustCourseRequest) GetID() (v int64) {
+	return p.ID
 }
 
-var ChangeImagePropertyRequest_Duration_DEFAULT int64
+func (p *UpdateAdjustCourseRequest) GetSecret() (v string) {
+	return p.Secret
+}
 
-func (p *ChangeImagePropertyRequest) GetDuration() (v int64) {
-	if !p.IsSetDuration() {
-		return ChangeImagePropertyRequest_Duration_DEFAULT
+var UpdateAdjustCourseRequest_Enabled_DEFAULT bool
+
+func (p *UpdateAdjustCourseRequest) GetEnabled() (v bool) {
+	if !p.IsSetEnabled() {
+		return UpdateAdjustCourseRequest_Enabled_DEFAULT
 	}
-	return *p.Duration
+	return *p.Enabled
 }
 
-var ChangeImagePropertyRequest_Href_DEFAULT string
+var UpdateAdjustCourseRequest_FromDate_DEFAULT string
 
-func (p *ChangeImagePropertyRequest) GetHref() (v string) {
-	if !p.IsSetHref() {
-		return ChangeImagePropertyRequest_Href_DEFAULT
+func (p *UpdateAdjustCourseRequest) GetFromDate() (v string) {
+	if !p.IsSetFromDate() {
+		return UpdateAdjustCourseRequest_FromDate_DEFAULT
 	}
-	return *p.Href
+	return *p.FromDate
 }
 
-func (p *ChangeImagePropertyRequest) GetStartAt() (v int64) {
-	return p.StartAt
-}
+var UpdateAdjustCourseRequest_ToDate_DEFAULT string
 
-func (p *ChangeImagePropertyRequest) GetEndAt() (v int64) {
-	return p.EndAt
+func (p *UpdateAdjustCourseRequest) GetToDate() (v string) {
+	if !p.IsSetToDate() {
+		return UpdateAdjustCourseRequest_ToDate_DEFAULT
+	}
+	return *p.ToDate
 }
 
-func (p *ChangeImagePropertyRequest) GetSType() (v int64) {
-	return p.SType
+func (p *UpdateAdjustCourseRequest) IsSetEnabled() bool {
+	return p.Enabled != nil
 }
 
-func (p *ChangeImagePropertyRequest) GetFrequency() (v int64) {
-	return p.Frequency
+func (p *UpdateAdjustCourseRequest) IsSetFromDate() bool {
+	return p.FromDate != nil
 }
 
-func (p *ChangeImagePropertyRequest) GetStartTime() (v int64) {
-	return p.StartTime
+func (p *UpdateAdjustCourseRequest) IsSetToDate() bool {
+	return p.ToDate != nil
 }
 
-func (p *ChangeImagePropertyRequest) GetEndTime() (v int64) {
-	return p.EndTime
+func (p *UpdateAdjustCourseRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateAdjustCourseRequest(%+v)", *p)
 }
 
-func (p *ChangeImagePropertyRequest) GetText() (v string) {
-	return p.Text
+type UpdateAdjustCourseResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
 }
 
-func (p *ChangeImagePropertyRequest) GetPictureID() (v int64) {
-	return p.PictureID
+func NewUpdateAdjustCourseResponse() *UpdateAdjustCourseResponse {
+	return &UpdateAdjustCourseResponse{}
 }
 
-func (p *ChangeImagePropertyRequest) GetRegex() (v string) {
-	return p.Regex
+func (p *UpdateAdjustCourseResponse) InitDefault() {
 }
 
-func (p *ChangeImagePropertyRequest) GetSecret() (v string) {
-	return p.Secret
-}
+var UpdateAdjustCourseResponse_Base_DEFAULT *model.BaseResp
 
-func (p *ChangeImagePropertyRequest) IsSetDuration() bool {
-	return p.Duration != nil
+func (p *UpdateAdjustCourseResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateAdjustCourseResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *ChangeImagePropertyRequest) IsSetHref() bool {
-	return p.Href != nil
+func (p *UpdateAdjustCourseResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *ChangeImagePropertyRequest) String() string {
+func (p *UpdateAdjustCourseResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ChangeImagePropertyRequest(%+v)", *p)
+	return fmt.Sprintf("UpdateAdjustCourseResponse(%+v)", *p)
 }
 
-type ChangeImagePropertyResponse struct {
-	Base    *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
-	Picture *model.Picture  `thrift:"picture,2,optional" form:"picture" json:"picture,omitempty" query:"picture"`
+// # ----------------------------------------------------------------------------
+// # launch_screen 开屏页
+// # ----------------------------------------------------------------------------
+type CreateImageRequest struct {
+	PicType   int64  `thrift:"pic_type,1,required" form:"pic_type,required" json:"pic_type,required" query:"pic_type,required"`
+	Duration  *int64 `thrift:"duration,2,optional" form:"duration" json:"duration,omitempty" query:"duration"`
+	Href      string `thrift:"href,3" form:"href" json:"href" query:"href"`
+	Image     []byte `thrift:"image,4" form:"image" json:"image" query:"image"`
+	StartAt   int64  `thrift:"start_at,5,required" form:"start_at,required" json:"start_at,required" query:"start_at,required"`
+	EndAt     int64  `thrift:"end_at,6,required" form:"end_at,required" json:"end_at,required" query:"end_at,required"`
+	SType     int64  `thrift:"s_type,7,required" form:"s_type,required" json:"s_type,required" query:"s_type,required"`
+	Frequency int64  `thrift:"frequency,8,required" form:"frequency,required" json:"frequency,required" query:"frequency,required"`
+	StartTime int64  `thrift:"start_time,9,required" form:"start_time,required" json:"start_time,required" query:"start_time,required"`
+	EndTime   int64  `thrift:"end_time,10,required" form:"end_time,required" json:"end_time,required" query:"end_time,required"`
+	Text      string `thrift:"text,11,required" form:"text,required" json:"text,required" query:"text,required"`
+	Regex     string `thrift:"regex,12,required" form:"regex,required" json:"regex,required" query:"regex,required"`
+	Secret    string `thrift:"secret,13,required" form:"secret,required" json:"secret,required" query:"secret,required"`
 }
 
-func NewChangeImagePropertyResponse() *ChangeImagePropertyResponse {
-	return &ChangeImagePropertyResponse{}
+func NewCreateImageRequest() *CreateImageRequest {
+	return &CreateImageRequest{}
 }
 
-func (p *ChangeImagePropertyResponse) InitDefault() {
+func (p *CreateImageRequest) InitDefault() {
 }
 
-var ChangeImagePropertyResponse_Base_DEFAULT *model.BaseResp
+func (p *CreateImageRequest) GetPicType() (v int64) {
+	return p.PicType
+}
 
-func (p *ChangeImagePropertyResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return ChangeImagePropertyResponse_Base_DEFAULT
+var CreateImageRequest_Duration_DEFAULT int64
+
+func (p *CreateImageRequest) GetDuration() (v int64) {
+	if !p.IsSetDuration() {
+		return CreateImageRequest_Duration_DEFAULT
 	}
-	return p.Base
+	return *p.Duration
 }
 
-var ChangeImagePropertyResponse_Picture_DEFAULT *model.Picture
+func (p *CreateImageRequest) GetHref() (v string) {
+	return p.Href
+}
 
-func (p *ChangeImagePropertyResponse) GetPicture() (v *model.Picture) {
-	if !p.IsSetPicture() {
-		return ChangeImagePropertyResponse_Picture_DEFAULT
-	}
-	return p.Picture
+func (p *CreateImageRequest) GetImage() (v []byte) {
+	return p.Image
 }
 
-func (p *ChangeImagePropertyResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *CreateImageRequest) GetStartAt() (v int64) {
+	return p.StartAt
 }
 
-func (p *ChangeImagePropertyResponse) IsSetPicture() bool {
-	return p.Picture != nil
+func (p *CreateImageRequest) GetEndAt() (v int64) {
+	return p.EndAt
 }
 
-func (p *ChangeImagePropertyResponse) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ChangeImagePropertyResponse(%+v)", *p)
+func (p *CreateImageRequest) GetSType() (v int64) {
+	return p.SType
 }
 
-type ChangeImageRequest struct {
-	PictureID int64  `thrift:"picture_id,1,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
-	Secret    string `thrift:"secret,2,required" form:"secret,required" json:"secret,required" query:"secret,required"`
-	Image     []byte `thrift:"image,3" form:"image" json:"image" query:"image"`
+func (p *CreateImageRequest) GetFrequency() (v int64) {
+	return p.Frequency
 }
 
-func NewChangeImageRequest() *ChangeImageRequest {
-	return &ChangeImageRequest{}
+func (p *CreateImageRequest) GetStartTime() (v int64) {
+	return p.StartTime
 }
 
-func (p *ChangeImageRequest) InitDefault() {
+func (p *CreateImageRequest) GetEndTime() (v int64) {
+	return p.EndTime
 }
 
-func (p *ChangeImageRequest) GetPictureID() (v int64) {
-	return p.PictureID
+func (p *CreateImageRequest) GetText() (v string) {
+	return p.Text
 }
 
-func (p *ChangeImageRequest) GetSecret() (v string) {
+func (p *CreateImageRequest) GetRegex() (v string) {
+	return p.Regex
+}
+
+func (p *CreateImageRequest) GetSecret() (v string) {
 	return p.Secret
 }
 
-func (p *ChangeImageRequest) GetImage() (v []byte) {
-	return p.Image
+func (p *CreateImageRequest) IsSetDuration() bool {
+	return p.Duration != nil
 }
 
-func (p *ChangeImageRequest) String() string {
+func (p *CreateImageRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ChangeImageRequest(%+v)", *p)
+	return fmt.Sprintf("CreateImageRequest(%+v)", *p)
 }
 
-type ChangeImageResponse struct {
+type CreateImageResponse struct {
 	Base    *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
 	Picture *model.Picture  `thrift:"picture,2,optional" form:"picture" json:"picture,omitempty" query:"picture"`
 }
 
-func NewChangeImageResponse() *ChangeImageResponse {
-	return &ChangeImageResponse{}
+func NewCreateImageResponse() *CreateImageResponse {
+	return &CreateImageResponse{}
 }
 
-func (p *ChangeImageResponse) InitDefault() {
+func (p *CreateImageResponse) InitDefault() {
 }
 
-var ChangeImageResponse_Base_DEFAULT *model.BaseResp
+var CreateImageResponse_Base_DEFAULT *model.BaseResp
 
-func (p *ChangeImageResponse) GetBase() (v *model.BaseResp) {
+func (p *CreateImageResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return ChangeImageResponse_Base_DEFAULT
+		return CreateImageResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var ChangeImageResponse_Picture_DEFAULT *model.Picture
+var CreateImageResponse_Picture_DEFAULT *model.Picture
 
-func (p *ChangeImageResponse) GetPicture() (v *model.Picture) {
+func (p *CreateImageResponse) GetPicture() (v *model.Picture) {
 	if !p.IsSetPicture() {
-		return ChangeImageResponse_Picture_DEFAULT
+		return CreateImageResponse_Picture_DEFAULT
 	}
 	return p.Picture
 }
 
-func (p *ChangeImageResponse) IsSetBase() bool {
+func (p *CreateImageResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *ChangeImageResponse) IsSetPicture() bool {
+func (p *CreateImageResponse) IsSetPicture() bool {
 	return p.Picture != nil
 }
 
-func (p *ChangeImageResponse) String() string {
+func (p *CreateImageResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ChangeImageResponse(%+v)", *p)
+	return fmt.Sprintf("CreateImageResponse(%+v)", *p)
 }
 
-type DeleteImageRequest struct {
-	PictureID int64  `thrift:"picture_id,1,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
-	Secret    string `thrift:"secret,2,required" form:"secret,required" json:"secret,required" query:"secret,required"`
+type GetImageRequest struct {
+	PictureID int64 `thrift:"picture_id,1,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
 }
 
-func NewDeleteImageRequest() *DeleteImageRequest {
-	return &DeleteImageRequest{}
+func NewGetImageRequest() *GetImageRequest {
+	return &GetImageRequest{}
 }
 
-func (p *DeleteImageRequest) InitDefault() {
+func (p *GetImageRequest) InitDefault() {
 }
 
-func (p *DeleteImageRequest) GetPictureID() (v int64) {
+func (p *GetImageRequest) GetPictureID() (v int64) {
 	return p.PictureID
 }
 
-func (p *DeleteImageRequest) GetSecret() (v string) {
-	return p.Secret
-}
-
-func (p *DeleteImageRequest) String() string {
+func (p *GetImageRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteImageRequest(%+v)", *p)
+	return fmt.Sprintf("GetImageRequest(%+v)", *p)
 }
 
-type DeleteImageResponse struct {
-	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+type GetImageResponse struct {
+	Base    *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+	Picture *model.Picture  `thrift:"picture,2,optional" form:"picture" json:"picture,omitempty" query:"picture"`
 }
 
-func NewDeleteImageResponse() *DeleteImageResponse {
-	return &DeleteImageResponse{}
+func NewGetImageResponse() *GetImageResponse {
+	return &GetImageResponse{}
 }
 
-func (p *DeleteImageResponse) InitDefault() {
+func (p *GetImageResponse) InitDefault() {
 }
 
-var DeleteImageResponse_Base_DEFAULT *model.BaseResp
+var GetImageResponse_Base_DEFAULT *model.BaseResp
 
-func (p *DeleteImageResponse) GetBase() (v *model.BaseResp) {
+func (p *GetImageResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return DeleteImageResponse_Base_DEFAULT
+		return GetImageResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *DeleteImageResponse) IsSetBase() bool {
+var GetImageResponse_Picture_DEFAULT *model.Picture
+
+func (p *GetImageResponse) GetPicture() (v *model.Picture) {
+	if !p.IsSetPicture() {
+		return GetImageResponse_Picture_DEFAULT
+	}
+	return p.Picture
+}
+
+func (p *GetImageResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *DeleteImageResponse) String() string {
+func (p *GetImageResponse) IsSetPicture() bool {
+	return p.Picture != nil
+}
+
+func (p *GetImageResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteImageResponse(%+v)", *p)
+	return fmt.Sprintf("GetImageResponse(%+v)", *p)
 }
 
-type MobileGetImageRequest struct {
-	Type      int64   `thrift:"type,1,required" form:"type,required" json:"type,required" query:"type,required"`
-	StudentID string  `thrift:"student_id,2,required" form:"student_id,required" json:"student_id,required" query:"student_id,required"`
-	College   *string `thrift:"college,3,optional" form:"college" json:"college,omitempty" query:"college"`
-	Device    string  `thrift:"device,4,required" form:"device,required" json:"device,required" query:"device,required"`
+type ChangeImagePropertyRequest struct {
+	// 1 为空，2 为页面跳转，3 为 APP 跳转
+	PicType  int64  `thrift:"pic_type,1,required" form:"pic_type,required" json:"pic_type,required" query:"pic_type,required"`
+	Duration *int64 `thrift:"duration,2,optional" form:"duration" json:"duration,omitempty" query:"duration"`
+	// 链接
+	Href      *string `thrift:"href,3,optional" form:"href" json:"href,omitempty" query:"href"`
+	StartAt   int64   `thrift:"start_at,4,required" form:"start_at,required" json:"start_at,required" query:"start_at,required"`
+	EndAt     int64   `thrift:"end_at,5,required" form:"end_at,required" json:"end_at,required" query:"end_at,required"`
+	SType     int64   `thrift:"s_type,6,required" form:"s_type,required" json:"s_type,required" query:"s_type,required"`
+	Frequency int64   `thrift:"frequency,7,required" form:"frequency,required" json:"frequency,required" query:"frequency,required"`
+	// 例：6 表示 6点
+	StartTime int64 `thrift:"start_time,8,required" form:"start_time,required" json:"start_time,required" query:"start_time,required"`
+	EndTime   int64 `thrift:"end_time,9,required" form:"end_time,required" json:"end_time,required" query:"end_time,required"`
+	// 描述图片
+	Text      string `thrift:"text,10,required" form:"text,required" json:"text,required" query:"text,required"`
+	PictureID int64  `thrift:"picture_id,11,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
+	Regex     string `thrift:"regex,12,required" form:"regex,required" json:"regex,required" query:"regex,required"`
+	Secret    string `thrift:"secret,13,required" form:"secret,required" json:"secret,required" query:"secret,required"`
 }
 
-func NewMobileGetImageRequest() *MobileGetImageRequest {
-	return &MobileGetImageRequest{}
+func NewChangeImagePropertyRequest() *ChangeImagePropertyRequest {
+	return &ChangeImagePropertyRequest{}
 }
 
-func (p *MobileGetImageRequest) InitDefault() {
+func (p *ChangeImagePropertyRequest) InitDefault() {
 }
 
-func (p *MobileGetImageRequest) GetType() (v int64) {
-	return p.Type
+func (p *ChangeImagePropertyRequest) GetPicType() (v int64) {
+	return p.PicType
 }
 
-func (p *MobileGetImageRequest) GetStudentID() (v string) {
-	return p.StudentID
+var ChangeImagePropertyRequest_Duration_DEFAULT int64
+
+func (p *ChangeImagePropertyRequest) GetDuration() (v int64) {
+	if !p.IsSetDuration() {
+		return ChangeImagePropertyRequest_Duration_DEFAULT
+	}
+	return *p.Duration
 }
 
-var MobileGetImageRequest_College_DEFAULT string
+var ChangeImagePropertyRequest_Href_DEFAULT string
 
-func (p *MobileGetImageRequest) GetCollege() (v string) {
-	if !p.IsSetCollege() {
-		return MobileGetImageRequest_College_DEFAULT
+func (p *ChangeImagePropertyRequest) GetHref() (v string) {
+	if !p.IsSetHref() {
+		return ChangeImagePropertyRequest_Href_DEFAULT
 	}
-	return *p.College
+	return *p.Href
 }
 
-func (p *MobileGetImageRequest) GetDevice() (v string) {
-	return p.Device
+func (p *ChangeImagePropertyRequest) GetStartAt() (v int64) {
+	return p.StartAt
 }
 
-func (p *MobileGetImageRequest) IsSetCollege() bool {
-	return p.College != nil
+func (p *ChangeImagePropertyRequest) GetEndAt() (v int64) {
+	return p.EndAt
 }
 
-func (p *MobileGetImageRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("MobileGetImageRequest(%+v)", *p)
+func (p *ChangeImagePropertyRequest) GetSType() (v int64) {
+	return p.SType
 }
 
-type MobileGetImageResponse struct {
-	Base        *model.BaseResp  `thrift:"base,1" form:"base" json:"base" query:"base"`
-	Count       *int64           `thrift:"count,2,optional" form:"count" json:"count,omitempty" query:"count"`
-	PictureList []*model.Picture `thrift:"picture_list,3,optional,list<model.Picture>" form:"picture_list" json:"picture_list,omitempty" query:"picture_list"`
+func (p *ChangeImagePropertyRequest) GetFrequency() (v int64) {
+	return p.Frequency
 }
 
-func NewMobileGetImageResponse() *MobileGetImageResponse {
-	return &MobileGetImageResponse{}
-}
-
-func (p *MobileGetImageResponse) InitDefault() {
-}
-
-var MobileGetImageResponse_Base_DEFAULT *model.BaseResp
-
-func (p *MobileGetImageResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return MobileGetImageResponse_Base_DEFAULT
-	}
-	return p.Base
-}
-
-var MobileGetImageResponse_Count_DEFAULT int64
-
-func (p *MobileGetImageResponse) GetCount() (v int64) {
-	if !p.IsSetCount() {
-		return MobileGetImageResponse_Count_DEFAULT
-	}
-	return *p.Count
-}
-
-var MobileGetImageResponse_PictureList_DEFAULT []*model.Picture
-
-func (p *MobileGetImageResponse) GetPictureList() (v []*model.Picture) {
-	if !p.IsSetPictureList() {
-		return MobileGetImageResponse_PictureList_DEFAULT
-	}
-	return p.PictureList
-}
-
-func (p *MobileGetImageResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *ChangeImagePropertyRequest) GetStartTime() (v int64) {
+	return p.StartTime
 }
 
-func (p *MobileGetImageResponse) IsSetCount() bool {
-	return p.Count != nil
+func (p *ChangeImagePropertyRequest) GetEndTime() (v int64) {
+	return p.EndTime
 }
 
-func (p *MobileGetImageResponse) IsSetPictureList() bool {
-	return p.PictureList != nil
+func (p *ChangeImagePropertyRequest) GetText() (v string) {
+	return p.Text
 }
 
-func (p *MobileGetImageResponse) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("MobileGetImageResponse(%+v)", *p)
+func (p *ChangeImagePropertyRequest) GetPictureID() (v int64) {
+	return p.PictureID
 }
 
-type AddImagePointTimeRequest struct {
-	PictureID int64 `thrift:"picture_id,1,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
+func (p *ChangeImagePropertyRequest) GetRegex() (v string) {
+	return p.Regex
 }
 
-func NewAddImagePointTimeRequest() *AddImagePointTimeRequest {
-	return &AddImagePointTimeRequest{}
+func (p *ChangeImagePropertyRequest) GetSecret() (v string) {
+	return p.Secret
 }
 
-func (p *AddImagePointTimeRequest) InitDefault() {
+func (p *ChangeImagePropertyRequest) IsSetDuration() bool {
+	return p.Duration != nil
 }
 
-func (p *AddImagePointTimeRequest) GetPictureID() (v int64) {
-	return p.PictureID
+func (p *ChangeImagePropertyRequest) IsSetHref() bool {
+	return p.Href != nil
 }
 
-func (p *AddImagePointTimeRequest) String() string {
+func (p *ChangeImagePropertyRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AddImagePointTimeRequest(%+v)", *p)
+	return fmt.Sprintf("ChangeImagePropertyRequest(%+v)", *p)
 }
 
-type AddImagePointTimeResponse struct {
+type ChangeImagePropertyResponse struct {
 	Base    *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
 	Picture *model.Picture  `thrift:"picture,2,optional" form:"picture" json:"picture,omitempty" query:"picture"`
 }
 
-func NewAddImagePointTimeResponse() *AddImagePointTimeResponse {
-	return &AddImagePointTimeResponse{}
+func NewChangeImagePropertyResponse() *ChangeImagePropertyResponse {
+	return &ChangeImagePropertyResponse{}
 }
 
-func (p *AddImagePointTimeResponse) InitDefault() {
+func (p *ChangeImagePropertyResponse) InitDefault() {
 }
 
-var AddImagePointTimeResponse_Base_DEFAULT *model.BaseResp
+var ChangeImagePropertyResponse_Base_DEFAULT *model.BaseResp
 
-func (p *AddImagePointTimeResponse) GetBase() (v *model.BaseResp) {
+func (p *ChangeImagePropertyResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return AddImagePointTimeResponse_Base_DEFAULT
+		return ChangeImagePropertyResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var AddImagePointTimeResponse_Picture_DEFAULT *model.Picture
+var ChangeImagePropertyResponse_Picture_DEFAULT *model.Picture
 
-func (p *AddImagePointTimeResponse) GetPicture() (v *model.Picture) {
+func (p *ChangeImagePropertyResponse) GetPicture() (v *model.Picture) {
 	if !p.IsSetPicture() {
-		return AddImagePointTimeResponse_Picture_DEFAULT
+		return ChangeImagePropertyResponse_Picture_DEFAULT
 	}
 	return p.Picture
 }
 
-func (p *AddImagePointTimeResponse) IsSetBase() bool {
+func (p *ChangeImagePropertyResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *AddImagePointTimeResponse) IsSetPicture() bool {
+func (p *ChangeImagePropertyResponse) IsSetPicture() bool {
 	return p.Picture != nil
 }
 
-func (p *AddImagePointTimeResponse) String() string {
+func (p *ChangeImagePropertyResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AddImagePointTimeResponse(%+v)", *p)
+	return fmt.Sprintf("ChangeImagePropertyResponse(%+v)", *p)
 }
 
-type ListImageRequest struct {
-	Secret   string `thrift:"secret,1,required" form:"secret,required" json:"secret,required" query:"secret,required"`
-	PageNum  *int64 `thrift:"page_num,2,optional" form:"page_num" json:"page_num,omitempty" query:"page_num"`
-	PageSize *int64 `thrift:"page_size,3,optional" form:"page_size" json:"page_size,omitempty" query:"page_size"`
+type ChangeImageRequest struct {
+	PictureID int64  `thrift:"picture_id,1,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
+	Secret    string `thrift:"secret,2,required" form:"secret,required" json:"secret,required" query:"secret,required"`
+	Image     []byte `thrift:"image,3" form:"image" json:"image" query:"image"`
 }
 
-func NewListImageRequest() *ListImageRequest {
-	return &ListImageRequest{}
+func NewChangeImageRequest() *ChangeImageRequest {
+	return &ChangeImageRequest{}
 }
 
-func (p *ListImageRequest) InitDefault() {
+func (p *ChangeImageRequest) InitDefault() {
 }
 
-func (p *ListImageRequest) GetSecret() (v string) {
-	return p.Secret
+func (p *ChangeImageRequest) GetPictureID() (v int64) {
+	return p.PictureID
 }
 
-var ListImageRequest_PageNum_DEFAULT int64
-
-func (p *ListImageRequest) GetPageNum() (v int64) {
-	if !p.IsSetPageNum() {
-		return ListImageRequest_PageNum_DEFAULT
-	}
-	return *p.PageNum
+func (p *ChangeImageRequest) GetSecret() (v string) {
+	return p.Secret
 }
 
-var ListImageRequest_PageSize_DEFAULT int64
+func (p *ChangeImageRequest) GetImage() (v []byte) {
+	return p.Image
+}
 
-func (p *ListImageRequest) GetPageSize() (v int64) {
-	if !p.IsSetPageSize() {
-		return ListImageRequest_PageSize_DEFAULT
+func (p *ChangeImageRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.PageSize
+	return fmt.Sprintf("ChangeImageRequest(%+v)", *p)
 }
 
-func (p *ListImageRequest) IsSetPageNum() bool {
-	return p.PageNum != nil
+type ChangeImageResponse struct {
+	Base    *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+	Picture *model.Picture  `thrift:"picture,2,optional" form:"picture" json:"picture,omitempty" query:"picture"`
 }
 
-func (p *ListImageRequest) IsSetPageSize() bool {
-	return p.PageSize != nil
+func NewChangeImageResponse() *ChangeImageResponse {
+	return &ChangeImageResponse{}
 }
 
-func (p *ListImageRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ListImageRequest(%+v)", *p)
+func (p *ChangeImageResponse) InitDefault() {
 }
 
-type ListImageResponse struct {
-	Images []*model.Picture `thrift:"images,1,required,list<model.Picture>" form:"images,required" json:"images,required" query:"images,required"`
-	Total  int64            `thrift:"total,2,required" form:"total,required" json:"total,required" query:"total,required"`
-}
+var ChangeImageResponse_Base_DEFAULT *model.BaseResp
 
-func NewListImageResponse() *ListImageResponse {
-	return &ListImageResponse{}
+func (p *ChangeImageResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ChangeImageResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *ListImageResponse) InitDefault() {
+var ChangeImageResponse_Picture_DEFAULT *model.Picture
+
+func (p *ChangeImageResponse) GetPicture() (v *model.Picture) {
+	if !p.IsSetPicture() {
+		return ChangeImageResponse_Picture_DEFAULT
+	}
+	return p.Picture
 }
 
-func (p *ListImageResponse) GetImages() (v []*model.Picture) {
-	return p.Images
+func (p *ChangeImageResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *ListImageResponse) GetTotal() (v int64) {
-	return p.Total
+func (p *ChangeImageResponse) IsSetPicture() bool {
+	return p.Picture != nil
 }
 
-func (p *ListImageResponse) String() string {
+func (p *ChangeImageResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListImageResponse(%+v)", *p)
+	return fmt.Sprintf("ChangeImageResponse(%+v)", *p)
 }
 
-// # ----------------------------------------------------------------------------
-// # paper 历年卷
-// # ----------------------------------------------------------------------------
-type ListDirFilesRequest struct {
-	Path string `thrift:"path,1,required" form:"path,required" json:"path,required" query:"path,required"`
+type DeleteImageRequest struct {
+	PictureID int64  `thrift:"picture_id,1,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
+	Secret    string `thrift:"secret,2,required" form:"secret,required" json:"secret,required" query:"secret,required"`
 }
 
-func NewListDirFilesRequest() *ListDirFilesRequest {
-	return &ListDirFilesRequest{}
+func NewDeleteImageRequest() *DeleteImageRequest {
+	return &DeleteImageRequest{}
 }
 
-func (p *ListDirFilesRequest) InitDefault() {
+func (p *DeleteImageRequest) InitDefault() {
 }
 
-func (p *ListDirFilesRequest) GetPath() (v string) {
-	return p.Path
+func (p *DeleteImageRequest) GetPictureID() (v int64) {
+	return p.PictureID
 }
 
-func (p *ListDirFilesRequest) String() string {
+func (p *DeleteImageRequest) GetSecret() (v string) {
+	return p.Secret
+}
+
+func (p *DeleteImageRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDirFilesRequest(%+v)", *p)
+	return fmt.Sprintf("DeleteImageRequest(%+v)", *p)
 }
 
-type ListDirFilesResponse struct {
-	Dir *model.UpYunFileDir `thrift:"dir,1,required" form:"dir,required" json:"dir,required" query:"dir,required"`
+type DeleteImageResponse struct {
+	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
 }
 
-func NewListDirFilesResponse() *ListDirFilesResponse {
-	return &ListDirFilesResponse{}
+func NewDeleteImageResponse() *DeleteImageResponse {
+	return &DeleteImageResponse{}
 }
 
-func (p *ListDirFilesResponse) InitDefault() {
+func (p *DeleteImageResponse) InitDefault() {
 }
 
-var ListDirFilesResponse_Dir_DEFAULT *model.UpYunFileDir
+var DeleteImageResponse_Base_DEFAULT *model.BaseResp
 
-func (p *ListDirFilesResponse) GetDir() (v *model.UpYunFileDir) {
-	if !p.IsSetDir() {
-		return ListDirFilesResponse_Dir_DEFAULT
+func (p *DeleteImageResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return DeleteImageResponse_Base_DEFAULT
 	}
-	return p.Dir
+	return p.Base
 }
 
-func (p *ListDirFilesResponse) IsSetDir() bool {
-	return p.Dir != nil
+func (p *DeleteImageResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *ListDirFilesResponse) String() string {
+func (p *DeleteImageResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDirFilesResponse(%+v)", *p)
+	return fmt.Sprintf("DeleteImageResponse(%+v)", *p)
 }
 
-type GetDownloadUrlRequest struct {
-	Filepath string `thrift:"filepath,1,required" form:"filepath,required" json:"filepath,required" query:"filepath,required"`
+type MobileGetImageRequest struct {
+	Type      int64   `thrift:"type,1,required" form:"type,required" json:"type,required" query:"type,required"`
+	StudentID string  `thrift:"student_id,2,required" form:"student_id,required" json:"student_id,required" query:"student_id,required"`
+	College   *string `thrift:"college,3,optional" form:"college" json:"college,omitempty" query:"college"`
+	Device    string  `thrift:"device,4,required" form:"device,required" json:"device,required" query:"device,required"`
 }
 
-func NewGetDownloadUrlRequest() *GetDownloadUrlRequest {
-	return &GetDownloadUrlRequest{}
+func NewMobileGetImageRequest() *MobileGetImageRequest {
+	return &MobileGetImageRequest{}
 }
 
-func (p *GetDownloadUrlRequest) InitDefault() {
+func (p *MobileGetImageRequest) InitDefault() {
 }
 
-func (p *GetDownloadUrlRequest) GetFilepath() (v string) {
-	return p.Filepath
-}
-
-func (p *GetDownloadUrlRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("GetDownloadUrlRequest(%+v)", *p)
+func (p *MobileGetImageRequest) GetType() (v int64) {
+	return p.Type
 }
 
-type GetDownloadUrlResponse struct {
-	URL string `thrift:"url,1,required" form:"url,required" json:"url,required" query:"url,required"`
+func (p *MobileGetImageRequest) GetStudentID() (v string) {
+	return p.StudentID
 }
 
-func NewGetDownloadUrlResponse() *GetDownloadUrlResponse {
-	return &GetDownloadUrlResponse{}
+var MobileGetImageRequest_College_DEFAULT string
+
+func (p *MobileGetImageRequest) GetCollege() (v string) {
+	if !p.IsSetCollege() {
+		return MobileGetImageRequest_College_DEFAULT
+	}
+	return *p.College
 }
 
-func (p *GetDownloadUrlResponse) InitDefault() {
+func (p *MobileGetImageRequest) GetDevice() (v string) {
+	return p.Device
 }
 
-func (p *GetDownloadUrlResponse) GetURL() (v string) {
-	return p.URL
+func (p *MobileGetImageRequest) IsSetCollege() bool {
+	return p.College != nil
 }
 
-func (p *GetDownloadUrlResponse) String() string {
+func (p *MobileGetImageRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDownloadUrlResponse(%+v)", *p)
+	return fmt.Sprintf("MobileGetImageRequest(%+v)", *p)
 }
 
-// 以下是旧版本兼容
-type ListDirFilesForAndroidRequest struct {
-	Path string `thrift:"path,1,required" form:"path,required" json:"path,required" query:"path,required"`
+type MobileGetImageResponse struct {
+	Base        *model.BaseResp  `thrift:"base,1" form:"base" json:"base" query:"base"`
+	Count       *int64           `thrift:"count,2,optional" form:"count" json:"count,omitempty" query:"count"`
+	PictureList []*model.Picture `thrift:"picture_list,3,optional,list<model.Picture>" form:"picture_list" json:"picture_list,omitempty" query:"picture_list"`
 }
 
-func NewListDirFilesForAndroidRequest() *ListDirFilesForAndroidRequest {
-	return &ListDirFilesForAndroidRequest{}
+func NewMobileGetImageResponse() *MobileGetImageResponse {
+	return &MobileGetImageResponse{}
 }
 
-func (p *ListDirFilesForAndroidRequest) InitDefault() {
+func (p *MobileGetImageResponse) InitDefault() {
 }
 
-func (p *ListDirFilesForAndroidRequest) GetPath() (v string) {
-	return p.Path
+var MobileGetImageResponse_Base_DEFAULT *model.BaseResp
+
+func (p *MobileGetImageResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return MobileGetImageResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *ListDirFilesForAndroidRequest) String() string {
-	if p == nil {
-		return "<nil>"
+var MobileGetImageResponse_Count_DEFAULT int64
+
+func (p *MobileGetImageResponse) GetCount() (v int64) {
+	if !p.IsSetCount() {
+		return MobileGetImageResponse_Count_DEFAULT
 	}
-	return fmt.Sprintf("ListDirFilesForAndroidRequest(%+v)", *p)
+	return *p.Count
 }
 
-type ListDirFilesForAndroidResponse struct {
+var MobileGetImageResponse_PictureList_DEFAULT []*model.Picture
+
+func (p *MobileGetImageResponse) GetPictureList() (v []*model.Picture) {
+	if !p.IsSetPictureList() {
+		return MobileGetImageResponse_PictureList_DEFAULT
+	}
+	return p.PictureList
 }
 
-func NewListDirFilesForAndroidResponse() *ListDirFilesForAndroidResponse {
-	return &ListDirFilesForAndroidResponse{}
+func (p *MobileGetImageResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *ListDirFilesForAndroidResponse) InitDefault() {
+func (p *MobileGetImageResponse) IsSetCount() bool {
+	return p.Count != nil
 }
 
-func (p *ListDirFilesForAndroidResponse) String() string {
+func (p *MobileGetImageResponse) IsSetPictureList() bool {
+	return p.PictureList != nil
+}
+
+func (p *MobileGetImageResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDirFilesForAndroidResponse(%+v)", *p)
+	return fmt.Sprintf("MobileGetImageResponse(%+v)", *p)
 }
 
-type GetDownloadUrlForAndroidRequest struct {
-	Filepath string `thrift:"filepath,1,required" form:"filepath,required" json:"filepath,required" query:"filepath,required"`
+type AddImagePointTimeRequest struct {
+	PictureID int64 `thrift:"picture_id,1,required" form:"picture_id,required" json:"picture_id,required" query:"picture_id,required"`
 }
 
-func NewGetDownloadUrlForAndroidRequest() *GetDownloadUrlForAndroidRequest {
-	return &GetDownloadUrlForAndroidRequest{}
+func NewAddImagePointTimeRequest() *AddImagePointTimeRequest {
+	return &AddImagePointTimeRequest{}
 }
 
-func (p *GetDownloadUrlForAndroidRequest) InitDefault() {
+func (p *AddImagePointTimeRequest) InitDefault() {
 }
 
-func (p *GetDownloadUrlForAndroidRequest) GetFilepath() (v string) {
-	return p.Filepath
+func (p *AddImagePointTimeRequest) GetPictureID() (v int64) {
+	return p.PictureID
 }
 
-func (p *GetDownloadUrlForAndroidRequest) String() string {
+func (p *AddImagePointTimeRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDownloadUrlForAndroidRequest(%+v)", *p)
+	return fmt.Sprintf("AddImagePointTimeRequest(%+v)", *p)
 }
 
-type GetDownloadUrlForAndroidResponse struct {
+type AddImagePointTimeResponse struct {
+	Base    *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+	Picture *model.Picture  `thrift:"picture,2,optional" form:"picture" json:"picture,omitempty" query:"picture"`
 }
 
-func NewGetDownloadUrlForAndroidResponse() *GetDownloadUrlForAndroidResponse {
-	return &GetDownloadUrlForAndroidResponse{}
+func NewAddImagePointTimeResponse() *AddImagePointTimeResponse {
+	return &AddImagePointTimeResponse{}
 }
 
-func (p *GetDownloadUrlForAndroidResponse) InitDefault() {
+func (p *AddImagePointTimeResponse) InitDefault() {
 }
 
-func (p *GetDownloadUrlForAndroidResponse) String() string {
-	if p == nil {
-		return "<nil>"
+var AddImagePointTimeResponse_Base_DEFAULT *model.BaseResp
+
+func (p *AddImagePointTimeResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return AddImagePointTimeResponse_Base_DEFAULT
 	}
-	return fmt.Sprintf("GetDownloadUrlForAndroidResponse(%+v)", *p)
+	return p.Base
 }
 
-// # ----------------------------------------------------------------------------
-// # academic 学业信息
-// # ----------------------------------------------------------------------------
-type GetScoresRequest struct {
+var AddImagePointTimeResponse_Picture_DEFAULT *model.Picture
+
+func (p *AddImagePointTimeResponse) GetPicture() (v *model.Picture) {
+	if !p.IsSetPicture() {
+		return AddImagePointTimeResponse_Picture_DEFAULT
+	}
+	return p.Picture
 }
 
-func NewGetScoresRequest() *GetScoresRequest {
-	return &GetScoresRequest{}
+func (p *AddImagePointTimeResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *GetScoresRequest) InitDefault() {
+func (p *AddImagePointTimeResponse) IsSetPicture() bool {
+	return p.Picture != nil
 }
 
-func (p *GetScoresRequest) String() string {
+func (p *AddImagePointTimeResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetScoresRequest(%+v)", *p)
+	return fmt.Sprintf("AddImagePointTimeResponse(%+v)", *p)
 }
 
-type GetScoresResponse struct {
-	Scores []*model.Score `thrift:"scores,1,required,list<model.Score>" form:"scores,required" json:"scores,required" query:"scores,required"`
+type ListImageRequest struct {
+	Secret   string `thrift:"secret,1,required" form:"secret,required" json:"secret,required" query:"secret,required"`
+	PageNum  *int64 `thrift:"page_num,2,optional" form:"page_num" json:"page_num,omitempty" query:"page_num"`
+	PageSize *int64 `thrift:"page_size,3,optional" form:"page_size" json:"page_size,omitempty" query:"page_size"`
 }
 
-func NewGetScoresResponse() *GetScoresResponse {
-	return &GetScoresResponse{}
+func NewListImageRequest() *ListImageRequest {
+	return &ListImageRequest{}
 }
 
-func (p *GetScoresResponse) InitDefault() {
+func (p *ListImageRequest) InitDefault() {
 }
 
-func (p *GetScoresResponse) GetScores() (v []*model.Score) {
-	return p.Scores
+func (p *ListImageRequest) GetSecret() (v string) {
+	return p.Secret
 }
 
-func (p *GetScoresResponse) String() string {
-	if p == nil {
-		return "<nil>"
+var ListImageRequest_PageNum_DEFAULT int64
+
+func (p *ListImageRequest) GetPageNum() (v int64) {
+	if !p.IsSetPageNum() {
+		return ListImageRequest_PageNum_DEFAULT
 	}
-	return fmt.Sprintf("GetScoresResponse(%+v)", *p)
+	return *p.PageNum
 }
 
-type GetGPARequest struct {
+var ListImageRequest_PageSize_DEFAULT int64
+
+func (p *ListImageRequest) GetPageSize() (v int64) {
+	if !p.IsSetPageSize() {
+		return ListImageRequest_PageSize_DEFAULT
+	}
+	return *p.PageSize
 }
 
-func NewGetGPARequest() *GetGPARequest {
-	return &GetGPARequest{}
+func (p *ListImageRequest) IsSetPageNum() bool {
+	return p.PageNum != nil
 }
 
-func (p *GetGPARequest) InitDefault() {
+func (p *ListImageRequest) IsSetPageSize() bool {
+	return p.PageSize != nil
 }
 
-func (p *GetGPARequest) String() string {
+func (p *ListImageRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetGPARequest(%+v)", *p)
+	return fmt.Sprintf("ListImageRequest(%+v)", *p)
 }
 
-type GetGPAResponse struct {
-	Gpa *model.GPABean `thrift:"gpa,1,required" form:"gpa,required" json:"gpa,required" query:"gpa,required"`
+type ListImageResponse struct {
+	Images []*model.Picture `thrift:"images,1,required,list<model.Picture>" form:"images,required" json:"images,required" query:"images,required"`
+	Total  int64            `thrift:"total,2,required" form:"total,required" json:"total,required" query:"total,required"`
 }
 
-func NewGetGPAResponse() *GetGPAResponse {
-	return &GetGPAResponse{}
+func NewListImageResponse() *ListImageResponse {
+	return &ListImageResponse{}
 }
 
-func (p *GetGPAResponse) InitDefault() {
+func (p *ListImageResponse) InitDefault() {
 }
 
-var GetGPAResponse_Gpa_DEFAULT *model.GPABean
+func (p *ListImageResponse) GetImages() (v []*model.Picture) {
+	return p.Images
+}
 
-func (p *GetGPAResponse) GetGpa() (v *model.GPABean) {
-	if !p.IsSetGpa() {
-		return GetGPAResponse_Gpa_DEFAULT
+func (p *ListImageResponse) GetTotal() (v int64) {
+	return p.Total
+}
+
+func (p *ListImageResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Gpa
+	return fmt.Sprintf("ListImageResponse(%+v)", *p)
 }
 
-func (p *GetGPAResponse) IsSetGpa() bool {
-	return p.Gpa != nil
+// # ----------------------------------------------------------------------------
+// # paper 历年卷
+// # ----------------------------------------------------------------------------
+type ListDirFilesRequest struct {
+	Path string `thrift:"path,1,required" form:"path,required" json:"path,required" query:"path,required"`
 }
 
-func (p *GetGPAResponse) String() string {
+func NewListDirFilesRequest() *ListDirFilesRequest {
+	return &ListDirFilesRequest{}
+}
+
+func (p *ListDirFilesRequest) InitDefault() {
+}
+
+func (p *ListDirFilesRequest) GetPath() (v string) {
+	return p.Path
+}
+
+func (p *ListDirFilesRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetGPAResponse(%+v)", *p)
+	return fmt.Sprintf("ListDirFilesRequest(%+v)", *p)
 }
 
-type GetCreditRequest struct {
+type ListDirFilesResponse struct {
+	Dir *model.UpYunFileDir `thrift:"dir,1,required" form:"dir,required" json:"dir,required" query:"dir,required"`
 }
 
-func NewGetCreditRequest() *GetCreditRequest {
-	return &GetCreditRequest{}
+func NewListDirFilesResponse() *ListDirFilesResponse {
+	return &ListDirFilesResponse{}
+}
+
+func (p *ListDirFilesResponse) InitDefault() {
+}
+
+var ListDirFilesResponse_Dir_DEFAULT *model.UpYunFileDir
+
+func (p *ListDirFilesResponse) GetDir() (v *model.UpYunFileDir) {
+	if !p.IsSetDir() {
+		return ListDirFilesResponse_Dir_DEFAULT
+	}
+	return p.Dir
+}
+
+func (p *ListDirFilesResponse) IsSetDir() bool {
+	return p.Dir != nil
+}
+
+func (p *ListDirFilesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDirFilesResponse(%+v)", *p)
+}
+
+type GetDownloadUrlRequest struct {
+	Filepath string `thrift:"filepath,1,required" form:"filepath,required" json:"filepath,required" query:"filepath,required"`
+}
+
+func NewGetDownloadUrlRequest() *GetDownloadUrlRequest {
+	return &GetDownloadUrlRequest{}
+}
+
+func (p *GetDownloadUrlRequest) InitDefault() {
+}
+
+func (p *GetDownloadUrlRequest) GetFilepath() (v string) {
+	return p.Filepath
+}
+
+func (p *GetDownloadUrlRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetDownloadUrlRequest(%+v)", *p)
+}
+
+type GetDownloadUrlResponse struct {
+	URL string `thrift:"url,1,required" form:"url,required" json:"url,required" query:"url,required"`
+}
+
+func NewGetDownloadUrlResponse() *GetDownloadUrlResponse {
+	return &GetDownloadUrlResponse{}
+}
+
+func (p *GetDownloadUrlResponse) InitDefault() {
+}
+
+func (p *GetDownloadUrlResponse) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *GetDownloadUrlResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetDownloadUrlResponse(%+v)", *p)
+}
+
+// 以下是旧版本兼容
+type ListDirFilesForAndroidRequest struct {
+	Path string `thrift:"path,1,required" form:"path,required" json:"path,required" query:"path,required"`
+}
+
+func NewListDirFilesForAndroidRequest() *ListDirFilesForAndroidRequest {
+	return &ListDirFilesForAndroidRequest{}
+}
+
+func (p *ListDirFilesForAndroidRequest) InitDefault() {
+}
+
+func (p *ListDirFilesForAndroidRequest) GetPath() (v string) {
+	return p.Path
+}
+
+func (p *ListDirFilesForAndroidRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDirFilesForAndroidRequest(%+v)", *p)
+}
+
+type ListDirFilesForAndroidResponse struct {
+}
+
+func NewListDirFilesForAndroidResponse() *ListDirFilesForAndroidResponse {
+	return &ListDirFilesForAndroidResponse{}
+}
+
+func (p *ListDirFilesForAndroidResponse) InitDefault() {
+}
+
+func (p *ListDirFilesForAndroidResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDirFilesForAndroidResponse(%+v)", *p)
+}
+
+type GetDownloadUrlForAndroidRequest struct {
+	Filepath string `thrift:"filepath,1,required" form:"filepath,required" json:"filepath,required" query:"filepath,required"`
+}
+
+func NewGetDownloadUrlForAndroidRequest() *GetDownloadUrlForAndroidRequest {
+	return &GetDownloadUrlForAndroidRequest{}
+}
+
+func (p *GetDownloadUrlForAndroidRequest) InitDefault() {
+}
+
+func (p *GetDownloadUrlForAndroidRequest) GetFilepath() (v string) {
+	return p.Filepath
+}
+
+func (p *GetDownloadUrlForAndroidRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetDownloadUrlForAndroidRequest(%+v)", *p)
+}
+
+type GetDownloadUrlForAndroidResponse struct {
+}
+
+func NewGetDownloadUrlForAndroidResponse() *GetDownloadUrlForAndroidResponse {
+	return &GetDownloadUrlForAndroidResponse{}
+}
+
+func (p *GetDownloadUrlForAndroidResponse) InitDefault() {
+}
+
+func (p *GetDownloadUrlForAndroidResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetDownloadUrlForAndroidResponse(%+v)", *p)
+}
+
+// # ----------------------------------------------------------------------------
+// # academic 学业信息
+// # ----------------------------------------------------------------------------
+type GetScoresRequest struct {
+}
+
+func NewGetScoresRequest() *GetScoresRequest {
+	return &GetScoresRequest{}
+}
+
+func (p *GetScoresRequest) InitDefault() {
+}
+
+func (p *GetScoresRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetScoresRequest(%+v)", *p)
+}
+
+type GetScoresResponse struct {
+	Scores       []*model.Score `thrift:"scores,1,required,list<model.Score>" form:"scores,required" json:"scores,required" query:"scores,required"`
+	Stale        *bool          `thrift:"stale,2,optional" form:"stale" json:"stale,omitempty" query:"stale"`
+	SnapshotTime *int64         `thrift:"snapshot_time,3,optional" form:"snapshot_time" json:"snapshot_time,omitempty" query:"snapshot_time"`
+}
+
+func NewGetScoresResponse() *GetScoresResponse {
+	return &GetScoresResponse{}
+}
+
+func (p *GetScoresResponse) InitDefault() {
+}
+
+func (p *GetScoresResponse) GetScores() (v []*model.Score) {
+	return p.Scores
+}
+
+var GetScoresResponse_Stale_DEFAULT bool
+
+func (p *GetScoresResponse) GetStale() (v bool) {
+	if !p.IsSetStale() {
+		return GetScoresResponse_Stale_DEFAULT
+	}
+	return *p.Stale
+}
+
+var GetScoresResponse_SnapshotTime_DEFAULT int64
+
+func (p *GetScoresResponse) GetSnapshotTime() (v int64) {
+	if !p.IsSetSnapshotTime() {
+		return GetScoresResponse_SnapshotTime_DEFAULT
+	}
+	return *p.SnapshotTime
+}
+
+func (p *GetScoresResponse) IsSetStale() bool {
+	return p.Stale != nil
+}
+
+func (p *GetScoresResponse) IsSetSnapshotTime() bool {
+	return p.SnapshotTime != nil
+}
+
+func (p *GetScoresResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetScoresResponse(%+v)", *p)
+}
+
+type GetGPARequest struct {
+}
+
+func NewGetGPARequest() *GetGPARequest {
+	return &GetGPARequest{}
+}
+
+func (p *GetGPARequest) InitDefault() {
+}
+
+func (p *GetGPARequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetGPARequest(%+v)", *p)
+}
+
+type GetGPAResponse struct {
+	Gpa *model.GPABean `thrift:"gpa,1,required" form:"gpa,required" json:"gpa,required" query:"gpa,required"`
+}
+
+func NewGetGPAResponse() *GetGPAResponse {
+	return &GetGPAResponse{}
+}
+
+func (p *GetGPAResponse) InitDefault() {
+}
+
+var GetGPAResponse_Gpa_DEFAULT *model.GPABean
+
+func (p *GetGPAResponse) GetGpa() (v *model.GPABean) {
+	if !p.IsSetGpa() {
+		return GetGPAResponse_Gpa_DEFAULT
+	}
+	return p.Gpa
+}
+
+func (p *GetGPAResponse) IsSetGpa() bool {
+	return p.Gpa != nil
+}
+
+func (p *GetGPAResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetGPAResponse(%+v)", *p)
+}
+
+type GetCreditRequest struct {
+}
+
+func NewGetCreditRequest() *GetCreditRequest {
+	return &GetCreditRequest{}
+}
+
+func (p *GetCreditRequest) InitDefault() {
+}
+
+func (p *GetCreditRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCreditRequest(%+v)", *p)
+}
+
+type GetCreditResponse struct {
+	Major []*model.Credit `thrift:"major,1,required,list<model.Credit>" form:"major,required" json:"major,required" query:"major,required"`
+}
+
+func NewGetCreditResponse() *GetCreditResponse {
+	return &GetCreditResponse{}
+}
+
+func (p *GetCreditResponse) InitDefault() {
+}
+
+func (p *GetCreditResponse) GetMajor() (v []*model.Credit) {
+	return p.Major
+}
+
+func (p *GetCreditResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCreditResponse(%+v)", *p)
+}
+
+type GetUnifiedExamRequest struct {
+}
+
+func NewGetUnifiedExamRequest() *GetUnifiedExamRequest {
+	return &GetUnifiedExamRequest{}
+}
+
+func (p *GetUnifiedExamRequest) InitDefault() {
+}
+
+func (p *GetUnifiedExamRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUnifiedExamRequest(%+v)", *p)
+}
+
+type GetUnifiedExamResponse struct {
+	UnifiedExam []*model.UnifiedExam `thrift:"unifiedExam,1,required,list<model.UnifiedExam>" form:"unifiedExam,required" json:"unifiedExam,required" query:"unifiedExam,required"`
+}
+
+func NewGetUnifiedExamResponse() *GetUnifiedExamResponse {
+	return &GetUnifiedExamResponse{}
+}
+
+func (p *GetUnifiedExamResponse) InitDefault() {
+}
+
+func (p *GetUnifiedExamResponse) GetUnifiedExam() (v []*model.UnifiedExam) {
+	return p.UnifiedExam
+}
+
+func (p *GetUnifiedExamResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUnifiedExamResponse(%+v)", *p)
+}
+
+type GetCreditV2Request struct {
+}
+
+func NewGetCreditV2Request() *GetCreditV2Request {
+	return &GetCreditV2Request{}
+}
+
+func (p *GetCreditV2Request) InitDefault() {
+}
+
+func (p *GetCreditV2Request) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCreditV2Request(%+v)", *p)
+}
+
+type GetCreditV2Response struct {
+	Base   *model.BaseResp      `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
+	Credit model.CreditResponse `thrift:"credit,2,optional,list<model.CreditCategory>" form:"credit" json:"credit,omitempty" query:"credit"`
+}
+
+func NewGetCreditV2Response() *GetCreditV2Response {
+	return &GetCreditV2Response{}
+}
+
+func (p *GetCreditV2Response) InitDefault() {
+}
+
+var GetCreditV2Response_Base_DEFAULT *model.BaseResp
+
+func (p *GetCreditV2Response) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetCreditV2Response_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetCreditV2Response_Credit_DEFAULT model.CreditResponse
+
+func (p *GetCreditV2Response) GetCredit() (v model.CreditResponse) {
+	if !p.IsSetCredit() {
+		return GetCreditV2Response_Credit_DEFAULT
+	}
+	return p.Credit
+}
+
+func (p *GetCreditV2Response) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetCreditV2Response) IsSetCredit() bool {
+	return p.Credit != nil
+}
+
+func (p *GetCreditV2Response) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCreditV2Response(%+v)", *p)
+}
+
+type GetPlanRequest struct {
+	ID      string `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	Cookies string `thrift:"cookies,2,required" form:"cookies,required" json:"cookies,required" query:"cookies,required"`
+}
+
+func NewGetPlanRequest() *GetPlanRequest {
+	return &GetPlanRequest{}
+}
+
+func (p *GetPlanRequest) InitDefault() {
+}
+
+func (p *GetPlanRequest) GetID() (v string) {
+	return p.ID
+}
+
+func (p *GetPlanRequest) GetCookies() (v string) {
+	return p.Cookies
+}
+
+func (p *GetPlanRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPlanRequest(%+v)", *p)
+}
+
+type GetPlanResponse struct {
+	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+}
+
+func NewGetPlanResponse() *GetPlanResponse {
+	return &GetPlanResponse{}
+}
+
+func (p *GetPlanResponse) InitDefault() {
+}
+
+var GetPlanResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetPlanResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetPlanResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetPlanResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetPlanResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPlanResponse(%+v)", *p)
+}
+
+// # ----------------------------------------------------------------------------
+// # version（原url，版本控制相关）
+// # ----------------------------------------------------------------------------
+type LoginRequest struct {
+	Password string `thrift:"password,1,required" form:"password,required" json:"password,required" query:"password,required"`
+}
+
+func NewLoginRequest() *LoginRequest {
+	return &LoginRequest{}
+}
+
+func (p *LoginRequest) InitDefault() {
+}
+
+func (p *LoginRequest) GetPassword() (v string) {
+	return p.Password
+}
+
+func (p *LoginRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LoginRequest(%+v)", *p)
+}
+
+type LoginResponse struct {
+	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+}
+
+func NewLoginResponse() *LoginResponse {
+	return &LoginResponse{}
+}
+
+func (p *LoginResponse) InitDefault() {
+}
+
+var LoginResponse_Base_DEFAULT *model.BaseResp
+
+func (p *LoginResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return LoginResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *LoginResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *LoginResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LoginResponse(%+v)", *p)
+}
+
+type UploadRequest struct {
+	Version  string `thrift:"version,1,required" form:"version,required" json:"version,required" query:"version,required"`
+	Code     string `thrift:"code,2,required" form:"code,required" json:"code,required" query:"code,required"`
+	URL      string `thrift:"url,3,required" form:"url,required" json:"url,required" query:"url,required"`
+	Feature  string `thrift:"feature,4,required" form:"feature,required" json:"feature,required" query:"feature,required"`
+	Type     string `thrift:"type,5,required" form:"type,required" json:"type,required" query:"type,required"`
+	Password string `thrift:"password,6,required" form:"password,required" json:"password,required" query:"password,required"`
+	Force    bool   `thrift:"force,7,required" form:"force,required" json:"force,required" query:"force,required"`
+	// android / ios / harmony，默认 android
+	Platform *string `thrift:"platform,8,optional" form:"platform" json:"platform,omitempty" query:"platform"`
+	// 灰度比例 0-100，默认 100；以相同 code 和相同内容重新上传可调整比例
+	RolloutPercent *int64 `thrift:"rollout_percent,9,optional" form:"rollout_percent" json:"rollout_percent,omitempty" query:"rollout_percent"`
+	// 最低支持的版本号，低于该版本的客户端强制更新
+	MinSupportedCode *int64 `thrift:"min_supported_code,10,optional" form:"min_supported_code" json:"min_supported_code,omitempty" query:"min_supported_code"`
+}
+
+func NewUploadRequest() *UploadRequest {
+	return &UploadRequest{}
+}
+
+func (p *UploadRequest) InitDefault() {
+}
+
+func (p *UploadRequest) GetVersion() (v string) {
+	return p.Version
+}
+
+func (p *UploadRequest) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *UploadRequest) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *UploadRequest) GetFeature() (v string) {
+	return p.Feature
+}
+
+func (p *UploadRequest) GetType() (v string) {
+	return p.Type
+}
+
+func (p *UploadRequest) GetPassword() (v string) {
+	return p.Password
+}
+
+func (p *UploadRequest) GetForce() (v bool) {
+	return p.Force
+}
+
+var UploadRequest_Platform_DEFAULT string
+
+func (p *UploadRequest) GetPlatform() (v string) {
+	if !p.IsSetPlatform() {
+		return UploadRequest_Platform_DEFAULT
+	}
+	return *p.Platform
+}
+
+var UploadRequest_RolloutPercent_DEFAULT int64
+
+func (p *UploadRequest) GetRolloutPercent() (v int64) {
+	if !p.IsSetRolloutPercent() {
+		return UploadRequest_RolloutPercent_DEFAULT
+	}
+	return *p.RolloutPercent
+}
+
+var UploadRequest_MinSupportedCode_DEFAULT int64
+
+func (p *UploadRequest) GetMinSupportedCode() (v int64) {
+	if !p.IsSetMinSupportedCode() {
+		return UploadRequest_MinSupportedCode_DEFAULT
+	}
+	return *p.MinSupportedCode
+}
+
+func (p *UploadRequest) IsSetPlatform() bool {
+	return p.Platform != nil
+}
+
+func (p *UploadRequest) IsSetRolloutPercent() bool {
+	return p.RolloutPercent != nil
+}
+
+func (p *UploadRequest) IsSetMinSupportedCode() bool {
+	return p.MinSupportedCode != nil
+}
+
+func (p *UploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadRequest(%+v)", *p)
+}
+
+type UploadResponse struct {
+	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+}
+
+func NewUploadResponse() *UploadResponse {
+	return &UploadResponse{}
+}
+
+func (p *UploadResponse) InitDefault() {
+}
+
+var UploadResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UploadResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UploadResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *UploadResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UploadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadResponse(%+v)", *p)
+}
+
+type UploadParamsRequest struct {
+	Password string `thrift:"password,1,required" form:"password,required" json:"password,required" query:"password,required"`
+}
+
+func NewUploadParamsRequest() *UploadParamsRequest {
+	return &UploadParamsRequest{}
+}
+
+func (p *UploadParamsRequest) InitDefault() {
+}
+
+func (p *UploadParamsRequest) GetPassword() (v string) {
+	return p.Password
+}
+
+func (p *UploadParamsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadParamsRequest(%+v)", *p)
+}
+
+type UploadParamsResponse struct {
+	Base          *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
+	Policy        *string         `thrift:"policy,2,optional" form:"policy" json:"policy,omitempty" query:"policy"`
+	Authorization *string         `thrift:"authorization,3,optional" form:"authorization" json:"authorization,omitempty" query:"authorization"`
+}
+
+func NewUploadParamsResponse() *UploadParamsResponse {
+	return &UploadParamsResponse{}
+}
+
+func (p *UploadParamsResponse) InitDefault() {
+}
+
+var UploadParamsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UploadParamsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UploadParamsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var UploadParamsResponse_Policy_DEFAULT string
+
+func (p *UploadParamsResponse) GetPolicy() (v string) {
+	if !p.IsSetPolicy() {
+		return UploadParamsResponse_Policy_DEFAULT
+	}
+	return *p.Policy
+}
+
+var UploadParamsResponse_Authorization_DEFAULT string
+
+func (p *UploadParamsResponse) GetAuthorization() (v string) {
+	if !p.IsSetAuthorization() {
+		return UploadParamsResponse_Authorization_DEFAULT
+	}
+	return *p.Authorization
+}
+
+func (p *UploadParamsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UploadParamsResponse) IsSetPolicy() bool {
+	return p.Policy != nil
+}
+
+func (p *UploadParamsResponse) IsSetAuthorization() bool {
+	return p.Authorization != nil
+}
+
+func (p *UploadParamsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadParamsResponse(%+v)", *p)
+}
+
+type DownloadReleaseApkRequest struct {
+}
+
+func NewDownloadReleaseApkRequest() *DownloadReleaseApkRequest {
+	return &DownloadReleaseApkRequest{}
+}
+
+func (p *DownloadReleaseApkRequest) InitDefault() {
+}
+
+func (p *DownloadReleaseApkRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DownloadReleaseApkRequest(%+v)", *p)
+}
+
+type DownloadReleaseApkResponse struct {
+	File []byte `thrift:"file,1,optional" form:"file" json:"file,omitempty" query:"file"`
+}
+
+func NewDownloadReleaseApkResponse() *DownloadReleaseApkResponse {
+	return &DownloadReleaseApkResponse{}
+}
+
+func (p *DownloadReleaseApkResponse) InitDefault() {
+}
+
+var DownloadReleaseApkResponse_File_DEFAULT []byte
+
+func (p *DownloadReleaseApkResponse) GetFile() (v []byte) {
+	if !p.IsSetFile() {
+		return DownloadReleaseApkResponse_File_DEFAULT
+	}
+	return p.File
+}
+
+func (p *DownloadReleaseApkResponse) IsSetFile() bool {
+	return p.File != nil
+}
+
+func (p *DownloadReleaseApkResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DownloadReleaseApkResponse(%+v)", *p)
+}
+
+type DownloadBetaApkRequest struct {
+}
+
+func NewDownloadBetaApkRequest() *DownloadBetaApkRequest {
+	return &DownloadBetaApkRequest{}
+}
+
+func (p *DownloadBetaApkRequest) InitDefault() {
+}
+
+func (p *DownloadBetaApkRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DownloadBetaApkRequest(%+v)", *p)
+}
+
+type DownloadBetaApkResponse struct {
+	File []byte `thrift:"file,1,optional" form:"file" json:"file,omitempty" query:"file"`
+}
+
+func NewDownloadBetaApkResponse() *DownloadBetaApkResponse {
+	return &DownloadBetaApkResponse{}
+}
+
+func (p *DownloadBetaApkResponse) InitDefault() {
+}
+
+var DownloadBetaApkResponse_File_DEFAULT []byte
+
+func (p *DownloadBetaApkResponse) GetFile() (v []byte) {
+	if !p.IsSetFile() {
+		return DownloadBetaApkResponse_File_DEFAULT
+	}
+	return p.File
+}
+
+func (p *DownloadBetaApkResponse) IsSetFile() bool {
+	return p.File != nil
+}
+
+func (p *DownloadBetaApkResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DownloadBetaApkResponse(%+v)", *p)
+}
+
+type GetReleaseVersionRequest struct {
+	// 设备标识，优先用于灰度分桶
+	DeviceID *string `thrift:"device_id,1,optional" form:"device_id" json:"device_id,omitempty" query:"device_id"`
+	// 学号，未提供设备标识时用于灰度分桶
+	StuID *string `thrift:"stu_id,2,optional" form:"stu_id" json:"stu_id,omitempty" query:"stu_id"`
+	// 客户端当前版本号，低于最低支持版本时强制更新
+	Code *string `thrift:"code,3,optional" form:"code" json:"code,omitempty" query:"code"`
+}
+
+func NewGetReleaseVersionRequest() *GetReleaseVersionRequest {
+	return &GetReleaseVersionRequest{}
+}
+
+func (p *GetReleaseVersionRequest) InitDefault() {
+}
+
+var GetReleaseVersionRequest_DeviceID_DEFAULT string
+
+func (p *GetReleaseVersionRequest) GetDeviceID() (v string) {
+	if !p.IsSetDeviceID() {
+		return GetReleaseVersionRequest_DeviceID_DEFAULT
+	}
+	return *p.DeviceID
+}
+
+var GetReleaseVersionRequest_StuID_DEFAULT string
+
+func (p *GetReleaseVersionRequest) GetStuID() (v string) {
+	if !p.IsSetStuID() {
+		return GetReleaseVersionRequest_StuID_DEFAULT
+	}
+	return *p.StuID
+}
+
+var GetReleaseVersionRequest_Code_DEFAULT string
+
+func (p *GetReleaseVersionRequest) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return GetReleaseVersionRequest_Code_DEFAULT
+	}
+	return *p.Code
+}
+
+func (p *GetReleaseVersionRequest) IsSetDeviceID() bool {
+	return p.DeviceID != nil
+}
+
+func (p *GetReleaseVersionRequest) IsSetStuID() bool {
+	return p.StuID != nil
+}
+
+func (p *GetReleaseVersionRequest) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *GetReleaseVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReleaseVersionRequest(%+v)", *p)
+}
+
+type GetReleaseVersionResponse struct {
+	Base    *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
+	Code    *string         `thrift:"code,2,optional" form:"code" json:"code,omitempty" query:"code"`
+	Feature *string         `thrift:"feature,3,optional" form:"feature" json:"feature,omitempty" query:"feature"`
+	URL     *string         `thrift:"url,4,optional" form:"url" json:"url,omitempty" query:"url"`
+	Version *string         `thrift:"version,5,optional" form:"version" json:"version,omitempty" query:"version"`
+	Force   *bool           `thrift:"force,6,optional" form:"force" json:"force,omitempty" query:"force"`
+}
+
+func NewGetReleaseVersionResponse() *GetReleaseVersionResponse {
+	return &GetReleaseVersionResponse{}
+}
+
+func (p *GetReleaseVersionResponse) InitDefault() {
+}
+
+var GetReleaseVersionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetReleaseVersionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetReleaseVersionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetReleaseVersionResponse_Code_DEFAULT string
+
+func (p *GetReleaseVersionResponse) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return GetReleaseVersionResponse_Code_DEFAULT
+	}
+	return *p.Code
+}
+
+var GetReleaseVersionResponse_Feature_DEFAULT string
+
+func (p *GetReleaseVersionResponse) GetFeature() (v string) {
+	if !p.IsSetFeature() {
+		return GetReleaseVersionResponse_Feature_DEFAULT
+	}
+	return *p.Feature
+}
+
+var GetReleaseVersionResponse_URL_DEFAULT string
+
+func (p *GetReleaseVersionResponse) GetURL() (v string) {
+	if !p.IsSetURL() {
+		return GetReleaseVersionResponse_URL_DEFAULT
+	}
+	return *p.URL
+}
+
+var GetReleaseVersionResponse_Version_DEFAULT string
+
+func (p *GetReleaseVersionResponse) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return GetReleaseVersionResponse_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var GetReleaseVersionResponse_Force_DEFAULT bool
+
+func (p *GetReleaseVersionResponse) GetForce() (v bool) {
+	if !p.IsSetForce() {
+		return GetReleaseVersionResponse_Force_DEFAULT
+	}
+	return *p.Force
+}
+
+func (p *GetReleaseVersionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetReleaseVersionResponse) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *GetReleaseVersionResponse) IsSetFeature() bool {
+	return p.Feature != nil
+}
+
+func (p *GetReleaseVersionResponse) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *GetReleaseVersionResponse) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *GetReleaseVersionResponse) IsSetForce() bool {
+	return p.Force != nil
+}
+
+func (p *GetReleaseVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReleaseVersionResponse(%+v)", *p)
+}
+
+type GetBetaVersionRequest struct {
+	// 设备标识，优先用于灰度分桶
+	DeviceID *string `thrift:"device_id,1,optional" form:"device_id" json:"device_id,omitempty" query:"device_id"`
+	// 学号，未提供设备标识时用于灰度分桶
+	StuID *string `thrift:"stu_id,2,optional" form:"stu_id" json:"stu_id,omitempty" query:"stu_id"`
+	// 客户端当前版本号，低于最低支持版本时强制更新
+	Code *string `thrift:"code,3,optional" form:"code" json:"code,omitempty" query:"code"`
+}
+
+func NewGetBetaVersionRequest() *GetBetaVersionRequest {
+	return &GetBetaVersionRequest{}
+}
+
+func (p *GetBetaVersionRequest) InitDefault() {
+}
+
+var GetBetaVersionRequest_DeviceID_DEFAULT string
+
+func (p *GetBetaVersionRequest) GetDeviceID() (v string) {
+	if !p.IsSetDeviceID() {
+		return GetBetaVersionRequest_DeviceID_DEFAULT
+	}
+	return *p.DeviceID
+}
+
+var GetBetaVersionRequest_StuID_DEFAULT string
+
+func (p *GetBetaVersionRequest) GetStuID() (v string) {
+	if !p.IsSetStuID() {
+		return GetBetaVersionRequest_StuID_DEFAULT
+	}
+	return *p.StuID
+}
+
+var GetBetaVersionRequest_Code_DEFAULT string
+
+func (p *GetBetaVersionRequest) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return GetBetaVersionRequest_Code_DEFAULT
+	}
+	return *p.Code
+}
+
+func (p *GetBetaVersionRequest) IsSetDeviceID() bool {
+	return p.DeviceID != nil
+}
+
+func (p *GetBetaVersionRequest) IsSetStuID() bool {
+	return p.StuID != nil
+}
+
+func (p *GetBetaVersionRequest) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *GetBetaVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetBetaVersionRequest(%+v)", *p)
+}
+
+type GetBetaVersionResponse struct {
+	Base    *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
+	Code    *string         `thrift:"code,2,optional" form:"code" json:"code,omitempty" query:"code"`
+	Feature *string         `thrift:"feature,3,optional" form:"feature" json:"feature,omitempty" query:"feature"`
+	URL     *string         `thrift:"url,4,optional" form:"url" json:"url,omitempty" query:"url"`
+	Version *string         `thrift:"version,5,optional" form:"version" json:"version,omitempty" query:"version"`
+	Force   *bool           `thrift:"force,6,optional" form:"force" json:"force,omitempty" query:"force"`
+}
+
+func NewGetBetaVersionResponse() *GetBetaVersionResponse {
+	return &GetBetaVersionResponse{}
+}
+
+func (p *GetBetaVersionResponse) InitDefault() {
+}
+
+var GetBetaVersionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetBetaVersionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetBetaVersionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetBetaVersionResponse_Code_DEFAULT string
+
+func (p *GetBetaVersionResponse) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return GetBetaVersionResponse_Code_DEFAULT
+	}
+	return *p.Code
+}
+
+var GetBetaVersionResponse_Feature_DEFAULT string
+
+func (p *GetBetaVersionResponse) GetFeature() (v string) {
+	if !p.IsSetFeature() {
+		return GetBetaVersionResponse_Feature_DEFAULT
+	}
+	return *p.Feature
+}
+
+var GetBetaVersionResponse_URL_DEFAULT string
+
+func (p *GetBetaVersionResponse) GetURL() (v string) {
+	if !p.IsSetURL() {
+		return GetBetaVersionResponse_URL_DEFAULT
+	}
+	return *p.URL
+}
+
+var GetBetaVersionResponse_Version_DEFAULT string
+
+func (p *GetBetaVersionResponse) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return GetBetaVersionResponse_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var GetBetaVersionResponse_Force_DEFAULT bool
+
+func (p *GetBetaVersionResponse) GetForce() (v bool) {
+	if !p.IsSetForce() {
+		return GetBetaVersionResponse_Force_DEFAULT
+	}
+	return *p.Force
+}
+
+func (p *GetBetaVersionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetBetaVersionResponse) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *GetBetaVersionResponse) IsSetFeature() bool {
+	return p.Feature != nil
+}
+
+func (p *GetBetaVersionResponse) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *GetBetaVersionResponse) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *GetBetaVersionResponse) IsSetForce() bool {
+	return p.Force != nil
+}
+
+func (p *GetBetaVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetBetaVersionResponse(%+v)", *p)
+}
+
+type GetSettingRequest struct {
+	Account   *string `thrift:"account,1,optional" form:"account" json:"account,omitempty" query:"account"`
+	Version   *string `thrift:"version,2,optional" form:"version" json:"version,omitempty" query:"version"`
+	Beta      *bool   `thrift:"beta,3,optional" form:"beta" json:"beta,omitempty" query:"beta"`
+	Phone     *string `thrift:"phone,4,optional" form:"phone" json:"phone,omitempty" query:"phone"`
+	IsLogin   *bool   `thrift:"isLogin,5,optional" form:"isLogin" json:"isLogin,omitempty" query:"isLogin"`
+	LoginType *string `thrift:"loginType,6,optional" form:"loginType" json:"loginType,omitempty" query:"loginType"`
+}
+
+func NewGetSettingRequest() *GetSettingRequest {
+	return &GetSettingRequest{}
+}
+
+func (p *GetSettingRequest) InitDefault() {
+}
+
+var GetSettingRequest_Account_DEFAULT string
+
+func (p *GetSettingRequest) GetAccount() (v string) {
+	if !p.IsSetAccount() {
+		return GetSettingRequest_Account_DEFAULT
+	}
+	return *p.Account
+}
+
+var GetSettingRequest_Version_DEFAULT string
+
+func (p *GetSettingRequest) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return GetSettingRequest_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var GetSettingRequest_Beta_DEFAULT bool
+
+func (p *GetSettingRequest) GetBeta() (v bool) {
+	if !p.IsSetBeta() {
+		return GetSettingRequest_Beta_DEFAULT
+	}
+	return *p.Beta
+}
+
+var GetSettingRequest_Phone_DEFAULT string
+
+func (p *GetSettingRequest) GetPhone() (v string) {
+	if !p.IsSetPhone() {
+		return GetSettingRequest_Phone_DEFAULT
+	}
+	return *p.Phone
+}
+
+var GetSettingRequest_IsLogin_DEFAULT bool
+
+func (p *GetSettingRequest) GetIsLogin() (v bool) {
+	if !p.IsSetIsLogin() {
+		return GetSettingRequest_IsLogin_DEFAULT
+	}
+	return *p.IsLogin
+}
+
+var GetSettingRequest_LoginType_DEFAULT string
+
+func (p *GetSettingRequest) GetLoginType() (v string) {
+	if !p.IsSetLoginType() {
+		return GetSettingRequest_LoginType_DEFAULT
+	}
+	return *p.LoginType
+}
+
+func (p *GetSettingRequest) IsSetAccount() bool {
+	return p.Account != nil
+}
+
+func (p *GetSettingRequest) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *GetSettingRequest) IsSetBeta() bool {
+	return p.Beta != nil
+}
+
+func (p *GetSettingRequest) IsSetPhone() bool {
+	return p.Phone != nil
+}
+
+func (p *GetSettingRequest) IsSetIsLogin() bool {
+	return p.IsLogin != nil
+}
+
+func (p *GetSettingRequest) IsSetLoginType() bool {
+	return p.LoginType != nil
+}
+
+func (p *GetSettingRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetSettingRequest(%+v)", *p)
+}
+
+type GetSettingResponse struct {
+	Base         *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
+	CloudSetting []byte          `thrift:"cloud_setting,2" form:"cloud_setting" json:"cloud_setting" query:"cloud_setting"`
+}
+
+func NewGetSettingResponse() *GetSettingResponse {
+	return &GetSettingResponse{}
+}
+
+func (p *GetSettingResponse) InitDefault() {
+}
+
+var GetSettingResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetSettingResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetSettingResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetSettingResponse) GetCloudSetting() (v []byte) {
+	return p.CloudSetting
+}
+
+func (p *GetSettingResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetSettingResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetSettingResponse(%+v)", *p)
+}
+
+type GetTestRequest struct {
+	Account   *string `thrift:"account,1,optional" form:"account" json:"account,omitempty" query:"account"`
+	Version   *string `thrift:"version,2,optional" form:"version" json:"version,omitempty" query:"version"`
+	Beta      *bool   `thrift:"beta,3,optional" form:"beta" json:"beta,omitempty" query:"beta"`
+	Phone     *string `thrift:"phone,4,optional" form:"phone" json:"phone,omitempty" query:"phone"`
+	IsLogin   *bool   `thrift:"isLogin,5,optional" form:"isLogin" json:"isLogin,omitempty" query:"isLogin"`
+	LoginType *string `thrift:"loginType,6,optional" form:"loginType" json:"loginType,omitempty" query:"loginType"`
+	Setting   *string `thrift:"setting,7,optional" form:"setting" json:"setting,omitempty" query:"setting"`
+}
+
+func NewGetTestRequest() *GetTestRequest {
+	return &GetTestRequest{}
+}
+
+func (p *GetTestRequest) InitDefault() {
+}
+
+var GetTestRequest_Account_DEFAULT string
+
+func (p *GetTestRequest) GetAccount() (v string) {
+	if !p.IsSetAccount() {
+		return GetTestRequest_Account_DEFAULT
+	}
+	return *p.Account
+}
+
+var GetTestRequest_Version_DEFAULT string
+
+func (p *GetTestRequest) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return GetTestRequest_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var GetTestRequest_Beta_DEFAULT bool
+
+func (p *GetTestRequest) GetBeta() (v bool) {
+	if !p.IsSetBeta() {
+		return GetTestRequest_Beta_DEFAULT
+	}
+	return *p.Beta
+}
+
+var GetTestRequest_Phone_DEFAULT string
+
+func (p *GetTestRequest) GetPhone() (v string) {
+	if !p.IsSetPhone() {
+		return GetTestRequest_Phone_DEFAULT
+	}
+	return *p.Phone
+}
+
+var GetTestRequest_IsLogin_DEFAULT bool
+
+func (p *GetTestRequest) GetIsLogin() (v bool) {
+	if !p.IsSetIsLogin() {
+		return GetTestRequest_IsLogin_DEFAULT
+	}
+	return *p.IsLogin
+}
+
+var GetTestRequest_LoginType_DEFAULT string
+
+func (p *GetTestRequest) GetLoginType() (v string) {
+	if !p.IsSetLoginType() {
+		return GetTestRequest_LoginType_DEFAULT
+	}
+	return *p.LoginType
+}
+
+var GetTestRequest_Setting_DEFAULT string
+
+func (p *GetTestRequest) GetSetting() (v string) {
+	if !p.IsSetSetting() {
+		return GetTestRequest_Setting_DEFAULT
+	}
+	return *p.Setting
+}
+
+func (p *GetTestRequest) IsSetAccount() bool {
+	return p.Account != nil
+}
+
+func (p *GetTestRequest) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *GetTestRequest) IsSetBeta() bool {
+	return p.Beta != nil
+}
+
+func (p *GetTestRequest) IsSetPhone() bool {
+	return p.Phone != nil
+}
+
+func (p *GetTestRequest) IsSetIsLogin() bool {
+	return p.IsLogin != nil
+}
+
+func (p *GetTestRequest) IsSetLoginType() bool {
+	return p.LoginType != nil
+}
+
+func (p *GetTestRequest) IsSetSetting() bool {
+	return p.Setting != nil
+}
+
+func (p *GetTestRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetTestRequest(%+v)", *p)
+}
+
+type GetTestResponse struct {
+	Base         *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+	CloudSetting []byte          `thrift:"cloud_setting,2" form:"cloud_setting" json:"cloud_setting" query:"cloud_setting"`
+}
+
+func NewGetTestResponse() *GetTestResponse {
+	return &GetTestResponse{}
+}
+
+func (p *GetTestResponse) InitDefault() {
+}
+
+var GetTestResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetTestResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetTestResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetTestResponse) GetCloudSetting() (v []byte) {
+	return p.CloudSetting
 }
 
-func (p *GetCreditRequest) InitDefault() {
+func (p *GetTestResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *GetCreditRequest) String() string {
+func (p *GetTestResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetCreditRequest(%+v)", *p)
-}
-
-type GetCreditResponse struct {
-	Major []*model.Credit `thrift:"major,1,required,list<model.Credit>" form:"major,required" json:"major,required" query:"major,required"`
+	return fmt.Sprintf("GetTestResponse(%+v)", *p)
 }
 
-func NewGetCreditResponse() *GetCreditResponse {
-	return &GetCreditResponse{}
+type GetCloudRequest struct {
 }
 
-func (p *GetCreditResponse) InitDefault() {
+func NewGetCloudRequest() *GetCloudRequest {
+	return &GetCloudRequest{}
 }
 
-func (p *GetCreditResponse) GetMajor() (v []*model.Credit) {
-	return p.Major
+func (p *GetCloudRequest) InitDefault() {
 }
 
-func (p *GetCreditResponse) String() string {
+func (p *GetCloudRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetCreditResponse(%+v)", *p)
+	return fmt.Sprintf("GetCloudRequest(%+v)", *p)
 }
 
-type GetUnifiedExamRequest struct {
+type GetCloudResponse struct {
+	Base         *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
+	CloudSetting []byte          `thrift:"cloud_setting,2" form:"cloud_setting" json:"cloud_setting" query:"cloud_setting"`
 }
 
-func NewGetUnifiedExamRequest() *GetUnifiedExamRequest {
-	return &GetUnifiedExamRequest{}
+func NewGetCloudResponse() *GetCloudResponse {
+	return &GetCloudResponse{}
 }
 
-func (p *GetUnifiedExamRequest) InitDefault() {
+func (p *GetCloudResponse) InitDefault() {
 }
 
-func (p *GetUnifiedExamRequest) String() string {
-	if p == nil {
-		return "<nil>"
+var GetCloudResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetCloudResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetCloudResponse_Base_DEFAULT
 	}
-	return fmt.Sprintf("GetUnifiedExamRequest(%+v)", *p)
+	return p.Base
 }
 
-type GetUnifiedExamResponse struct {
-	UnifiedExam []*model.UnifiedExam `thrift:"unifiedExam,1,required,list<model.UnifiedExam>" form:"unifiedExam,required" json:"unifiedExam,required" query:"unifiedExam,required"`
+func (p *GetCloudResponse) GetCloudSetting() (v []byte) {
+	return p.CloudSetting
 }
 
-func NewGetUnifiedExamResponse() *GetUnifiedExamResponse {
-	return &GetUnifiedExamResponse{}
+func (p *GetCloudResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *GetUnifiedExamResponse) InitDefault() {
+func (p *GetCloudResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCloudResponse(%+v)", *p)
 }
 
-func (p *GetUnifiedExamResponse) GetUnifiedExam() (v []*model.UnifiedExam) {
-	return p.UnifiedExam
+type SetCloudRequest struct {
+	Password string `thrift:"password,1,required" form:"password,required" json:"password,required" query:"password,required"`
+	Setting  string `thrift:"setting,2,required" form:"setting,required" json:"setting,required" query:"setting,required"`
 }
 
-func (p *GetUnifiedExamResponse) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("GetUnifiedExamResponse(%+v)", *p)
+func NewSetCloudRequest() *SetCloudRequest {
+	return &SetCloudRequest{}
 }
 
-type GetCreditV2Request struct {
+func (p *SetCloudRequest) InitDefault() {
 }
 
-func NewGetCreditV2Request() *GetCreditV2Request {
-	return &GetCreditV2Request{}
+func (p *SetCloudRequest) GetPassword() (v string) {
+	return p.Password
 }
 
-func (p *GetCreditV2Request) InitDefault() {
+func (p *SetCloudRequest) GetSetting() (v string) {
+	return p.Setting
 }
 
-func (p *GetCreditV2Request) String() string {
+func (p *SetCloudRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetCreditV2Request(%+v)", *p)
+	return fmt.Sprintf("SetCloudRequest(%+v)", *p)
 }
 
-type GetCreditV2Response struct {
-	Base   *model.BaseResp      `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
-	Credit model.CreditResponse `thrift:"credit,2,optional,list<model.CreditCategory>" form:"credit" json:"credit,omitempty" query:"credit"`
+type SetCloudResponse struct {
+	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
 }
 
-func NewGetCreditV2Response() *GetCreditV2Response {
-	return &GetCreditV2Response{}
+func NewSetCloudResponse() *SetCloudResponse {
+	return &SetCloudResponse{}
 }
 
-func (p *GetCreditV2Response) InitDefault() {
+func (p *SetCloudResponse) InitDefault() {
 }
 
-var GetCreditV2Response_Base_DEFAULT *model.BaseResp
+var SetCloudResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetCreditV2Response) GetBase() (v *model.BaseResp) {
+func (p *SetCloudResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return GetCreditV2Response_Base_DEFAULT
+		return SetCloudResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var GetCreditV2Response_Credit_DEFAULT model.CreditResponse
+func (p *SetCloudResponse) IsSetBase() bool {
+	return p.Base != nil
+}
 
-func (p *GetCreditV2Response) GetCredit() (v model.CreditResponse) {
-	if !p.IsSetCredit() {
-		return GetCreditV2Response_Credit_DEFAULT
+func (p *SetCloudResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Credit
+	return fmt.Sprintf("SetCloudResponse(%+v)", *p)
 }
 
-func (p *GetCreditV2Response) IsSetBase() bool {
-	return p.Base != nil
+type GetDumpRequest struct {
 }
 
-func (p *GetCreditV2Response) IsSetCredit() bool {
-	return p.Credit != nil
+func NewGetDumpRequest() *GetDumpRequest {
+	return &GetDumpRequest{}
 }
 
-func (p *GetCreditV2Response) String() string {
+func (p *GetDumpRequest) InitDefault() {
+}
+
+func (p *GetDumpRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetCreditV2Response(%+v)", *p)
+	return fmt.Sprintf("GetDumpRequest(%+v)", *p)
 }
 
-type GetPlanRequest struct {
-	ID      string `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	Cookies string `thrift:"cookies,2,required" form:"cookies,required" json:"cookies,required" query:"cookies,required"`
+type GetDumpResponse struct {
+	Base *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
+	Data string          `thrift:"data,2" form:"data" json:"data" query:"data"`
 }
 
-func NewGetPlanRequest() *GetPlanRequest {
-	return &GetPlanRequest{}
+func NewGetDumpResponse() *GetDumpResponse {
+	return &GetDumpResponse{}
 }
 
-func (p *GetPlanRequest) InitDefault() {
+func (p *GetDumpResponse) InitDefault() {
 }
 
-func (p *GetPlanRequest) GetID() (v string) {
-	return p.ID
+var GetDumpResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetDumpResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetDumpResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *GetPlanRequest) GetCookies() (v string) {
-	return p.Cookies
+func (p *GetDumpResponse) GetData() (v string) {
+	return p.Data
 }
 
-func (p *GetPlanRequest) String() string {
+func (p *GetDumpResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetDumpResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetPlanRequest(%+v)", *p)
+	return fmt.Sprintf("GetDumpResponse(%+v)", *p)
 }
 
-type GetPlanResponse struct {
-	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+type AndroidGetVersioneRequest struct {
+	// 设备标识，优先用于灰度分桶
+	DeviceID *string `thrift:"device_id,1,optional" form:"device_id" json:"device_id,omitempty" query:"device_id"`
+	// 学号，未提供设备标识时用于灰度分桶
+	StuID *string `thrift:"stu_id,2,optional" form:"stu_id" json:"stu_id,omitempty" query:"stu_id"`
+	// 客户端当前版本号，低于最低支持版本时强制更新
+	Code *string `thrift:"code,3,optional" form:"code" json:"code,omitempty" query:"code"`
 }
 
-func NewGetPlanResponse() *GetPlanResponse {
-	return &GetPlanResponse{}
+func NewAndroidGetVersioneRequest() *AndroidGetVersioneRequest {
+	return &AndroidGetVersioneRequest{}
 }
 
-func (p *GetPlanResponse) InitDefault() {
+func (p *AndroidGetVersioneRequest) InitDefault() {
 }
 
-var GetPlanResponse_Base_DEFAULT *model.BaseResp
+var AndroidGetVersioneRequest_DeviceID_DEFAULT string
 
-func (p *GetPlanResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return GetPlanResponse_Base_DEFAULT
+func (p *AndroidGetVersioneRequest) GetDeviceID() (v string) {
+	if !p.IsSetDeviceID() {
+		return AndroidGetVersioneRequest_DeviceID_DEFAULT
 	}
-	return p.Base
+	return *p.DeviceID
 }
 
-func (p *GetPlanResponse) IsSetBase() bool {
-	return p.Base != nil
-}
+var AndroidGetVersioneRequest_StuID_DEFAULT string
 
-func (p *GetPlanResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *AndroidGetVersioneRequest) GetStuID() (v string) {
+	if !p.IsSetStuID() {
+		return AndroidGetVersioneRequest_StuID_DEFAULT
 	}
-	return fmt.Sprintf("GetPlanResponse(%+v)", *p)
+	return *p.StuID
 }
 
-// # ----------------------------------------------------------------------------
-// # version（原url，版本控制相关）
-// # ----------------------------------------------------------------------------
-type LoginRequest struct {
-	Password string `thrift:"password,1,required" form:"password,required" json:"password,required" query:"password,required"`
+var AndroidGetVersioneRequest_Code_DEFAULT string
+
+func (p *AndroidGetVersioneRequest) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return AndroidGetVersioneRequest_Code_DEFAULT
+	}
+	return *p.Code
 }
 
-func NewLoginRequest() *LoginRequest {
-	return &LoginRequest{}
+func (p *AndroidGetVersioneRequest) IsSetDeviceID() bool {
+	return p.DeviceID != nil
 }
 
-func (p *LoginRequest) InitDefault() {
+func (p *AndroidGetVersioneRequest) IsSetStuID() bool {
+	return p.StuID != nil
 }
 
-func (p *LoginRequest) GetPassword() (v string) {
-	return p.Password
+func (p *AndroidGetVersioneRequest) IsSetCode() bool {
+	return p.Code != nil
 }
 
-func (p *LoginRequest) String() string {
+func (p *AndroidGetVersioneRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LoginRequest(%+v)", *p)
+	return fmt.Sprintf("AndroidGetVersioneRequest(%+v)", *p)
 }
 
-type LoginResponse struct {
-	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+type AndroidGetVersionResponse struct {
+	Base    *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
+	Release *model.Version  `thrift:"release,2,optional" form:"release" json:"release,omitempty" query:"release"`
+	Beta    *model.Version  `thrift:"beta,3,optional" form:"beta" json:"beta,omitempty" query:"beta"`
 }
 
-func NewLoginResponse() *LoginResponse {
-	return &LoginResponse{}
+func NewAndroidGetVersionResponse() *AndroidGetVersionResponse {
+	return &AndroidGetVersionResponse{}
 }
 
-func (p *LoginResponse) InitDefault() {
+func (p *AndroidGetVersionResponse) InitDefault() {
 }
 
-var LoginResponse_Base_DEFAULT *model.BaseResp
+var AndroidGetVersionResponse_Base_DEFAULT *model.BaseResp
 
-func (p *LoginResponse) GetBase() (v *model.BaseResp) {
+func (p *AndroidGetVersionResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return LoginResponse_Base_DEFAULT
+		return AndroidGetVersionResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *LoginResponse) IsSetBase() bool {
-	return p.Base != nil
+var AndroidGetVersionResponse_Release_DEFAULT *model.Version
+
+func (p *AndroidGetVersionResponse) GetRelease() (v *model.Version) {
+	if !p.IsSetRelease() {
+		return AndroidGetVersionResponse_Release_DEFAULT
+	}
+	return p.Release
 }
 
-func (p *LoginResponse) String() string {
-	if p == nil {
-		return "<nil>"
+var AndroidGetVersionResponse_Beta_DEFAULT *model.Version
+
+func (p *AndroidGetVersionResponse) GetBeta() (v *model.Version) {
+	if !p.IsSetBeta() {
+		return AndroidGetVersionResponse_Beta_DEFAULT
 	}
-	return fmt.Sprintf("LoginResponse(%+v)", *p)
+	return p.Beta
 }
 
-type UploadRequest struct {
-	Version  string `thrift:"version,1,required" form:"version,required" json:"version,required" query:"version,required"`
-	Code     string `thrift:"code,2,required" form:"code,required" json:"code,required" query:"code,required"`
-	URL      string `thrift:"url,3,required" form:"url,required" json:"url,required" query:"url,required"`
-	Feature  string `thrift:"feature,4,required" form:"feature,required" json:"feature,required" query:"feature,required"`
-	Type     string `thrift:"type,5,required" form:"type,required" json:"type,required" query:"type,required"`
-	Password string `thrift:"password,6,required" form:"password,required" json:"password,required" query:"password,required"`
-	Force    bool   `thrift:"force,7,required" form:"force,required" json:"force,required" query:"force,required"`
+func (p *AndroidGetVersionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *AndroidGetVersionResponse) IsSetRelease() bool {
+	return p.Release != nil
 }
 
-func NewUploadRequest() *UploadRequest {
-	return &UploadRequest{}
+func (p *AndroidGetVersionResponse) IsSetBeta() bool {
+	return p.Beta != nil
 }
 
-func (p *UploadRequest) InitDefault() {
+func (p *AndroidGetVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AndroidGetVersionResponse(%+v)", *p)
 }
 
-func (p *UploadRequest) GetVersion() (v string) {
-	return p.Version
+type RollbackVersionRequest struct {
+	Password string `thrift:"password,1,required" form:"password,required" json:"password,required" query:"password,required"`
+	// release / beta / nightly
+	Type string `thrift:"type,2,required" form:"type,required" json:"type,required" query:"type,required"`
+	// 默认 android
+	Platform *string `thrift:"platform,3,optional" form:"platform" json:"platform,omitempty" query:"platform"`
 }
 
-func (p *UploadRequest) GetCode() (v string) {
-	return p.Code
+func NewRollbackVersionRequest() *RollbackVersionRequest {
+	return &RollbackVersionRequest{}
 }
 
-func (p *UploadRequest) GetURL() (v string) {
-	return p.URL
+func (p *RollbackVersionRequest) InitDefault() {
 }
 
-func (p *UploadRequest) GetFeature() (v string) {
-	return p.Feature
+func (p *RollbackVersionRequest) GetPassword() (v string) {
+	return p.Password
 }
 
-func (p *UploadRequest) GetType() (v string) {
+func (p *RollbackVersionRequest) GetType() (v string) {
 	return p.Type
 }
 
-func (p *UploadRequest) GetPassword() (v string) {
-	return p.Password
+var RollbackVersionRequest_Platform_DEFAULT string
+
+func (p *RollbackVersionRequest) GetPlatform() (v string) {
+	if !p.IsSetPlatform() {
+		return RollbackVersionRequest_Platform_DEFAULT
+	}
+	return *p.Platform
 }
 
-func (p *UploadRequest) GetForce() (v bool) {
-	return p.Force
+func (p *RollbackVersionRequest) IsSetPlatform() bool {
+	return p.Platform != nil
 }
 
-func (p *UploadRequest) String() string {
+func (p *RollbackVersionRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UploadRequest(%+v)", *p)
+	return fmt.Sprintf("RollbackVersionRequest(%+v)", *p)
 }
 
-type UploadResponse struct {
+type RollbackVersionResponse struct {
 	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+	// 回滚后生效的版本
+	Data *model.Version `thrift:"data,2,optional" form:"data" json:"data,omitempty" query:"data"`
 }
 
-func NewUploadResponse() *UploadResponse {
-	return &UploadResponse{}
+func NewRollbackVersionResponse() *RollbackVersionResponse {
+	return &RollbackVersionResponse{}
 }
 
-func (p *UploadResponse) InitDefault() {
+func (p *RollbackVersionResponse) InitDefault() {
 }
 
-var UploadResponse_Base_DEFAULT *model.BaseResp
+var RollbackVersionResponse_Base_DEFAULT *model.BaseResp
 
-func (p *UploadResponse) GetBase() (v *model.BaseResp) {
+func (p *RollbackVersionResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return UploadResponse_Base_DEFAULT
+		return RollbackVersionResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *UploadResponse) IsSetBase() bool {
-	return p.Base != nil
-}
+var RollbackVersionResponse_Data_DEFAULT *model.Version
 
-func (p *UploadResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *RollbackVersionResponse) GetData() (v *model.Version) {
+	if !p.IsSetData() {
+		return RollbackVersionResponse_Data_DEFAULT
 	}
-	return fmt.Sprintf("UploadResponse(%+v)", *p)
-}
-
-type UploadParamsRequest struct {
-	Password string `thrift:"password,1,required" form:"password,required" json:"password,required" query:"password,required"`
-}
-
-func NewUploadParamsRequest() *UploadParamsRequest {
-	return &UploadParamsRequest{}
+	return p.Data
 }
 
-func (p *UploadParamsRequest) InitDefault() {
+func (p *RollbackVersionResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *UploadParamsRequest) GetPassword() (v string) {
-	return p.Password
+func (p *RollbackVersionResponse) IsSetData() bool {
+	return p.Data != nil
 }
 
-func (p *UploadParamsRequest) String() string {
+func (p *RollbackVersionResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UploadParamsRequest(%+v)", *p)
+	return fmt.Sprintf("RollbackVersionResponse(%+v)", *p)
 }
 
-type UploadParamsResponse struct {
-	Base          *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
-	Policy        *string         `thrift:"policy,2,optional" form:"policy" json:"policy,omitempty" query:"policy"`
-	Authorization *string         `thrift:"authorization,3,optional" form:"authorization" json:"authorization,omitempty" query:"authorization"`
+type ListVersionsRequest struct {
+	// release / beta / nightly
+	Channel string `thrift:"channel,1,required" form:"channel,required" json:"channel,required" query:"channel,required"`
+	// 默认 android
+	Platform *string `thrift:"platform,2,optional" form:"platform" json:"platform,omitempty" query:"platform"`
+	// 页码，默认为 1
+	PageNum *int64 `thrift:"page_num,3,optional" form:"page_num" json:"page_num,omitempty" query:"page_num"`
+	// 每页条数，默认为 20，最大为 100
+	PageSize *int64 `thrift:"page_size,4,optional" form:"page_size" json:"page_size,omitempty" query:"page_size"`
 }
 
-func NewUploadParamsResponse() *UploadParamsResponse {
-	return &UploadParamsResponse{}
+func NewListVersionsRequest() *ListVersionsRequest {
+	return &ListVersionsRequest{}
 }
 
-func (p *UploadParamsResponse) InitDefault() {
+func (p *ListVersionsRequest) InitDefault() {
 }
 
-var UploadParamsResponse_Base_DEFAULT *model.BaseResp
+func (p *ListVersionsRequest) GetChannel() (v string) {
+	return p.Channel
+}
 
-func (p *UploadParamsResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return UploadParamsResponse_Base_DEFAULT
+var ListVersionsRequest_Platform_DEFAULT string
+
+func (p *ListVersionsRequest) GetPlatform() (v string) {
+	if !p.IsSetPlatform() {
+		return ListVersionsRequest_Platform_DEFAULT
 	}
-	return p.Base
+	return *p.Platform
 }
 
-var UploadParamsResponse_Policy_DEFAULT string
+var ListVersionsRequest_PageNum_DEFAULT int64
 
-func (p *UploadParamsResponse) GetPolicy() (v string) {
-	if !p.IsSetPolicy() {
-		return UploadParamsResponse_Policy_DEFAULT
+func (p *ListVersionsRequest) GetPageNum() (v int64) {
+	if !p.IsSetPageNum() {
+		return ListVersionsRequest_PageNum_DEFAULT
 	}
-	return *p.Policy
+	return *p.PageNum
 }
 
-var UploadParamsResponse_Authorization_DEFAULT string
+var ListVersionsRequest_PageSize_DEFAULT int64
 
-func (p *UploadParamsResponse) GetAuthorization() (v string) {
-	if !p.IsSetAuthorization() {
-		return UploadParamsResponse_Authorization_DEFAULT
+func (p *ListVersionsRequest) GetPageSize() (v int64) {
+	if !p.IsSetPageSize() {
+		return ListVersionsRequest_PageSize_DEFAULT
 	}
-	return *p.Authorization
+	return *p.PageSize
 }
 
-func (p *UploadParamsResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *ListVersionsRequest) IsSetPlatform() bool {
+	return p.Platform != nil
 }
 
-func (p *UploadParamsResponse) IsSetPolicy() bool {
-	return p.Policy != nil
+func (p *ListVersionsRequest) IsSetPageNum() bool {
+	return p.PageNum != nil
 }
 
-func (p *UploadParamsResponse) IsSetAuthorization() bool {
-	return p.Authorization != nil
+func (p *ListVersionsRequest) IsSetPageSize() bool {
+	return p.PageSize != nil
 }
 
-func (p *UploadParamsResponse) String() string {
+func (p *ListVersionsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UploadParamsResponse(%+v)", *p)
+	return fmt.Sprintf("ListVersionsRequest(%+v)", *p)
 }
 
-type DownloadReleaseApkRequest struct {
-}
-
-func NewDownloadReleaseApkRequest() *DownloadReleaseApkRequest {
-	return &DownloadReleaseApkRequest{}
+type ListVersionsResponse struct {
+	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+	// 按版本号从新到旧排列，包括已回滚的版本
+	Data  []*model.Version `thrift:"data,2,default,list<model.Version>" form:"data" json:"data" query:"data"`
+	Total int64            `thrift:"total,3" form:"total" json:"total" query:"total"`
 }
 
-func (p *DownloadReleaseApkRequest) InitDefault() {
+func NewListVersionsResponse() *ListVersionsResponse {
+	return &ListVersionsResponse{}
 }
 
-func (p *DownloadReleaseApkRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("DownloadReleaseApkRequest(%+v)", *p)
+func (p *ListVersionsResponse) InitDefault() {
 }
 
-type DownloadReleaseApkResponse struct {
-	File []byte `thrift:"file,1,optional" form:"file" json:"file,omitempty" query:"file"`
-}
+var ListVersionsResponse_Base_DEFAULT *model.BaseResp
 
-func NewDownloadReleaseApkResponse() *DownloadReleaseApkResponse {
-	return &DownloadReleaseApkResponse{}
+func (p *ListVersionsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListVersionsResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *DownloadReleaseApkResponse) InitDefault() {
+func (p *ListVersionsResponse) GetData() (v []*model.Version) {
+	return p.Data
 }
 
-var DownloadReleaseApkResponse_File_DEFAULT []byte
-
-func (p *DownloadReleaseApkResponse) GetFile() (v []byte) {
-	if !p.IsSetFile() {
-		return DownloadReleaseApkResponse_File_DEFAULT
-	}
-	return p.File
+func (p *ListVersionsResponse) GetTotal() (v int64) {
+	return p.Total
 }
 
-func (p *DownloadReleaseApkResponse) IsSetFile() bool {
-	return p.File != nil
+func (p *ListVersionsResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *DownloadReleaseApkResponse) String() string {
+func (p *ListVersionsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DownloadReleaseApkResponse(%+v)", *p)
+	return fmt.Sprintf("ListVersionsResponse(%+v)", *p)
 }
 
-type DownloadBetaApkRequest struct {
+type GetVersionChangelogRequest struct {
+	// release / beta / nightly
+	Channel string `thrift:"channel,1,required" form:"channel,required" json:"channel,required" query:"channel,required"`
+	// 客户端当前版本号
+	Code string `thrift:"code,2,required" form:"code,required" json:"code,required" query:"code,required"`
+	// 默认 android
+	Platform *string `thrift:"platform,3,optional" form:"platform" json:"platform,omitempty" query:"platform"`
+	// 设备标识，用于确定请求者在灰度下能更新到的版本
+	DeviceID *string `thrift:"device_id,4,optional" form:"device_id" json:"device_id,omitempty" query:"device_id"`
+	// 学号，未提供设备标识时使用
+	StuID *string `thrift:"stu_id,5,optional" form:"stu_id" json:"stu_id,omitempty" query:"stu_id"`
 }
 
-func NewDownloadBetaApkRequest() *DownloadBetaApkRequest {
-	return &DownloadBetaApkRequest{}
+func NewGetVersionChangelogRequest() *GetVersionChangelogRequest {
+	return &GetVersionChangelogRequest{}
 }
 
-func (p *DownloadBetaApkRequest) InitDefault() {
+func (p *GetVersionChangelogRequest) InitDefault() {
 }
 
-func (p *DownloadBetaApkRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("DownloadBetaApkRequest(%+v)", *p)
+func (p *GetVersionChangelogRequest) GetChannel() (v string) {
+	return p.Channel
 }
 
-type DownloadBetaApkResponse struct {
-	File []byte `thrift:"file,1,optional" form:"file" json:"file,omitempty" query:"file"`
+func (p *GetVersionChangelogRequest) GetCode() (v string) {
+	return p.Code
 }
 
-func NewDownloadBetaApkResponse() *DownloadBetaApkResponse {
-	return &DownloadBetaApkResponse{}
-}
+var GetVersionChangelogRequest_Platform_DEFAULT string
 
-func (p *DownloadBetaApkResponse) InitDefault() {
+func (p *GetVersionChangelogRequest) GetPlatform() (v string) {
+	if !p.IsSetPlatform() {
+		return GetVersionChangelogRequest_Platform_DEFAULT
+	}
+	return *p.Platform
 }
 
-var DownloadBetaApkResponse_File_DEFAULT []byte
+var GetVersionChangelogRequest_DeviceID_DEFAULT string
 
-func (p *DownloadBetaApkResponse) GetFile() (v []byte) {
-	if !p.IsSetFile() {
-		return DownloadBetaApkResponse_File_DEFAULT
+func (p *GetVersionChangelogRequest) GetDeviceID() (v string) {
+	if !p.IsSetDeviceID() {
+		return GetVersionChangelogRequest_DeviceID_DEFAULT
 	}
-	return p.File
+	return *p.DeviceID
 }
 
-func (p *DownloadBetaApkResponse) IsSetFile() bool {
-	return p.File != nil
-}
+var GetVersionChangelogRequest_StuID_DEFAULT string
 
-func (p *DownloadBetaApkResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetVersionChangelogRequest) GetStuID() (v string) {
+	if !p.IsSetStuID() {
+		return GetVersionChangelogRequest_StuID_DEFAULT
 	}
-	return fmt.Sprintf("DownloadBetaApkResponse(%+v)", *p)
+	return *p.StuID
 }
 
-type GetReleaseVersionRequest struct {
+func (p *GetVersionChangelogRequest) IsSetPlatform() bool {
+	return p.Platform != nil
 }
 
-func NewGetReleaseVersionRequest() *GetReleaseVersionRequest {
-	return &GetReleaseVersionRequest{}
+func (p *GetVersionChangelogRequest) IsSetDeviceID() bool {
+	return p.DeviceID != nil
 }
 
-func (p *GetReleaseVersionRequest) InitDefault() {
+func (p *GetVersionChangelogRequest) IsSetStuID() bool {
+	return p.StuID != nil
 }
 
-func (p *GetReleaseVersionRequest) String() string {
+func (p *GetVersionChangelogRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetReleaseVersionRequest(%+v)", *p)
+	return fmt.Sprintf("GetVersionChangelogRequest(%+v)", *p)
 }
 
-type GetReleaseVersionResponse struct {
-	Base    *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
-	Code    *string         `thrift:"code,2,optional" form:"code" json:"code,omitempty" query:"code"`
-	Feature *string         `thrift:"feature,3,optional" form:"feature" json:"feature,omitempty" query:"feature"`
-	URL     *string         `thrift:"url,4,optional" form:"url" json:"url,omitempty" query:"url"`
-	Version *string         `thrift:"version,5,optional" form:"version" json:"version,omitempty" query:"version"`
-	Force   *bool           `thrift:"force,6,optional" form:"force" json:"force,omitempty" query:"force"`
+type GetVersionChangelogResponse struct {
+	Base *model.BaseResp         `thrift:"base,1" form:"base" json:"base" query:"base"`
+	Data *model.VersionChangelog `thrift:"data,2,optional" form:"data" json:"data,omitempty" query:"data"`
 }
 
-func NewGetReleaseVersionResponse() *GetReleaseVersionResponse {
-	return &GetReleaseVersionResponse{}
+func NewGetVersionChangelogResponse() *GetVersionChangelogResponse {
+	return &GetVersionChangelogResponse{}
 }
 
-func (p *GetReleaseVersionResponse) InitDefault() {
+func (p *GetVersionChangelogResponse) InitDefault() {
 }
 
-var GetReleaseVersionResponse_Base_DEFAULT *model.BaseResp
+var GetVersionChangelogResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetReleaseVersionResponse) GetBase() (v *model.BaseResp) {
+func (p *GetVersionChangelogResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return GetReleaseVersionResponse_Base_DEFAULT
+		return GetVersionChangelogResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var GetReleaseVersionResponse_Code_DEFAULT string
-
-func (p *GetReleaseVersionResponse) GetCode() (v string) {
-	if !p.IsSetCode() {
-		return GetReleaseVersionResponse_Code_DEFAULT
-	}
-	return *p.Code
-}
-
-var GetReleaseVersionResponse_Feature_DEFAULT string
-
-func (p *GetReleaseVersionResponse) GetFeature() (v string) {
-	if !p.IsSetFeature() {
-		return GetReleaseVersionResponse_Feature_DEFAULT
-	}
-	return *p.Feature
-}
-
-var GetReleaseVersionResponse_URL_DEFAULT string
-
-func (p *GetReleaseVersionResponse) GetURL() (v string) {
-	if !p.IsSetURL() {
-		return GetReleaseVersionResponse_URL_DEFAULT
-	}
-	return *p.URL
-}
-
-var GetReleaseVersionResponse_Version_DEFAULT string
-
-func (p *GetReleaseVersionResponse) GetVersion() (v string) {
-	if !p.IsSetVersion() {
-		return GetReleaseVersionResponse_Version_DEFAULT
-	}
-	return *p.Version
-}
-
-var GetReleaseVersionResponse_Force_DEFAULT bool
+var GetVersionChangelogResponse_Data_DEFAULT *model.VersionChangelog
 
-func (p *GetReleaseVersionResponse) GetForce() (v bool) {
-	if !p.IsSetForce() {
-		return GetReleaseVersionResponse_Force_DEFAULT
+func (p *GetVersionChangelogResponse) GetData() (v *model.VersionChangelog) {
+	if !p.IsSetData() {
+		return GetVersionChangelogResponse_Data_DEFAULT
 	}
-	return *p.Force
+	return p.Data
 }
 
-func (p *GetReleaseVersionResponse) IsSetBase() bool {
+func (p *GetVersionChangelogResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetReleaseVersionResponse) IsSetCode() bool {
-	return p.Code != nil
-}
-
-func (p *GetReleaseVersionResponse) IsSetFeature() bool {
-	return p.Feature != nil
-}
-
-func (p *GetReleaseVersionResponse) IsSetURL() bool {
-	return p.URL != nil
-}
-
-func (p *GetReleaseVersionResponse) IsSetVersion() bool {
-	return p.Version != nil
-}
-
-func (p *GetReleaseVersionResponse) IsSetForce() bool {
-	return p.Force != nil
+func (p *GetVersionChangelogResponse) IsSetData() bool {
+	return p.Data != nil
 }
 
-func (p *GetReleaseVersionResponse) String() string {
+func (p *GetVersionChangelogResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetReleaseVersionResponse(%+v)", *p)
+	return fmt.Sprintf("GetVersionChangelogResponse(%+v)", *p)
 }
 
-type GetBetaVersionRequest struct {
+type GetLatestVersionRequest struct {
+	// android / ios / harmony
+	Platform string `thrift:"platform,1,required" form:"platform,required" json:"platform,required" query:"platform,required"`
+	// release / beta / nightly，默认 release
+	Channel *string `thrift:"channel,2,optional" form:"channel" json:"channel,omitempty" query:"channel"`
+	// 设备标识，优先用于灰度分桶
+	DeviceID *string `thrift:"device_id,3,optional" form:"device_id" json:"device_id,omitempty" query:"device_id"`
+	// 学号，未提供设备标识时用于灰度分桶
+	StuID *string `thrift:"stu_id,4,optional" form:"stu_id" json:"stu_id,omitempty" query:"stu_id"`
+	// 客户端当前版本号，用于判断是否需要强制更新
+	Code *string `thrift:"code,5,optional" form:"code" json:"code,omitempty" query:"code"`
 }
 
-func NewGetBetaVersionRequest() *GetBetaVersionRequest {
-	return &GetBetaVersionRequest{}
+func NewGetLatestVersionRequest() *GetLatestVersionRequest {
+	return &GetLatestVersionRequest{}
 }
 
-func (p *GetBetaVersionRequest) InitDefault() {
+func (p *GetLatestVersionRequest) InitDefault() {
 }
 
-func (p *GetBetaVersionRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("GetBetaVersionRequest(%+v)", *p)
+func (p *GetLatestVersionRequest) GetPlatform() (v string) {
+	return p.Platform
 }
 
-type GetBetaVersionResponse struct {
-	Base    *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
-	Code    *string         `thrift:"code,2,optional" form:"code" json:"code,omitempty" query:"code"`
-	Feature *string         `thrift:"feature,3,optional" form:"feature" json:"feature,omitempty" query:"feature"`
-	URL     *string         `thrift:"url,4,optional" form:"url" json:"url,omitempty" query:"url"`
-	Version *string         `thrift:"version,5,optional" form:"version" json:"version,omitempty" query:"version"`
-	Force   *bool           `thrift:"force,6,optional" form:"force" json:"force,omitempty" query:"force"`
-}
+var GetLatestVersionRequest_Channel_DEFAULT string
 
-func NewGetBetaVersionResponse() *GetBetaVersionResponse {
-	return &GetBetaVersionResponse{}
+func (p *GetLatestVersionRequest) GetChannel() (v string) {
+	if !p.IsSetChannel() {
+		return GetLatestVersionRequest_Channel_DEFAULT
+	}
+	return *p.Channel
 }
 
-func (p *GetBetaVersionResponse) InitDefault() {
+var GetLatestVersionRequest_DeviceID_DEFAULT string
+
+func (p *GetLatestVersionRequest) GetDeviceID() (v string) {
+	if !p.IsSetDeviceID() {
+		return GetLatestVersionRequest_DeviceID_DEFAULT
+	}
+	return *p.DeviceID
 }
 
-var GetBetaVersionResponse_Base_DEFAULT *model.BaseResp
+var GetLatestVersionRequest_StuID_DEFAULT string
 
-func (p *GetBetaVersionResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return GetBetaVersionResponse_Base_DEFAULT
+func (p *GetLatestVersionRequest) GetStuID() (v string) {
+	if !p.IsSetStuID() {
+		return GetLatestVersionRequest_StuID_DEFAULT
 	}
-	return p.Base
+	return *p.StuID
 }
 
-var GetBetaVersionResponse_Code_DEFAULT string
+var GetLatestVersionRequest_Code_DEFAULT string
 
-func (p *GetBetaVersionResponse) GetCode() (v string) {
+func (p *GetLatestVersionRequest) GetCode() (v string) {
 	if !p.IsSetCode() {
-		return GetBetaVersionResponse_Code_DEFAULT
+		return GetLatestVersionRequest_Code_DEFAULT
 	}
 	return *p.Code
 }
 
-var GetBetaVersionResponse_Feature_DEFAULT string
-
-func (p *GetBetaVersionResponse) GetFeature() (v string) {
-	if !p.IsSetFeature() {
-		return GetBetaVersionResponse_Feature_DEFAULT
-	}
-	return *p.Feature
+func (p *GetLatestVersionRequest) IsSetChannel() bool {
+	return p.Channel != nil
 }
 
-var GetBetaVersionResponse_URL_DEFAULT string
+func (p *GetLatestVersionRequest) IsSetDeviceID() bool {
+	return p.DeviceID != nil
+}
 
-func (p *GetBetaVersionResponse) GetURL() (v string) {
-	if !p.IsSetURL() {
-		return GetBetaVersionResponse_URL_DEFAULT
-	}
-	return *p.URL
+func (p *GetLatestVersionRequest) IsSetStuID() bool {
+	return p.StuID != nil
 }
 
-var GetBetaVersionResponse_Version_DEFAULT string
+func (p *GetLatestVersionRequest) IsSetCode() bool {
+	return p.Code != nil
+}
 
-func (p *GetBetaVersionResponse) GetVersion() (v string) {
-	if !p.IsSetVersion() {
-		return GetBetaVersionResponse_Version_DEFAULT
+func (p *GetLatestVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Version
+	return fmt.Sprintf("GetLatestVersionRequest(%+v)", *p)
 }
 
-var GetBetaVersionResponse_Force_DEFAULT bool
-
-func (p *GetBetaVersionResponse) GetForce() (v bool) {
-	if !p.IsSetForce() {
-		return GetBetaVersionResponse_Force_DEFAULT
-	}
-	return *p.Force
+type GetLatestVersionResponse struct {
+	Base *model.BaseResp      `thrift:"base,1" form:"base" json:"base" query:"base"`
+	Data *model.LatestVersion `thrift:"data,2,optional" form:"data" json:"data,omitempty" query:"data"`
 }
 
-func (p *GetBetaVersionResponse) IsSetBase() bool {
-	return p.Base != nil
+func NewGetLatestVersionResponse() *GetLatestVersionResponse {
+	return &GetLatestVersionResponse{}
 }
 
-func (p *GetBetaVersionResponse) IsSetCode() bool {
-	return p.Code != nil
+func (p *GetLatestVersionResponse) InitDefault() {
 }
 
-func (p *GetBetaVersionResponse) IsSetFeature() bool {
-	return p.Feature != nil
+var GetLatestVersionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetLatestVersionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetLatestVersionResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *GetBetaVersionResponse) IsSetURL() bool {
-	return p.URL != nil
+var GetLatestVersionResponse_Data_DEFAULT *model.LatestVersion
+
+func (p *GetLatestVersionResponse) GetData() (v *model.LatestVersion) {
+	if !p.IsSetData() {
+		return GetLatestVersionResponse_Data_DEFAULT
+	}
+	return p.Data
 }
 
-func (p *GetBetaVersionResponse) IsSetVersion() bool {
-	return p.Version != nil
+func (p *GetLatestVersionResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *GetBetaVersionResponse) IsSetForce() bool {
-	return p.Force != nil
+func (p *GetLatestVersionResponse) IsSetData() bool {
+	return p.Data != nil
 }
 
-func (p *GetBetaVersionResponse) String() string {
+func (p *GetLatestVersionResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetBetaVersionResponse(%+v)", *p)
+	return fmt.Sprintf("GetLatestVersionResponse(%+v)", *p)
 }
 
-type GetSettingRequest struct {
+type DryRunCloudSettingRequest struct {
 	Account   *string `thrift:"account,1,optional" form:"account" json:"account,omitempty" query:"account"`
 	Version   *string `thrift:"version,2,optional" form:"version" json:"version,omitempty" query:"version"`
 	Beta      *bool   `thrift:"beta,3,optional" form:"beta" json:"beta,omitempty" query:"beta"`
 	Phone     *string `thrift:"phone,4,optional" form:"phone" json:"phone,omitempty" query:"phone"`
 	IsLogin   *bool   `thrift:"isLogin,5,optional" form:"isLogin" json:"isLogin,omitempty" query:"isLogin"`
 	LoginType *string `thrift:"loginType,6,optional" form:"loginType" json:"loginType,omitempty" query:"loginType"`
+	// 待发布的配置，为空时使用当前生效的配置
+	Setting *string `thrift:"setting,7,optional" form:"setting" json:"setting,omitempty" query:"setting"`
 }
 
-func NewGetSettingRequest() *GetSettingRequest {
-	return &GetSettingRequest{}
+func NewDryRunCloudSettingRequest() *DryRunCloudSettingRequest {
+	return &DryRunCloudSettingRequest{}
 }
 
-func (p *GetSettingRequest) InitDefault() {
+func (p *DryRunCloudSettingRequest) InitDefault() {
 }
 
-var GetSettingRequest_Account_DEFAULT string
+var DryRunCloudSettingRequest_Account_DEFAULT string
 
-func (p *GetSettingRequest) GetAccount() (v string) {
+func (p *DryRunCloudSettingRequest) GetAccount() (v string) {
 	if !p.IsSetAccount() {
-		return GetSettingRequest_Account_DEFAULT
+		return DryRunCloudSettingRequest_Account_DEFAULT
 	}
 	return *p.Account
 }
 
-var GetSettingRequest_Version_DEFAULT string
+var DryRunCloudSettingRequest_Version_DEFAULT string
 
-func (p *GetSettingRequest) GetVersion() (v string) {
+func (p *DryRunCloudSettingRequest) GetVersion() (v string) {
 	if !p.IsSetVersion() {
-		return GetSettingRequest_Version_DEFAULT
+		return DryRunCloudSettingRequest_Version_DEFAULT
 	}
 	return *p.Version
 }
 
-var GetSettingRequest_Beta_DEFAULT bool
+var DryRunCloudSettingRequest_Beta_DEFAULT bool
 
-func (p *GetSettingRequest) GetBeta() (v bool) {
+func (p *DryRunCloudSettingRequest) GetBeta() (v bool) {
 	if !p.IsSetBeta() {
-		return GetSettingRequest_Beta_DEFAULT
+		return DryRunCloudSettingRequest_Beta_DEFAULT
 	}
 	return *p.Beta
 }
 
-var GetSettingRequest_Phone_DEFAULT string
+var DryRunCloudSettingRequest_Phone_DEFAULT string
 
-func (p *GetSettingRequest) GetPhone() (v string) {
+func (p *DryRunCloudSettingRequest) GetPhone() (v string) {
 	if !p.IsSetPhone() {
-		return GetSettingRequest_Phone_DEFAULT
+		return DryRunCloudSettingRequest_Phone_DEFAULT
 	}
 	return *p.Phone
 }
 
-var GetSettingRequest_IsLogin_DEFAULT bool
+var DryRunCloudSettingRequest_IsLogin_DEFAULT bool
 
-func (p *GetSettingRequest) GetIsLogin() (v bool) {
+func (p *DryRunCloudSettingRequest) GetIsLogin() (v bool) {
 	if !p.IsSetIsLogin() {
-		return GetSettingRequest_IsLogin_DEFAULT
+		return DryRunCloudSettingRequest_IsLogin_DEFAULT
 	}
 	return *p.IsLogin
 }
 
-var GetSettingRequest_LoginType_DEFAULT string
+var DryRunCloudSettingRequest_LoginType_DEFAULT string
 
-func (p *GetSettingRequest) GetLoginType() (v string) {
+func (p *DryRunCloudSettingRequest) GetLoginType() (v string) {
 	if !p.IsSetLoginType() {
-		return GetSettingRequest_LoginType_DEFAULT
+		return DryRunCloudSettingRequest_LoginType_DEFAULT
 	}
 	return *p.LoginType
 }
 
-func (p *GetSettingRequest) IsSetAccount() bool {
+var DryRunCloudSettingRequest_Setting_DEFAULT string
+
+func (p *DryRunCloudSettingRequest) GetSetting() (v string) {
+	if !p.IsSetSetting() {
+		return DryRunCloudSettingRequest_Setting_DEFAULT
+	}
+	return *p.Setting
+}
+
+func (p *DryRunCloudSettingRequest) IsSetAccount() bool {
 	return p.Account != nil
 }
 
-func (p *GetSettingRequest) IsSetVersion() bool {
+func (p *DryRunCloudSettingRequest) IsSetVersion() bool {
 	return p.Version != nil
 }
 
-func (p *GetSettingRequest) IsSetBeta() bool {
+func (p *DryRunCloudSettingRequest) IsSetBeta() bool {
 	return p.Beta != nil
 }
 
-func (p *GetSettingRequest) IsSetPhone() bool {
+func (p *DryRunCloudSettingRequest) IsSetPhone() bool {
 	return p.Phone != nil
 }
 
-func (p *GetSettingRequest) IsSetIsLogin() bool {
+func (p *DryRunCloudSettingRequest) IsSetIsLogin() bool {
 	return p.IsLogin != nil
 }
 
-func (p *GetSettingRequest) IsSetLoginType() bool {
+func (p *DryRunCloudSettingRequest) IsSetLoginType() bool {
 	return p.LoginType != nil
 }
 
-func (p *GetSettingRequest) String() string {
+func (p *DryRunCloudSettingRequest) IsSetSetting() bool {
+	return p.Setting != nil
+}
+
+func (p *DryRunCloudSettingRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetSettingRequest(%+v)", *p)
+	return fmt.Sprintf("DryRunCloudSettingRequest(%+v)", *p)
 }
 
-type GetSettingResponse struct {
-	Base         *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
-	CloudSetting []byte          `thrift:"cloud_setting,2" form:"cloud_setting" json:"cloud_setting" query:"cloud_setting"`
+type DryRunCloudSettingResponse struct {
+	Base *model.BaseResp           `thrift:"base,1" form:"base" json:"base" query:"base"`
+	Data *model.CloudSettingDryRun `thrift:"data,2,optional" form:"data" json:"data,omitempty" query:"data"`
 }
 
-func NewGetSettingResponse() *GetSettingResponse {
-	return &GetSettingResponse{}
+func NewDryRunCloudSettingResponse() *DryRunCloudSettingResponse {
+	return &DryRunCloudSettingResponse{}
 }
 
-func (p *GetSettingResponse) InitDefault() {
+func (p *DryRunCloudSettingResponse) InitDefault() {
 }
 
-var GetSettingResponse_Base_DEFAULT *model.BaseResp
+var DryRunCloudSettingResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetSettingResponse) GetBase() (v *model.BaseResp) {
+func (p *DryRunCloudSettingResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return GetSettingResponse_Base_DEFAULT
+		return DryRunCloudSettingResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *GetSettingResponse) GetCloudSetting() (v []byte) {
-	return p.CloudSetting
+var DryRunCloudSettingResponse_Data_DEFAULT *model.CloudSettingDryRun
+
+func (p *DryRunCloudSettingResponse) GetData() (v *model.CloudSettingDryRun) {
+	if !p.IsSetData() {
+		return DryRunCloudSettingResponse_Data_DEFAULT
+	}
+	return p.Data
 }
 
-func (p *GetSettingResponse) IsSetBase() bool {
+func (p *DryRunCloudSettingResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetSettingResponse) String() string {
+func (p *DryRunCloudSettingResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *DryRunCloudSettingResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetSettingResponse(%+v)", *p)
+	return fmt.Sprintf("DryRunCloudSettingResponse(%+v)", *p)
 }
 
-type GetTestRequest struct {
-	Account   *string `thrift:"account,1,optional" form:"account" json:"account,omitempty" query:"account"`
-	Version   *string `thrift:"version,2,optional" form:"version" json:"version,omitempty" query:"version"`
-	Beta      *bool   `thrift:"beta,3,optional" form:"beta" json:"beta,omitempty" query:"beta"`
-	Phone     *string `thrift:"phone,4,optional" form:"phone" json:"phone,omitempty" query:"phone"`
-	IsLogin   *bool   `thrift:"isLogin,5,optional" form:"isLogin" json:"isLogin,omitempty" query:"isLogin"`
-	LoginType *string `thrift:"loginType,6,optional" form:"loginType" json:"loginType,omitempty" query:"loginType"`
-	Setting   *string `thrift:"setting,7,optional" form:"setting" json:"setting,omitempty" query:"setting"`
+type GetCloudSettingCoverageRequest struct {
+	Password string `thrift:"password,1,required" form:"password,required" json:"password,required" query:"password,required"`
+	// 待发布的配置，为空时使用当前生效的配置
+	Setting *string `thrift:"setting,2,optional" form:"setting" json:"setting,omitempty" query:"setting"`
+	// 使用最近的多少条请求条件，默认且最多为 1000
+	Samples *int64 `thrift:"samples,3,optional" form:"samples" json:"samples,omitempty" query:"samples"`
+}
+
+func NewGetCloudSettingCoverageRequest() *GetCloudSettingCoverageRequest {
+	return &GetCloudSettingCoverageRequest{}
+}
+
+func (p *GetCloudSettingCoverageRequest) InitDefault() {
+}
+
+func (p *GetCloudSettingCoverageRequest) GetPassword() (v string) {
+	return p.Password
+}
+
+var GetCloudSettingCoverageRequest_Setting_DEFAULT string
+
+func (p *GetCloudSettingCoverageRequest) GetSetting() (v string) {
+	if !p.IsSetSetting() {
+		return GetCloudSettingCoverageRequest_Setting_DEFAULT
+	}
+	return *p.Setting
+}
+
+var GetCloudSettingCoverageRequest_Samples_DEFAULT int64
+
+func (p *GetCloudSettingCoverageRequest) GetSamples() (v int64) {
+	if !p.IsSetSamples() {
+		return GetCloudSettingCoverageRequest_Samples_DEFAULT
+	}
+	return *p.Samples
+}
+
+func (p *GetCloudSettingCoverageRequest) IsSetSetting() bool {
+	return p.Setting != nil
+}
+
+func (p *GetCloudSettingCoverageRequest) IsSetSamples() bool {
+	return p.Samples != nil
+}
+
+func (p *GetCloudSettingCoverageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCloudSettingCoverageRequest(%+v)", *p)
+}
+
+type GetCloudSettingCoverageResponse struct {
+	Base *model.BaseResp             `thrift:"base,1" form:"base" json:"base" query:"base"`
+	Data *model.CloudSettingCoverage `thrift:"data,2,optional" form:"data" json:"data,omitempty" query:"data"`
+}
+
+func NewGetCloudSettingCoverageResponse() *GetCloudSettingCoverageResponse {
+	return &GetCloudSettingCoverageResponse{}
+}
+
+func (p *GetCloudSettingCoverageResponse) InitDefault() {
+}
+
+var GetCloudSettingCoverageResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetCloudSettingCoverageResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetCloudSettingCoverageResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetCloudSettingCoverageResponse_Data_DEFAULT *model.CloudSettingCoverage
+
+func (p *GetCloudSettingCoverageResponse) GetData() (v *model.CloudSettingCoverage) {
+	if !p.IsSetData() {
+		return GetCloudSettingCoverageResponse_Data_DEFAULT
+	}
+	return p.Data
+}
+
+func (p *GetCloudSettingCoverageResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetCloudSettingCoverageResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *GetCloudSettingCoverageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCloudSettingCoverageResponse(%+v)", *p)
+}
+
+// # ----------------------------------------------------------------------------
+// # common（通用内容，如隐私政策等信息）
+// # ----------------------------------------------------------------------------
+type GetCSSRequest struct {
+}
+
+func NewGetCSSRequest() *GetCSSRequest {
+	return &GetCSSRequest{}
+}
+
+func (p *GetCSSRequest) InitDefault() {
+}
+
+func (p *GetCSSRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCSSRequest(%+v)", *p)
+}
+
+type GetCSSResponse struct {
+	CSS []byte `thrift:"css,1" form:"css" json:"css" query:"css"`
+}
+
+func NewGetCSSResponse() *GetCSSResponse {
+	return &GetCSSResponse{}
+}
+
+func (p *GetCSSResponse) InitDefault() {
+}
+
+func (p *GetCSSResponse) GetCSS() (v []byte) {
+	return p.CSS
+}
+
+func (p *GetCSSResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCSSResponse(%+v)", *p)
 }
 
-func NewGetTestRequest() *GetTestRequest {
-	return &GetTestRequest{}
+type GetHtmlRequest struct {
 }
 
-func (p *GetTestRequest) InitDefault() {
+func NewGetHtmlRequest() *GetHtmlRequest {
+	return &GetHtmlRequest{}
 }
 
-var GetTestRequest_Account_DEFAULT string
+func (p *GetHtmlRequest) InitDefault() {
+}
 
-func (p *GetTestRequest) GetAccount() (v string) {
-	if !p.IsSetAccount() {
-		return GetTestRequest_Account_DEFAULT
+func (p *GetHtmlRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Account
+	return fmt.Sprintf("GetHtmlRequest(%+v)", *p)
 }
 
-var GetTestRequest_Version_DEFAULT string
-
-func (p *GetTestRequest) GetVersion() (v string) {
-	if !p.IsSetVersion() {
-		return GetTestRequest_Version_DEFAULT
-	}
-	return *p.Version
+type GetHtmlResponse struct {
+	HTML []byte `thrift:"html,1" form:"html" json:"html" query:"html"`
 }
 
-var GetTestRequest_Beta_DEFAULT bool
+func NewGetHtmlResponse() *GetHtmlResponse {
+	return &GetHtmlResponse{}
+}
 
-func (p *GetTestRequest) GetBeta() (v bool) {
-	if !p.IsSetBeta() {
-		return GetTestRequest_Beta_DEFAULT
-	}
-	return *p.Beta
+func (p *GetHtmlResponse) InitDefault() {
 }
 
-var GetTestRequest_Phone_DEFAULT string
+func (p *GetHtmlResponse) GetHTML() (v []byte) {
+	return p.HTML
+}
 
-func (p *GetTestRequest) GetPhone() (v string) {
-	if !p.IsSetPhone() {
-		return GetTestRequest_Phone_DEFAULT
+func (p *GetHtmlResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Phone
+	return fmt.Sprintf("GetHtmlResponse(%+v)", *p)
 }
 
-var GetTestRequest_IsLogin_DEFAULT bool
+type GetUserAgreementRequest struct {
+}
 
-func (p *GetTestRequest) GetIsLogin() (v bool) {
-	if !p.IsSetIsLogin() {
-		return GetTestRequest_IsLogin_DEFAULT
-	}
-	return *p.IsLogin
+func NewGetUserAgreementRequest() *GetUserAgreementRequest {
+	return &GetUserAgreementRequest{}
 }
 
-var GetTestRequest_LoginType_DEFAULT string
+func (p *GetUserAgreementRequest) InitDefault() {
+}
 
-func (p *GetTestRequest) GetLoginType() (v string) {
-	if !p.IsSetLoginType() {
-		return GetTestRequest_LoginType_DEFAULT
+func (p *GetUserAgreementRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.LoginType
+	return fmt.Sprintf("GetUserAgreementRequest(%+v)", *p)
 }
 
-var GetTestRequest_Setting_DEFAULT string
-
-func (p *GetTestRequest) GetSetting() (v string) {
-	if !p.IsSetSetting() {
-		return GetTestRequest_Setting_DEFAULT
-	}
-	return *p.Setting
+type GetUserAgreementResponse struct {
+	UserAgreement []byte `thrift:"user_agreement,1" form:"user_agreement" json:"user_agreement" query:"user_agreement"`
 }
 
-func (p *GetTestRequest) IsSetAccount() bool {
-	return p.Account != nil
+func NewGetUserAgreementResponse() *GetUserAgreementResponse {
+	return &GetUserAgreementResponse{}
 }
 
-func (p *GetTestRequest) IsSetVersion() bool {
-	return p.Version != nil
+func (p *GetUserAgreementResponse) InitDefault() {
 }
 
-func (p *GetTestRequest) IsSetBeta() bool {
-	return p.Beta != nil
+func (p *GetUserAgreementResponse) GetUserAgreement() (v []byte) {
+	return p.UserAgreement
 }
 
-func (p *GetTestRequest) IsSetPhone() bool {
-	return p.Phone != nil
+func (p *GetUserAgreementResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserAgreementResponse(%+v)", *p)
 }
 
-func (p *GetTestRequest) IsSetIsLogin() bool {
-	return p.IsLogin != nil
+// 学期列表
+type TermListRequest struct {
 }
 
-func (p *GetTestRequest) IsSetLoginType() bool {
-	return p.LoginType != nil
+func NewTermListRequest() *TermListRequest {
+	return &TermListRequest{}
 }
 
-func (p *GetTestRequest) IsSetSetting() bool {
-	return p.Setting != nil
+func (p *TermListRequest) InitDefault() {
 }
 
-func (p *GetTestRequest) String() string {
+func (p *TermListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetTestRequest(%+v)", *p)
+	return fmt.Sprintf("TermListRequest(%+v)", *p)
 }
 
-type GetTestResponse struct {
-	Base         *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
-	CloudSetting []byte          `thrift:"cloud_setting,2" form:"cloud_setting" json:"cloud_setting" query:"cloud_setting"`
+type TermListResponse struct {
+	Base      *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
+	TermLists *model.TermList `thrift:"term_lists,2,required" form:"term_lists,required" json:"term_lists,required" query:"term_lists,required"`
 }
 
-func NewGetTestResponse() *GetTestResponse {
-	return &GetTestResponse{}
+func NewTermListResponse() *TermListResponse {
+	return &TermListResponse{}
 }
 
-func (p *GetTestResponse) InitDefault() {
+func (p *TermListResponse) InitDefault() {
 }
 
-var GetTestResponse_Base_DEFAULT *model.BaseResp
+var TermListResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetTestResponse) GetBase() (v *model.BaseResp) {
+func (p *TermListResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return GetTestResponse_Base_DEFAULT
+		return TermListResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *GetTestResponse) GetCloudSetting() (v []byte) {
-	return p.CloudSetting
+var TermListResponse_TermLists_DEFAULT *model.TermList
+
+func (p *TermListResponse) GetTermLists() (v *model.TermList) {
+	if !p.IsSetTermLists() {
+		return TermListResponse_TermLists_DEFAULT
+	}
+	return p.TermLists
 }
 
-func (p *GetTestResponse) IsSetBase() bool {
+func (p *TermListResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetTestResponse) String() string {
+func (p *TermListResponse) IsSetTermLists() bool {
+	return p.TermLists != nil
+}
+
+func (p *TermListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetTestResponse(%+v)", *p)
+	return fmt.Sprintf("TermListResponse(%+v)", *p)
 }
 
-type GetCloudRequest struct {
+// 学期信息
+type TermRequest struct {
+	Term string `thrift:"term,1,required" form:"term,required" json:"term,required" query:"term,required"`
 }
 
-func NewGetCloudRequest() *GetCloudRequest {
-	return &GetCloudRequest{}
+func NewTermRequest() *TermRequest {
+	return &TermRequest{}
 }
 
-func (p *GetCloudRequest) InitDefault() {
+func (p *TermRequest) InitDefault() {
 }
 
-func (p *GetCloudRequest) String() string {
+func (p *TermRequest) GetTerm() (v string) {
+	return p.Term
+}
+
+func (p *TermRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetCloudRequest(%+v)", *p)
+	return fmt.Sprintf("TermRequest(%+v)", *p)
 }
 
-type GetCloudResponse struct {
-	Base         *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
-	CloudSetting []byte          `thrift:"cloud_setting,2" form:"cloud_setting" json:"cloud_setting" query:"cloud_setting"`
+type TermResponse struct {
+	Base     *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
+	TermInfo *model.TermInfo `thrift:"term_info,2,required" form:"term_info,required" json:"term_info,required" query:"term_info,required"`
 }
 
-func NewGetCloudResponse() *GetCloudResponse {
-	return &GetCloudResponse{}
+func NewTermResponse() *TermResponse {
+	return &TermResponse{}
 }
 
-func (p *GetCloudResponse) InitDefault() {
+func (p *TermResponse) InitDefault() {
 }
 
-var GetCloudResponse_Base_DEFAULT *model.BaseResp
+var TermResponse_Base_DEFAULT *model.BaseResp
 
-func (p *GetCloudResponse) GetBase() (v *model.BaseResp) {
+func (p *TermResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return GetCloudResponse_Base_DEFAULT
+		return TermResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *GetCloudResponse) GetCloudSetting() (v []byte) {
-	return p.CloudSetting
+var TermResponse_TermInfo_DEFAULT *model.TermInfo
+
+func (p *TermResponse) GetTermInfo() (v *model.TermInfo) {
+	if !p.IsSetTermInfo() {
+		return TermResponse_TermInfo_DEFAULT
+	}
+	return p.TermInfo
 }
 
-func (p *GetCloudResponse) IsSetBase() bool {
+func (p *TermResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetCloudResponse) String() string {
+func (p *TermResponse) IsSetTermInfo() bool {
+	return p.TermInfo != nil
+}
+
+func (p *TermResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetCloudResponse(%+v)", *p)
+	return fmt.Sprintf("TermResponse(%+v)", *p)
 }
 
-type SetCloudRequest struct {
-	Password string `thrift:"password,1,required" form:"password,required" json:"password,required" query:"password,required"`
-	Setting  string `thrift:"setting,2,required" form:"setting,required" json:"setting,required" query:"setting,required"`
+type GetNoticeRequst struct {
+	PageNum int64   `thrift:"pageNum,1,required" form:"pageNum,required" json:"pageNum,required" query:"pageNum,required"`
+	Source  *string `thrift:"source,2,optional" form:"source" json:"source,omitempty" query:"source"`
 }
 
-func NewSetCloudRequest() *SetCloudRequest {
-	return &SetCloudRequest{}
+func NewGetNoticeRequst() *GetNoticeRequst {
+	return &GetNoticeRequst{}
 }
 
-func (p *SetCloudRequest) InitDefault() {
+func (p *GetNoticeRequst) InitDefault() {
 }
 
-func (p *SetCloudRequest) GetPassword() (v string) {
-	return p.Password
+func (p *GetNoticeRequst) GetPageNum() (v int64) {
+	return p.PageNum
 }
 
-func (p *SetCloudRequest) GetSetting() (v string) {
-	return p.Setting
+var GetNoticeRequst_Source_DEFAULT string
+
+func (p *GetNoticeRequst) GetSource() (v string) {
+	if !p.IsSetSource() {
+		return GetNoticeRequst_Source_DEFAULT
+	}
+	return *p.Source
 }
 
-func (p *SetCloudRequest) String() string {
+func (p *GetNoticeRequst) IsSetSource() bool {
+	return p.Source != nil
+}
+
+func (p *GetNoticeRequst) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SetCloudRequest(%+v)", *p)
+	return fmt.Sprintf("GetNoticeRequst(%+v)", *p)
 }
 
-type SetCloudResponse struct {
-	Base *model.BaseResp `thrift:"base,1" form:"base" json:"base" query:"base"`
+type ListNoticeSourcesRequest struct {
 }
 
-func NewSetCloudResponse() *SetCloudResponse {
-	return &SetCloudResponse{}
+func NewListNoticeSourcesRequest() *ListNoticeSourcesRequest {
+	return &ListNoticeSourcesRequest{}
 }
 
-func (p *SetCloudResponse) InitDefault() {
+func (p *ListNoticeSourcesRequest) InitDefault() {
 }
 
-var SetCloudResponse_Base_DEFAULT *model.BaseResp
+func (p *ListNoticeSourcesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNoticeSourcesRequest(%+v)", *p)
+}
 
-func (p *SetCloudResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return SetCloudResponse_Base_DEFAULT
+type ListNoticeSourcesResponse struct {
+	Sources []*model.NoticeSource `thrift:"sources,1,required,list<model.NoticeSource>" form:"sources,required" json:"sources,required" query:"sources,required"`
+}
+
+func NewListNoticeSourcesResponse() *ListNoticeSourcesResponse {
+	return &ListNoticeSourcesResponse{}
+}
+
+func (p *ListNoticeSourcesResponse) InitDefault() {
+}
+
+func (p *ListNoticeSourcesResponse) GetSources() (v []*model.NoticeSource) {
+	return p.Sources
+}
+
+func (p *ListNoticeSourcesResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Base
+	return fmt.Sprintf("ListNoticeSourcesResponse(%+v)", *p)
 }
 
-func (p *SetCloudResponse) IsSetBase() bool {
-	return p.Base != nil
+type GetNoticeResponse struct {
+	Notices []*model.NoticeInfo `thrift:"notices,1,required,list<model.NoticeInfo>" form:"notices,required" json:"notices,required" query:"notices,required"`
+	Total   int64               `thrift:"total,2,required" form:"total,required" json:"total,required" query:"total,required"`
 }
 
-func (p *SetCloudResponse) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("SetCloudResponse(%+v)", *p)
+func NewGetNoticeResponse() *GetNoticeResponse {
+	return &GetNoticeResponse{}
 }
 
-type GetDumpRequest struct {
+func (p *GetNoticeResponse) InitDefault() {
 }
 
-func NewGetDumpRequest() *GetDumpRequest {
-	return &GetDumpRequest{}
+func (p *GetNoticeResponse) GetNotices() (v []*model.NoticeInfo) {
+	return p.Notices
 }
 
-func (p *GetDumpRequest) InitDefault() {
+func (p *GetNoticeResponse) GetTotal() (v int64) {
+	return p.Total
 }
 
-func (p *GetDumpRequest) String() string {
+func (p *GetNoticeResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDumpRequest(%+v)", *p)
+	return fmt.Sprintf("GetNoticeResponse(%+v)", *p)
 }
 
-type GetDumpResponse struct {
-	Base *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
-	Data string          `thrift:"data,2" form:"data" json:"data" query:"data"`
+type SearchNoticesRequest struct {
+	Query     string  `thrift:"query,1,required" form:"query,required" json:"query,required" query:"query,required"`
+	StartDate *string `thrift:"startDate,2,optional" form:"startDate" json:"startDate,omitempty" query:"startDate"`
+	EndDate   *string `thrift:"endDate,3,optional" form:"endDate" json:"endDate,omitempty" query:"endDate"`
+	PageNum   *int64  `thrift:"pageNum,4,optional" form:"pageNum" json:"pageNum,omitempty" query:"pageNum"`
 }
 
-func NewGetDumpResponse() *GetDumpResponse {
-	return &GetDumpResponse{}
+func NewSearchNoticesRequest() *SearchNoticesRequest {
+	return &SearchNoticesRequest{}
 }
 
-func (p *GetDumpResponse) InitDefault() {
+func (p *SearchNoticesRequest) InitDefault() {
 }
 
-var GetDumpResponse_Base_DEFAULT *model.BaseResp
+func (p *SearchNoticesRequest) GetQuery() (v string) {
+	return p.Query
+}
 
-func (p *GetDumpResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return GetDumpResponse_Base_DEFAULT
+var SearchNoticesRequest_StartDate_DEFAULT string
+
+func (p *SearchNoticesRequest) GetStartDate() (v string) {
+	if !p.IsSetStartDate() {
+		return SearchNoticesRequest_StartDate_DEFAULT
 	}
-	return p.Base
+	return *p.StartDate
 }
 
-func (p *GetDumpResponse) GetData() (v string) {
-	return p.Data
-}
+var SearchNoticesRequest_EndDate_DEFAULT string
 
-func (p *GetDumpResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *SearchNoticesRequest) GetEndDate() (v string) {
+	if !p.IsSetEndDate() {
+		return SearchNoticesRequest_EndDate_DEFAULT
+	}
+	return *p.EndDate
 }
 
-func (p *GetDumpResponse) String() string {
-	if p == nil {
-		return "<nil>"
+var SearchNoticesRequest_PageNum_DEFAULT int64
+
+func (p *SearchNoticesRequest) GetPageNum() (v int64) {
+	if !p.IsSetPageNum() {
+		return SearchNoticesRequest_PageNum_DEFAULT
 	}
-	return fmt.Sprintf("GetDumpResponse(%+v)", *p)
+	return *p.PageNum
 }
 
-type AndroidGetVersioneRequest struct {
+func (p *SearchNoticesRequest) IsSetStartDate() bool {
+	return p.StartDate != nil
 }
 
-func NewAndroidGetVersioneRequest() *AndroidGetVersioneRequest {
-	return &AndroidGetVersioneRequest{}
+func (p *SearchNoticesRequest) IsSetEndDate() bool {
+	return p.EndDate != nil
 }
 
-func (p *AndroidGetVersioneRequest) InitDefault() {
+func (p *SearchNoticesRequest) IsSetPageNum() bool {
+	return p.PageNum != nil
 }
 
-func (p *AndroidGetVersioneRequest) String() string {
+func (p *SearchNoticesRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AndroidGetVersioneRequest(%+v)", *p)
+	return fmt.Sprintf("SearchNoticesRequest(%+v)", *p)
 }
 
-type AndroidGetVersionResponse struct {
-	Base    *model.BaseResp `thrift:"base,1,optional" form:"base" json:"base,omitempty" query:"base"`
-	Release *model.Version  `thrift:"release,2,optional" form:"release" json:"release,omitempty" query:"release"`
-	Beta    *model.Version  `thrift:"beta,3,optional" form:"beta" json:"beta,omitempty" query:"beta"`
+type SearchNoticesResponse struct {
+	Results []*model.NoticeSearchHit `thrift:"results,1,required,list<model.NoticeSearchHit>" form:"results,required" json:"results,required" query:"results,required"`
+	Total   int64                    `thrift:"total,2,required" form:"total,required" json:"total,required" query:"total,required"`
 }
 
-func NewAndroidGetVersionResponse() *AndroidGetVersionResponse {
-	return &AndroidGetVersionResponse{}
+func NewSearchNoticesResponse() *SearchNoticesResponse {
+	return &SearchNoticesResponse{}
 }
 
-func (p *AndroidGetVersionResponse) InitDefault() {
+func (p *SearchNoticesResponse) InitDefault() {
 }
 
-var AndroidGetVersionResponse_Base_DEFAULT *model.BaseResp
-
-func (p *AndroidGetVersionResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return AndroidGetVersionResponse_Base_DEFAULT
-	}
-	return p.Base
+func (p *SearchNoticesResponse) GetResults() (v []*model.NoticeSearchHit) {
+	return p.Results
 }
 
-var AndroidGetVersionResponse_Release_DEFAULT *model.Version
+func (p *SearchNoticesResponse) GetTotal() (v int64) {
+	return p.Total
+}
 
-func (p *AndroidGetVersionResponse) GetRelease() (v *model.Version) {
-	if !p.IsSetRelease() {
-		return AndroidGetVersionResponse_Release_DEFAULT
+func (p *SearchNoticesResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Release
+	return fmt.Sprintf("SearchNoticesResponse(%+v)", *p)
 }
 
-var AndroidGetVersionResponse_Beta_DEFAULT *model.Version
-
-func (p *AndroidGetVersionResponse) GetBeta() (v *model.Version) {
-	if !p.IsSetBeta() {
-		return AndroidGetVersionResponse_Beta_DEFAULT
-	}
-	return p.Beta
+type GetNoticeDetailRequest struct {
+	URL string `thrift:"url,1,required" form:"url,required" json:"url,required" query:"url,required"`
 }
 
-func (p *AndroidGetVersionResponse) IsSetBase() bool {
-	return p.Base != nil
+func NewGetNoticeDetailRequest() *GetNoticeDetailRequest {
+	return &GetNoticeDetailRequest{}
 }
 
-func (p *AndroidGetVersionResponse) IsSetRelease() bool {
-	return p.Release != nil
+func (p *GetNoticeDetailRequest) InitDefault() {
 }
 
-func (p *AndroidGetVersionResponse) IsSetBeta() bool {
-	return p.Beta != nil
+func (p *GetNoticeDetailRequest) GetURL() (v string) {
+	return p.URL
 }
 
-func (p *AndroidGetVersionResponse) String() string {
+func (p *GetNoticeDetailRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AndroidGetVersionResponse(%+v)", *p)
+	return fmt.Sprintf("GetNoticeDetailRequest(%+v)", *p)
 }
 
-// # ----------------------------------------------------------------------------
-// # common（通用内容，如隐私政策等信息）
-// # ----------------------------------------------------------------------------
-type GetCSSRequest struct {
+type GetNoticeDetailResponse struct {
+	Detail *model.NoticeDetail `thrift:"detail,1,required" form:"detail,required" json:"detail,required" query:"detail,required"`
 }
 
-func NewGetCSSRequest() *GetCSSRequest {
-	return &GetCSSRequest{}
+func NewGetNoticeDetailResponse() *GetNoticeDetailResponse {
+	return &GetNoticeDetailResponse{}
 }
 
-func (p *GetCSSRequest) InitDefault() {
+func (p *GetNoticeDetailResponse) InitDefault() {
 }
 
-func (p *GetCSSRequest) String() string {
-	if p == nil {
-		return "<nil>"
+var GetNoticeDetailResponse_Detail_DEFAULT *model.NoticeDetail
+
+func (p *GetNoticeDetailResponse) GetDetail() (v *model.NoticeDetail) {
+	if !p.IsSetDetail() {
+		return GetNoticeDetailResponse_Detail_DEFAULT
 	}
-	return fmt.Sprintf("GetCSSRequest(%+v)", *p)
+	return p.Detail
 }
 
-type GetCSSResponse struct {
-	CSS []byte `thrift:"css,1" form:"css" json:"css" query:"css"`
+func (p *GetNoticeDetailResponse) IsSetDetail() bool {
+	return p.Detail != nil
 }
 
-func NewGetCSSResponse() *GetCSSResponse {
-	return &GetCSSResponse{}
+func (p *GetNoticeDetailResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNoticeDetailResponse(%+v)", *p)
 }
 
-func (p *GetCSSResponse) InitDefault() {
+type ListNoticeSubscriptionsRequest struct {
 }
 
-func (p *GetCSSResponse) GetCSS() (v []byte) {
-	return p.CSS
+func NewListNoticeSubscriptionsRequest() *ListNoticeSubscriptionsRequest {
+	return &ListNoticeSubscriptionsRequest{}
 }
 
-func (p *GetCSSResponse) String() string {
+func (p *ListNoticeSubscriptionsRequest) InitDefault() {
+}
+
+func (p *ListNoticeSubscriptionsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetCSSResponse(%+v)", *p)
+	return fmt.Sprintf("ListNoticeSubscriptionsRequest(%+v)", *p)
 }
 
-type GetHtmlRequest struct {
+type ListNoticeSubscriptionsResponse struct {
+	Subscriptions []*model.NoticeSubscription `thrift:"subscriptions,1,required,list<model.NoticeSubscription>" form:"subscriptions,required" json:"subscriptions,required" query:"subscriptions,required"`
 }
 
-func NewGetHtmlRequest() *GetHtmlRequest {
-	return &GetHtmlRequest{}
+func NewListNoticeSubscriptionsResponse() *ListNoticeSubscriptionsResponse {
+	return &ListNoticeSubscriptionsResponse{}
 }
 
-func (p *GetHtmlRequest) InitDefault() {
+func (p *ListNoticeSubscriptionsResponse) InitDefault() {
 }
 
-func (p *GetHtmlRequest) String() string {
+func (p *ListNoticeSubscriptionsResponse) GetSubscriptions() (v []*model.NoticeSubscription) {
+	return p.Subscriptions
+}
+
+func (p *ListNoticeSubscriptionsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetHtmlRequest(%+v)", *p)
+	return fmt.Sprintf("ListNoticeSubscriptionsResponse(%+v)", *p)
 }
 
-type GetHtmlResponse struct {
-	HTML []byte `thrift:"html,1" form:"html" json:"html" query:"html"`
+type SubscribeNoticeRequest struct {
+	Keyword string `thrift:"keyword,1,required" form:"keyword,required" json:"keyword,required" query:"keyword,required"`
 }
 
-func NewGetHtmlResponse() *GetHtmlResponse {
-	return &GetHtmlResponse{}
+func NewSubscribeNoticeRequest() *SubscribeNoticeRequest {
+	return &SubscribeNoticeRequest{}
 }
 
-func (p *GetHtmlResponse) InitDefault() {
+func (p *SubscribeNoticeRequest) InitDefault() {
 }
 
-func (p *GetHtmlResponse) GetHTML() (v []byte) {
-	return p.HTML
+func (p *SubscribeNoticeRequest) GetKeyword() (v string) {
+	return p.Keyword
 }
 
-func (p *GetHtmlResponse) String() string {
+func (p *SubscribeNoticeRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetHtmlResponse(%+v)", *p)
+	return fmt.Sprintf("SubscribeNoticeRequest(%+v)", *p)
 }
 
-type GetUserAgreementRequest struct {
+type SubscribeNoticeResponse struct {
+	Subscription *model.NoticeSubscription `thrift:"subscription,1,required" form:"subscription,required" json:"subscription,required" query:"subscription,required"`
 }
 
-func NewGetUserAgreementRequest() *GetUserAgreementRequest {
-	return &GetUserAgreementRequest{}
+func NewSubscribeNoticeResponse() *SubscribeNoticeResponse {
+	return &SubscribeNoticeResponse{}
 }
 
-func (p *GetUserAgreementRequest) InitDefault() {
+func (p *SubscribeNoticeResponse) InitDefault() {
 }
 
-func (p *GetUserAgreementRequest) String() string {
+var SubscribeNoticeResponse_Subscription_DEFAULT *model.NoticeSubscription
+
+func (p *SubscribeNoticeResponse) GetSubscription() (v *model.NoticeSubscription) {
+	if !p.IsSetSubscription() {
+		return SubscribeNoticeResponse_Subscription_DEFAULT
+	}
+	return p.Subscription
+}
+
+func (p *SubscribeNoticeResponse) IsSetSubscription() bool {
+	return p.Subscription != nil
+}
+
+func (p *SubscribeNoticeResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserAgreementRequest(%+v)", *p)
+	return fmt.Sprintf("SubscribeNoticeResponse(%+v)", *p)
 }
 
-type GetUserAgreementResponse struct {
-	UserAgreement []byte `thrift:"user_agreement,1" form:"user_agreement" json:"user_agreement" query:"user_agreement"`
+type UnsubscribeNoticeRequest struct {
+	Keyword string `thrift:"keyword,1,required" form:"keyword,required" json:"keyword,required" query:"keyword,required"`
 }
 
-func NewGetUserAgreementResponse() *GetUserAgreementResponse {
-	return &GetUserAgreementResponse{}
+func NewUnsubscribeNoticeRequest() *UnsubscribeNoticeRequest {
+	return &UnsubscribeNoticeRequest{}
 }
 
-func (p *GetUserAgreementResponse) InitDefault() {
+func (p *UnsubscribeNoticeRequest) InitDefault() {
 }
 
-func (p *GetUserAgreementResponse) GetUserAgreement() (v []byte) {
-	return p.UserAgreement
+func (p *UnsubscribeNoticeRequest) GetKeyword() (v string) {
+	return p.Keyword
 }
 
-func (p *GetUserAgreementResponse) String() string {
+func (p *UnsubscribeNoticeRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserAgreementResponse(%+v)", *p)
+	return fmt.Sprintf("UnsubscribeNoticeRequest(%+v)", *p)
 }
 
-// 学期列表
-type TermListRequest struct {
+type UnsubscribeNoticeResponse struct {
 }
 
-func NewTermListRequest() *TermListRequest {
-	return &TermListRequest{}
+func NewUnsubscribeNoticeResponse() *UnsubscribeNoticeResponse {
+	return &UnsubscribeNoticeResponse{}
 }
 
-func (p *TermListRequest) InitDefault() {
+func (p *UnsubscribeNoticeResponse) InitDefault() {
 }
 
-func (p *TermListRequest) String() string {
+func (p *UnsubscribeNoticeResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermListRequest(%+v)", *p)
+	return fmt.Sprintf("UnsubscribeNoticeResponse(%+v)", *p)
 }
 
-type TermListResponse struct {
-	Base      *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
-	TermLists *model.TermList `thrift:"term_lists,2,required" form:"term_lists,required" json:"term_lists,required" query:"term_lists,required"`
+type ListNotificationsRequest struct {
+	PageNum    *int64 `thrift:"pageNum,1,optional" form:"pageNum" json:"pageNum,omitempty" query:"pageNum"`
+	UnreadOnly *bool  `thrift:"unreadOnly,2,optional" form:"unreadOnly" json:"unreadOnly,omitempty" query:"unreadOnly"`
 }
 
-func NewTermListResponse() *TermListResponse {
-	return &TermListResponse{}
+func NewListNotificationsRequest() *ListNotificationsRequest {
+	return &ListNotificationsRequest{}
 }
 
-func (p *TermListResponse) InitDefault() {
+func (p *ListNotificationsRequest) InitDefault() {
 }
 
-var TermListResponse_Base_DEFAULT *model.BaseResp
+var ListNotificationsRequest_PageNum_DEFAULT int64
 
-func (p *TermListResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return TermListResponse_Base_DEFAULT
+func (p *ListNotificationsRequest) GetPageNum() (v int64) {
+	if !p.IsSetPageNum() {
+		return ListNotificationsRequest_PageNum_DEFAULT
 	}
-	return p.Base
+	return *p.PageNum
 }
 
-var TermListResponse_TermLists_DEFAULT *model.TermList
+var ListNotificationsRequest_UnreadOnly_DEFAULT bool
 
-func (p *TermListResponse) GetTermLists() (v *model.TermList) {
-	if !p.IsSetTermLists() {
-		return TermListResponse_TermLists_DEFAULT
+func (p *ListNotificationsRequest) GetUnreadOnly() (v bool) {
+	if !p.IsSetUnreadOnly() {
+		return ListNotificationsRequest_UnreadOnly_DEFAULT
 	}
-	return p.TermLists
+	return *p.UnreadOnly
 }
 
-func (p *TermListResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *ListNotificationsRequest) IsSetPageNum() bool {
+	return p.PageNum != nil
 }
 
-func (p *TermListResponse) IsSetTermLists() bool {
-	return p.TermLists != nil
+func (p *ListNotificationsRequest) IsSetUnreadOnly() bool {
+	return p.UnreadOnly != nil
 }
 
-func (p *TermListResponse) String() string {
+func (p *ListNotificationsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermListResponse(%+v)", *p)
+	return fmt.Sprintf("ListNotificationsRequest(%+v)", *p)
 }
 
-// 学期信息
-type TermRequest struct {
-	Term string `thrift:"term,1,required" form:"term,required" json:"term,required" query:"term,required"`
+type ListNotificationsResponse struct {
+	Notifications []*model.Notification `thrift:"notifications,1,required,list<model.Notification>" form:"notifications,required" json:"notifications,required" query:"notifications,required"`
+	Total         int64                 `thrift:"total,2,required" form:"total,required" json:"total,required" query:"total,required"`
 }
 
-func NewTermRequest() *TermRequest {
-	return &TermRequest{}
+func NewListNotificationsResponse() *ListNotificationsResponse {
+	return &ListNotificationsResponse{}
 }
 
-func (p *TermRequest) InitDefault() {
+func (p *ListNotificationsResponse) InitDefault() {
 }
 
-func (p *TermRequest) GetTerm() (v string) {
-	return p.Term
+func (p *ListNotificationsResponse) GetNotifications() (v []*model.Notification) {
+	return p.Notifications
 }
 
-func (p *TermRequest) String() string {
+func (p *ListNotificationsResponse) GetTotal() (v int64) {
+	return p.Total
+}
+
+func (p *ListNotificationsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermRequest(%+v)", *p)
+	return fmt.Sprintf("ListNotificationsResponse(%+v)", *p)
 }
 
-type TermResponse struct {
-	Base     *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required" query:"base,required"`
-	TermInfo *model.TermInfo `thrift:"term_info,2,required" form:"term_info,required" json:"term_info,required" query:"term_info,required"`
+type MarkNotificationsReadRequest struct {
+	Ids []int64 `thrift:"ids,1,optional,list<i64>" form:"ids" json:"ids,omitempty" query:"ids"`
 }
 
-func NewTermResponse() *TermResponse {
-	return &TermResponse{}
+func NewMarkNotificationsReadRequest() *MarkNotificationsReadRequest {
+	return &MarkNotificationsReadRequest{}
 }
 
-func (p *TermResponse) InitDefault() {
+func (p *MarkNotificationsReadRequest) InitDefault() {
 }
 
-var TermResponse_Base_DEFAULT *model.BaseResp
+var MarkNotificationsReadRequest_Ids_DEFAULT []int64
 
-func (p *TermResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return TermResponse_Base_DEFAULT
+func (p *MarkNotificationsReadRequest) GetIds() (v []int64) {
+	if !p.IsSetIds() {
+		return MarkNotificationsReadRequest_Ids_DEFAULT
 	}
-	return p.Base
+	return p.Ids
 }
 
-var TermResponse_TermInfo_DEFAULT *model.TermInfo
+func (p *MarkNotificationsReadRequest) IsSetIds() bool {
+	return p.Ids != nil
+}
 
-func (p *TermResponse) GetTermInfo() (v *model.TermInfo) {
-	if !p.IsSetTermInfo() {
-		return TermResponse_TermInfo_DEFAULT
+func (p *MarkNotificationsReadRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.TermInfo
+	return fmt.Sprintf("MarkNotificationsReadRequest(%+v)", *p)
 }
 
-func (p *TermResponse) IsSetBase() bool {
-	return p.Base != nil
+type MarkNotificationsReadResponse struct {
+	Marked int64 `thrift:"marked,1,required" form:"marked,required" json:"marked,required" query:"marked,required"`
 }
 
-func (p *TermResponse) IsSetTermInfo() bool {
-	return p.TermInfo != nil
+func NewMarkNotificationsReadResponse() *MarkNotificationsReadResponse {
+	return &MarkNotificationsReadResponse{}
 }
 
-func (p *TermResponse) String() string {
+func (p *MarkNotificationsReadResponse) InitDefault() {
+}
+
+func (p *MarkNotificationsReadResponse) GetMarked() (v int64) {
+	return p.Marked
+}
+
+func (p *MarkNotificationsReadResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermResponse(%+v)", *p)
+	return fmt.Sprintf("MarkNotificationsReadResponse(%+v)", *p)
 }
 
-type GetNoticeRequst struct {
-	PageNum int64 `thrift:"pageNum,1,required" form:"pageNum,required" json:"pageNum,required" query:"pageNum,required"`
-}
-
-func NewGetNoticeRequst() *GetNoticeRequst {
-	return &GetNoticeRequst{}
+type GetUnreadNotificationCountRequest struct {
 }
 
-func (p *GetNoticeRequst) InitDefault() {
+func NewGetUnreadNotificationCountRequest() *GetUnreadNotificationCountRequest {
+	return &GetUnreadNotificationCountRequest{}
 }
 
-func (p *GetNoticeRequst) GetPageNum() (v int64) {
-	return p.PageNum
+func (p *GetUnreadNotificationCountRequest) InitDefault() {
 }
 
-func (p *GetNoticeRequst) String() string {
+func (p *GetUnreadNotificationCountRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNoticeRequst(%+v)", *p)
-}
-
-type GetNoticeResponse struct {
-	Notices []*model.NoticeInfo `thrift:"notices,1,required,list<model.NoticeInfo>" form:"notices,required" json:"notices,required" query:"notices,required"`
-	Total   int64               `thrift:"total,2,required" form:"total,required" json:"total,required" query:"total,required"`
+	return fmt.Sprintf("GetUnreadNotificationCountRequest(%+v)", *p)
 }
 
-func NewGetNoticeResponse() *GetNoticeResponse {
-	return &GetNoticeResponse{}
+type GetUnreadNotificationCountResponse struct {
+	Count int64 `thrift:"count,1,required" form:"count,required" json:"count,required" query:"count,required"`
 }
 
-func (p *GetNoticeResponse) InitDefault() {
+func NewGetUnreadNotificationCountResponse() *GetUnreadNotificationCountResponse {
+	return &GetUnreadNotificationCountResponse{}
 }
 
-func (p *GetNoticeResponse) GetNotices() (v []*model.NoticeInfo) {
-	return p.Notices
+func (p *GetUnreadNotificationCountResponse) InitDefault() {
 }
 
-func (p *GetNoticeResponse) GetTotal() (v int64) {
-	return p.Total
+func (p *GetUnreadNotificationCountResponse) GetCount() (v int64) {
+	return p.Count
 }
 
-func (p *GetNoticeResponse) String() string {
+func (p *GetUnreadNotificationCountResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNoticeResponse(%+v)", *p)
+	return fmt.Sprintf("GetUnreadNotificationCountResponse(%+v)", *p)
 }
 
 type GetContributorInfoRequest struct {
@@ -5260,6 +7207,10 @@ type ClassRoomService interface {
 	GetEmptyClassrooms(ctx context.Context, request *EmptyClassroomRequest) (r *EmptyClassroomResponse, err error)
 	// 查询考表
 	GetExamRoomInfo(ctx context.Context, request *ExamRoomInfoRequest) (r *ExamRoomInfoResponse, err error)
+	// 查询单个教室一天内的占用情况
+	GetRoomSchedule(ctx context.Context, request *RoomScheduleRequest) (r *RoomScheduleResponse, err error)
+	// 查询考场变化记录
+	GetExamRoomChanges(ctx context.Context, request *ExamRoomChangesRequest) (r *ExamRoomChangesResponse, err error)
 }
 
 type UserService interface {
@@ -5289,6 +7240,14 @@ type UserService interface {
 	GetFriendMaxNum(ctx context.Context, request *GetFriendMaxNumRequest) (r *GetFriendMaxNumResponse, err error)
 	// 好友列表排序
 	ReorderFriendList(ctx context.Context, request *ReorderFriendListRequest) (r *ReorderFriendListResponse, err error)
+	// 登记当前设备的友盟 device token，客户端在登录后上报，用于按学生定向推送
+	RegisterDevice(ctx context.Context, request *RegisterDeviceRequest) (r *RegisterDeviceResponse, err error)
+	// 注销当前设备，客户端退出登录时调用
+	UnregisterDevice(ctx context.Context, request *UnregisterDeviceRequest) (r *UnregisterDeviceResponse, err error)
+	// 获取通知偏好，未设置过时返回默认偏好
+	GetNotificationPreference(ctx context.Context, request *GetNotificationPreferenceRequest) (r *GetNotificationPreferenceResponse, err error)
+	// 更新通知偏好，免打扰期间的定向推送会延迟到免打扰结束后发送
+	UpdateNotificationPreference(ctx context.Context, request *UpdateNotificationPreferenceRequest) (r *UpdateNotificationPreferenceResponse, err error)
 }
 
 type CourseService interface {
@@ -5381,6 +7340,18 @@ type VersionService interface {
 	GetDump(ctx context.Context, req *GetDumpRequest) (r *GetDumpResponse, err error)
 
 	AndroidGetVersion(ctx context.Context, req *AndroidGetVersioneRequest) (r *AndroidGetVersionResponse, err error)
+	// 将最新的生效版本标记为已回滚，客户端重新获取版本信息时回到上一个版本
+	RollbackVersion(ctx context.Context, req *RollbackVersionRequest) (r *RollbackVersionResponse, err error)
+	// 版本历史，供客户端展示历次更新内容
+	ListVersions(ctx context.Context, req *ListVersionsRequest) (r *ListVersionsResponse, err error)
+	// 客户端当前版本到最新版本之间的更新日志，用于跳过多次更新后展示更新内容
+	GetVersionChangelog(ctx context.Context, req *GetVersionChangelogRequest) (r *GetVersionChangelogResponse, err error)
+	// 各平台通用的最新版本查询，Android 的 apk 下载与版本接口保留用于兼容旧版客户端
+	GetLatestVersion(ctx context.Context, req *GetLatestVersionRequest) (r *GetLatestVersionResponse, err error)
+	// 试运行云控配置，返回每个计划对给定条件的匹配结果
+	DryRunCloudSetting(ctx context.Context, req *DryRunCloudSettingRequest) (r *DryRunCloudSettingResponse, err error)
+	// 用最近的请求条件模拟匹配，统计各计划的命中分布、不可达的计划与没有命中任何计划的条件
+	GetCloudSettingCoverage(ctx context.Context, req *GetCloudSettingCoverageRequest) (r *GetCloudSettingCoverageResponse, err error)
 }
 
 type CommonService interface {
@@ -5396,6 +7367,24 @@ type CommonService interface {
 	GetTerm(ctx context.Context, req *TermRequest) (r *TermResponse, err error)
 	// 获取教务处通知
 	GetNotice(ctx context.Context, req *GetNoticeRequst) (r *GetNoticeResponse, err error)
+	// 列出已启用的通知来源
+	ListNoticeSources(ctx context.Context, req *ListNoticeSourcesRequest) (r *ListNoticeSourcesResponse, err error)
+	// 教务处通知全文检索
+	SearchNotices(ctx context.Context, req *SearchNoticesRequest) (r *SearchNoticesResponse, err error)
+	// 获取教务处通知详情（正文与附件）
+	GetNoticeDetail(ctx context.Context, req *GetNoticeDetailRequest) (r *GetNoticeDetailResponse, err error)
+	// 通知订阅：列出当前用户的订阅关键词
+	ListNoticeSubscriptions(ctx context.Context, req *ListNoticeSubscriptionsRequest) (r *ListNoticeSubscriptionsResponse, err error)
+	// 通知订阅：订阅关键词
+	SubscribeNotice(ctx context.Context, req *SubscribeNoticeRequest) (r *SubscribeNoticeResponse, err error)
+	// 通知订阅：取消订阅关键词
+	UnsubscribeNotice(ctx context.Context, req *UnsubscribeNoticeRequest) (r *UnsubscribeNoticeResponse, err error)
+	// 通知中心：分页列出当前用户的通知
+	ListNotifications(ctx context.Context, req *ListNotificationsRequest) (r *ListNotificationsResponse, err error)
+	// 通知中心：标记通知为已读
+	MarkNotificationsRead(ctx context.Context, req *MarkNotificationsReadRequest) (r *MarkNotificationsReadResponse, err error)
+	// 通知中心：获取未读通知数量
+	GetUnreadNotificationCount(ctx context.Context, req *GetUnreadNotificationCountRequest) (r *GetUnreadNotificationCountResponse, err error)
 	// 获取贡献者列表
 	GetContributorInfo(ctx context.Context, req *GetContributorInfoRequest) (r *GetContributorInfoResponse, err error)
 	// 获取工具箱配置
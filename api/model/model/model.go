@@ -208,6 +208,67 @@ func (p *FriendMaxNumInfo) String() string {
 	return fmt.Sprintf("FriendMaxNumInfo(%+v)", *p)
 }
 
+// 通知偏好，仅对按学号定向的推送（成绩、考试）生效，收件箱始终保留全部通知
+// 教务处通知与通知订阅按 tag 广播，不受偏好影响，只在全局免打扰时段外推送
+type NotificationPreference struct {
+	// 关闭推送的通知类型，score / exam
+	DisabledTypes []string `thrift:"disabled_types,1,required,list<string>" form:"disabled_types,required" json:"disabled_types,required" query:"disabled_types,required"`
+	// 推送渠道，all 为收件箱与设备推送，inbox 为只写收件箱
+	Channel string `thrift:"channel,2,required" form:"channel,required" json:"channel,required" query:"channel,required"`
+	// 免打扰开始时间，HH:MM，与 quiet_end 同时为空时不开启免打扰
+	QuietStart *string `thrift:"quiet_start,3,optional" form:"quiet_start" json:"quiet_start,omitempty" query:"quiet_start"`
+	// 免打扰结束时间，HH:MM，早于开始时间时表示跨零点
+	QuietEnd *string `thrift:"quiet_end,4,optional" form:"quiet_end" json:"quiet_end,omitempty" query:"quiet_end"`
+}
+
+func NewNotificationPreference() *NotificationPreference {
+	return &NotificationPreference{}
+}
+
+func (p *NotificationPreference) InitDefault() {
+}
+
+func (p *NotificationPreference) GetDisabledTypes() (v []string) {
+	return p.DisabledTypes
+}
+
+func (p *NotificationPreference) GetChannel() (v string) {
+	return p.Channel
+}
+
+var NotificationPreference_QuietStart_DEFAULT string
+
+func (p *NotificationPreference) GetQuietStart() (v string) {
+	if !p.IsSetQuietStart() {
+		return NotificationPreference_QuietStart_DEFAULT
+	}
+	return *p.QuietStart
+}
+
+var NotificationPreference_QuietEnd_DEFAULT string
+
+func (p *NotificationPreference) GetQuietEnd() (v string) {
+	if !p.IsSetQuietEnd() {
+		return NotificationPreference_QuietEnd_DEFAULT
+	}
+	return *p.QuietEnd
+}
+
+func (p *NotificationPreference) IsSetQuietStart() bool {
+	return p.QuietStart != nil
+}
+
+func (p *NotificationPreference) IsSetQuietEnd() bool {
+	return p.QuietEnd != nil
+}
+
+func (p *NotificationPreference) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NotificationPreference(%+v)", *p)
+}
+
 // 空教室
 type Classroom struct {
 	// 空教室所在楼，例 西三
@@ -218,6 +279,14 @@ type Classroom struct {
 	Capacity string `thrift:"capacity,3,required" form:"capacity,required" json:"capacity,required" query:"capacity,required"`
 	// 教师类型，例 智慧教室普通型
 	Type string `thrift:"type,4,required" form:"type,required" json:"type,required" query:"type,required"`
+	// 楼层，例 3
+	Floor *int64 `thrift:"floor,5,optional" form:"floor" json:"floor,omitempty" query:"floor"`
+	// 纬度，来自教室元数据
+	Latitude *float64 `thrift:"latitude,6,optional" form:"latitude" json:"latitude,omitempty" query:"latitude"`
+	// 经度，来自教室元数据
+	Longitude *float64 `thrift:"longitude,7,optional" form:"longitude" json:"longitude,omitempty" query:"longitude"`
+	// 连续空闲到第几节，例 请求 3-4 节时为 6 表示空闲到第 6 节
+	FreeUntil *int64 `thrift:"freeUntil,8,optional" form:"freeUntil" json:"freeUntil,omitempty" query:"freeUntil"`
 }
 
 func NewClassroom() *Classroom {
@@ -243,6 +312,58 @@ func (p *Classroom) GetType() (v string) {
 	return p.Type
 }
 
+var Classroom_Floor_DEFAULT int64
+
+func (p *Classroom) GetFloor() (v int64) {
+	if !p.IsSetFloor() {
+		return Classroom_Floor_DEFAULT
+	}
+	return *p.Floor
+}
+
+var Classroom_Latitude_DEFAULT float64
+
+func (p *Classroom) GetLatitude() (v float64) {
+	if !p.IsSetLatitude() {
+		return Classroom_Latitude_DEFAULT
+	}
+	return *p.Latitude
+}
+
+var Classroom_Longitude_DEFAULT float64
+
+func (p *Classroom) GetLongitude() (v float64) {
+	if !p.IsSetLongitude() {
+		return Classroom_Longitude_DEFAULT
+	}
+	return *p.Longitude
+}
+
+var Classroom_FreeUntil_DEFAULT int64
+
+func (p *Classroom) GetFreeUntil() (v int64) {
+	if !p.IsSetFreeUntil() {
+		return Classroom_FreeUntil_DEFAULT
+	}
+	return *p.FreeUntil
+}
+
+func (p *Classroom) IsSetFloor() bool {
+	return p.Floor != nil
+}
+
+func (p *Classroom) IsSetLatitude() bool {
+	return p.Latitude != nil
+}
+
+func (p *Classroom) IsSetLongitude() bool {
+	return p.Longitude != nil
+}
+
+func (p *Classroom) IsSetFreeUntil() bool {
+	return p.FreeUntil != nil
+}
+
 func (p *Classroom) String() string {
 	if p == nil {
 		return "<nil>"
@@ -250,6 +371,120 @@ func (p *Classroom) String() string {
 	return fmt.Sprintf("Classroom(%+v)", *p)
 }
 
+// 空教室数据的同步状态，用于告知客户端数据的新鲜度
+type EmptyRoomSyncStatus struct {
+	// 返回数据对应的日期，回退时为前一天
+	Date string `thrift:"date,1,required" form:"date,required" json:"date,required" query:"date,required"`
+	// 最近一次同步成功的时间（毫秒时间戳），0 表示未知
+	LastSuccess int64 `thrift:"lastSuccess,2,required" form:"lastSuccess,required" json:"lastSuccess,required" query:"lastSuccess,required"`
+	// 最近一次尝试同步的时间（毫秒时间戳），0 表示未知
+	LastAttempt int64 `thrift:"lastAttempt,3,required" form:"lastAttempt,required" json:"lastAttempt,required" query:"lastAttempt,required"`
+	// 最近一次同步的错误信息，成功时为空
+	Error *string `thrift:"error,4,optional" form:"error" json:"error,omitempty" query:"error"`
+	// 最近一次同步中重试后仍失败的节次，这些节次的教室按占用处理
+	FailedPeriods []int64 `thrift:"failedPeriods,5,required,list<i64>" form:"failedPeriods,required" json:"failedPeriods,required" query:"failedPeriods,required"`
+	// 当天数据缺失时是否回退使用了前一天的数据
+	Fallback bool `thrift:"fallback,6,required" form:"fallback,required" json:"fallback,required" query:"fallback,required"`
+}
+
+func NewEmptyRoomSyncStatus() *EmptyRoomSyncStatus {
+	return &EmptyRoomSyncStatus{}
+}
+
+func (p *EmptyRoomSyncStatus) InitDefault() {
+}
+
+func (p *EmptyRoomSyncStatus) GetDate() (v string) {
+	return p.Date
+}
+
+func (p *EmptyRoomSyncStatus) GetLastSuccess() (v int64) {
+	return p.LastSuccess
+}
+
+func (p *EmptyRoomSyncStatus) GetLastAttempt() (v int64) {
+	return p.LastAttempt
+}
+
+var EmptyRoomSyncStatus_Error_DEFAULT string
+
+func (p *EmptyRoomSyncStatus) GetError() (v string) {
+	if !p.IsSetError() {
+		return EmptyRoomSyncStatus_Error_DEFAULT
+	}
+	return *p.Error
+}
+
+func (p *EmptyRoomSyncStatus) GetFailedPeriods() (v []int64) {
+	return p.FailedPeriods
+}
+
+func (p *EmptyRoomSyncStatus) GetFallback() (v bool) {
+	return p.Fallback
+}
+
+func (p *EmptyRoomSyncStatus) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *EmptyRoomSyncStatus) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EmptyRoomSyncStatus(%+v)", *p)
+}
+
+// 单个教室一天内的占用情况
+type RoomSchedule struct {
+	// 教室信息
+	Room *Classroom `thrift:"room,1,required" form:"room,required" json:"room,required" query:"room,required"`
+	// 日期，例 2024-10-01
+	Date string `thrift:"date,2,required" form:"date,required" json:"date,required" query:"date,required"`
+	// 空闲的节次
+	FreePeriods []int64 `thrift:"freePeriods,3,required,list<i64>" form:"freePeriods,required" json:"freePeriods,required" query:"freePeriods,required"`
+	// 被占用的节次
+	OccupiedPeriods []int64 `thrift:"occupiedPeriods,4,required,list<i64>" form:"occupiedPeriods,required" json:"occupiedPeriods,required" query:"occupiedPeriods,required"`
+}
+
+func NewRoomSchedule() *RoomSchedule {
+	return &RoomSchedule{}
+}
+
+func (p *RoomSchedule) InitDefault() {
+}
+
+var RoomSchedule_Room_DEFAULT *Classroom
+
+func (p *RoomSchedule) GetRoom() (v *Classroom) {
+	if !p.IsSetRoom() {
+		return RoomSchedule_Room_DEFAULT
+	}
+	return p.Room
+}
+
+func (p *RoomSchedule) GetDate() (v string) {
+	return p.Date
+}
+
+func (p *RoomSchedule) GetFreePeriods() (v []int64) {
+	return p.FreePeriods
+}
+
+func (p *RoomSchedule) GetOccupiedPeriods() (v []int64) {
+	return p.OccupiedPeriods
+}
+
+func (p *RoomSchedule) IsSetRoom() bool {
+	return p.Room != nil
+}
+
+func (p *RoomSchedule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoomSchedule(%+v)", *p)
+}
+
 // 考场信息
 type ExamRoomInfo struct {
 	// 课程名
@@ -304,6 +539,144 @@ func (p *ExamRoomInfo) String() string {
 	return fmt.Sprintf("ExamRoomInfo(%+v)", *p)
 }
 
+// 考场变化记录
+type ExamRoomChange struct {
+	// 课程名
+	Name string `thrift:"name,1,required" form:"name,required" json:"name,required" query:"name,required"`
+	// 学分
+	Credit string `thrift:"credit,2,required" form:"credit,required" json:"credit,required" query:"credit,required"`
+	// 任课教师
+	Teacher string `thrift:"teacher,3,required" form:"teacher,required" json:"teacher,required" query:"teacher,required"`
+	// 变化类型，added 新增、changed 变更、removed 移除
+	ChangeType string `thrift:"changeType,4,required" form:"changeType,required" json:"changeType,required" query:"changeType,required"`
+	// 变化前考场
+	PrevLocation *string `thrift:"prevLocation,5,optional" form:"prevLocation" json:"prevLocation,omitempty" query:"prevLocation"`
+	// 变化前日期
+	PrevDate *string `thrift:"prevDate,6,optional" form:"prevDate" json:"prevDate,omitempty" query:"prevDate"`
+	// 变化前时间
+	PrevTime *string `thrift:"prevTime,7,optional" form:"prevTime" json:"prevTime,omitempty" query:"prevTime"`
+	// 变化后考场，考场被移除时为空
+	Location *string `thrift:"location,8,optional" form:"location" json:"location,omitempty" query:"location"`
+	// 变化后日期
+	Date *string `thrift:"date,9,optional" form:"date" json:"date,omitempty" query:"date"`
+	// 变化后时间
+	Time *string `thrift:"time,10,optional" form:"time" json:"time,omitempty" query:"time"`
+	// 发现变化的时间（毫秒时间戳）
+	DetectedAt int64 `thrift:"detectedAt,11,required" form:"detectedAt,required" json:"detectedAt,required" query:"detectedAt,required"`
+}
+
+func NewExamRoomChange() *ExamRoomChange {
+	return &ExamRoomChange{}
+}
+
+func (p *ExamRoomChange) InitDefault() {
+}
+
+func (p *ExamRoomChange) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ExamRoomChange) GetCredit() (v string) {
+	return p.Credit
+}
+
+func (p *ExamRoomChange) GetTeacher() (v string) {
+	return p.Teacher
+}
+
+func (p *ExamRoomChange) GetChangeType() (v string) {
+	return p.ChangeType
+}
+
+var ExamRoomChange_PrevLocation_DEFAULT string
+
+func (p *ExamRoomChange) GetPrevLocation() (v string) {
+	if !p.IsSetPrevLocation() {
+		return ExamRoomChange_PrevLocation_DEFAULT
+	}
+	return *p.PrevLocation
+}
+
+var ExamRoomChange_PrevDate_DEFAULT string
+
+func (p *ExamRoomChange) GetPrevDate() (v string) {
+	if !p.IsSetPrevDate() {
+		return ExamRoomChange_PrevDate_DEFAULT
+	}
+	return *p.PrevDate
+}
+
+var ExamRoomChange_PrevTime_DEFAULT string
+
+func (p *ExamRoomChange) GetPrevTime() (v string) {
+	if !p.IsSetPrevTime() {
+		return ExamRoomChange_PrevTime_DEFAULT
+	}
+	return *p.PrevTime
+}
+
+var ExamRoomChange_Location_DEFAULT string
+
+func (p *ExamRoomChange) GetLocation() (v string) {
+	if !p.IsSetLocation() {
+		return ExamRoomChange_Location_DEFAULT
+	}
+	return *p.Location
+}
+
+var ExamRoomChange_Date_DEFAULT string
+
+func (p *ExamRoomChange) GetDate() (v string) {
+	if !p.IsSetDate() {
+		return ExamRoomChange_Date_DEFAULT
+	}
+	return *p.Date
+}
+
+var ExamRoomChange_Time_DEFAULT string
+
+func (p *ExamRoomChange) GetTime() (v string) {
+	if !p.IsSetTime() {
+		return ExamRoomChange_Time_DEFAULT
+	}
+	return *p.Time
+}
+
+func (p *ExamRoomChange) GetDetectedAt() (v int64) {
+	return p.DetectedAt
+}
+
+func (p *ExamRoomChange) IsSetPrevLocation() bool {
+	return p.PrevLocation != nil
+}
+
+func (p *ExamRoomChange) IsSetPrevDate() bool {
+	return p.PrevDate != nil
+}
+
+func (p *ExamRoomChange) IsSetPrevTime() bool {
+	return p.PrevTime != nil
+}
+
+func (p *ExamRoomChange) IsSetLocation() bool {
+	return p.Location != nil
+}
+
+func (p *ExamRoomChange) IsSetDate() bool {
+	return p.Date != nil
+}
+
+func (p *ExamRoomChange) IsSetTime() bool {
+	return p.Time != nil
+}
+
+func (p *ExamRoomChange) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExamRoomChange(%+v)", *p)
+}
+
 // 课程安排
 type CourseScheduleRule struct {
 	// 定制
@@ -1425,6 +1798,8 @@ type NoticeInfo struct {
 	Title *string `thrift:"title,1,optional" form:"title" json:"title,omitempty" query:"title"`
 	URL   *string `thrift:"url,2,optional" form:"url" json:"url,omitempty" query:"url"`
 	Date  *string `thrift:"date,3,optional" form:"date" json:"date,omitempty" query:"date"`
+	// 通知来源，例 jwch（教务处）、library
+	Source *string `thrift:"source,4,optional" form:"source" json:"source,omitempty" query:"source"`
 }
 
 func NewNoticeInfo() *NoticeInfo {
@@ -1461,6 +1836,15 @@ func (p *NoticeInfo) GetDate() (v string) {
 	return *p.Date
 }
 
+var NoticeInfo_Source_DEFAULT string
+
+func (p *NoticeInfo) GetSource() (v string) {
+	if !p.IsSetSource() {
+		return NoticeInfo_Source_DEFAULT
+	}
+	return *p.Source
+}
+
 func (p *NoticeInfo) IsSetTitle() bool {
 	return p.Title != nil
 }
@@ -1473,6 +1857,10 @@ func (p *NoticeInfo) IsSetDate() bool {
 	return p.Date != nil
 }
 
+func (p *NoticeInfo) IsSetSource() bool {
+	return p.Source != nil
+}
+
 func (p *NoticeInfo) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1480,114 +1868,368 @@ func (p *NoticeInfo) String() string {
 	return fmt.Sprintf("NoticeInfo(%+v)", *p)
 }
 
-type Contributor struct {
-	Name          string `thrift:"name,1" form:"name" json:"name" query:"name"`
-	AvatarURL     string `thrift:"avatar_url,2" form:"avatar_url" json:"avatar_url" query:"avatar_url"`
-	URL           string `thrift:"url,3" form:"url" json:"url" query:"url"`
-	Contributions int64  `thrift:"contributions,4" form:"contributions" json:"contributions" query:"contributions"`
+// 通知来源
+type NoticeSource struct {
+	// 来源标识，用于按来源查询通知
+	Name string `thrift:"name,1,required" form:"name,required" json:"name,required" query:"name,required"`
+	// 展示名称，例 教务处
+	DisplayName string `thrift:"displayName,2,required" form:"displayName,required" json:"displayName,required" query:"displayName,required"`
 }
 
-func NewContributor() *Contributor {
-	return &Contributor{}
+func NewNoticeSource() *NoticeSource {
+	return &NoticeSource{}
 }
 
-func (p *Contributor) InitDefault() {
+func (p *NoticeSource) InitDefault() {
 }
 
-func (p *Contributor) GetName() (v string) {
+func (p *NoticeSource) GetName() (v string) {
 	return p.Name
 }
 
-func (p *Contributor) GetAvatarURL() (v string) {
-	return p.AvatarURL
-}
-
-func (p *Contributor) GetURL() (v string) {
-	return p.URL
-}
-
-func (p *Contributor) GetContributions() (v int64) {
-	return p.Contributions
+func (p *NoticeSource) GetDisplayName() (v string) {
+	return p.DisplayName
 }
 
-func (p *Contributor) String() string {
+func (p *NoticeSource) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Contributor(%+v)", *p)
+	return fmt.Sprintf("NoticeSource(%+v)", *p)
 }
 
-type ToolboxConfig struct {
-	ToolID    int64   `thrift:"tool_id,1,required" form:"tool_id,required" json:"tool_id,required" query:"tool_id,required"`
-	Visible   *bool   `thrift:"visible,2,optional" form:"visible" json:"visible,omitempty" query:"visible"`
-	Name      *string `thrift:"name,3,optional" form:"name" json:"name,omitempty" query:"name"`
-	Icon      *string `thrift:"icon,4,optional" form:"icon" json:"icon,omitempty" query:"icon"`
-	Type      *string `thrift:"type,5,optional" form:"type" json:"type,omitempty" query:"type"`
-	Message   *string `thrift:"message,6,optional" form:"message" json:"message,omitempty" query:"message"`
-	Extra     *string `thrift:"extra,7,optional" form:"extra" json:"extra,omitempty" query:"extra"`
-	Platform  *string `thrift:"platform,8,optional" form:"platform" json:"platform,omitempty" query:"platform"`
-	Version   *int64  `thrift:"version,9,optional" form:"version" json:"version,omitempty" query:"version"`
-	ConfigID  *int64  `thrift:"config_id,10,optional" form:"config_id" json:"config_id,omitempty" query:"config_id"`
-	StudentID *string `thrift:"student_id,11,optional" form:"student_id" json:"student_id,omitempty" query:"student_id"`
+// 教务处通知的检索结果
+type NoticeSearchHit struct {
+	Title string `thrift:"title,1,required" form:"title,required" json:"title,required" query:"title,required"`
+	URL   string `thrift:"url,2,required" form:"url,required" json:"url,required" query:"url,required"`
+	Date  string `thrift:"date,3,required" form:"date,required" json:"date,required" query:"date,required"`
+	// 标题命中时带 <em> 标记的标题
+	HighlightTitle *string `thrift:"highlightTitle,4,optional" form:"highlightTitle" json:"highlightTitle,omitempty" query:"highlightTitle"`
+	// 正文中命中的片段，关键词使用 <em> 标记
+	Highlights []string `thrift:"highlights,5,required,list<string>" form:"highlights,required" json:"highlights,required" query:"highlights,required"`
 }
 
-func NewToolboxConfig() *ToolboxConfig {
-	return &ToolboxConfig{}
+func NewNoticeSearchHit() *NoticeSearchHit {
+	return &NoticeSearchHit{}
 }
 
-func (p *ToolboxConfig) InitDefault() {
+func (p *NoticeSearchHit) InitDefault() {
 }
 
-func (p *ToolboxConfig) GetToolID() (v int64) {
-	return p.ToolID
+func (p *NoticeSearchHit) GetTitle() (v string) {
+	return p.Title
 }
 
-var ToolboxConfig_Visible_DEFAULT bool
+func (p *NoticeSearchHit) GetURL() (v string) {
+	return p.URL
+}
 
-func (p *ToolboxConfig) GetVisible() (v bool) {
-	if !p.IsSetVisible() {
-		return ToolboxConfig_Visible_DEFAULT
-	}
-	return *p.Visible
+func (p *NoticeSearchHit) GetDate() (v string) {
+	return p.Date
 }
 
-var ToolboxConfig_Name_DEFAULT string
+var NoticeSearchHit_HighlightTitle_DEFAULT string
 
-func (p *ToolboxConfig) GetName() (v string) {
-	if !p.IsSetName() {
-		return ToolboxConfig_Name_DEFAULT
+func (p *NoticeSearchHit) GetHighlightTitle() (v string) {
+	if !p.IsSetHighlightTitle() {
+		return NoticeSearchHit_HighlightTitle_DEFAULT
 	}
-	return *p.Name
+	return *p.HighlightTitle
 }
 
-var ToolboxConfig_Icon_DEFAULT string
-
-func (p *ToolboxConfig) GetIcon() (v string) {
-	if !p.IsSetIcon() {
-		return ToolboxConfig_Icon_DEFAULT
-	}
-	return *p.Icon
+func (p *NoticeSearchHit) GetHighlights() (v []string) {
+	return p.Highlights
 }
 
-var ToolboxConfig_Type_DEFAULT string
+func (p *NoticeSearchHit) IsSetHighlightTitle() bool {
+	return p.HighlightTitle != nil
+}
 
-func (p *ToolboxConfig) GetType() (v string) {
-	if !p.IsSetType() {
-		return ToolboxConfig_Type_DEFAULT
+func (p *NoticeSearchHit) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Type
+	return fmt.Sprintf("NoticeSearchHit(%+v)", *p)
 }
 
-var ToolboxConfig_Message_DEFAULT string
+type NoticeAttachment struct {
+	Name string `thrift:"name,1,required" form:"name,required" json:"name,required" query:"name,required"`
+	// 下载地址，已转存时为 OSS 地址，否则为教务处原始地址
+	URL string `thrift:"url,2,required" form:"url,required" json:"url,required" query:"url,required"`
+	// 是否已转存到 OSS
+	Mirrored bool `thrift:"mirrored,3,required" form:"mirrored,required" json:"mirrored,required" query:"mirrored,required"`
+}
 
-func (p *ToolboxConfig) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return ToolboxConfig_Message_DEFAULT
-	}
-	return *p.Message
+func NewNoticeAttachment() *NoticeAttachment {
+	return &NoticeAttachment{}
 }
 
-var ToolboxConfig_Extra_DEFAULT string
+func (p *NoticeAttachment) InitDefault() {
+}
+
+func (p *NoticeAttachment) GetName() (v string) {
+	return p.Name
+}
+
+func (p *NoticeAttachment) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *NoticeAttachment) GetMirrored() (v bool) {
+	return p.Mirrored
+}
+
+func (p *NoticeAttachment) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoticeAttachment(%+v)", *p)
+}
+
+type NoticeDetail struct {
+	Title string `thrift:"title,1,required" form:"title,required" json:"title,required" query:"title,required"`
+	URL   string `thrift:"url,2,required" form:"url,required" json:"url,required" query:"url,required"`
+	Date  string `thrift:"date,3,required" form:"date,required" json:"date,required" query:"date,required"`
+	// 清洗后的正文 HTML，附件链接已替换为下载地址
+	Content     string              `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
+	Attachments []*NoticeAttachment `thrift:"attachments,5,required,list<NoticeAttachment>" form:"attachments,required" json:"attachments,required" query:"attachments,required"`
+}
+
+func NewNoticeDetail() *NoticeDetail {
+	return &NoticeDetail{}
+}
+
+func (p *NoticeDetail) InitDefault() {
+}
+
+func (p *NoticeDetail) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *NoticeDetail) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *NoticeDetail) GetDate() (v string) {
+	return p.Date
+}
+
+func (p *NoticeDetail) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *NoticeDetail) GetAttachments() (v []*NoticeAttachment) {
+	return p.Attachments
+}
+
+func (p *NoticeDetail) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoticeDetail(%+v)", *p)
+}
+
+type NoticeSubscription struct {
+	Keyword string `thrift:"keyword,1,required" form:"keyword,required" json:"keyword,required" query:"keyword,required"`
+	// 客户端需向友盟注册的设备 tag，命中关键词的通知会推送到该 tag
+	Tag string `thrift:"tag,2,required" form:"tag,required" json:"tag,required" query:"tag,required"`
+}
+
+func NewNoticeSubscription() *NoticeSubscription {
+	return &NoticeSubscription{}
+}
+
+func (p *NoticeSubscription) InitDefault() {
+}
+
+func (p *NoticeSubscription) GetKeyword() (v string) {
+	return p.Keyword
+}
+
+func (p *NoticeSubscription) GetTag() (v string) {
+	return p.Tag
+}
+
+func (p *NoticeSubscription) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoticeSubscription(%+v)", *p)
+}
+
+// 通知中心收件箱中的一条通知
+type Notification struct {
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 通知类型，例 score（成绩）、exam（考试）、teaching（教务处通知）
+	Type    string `thrift:"type,2,required" form:"type,required" json:"type,required" query:"type,required"`
+	Title   string `thrift:"title,3,required" form:"title,required" json:"title,required" query:"title,required"`
+	Content string `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
+	// 客户端跳转地址，与推送中的 deeplink 一致
+	Deeplink *string `thrift:"deeplink,5,optional" form:"deeplink" json:"deeplink,omitempty" query:"deeplink"`
+	IsRead   bool    `thrift:"isRead,6,required" form:"isRead,required" json:"isRead,required" query:"isRead,required"`
+	// 通知时间，毫秒时间戳
+	CreatedAt int64 `thrift:"createdAt,7,required" form:"createdAt,required" json:"createdAt,required" query:"createdAt,required"`
+}
+
+func NewNotification() *Notification {
+	return &Notification{}
+}
+
+func (p *Notification) InitDefault() {
+}
+
+func (p *Notification) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *Notification) GetType() (v string) {
+	return p.Type
+}
+
+func (p *Notification) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *Notification) GetContent() (v string) {
+	return p.Content
+}
+
+var Notification_Deeplink_DEFAULT string
+
+func (p *Notification) GetDeeplink() (v string) {
+	if !p.IsSetDeeplink() {
+		return Notification_Deeplink_DEFAULT
+	}
+	return *p.Deeplink
+}
+
+func (p *Notification) GetIsRead() (v bool) {
+	return p.IsRead
+}
+
+func (p *Notification) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Notification) IsSetDeeplink() bool {
+	return p.Deeplink != nil
+}
+
+func (p *Notification) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Notification(%+v)", *p)
+}
+
+type Contributor struct {
+	Name          string `thrift:"name,1" form:"name" json:"name" query:"name"`
+	AvatarURL     string `thrift:"avatar_url,2" form:"avatar_url" json:"avatar_url" query:"avatar_url"`
+	URL           string `thrift:"url,3" form:"url" json:"url" query:"url"`
+	Contributions int64  `thrift:"contributions,4" form:"contributions" json:"contributions" query:"contributions"`
+}
+
+func NewContributor() *Contributor {
+	return &Contributor{}
+}
+
+func (p *Contributor) InitDefault() {
+}
+
+func (p *Contributor) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Contributor) GetAvatarURL() (v string) {
+	return p.AvatarURL
+}
+
+func (p *Contributor) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *Contributor) GetContributions() (v int64) {
+	return p.Contributions
+}
+
+func (p *Contributor) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Contributor(%+v)", *p)
+}
+
+type ToolboxConfig struct {
+	ToolID    int64   `thrift:"tool_id,1,required" form:"tool_id,required" json:"tool_id,required" query:"tool_id,required"`
+	Visible   *bool   `thrift:"visible,2,optional" form:"visible" json:"visible,omitempty" query:"visible"`
+	Name      *string `thrift:"name,3,optional" form:"name" json:"name,omitempty" query:"name"`
+	Icon      *string `thrift:"icon,4,optional" form:"icon" json:"icon,omitempty" query:"icon"`
+	Type      *string `thrift:"type,5,optional" form:"type" json:"type,omitempty" query:"type"`
+	Message   *string `thrift:"message,6,optional" form:"message" json:"message,omitempty" query:"message"`
+	Extra     *string `thrift:"extra,7,optional" form:"extra" json:"extra,omitempty" query:"extra"`
+	Platform  *string `thrift:"platform,8,optional" form:"platform" json:"platform,omitempty" query:"platform"`
+	Version   *int64  `thrift:"version,9,optional" form:"version" json:"version,omitempty" query:"version"`
+	ConfigID  *int64  `thrift:"config_id,10,optional" form:"config_id" json:"config_id,omitempty" query:"config_id"`
+	StudentID *string `thrift:"student_id,11,optional" form:"student_id" json:"student_id,omitempty" query:"student_id"`
+}
+
+func NewToolboxConfig() *ToolboxConfig {
+	return &ToolboxConfig{}
+}
+
+func (p *ToolboxConfig) InitDefault() {
+}
+
+func (p *ToolboxConfig) GetToolID() (v int64) {
+	return p.ToolID
+}
+
+var ToolboxConfig_Visible_DEFAULT bool
+
+func (p *ToolboxConfig) GetVisible() (v bool) {
+	if !p.IsSetVisible() {
+		return ToolboxConfig_Visible_DEFAULT
+	}
+	return *p.Visible
+}
+
+var ToolboxConfig_Name_DEFAULT string
+
+func (p *ToolboxConfig) GetName() (v string) {
+	if !p.IsSetName() {
+		return ToolboxConfig_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var ToolboxConfig_Icon_DEFAULT string
+
+func (p *ToolboxConfig) GetIcon() (v string) {
+	if !p.IsSetIcon() {
+		return ToolboxConfig_Icon_DEFAULT
+	}
+	return *p.Icon
+}
+
+var ToolboxConfig_Type_DEFAULT string
+
+func (p *ToolboxConfig) GetType() (v string) {
+	if !p.IsSetType() {
+		return ToolboxConfig_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var ToolboxConfig_Message_DEFAULT string
+
+func (p *ToolboxConfig) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ToolboxConfig_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var ToolboxConfig_Extra_DEFAULT string
 
 func (p *ToolboxConfig) GetExtra() (v string) {
 	if !p.IsSetExtra() {
@@ -1833,6 +2475,10 @@ type Version struct {
 	Force       *bool   `thrift:"force,3,optional" form:"force" json:"force,omitempty" query:"force"`
 	Changelog   *string `thrift:"changelog,4,optional" form:"changelog" json:"changelog,omitempty" query:"changelog"`
 	URL         *string `thrift:"url,5,optional" form:"url" json:"url,omitempty" query:"url"`
+	// 发布时间，毫秒时间戳；仅版本历史返回
+	ReleasedAt *int64 `thrift:"released_at,6,optional" form:"released_at" json:"released_at,omitempty" query:"released_at"`
+	// active / rolled_back；仅版本历史返回
+	Status *string `thrift:"status,7,optional" form:"status" json:"status,omitempty" query:"status"`
 }
 
 func NewVersion() *Version {
@@ -1887,6 +2533,24 @@ func (p *Version) GetURL() (v string) {
 	return *p.URL
 }
 
+var Version_ReleasedAt_DEFAULT int64
+
+func (p *Version) GetReleasedAt() (v int64) {
+	if !p.IsSetReleasedAt() {
+		return Version_ReleasedAt_DEFAULT
+	}
+	return *p.ReleasedAt
+}
+
+var Version_Status_DEFAULT string
+
+func (p *Version) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return Version_Status_DEFAULT
+	}
+	return *p.Status
+}
+
 func (p *Version) IsSetVersionCode() bool {
 	return p.VersionCode != nil
 }
@@ -1907,6 +2571,14 @@ func (p *Version) IsSetURL() bool {
 	return p.URL != nil
 }
 
+func (p *Version) IsSetReleasedAt() bool {
+	return p.ReleasedAt != nil
+}
+
+func (p *Version) IsSetStatus() bool {
+	return p.Status != nil
+}
+
 func (p *Version) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1914,6 +2586,448 @@ func (p *Version) String() string {
 	return fmt.Sprintf("Version(%+v)", *p)
 }
 
+type VersionChangelog struct {
+	// 请求者能更新到的最新版本，已是最新时为空
+	Latest *Version `thrift:"latest,1,optional" form:"latest" json:"latest,omitempty" query:"latest"`
+	// 各版本更新日志按版本号从新到旧拼接
+	Changelog string     `thrift:"changelog,2,required" form:"changelog,required" json:"changelog,required" query:"changelog,required"`
+	Versions  []*Version `thrift:"versions,3,required,list<Version>" form:"versions,required" json:"versions,required" query:"versions,required"`
+}
+
+func NewVersionChangelog() *VersionChangelog {
+	return &VersionChangelog{}
+}
+
+func (p *VersionChangelog) InitDefault() {
+}
+
+var VersionChangelog_Latest_DEFAULT *Version
+
+func (p *VersionChangelog) GetLatest() (v *Version) {
+	if !p.IsSetLatest() {
+		return VersionChangelog_Latest_DEFAULT
+	}
+	return p.Latest
+}
+
+func (p *VersionChangelog) GetChangelog() (v string) {
+	return p.Changelog
+}
+
+func (p *VersionChangelog) GetVersions() (v []*Version) {
+	return p.Versions
+}
+
+func (p *VersionChangelog) IsSetLatest() bool {
+	return p.Latest != nil
+}
+
+func (p *VersionChangelog) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionChangelog(%+v)", *p)
+}
+
+type LatestVersion struct {
+	// 请求者能更新到的最新版本，没有可用版本时为空
+	Version *Version `thrift:"version,1,optional" form:"version" json:"version,omitempty" query:"version"`
+	// 正式版的应用商店链接：iOS 为 App Store，HarmonyOS 为 AppGallery
+	StoreURL *string `thrift:"store_url,2,optional" form:"store_url" json:"store_url,omitempty" query:"store_url"`
+	// 当前客户端是否必须更新
+	Force bool `thrift:"force,3,required" form:"force,required" json:"force,required" query:"force,required"`
+	// 最低支持的版本号，低于该版本的客户端必须更新，0 表示不限制
+	MinSupportedCode int64 `thrift:"min_supported_code,4,required" form:"min_supported_code,required" json:"min_supported_code,required" query:"min_supported_code,required"`
+}
+
+func NewLatestVersion() *LatestVersion {
+	return &LatestVersion{}
+}
+
+func (p *LatestVersion) InitDefault() {
+}
+
+var LatestVersion_Version_DEFAULT *Version
+
+func (p *LatestVersion) GetVersion() (v *Version) {
+	if !p.IsSetVersion() {
+		return LatestVersion_Version_DEFAULT
+	}
+	return p.Version
+}
+
+var LatestVersion_StoreURL_DEFAULT string
+
+func (p *LatestVersion) GetStoreURL() (v string) {
+	if !p.IsSetStoreURL() {
+		return LatestVersion_StoreURL_DEFAULT
+	}
+	return *p.StoreURL
+}
+
+func (p *LatestVersion) GetForce() (v bool) {
+	return p.Force
+}
+
+func (p *LatestVersion) GetMinSupportedCode() (v int64) {
+	return p.MinSupportedCode
+}
+
+func (p *LatestVersion) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *LatestVersion) IsSetStoreURL() bool {
+	return p.StoreURL != nil
+}
+
+func (p *LatestVersion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LatestVersion(%+v)", *p)
+}
+
+type CloudSettingPlanMatch struct {
+	// 计划在配置中的位置，从 0 开始
+	Index int64 `thrift:"index,1,required" form:"index,required" json:"index,required" query:"index,required"`
+	// 计划名称
+	Name *string `thrift:"name,2,optional" form:"name" json:"name,omitempty" query:"name"`
+	// 条件是否满足该计划的所有规则
+	Matched bool `thrift:"matched,3,required" form:"matched,required" json:"matched,required" query:"matched,required"`
+	// 不满足的字段
+	MismatchedFields []string `thrift:"mismatched_fields,4,required,list<string>" form:"mismatched_fields,required" json:"mismatched_fields,required" query:"mismatched_fields,required"`
+}
+
+func NewCloudSettingPlanMatch() *CloudSettingPlanMatch {
+	return &CloudSettingPlanMatch{}
+}
+
+func (p *CloudSettingPlanMatch) InitDefault() {
+}
+
+func (p *CloudSettingPlanMatch) GetIndex() (v int64) {
+	return p.Index
+}
+
+var CloudSettingPlanMatch_Name_DEFAULT string
+
+func (p *CloudSettingPlanMatch) GetName() (v string) {
+	if !p.IsSetName() {
+		return CloudSettingPlanMatch_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+func (p *CloudSettingPlanMatch) GetMatched() (v bool) {
+	return p.Matched
+}
+
+func (p *CloudSettingPlanMatch) GetMismatchedFields() (v []string) {
+	return p.MismatchedFields
+}
+
+func (p *CloudSettingPlanMatch) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *CloudSettingPlanMatch) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingPlanMatch(%+v)", *p)
+}
+
+type CloudSettingDryRun struct {
+	// 实际下发的计划，即第一个满足条件的计划，没有命中时为空
+	MatchedIndex *int64 `thrift:"matched_index,1,optional" form:"matched_index" json:"matched_index,omitempty" query:"matched_index"`
+	// 命中计划下发给客户端的配置
+	Plan  *string                  `thrift:"plan,2,optional" form:"plan" json:"plan,omitempty" query:"plan"`
+	Plans []*CloudSettingPlanMatch `thrift:"plans,3,required,list<CloudSettingPlanMatch>" form:"plans,required" json:"plans,required" query:"plans,required"`
+}
+
+func NewCloudSettingDryRun() *CloudSettingDryRun {
+	return &CloudSettingDryRun{}
+}
+
+func (p *CloudSettingDryRun) InitDefault() {
+}
+
+var CloudSettingDryRun_MatchedIndex_DEFAULT int64
+
+func (p *CloudSettingDryRun) GetMatchedIndex() (v int64) {
+	if !p.IsSetMatchedIndex() {
+		return CloudSettingDryRun_MatchedIndex_DEFAULT
+	}
+	return *p.MatchedIndex
+}
+
+var CloudSettingDryRun_Plan_DEFAULT string
+
+func (p *CloudSettingDryRun) GetPlan() (v string) {
+	if !p.IsSetPlan() {
+		return CloudSettingDryRun_Plan_DEFAULT
+	}
+	return *p.Plan
+}
+
+func (p *CloudSettingDryRun) GetPlans() (v []*CloudSettingPlanMatch) {
+	return p.Plans
+}
+
+func (p *CloudSettingDryRun) IsSetMatchedIndex() bool {
+	return p.MatchedIndex != nil
+}
+
+func (p *CloudSettingDryRun) IsSetPlan() bool {
+	return p.Plan != nil
+}
+
+func (p *CloudSettingDryRun) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingDryRun(%+v)", *p)
+}
+
+type CloudSettingCriteria struct {
+	Account   *string `thrift:"account,1,optional" form:"account" json:"account,omitempty" query:"account"`
+	Version   *string `thrift:"version,2,optional" form:"version" json:"version,omitempty" query:"version"`
+	Beta      *bool   `thrift:"beta,3,optional" form:"beta" json:"beta,omitempty" query:"beta"`
+	Phone     *string `thrift:"phone,4,optional" form:"phone" json:"phone,omitempty" query:"phone"`
+	IsLogin   *bool   `thrift:"isLogin,5,optional" form:"isLogin" json:"isLogin,omitempty" query:"isLogin"`
+	LoginType *string `thrift:"loginType,6,optional" form:"loginType" json:"loginType,omitempty" query:"loginType"`
+}
+
+func NewCloudSettingCriteria() *CloudSettingCriteria {
+	return &CloudSettingCriteria{}
+}
+
+func (p *CloudSettingCriteria) InitDefault() {
+}
+
+var CloudSettingCriteria_Account_DEFAULT string
+
+func (p *CloudSettingCriteria) GetAccount() (v string) {
+	if !p.IsSetAccount() {
+		return CloudSettingCriteria_Account_DEFAULT
+	}
+	return *p.Account
+}
+
+var CloudSettingCriteria_Version_DEFAULT string
+
+func (p *CloudSettingCriteria) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return CloudSettingCriteria_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var CloudSettingCriteria_Beta_DEFAULT bool
+
+func (p *CloudSettingCriteria) GetBeta() (v bool) {
+	if !p.IsSetBeta() {
+		return CloudSettingCriteria_Beta_DEFAULT
+	}
+	return *p.Beta
+}
+
+var CloudSettingCriteria_Phone_DEFAULT string
+
+func (p *CloudSettingCriteria) GetPhone() (v string) {
+	if !p.IsSetPhone() {
+		return CloudSettingCriteria_Phone_DEFAULT
+	}
+	return *p.Phone
+}
+
+var CloudSettingCriteria_IsLogin_DEFAULT bool
+
+func (p *CloudSettingCriteria) GetIsLogin() (v bool) {
+	if !p.IsSetIsLogin() {
+		return CloudSettingCriteria_IsLogin_DEFAULT
+	}
+	return *p.IsLogin
+}
+
+var CloudSettingCriteria_LoginType_DEFAULT string
+
+func (p *CloudSettingCriteria) GetLoginType() (v string) {
+	if !p.IsSetLoginType() {
+		return CloudSettingCriteria_LoginType_DEFAULT
+	}
+	return *p.LoginType
+}
+
+func (p *CloudSettingCriteria) IsSetAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CloudSettingCriteria) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *CloudSettingCriteria) IsSetBeta() bool {
+	return p.Beta != nil
+}
+
+func (p *CloudSettingCriteria) IsSetPhone() bool {
+	return p.Phone != nil
+}
+
+func (p *CloudSettingCriteria) IsSetIsLogin() bool {
+	return p.IsLogin != nil
+}
+
+func (p *CloudSettingCriteria) IsSetLoginType() bool {
+	return p.LoginType != nil
+}
+
+func (p *CloudSettingCriteria) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingCriteria(%+v)", *p)
+}
+
+type CloudSettingPlanCoverage struct {
+	// 计划在配置中的位置，从 0 开始
+	Index int64 `thrift:"index,1,required" form:"index,required" json:"index,required" query:"index,required"`
+	// 计划名称
+	Name *string `thrift:"name,2,optional" form:"name" json:"name,omitempty" query:"name"`
+	// 实际下发该计划的样本数
+	Hits int64 `thrift:"hits,3,required" form:"hits,required" json:"hits,required" query:"hits,required"`
+	// 满足该计划所有规则的样本数，超出 hits 的部分被前面的计划截走
+	Eligible int64 `thrift:"eligible,4,required" form:"eligible,required" json:"eligible,required" query:"eligible,required"`
+	// 使该计划永远不会下发的前序计划，为空时表示可达
+	ShadowedBy *int64 `thrift:"shadowed_by,5,optional" form:"shadowed_by" json:"shadowed_by,omitempty" query:"shadowed_by"`
+}
+
+func NewCloudSettingPlanCoverage() *CloudSettingPlanCoverage {
+	return &CloudSettingPlanCoverage{}
+}
+
+func (p *CloudSettingPlanCoverage) InitDefault() {
+}
+
+func (p *CloudSettingPlanCoverage) GetIndex() (v int64) {
+	return p.Index
+}
+
+var CloudSettingPlanCoverage_Name_DEFAULT string
+
+func (p *CloudSettingPlanCoverage) GetName() (v string) {
+	if !p.IsSetName() {
+		return CloudSettingPlanCoverage_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+func (p *CloudSettingPlanCoverage) GetHits() (v int64) {
+	return p.Hits
+}
+
+func (p *CloudSettingPlanCoverage) GetEligible() (v int64) {
+	return p.Eligible
+}
+
+var CloudSettingPlanCoverage_ShadowedBy_DEFAULT int64
+
+func (p *CloudSettingPlanCoverage) GetShadowedBy() (v int64) {
+	if !p.IsSetShadowedBy() {
+		return CloudSettingPlanCoverage_ShadowedBy_DEFAULT
+	}
+	return *p.ShadowedBy
+}
+
+func (p *CloudSettingPlanCoverage) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *CloudSettingPlanCoverage) IsSetShadowedBy() bool {
+	return p.ShadowedBy != nil
+}
+
+func (p *CloudSettingPlanCoverage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingPlanCoverage(%+v)", *p)
+}
+
+type CloudSettingUnmatched struct {
+	// 没有命中任何计划的条件
+	Criteria *CloudSettingCriteria `thrift:"criteria,1,required" form:"criteria,required" json:"criteria,required" query:"criteria,required"`
+	// 在样本中出现的次数
+	Count int64 `thrift:"count,2,required" form:"count,required" json:"count,required" query:"count,required"`
+}
+
+func NewCloudSettingUnmatched() *CloudSettingUnmatched {
+	return &CloudSettingUnmatched{}
+}
+
+func (p *CloudSettingUnmatched) InitDefault() {
+}
+
+var CloudSettingUnmatched_Criteria_DEFAULT *CloudSettingCriteria
+
+func (p *CloudSettingUnmatched) GetCriteria() (v *CloudSettingCriteria) {
+	if !p.IsSetCriteria() {
+		return CloudSettingUnmatched_Criteria_DEFAULT
+	}
+	return p.Criteria
+}
+
+func (p *CloudSettingUnmatched) GetCount() (v int64) {
+	return p.Count
+}
+
+func (p *CloudSettingUnmatched) IsSetCriteria() bool {
+	return p.Criteria != nil
+}
+
+func (p *CloudSettingUnmatched) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingUnmatched(%+v)", *p)
+}
+
+type CloudSettingCoverage struct {
+	// 参与统计的样本数
+	Samples int64                       `thrift:"samples,1,required" form:"samples,required" json:"samples,required" query:"samples,required"`
+	Plans   []*CloudSettingPlanCoverage `thrift:"plans,2,required,list<CloudSettingPlanCoverage>" form:"plans,required" json:"plans,required" query:"plans,required"`
+	// 按出现次数从多到少排列
+	Unmatched []*CloudSettingUnmatched `thrift:"unmatched,3,required,list<CloudSettingUnmatched>" form:"unmatched,required" json:"unmatched,required" query:"unmatched,required"`
+}
+
+func NewCloudSettingCoverage() *CloudSettingCoverage {
+	return &CloudSettingCoverage{}
+}
+
+func (p *CloudSettingCoverage) InitDefault() {
+}
+
+func (p *CloudSettingCoverage) GetSamples() (v int64) {
+	return p.Samples
+}
+
+func (p *CloudSettingCoverage) GetPlans() (v []*CloudSettingPlanCoverage) {
+	return p.Plans
+}
+
+func (p *CloudSettingCoverage) GetUnmatched() (v []*CloudSettingUnmatched) {
+	return p.Unmatched
+}
+
+func (p *CloudSettingCoverage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingCoverage(%+v)", *p)
+}
+
 // ====== OA ======
 type Feedback struct {
 	ReportID     int64  `thrift:"report_id,1,required" form:"report_id,required" json:"report_id,required" query:"report_id,required"`
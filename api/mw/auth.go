@@ -28,17 +28,18 @@ import (
 )
 
 // Auth 负责校验用户身份，会提取 token 并做处理，Next 时会携带 token 类型
+// token 中带有学号时沿用到新签发的 token，并将学号传入 context
 func Auth() app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
 		token := string(c.GetHeader(constants.AuthHeader))
-		_, _, err := CheckToken(token)
+		_, stuId, err := CheckToken(token)
 		if err != nil {
 			pack.RespError(c, err)
 			c.Abort()
 			return
 		}
 
-		access, refresh, err := CreateAllToken()
+		access, refresh, err := CreateAllToken(stuId)
 		if err != nil {
 			pack.RespError(c, err)
 			c.Abort()
@@ -47,6 +48,9 @@ func Auth() app.HandlerFunc {
 
 		c.Header(constants.AccessTokenHeader, access)
 		c.Header(constants.RefreshTokenHeader, refresh)
+		if stuId != "" {
+			c.Set(constants.StuIDContextKey, stuId)
+		}
 		c.Next(ctx)
 	}
 }
@@ -0,0 +1,168 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+// 告警事件类型
+const (
+	AlertFiring    = "firing"
+	AlertRecovered = "recovered"
+)
+
+const percent = 100
+
+// Alert 是发送给告警通道的路由异常信息
+type Alert struct {
+	Event         string      `json:"event"`
+	Route         string      `json:"route"`
+	Requests      int64       `json:"requests"`
+	Errors        int64       `json:"errors"`
+	ErrorRate     float64     `json:"error_rate"`
+	Threshold     float64     `json:"threshold"`
+	WindowSeconds int64       `json:"window_seconds"`
+	TraceIDs      []string    `json:"trace_ids"`
+	TopCodes      []CodeCount `json:"top_codes"`
+	Timestamp     time.Time   `json:"timestamp"`
+}
+
+// CodeCount 是窗口内某个错误码出现的次数
+type CodeCount struct {
+	Code  int64 `json:"code"`
+	Count int64 `json:"count"`
+}
+
+// AlertSink 是告警的发送通道，Send 应当在 ctx 超时后尽快返回
+type AlertSink interface {
+	Name() string
+	Send(ctx context.Context, alert Alert) error
+}
+
+// AlertRoute 描述一个告警通道以及哪些告警会被投递给它
+type AlertRoute struct {
+	Sink          AlertSink
+	RoutePrefixes []string // 为空时接收全部路由
+	MinErrorRate  float64  // 只投递错误率不低于该值的告警，恢复通知不受影响
+	SendRecovered bool
+}
+
+func (r AlertRoute) match(alert Alert) bool {
+	if alert.Event == AlertRecovered {
+		if !r.SendRecovered {
+			return false
+		}
+	} else if alert.ErrorRate < r.MinErrorRate {
+		return false
+	}
+
+	if len(r.RoutePrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range r.RoutePrefixes {
+		if strings.HasPrefix(alert.Route, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Summary 生成告警的文本摘要，供 IM 和邮件等面向人的通道使用
+func (a Alert) Summary() string {
+	title := "API 异常告警"
+	if a.Event == AlertRecovered {
+		title = "API 异常恢复"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[%s] %s\n", title, a.Route)
+	fmt.Fprintf(&sb, "错误率: %.2f%% (阈值 %.2f%%)，请求 %d，错误 %d，窗口 %ds\n",
+		a.ErrorRate*percent, a.Threshold*percent, a.Requests, a.Errors, a.WindowSeconds)
+	if len(a.TopCodes) > 0 {
+		codes := make([]string, 0, len(a.TopCodes))
+		for _, c := range a.TopCodes {
+			codes = append(codes, strconv.FormatInt(c.Code, 10)+"×"+strconv.FormatInt(c.Count, 10))
+		}
+		fmt.Fprintf(&sb, "错误码: %s\n", strings.Join(codes, ", "))
+	}
+	if len(a.TraceIDs) > 0 {
+		fmt.Fprintf(&sb, "TraceID: %s\n", strings.Join(a.TraceIDs, ", "))
+	}
+	fmt.Fprintf(&sb, "时间: %s", a.Timestamp.Format(time.DateTime))
+	return sb.String()
+}
+
+// topCodes 按出现次数降序返回前 n 个错误码，次数相同时按错误码升序
+func topCodes(codes map[int64]int64, n int) []CodeCount {
+	res := make([]CodeCount, 0, len(codes))
+	for code, count := range codes {
+		res = append(res, CodeCount{Code: code, Count: count})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Code < res[j].Code
+	})
+	if len(res) > n {
+		res = res[:n]
+	}
+	return res
+}
+
+func (m *apiMonitor) buildAlert(event string, route string, stat routeStat, now time.Time) Alert {
+	return Alert{
+		Event:         event,
+		Route:         route,
+		Requests:      stat.requests,
+		Errors:        stat.errors,
+		ErrorRate:     stat.errorRate,
+		Threshold:     m.cfg.Threshold,
+		WindowSeconds: int64(m.cfg.Window.Seconds()),
+		TraceIDs:      stat.traceIDs,
+		TopCodes:      topCodes(stat.codes, constants.APIMonitorTopCodes),
+		Timestamp:     now,
+	}
+}
+
+// notify 将告警异步投递给所有匹配的通道，避免网络请求阻塞监控检查
+func (m *apiMonitor) notify(alert Alert) {
+	for _, route := range m.cfg.Sinks {
+		if route.Sink == nil || !route.match(alert) {
+			continue
+		}
+		sink := route.Sink
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), constants.APIMonitorSinkTimeout)
+			defer cancel()
+			if err := sink.Send(ctx, alert); err != nil {
+				logger.Errorf("api monitor: send alert of route %s to sink %s failed, err: %v", alert.Route, sink.Name(), err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSink struct {
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func (s *fakeSink) Name() string {
+	return "fake"
+}
+
+func (s *fakeSink) Send(_ context.Context, alert Alert) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.alerts = append(s.alerts, alert)
+	return nil
+}
+
+func TestAlertRouteMatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		route    AlertRoute
+		alert    Alert
+		expected bool
+	}{
+		{
+			name:     "empty rule matches firing alert",
+			route:    AlertRoute{},
+			alert:    Alert{Event: AlertFiring, Route: "/api/foo", ErrorRate: 0.1},
+			expected: true,
+		},
+		{
+			name:     "route prefix matches",
+			route:    AlertRoute{RoutePrefixes: []string{"/api/v1/jwch"}},
+			alert:    Alert{Event: AlertFiring, Route: "/api/v1/jwch/course/list"},
+			expected: true,
+		},
+		{
+			name:     "route prefix mismatches",
+			route:    AlertRoute{RoutePrefixes: []string{"/api/v1/jwch"}},
+			alert:    Alert{Event: AlertFiring, Route: "/api/v1/common/term"},
+			expected: false,
+		},
+		{
+			name:     "error rate below min",
+			route:    AlertRoute{MinErrorRate: 0.5},
+			alert:    Alert{Event: AlertFiring, Route: "/api/foo", ErrorRate: 0.2},
+			expected: false,
+		},
+		{
+			name:     "recovered alert is skipped by default",
+			route:    AlertRoute{},
+			alert:    Alert{Event: AlertRecovered, Route: "/api/foo"},
+			expected: false,
+		},
+		{
+			name:     "recovered alert ignores min error rate",
+			route:    AlertRoute{SendRecovered: true, MinErrorRate: 0.5},
+			alert:    Alert{Event: AlertRecovered, Route: "/api/foo"},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.route.match(tc.alert))
+		})
+	}
+}
+
+func TestTopCodes(t *testing.T) {
+	codes := map[int64]int64{50001: 3, 40007: 5, 50004: 3, 20001: 1}
+	assert.Equal(t, []CodeCount{
+		{Code: 40007, Count: 5},
+		{Code: 50001, Count: 3},
+		{Code: 50004, Count: 3},
+	}, topCodes(codes, 3))
+	assert.Empty(t, topCodes(nil, 3))
+}
+
+func TestAggregateRouteStatsSamples(t *testing.T) {
+	events := make([]requestEvent, 0)
+	for i := 0; i < 8; i++ {
+		events = append(events, requestEvent{route: "/api/foo", errorCode: 50001, traceID: "trace"})
+	}
+	events = append(events,
+		requestEvent{route: "/api/foo", errorCode: 40007},
+		requestEvent{route: "/api/foo"},
+	)
+
+	stat := aggregateRouteStats(events)["/api/foo"]
+	assert.Len(t, stat.traceIDs, 5)
+	assert.Equal(t, map[int64]int64{50001: 8, 40007: 1}, stat.codes)
+}
+
+func TestAlertSummary(t *testing.T) {
+	alert := Alert{
+		Event:         AlertFiring,
+		Route:         "/api/foo",
+		Requests:      200,
+		Errors:        50,
+		ErrorRate:     0.25,
+		Threshold:     0.05,
+		WindowSeconds: 300,
+		TraceIDs:      []string{"trace-1", "trace-2"},
+		TopCodes:      []CodeCount{{Code: 50001, Count: 40}, {Code: 40007, Count: 10}},
+		Timestamp:     time.Date(2026, time.July, 30, 12, 0, 0, 0, time.Local),
+	}
+
+	summary := alert.Summary()
+	assert.Contains(t, summary, "[API 异常告警] /api/foo")
+	assert.Contains(t, summary, "错误率: 25.00% (阈值 5.00%)，请求 200，错误 50，窗口 300s")
+	assert.Contains(t, summary, "错误码: 50001×40, 40007×10")
+	assert.Contains(t, summary, "TraceID: trace-1, trace-2")
+	assert.Contains(t, summary, "时间: 2026-07-30 12:00:00")
+
+	alert.Event = AlertRecovered
+	assert.Contains(t, alert.Summary(), "[API 异常恢复]")
+}
+
+func TestMonitorNotifiesSinks(t *testing.T) {
+	all, jwchOnly := &fakeSink{}, &fakeSink{}
+	monitor := newAPIMonitor(MonitorConfig{
+		Enabled:     true,
+		Window:      time.Minute,
+		Threshold:   0.5,
+		MinRequests: 2,
+		Cooldown:    10 * time.Minute,
+		Sinks: []AlertRoute{
+			{Sink: all, SendRecovered: true},
+			{Sink: jwchOnly, RoutePrefixes: []string{"/api/v1/jwch"}},
+		},
+	})
+	now := time.Date(2026, time.July, 30, 12, 0, 0, 0, time.UTC)
+	stat := routeStat{
+		requests:  4,
+		errors:    2,
+		errorRate: 0.5,
+		traceIDs:  []string{"trace-1", "trace-2"},
+		codes:     map[int64]int64{50001: 2},
+	}
+
+	monitor.checkRoute(now, "/api/foo", stat)
+	stat.errorRate = 0.1
+	monitor.checkRoute(now.Add(time.Minute), "/api/foo", stat)
+	monitor.wg.Wait()
+
+	if assert.Len(t, all.alerts, 2) {
+		events := []string{all.alerts[0].Event, all.alerts[1].Event}
+		assert.ElementsMatch(t, []string{AlertFiring, AlertRecovered}, events)
+		for _, alert := range all.alerts {
+			if alert.Event == AlertFiring {
+				assert.Equal(t, []string{"trace-1", "trace-2"}, alert.TraceIDs)
+				assert.Equal(t, []CodeCount{{Code: 50001, Count: 2}}, alert.TopCodes)
+				assert.Equal(t, int64(60), alert.WindowSeconds)
+			}
+		}
+	}
+	assert.Empty(t, jwchOnly.alerts)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+)
+
+// unmatchedRoute 用于未命中路由的请求，避免把任意路径写入指标标签
+const unmatchedRoute = "unmatched"
+
+// MetricsMiddleware 记录每个路由的请求数和耗时，供 Prometheus 抓取
+func MetricsMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		start := time.Now()
+		c.Next(ctx)
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		method := string(c.Method())
+		metrics.HTTPRequests.WithLabelValues(route, method, strconv.Itoa(c.Response.StatusCode())).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(route, method).Observe(time.Since(start).Seconds())
+	}
+}
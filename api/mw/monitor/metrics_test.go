@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/config"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/hertz/pkg/route"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	type testCase struct {
+		name         string
+		url          string
+		expectRoute  string
+		expectStatus string
+	}
+
+	testCases := []testCase{
+		{
+			name:         "matched route uses route template",
+			url:          "/api/v1/foo/123",
+			expectRoute:  "/api/v1/foo/:id",
+			expectStatus: "200",
+		},
+		{
+			name:         "unmatched route",
+			url:          "/not/exist",
+			expectRoute:  unmatchedRoute,
+			expectStatus: "404",
+		},
+	}
+
+	router := route.NewEngine(&config.Options{})
+	router.Use(MetricsMiddleware())
+	router.GET("/api/v1/foo/:id", func(ctx context.Context, c *app.RequestContext) {
+		c.String(consts.StatusOK, "ok")
+	})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := metrics.HTTPRequests.WithLabelValues(tc.expectRoute, consts.MethodGet, tc.expectStatus)
+			before := testutil.ToFloat64(counter)
+			ut.PerformRequest(router, consts.MethodGet, tc.url, nil)
+			assert.Equal(t, float64(1), testutil.ToFloat64(counter)-before)
+		})
+	}
+}
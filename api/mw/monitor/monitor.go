@@ -36,6 +36,7 @@ type MonitorConfig struct {
 	MinRequests   int64
 	Cooldown      time.Duration
 	Blacklist     map[string]struct{}
+	Sinks         []AlertRoute // 告警通道，为空时只记录日志
 }
 
 var (
@@ -90,6 +91,16 @@ func StartAPIMonitor(cfg MonitorConfig) func(context.Context) {
 			case <-ctx.Done():
 				return
 			}
+			// 尽量等待已触发的告警发送完成
+			sendDone := make(chan struct{})
+			go func() {
+				apiMonitorInstance.wg.Wait()
+				close(sendDone)
+			}()
+			select {
+			case <-sendDone:
+			case <-ctx.Done():
+			}
 		}
 	})
 
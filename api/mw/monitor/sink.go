@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import "fmt"
+
+// 告警通道类型
+const (
+	SinkWebhook = "webhook"
+	SinkEmail   = "email"
+	SinkKafka   = "kafka"
+)
+
+// SinkConfig 是单个告警通道的配置，不同类型只使用各自相关的字段
+type SinkConfig struct {
+	Name string
+	Type string
+
+	// webhook
+	Format string
+	URL    string
+	Secret string
+
+	// email
+	SMTPAddr string
+	Username string
+	Password string
+	From     string
+	To       []string
+
+	// kafka
+	Topic string
+}
+
+// NewAlertSink 根据配置创建告警通道
+func NewAlertSink(cfg SinkConfig) (AlertSink, error) {
+	switch cfg.Type {
+	case SinkWebhook:
+		return NewWebhookSink(cfg.Name, cfg.Format, cfg.URL, cfg.Secret)
+	case SinkEmail:
+		return NewEmailSink(cfg.Name, cfg.SMTPAddr, cfg.Username, cfg.Password, cfg.From, cfg.To)
+	case SinkKafka:
+		return NewKafkaSink(cfg.Name, cfg.Topic)
+	default:
+		return nil, fmt.Errorf("monitor.NewAlertSink: unsupported sink type %q of sink %s", cfg.Type, cfg.Name)
+	}
+}
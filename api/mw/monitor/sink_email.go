@@ -0,0 +1,97 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+// emailSink 通过 SMTP 发送告警邮件，服务器支持时会自动使用 STARTTLS
+type emailSink struct {
+	name string
+	addr string // host:port
+	auth smtp.Auth
+	from string
+	to   []string
+}
+
+// NewEmailSink 创建邮件告警通道，username 为空时不进行认证
+func NewEmailSink(name, addr, username, password, from string, to []string) (AlertSink, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("monitor.NewEmailSink: invalid smtp addr %q: %w", addr, err)
+	}
+	if from == "" || len(to) == 0 {
+		return nil, fmt.Errorf("monitor.NewEmailSink: sender or recipients of sink %s is empty", name)
+	}
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &emailSink{
+		name: name,
+		addr: addr,
+		auth: auth,
+		from: from,
+		to:   to,
+	}, nil
+}
+
+func (s *emailSink) Name() string {
+	return s.name
+}
+
+// Send 在单独的 goroutine 中发送邮件，net/smtp 不支持 context，超时后直接返回
+func (s *emailSink) Send(ctx context.Context, alert Alert) error {
+	msg := s.buildMessage(alert)
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(s.addr, s.auth, s.from, s.to, msg)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("monitor.emailSink.Send: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("monitor.emailSink.Send: %w", ctx.Err())
+	}
+}
+
+func (s *emailSink) buildMessage(alert Alert) []byte {
+	subject := "[fzuhelper] API 异常告警 " + alert.Route
+	if alert.Event == AlertRecovered {
+		subject = "[fzuhelper] API 异常恢复 " + alert.Route
+	}
+
+	var sb strings.Builder
+	sb.WriteString("From: " + s.from + "\r\n")
+	sb.WriteString("To: " + strings.Join(s.to, ", ") + "\r\n")
+	sb.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", subject) + "\r\n")
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(strings.ReplaceAll(alert.Summary(), "\n", "\r\n"))
+	sb.WriteString("\r\n")
+	return []byte(sb.String())
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/west2-online/fzuhelper-server/pkg/kafka"
+)
+
+// kafkaSink 将告警以 JSON 写入 Kafka topic，供下游告警平台消费
+type kafkaSink struct {
+	name  string
+	topic string
+	mq    *kafka.Kafka
+}
+
+// NewKafkaSink 创建 Kafka 告警通道，并提前为 topic 创建 writer
+func NewKafkaSink(name, topic string) (AlertSink, error) {
+	if topic == "" {
+		return nil, fmt.Errorf("monitor.NewKafkaSink: topic of sink %s is empty", name)
+	}
+	mq := kafka.NewKafkaInstance()
+	if err := mq.SetWriter(topic); err != nil {
+		return nil, fmt.Errorf("monitor.NewKafkaSink: set writer failed: %w", err)
+	}
+	return &kafkaSink{
+		name:  name,
+		topic: topic,
+		mq:    mq,
+	}, nil
+}
+
+func (s *kafkaSink) Name() string {
+	return s.name
+}
+
+func (s *kafkaSink) Send(ctx context.Context, alert Alert) error {
+	value, err := sonic.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("monitor.kafkaSink.Send: marshal alert failed: %w", err)
+	}
+	// 以路由作为 key，保证同一路由的告警和恢复通知有序
+	if errs := s.mq.Send(ctx, s.topic, []*kafka.Message{{K: []byte(alert.Route), V: value}}); len(errs) > 0 {
+		return fmt.Errorf("monitor.kafkaSink.Send: %w", errors.Join(errs...))
+	}
+	return nil
+}
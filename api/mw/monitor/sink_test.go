@@ -0,0 +1,216 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAlertSink(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         SinkConfig
+		expectError string
+	}{
+		{
+			name: "webhook",
+			cfg:  SinkConfig{Name: "hook", Type: SinkWebhook, URL: "http://127.0.0.1/hook"},
+		},
+		{
+			name:        "webhook without url",
+			cfg:         SinkConfig{Name: "hook", Type: SinkWebhook},
+			expectError: "url of sink hook is empty",
+		},
+		{
+			name:        "webhook with unknown format",
+			cfg:         SinkConfig{Name: "hook", Type: SinkWebhook, Format: "slack", URL: "http://127.0.0.1/hook"},
+			expectError: "unsupported format",
+		},
+		{
+			name: "email",
+			cfg: SinkConfig{
+				Name: "mail", Type: SinkEmail, SMTPAddr: "smtp.example.com:587",
+				Username: "user", Password: "pass", From: "alert@example.com", To: []string{"admin@example.com"},
+			},
+		},
+		{
+			name:        "email with invalid addr",
+			cfg:         SinkConfig{Name: "mail", Type: SinkEmail, SMTPAddr: "smtp.example.com", From: "a@b.c", To: []string{"d@e.f"}},
+			expectError: "invalid smtp addr",
+		},
+		{
+			name:        "email without recipients",
+			cfg:         SinkConfig{Name: "mail", Type: SinkEmail, SMTPAddr: "smtp.example.com:587", From: "a@b.c"},
+			expectError: "sender or recipients",
+		},
+		{
+			name:        "kafka without topic",
+			cfg:         SinkConfig{Name: "mq", Type: SinkKafka},
+			expectError: "topic of sink mq is empty",
+		},
+		{
+			name:        "unknown type",
+			cfg:         SinkConfig{Name: "x", Type: "sms"},
+			expectError: "unsupported sink type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink, err := NewAlertSink(tc.cfg)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.cfg.Name, sink.Name())
+		})
+	}
+}
+
+func TestWebhookSinkSend(t *testing.T) {
+	alert := Alert{Event: AlertFiring, Route: "/api/foo", ErrorRate: 0.5, Timestamp: time.Now()}
+	testCases := []struct {
+		name        string
+		format      string
+		secret      string
+		status      int
+		response    string
+		expectError bool
+		check       func(t *testing.T, query url.Values, body map[string]any)
+	}{
+		{
+			name:   "generic",
+			format: WebhookGeneric,
+			status: http.StatusOK,
+			check: func(t *testing.T, _ url.Values, body map[string]any) {
+				assert.Equal(t, "/api/foo", body["route"])
+				assert.Equal(t, AlertFiring, body["event"])
+			},
+		},
+		{
+			name:     "feishu with sign",
+			format:   WebhookFeishu,
+			secret:   "secret",
+			status:   http.StatusOK,
+			response: `{"code":0,"msg":"success","data":{}}`,
+			check: func(t *testing.T, _ url.Values, body map[string]any) {
+				assert.Equal(t, "text", body["msg_type"])
+				assert.Contains(t, body["content"].(map[string]any)["text"], "/api/foo")
+				assert.NotEmpty(t, body["sign"])
+				assert.NotEmpty(t, body["timestamp"])
+			},
+		},
+		{
+			name:     "dingtalk with sign",
+			format:   WebhookDingTalk,
+			secret:   "secret",
+			status:   http.StatusOK,
+			response: `{"errcode":0,"errmsg":"ok"}`,
+			check: func(t *testing.T, query url.Values, body map[string]any) {
+				assert.Equal(t, "text", body["msgtype"])
+				assert.Equal(t, "token", query.Get("access_token"))
+				assert.NotEmpty(t, query.Get("sign"))
+				assert.NotEmpty(t, query.Get("timestamp"))
+			},
+		},
+		{
+			name:     "wecom",
+			format:   WebhookWeCom,
+			status:   http.StatusOK,
+			response: `{"errcode":0,"errmsg":"ok"}`,
+			check: func(t *testing.T, _ url.Values, body map[string]any) {
+				assert.Equal(t, "text", body["msgtype"])
+				assert.Contains(t, body["text"].(map[string]any)["content"], "[API 异常告警]")
+			},
+		},
+		{
+			name:        "unexpected status",
+			format:      WebhookGeneric,
+			status:      http.StatusInternalServerError,
+			expectError: true,
+		},
+		{
+			name:        "feishu sign mismatch",
+			format:      WebhookFeishu,
+			secret:      "secret",
+			status:      http.StatusOK,
+			response:    `{"code":19021,"msg":"sign match fail or timestamp is not within one hour from current time"}`,
+			expectError: true,
+		},
+		{
+			name:        "dingtalk rate limited",
+			format:      WebhookDingTalk,
+			status:      http.StatusOK,
+			response:    `{"errcode":660026,"errmsg":"send too fast"}`,
+			expectError: true,
+		},
+		{
+			name:        "wecom invalid response",
+			format:      WebhookWeCom,
+			status:      http.StatusOK,
+			response:    `<html></html>`,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var query url.Values
+			var body map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				raw, _ := io.ReadAll(r.Body)
+				_ = sonic.Unmarshal(raw, &body)
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			sink, err := NewWebhookSink("hook", tc.format, server.URL+"?access_token=token", tc.secret)
+			assert.NoError(t, err)
+			err = sink.Send(context.Background(), alert)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			tc.check(t, query, body)
+		})
+	}
+}
+
+func TestEmailSinkBuildMessage(t *testing.T) {
+	sink, err := NewEmailSink("mail", "smtp.example.com:587", "", "", "alert@example.com", []string{"a@example.com", "b@example.com"})
+	assert.NoError(t, err)
+
+	msg := string(sink.(*emailSink).buildMessage(Alert{Event: AlertRecovered, Route: "/api/foo"}))
+	assert.Contains(t, msg, "From: alert@example.com\r\n")
+	assert.Contains(t, msg, "To: a@example.com, b@example.com\r\n")
+	assert.Contains(t, msg, "Subject: =?UTF-8?b?")
+	assert.True(t, strings.Contains(msg, "\r\n\r\n[API 异常恢复] /api/foo\r\n"))
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/sonic"
+)
+
+// 支持的 webhook 消息格式
+const (
+	WebhookGeneric  = "generic" // 直接发送 Alert 的 JSON
+	WebhookFeishu   = "feishu"
+	WebhookDingTalk = "dingtalk"
+	WebhookWeCom    = "wecom"
+)
+
+// webhookSink 通过 HTTP POST 将告警发送到 IM 机器人或自定义服务
+type webhookSink struct {
+	name   string
+	format string
+	url    string
+	secret string // 飞书和钉钉机器人的加签密钥，为空时不签名
+	client *http.Client
+}
+
+// NewWebhookSink 创建 webhook 告警通道，format 为空时使用 generic
+func NewWebhookSink(name, format, webhookURL, secret string) (AlertSink, error) {
+	switch format {
+	case "":
+		format = WebhookGeneric
+	case WebhookGeneric, WebhookFeishu, WebhookDingTalk, WebhookWeCom:
+	default:
+		return nil, fmt.Errorf("monitor.NewWebhookSink: unsupported format %q", format)
+	}
+	if webhookURL == "" {
+		return nil, fmt.Errorf("monitor.NewWebhookSink: url of sink %s is empty", name)
+	}
+	return &webhookSink{
+		name:   name,
+		format: format,
+		url:    webhookURL,
+		secret: secret,
+		client: &http.Client{},
+	}, nil
+}
+
+func (s *webhookSink) Name() string {
+	return s.name
+}
+
+func (s *webhookSink) Send(ctx context.Context, alert Alert) error {
+	target, body, err := s.buildRequest(alert, time.Now())
+	if err != nil {
+		return err
+	}
+	payload, err := sonic.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("monitor.webhookSink.Send: marshal payload failed: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("monitor.webhookSink.Send: build request failed: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("monitor.webhookSink.Send: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("monitor.webhookSink.Send: unexpected status %d, body: %s", resp.StatusCode, respBody)
+	}
+	return s.checkResponse(respBody)
+}
+
+// webhookResponse IM 机器人的响应体，飞书使用 code/msg，钉钉与企业微信使用 errcode/errmsg
+type webhookResponse struct {
+	Code    int    `json:"code"`
+	Msg     string `json:"msg"`
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+// checkResponse IM 机器人在签名错误、限流等情况下仍返回 200，需要根据响应体中的错误码判断是否发送成功
+func (s *webhookSink) checkResponse(body []byte) error {
+	if s.format == WebhookGeneric {
+		return nil
+	}
+	resp := new(webhookResponse)
+	if err := sonic.Unmarshal(body, resp); err != nil {
+		return fmt.Errorf("monitor.webhookSink.Send: unmarshal response failed: %w, body: %s", err, body)
+	}
+	switch s.format {
+	case WebhookFeishu:
+		if resp.Code != 0 {
+			return fmt.Errorf("monitor.webhookSink.Send: feishu error code %d: %s", resp.Code, resp.Msg)
+		}
+	default:
+		if resp.ErrCode != 0 {
+			return fmt.Errorf("monitor.webhookSink.Send: %s error code %d: %s", s.format, resp.ErrCode, resp.ErrMsg)
+		}
+	}
+	return nil
+}
+
+// buildRequest 按机器人的消息格式构造请求地址和请求体
+func (s *webhookSink) buildRequest(alert Alert, now time.Time) (string, any, error) {
+	text := alert.Summary()
+	switch s.format {
+	case WebhookFeishu:
+		body := map[string]any{
+			"msg_type": "text",
+			"content":  map[string]string{"text": text},
+		}
+		if s.secret != "" {
+			timestamp := strconv.FormatInt(now.Unix(), 10)
+			sign, err := feishuSign(timestamp, s.secret)
+			if err != nil {
+				return "", nil, err
+			}
+			body["timestamp"] = timestamp
+			body["sign"] = sign
+		}
+		return s.url, body, nil
+	case WebhookDingTalk:
+		body := map[string]any{
+			"msgtype": "text",
+			"text":    map[string]string{"content": text},
+		}
+		if s.secret == "" {
+			return s.url, body, nil
+		}
+		target, err := dingTalkSignedURL(s.url, s.secret, now)
+		return target, body, err
+	case WebhookWeCom:
+		return s.url, map[string]any{
+			"msgtype": "text",
+			"text":    map[string]string{"content": text},
+		}, nil
+	default:
+		return s.url, alert, nil
+	}
+}
+
+// feishuSign 飞书签名：以 timestamp + "\n" + secret 为密钥对空串做 HmacSHA256
+func feishuSign(timestamp, secret string) (string, error) {
+	h := hmac.New(sha256.New, []byte(timestamp+"\n"+secret))
+	if _, err := h.Write(nil); err != nil {
+		return "", fmt.Errorf("monitor.feishuSign: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// dingTalkSignedURL 钉钉签名：以 secret 为密钥对 timestamp(ms) + "\n" + secret 做 HmacSHA256，结果附加在 URL 上
+func dingTalkSignedURL(webhookURL, secret string, now time.Time) (string, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return "", fmt.Errorf("monitor.dingTalkSignedURL: parse url failed: %w", err)
+	}
+	timestamp := strconv.FormatInt(now.UnixMilli(), 10)
+	h := hmac.New(sha256.New, []byte(secret))
+	if _, err = h.Write([]byte(timestamp + "\n" + secret)); err != nil {
+		return "", fmt.Errorf("monitor.dingTalkSignedURL: %w", err)
+	}
+	query := u.Query()
+	query.Set("timestamp", timestamp)
+	query.Set("sign", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
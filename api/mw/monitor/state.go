@@ -24,6 +24,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
 )
@@ -43,6 +44,8 @@ type routeStat struct {
 	errorRate float64
 	traceID   string
 	errorCode int64
+	traceIDs  []string        // 采样的错误请求 trace id，用于告警排查
+	codes     map[int64]int64 // 各错误码出现次数
 }
 
 // alertState 记录单个路由当前的报警状态。
@@ -60,6 +63,7 @@ type apiMonitor struct {
 	cfg    MonitorConfig
 	events []requestEvent
 	alerts map[string]alertState
+	wg     sync.WaitGroup // 等待正在发送的告警
 }
 
 func newAPIMonitor(cfg MonitorConfig) *apiMonitor {
@@ -117,6 +121,7 @@ func (m *apiMonitor) check() {
 	for route, alert := range m.alerts {
 		if _, ok := stats[route]; !ok && alert.firing {
 			m.logRecovered(route, routeStat{})
+			m.notify(m.buildAlert(AlertRecovered, route, routeStat{}, now))
 			delete(m.alerts, route)
 		}
 	}
@@ -138,12 +143,14 @@ func (m *apiMonitor) checkRoute(now time.Time, route string, stat routeStat) {
 			alert.lastErrors = stat.errors
 			m.alerts[route] = alert
 			m.logAlert(route, stat)
+			m.notify(m.buildAlert(AlertFiring, route, stat, now))
 		}
 		return
 	}
 
 	if alert.firing {
 		m.logRecovered(route, stat)
+		m.notify(m.buildAlert(AlertRecovered, route, stat, now))
 		delete(m.alerts, route)
 	}
 }
@@ -186,6 +193,13 @@ func aggregateRouteStats(events []requestEvent) map[string]routeStat {
 				stat.traceID = event.traceID
 				stat.errorCode = event.errorCode
 			}
+			if event.traceID != "" && len(stat.traceIDs) < constants.APIMonitorSampleTraces {
+				stat.traceIDs = append(stat.traceIDs, event.traceID)
+			}
+			if stat.codes == nil {
+				stat.codes = make(map[int64]int64)
+			}
+			stat.codes[event.errorCode]++
 		}
 		stats[event.route] = stat
 	}
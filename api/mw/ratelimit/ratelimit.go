@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/fzuhelper-server/api/pack"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+)
+
+// 限流维度
+const (
+	KeyByStudent = "student" // 按有效 token 中的学号限流，token 无效或不含学号时退化为 IP
+	KeyByIP      = "ip"
+	KeyByToken   = "token" // 按有效的 Authorization 头限流，缺失或无效时退化为 IP
+)
+
+// Policy 是单个路由的限流策略
+type Policy struct {
+	Route  string
+	Method string // 为空时匹配所有方法
+	KeyBy  string
+	Limit  int64
+	Window time.Duration
+	Query  map[string]string // 仅当请求携带全部指定的查询参数时生效
+}
+
+// LimiterConfig 是 API 限流的配置参数
+type LimiterConfig struct {
+	Enabled  bool
+	Policies []Policy
+}
+
+var limiterInstance *rateLimiter
+
+// InitRateLimiter 初始化限流器，client 为 nil 或未启用时中间件直接放行
+func InitRateLimiter(cfg LimiterConfig, client *redis.Client) {
+	limiterInstance = newRateLimiter(cfg, client)
+}
+
+// RateLimitMiddleware 基于 Redis 滑动窗口对命中策略的路由进行限流
+// 同一路由可以配置多条策略（例如同时按学号和 IP 限制），任意一条超限即拒绝
+// Redis 异常时放行请求，避免限流器本身成为单点故障
+func RateLimitMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if limiterInstance == nil || !limiterInstance.enabled() {
+			c.Next(ctx)
+			return
+		}
+
+		route := routeName(c)
+		for _, policy := range limiterInstance.match(c, route) {
+			allowed, retryAfter, err := limiterInstance.allow(ctx, policy, buildKey(c, policy))
+			if err != nil {
+				logger.Errorf("ratelimit: check route %s failed, err: %v", route, err)
+				metrics.RateLimitRequests.WithLabelValues(route, policy.KeyBy, metrics.RateLimitError).Inc()
+				continue
+			}
+			if !allowed {
+				metrics.RateLimitRequests.WithLabelValues(route, policy.KeyBy, metrics.RateLimitRejected).Inc()
+				c.Header("Retry-After", strconv.FormatInt(int64(retryAfter.Seconds())+1, 10))
+				pack.RespError(c, errno.RateLimitError)
+				c.Abort()
+				return
+			}
+			metrics.RateLimitRequests.WithLabelValues(route, policy.KeyBy, metrics.RateLimitAllowed).Inc()
+		}
+		c.Next(ctx)
+	}
+}
+
+func routeName(c *app.RequestContext) string {
+	if route := c.FullPath(); route != "" {
+		return route
+	}
+	return string(c.Path())
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/api/mw"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+func newRequestContext(method, uri string, headers map[string]string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.Header.SetMethod(method)
+	c.Request.SetRequestURI(uri)
+	for k, v := range headers {
+		c.Request.Header.Set(k, v)
+	}
+	return c
+}
+
+func TestNewRateLimiterSkipsInvalidPolicies(t *testing.T) {
+	limiter := newRateLimiter(LimiterConfig{
+		Enabled: true,
+		Policies: []Policy{
+			{Route: "/api/v1/jwch/academic/scores", KeyBy: KeyByStudent, Limit: 10, Window: time.Minute},
+			{Route: "/api/v1/jwch/academic/scores", KeyBy: KeyByIP, Limit: 0, Window: time.Minute},
+			{Route: "", KeyBy: KeyByIP, Limit: 10, Window: time.Minute},
+			{Route: "/api/v1/jwch/course/list", KeyBy: KeyByIP, Limit: 10},
+		},
+	}, nil)
+
+	assert.Len(t, limiter.policies, 1)
+	assert.Len(t, limiter.policies["/api/v1/jwch/academic/scores"], 1)
+	assert.False(t, limiter.enabled())
+}
+
+func TestRateLimiterMatch(t *testing.T) {
+	refreshPolicy := Policy{
+		Route:  "/api/v1/jwch/course/list",
+		Method: "GET",
+		KeyBy:  KeyByStudent,
+		Limit:  10,
+		Window: time.Minute,
+		Query:  map[string]string{"is_refresh": "true"},
+	}
+	limiter := newRateLimiter(LimiterConfig{Enabled: true, Policies: []Policy{refreshPolicy}}, redis.NewClient(&redis.Options{}))
+
+	testCases := []struct {
+		name     string
+		method   string
+		uri      string
+		expected int
+	}{
+		{
+			name:     "refresh request matches",
+			method:   "GET",
+			uri:      "/api/v1/jwch/course/list?term=202401&is_refresh=true",
+			expected: 1,
+		},
+		{
+			name:     "normal request is not limited",
+			method:   "GET",
+			uri:      "/api/v1/jwch/course/list?term=202401",
+			expected: 0,
+		},
+		{
+			name:     "method mismatch",
+			method:   "POST",
+			uri:      "/api/v1/jwch/course/list?is_refresh=true",
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newRequestContext(tc.method, tc.uri, nil)
+			assert.Len(t, limiter.match(c, refreshPolicy.Route), tc.expected)
+			assert.True(t, limiter.enabled())
+		})
+	}
+}
+
+func TestBuildKey(t *testing.T) {
+	testCases := []struct {
+		name        string
+		keyBy       string
+		headers     map[string]string
+		mockStuID   string
+		mockInvalid bool
+		expected    string
+	}{
+		{
+			name:      "student id from verified token",
+			keyBy:     KeyByStudent,
+			headers:   map[string]string{constants.AuthHeader: "token", "Id": "20241025133150102301318"},
+			mockStuID: "102301317",
+			expected:  constants.RateLimitKeyPrefix + ":/api/foo:student:102301317",
+		},
+		{
+			name:     "token without student id falls back to ip",
+			keyBy:    KeyByStudent,
+			headers:  map[string]string{constants.AuthHeader: "token", "X-Real-IP": "10.0.0.1"},
+			expected: constants.RateLimitKeyPrefix + ":/api/foo:ip:10.0.0.1",
+		},
+		{
+			name:        "invalid token ignores id header",
+			keyBy:       KeyByStudent,
+			headers:     map[string]string{constants.AuthHeader: "forged", "Id": "20241025133150102301317", "X-Real-IP": "10.0.0.1"},
+			mockInvalid: true,
+			expected:    constants.RateLimitKeyPrefix + ":/api/foo:ip:10.0.0.1",
+		},
+		{
+			name:     "missing token falls back to ip",
+			keyBy:    KeyByStudent,
+			headers:  map[string]string{"Id": "20241025133150102301317", "X-Real-IP": "10.0.0.1"},
+			expected: constants.RateLimitKeyPrefix + ":/api/foo:ip:10.0.0.1",
+		},
+		{
+			name:     "token is hashed",
+			keyBy:    KeyByToken,
+			headers:  map[string]string{constants.AuthHeader: "token"},
+			expected: constants.RateLimitKeyPrefix + ":/api/foo:token:3c469e9d6c5875d3",
+		},
+		{
+			name:        "invalid token falls back to ip",
+			keyBy:       KeyByToken,
+			headers:     map[string]string{constants.AuthHeader: "forged", "X-Real-IP": "10.0.0.3"},
+			mockInvalid: true,
+			expected:    constants.RateLimitKeyPrefix + ":/api/foo:ip:10.0.0.3",
+		},
+		{
+			name:     "ip",
+			keyBy:    KeyByIP,
+			headers:  map[string]string{"X-Real-IP": "10.0.0.2"},
+			expected: constants.RateLimitKeyPrefix + ":/api/foo:ip:10.0.0.2",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(mw.CheckToken).To(func(token string) (int64, string, error) {
+				if tc.mockInvalid {
+					return -1, "", errno.AuthInvalid
+				}
+				return constants.TypeAccessToken, tc.mockStuID, nil
+			}).Build()
+
+			c := newRequestContext("GET", "/api/foo", tc.headers)
+			key := buildKey(c, Policy{Route: "/api/foo", KeyBy: tc.keyBy})
+			assert.Equal(t, tc.expected, key)
+		})
+	}
+}
+
+func TestRateLimitMiddlewareDisabled(t *testing.T) {
+	limiterInstance = nil
+	defer func() { limiterInstance = nil }()
+
+	InitRateLimiter(LimiterConfig{Enabled: false}, nil)
+	c := newRequestContext("GET", "/api/foo", nil)
+	called := false
+	c.SetHandlers(app.HandlersChain{RateLimitMiddleware(), func(ctx context.Context, c *app.RequestContext) {
+		called = true
+	}})
+	c.Next(context.Background())
+
+	assert.True(t, called)
+	assert.False(t, c.IsAborted())
+}
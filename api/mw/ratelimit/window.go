@@ -0,0 +1,157 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/fzuhelper-server/api/mw"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+// slidingWindowScript 使用有序集合实现滑动窗口，score 和 ARGV 中的时间均为毫秒
+// 返回 {是否放行, 距离窗口内最早请求过期的毫秒数}
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+if count >= limit then
+  local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+  return {0, tonumber(oldest[2]) + window - now}
+end
+redis.call('ZADD', key, now, ARGV[4])
+redis.call('PEXPIRE', key, window)
+return {1, 0}
+`)
+
+const scriptResultLen = 2
+
+// rateLimiter 维护按路由索引的限流策略
+type rateLimiter struct {
+	cfg      LimiterConfig
+	client   *redis.Client
+	policies map[string][]Policy
+}
+
+func newRateLimiter(cfg LimiterConfig, client *redis.Client) *rateLimiter {
+	policies := make(map[string][]Policy, len(cfg.Policies))
+	for _, policy := range cfg.Policies {
+		if policy.Route == "" || policy.Limit <= 0 || policy.Window <= 0 {
+			logger.Warnf("ratelimit: ignore invalid policy %+v", policy)
+			continue
+		}
+		policies[policy.Route] = append(policies[policy.Route], policy)
+	}
+	return &rateLimiter{
+		cfg:      cfg,
+		client:   client,
+		policies: policies,
+	}
+}
+
+func (l *rateLimiter) enabled() bool {
+	return l.cfg.Enabled && l.client != nil
+}
+
+// match 返回当前请求命中的全部策略
+func (l *rateLimiter) match(c *app.RequestContext, route string) []Policy {
+	var matched []Policy
+	for _, policy := range l.policies[route] {
+		if policy.Method != "" && !strings.EqualFold(policy.Method, string(c.Method())) {
+			continue
+		}
+		if !matchQuery(c, policy.Query) {
+			continue
+		}
+		matched = append(matched, policy)
+	}
+	return matched
+}
+
+func matchQuery(c *app.RequestContext, query map[string]string) bool {
+	for k, v := range query {
+		if c.Query(k) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// allow 在滑动窗口中记录一次请求，超限时返回还需等待的时间
+func (l *rateLimiter) allow(ctx context.Context, policy Policy, key string) (bool, time.Duration, error) {
+	now := time.Now()
+	member := strconv.FormatInt(now.UnixNano(), 10) + "-" + strconv.FormatUint(rand.Uint64(), 36)
+	res, err := slidingWindowScript.Run(ctx, l.client, []string{key},
+		now.UnixMilli(), policy.Window.Milliseconds(), policy.Limit, member).Int64Slice()
+	if err != nil {
+		return false, 0, errno.Errorf(errno.InternalRedisErrorCode, "ratelimit.allow: run script failed: %v", err)
+	}
+	if len(res) != scriptResultLen {
+		return false, 0, errno.Errorf(errno.InternalRedisErrorCode, "ratelimit.allow: unexpected script result %v", res)
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// buildKey 根据策略的限流维度生成 Redis key
+func buildKey(c *app.RequestContext, policy Policy) string {
+	keyBy, identity := policy.KeyBy, ""
+	switch policy.KeyBy {
+	case KeyByStudent:
+		if _, stuID, ok := verifiedToken(c); ok {
+			identity = stuID
+		}
+	case KeyByToken:
+		if token, _, ok := verifiedToken(c); ok {
+			// token 较长，取摘要避免 key 过大
+			sum := sha256.Sum256([]byte(token))
+			identity = hex.EncodeToString(sum[:8])
+		}
+	}
+	if identity == "" {
+		keyBy, identity = KeyByIP, c.ClientIP()
+	}
+	return fmt.Sprintf("%s:%s:%s:%s", constants.RateLimitKeyPrefix, policy.Route, keyBy, identity)
+}
+
+// verifiedToken 返回请求中签名有效的 token 及其中的学号
+// 限流中间件在路由组的 Auth 之前执行，因此在这里按 Auth 的方式自行校验，客户端自报的 Id 请求头不参与限流
+func verifiedToken(c *app.RequestContext) (string, string, bool) {
+	token := string(c.GetHeader(constants.AuthHeader))
+	if token == "" {
+		return "", "", false
+	}
+	_, stuID, err := mw.CheckToken(token)
+	if err != nil {
+		return "", "", false
+	}
+	return token, stuID, true
+}
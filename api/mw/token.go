@@ -40,12 +40,13 @@ type Claims struct {
 }
 
 // CreateAllToken 创建一对 token，第一个是 access token，第二个是 refresh token
-func CreateAllToken() (string, string, error) {
-	accessToken, err := CreateToken(constants.TypeAccessToken, "")
+// stuID 为已校验过教务处会话的学号，会写入 token 供限流等按学号区分用户，未知时传空串
+func CreateAllToken(stuID string) (string, string, error) {
+	accessToken, err := CreateToken(constants.TypeAccessToken, stuID)
 	if err != nil {
 		return "", "", err
 	}
-	refreshToken, err := CreateToken(constants.TypeRefreshToken, "")
+	refreshToken, err := CreateToken(constants.TypeRefreshToken, stuID)
 	if err != nil {
 		return "", "", err
 	}
@@ -105,7 +105,7 @@ func TestCreateAllToken(t *testing.T) {
 				Return(tc.mockRefreshToken, tc.mockError).
 				Build()
 
-			accessToken, refreshToken, err := CreateAllToken()
+			accessToken, refreshToken, err := CreateAllToken("102301317")
 
 			if tc.expectingError {
 				assert.Empty(t, accessToken)
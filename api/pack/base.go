@@ -24,6 +24,7 @@ import (
 
 	"github.com/west2-online/fzuhelper-server/api/model/model"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 )
 
 type Base struct {
@@ -37,8 +38,28 @@ type RespWithData struct {
 	Data any    `json:"data"`
 }
 
+// RespWithSnapshot 用于教务处不可用时返回数据库快照，Stale 为 true 且附带快照时间（毫秒）
+type RespWithSnapshot struct {
+	Code         string `json:"code"`
+	Msg          string `json:"message"`
+	Data         any    `json:"data"`
+	Stale        bool   `json:"stale,omitempty"`
+	SnapshotTime int64  `json:"snapshot_time,omitempty"`
+}
+
+// RespWithSyncStatus 用于空教室相关接口，附带数据的完整同步状态；数据回退或部分节次失败时同时标记 Stale
+type RespWithSyncStatus struct {
+	Code         string                     `json:"code"`
+	Msg          string                     `json:"message"`
+	Data         any                        `json:"data"`
+	Stale        bool                       `json:"stale,omitempty"`
+	SnapshotTime int64                      `json:"snapshot_time,omitempty"`
+	SyncStatus   *model.EmptyRoomSyncStatus `json:"sync_status,omitempty"`
+}
+
 func RespError(c *app.RequestContext, err error) {
 	Errno := errno.ConvertErr(err)
+	metrics.RecordErrno(Errno.ErrorCode)
 	c.JSON(consts.StatusOK, Base{
 		Code: strconv.FormatInt(Errno.ErrorCode, 10),
 		Msg:  Errno.ErrorMsg,
@@ -71,6 +92,55 @@ func RespList(c *app.RequestContext, items any) {
 	c.JSON(consts.StatusOK, resp)
 }
 
+// RespListWithSnapshot 在 stale 为 false 时与 RespList 的响应完全一致
+func RespListWithSnapshot(c *app.RequestContext, items any, stale bool, snapshotTime int64) {
+	if !stale {
+		RespList(c, items)
+		return
+	}
+	Errno := errno.Success
+	c.JSON(consts.StatusOK, RespWithSnapshot{
+		Code:         strconv.FormatInt(Errno.ErrorCode, 10),
+		Msg:          Errno.ErrorMsg,
+		Data:         items,
+		Stale:        stale,
+		SnapshotTime: snapshotTime,
+	})
+}
+
+// RespListWithSyncStatus 在 status 为 nil 时与 RespList 的响应完全一致
+func RespListWithSyncStatus(c *app.RequestContext, items any, status *model.EmptyRoomSyncStatus) {
+	if status == nil {
+		RespList(c, items)
+		return
+	}
+	respWithSyncStatus(c, errno.Success.ErrorMsg, items, status)
+}
+
+// RespDataWithSyncStatus 在 status 为 nil 时与 RespData 的响应完全一致
+func RespDataWithSyncStatus(c *app.RequestContext, data any, status *model.EmptyRoomSyncStatus) {
+	if status == nil {
+		RespData(c, data)
+		return
+	}
+	respWithSyncStatus(c, "Success", data, status)
+}
+
+// respWithSyncStatus 当天数据缺失回退到前一天，或部分节次同步失败时，额外标记 Stale 提示客户端数据不是最新的
+func respWithSyncStatus(c *app.RequestContext, msg string, data any, status *model.EmptyRoomSyncStatus) {
+	resp := RespWithSyncStatus{
+		Code:       strconv.FormatInt(errno.SuccessCode, 10),
+		Msg:        msg,
+		Data:       data,
+		SyncStatus: status,
+	}
+	if status.Fallback || len(status.FailedPeriods) > 0 {
+		resp.Stale = true
+		resp.SnapshotTime = status.LastSuccess
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
 /*
 	20241113
 	customize for old client of launch_screen
@@ -23,10 +23,14 @@ import (
 
 func BuildClassroom(res *model.Classroom) *classroomModel.Classroom {
 	return &classroomModel.Classroom{
-		Build:    res.Build,
-		Location: res.Location,
-		Capacity: res.Capacity,
-		Type:     res.Type,
+		Build:     res.Build,
+		Location:  res.Location,
+		Capacity:  res.Capacity,
+		Type:      res.Type,
+		Floor:     res.Floor,
+		Latitude:  res.Latitude,
+		Longitude: res.Longitude,
+		FreeUntil: res.FreeUntil,
 	}
 }
 
@@ -38,6 +42,21 @@ func BuildClassroomList(res []*model.Classroom) []*classroomModel.Classroom {
 	return list
 }
 
+// BuildEmptyRoomSyncStatus 转换空教室数据的同步状态，status 为 nil 时返回 nil
+func BuildEmptyRoomSyncStatus(status *model.EmptyRoomSyncStatus) *classroomModel.EmptyRoomSyncStatus {
+	if status == nil {
+		return nil
+	}
+	return &classroomModel.EmptyRoomSyncStatus{
+		Date:          status.Date,
+		LastSuccess:   status.LastSuccess,
+		LastAttempt:   status.LastAttempt,
+		Error:         status.Error,
+		FailedPeriods: status.FailedPeriods,
+		Fallback:      status.Fallback,
+	}
+}
+
 func BuildExamRoomInfo(rooms []*model.ExamRoomInfo) []*classroomModel.ExamRoomInfo {
 	list := make([]*classroomModel.ExamRoomInfo, 0, len(rooms))
 	for _, room := range rooms {
@@ -52,3 +71,32 @@ func BuildExamRoomInfo(rooms []*model.ExamRoomInfo) []*classroomModel.ExamRoomIn
 	}
 	return list
 }
+
+func BuildRoomSchedule(schedule *model.RoomSchedule) *classroomModel.RoomSchedule {
+	return &classroomModel.RoomSchedule{
+		Room:            BuildClassroom(schedule.Room),
+		Date:            schedule.Date,
+		FreePeriods:     schedule.FreePeriods,
+		OccupiedPeriods: schedule.OccupiedPeriods,
+	}
+}
+
+func BuildExamRoomChanges(changes []*model.ExamRoomChange) []*classroomModel.ExamRoomChange {
+	list := make([]*classroomModel.ExamRoomChange, 0, len(changes))
+	for _, change := range changes {
+		list = append(list, &classroomModel.ExamRoomChange{
+			Name:         change.Name,
+			Credit:       change.Credit,
+			Teacher:      change.Teacher,
+			ChangeType:   change.ChangeType,
+			PrevLocation: change.PrevLocation,
+			PrevDate:     change.PrevDate,
+			PrevTime:     change.PrevTime,
+			Location:     change.Location,
+			Date:         change.Date,
+			Time:         change.Time,
+			DetectedAt:   change.DetectedAt,
+		})
+	}
+	return list
+}
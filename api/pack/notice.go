@@ -25,10 +25,98 @@ func BuildNotices(notices []*model.NoticeInfo) []*api.NoticeInfo {
 	list := make([]*api.NoticeInfo, len(notices))
 	for i, notice := range notices {
 		list[i] = &api.NoticeInfo{
-			Title: notice.Title,
-			Date:  notice.Date,
-			URL:   notice.Url,
+			Title:  notice.Title,
+			Date:   notice.Date,
+			URL:    notice.Url,
+			Source: notice.Source,
 		}
 	}
 	return list
 }
+
+func BuildNoticeSources(sources []*model.NoticeSource) []*api.NoticeSource {
+	list := make([]*api.NoticeSource, len(sources))
+	for i, src := range sources {
+		list[i] = &api.NoticeSource{
+			Name:        src.Name,
+			DisplayName: src.DisplayName,
+		}
+	}
+	return list
+}
+
+func BuildNoticeSearchHits(hits []*model.NoticeSearchHit) []*api.NoticeSearchHit {
+	list := make([]*api.NoticeSearchHit, len(hits))
+	for i, hit := range hits {
+		list[i] = &api.NoticeSearchHit{
+			Title:          hit.Title,
+			URL:            hit.Url,
+			Date:           hit.Date,
+			HighlightTitle: hit.HighlightTitle,
+			Highlights:     hit.Highlights,
+		}
+	}
+	return list
+}
+
+func BuildNoticeDetail(detail *model.NoticeDetail) *api.NoticeDetail {
+	if detail == nil {
+		return nil
+	}
+	attachments := make([]*api.NoticeAttachment, len(detail.Attachments))
+	for i, attachment := range detail.Attachments {
+		attachments[i] = &api.NoticeAttachment{
+			Name:     attachment.Name,
+			URL:      attachment.Url,
+			Mirrored: attachment.Mirrored,
+		}
+	}
+	return &api.NoticeDetail{
+		Title:       detail.Title,
+		URL:         detail.Url,
+		Date:        detail.Date,
+		Content:     detail.Content,
+		Attachments: attachments,
+	}
+}
+
+func BuildNoticeSubscription(sub *model.NoticeSubscription) *api.NoticeSubscription {
+	if sub == nil {
+		return nil
+	}
+	return &api.NoticeSubscription{
+		Keyword: sub.Keyword,
+		Tag:     sub.Tag,
+	}
+}
+
+func BuildNoticeSubscriptions(subs []*model.NoticeSubscription) []*api.NoticeSubscription {
+	list := make([]*api.NoticeSubscription, len(subs))
+	for i, sub := range subs {
+		list[i] = BuildNoticeSubscription(sub)
+	}
+	return list
+}
+
+func BuildNotification(notification *model.Notification) *api.Notification {
+	if notification == nil {
+		return nil
+	}
+	return &api.Notification{
+		ID:        notification.Id,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Content:   notification.Content,
+		Deeplink:  notification.Deeplink,
+		IsRead:    notification.IsRead,
+		CreatedAt: notification.CreatedAt,
+	}
+}
+
+func BuildNotifications(notifications []*model.Notification) []*api.Notification {
+	list := make([]*api.Notification, len(notifications))
+	for i, notification := range notifications {
+		list[i] = BuildNotification(notification)
+	}
+	return list
+}
@@ -23,6 +23,7 @@ import (
 	api "github.com/west2-online/fzuhelper-server/api/model/model"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 )
 
 func BuildUpYunFileDir(res *model.UpYunFileDir) *api.UpYunFileDir {
@@ -49,6 +50,7 @@ func RespDataInPaper(c *app.RequestContext, data any) {
 
 func RespErrorInPaper(c *app.RequestContext, err error) {
 	Errno := errno.ConvertErr(err)
+	metrics.RecordErrno(Errno.ErrorCode)
 	c.JSON(consts.StatusOK, RespWithDataInPaper{
 		Code: int(Errno.ErrorCode),
 		Msg:  Errno.ErrorMsg,
@@ -28,5 +28,87 @@ func BuildVersion(rpcVersion *model.Version) *api.Version {
 		Force:       rpcVersion.Force,
 		Changelog:   rpcVersion.Changelog,
 		URL:         rpcVersion.Url,
+		ReleasedAt:  rpcVersion.ReleasedAt,
+		Status:      rpcVersion.Status,
+	}
+}
+
+func BuildVersionList(rpcVersions []*model.Version) []*api.Version {
+	versions := make([]*api.Version, 0, len(rpcVersions))
+	for _, v := range rpcVersions {
+		versions = append(versions, BuildVersion(v))
+	}
+	return versions
+}
+
+func BuildVersionChangelog(rpcChangelog *model.VersionChangelog) *api.VersionChangelog {
+	changelog := &api.VersionChangelog{
+		Changelog: rpcChangelog.Changelog,
+		Versions:  BuildVersionList(rpcChangelog.Versions),
+	}
+	if rpcChangelog.Latest != nil {
+		changelog.Latest = BuildVersion(rpcChangelog.Latest)
+	}
+	return changelog
+}
+
+func BuildLatestVersion(rpcLatest *model.LatestVersion) *api.LatestVersion {
+	latest := &api.LatestVersion{
+		StoreURL:         rpcLatest.StoreUrl,
+		Force:            rpcLatest.Force,
+		MinSupportedCode: rpcLatest.MinSupportedCode,
+	}
+	if rpcLatest.Version != nil {
+		latest.Version = BuildVersion(rpcLatest.Version)
+	}
+	return latest
+}
+
+func BuildCloudSettingDryRun(rpcDryRun *model.CloudSettingDryRun) *api.CloudSettingDryRun {
+	plans := make([]*api.CloudSettingPlanMatch, 0, len(rpcDryRun.Plans))
+	for _, p := range rpcDryRun.Plans {
+		plans = append(plans, &api.CloudSettingPlanMatch{
+			Index:            p.Index,
+			Name:             p.Name,
+			Matched:          p.Matched,
+			MismatchedFields: p.MismatchedFields,
+		})
+	}
+	return &api.CloudSettingDryRun{
+		MatchedIndex: rpcDryRun.MatchedIndex,
+		Plan:         rpcDryRun.Plan,
+		Plans:        plans,
+	}
+}
+
+func BuildCloudSettingCoverage(rpcCoverage *model.CloudSettingCoverage) *api.CloudSettingCoverage {
+	plans := make([]*api.CloudSettingPlanCoverage, 0, len(rpcCoverage.Plans))
+	for _, p := range rpcCoverage.Plans {
+		plans = append(plans, &api.CloudSettingPlanCoverage{
+			Index:      p.Index,
+			Name:       p.Name,
+			Hits:       p.Hits,
+			Eligible:   p.Eligible,
+			ShadowedBy: p.ShadowedBy,
+		})
+	}
+	unmatched := make([]*api.CloudSettingUnmatched, 0, len(rpcCoverage.Unmatched))
+	for _, u := range rpcCoverage.Unmatched {
+		unmatched = append(unmatched, &api.CloudSettingUnmatched{
+			Criteria: &api.CloudSettingCriteria{
+				Account:   u.Criteria.Account,
+				Version:   u.Criteria.Version,
+				Beta:      u.Criteria.Beta,
+				Phone:     u.Criteria.Phone,
+				IsLogin:   u.Criteria.IsLogin,
+				LoginType: u.Criteria.LoginType,
+			},
+			Count: u.Count,
+		})
+	}
+	return &api.CloudSettingCoverage{
+		Samples:   rpcCoverage.Samples,
+		Plans:     plans,
+		Unmatched: unmatched,
 	}
 }
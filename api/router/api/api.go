@@ -20,7 +20,6 @@ package api
 
 import (
 	"github.com/cloudwego/hertz/pkg/app/server"
-
 	api "github.com/west2-online/fzuhelper-server/api/handler/api"
 )
 
@@ -48,10 +47,15 @@ func Register(r *server.Hertz) {
 				_common := _v1.Group("/common", _commonMw()...)
 				_common.GET("/contributor", append(_getcontributorinfoMw(), api.GetContributorInfo)...)
 				_common.GET("/notice", append(_getnoticeMw(), api.GetNotice)...)
+				_notice := _common.Group("/notice", _noticeMw()...)
+				_notice.GET("/detail", append(_getnoticedetailMw(), api.GetNoticeDetail)...)
+				_notice.GET("/search", append(_searchnoticesMw(), api.SearchNotices)...)
+				_notice.GET("/sources", append(_listnoticesourcesMw(), api.ListNoticeSources)...)
 				_common.POST("/signed-location-api-url", append(_getsignedlocationapiurlMw(), api.GetSignedLocationApiUrl)...)
 				{
 					_classroom := _common.Group("/classroom", _classroomMw()...)
 					_classroom.GET("/empty", append(_getemptyclassroomsMw(), api.GetEmptyClassrooms)...)
+					_classroom.GET("/schedule", append(_getroomscheduleMw(), api.GetRoomSchedule)...)
 				}
 			}
 			{
@@ -96,6 +100,10 @@ func Register(r *server.Hertz) {
 			}
 			{
 				_jwch := _v1.Group("/jwch", _jwchMw()...)
+				_jwch.GET("/notifications", append(_listnotificationsMw(), api.ListNotifications)...)
+				_notifications := _jwch.Group("/notifications", _notificationsMw()...)
+				_notifications.POST("/read", append(_marknotificationsreadMw(), api.MarkNotificationsRead)...)
+				_notifications.GET("/unread-count", append(_getunreadnotificationcountMw(), api.GetUnreadNotificationCount)...)
 				_jwch.GET("/ping", append(_testauthMw(), api.TestAuth)...)
 				{
 					_academic := _jwch.Group("/academic", _academicMw()...)
@@ -108,6 +116,8 @@ func Register(r *server.Hertz) {
 				{
 					_classroom0 := _jwch.Group("/classroom", _classroom0Mw()...)
 					_classroom0.GET("/exam", append(_getexamroominfoMw(), api.GetExamRoomInfo)...)
+					_exam := _classroom0.Group("/exam", _examMw()...)
+					_exam.GET("/changes", append(_getexamroomchangesMw(), api.GetExamRoomChanges)...)
 				}
 				{
 					_course0 := _jwch.Group("/course", _course0Mw()...)
@@ -117,13 +127,26 @@ func Register(r *server.Hertz) {
 						_calendar0.GET("/token", append(_getcalendarMw(), api.GetCalendar)...)
 					}
 				}
+				{
+					_notice0 := _jwch.Group("/notice", _notice0Mw()...)
+					_notice0.DELETE("/subscriptions", append(_unsubscribenoticeMw(), api.UnsubscribeNotice)...)
+					_notice0.GET("/subscriptions", append(_listnoticesubscriptionsMw(), api.ListNoticeSubscriptions)...)
+					_notice0.POST("/subscriptions", append(_subscribenoticeMw(), api.SubscribeNotice)...)
+				}
 				{
 					_term := _jwch.Group("/term", _termMw()...)
 					_term.GET("/list", append(_gettermlistMw(), api.GetTermList)...)
 				}
 				{
 					_user1 := _jwch.Group("/user", _user1Mw()...)
+					_user1.DELETE("/device", append(_unregisterdeviceMw(), api.UnregisterDevice)...)
+					_user1.POST("/device", append(_registerdeviceMw(), api.RegisterDevice)...)
 					_user1.GET("/info", append(_getuserinfoMw(), api.GetUserInfo)...)
+					{
+						_notification := _user1.Group("/notification", _notificationMw()...)
+						_notification.GET("/preference", append(_getnotificationpreferenceMw(), api.GetNotificationPreference)...)
+						_notification.PUT("/preference", append(_updatenotificationpreferenceMw(), api.UpdateNotificationPreference)...)
+					}
 				}
 			}
 			{
@@ -198,10 +221,13 @@ func Register(r *server.Hertz) {
 			{
 				_url := _v2.Group("/url", _urlMw()...)
 				_url.GET("/beta.apk", append(_downloadbetaapkMw(), api.DownloadBetaApk)...)
+				_url.POST("/coverage", append(_getcloudsettingcoverageMw(), api.GetCloudSettingCoverage)...)
+				_url.POST("/dryrun", append(_dryruncloudsettingMw(), api.DryRunCloudSetting)...)
 				_url.GET("/dump", append(_getdumpMw(), api.GetDump)...)
 				_url.GET("/getcloud", append(_getcloudMw(), api.GetCloud)...)
 				_url.POST("/login", append(_login1Mw(), api.Login)...)
 				_url.GET("/release.apk", append(_downloadreleaseapkMw(), api.DownloadReleaseApk)...)
+				_url.POST("/rollback", append(_rollbackversionMw(), api.RollbackVersion)...)
 				_url.POST("/setcloud", append(_setcloudMw(), api.SetCloud)...)
 				_url.GET("/settings.php", append(_getsettingMw(), api.GetSetting)...)
 				_url.POST("/test", append(_gettestMw(), api.GetTest)...)
@@ -213,6 +239,9 @@ func Register(r *server.Hertz) {
 			{
 				_version := _v2.Group("/version", _versionMw()...)
 				_version.GET("/android", append(_androidgetversionMw(), api.AndroidGetVersion)...)
+				_version.GET("/changelog", append(_getversionchangelogMw(), api.GetVersionChangelog)...)
+				_version.GET("/latest", append(_getlatestversionMw(), api.GetLatestVersion)...)
+				_version.GET("/list", append(_listversionsMw(), api.ListVersions)...)
 			}
 		}
 	}
@@ -631,3 +631,133 @@ func _listimageMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _getroomscheduleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _noticeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchnoticesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _notice0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unsubscribenoticeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listnoticesubscriptionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _subscribenoticeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnoticedetailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listnoticesourcesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _examMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getexamroomchangesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _notificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listnotificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _marknotificationsreadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getunreadnotificationcountMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unregisterdeviceMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _registerdeviceMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _notificationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnotificationpreferenceMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatenotificationpreferenceMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _rollbackversionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getversionchangelogMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listversionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getlatestversionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _dryruncloudsettingMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcloudsettingcoverageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
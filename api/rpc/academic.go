@@ -35,7 +35,8 @@ func InitAcademicRPC() {
 	academicClient = *c
 }
 
-func GetScoresRPC(ctx context.Context, req *academic.GetScoresRequest) (scores []*model.Score, err error) {
+// GetScoresRPC 返回完整响应，教务处不可用时响应中会带有 stale 标记和快照时间
+func GetScoresRPC(ctx context.Context, req *academic.GetScoresRequest) (*academic.GetScoresResponse, error) {
 	resp, err := academicClient.GetScores(ctx, req)
 	if err != nil {
 		logger.WithCtx(ctx).Errorf("GetScoresRPC: RPC called failed: %v", err.Error())
@@ -44,7 +45,7 @@ func GetScoresRPC(ctx context.Context, req *academic.GetScoresRequest) (scores [
 	if err = utils.HandleBaseRespWithCookie(resp.Base); err != nil {
 		return nil, err
 	}
-	return resp.Scores, nil
+	return resp, nil
 }
 
 func GetGPARPC(ctx context.Context, req *academic.GetGPARequest) (gpa *model.GPABean, err error) {
@@ -35,7 +35,7 @@ func InitClassroomRPC() {
 	classroomClient = *c
 }
 
-func GetEmptyRoomRPC(ctx context.Context, req *classroom.EmptyRoomRequest) (emptyRooms []*model.Classroom, err error) {
+func GetEmptyRoomRPC(ctx context.Context, req *classroom.EmptyRoomRequest) (*classroom.EmptyRoomResponse, error) {
 	resp, err := classroomClient.GetEmptyRoom(ctx, req)
 	if err != nil {
 		logger.WithCtx(ctx).Errorf("GetEmptyRoomRPC: RPC called failed: %v", err.Error())
@@ -44,10 +44,10 @@ func GetEmptyRoomRPC(ctx context.Context, req *classroom.EmptyRoomRequest) (empt
 	if !utils.IsSuccess(resp.Base) {
 		return nil, errno.BizError.WithMessage(resp.Base.Msg)
 	}
-	return resp.Rooms, nil
+	return resp, nil
 }
 
-func GetExamRoomInfoRPC(ctx context.Context, req *classroom.ExamRoomInfoRequest) (roomInfo []*model.ExamRoomInfo, err error) {
+func GetExamRoomInfoRPC(ctx context.Context, req *classroom.ExamRoomInfoRequest) (*classroom.ExamRoomInfoResponse, error) {
 	resp, err := classroomClient.GetExamRoomInfo(ctx, req)
 	if err != nil {
 		logger.WithCtx(ctx).Errorf("GetExamRoomInfoRPC: RPC called failed: %v", err.Error())
@@ -56,5 +56,29 @@ func GetExamRoomInfoRPC(ctx context.Context, req *classroom.ExamRoomInfoRequest)
 	if err = utils.HandleBaseRespWithCookie(resp.Base); err != nil {
 		return nil, err
 	}
-	return resp.Rooms, nil
+	return resp, nil
+}
+
+func GetRoomScheduleRPC(ctx context.Context, req *classroom.RoomScheduleRequest) (*classroom.RoomScheduleResponse, error) {
+	resp, err := classroomClient.GetRoomSchedule(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("GetRoomScheduleRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+func GetExamRoomChangesRPC(ctx context.Context, req *classroom.ExamRoomChangesRequest) (changes []*model.ExamRoomChange, err error) {
+	resp, err := classroomClient.GetExamRoomChanges(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("GetExamRoomChangesRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Changes, nil
 }
@@ -110,6 +110,111 @@ func GetNoticesRPC(ctx context.Context, req *common.NoticeRequest) ([]*model.Not
 	return resp.Notices, resp.Total, nil
 }
 
+func SearchNoticesRPC(ctx context.Context, req *common.SearchNoticesRequest) ([]*model.NoticeSearchHit, int64, error) {
+	resp, err := commonClient.SearchNotices(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("SearchNoticesRPC: RPC called failed: %v", err.Error())
+		return nil, 0, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, 0, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Results, resp.Total, nil
+}
+
+func ListNoticeSourcesRPC(ctx context.Context, req *common.ListNoticeSourcesRequest) ([]*model.NoticeSource, error) {
+	resp, err := commonClient.ListNoticeSources(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("ListNoticeSourcesRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Sources, nil
+}
+
+func GetNoticeDetailRPC(ctx context.Context, req *common.GetNoticeDetailRequest) (*model.NoticeDetail, error) {
+	resp, err := commonClient.GetNoticeDetail(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("GetNoticeDetailRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Detail, nil
+}
+
+func ListNoticeSubscriptionsRPC(ctx context.Context, req *common.ListNoticeSubscriptionsRequest) ([]*model.NoticeSubscription, error) {
+	resp, err := commonClient.ListNoticeSubscriptions(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("ListNoticeSubscriptionsRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if err = utils.HandleBaseRespWithCookie(resp.Base); err != nil {
+		return nil, err
+	}
+	return resp.Subscriptions, nil
+}
+
+func SubscribeNoticeRPC(ctx context.Context, req *common.SubscribeNoticeRequest) (*model.NoticeSubscription, error) {
+	resp, err := commonClient.SubscribeNotice(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("SubscribeNoticeRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if err = utils.HandleBaseRespWithCookie(resp.Base); err != nil {
+		return nil, err
+	}
+	return resp.Subscription, nil
+}
+
+func UnsubscribeNoticeRPC(ctx context.Context, req *common.UnsubscribeNoticeRequest) error {
+	resp, err := commonClient.UnsubscribeNotice(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("UnsubscribeNoticeRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithMessage(err.Error())
+	}
+	return utils.HandleBaseRespWithCookie(resp.Base)
+}
+
+func ListNotificationsRPC(ctx context.Context, req *common.ListNotificationsRequest) ([]*model.Notification, int64, error) {
+	resp, err := commonClient.ListNotifications(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("ListNotificationsRPC: RPC called failed: %v", err.Error())
+		return nil, 0, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if err = utils.HandleBaseRespWithCookie(resp.Base); err != nil {
+		return nil, 0, err
+	}
+	return resp.Notifications, resp.Total, nil
+}
+
+func MarkNotificationsReadRPC(ctx context.Context, req *common.MarkNotificationsReadRequest) (int64, error) {
+	resp, err := commonClient.MarkNotificationsRead(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("MarkNotificationsReadRPC: RPC called failed: %v", err.Error())
+		return 0, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if err = utils.HandleBaseRespWithCookie(resp.Base); err != nil {
+		return 0, err
+	}
+	return resp.Marked, nil
+}
+
+func GetUnreadNotificationCountRPC(ctx context.Context, req *common.GetUnreadNotificationCountRequest) (int64, error) {
+	resp, err := commonClient.GetUnreadNotificationCount(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("GetUnreadNotificationCountRPC: RPC called failed: %v", err.Error())
+		return 0, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if err = utils.HandleBaseRespWithCookie(resp.Base); err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
+
 func GetContributorRPC(ctx context.Context, req *common.GetContributorInfoRequest) (*common.GetContributorInfoResponse, error) {
 	resp, err := commonClient.GetContributorInfo(ctx, req)
 	if err != nil {
@@ -35,7 +35,8 @@ func InitCourseRPC() {
 	courseClient = *c
 }
 
-func GetCourseListRPC(ctx context.Context, req *course.CourseListRequest) (courses []*model.Course, err error) {
+// GetCourseListRPC 返回完整响应，教务处不可用时响应中会带有 stale 标记和快照时间
+func GetCourseListRPC(ctx context.Context, req *course.CourseListRequest) (*course.CourseListResponse, error) {
 	resp, err := courseClient.GetCourseList(ctx, req)
 	if err != nil {
 		logger.WithCtx(ctx).Errorf("GetCourseListRPC: RPC called failed: %v", err.Error())
@@ -45,7 +46,7 @@ func GetCourseListRPC(ctx context.Context, req *course.CourseListRequest) (cours
 		return nil, err
 	}
 
-	return resp.Data, nil
+	return resp, nil
 }
 
 func GetCourseTermsListRPC(ctx context.Context, req *course.TermListRequest) (*course.TermListResponse, error) {
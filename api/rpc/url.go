@@ -19,6 +19,7 @@ package rpc
 import (
 	"context"
 
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
 	"github.com/west2-online/fzuhelper-server/pkg/base/client"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
@@ -165,3 +166,75 @@ func AndroidVersionRPC(ctx context.Context, req *version.AndroidGetVersioneReque
 	}
 	return resp, nil
 }
+
+func RollbackVersionRPC(ctx context.Context, req *version.RollbackVersionRequest) (*model.Version, error) {
+	resp, err := versionClient.RollbackVersion(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("RollbackVersionRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Data, nil
+}
+
+func ListVersionsRPC(ctx context.Context, req *version.ListVersionsRequest) ([]*model.Version, int64, error) {
+	resp, err := versionClient.ListVersions(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("ListVersionsRPC: RPC called failed: %v", err.Error())
+		return nil, 0, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, 0, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Data, resp.Total, nil
+}
+
+func GetVersionChangelogRPC(ctx context.Context, req *version.GetVersionChangelogRequest) (*model.VersionChangelog, error) {
+	resp, err := versionClient.GetVersionChangelog(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("GetVersionChangelogRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Data, nil
+}
+
+func GetLatestVersionRPC(ctx context.Context, req *version.GetLatestVersionRequest) (*model.LatestVersion, error) {
+	resp, err := versionClient.GetLatestVersion(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("GetLatestVersionRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Data, nil
+}
+
+func DryRunCloudSettingRPC(ctx context.Context, req *version.DryRunCloudSettingRequest) (*model.CloudSettingDryRun, error) {
+	resp, err := versionClient.DryRunCloudSetting(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("DryRunCloudSettingRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Data, nil
+}
+
+func GetCloudSettingCoverageRPC(ctx context.Context, req *version.GetCloudSettingCoverageRequest) (*model.CloudSettingCoverage, error) {
+	resp, err := versionClient.GetCloudSettingCoverage(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("GetCloudSettingCoverageRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithMessage(err.Error())
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.NewErrNo(resp.Base.Code, resp.Base.Msg)
+	}
+	return resp.Data, nil
+}
@@ -154,3 +154,51 @@ func ReorderFriendListRPC(ctx context.Context, req *user.ReorderFriendListReques
 	}
 	return nil
 }
+
+func RegisterDeviceRPC(ctx context.Context, req *user.RegisterDeviceRequest) error {
+	resp, err := userClient.RegisterDevice(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("RegisterDeviceRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.BizError.WithMessage("登记设备失败: " + resp.Base.Msg)
+	}
+	return nil
+}
+
+func UnregisterDeviceRPC(ctx context.Context, req *user.UnregisterDeviceRequest) error {
+	resp, err := userClient.UnregisterDevice(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("UnregisterDeviceRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.BizError.WithMessage("注销设备失败: " + resp.Base.Msg)
+	}
+	return nil
+}
+
+func GetNotificationPreferenceRPC(ctx context.Context, req *user.GetNotificationPreferenceRequest) (*model.NotificationPreference, error) {
+	resp, err := userClient.GetNotificationPreference(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("GetNotificationPreferenceRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.BizError.WithMessage("获取通知偏好失败: " + resp.Base.Msg)
+	}
+	return resp.Data, nil
+}
+
+func UpdateNotificationPreferenceRPC(ctx context.Context, req *user.UpdateNotificationPreferenceRequest) error {
+	resp, err := userClient.UpdateNotificationPreference(ctx, req)
+	if err != nil {
+		logger.WithCtx(ctx).Errorf("UpdateNotificationPreferenceRPC: RPC called failed: %v", err.Error())
+		return errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return errno.BizError.WithMessage("更新通知偏好失败: " + resp.Base.Msg)
+	}
+	return nil
+}
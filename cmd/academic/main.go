@@ -34,6 +34,7 @@ import (
 	baseserver "github.com/west2-online/fzuhelper-server/pkg/base/server"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/tracing"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -49,7 +50,8 @@ var (
 func init() {
 	config.Init(serviceName)
 	logger.Init(serviceName, config.GetLoggerLevel())
-	clientSet = base.NewClientSet(base.WithDBClient(), base.WithRedisClient(constants.RedisDBAcademic))
+	clientSet = base.NewClientSet(base.WithDBClient(), base.WithRedisClient(constants.RedisDBAcademic), base.WithGovernor(),
+		base.WithUmengQueue())
 	taskQueue = taskqueue.NewBaseTaskQueue()
 }
 
@@ -85,6 +87,7 @@ func main() {
 		baseserver.AssembleCommonServerConfig(serviceName, addr, r)...,
 	)
 	server.RegisterShutdownHook(clientSet.Close)
+	server.RegisterShutdownHook(metrics.StartServer(config.Service.MetricsAddr)) // prometheus
 	server.RegisterShutdownHook(tracing.ProviderShutdown(shutdown,
 		"Academic: otel provider shutdown failed: %v")) // otel provider
 
@@ -33,15 +33,18 @@ import (
 
 	"github.com/west2-online/fzuhelper-server/api/mcp"
 	"github.com/west2-online/fzuhelper-server/api/mw/monitor"
+	"github.com/west2-online/fzuhelper-server/api/mw/ratelimit"
 
 	hertztracing "github.com/hertz-contrib/obs-opentelemetry/tracing"
 
 	"github.com/west2-online/fzuhelper-server/api/router"
 	"github.com/west2-online/fzuhelper-server/api/rpc"
 	"github.com/west2-online/fzuhelper-server/config"
+	"github.com/west2-online/fzuhelper-server/pkg/base/client"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/tracing"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
@@ -92,6 +95,13 @@ func main() {
 	})
 	h.Use(monitor.APIMonitorMiddleware())
 
+	// Prometheus，指标在独立端口上暴露，不经过对外的 api 监听地址
+	h.Use(monitor.MetricsMiddleware())
+	stopMetricsServer := metrics.StartServer(config.Service.MetricsAddr)
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		stopMetricsServer()
+	})
+
 	// register http2 server factory
 	h.AddProtocol("h2", factory.NewServerFactory())
 
@@ -113,6 +123,10 @@ func main() {
 		}),
 	))
 
+	// Rate limit
+	initRateLimiter(h)
+	h.Use(ratelimit.RateLimitMiddleware())
+
 	// MCP 封装后，本质变成了路由 + Handler
 	proxy := mcp.CreateMCPProxy()
 
@@ -141,6 +155,34 @@ func apiMonitorConfig() monitor.MonitorConfig {
 		blacklist[route] = struct{}{}
 	}
 
+	// 告警通道初始化失败时只跳过该通道，不影响服务启动
+	sinks := make([]monitor.AlertRoute, 0, len(cfg.Sinks))
+	for _, s := range cfg.Sinks {
+		sink, err := monitor.NewAlertSink(monitor.SinkConfig{
+			Name:     s.Name,
+			Type:     s.Type,
+			Format:   s.Format,
+			URL:      s.URL,
+			Secret:   s.Secret,
+			SMTPAddr: s.SMTPAddr,
+			Username: s.Username,
+			Password: s.Password,
+			From:     s.From,
+			To:       s.To,
+			Topic:    s.Topic,
+		})
+		if err != nil {
+			logger.Errorf("Api: init api monitor sink %s failed, err: %v", s.Name, err)
+			continue
+		}
+		sinks = append(sinks, monitor.AlertRoute{
+			Sink:          sink,
+			RoutePrefixes: s.Routes,
+			MinErrorRate:  s.MinErrorRate,
+			SendRecovered: s.SendRecovered,
+		})
+	}
+
 	return monitor.MonitorConfig{
 		Enabled:       cfg.Enabled,
 		Window:        time.Duration(cfg.WindowSeconds) * time.Second,
@@ -149,7 +191,44 @@ func apiMonitorConfig() monitor.MonitorConfig {
 		MinRequests:   cfg.MinRequests,
 		Cooldown:      time.Duration(cfg.AlertCooldownSeconds) * time.Second,
 		Blacklist:     blacklist,
+		Sinks:         sinks,
+	}
+}
+
+// 限流依赖 Redis，连接失败时不影响服务启动，中间件会直接放行
+func initRateLimiter(h *server.Hertz) {
+	cfg := config.RateLimit
+	if cfg == nil || !cfg.Enabled {
+		return
 	}
+
+	policies := make([]ratelimit.Policy, 0, len(cfg.Policies))
+	for _, p := range cfg.Policies {
+		policies = append(policies, ratelimit.Policy{
+			Route:  p.Route,
+			Method: p.Method,
+			KeyBy:  p.KeyBy,
+			Limit:  p.Limit,
+			Window: time.Duration(p.WindowSeconds) * time.Second,
+			Query:  p.Query,
+		})
+	}
+
+	redisClient, err := client.NewRedisClient(constants.RedisDBRateLimit)
+	if err != nil {
+		logger.Errorf("Api: init rate limit redis client failed, err: %v", err)
+		return
+	}
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		if err := redisClient.Close(); err != nil {
+			logger.Errorf("Api: close rate limit redis client failed, err: %v", err)
+		}
+	})
+
+	ratelimit.InitRateLimiter(ratelimit.LimiterConfig{
+		Enabled:  cfg.Enabled,
+		Policies: policies,
+	}, redisClient)
 }
 
 func initSentinel() {
@@ -29,6 +29,7 @@ import (
 	captchapkg "github.com/west2-online/fzuhelper-server/pkg/captcha"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/tracing"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
@@ -69,6 +70,7 @@ func main() {
 		baseserver.AssembleCommonServerConfig(serviceName, addr, r)...,
 	)
 	server.RegisterShutdownHook(clientSet.Close)
+	server.RegisterShutdownHook(metrics.StartServer(config.Service.MetricsAddr)) // prometheus
 	server.RegisterShutdownHook(tracing.ProviderShutdown(shutdown,
 		"Captcha: otel provider shutdown failed: %v")) // otel provider
 
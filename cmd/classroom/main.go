@@ -19,7 +19,6 @@ package main
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"time"
 
 	"github.com/cloudwego/kitex/server"
@@ -29,11 +28,13 @@ import (
 
 	"github.com/west2-online/fzuhelper-server/config"
 	"github.com/west2-online/fzuhelper-server/internal/classroom"
+	"github.com/west2-online/fzuhelper-server/internal/classroom/service"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom/classroomservice"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	baseserver "github.com/west2-online/fzuhelper-server/pkg/base/server"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/tracing"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -50,7 +51,12 @@ func init() {
 	config.Init(serviceName)
 	logger.Init(serviceName, config.GetLoggerLevel())
 	// eshook.InitLoggerWithHook(serviceName)
-	clientSet = base.NewClientSet(base.WithRedisClient(constants.RedisDBEmptyRoom))
+	clientSet = base.NewClientSet(
+		base.WithRedisClient(constants.RedisDBEmptyRoom),
+		base.WithDBClient(),
+		base.WithGovernor(),
+		base.WithUmengQueue(),
+	)
 	taskQueue = taskqueue.NewBaseTaskQueue()
 }
 
@@ -73,10 +79,11 @@ func main() {
 	}
 
 	svr := classroomservice.NewServer(
-		classroom.NewClassroomService(clientSet),
+		classroom.NewClassroomService(clientSet, taskQueue),
 		baseserver.AssembleCommonServerConfig(serviceName, addr, r)...,
 	)
 	server.RegisterShutdownHook(clientSet.Close)
+	server.RegisterShutdownHook(metrics.StartServer(config.Service.MetricsAddr)) // prometheus
 	server.RegisterShutdownHook(tracing.ProviderShutdown(shutdown,
 		"Classroom: otel provider shutdown failed: %v")) // otel provider
 
@@ -131,7 +138,6 @@ func scheduleUpdateEmptyClassroomsInfo(ctx context.Context, date time.Time) erro
 }
 
 func updateEmptyClassroomsInfo(ctx context.Context, date time.Time) error {
-	currentDate := date.Format("2006-01-02")
 	// 定义 jwch 的 stu 客户端
 	stu := jwch.NewStudent().WithUser(config.DefaultUser.Account, config.DefaultUser.Password)
 	// 登录，id 和 cookies 会自动保存在 client 中
@@ -140,43 +146,8 @@ func updateEmptyClassroomsInfo(ctx context.Context, date time.Time) error {
 	if err != nil {
 		return fmt.Errorf("updateEmptyClassroomsInfo: failed to login: %w", err)
 	}
-	for _, campus := range constants.CampusArray {
-		for startTime := 1; startTime <= 11; startTime++ {
-			for endTime := startTime; endTime <= 11; endTime++ {
-				args := jwch.EmptyRoomReq{
-					Campus: campus,
-					Time:   currentDate, // 使用传递进来的 date 参数
-					Start:  strconv.Itoa(startTime),
-					End:    strconv.Itoa(endTime),
-				}
-				var res []string
-				var err error
-				// 从 jwch 获取空教室信息
-				switch campus {
-				case "旗山校区":
-					res, err = stu.GetQiShanEmptyRoom(args)
-				default:
-					res, err = stu.GetEmptyRoom(args)
-				}
-				if err != nil {
-					return fmt.Errorf("updateEmptyClassroomsInfo: failed to get empty room info: %w", err)
-				}
-				// 收集结果并缓存
-				switch campus {
-				case "厦门工艺美院":
-					err = clientSet.CacheClient.Classroom.SetXiaMenEmptyRoomCache(ctx, currentDate, args.Start, args.End, res)
-					if err != nil {
-						return fmt.Errorf("updateEmptyClassroomsInfo: failed to set xiamen empty room cache: %w", err)
-					}
-				default:
-					key := fmt.Sprintf("%s.%s.%s.%s", args.Time, args.Campus, args.Start, args.End)
-					err = clientSet.CacheClient.Classroom.SetEmptyRoomCache(ctx, key, res)
-					if err != nil {
-						return fmt.Errorf("updateEmptyClassroomsInfo: failed to set empty room cache: %w", err)
-					}
-				}
-			}
-		}
+	if err = service.NewClassroomService(ctx, clientSet, taskQueue).SyncEmptyRoom(stu, date); err != nil {
+		return fmt.Errorf("updateEmptyClassroomsInfo: %w", err)
 	}
 	return nil
 }
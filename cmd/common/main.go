@@ -22,6 +22,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,11 +41,15 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/db"
 	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/es"
 	"github.com/west2-online/fzuhelper-server/pkg/github"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+	"github.com/west2-online/fzuhelper-server/pkg/noticesource"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
+	"github.com/west2-online/fzuhelper-server/pkg/oss"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/tracing"
-	"github.com/west2-online/fzuhelper-server/pkg/umeng"
 	"github.com/west2-online/fzuhelper-server/pkg/upyun"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
@@ -60,12 +65,47 @@ var (
 func init() {
 	config.Init(serviceName)
 	logger.Init(serviceName, config.GetLoggerLevel())
-	clientSet = base.NewClientSet(base.WithDBClient(), base.WithRedisClient(constants.RedisDBCommon), base.WithHzClient())
+	clientSet = base.NewClientSet(base.WithDBClient(), base.WithRedisClient(constants.RedisDBCommon), base.WithHzClient(), base.WithGovernor(),
+		base.WithUmengQueue(), base.WithOptionalElasticSearch(), base.WithOssSet(oss.UpYunProvider))
 	taskQueue = taskqueue.NewBaseTaskQueue()
 	noticeReady = make(chan struct{})
+	if clientSet.ESClient != nil {
+		if err := es.EnsureNoticeIndex(context.Background(), clientSet.ESClient); err != nil {
+			logger.Errorf("syncer init: ensure notice index failed: %v", err)
+		}
+	}
+	registerNoticeSources()
 	go loadNotice(clientSet.DBClient)
 }
 
+// registerNoticeSources 注册教务处和配置中的校内网站通知来源，配置有误的来源会被跳过
+func registerNoticeSources() {
+	if err := noticesource.Register(noticesource.NewJwchSource(config.DefaultUser.Account, config.DefaultUser.Password)); err != nil {
+		logger.Fatalf("syncer init: register jwch notice source failed: %v", err)
+	}
+	for _, cfg := range config.NoticeSources {
+		src, err := noticesource.NewWebSource(noticesource.WebConfig{
+			Name:         cfg.Name,
+			DisplayName:  cfg.DisplayName,
+			ListURL:      cfg.ListURL,
+			ItemXPath:    cfg.ItemXPath,
+			TitleXPath:   cfg.TitleXPath,
+			LinkXPath:    cfg.LinkXPath,
+			DateXPath:    cfg.DateXPath,
+			ContentXPath: cfg.ContentXPath,
+			Interval:     time.Duration(cfg.IntervalMinutes) * time.Minute,
+		}, clientSet.HzClient)
+		if err == nil {
+			err = noticesource.Register(src)
+		}
+		if err != nil {
+			logger.Errorf("syncer init: skip notice source %s: %v", cfg.Name, err)
+			continue
+		}
+		logger.Infof("syncer init: notice source %s registered", cfg.Name)
+	}
+}
+
 // TODO: 失败后的重试机制
 func loadNotice(db *db.Database) {
 	defer func() {
@@ -75,51 +115,76 @@ func loadNotice(db *db.Database) {
 		noticeReady <- struct{}{}
 	}()
 
-	stu := jwch.NewStudent().WithUser(config.DefaultUser.Account, config.DefaultUser.Password)
-	_, totalPage, err := stu.GetNoticeInfo(&jwch.NoticeInfoReq{PageNum: 1})
+	for _, src := range noticesource.All() {
+		loadNoticeSource(db, src)
+	}
+	logger.Infof("syncer init: notice syncer init success")
+}
+
+// loadNoticeSource 将来源的全部通知写入数据库，并为近期尚未抓取正文的历史通知补抓正文和附件
+// 补抓按发布时间截止且每次启动有数量上限，避免每次重启都把全部历史通知重新排队
+func loadNoticeSource(db *db.Database, src noticesource.NoticeSource) {
+	ctx := context.Background()
+	backfillSince := time.Now().Add(-constants.NoticeDetailBackfillWindow).Format(time.DateOnly)
+	backfilled := 0
+	_, totalPage, err := src.FetchPage(ctx, 1)
 	if err != nil {
-		logger.Errorf("syncer init: failed to get notice info: %v", err)
+		logger.Errorf("syncer init: failed to get notice info of %s: %v", src.Name(), err)
 		return
 	}
 	// 初始化数据库
 	for i := 1; i <= totalPage; i++ {
-		content, _, err := stu.GetNoticeInfo(&jwch.NoticeInfoReq{PageNum: i})
+		items, _, err := src.FetchPage(ctx, i)
 		if err != nil {
-			logger.Errorf("syncer init: failed to get notice info in page %d: %v", i, err)
+			logger.Errorf("syncer init: failed to get notice info of %s in page %d: %v", src.Name(), i, err)
 			continue
 		}
-		for _, row := range content {
-			ctx := context.Background()
-
-			ok, err := db.Notice.IsNoticeExists(ctx, row.Title, row.URL)
+		for _, item := range items {
+			ok, err := db.Notice.IsURLExists(ctx, item.URL)
 			if err != nil {
 				logger.Warnf("syncer init: failed to check notice exists in page %d: %v", i, err)
 				continue
 			}
-			// 数据库已存在，无需处理
+			// 数据库已存在，仅为近期尚未抓取正文的历史通知补抓正文和附件
 			if ok {
+				if backfilled >= constants.NoticeDetailBackfillLimit || item.Date < backfillSince {
+					continue
+				}
+				existing, err := db.Notice.GetNoticeByURL(ctx, item.URL)
+				if err != nil {
+					logger.Warnf("syncer init: failed to get notice in page %d: %v", i, err)
+					continue
+				}
+				if existing != nil && existing.Html == "" {
+					enqueueNoticeDetail(src, existing, item)
+					backfilled++
+				}
 				continue
 			}
 
 			info := &model.Notice{
-				Title:       row.Title,
-				PublishedAt: row.Date,
-				URL:         row.URL,
+				Title:       item.Title,
+				PublishedAt: item.Date,
+				URL:         item.URL,
+				Source:      src.Name(),
 			}
 			if err = db.Notice.CreateNotice(ctx, info); err != nil {
 				logger.Warnf("syncer init: failed to create notice in page %d: %v", i, err)
 				continue
 			}
-
-			go func(notice *jwch.NoticeInfo) {
-				ctx := context.Background()
-				if err := commonSvc.NewCommonService(ctx, clientSet, taskQueue).ProcessAutoAdjustCourseNotice(notice); err != nil {
-					logger.Errorf("syncer init: ProcessAutoAdjustCourseNotice failed, title=%s url=%s err=%v", notice.Title, notice.URL, err)
-				}
-			}(row)
+			enqueueNoticeDetail(src, info, item)
+
+			// 调课通知只来自教务处
+			if row, ok := item.Raw.(*jwch.NoticeInfo); ok {
+				go func(notice *jwch.NoticeInfo) {
+					ctx := context.Background()
+					if err := commonSvc.NewCommonService(ctx, clientSet, taskQueue).ProcessAutoAdjustCourseNotice(notice); err != nil {
+						logger.Errorf("syncer init: ProcessAutoAdjustCourseNotice failed, title=%s url=%s err=%v", notice.Title, notice.URL, err)
+					}
+				}(row)
+			}
 		}
 	}
-	logger.Infof("syncer init: notice syncer init success")
 }
 
 func main() {
@@ -144,18 +209,19 @@ func main() {
 		baseserver.AssembleCommonServerConfig(serviceName, addr, r)...,
 	)
 	server.RegisterShutdownHook(clientSet.Close)
+	server.RegisterShutdownHook(metrics.StartServer(config.Service.MetricsAddr)) // prometheus
 	server.RegisterShutdownHook(tracing.ProviderShutdown(shutdown,
 		"Common: otel provider shutdown failed: %v")) // otel provider
 
 	go func() {
 		<-noticeReady
 
-		taskQueue.AddSchedule(constants.NoticeTaskKey, taskqueue.ScheduleQueueTask{
-			Execute: syncNoticeTask,
-			GetScheduleTime: func() time.Duration {
-				return constants.NoticeUpdateTime
-			},
-		})
+		for _, src := range noticesource.All() {
+			taskQueue.AddSchedule(noticeTaskKey(src), taskqueue.ScheduleQueueTask{
+				Execute:         syncNoticeTask(src),
+				GetScheduleTime: src.Interval,
+			})
+		}
 
 		logger.Infof("Common: notice schedule task registered")
 	}()
@@ -166,6 +232,14 @@ func main() {
 			return constants.ContributorInfoUpdateTime
 		},
 	})
+	taskQueue.AddSchedule(constants.NotificationCleanupTaskKey, taskqueue.ScheduleQueueTask{
+		Execute: func(ctx context.Context) error {
+			return commonSvc.NewCommonService(ctx, clientSet, taskQueue).CleanupNotifications()
+		},
+		GetScheduleTime: func() time.Duration {
+			return constants.NotificationCleanupInterval
+		},
+	})
 	taskQueue.Start()
 
 	if err = svr.Run(); err != nil {
@@ -173,66 +247,92 @@ func main() {
 	}
 }
 
-func syncNoticeTask(ctx context.Context) error {
-	logger.WithCtx(ctx).Infof("syncNoticeTask: jwch notice sync task started")
-	// 默认爬取第一页的内容（教务处不太可能一次性更新出一页的数据），然后和数据库做 diff 操作
-	content, _, err := jwch.NewStudent().WithUser(config.DefaultUser.Account, config.DefaultUser.Password).GetNoticeInfo(&jwch.NoticeInfoReq{PageNum: 1})
-	if err != nil {
-		logger.WithCtx(ctx).Errorf("notice sync task: failed to get notice info: %v", err)
-		return fmt.Errorf("failed to get notice info: %w", err)
+// noticeTaskKey 教务处沿用原有的任务 key，其他来源以来源标识区分
+func noticeTaskKey(src noticesource.NoticeSource) string {
+	if src.Name() == constants.NoticeSourceJwch {
+		return constants.NoticeTaskKey
 	}
+	return constants.NoticeTaskKey + ":" + src.Name()
+}
 
-	for _, row := range content {
-		// 判断是否已存在
-		ok, err := clientSet.DBClient.Notice.IsNoticeExists(ctx, row.Title, row.URL)
+func syncNoticeTask(src noticesource.NoticeSource) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		logger.WithCtx(ctx).Infof("syncNoticeTask: %s notice sync task started", src.Name())
+		// 默认爬取第一页的内容（来源不太可能一次性更新出一页的数据），然后和数据库做 diff 操作
+		items, _, err := src.FetchPage(ctx, 1)
 		if err != nil {
-			return fmt.Errorf("notice sync task: failed to check url exists: %w", err)
+			logger.WithCtx(ctx).Errorf("notice sync task: failed to get notice info of %s: %v", src.Name(), err)
+			return fmt.Errorf("failed to get notice info of %s: %w", src.Name(), err)
 		}
 
-		// 数据库已存在，无需处理
-		if ok {
-			continue
-		}
+		for _, item := range items {
+			// 判断是否已存在
+			ok, err := clientSet.DBClient.Notice.IsURLExists(ctx, item.URL)
+			if err != nil {
+				return fmt.Errorf("notice sync task: failed to check url exists: %w", err)
+			}
+
+			// 数据库已存在，无需处理
+			if ok {
+				continue
+			}
 
-		logger.WithCtx(ctx).Infof("syncNoticeTask: new notice found, title=%s url=%s", row.Title, row.URL)
+			logger.WithCtx(ctx).Infof("syncNoticeTask: new notice found, source=%s title=%s url=%s", src.Name(), item.Title, item.URL)
 
-		info := &model.Notice{
-			Title:       row.Title,
-			URL:         row.URL,
-			PublishedAt: row.Date,
-		}
+			info := &model.Notice{
+				Title:       item.Title,
+				URL:         item.URL,
+				PublishedAt: item.Date,
+				Source:      src.Name(),
+			}
 
-		if err = clientSet.DBClient.Notice.CreateNotice(ctx, info); err != nil {
-			return fmt.Errorf("notice sync task: failed to create notice: %w", err)
-		}
+			if err = clientSet.DBClient.Notice.CreateNotice(ctx, info); err != nil {
+				return fmt.Errorf("notice sync task: failed to create notice: %w", err)
+			}
+			enqueueNoticeDetail(src, info, item)
 
-		go func(notice *jwch.NoticeInfo) {
-			ctx := context.Background()
-			if err := commonSvc.NewCommonService(ctx, clientSet, taskQueue).ProcessAutoAdjustCourseNotice(notice); err != nil {
-				logger.WithCtx(ctx).Errorf("ProcessAutoAdjustCourseNotice failed, title=%s url=%s err=%v", notice.Title, notice.URL, err)
+			// 订阅推送失败不影响通知入库，也不触发整个同步任务重试
+			if err = commonSvc.NewCommonService(ctx, clientSet, taskQueue).PushSubscribedNotice(info); err != nil {
+				logger.WithCtx(ctx).Errorf("notice sync task: push subscribed notice failed, title=%s err=%v", info.Title, err)
+			}
+
+			// 调课解析和全员推送只针对教务处通知
+			row, ok := item.Raw.(*jwch.NoticeInfo)
+			if !ok {
+				continue
+			}
+			go func(notice *jwch.NoticeInfo) {
+				ctx := context.Background()
+				if err := commonSvc.NewCommonService(ctx, clientSet, taskQueue).ProcessAutoAdjustCourseNotice(notice); err != nil {
+					logger.WithCtx(ctx).Errorf("ProcessAutoAdjustCourseNotice failed, title=%s url=%s err=%v", notice.Title, notice.URL, err)
+				}
+			}(row)
+
+			// 进行消息推送，教务处通知面向全体用户，只按 tag 推送而不逐人写入收件箱：
+			// 通知已写入 notice 表，错过推送的设备可以在通知列表中查看，不需要为每名学生复制一份收件箱记录
+			if err = notification.New(clientSet.DBClient).Notify(ctx, &notification.Message{
+				Type:        constants.UmengPushTypeTeaching,
+				Title:       "教务处通知",
+				Text:        info.Title,
+				Keywords:    []string{info.Title},
+				Description: "教务处",
+				Deeplink:    constants.UmengJwchNoticeDeeplink + "?url=" + url.QueryEscape(info.URL),
+				Tags:        []string{constants.UmengJwchNoticeTag},
+			}); err != nil {
+				logger.WithCtx(ctx).Errorf("notice sync task: drop notice notification: %v", err)
 			}
-		}(row)
-
-		// 进行消息推送
-		if ok := umeng.EnqueueAsync(func() error {
-			deeplink := constants.UmengJwchNoticeDeeplink + "?url=" + url.QueryEscape(info.URL)
-			umeng.PushByType(
-				constants.UmengPushTypeTeaching,
-				"教务处通知",
-				info.Title,
-				[]string{info.Title},
-				"",
-				constants.UmengJwchNoticeTag,
-				"教务处",
-				deeplink,
-			)
-			logger.WithCtx(ctx).Infof("notice sync task: notice send success")
-			return nil
-		}); !ok {
-			logger.WithCtx(ctx).Errorf("umeng async queue full, drop notice notification")
 		}
+		return nil
 	}
-	return nil
+}
+
+// enqueueNoticeDetail 将通知正文的抓取、附件转存与索引交给任务队列，失败时由队列退避重试
+func enqueueNoticeDetail(src noticesource.NoticeSource, notice *model.Notice, item *noticesource.Item) {
+	taskQueue.Add(constants.NoticeDetailTaskKeyPrefix+strconv.FormatInt(notice.Id, 10), taskqueue.QueueTask{
+		Execute: func() error {
+			return commonSvc.NewCommonService(context.Background(), clientSet, taskQueue).SyncNoticeDetail(src, notice, item)
+		},
+	})
 }
 
 func syncContributorTask(ctx context.Context) error {
@@ -26,6 +26,7 @@ import (
 
 	"github.com/west2-online/fzuhelper-server/config"
 	"github.com/west2-online/fzuhelper-server/internal/course"
+	"github.com/west2-online/fzuhelper-server/internal/course/service"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/course/courseservice"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
@@ -33,6 +34,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/tracing"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -49,7 +51,14 @@ func init() {
 	config.Init(serviceName)
 	logger.Init(serviceName, config.GetLoggerLevel())
 	// eshook.InitLoggerWithHook(serviceName)
-	clientSet = base.NewClientSet(base.WithDBClient(), base.WithRedisClient(constants.RedisDBCourse), base.WithCommonRPCClient(), base.WithUserRPCClient())
+	clientSet = base.NewClientSet(
+		base.WithDBClient(),
+		base.WithRedisClient(constants.RedisDBCourse),
+		base.WithCommonRPCClient(),
+		base.WithUserRPCClient(),
+		base.WithGovernor(),
+		base.WithUmengQueue(),
+	)
 	taskQueue = taskqueue.NewBaseTaskQueue()
 }
 
@@ -78,6 +87,7 @@ func main() {
 	)
 
 	server.RegisterShutdownHook(clientSet.Close)
+	server.RegisterShutdownHook(metrics.StartServer(config.Service.MetricsAddr)) // prometheus
 	server.RegisterShutdownHook(tracing.ProviderShutdown(shutdown,
 		"Course: otel provider shutdown failed: %v")) // otel provider
 
@@ -103,6 +113,14 @@ func main() {
 			return constants.LocateDateUpdateTime
 		},
 	})
+	taskQueue.AddSchedule(constants.ExamReminderTaskKey, taskqueue.ScheduleQueueTask{
+		Execute: func(ctx context.Context) error {
+			return service.NewCourseService(ctx, clientSet, taskQueue).DispatchExamReminders()
+		},
+		GetScheduleTime: func() time.Duration {
+			return constants.ExamReminderScanInterval
+		},
+	})
 	taskQueue.Start()
 	if err = svr.Run(); err != nil {
 		logger.Fatalf("Course: run server failed, err: %v", err)
@@ -28,6 +28,7 @@ import (
 	baseserver "github.com/west2-online/fzuhelper-server/pkg/base/server"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/tracing"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -46,6 +47,7 @@ func init() {
 	clientSet = base.NewClientSet(
 		base.WithDBClient(),
 		base.WithRedisClient(constants.RedisDBUser),
+		base.WithGovernor(),
 	)
 	taskQueue = taskqueue.NewBaseTaskQueue()
 }
@@ -72,6 +74,7 @@ func main() {
 		baseserver.AssembleCommonServerConfig(serviceName, addr, r)...,
 	)
 	server.RegisterShutdownHook(clientSet.Close)
+	server.RegisterShutdownHook(metrics.StartServer(config.Service.MetricsAddr)) // prometheus
 	server.RegisterShutdownHook(tracing.ProviderShutdown(shutdown,
 		"User: otel provider shutdown failed: %v")) // otel provider
 
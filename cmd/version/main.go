@@ -27,12 +27,14 @@ import (
 
 	"github.com/west2-online/fzuhelper-server/config"
 	"github.com/west2-online/fzuhelper-server/internal/version"
+	"github.com/west2-online/fzuhelper-server/internal/version/service"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/version/versionservice"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	baseserver "github.com/west2-online/fzuhelper-server/pkg/base/server"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/db/model"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/tracing"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -77,6 +79,7 @@ func main() {
 		baseserver.AssembleCommonServerConfig(serviceName, addr, r)...,
 	)
 	server.RegisterShutdownHook(clientSet.Close)
+	server.RegisterShutdownHook(metrics.StartServer(config.Service.MetricsAddr)) // prometheus
 	server.RegisterShutdownHook(tracing.ProviderShutdown(shutdown,
 		"Version: otel provider shutdown failed: %v")) // otel provider
 
@@ -94,6 +97,11 @@ func main() {
 	})
 	taskQueue.Start()
 
+	// 版本记录迁移到数据库前的版本只保存在又拍云上，导入后版本历史、更新日志才不会从空开始
+	if err = service.NewVersionService(context.Background(), clientSet).SeedLegacyVersions(); err != nil {
+		logger.Errorf("Version: seed legacy versions failed: %v", err)
+	}
+
 	if err = svr.Run(); err != nil {
 		logger.Fatalf("Version: server run failed: %v", err)
 	}
@@ -53,6 +53,12 @@ var (
 	Vendors              *vendors
 	Friend               *friend
 	APIMonitor           *apiMonitorConfig
+	RateLimit            *rateLimitConfig
+	Governor             *governorConfig
+	NoticeSources        []noticeSource
+	ExamReminder         *examReminder
+	Notification         *notification
+	AppStore             *appStore
 	runtimeViper         = viper.New()
 )
 
@@ -167,6 +173,12 @@ func configMapping(srv string) {
 	Umeng = &c.Umeng
 	Friend = &c.Friend
 	APIMonitor = &c.APIMonitor
+	RateLimit = &c.RateLimit
+	Governor = &c.Governor
+	NoticeSources = c.NoticeSources
+	ExamReminder = &c.ExamReminder
+	Notification = &c.Notification
+	AppStore = &c.AppStore
 	if upy, ok := c.UpYuns[srv]; ok {
 		UpYun = &upy
 	}
@@ -178,9 +190,10 @@ func getService(name string) *service {
 	addrList := runtimeViper.GetStringSlice("services." + name + ".addr")
 
 	return &service{
-		Name:     runtimeViper.GetString("services." + name + ".name"),
-		AddrList: addrList,
-		LB:       runtimeViper.GetBool("services." + name + ".load-balance"),
+		Name:        runtimeViper.GetString("services." + name + ".name"),
+		AddrList:    addrList,
+		LB:          runtimeViper.GetBool("services." + name + ".load-balance"),
+		MetricsAddr: runtimeViper.GetString("services." + name + ".metrics-addr"),
 	}
 }
 
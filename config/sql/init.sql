@@ -37,6 +37,16 @@ CREATE TABLE `fzu-helper`.`scores` (
     PRIMARY KEY (`stu_id`)
 ) ENGINE = InnoDB CHARSET = utf8mb4;
 
+CREATE TABLE `fzu-helper`.`score_subscribers` (
+    `id` BIGINT NOT NULL COMMENT 'ID',
+    `tag` VARCHAR(32) NOT NULL COMMENT '成绩通知使用的课程tag',
+    `stu_id` VARCHAR(16) NOT NULL COMMENT '学号',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uniq_tag_stu_id` (`tag`, `stu_id`),
+    INDEX `idx_stu_id` (`stu_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4;
+
 CREATE TABLE `fzu-helper`.`course_offerings` (
     `id` BIGINT NOT NULL AUTO_INCREMENT,
     `name` VARCHAR(64) NOT NULL COMMENT '课程名',
@@ -54,7 +64,7 @@ CREATE TABLE `fzu-helper`.`course_offerings` (
 CREATE TABLE `fzu-helper`.`exam_offerings` (
     `id` BIGINT NOT NULL AUTO_INCREMENT,
     `exam_hash` CHAR(64) NOT NULL COMMENT '通过课程和新旧考试信息生成的唯一hash',
-    `tag` VARCHAR(32) NOT NULL COMMENT '考试/考场通知使用的友盟tag',
+    `tag` VARCHAR(32) NOT NULL COMMENT '课程的考试tag',
     `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     `deleted_at` TIMESTAMP NULL DEFAULT NULL,
@@ -62,6 +72,72 @@ CREATE TABLE `fzu-helper`.`exam_offerings` (
     UNIQUE INDEX `uniq_exam_hash` (`exam_hash`)
 ) ENGINE=InnoDB CHARSET=utf8mb4;
 
+CREATE TABLE `fzu-helper`.`exam_reminders` (
+    `id` BIGINT NOT NULL COMMENT 'ID',
+    `reminder_key` CHAR(64) NOT NULL COMMENT '通过考试tag、学期、考试时间和提前量生成的唯一hash',
+    `tag` VARCHAR(32) NOT NULL COMMENT '考试通知使用的友盟tag',
+    `term` VARCHAR(16) NOT NULL COMMENT '学期',
+    `name` VARCHAR(255) NOT NULL COMMENT '课程名称',
+    `exam_time` VARCHAR(255) NOT NULL COMMENT '考试时间地点（原始文本）',
+    `offset_minutes` BIGINT NOT NULL COMMENT '提前提醒的分钟数',
+    `remind_at` DATETIME NOT NULL COMMENT '提醒时间',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '0 待推送 1 已推送 2 已取消',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `deleted_at` TIMESTAMP NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uniq_reminder_key` (`reminder_key`),
+    INDEX `idx_status_remind_at` (`status`, `remind_at`),
+    INDEX `idx_tag_term` (`tag`, `term`)
+) ENGINE=InnoDB CHARSET=utf8mb4;
+
+CREATE TABLE `fzu-helper`.`exam_subscribers` (
+    `id` BIGINT NOT NULL COMMENT 'ID',
+    `tag` VARCHAR(32) NOT NULL COMMENT '课程的考试tag',
+    `term` VARCHAR(16) NOT NULL COMMENT '学期',
+    `stu_id` VARCHAR(16) NOT NULL COMMENT '学号',
+    `exam_time` VARCHAR(255) NOT NULL COMMENT '该学生最近一次快照中的考试时间地点（原始文本），没有考试时间时为空',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uniq_tag_term_stu_id` (`tag`, `term`, `stu_id`),
+    INDEX `idx_stu_id_term` (`stu_id`, `term`)
+) ENGINE=InnoDB CHARSET=utf8mb4;
+
+CREATE TABLE `fzu-helper`.`exam_room_snapshot` (
+    `id` BIGINT NOT NULL COMMENT 'ID',
+    `stu_id` VARCHAR(255) NOT NULL COMMENT '学号',
+    `term` VARCHAR(16) NOT NULL COMMENT '学期',
+    `rooms` TEXT NOT NULL COMMENT '考场信息快照（json）',
+    `rooms_sha256` CHAR(64) NOT NULL COMMENT '考场信息快照的sha256',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `deleted_at` TIMESTAMP NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uniq_stu_id_term` (`stu_id`, `term`)
+) ENGINE=InnoDB CHARSET=utf8mb4;
+
+CREATE TABLE `fzu-helper`.`exam_room_change` (
+    `id` BIGINT NOT NULL COMMENT 'ID',
+    `stu_id` VARCHAR(255) NOT NULL COMMENT '学号',
+    `term` VARCHAR(16) NOT NULL COMMENT '学期',
+    `name` VARCHAR(255) NOT NULL COMMENT '课程名',
+    `teacher` VARCHAR(255) NOT NULL COMMENT '任课教师',
+    `credit` VARCHAR(16) NOT NULL COMMENT '学分',
+    `change_type` VARCHAR(16) NOT NULL COMMENT 'added 新增 changed 变更 removed 移除',
+    `old_location` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '变化前考场',
+    `old_date` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '变化前日期',
+    `old_time` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '变化前时间',
+    `new_location` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '变化后考场',
+    `new_date` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '变化后日期',
+    `new_time` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '变化后时间',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `deleted_at` TIMESTAMP NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_stu_id_term_created_at` (`stu_id`, `term`, `created_at`)
+) ENGINE=InnoDB CHARSET=utf8mb4;
+
 create table `fzu-helper`.`launch_screen`(
     `id`          bigint                NOT NULL           AUTO_INCREMENT           COMMENT 'ID',
     `url`         tinytext              NULL                                        COMMENT '图片url',
@@ -106,6 +182,9 @@ CREATE TABLE `fzu-helper`.`notice`(
     `title`       varchar(255) NOT NULL COMMENT '标题',
     `url`         varchar(255)         NOT NULL COMMENT '链接',
     `published_at` varchar(10)    NOT NULL COMMENT '发布时间',
+    `source`      varchar(32)  NOT NULL DEFAULT 'jwch' COMMENT '通知来源，例 jwch、library',
+    `content`     mediumtext   NULL COMMENT '正文纯文本，用于全文检索',
+    `html`        mediumtext   NULL COMMENT '清洗后的正文 HTML，用于通知详情',
     `created_at`  timestamp    NOT NULL DEFAULT current_timestamp,
     `updated_at`  timestamp    NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp,
     `deleted_at`  timestamp    NULL DEFAULT NULL,
@@ -114,6 +193,96 @@ CREATE TABLE `fzu-helper`.`notice`(
 )engine=InnoDB default charset=utf8mb4;
 /* 建立发布时间的索引 */
 CREATE INDEX idx_published_at ON `fzu-helper`.`notice`(`published_at`);
+CREATE INDEX idx_source_published_at ON `fzu-helper`.`notice`(`source`, `published_at`);
+
+CREATE TABLE `fzu-helper`.`notice_attachment`(
+    `id`          bigint       NOT NULL COMMENT 'ID',
+    `notice_id`   bigint       NOT NULL COMMENT '通知 ID',
+    `name`        varchar(255) NOT NULL COMMENT '附件名称',
+    `source_url`  varchar(512) NOT NULL COMMENT '教务处原始下载地址',
+    `mirror_url`  varchar(512) NULL COMMENT 'OSS 转存地址，为空表示尚未转存',
+    `created_at`  timestamp    NOT NULL DEFAULT current_timestamp,
+    `updated_at`  timestamp    NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp,
+    `deleted_at`  timestamp    NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `unique_notice_source` (`notice_id`, `source_url`)
+)engine=InnoDB default charset=utf8mb4;
+
+CREATE TABLE `fzu-helper`.`notice_subscription`(
+    `id`          bigint       NOT NULL COMMENT 'ID',
+    `stu_id`      varchar(20)  NOT NULL COMMENT '学号',
+    `keyword`     varchar(32)  NOT NULL COMMENT '订阅关键词',
+    `created_at`  timestamp    NOT NULL DEFAULT current_timestamp,
+    `updated_at`  timestamp    NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp,
+    `deleted_at`  timestamp    NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `unique_stu_keyword` (`stu_id`, `keyword`),
+    KEY `idx_keyword` (`keyword`)
+)engine=InnoDB default charset=utf8mb4;
+
+CREATE TABLE `fzu-helper`.`notification`(
+    `id`          bigint        NOT NULL COMMENT 'ID',
+    `stu_id`      varchar(20)   NOT NULL COMMENT '学号',
+    `type`        varchar(16)   NOT NULL COMMENT '通知类型，与推送类型一致',
+    `title`       varchar(255)  NOT NULL COMMENT '标题',
+    `content`     varchar(1024) NOT NULL COMMENT '内容',
+    `deeplink`    varchar(512)  NULL DEFAULT NULL COMMENT '客户端跳转地址',
+    `read_at`     timestamp     NULL DEFAULT NULL COMMENT '已读时间，NULL 表示未读',
+    `created_at`  timestamp     NOT NULL DEFAULT current_timestamp,
+    `updated_at`  timestamp     NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp,
+    PRIMARY KEY (`id`),
+    KEY `idx_stu_read` (`stu_id`, `read_at`),
+    KEY `idx_created_at` (`created_at`)
+)engine=InnoDB default charset=utf8mb4;
+
+CREATE TABLE `fzu-helper`.`device`(
+    `id`            bigint        NOT NULL COMMENT 'ID',
+    `stu_id`        varchar(20)   NOT NULL COMMENT '学号',
+    `device_token`  varchar(128)  NOT NULL COMMENT '友盟 device token',
+    `platform`      varchar(16)   NOT NULL COMMENT '平台，android/ios/harmony',
+    `app_version`   varchar(32)   NOT NULL DEFAULT '' COMMENT '客户端版本',
+    `created_at`    timestamp     NOT NULL DEFAULT current_timestamp,
+    `updated_at`    timestamp     NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `unique_device_token` (`device_token`),
+    KEY `idx_stu_id` (`stu_id`)
+)engine=InnoDB default charset=utf8mb4;
+
+CREATE TABLE `fzu-helper`.`notification_preference`(
+    `stu_id`          varchar(20)   NOT NULL COMMENT '学号',
+    `disabled_types`  varchar(64)   NOT NULL DEFAULT '' COMMENT '关闭推送的通知类型，逗号分隔',
+    `channel`         varchar(16)   NOT NULL DEFAULT 'all' COMMENT '推送渠道，all/inbox',
+    `quiet_start`     smallint      NOT NULL DEFAULT 0 COMMENT '免打扰开始时间，距零点的分钟数',
+    `quiet_end`       smallint      NOT NULL DEFAULT 0 COMMENT '免打扰结束时间，与开始时间相等时不开启',
+    `created_at`      timestamp     NOT NULL DEFAULT current_timestamp,
+    `updated_at`      timestamp     NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp,
+    PRIMARY KEY (`stu_id`)
+)engine=InnoDB default charset=utf8mb4;
+
+CREATE TABLE `fzu-helper`.`app_version`(
+    `id`                  bigint        NOT NULL COMMENT 'ID',
+    `platform`            varchar(16)   NOT NULL COMMENT '平台，android/ios/harmony',
+    `channel`             varchar(16)   NOT NULL COMMENT '渠道，release/beta/nightly',
+    `code`                bigint        NOT NULL COMMENT '版本号',
+    `version`             varchar(32)   NOT NULL COMMENT '版本名',
+    `url`                 varchar(255)  NOT NULL COMMENT '下载地址',
+    `feature`             text          NOT NULL COMMENT '更新日志',
+    `force`               tinyint(1)    NOT NULL DEFAULT 0 COMMENT '是否强制更新',
+    `rollout_percent`     tinyint       NOT NULL DEFAULT 100 COMMENT '灰度比例 0-100',
+    `min_supported_code`  bigint        NOT NULL DEFAULT 0 COMMENT '最低支持的版本号，低于该版本强制更新',
+    `status`              varchar(16)   NOT NULL DEFAULT 'active' COMMENT '状态，active/rolled_back',
+    `created_at`          timestamp     NOT NULL DEFAULT current_timestamp,
+    `updated_at`          timestamp     NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_platform_channel_code` (`platform`, `channel`, `code`)
+)engine=InnoDB default charset=utf8mb4;
+
+CREATE TABLE `fzu-helper`.`cloud_setting`(
+    `id`          bigint       NOT NULL COMMENT 'ID',
+    `content`     mediumtext   NOT NULL COMMENT '云控配置，保留注释的原始内容',
+    `created_at`  timestamp    NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (`id`)
+)engine=InnoDB default charset=utf8mb4;
 
 CREATE TABLE `fzu-helper`.`visit`(
     `id`          bigint       NOT NULL AUTO_INCREMENT COMMENT 'ID',
@@ -33,9 +33,10 @@ type admin struct {
 }
 
 type service struct {
-	Name     string
-	AddrList []string
-	LB       bool `mapstructure:"load-balance"`
+	Name        string
+	AddrList    []string
+	LB          bool   `mapstructure:"load-balance"`
+	MetricsAddr string `mapstructure:"metrics-addr"` // prometheus 抓取地址，为空时不暴露
 }
 
 /*
@@ -149,6 +150,13 @@ type umeng struct {
 	Harmony HarmonyUmeng `mapstructure:"harmony"`
 }
 
+// appStore 各平台应用商店中 fzuhelper 的页面链接，正式版更新时引导用户前往商店
+// 为空时使用发布版本时上传的链接
+type appStore struct {
+	IOS     string `mapstructure:"ios"`     // App Store
+	Harmony string `mapstructure:"harmony"` // AppGallery
+}
+
 type oppo struct {
 	ChannelID          string `mapstructure:"channel_id"`
 	Category           string `mapstructure:"category"`
@@ -211,13 +219,88 @@ type signedLocationApiUrl struct {
 }
 
 type apiMonitorConfig struct {
-	Enabled              bool     `mapstructure:"enabled"`
-	WindowSeconds        int64    `mapstructure:"window-seconds"`
-	CheckIntervalSeconds int64    `mapstructure:"check-interval-seconds"`
-	ErrorRateThreshold   float64  `mapstructure:"error-rate-threshold"`
-	MinRequests          int64    `mapstructure:"min-requests"`
-	AlertCooldownSeconds int64    `mapstructure:"alert-cooldown-seconds"`
-	RouteBlacklist       []string `mapstructure:"route-blacklist"`
+	Enabled              bool             `mapstructure:"enabled"`
+	WindowSeconds        int64            `mapstructure:"window-seconds"`
+	CheckIntervalSeconds int64            `mapstructure:"check-interval-seconds"`
+	ErrorRateThreshold   float64          `mapstructure:"error-rate-threshold"`
+	MinRequests          int64            `mapstructure:"min-requests"`
+	AlertCooldownSeconds int64            `mapstructure:"alert-cooldown-seconds"`
+	RouteBlacklist       []string         `mapstructure:"route-blacklist"`
+	Sinks                []apiMonitorSink `mapstructure:"sinks"`
+}
+
+// apiMonitorSink 描述单个告警通道及其投递规则
+// Type 可选 webhook、email、kafka；Format 仅对 webhook 生效，可选 generic、feishu、dingtalk、wecom
+// Routes 为路由前缀，为空时接收全部路由的告警
+type apiMonitorSink struct {
+	Name          string   `mapstructure:"name"`
+	Type          string   `mapstructure:"type"`
+	Format        string   `mapstructure:"format"`
+	URL           string   `mapstructure:"url"`
+	Secret        string   `mapstructure:"secret"`
+	SMTPAddr      string   `mapstructure:"smtp-addr"`
+	Username      string   `mapstructure:"username"`
+	Password      string   `mapstructure:"password"`
+	From          string   `mapstructure:"from"`
+	To            []string `mapstructure:"to"`
+	Topic         string   `mapstructure:"topic"`
+	Routes        []string `mapstructure:"routes"`
+	MinErrorRate  float64  `mapstructure:"min-error-rate"`
+	SendRecovered bool     `mapstructure:"send-recovered"`
+}
+
+// rateLimitPolicy 描述单个路由的限流策略
+// KeyBy 可选 student（学号）、ip、token，Query 用于限定只对携带特定查询参数的请求生效
+type rateLimitPolicy struct {
+	Route         string            `mapstructure:"route"`
+	Method        string            `mapstructure:"method"`
+	KeyBy         string            `mapstructure:"key-by"`
+	Limit         int64             `mapstructure:"limit"`
+	WindowSeconds int64             `mapstructure:"window-seconds"`
+	Query         map[string]string `mapstructure:"query"`
+}
+
+type rateLimitConfig struct {
+	Enabled  bool              `mapstructure:"enabled"`
+	Policies []rateLimitPolicy `mapstructure:"policies"`
+}
+
+// governorConfig 描述访问教务处（jwch/yjsy）的全局出口治理参数
+// Rate/Burst 为每个上游主机共享的令牌桶，失败阈值和熔断时长用于熔断器
+type governorConfig struct {
+	Enabled          bool    `mapstructure:"enabled"`
+	Rate             float64 `mapstructure:"rate"` // 每秒补充的令牌数
+	Burst            int64   `mapstructure:"burst"`
+	MaxWaitMillis    int64   `mapstructure:"max-wait-millis"`
+	FailureThreshold int     `mapstructure:"failure-threshold"`
+	FailureWindowSec int64   `mapstructure:"failure-window-seconds"`
+	OpenSeconds      int64   `mapstructure:"open-seconds"`
+}
+
+// noticeSource 描述教务处以外的校内网站通知来源，列表和正文均通过 XPath 解析
+// title-xpath、link-xpath、date-xpath 相对于 item-xpath 匹配到的节点
+type noticeSource struct {
+	Name            string `mapstructure:"name"`
+	DisplayName     string `mapstructure:"display-name"`
+	ListURL         string `mapstructure:"list-url"`
+	ItemXPath       string `mapstructure:"item-xpath"`
+	TitleXPath      string `mapstructure:"title-xpath"` // 为空时使用链接文字
+	LinkXPath       string `mapstructure:"link-xpath"`  // 为空时使用第一个 a 标签
+	DateXPath       string `mapstructure:"date-xpath"`  // 为空时从整个条目的文字中提取日期
+	ContentXPath    string `mapstructure:"content-xpath"`
+	IntervalMinutes int64  `mapstructure:"interval-minutes"`
+}
+
+// examReminder 描述考前提醒的推送时机，OffsetMinutes 为距考试开始的提前量（分钟），为空时默认考前一天
+type examReminder struct {
+	Enabled       bool    `mapstructure:"enabled"`
+	OffsetMinutes []int64 `mapstructure:"offset-minutes"`
+}
+
+// notification 描述通知中心收件箱的保留策略，单位为天，为 0 时使用默认值
+type notification struct {
+	RetentionDays     int64 `mapstructure:"retention-days"`
+	ReadRetentionDays int64 `mapstructure:"read-retention-days"`
 }
 
 type config struct {
@@ -243,4 +326,10 @@ type config struct {
 	Friend               friend
 	SignedLocationApiUrl signedLocationApiUrl `mapstructure:"signed_location_api_url"`
 	APIMonitor           apiMonitorConfig     `mapstructure:"api-monitor"`
+	RateLimit            rateLimitConfig      `mapstructure:"rate-limit"`
+	Governor             governorConfig       `mapstructure:"governor"`
+	NoticeSources        []noticeSource       `mapstructure:"notice-sources"`
+	ExamReminder         examReminder         `mapstructure:"exam-reminder"`
+	Notification         notification         `mapstructure:"notification"`
+	AppStore             appStore             `mapstructure:"app-store"`
 }
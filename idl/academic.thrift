@@ -7,6 +7,8 @@ struct GetScoresRequest {
 struct GetScoresResponse {
     1: required model.BaseResp base
     2: optional list<model.Score> scores
+    3: optional bool stale              // 教务处不可用时返回的数据库快照
+    4: optional i64 snapshot_time       // 快照时间，毫秒时间戳
 }
 
 struct GetGPARequest {
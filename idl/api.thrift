@@ -11,10 +11,24 @@ struct EmptyClassroomRequest {
     2: required string campus
     3: required string startTime;
     4: required string endTime;
+    5: optional string build            // 按楼过滤，例 西3
+    6: optional i64 minCapacity         // 最少可容纳人数
+    7: optional string sortBy           // 排序方式，free_longest 表示按请求节次之后的连续空闲时长倒序
 }
 
 struct EmptyClassroomResponse {
     1: optional list<model.Classroom> classrooms
+    2: optional model.EmptyRoomSyncStatus syncStatus
+}
+
+struct RoomScheduleRequest {
+    1: required string room
+    2: required string date
+}
+
+struct RoomScheduleResponse {
+    1: optional model.RoomSchedule schedule
+    2: optional model.EmptyRoomSyncStatus syncStatus
 }
 
 struct ExamRoomInfoRequest {
@@ -23,6 +37,16 @@ struct ExamRoomInfoRequest {
 
 struct ExamRoomInfoResponse {
     1: optional list<model.ExamRoomInfo> examRoomInfos
+    2: optional bool stale
+    3: optional i64 snapshot_time
+}
+
+struct ExamRoomChangesRequest {
+    1: required string term
+}
+
+struct ExamRoomChangesResponse {
+    1: optional list<model.ExamRoomChange> changes
 }
 
 service ClassRoomService {
@@ -30,6 +54,10 @@ service ClassRoomService {
     EmptyClassroomResponse GetEmptyClassrooms(1: EmptyClassroomRequest request)(api.get="/api/v1/common/classroom/empty")
     // 查询考表
     ExamRoomInfoResponse GetExamRoomInfo(1: ExamRoomInfoRequest request)(api.get="/api/v1/jwch/classroom/exam")
+    // 查询单个教室一天内的占用情况
+    RoomScheduleResponse GetRoomSchedule(1: RoomScheduleRequest request)(api.get="/api/v1/common/classroom/schedule")
+    // 查询考场变化记录
+    ExamRoomChangesResponse GetExamRoomChanges(1: ExamRoomChangesRequest request)(api.get="/api/v1/jwch/classroom/exam/changes")
 }
 
 ## ----------------------------------------------------------------------------
@@ -143,6 +171,43 @@ struct ReorderFriendListResponse {
     1: required model.BaseResp base,
 }
 
+struct RegisterDeviceRequest {
+    1: required string device_token,    // 友盟 device token
+    2: required string platform,        // android / ios / harmony
+    3: optional string app_version,
+}
+
+struct RegisterDeviceResponse {
+    1: required model.BaseResp base,
+}
+
+struct UnregisterDeviceRequest {
+    1: required string device_token,
+}
+
+struct UnregisterDeviceResponse {
+    1: required model.BaseResp base,
+}
+
+struct GetNotificationPreferenceRequest {
+}
+
+struct GetNotificationPreferenceResponse {
+    1: required model.BaseResp base,
+    2: required model.NotificationPreference data,
+}
+
+struct UpdateNotificationPreferenceRequest {
+    1: required list<string> disabled_types,
+    2: required string channel,
+    3: optional string quiet_start,
+    4: optional string quiet_end,
+}
+
+struct UpdateNotificationPreferenceResponse {
+    1: required model.BaseResp base,
+}
+
 service UserService {
     // 后端自动登录（含验证码识别），该接口默认不提供给客户端，仅供测试
     GetLoginDataResponse GetLoginData(1: GetLoginDataRequest request)(api.get="/api/v1/internal/user/login"), # 后端内部测试接口使用，使用 internal 前缀做区别
@@ -170,6 +235,14 @@ service UserService {
     GetFriendMaxNumResponse GetFriendMaxNum(1: GetFriendMaxNumRequest request)(api.get = "/api/v1/user/friend/max-num")
     // 好友列表排序
     ReorderFriendListResponse ReorderFriendList(1: ReorderFriendListRequest request)(api.post = "/api/v1/user/friend/reorder")
+    // 登记当前设备的友盟 device token，客户端在登录后上报，用于按学生定向推送
+    RegisterDeviceResponse RegisterDevice(1: RegisterDeviceRequest request)(api.post = "/api/v1/jwch/user/device")
+    // 注销当前设备，客户端退出登录时调用
+    UnregisterDeviceResponse UnregisterDevice(1: UnregisterDeviceRequest request)(api.delete = "/api/v1/jwch/user/device")
+    // 获取通知偏好，未设置过时返回默认偏好
+    GetNotificationPreferenceResponse GetNotificationPreference(1: GetNotificationPreferenceRequest request)(api.get = "/api/v1/jwch/user/notification/preference")
+    // 更新通知偏好，免打扰期间的定向推送会延迟到免打扰结束后发送
+    UpdateNotificationPreferenceResponse UpdateNotificationPreference(1: UpdateNotificationPreferenceRequest request)(api.put = "/api/v1/jwch/user/notification/preference")
 }
 
 ## ----------------------------------------------------------------------------
@@ -183,6 +256,8 @@ struct CourseListRequest {
 struct CourseListResponse {
     1: required model.BaseResp base
     2: required list<model.Course> data
+    3: optional bool stale
+    4: optional i64 snapshot_time
 }
 
 struct CourseTermListRequest{}
@@ -190,6 +265,8 @@ struct CourseTermListRequest{}
 struct CourseTermListResponse{
     1: required model.BaseResp base
     2: required list<string> data
+    3: optional bool stale
+    4: optional i64 snapshot_time
 }
 
 struct GetCalendarTokenRequest {
@@ -441,6 +518,8 @@ struct GetScoresRequest {}
 
 struct GetScoresResponse {
     1: required list<model.Score> scores
+    2: optional bool stale
+    3: optional i64 snapshot_time
 }
 
 struct GetGPARequest {}
@@ -512,6 +591,9 @@ struct UploadRequest{
     5: required string type,
     6: required string password,
     7: required bool force,
+    8: optional string platform,            // android / ios / harmony，默认 android
+    9: optional i64 rollout_percent,        // 灰度比例 0-100，默认 100；以相同 code 和相同内容重新上传可调整比例
+    10: optional i64 min_supported_code,    // 最低支持的版本号，低于该版本的客户端强制更新
 }
 
 struct UploadResponse{
@@ -544,6 +626,9 @@ struct DownloadBetaApkResponse{
 }
 
 struct GetReleaseVersionRequest{
+    1: optional string device_id,   // 设备标识，优先用于灰度分桶
+    2: optional string stu_id,      // 学号，未提供设备标识时用于灰度分桶
+    3: optional string code,        // 客户端当前版本号，低于最低支持版本时强制更新
 }
 
 struct GetReleaseVersionResponse{
@@ -557,6 +642,9 @@ struct GetReleaseVersionResponse{
 }
 
 struct GetBetaVersionRequest{
+    1: optional string device_id,   // 设备标识，优先用于灰度分桶
+    2: optional string stu_id,      // 学号，未提供设备标识时用于灰度分桶
+    3: optional string code,        // 客户端当前版本号，低于最低支持版本时强制更新
 }
 
 struct GetBetaVersionResponse{
@@ -624,6 +712,9 @@ struct GetDumpResponse{
 }
 
 struct AndroidGetVersioneRequest{
+    1: optional string device_id,   // 设备标识，优先用于灰度分桶
+    2: optional string stu_id,      // 学号，未提供设备标识时用于灰度分桶
+    3: optional string code,        // 客户端当前版本号，低于最低支持版本时强制更新
 }
 
 struct AndroidGetVersionResponse{
@@ -632,6 +723,82 @@ struct AndroidGetVersionResponse{
     3: optional model.Version beta,
 }
 
+struct RollbackVersionRequest{
+    1: required string password,
+    2: required string type,        // release / beta / nightly
+    3: optional string platform,    // 默认 android
+}
+
+struct RollbackVersionResponse{
+    1: model.BaseResp base,
+    2: optional model.Version data, // 回滚后生效的版本
+}
+
+struct ListVersionsRequest{
+    1: required string channel,     // release / beta / nightly
+    2: optional string platform,    // 默认 android
+    3: optional i64 page_num,       // 页码，默认为 1
+    4: optional i64 page_size,      // 每页条数，默认为 20，最大为 100
+}
+
+struct ListVersionsResponse{
+    1: model.BaseResp base,
+    2: list<model.Version> data,    // 按版本号从新到旧排列，包括已回滚的版本
+    3: i64 total,
+}
+
+struct GetVersionChangelogRequest{
+    1: required string channel,     // release / beta / nightly
+    2: required string code,        // 客户端当前版本号
+    3: optional string platform,    // 默认 android
+    4: optional string device_id,   // 设备标识，用于确定请求者在灰度下能更新到的版本
+    5: optional string stu_id,      // 学号，未提供设备标识时使用
+}
+
+struct GetVersionChangelogResponse{
+    1: model.BaseResp base,
+    2: optional model.VersionChangelog data,
+}
+
+struct GetLatestVersionRequest{
+    1: required string platform,    // android / ios / harmony
+    2: optional string channel,     // release / beta / nightly，默认 release
+    3: optional string device_id,   // 设备标识，优先用于灰度分桶
+    4: optional string stu_id,      // 学号，未提供设备标识时用于灰度分桶
+    5: optional string code,        // 客户端当前版本号，用于判断是否需要强制更新
+}
+
+struct GetLatestVersionResponse{
+    1: model.BaseResp base,
+    2: optional model.LatestVersion data,
+}
+
+struct DryRunCloudSettingRequest{
+    1: optional string account,
+    2: optional string version,
+    3: optional bool beta,
+    4: optional string phone,
+    5: optional bool isLogin,
+    6: optional string loginType,
+    7: optional string setting,     // 待发布的配置，为空时使用当前生效的配置
+}
+
+struct DryRunCloudSettingResponse{
+    1: model.BaseResp base,
+    2: optional model.CloudSettingDryRun data,
+}
+
+struct GetCloudSettingCoverageRequest{
+    1: required string password,
+    2: optional string setting,     // 待发布的配置，为空时使用当前生效的配置
+    3: optional i64 samples,        // 使用最近的多少条请求条件，默认且最多为 1000
+}
+
+struct GetCloudSettingCoverageResponse{
+    1: model.BaseResp base,
+    2: optional model.CloudSettingCoverage data,
+}
+
 service VersionService{
     LoginResponse Login(1:LoginRequest req)(api.post="/api/v2/url/login")
     UploadResponse UploadVersion(1:UploadRequest req)(api.post="/api/v2/url/upload")
@@ -646,6 +813,18 @@ service VersionService{
     SetCloudResponse SetCloud(1:SetCloudRequest req)(api.post="/api/v2/url/setcloud")
     GetDumpResponse GetDump(1:GetDumpRequest req)(api.get="/api/v2/url/dump")
     AndroidGetVersionResponse AndroidGetVersion(1:AndroidGetVersioneRequest req)(api.get="/api/v2/version/android"),
+    // 将最新的生效版本标记为已回滚，客户端重新获取版本信息时回到上一个版本
+    RollbackVersionResponse RollbackVersion(1:RollbackVersionRequest req)(api.post="/api/v2/url/rollback"),
+    // 版本历史，供客户端展示历次更新内容
+    ListVersionsResponse ListVersions(1:ListVersionsRequest req)(api.get="/api/v2/version/list"),
+    // 客户端当前版本到最新版本之间的更新日志，用于跳过多次更新后展示更新内容
+    GetVersionChangelogResponse GetVersionChangelog(1:GetVersionChangelogRequest req)(api.get="/api/v2/version/changelog"),
+    // 各平台通用的最新版本查询，Android 的 apk 下载与版本接口保留用于兼容旧版客户端
+    GetLatestVersionResponse GetLatestVersion(1:GetLatestVersionRequest req)(api.get="/api/v2/version/latest"),
+    // 试运行云控配置，返回每个计划对给定条件的匹配结果
+    DryRunCloudSettingResponse DryRunCloudSetting(1:DryRunCloudSettingRequest req)(api.post="/api/v2/url/dryrun"),
+    // 用最近的请求条件模拟匹配，统计各计划的命中分布、不可达的计划与没有命中任何计划的条件
+    GetCloudSettingCoverageResponse GetCloudSettingCoverage(1:GetCloudSettingCoverageRequest req)(api.post="/api/v2/url/coverage"),
 
 }
 
@@ -693,6 +872,14 @@ struct TermResponse {
 
 struct GetNoticeRequst {
     1: required i64 pageNum
+    2: optional string source
+}
+
+struct ListNoticeSourcesRequest {
+}
+
+struct ListNoticeSourcesResponse {
+    1: required list<model.NoticeSource> sources
 }
 
 struct GetNoticeResponse {
@@ -700,6 +887,73 @@ struct GetNoticeResponse {
     2: required i64 total
 }
 
+struct SearchNoticesRequest {
+    1: required string query
+    2: optional string startDate
+    3: optional string endDate
+    4: optional i64 pageNum
+}
+
+struct SearchNoticesResponse {
+    1: required list<model.NoticeSearchHit> results
+    2: required i64 total
+}
+
+struct GetNoticeDetailRequest {
+    1: required string url
+}
+
+struct GetNoticeDetailResponse {
+    1: required model.NoticeDetail detail
+}
+
+struct ListNoticeSubscriptionsRequest {
+}
+
+struct ListNoticeSubscriptionsResponse {
+    1: required list<model.NoticeSubscription> subscriptions
+}
+
+struct SubscribeNoticeRequest {
+    1: required string keyword
+}
+
+struct SubscribeNoticeResponse {
+    1: required model.NoticeSubscription subscription
+}
+
+struct UnsubscribeNoticeRequest {
+    1: required string keyword
+}
+
+struct UnsubscribeNoticeResponse {
+}
+
+struct ListNotificationsRequest {
+    1: optional i64 pageNum
+    2: optional bool unreadOnly
+}
+
+struct ListNotificationsResponse {
+    1: required list<model.Notification> notifications
+    2: required i64 total
+}
+
+struct MarkNotificationsReadRequest {
+    1: optional list<i64> ids
+}
+
+struct MarkNotificationsReadResponse {
+    1: required i64 marked
+}
+
+struct GetUnreadNotificationCountRequest {
+}
+
+struct GetUnreadNotificationCountResponse {
+    1: required i64 count
+}
+
 struct GetContributorInfoRequest {
 }
 
@@ -810,6 +1064,24 @@ service CommonService {
     TermResponse GetTerm(1: TermRequest req) (api.get="/api/v1/terms/info")
     // 获取教务处通知
     GetNoticeResponse GetNotice(1: GetNoticeRequst req) (api.get="/api/v1/common/notice")
+    // 列出已启用的通知来源
+    ListNoticeSourcesResponse ListNoticeSources(1: ListNoticeSourcesRequest req) (api.get="/api/v1/common/notice/sources")
+    // 教务处通知全文检索
+    SearchNoticesResponse SearchNotices(1: SearchNoticesRequest req) (api.get="/api/v1/common/notice/search")
+    // 获取教务处通知详情（正文与附件）
+    GetNoticeDetailResponse GetNoticeDetail(1: GetNoticeDetailRequest req) (api.get="/api/v1/common/notice/detail")
+    // 通知订阅：列出当前用户的订阅关键词
+    ListNoticeSubscriptionsResponse ListNoticeSubscriptions(1: ListNoticeSubscriptionsRequest req) (api.get="/api/v1/jwch/notice/subscriptions")
+    // 通知订阅：订阅关键词
+    SubscribeNoticeResponse SubscribeNotice(1: SubscribeNoticeRequest req) (api.post="/api/v1/jwch/notice/subscriptions")
+    // 通知订阅：取消订阅关键词
+    UnsubscribeNoticeResponse UnsubscribeNotice(1: UnsubscribeNoticeRequest req) (api.delete="/api/v1/jwch/notice/subscriptions")
+    // 通知中心：分页列出当前用户的通知
+    ListNotificationsResponse ListNotifications(1: ListNotificationsRequest req) (api.get="/api/v1/jwch/notifications")
+    // 通知中心：标记通知为已读
+    MarkNotificationsReadResponse MarkNotificationsRead(1: MarkNotificationsReadRequest req) (api.post="/api/v1/jwch/notifications/read")
+    // 通知中心：获取未读通知数量
+    GetUnreadNotificationCountResponse GetUnreadNotificationCount(1: GetUnreadNotificationCountRequest req) (api.get="/api/v1/jwch/notifications/unread-count")
     // 获取贡献者列表
     GetContributorInfoResponse GetContributorInfo(1: GetContributorInfoRequest req)(api.get="/api/v1/common/contributor")
      // 获取工具箱配置
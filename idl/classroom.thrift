@@ -6,11 +6,26 @@ struct EmptyRoomRequest{
     2: required string campus
     3: required string startTime;
     4: required string endTime;
+    5: optional string build            // 按楼过滤，例 西3
+    6: optional i64 minCapacity         // 最少可容纳人数
+    7: optional string sortBy           // 排序方式，free_longest 表示按请求节次之后的连续空闲时长倒序
 }
 
 struct EmptyRoomResponse{
     1: required model.BaseResp base,
     2: optional list<model.Classroom> rooms,
+    3: optional model.EmptyRoomSyncStatus syncStatus,
+}
+
+struct RoomScheduleRequest {
+    1: required string room             // 教室，例 旗山西3-206，旗山校区可省略校区前缀
+    2: required string date
+}
+
+struct RoomScheduleResponse {
+    1: required model.BaseResp base,
+    2: optional model.RoomSchedule schedule,
+    3: optional model.EmptyRoomSyncStatus syncStatus,
 }
 
 struct ExamRoomInfoRequest {
@@ -20,9 +35,22 @@ struct ExamRoomInfoRequest {
 struct ExamRoomInfoResponse {
     1: required model.BaseResp base,
     2: optional list<model.ExamRoomInfo> rooms,
+    3: optional bool stale              // 教务处不可用时返回的数据库快照
+    4: optional i64 snapshot_time       // 快照时间，毫秒时间戳
+}
+
+struct ExamRoomChangesRequest {
+    1: required string term
+}
+
+struct ExamRoomChangesResponse {
+    1: required model.BaseResp base,
+    2: optional list<model.ExamRoomChange> changes,
 }
 
 service ClassroomService {
     EmptyRoomResponse GetEmptyRoom(1:EmptyRoomRequest req),
     ExamRoomInfoResponse GetExamRoomInfo(1:ExamRoomInfoRequest req),
+    RoomScheduleResponse GetRoomSchedule(1:RoomScheduleRequest req),
+    ExamRoomChangesResponse GetExamRoomChanges(1:ExamRoomChangesRequest req),
 }
@@ -46,6 +46,7 @@ struct TermListResponse {
 // 教务处教学通知
 struct NoticeRequest {
     1: required i64 pageNum
+    2: optional string source           // 通知来源，为空时为教务处
 }
 
 struct NoticeResponse {
@@ -54,6 +55,94 @@ struct NoticeResponse {
     3: required i64 total
 }
 
+// 教务处通知全文检索
+struct SearchNoticesRequest {
+    1: required string query
+    2: optional string startDate        // 发布日期下限（含），例 2024-09-01
+    3: optional string endDate          // 发布日期上限（含）
+    4: optional i64 pageNum             // 页码，默认为 1
+}
+
+struct SearchNoticesResponse {
+    1: required model.BaseResp base
+    2: optional list<model.NoticeSearchHit> results
+    3: required i64 total
+}
+
+// 列出已启用的通知来源
+struct ListNoticeSourcesRequest {
+}
+
+struct ListNoticeSourcesResponse {
+    1: required model.BaseResp base
+    2: optional list<model.NoticeSource> sources
+}
+
+// 获取通知详情
+struct GetNoticeDetailRequest {
+    1: required string url              // 通知列表中返回的 url
+}
+
+struct GetNoticeDetailResponse {
+    1: required model.BaseResp base
+    2: optional model.NoticeDetail detail
+}
+
+// 通知订阅，学号从登录信息中获取
+struct ListNoticeSubscriptionsRequest {
+}
+
+struct ListNoticeSubscriptionsResponse {
+    1: required model.BaseResp base
+    2: optional list<model.NoticeSubscription> subscriptions
+}
+
+struct SubscribeNoticeRequest {
+    1: required string keyword          // 关键词，如 考试、转专业、奖学金 或学院名称
+}
+
+struct SubscribeNoticeResponse {
+    1: required model.BaseResp base
+    2: optional model.NoticeSubscription subscription
+}
+
+struct UnsubscribeNoticeRequest {
+    1: required string keyword
+}
+
+struct UnsubscribeNoticeResponse {
+    1: required model.BaseResp base
+}
+
+// 通知中心，学号从登录信息中获取
+struct ListNotificationsRequest {
+    1: optional i64 pageNum             // 页码，默认为 1
+    2: optional bool unreadOnly         // 是否只返回未读通知
+}
+
+struct ListNotificationsResponse {
+    1: required model.BaseResp base
+    2: optional list<model.Notification> notifications
+    3: required i64 total
+}
+
+struct MarkNotificationsReadRequest {
+    1: optional list<i64> ids           // 为空时将全部通知标记为已读
+}
+
+struct MarkNotificationsReadResponse {
+    1: required model.BaseResp base
+    2: required i64 marked              // 本次新标记为已读的通知数量
+}
+
+struct GetUnreadNotificationCountRequest {
+}
+
+struct GetUnreadNotificationCountResponse {
+    1: required model.BaseResp base
+    2: required i64 count
+}
+
 // 获取贡献者列表
 struct GetContributorInfoRequest {
 }
@@ -180,6 +269,24 @@ service CommonService {
     TermResponse GetTerm(1: TermRequest req)
     // 教务处教学通知
     NoticeResponse GetNotices(1: NoticeRequest req)
+    // 列出已启用的通知来源
+    ListNoticeSourcesResponse ListNoticeSources(1: ListNoticeSourcesRequest req)
+    // 教务处通知全文检索
+    SearchNoticesResponse SearchNotices(1: SearchNoticesRequest req)
+    // 获取教务处通知详情（正文与附件）
+    GetNoticeDetailResponse GetNoticeDetail(1: GetNoticeDetailRequest req)
+    // 通知订阅：列出当前用户的订阅关键词
+    ListNoticeSubscriptionsResponse ListNoticeSubscriptions(1: ListNoticeSubscriptionsRequest req)
+    // 通知订阅：订阅关键词
+    SubscribeNoticeResponse SubscribeNotice(1: SubscribeNoticeRequest req)
+    // 通知订阅：取消订阅关键词
+    UnsubscribeNoticeResponse UnsubscribeNotice(1: UnsubscribeNoticeRequest req)
+    // 通知中心：分页列出当前用户的通知
+    ListNotificationsResponse ListNotifications(1: ListNotificationsRequest req)
+    // 通知中心：标记通知为已读
+    MarkNotificationsReadResponse MarkNotificationsRead(1: MarkNotificationsReadRequest req)
+    // 通知中心：获取未读通知数量
+    GetUnreadNotificationCountResponse GetUnreadNotificationCount(1: GetUnreadNotificationCountRequest req)
     // 获取贡献者列表
     GetContributorInfoResponse GetContributorInfo(1: GetContributorInfoRequest req)
     // 获取工具箱配置
@@ -6,6 +6,8 @@ struct TermListRequest {}
 struct TermListResponse {
     1: required model.BaseResp base
     2: required list<string> data
+    3: optional bool stale              // 教务处不可用时返回的数据库快照
+    4: optional i64 snapshot_time       // 快照时间，毫秒时间戳
 }
 
 struct CourseListRequest {
@@ -16,6 +18,8 @@ struct CourseListRequest {
 struct CourseListResponse {
     1: required model.BaseResp base
     2: required list<model.Course> data
+    3: optional bool stale              // 教务处不可用时返回的数据库快照
+    4: optional i64 snapshot_time       // 快照时间，毫秒时间戳
 }
 
 struct GetCalendarRequest {
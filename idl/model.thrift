@@ -35,12 +35,43 @@ struct FriendMaxNumInfo {
     1: required i64 max_num
 }
 
+// 通知偏好，仅对按学号定向的推送（成绩、考试）生效，收件箱始终保留全部通知
+// 教务处通知与通知订阅按 tag 广播，不受偏好影响，只在全局免打扰时段外推送
+struct NotificationPreference {
+    1: required list<string> disabled_types,   // 关闭推送的通知类型，score / exam
+    2: required string channel,                // 推送渠道，all 为收件箱与设备推送，inbox 为只写收件箱
+    3: optional string quiet_start,            // 免打扰开始时间，HH:MM，与 quiet_end 同时为空时不开启免打扰
+    4: optional string quiet_end,              // 免打扰结束时间，HH:MM，早于开始时间时表示跨零点
+}
+
 // 空教室
 struct Classroom {
     1: required string build            // 空教室所在楼，例 西三
     2: required string location         // 空教室，例 旗山西3-104
     3: required string capacity         // 可容纳人数，例 153人
     4: required string type             // 教师类型，例 智慧教室普通型
+    5: optional i64 floor               // 楼层，例 3
+    6: optional double latitude         // 纬度，来自教室元数据
+    7: optional double longitude        // 经度，来自教室元数据
+    8: optional i64 freeUntil           // 连续空闲到第几节，例 请求 3-4 节时为 6 表示空闲到第 6 节
+}
+
+// 空教室数据的同步状态，用于告知客户端数据的新鲜度
+struct EmptyRoomSyncStatus {
+    1: required string date             // 返回数据对应的日期，回退时为前一天
+    2: required i64 lastSuccess         // 最近一次同步成功的时间（毫秒时间戳），0 表示未知
+    3: required i64 lastAttempt         // 最近一次尝试同步的时间（毫秒时间戳），0 表示未知
+    4: optional string error            // 最近一次同步的错误信息，成功时为空
+    5: required list<i64> failedPeriods // 最近一次同步中重试后仍失败的节次，这些节次的教室按占用处理
+    6: required bool fallback           // 当天数据缺失时是否回退使用了前一天的数据
+}
+
+// 单个教室一天内的占用情况
+struct RoomSchedule {
+    1: required Classroom room          // 教室信息
+    2: required string date             // 日期，例 2024-10-01
+    3: required list<i64> freePeriods   // 空闲的节次
+    4: required list<i64> occupiedPeriods // 被占用的节次
 }
 
 // 考场信息
@@ -53,6 +84,21 @@ struct ExamRoomInfo {
     6: required string date            // 日期
 }
 
+// 考场变化记录
+struct ExamRoomChange {
+    1: required string name            // 课程名
+    2: required string credit          // 学分
+    3: required string teacher         // 任课教师
+    4: required string changeType      // 变化类型，added 新增、changed 变更、removed 移除
+    5: optional string prevLocation    // 变化前考场
+    6: optional string prevDate        // 变化前日期
+    7: optional string prevTime        // 变化前时间
+    8: optional string location        // 变化后考场，考场被移除时为空
+    9: optional string date            // 变化后日期
+    10: optional string time           // 变化后时间
+    11: required i64 detectedAt        // 发现变化的时间（毫秒时间戳）
+}
+
 // 课程安排
 struct CourseScheduleRule {
     1: required string location         // 定制
@@ -241,6 +287,52 @@ struct NoticeInfo {
     1: optional string title
     2: optional string url
     3: optional string date
+    4: optional string source           // 通知来源，例 jwch（教务处）、library
+}
+
+// 通知来源
+struct NoticeSource {
+    1: required string name             // 来源标识，用于按来源查询通知
+    2: required string displayName      // 展示名称，例 教务处
+}
+
+// 教务处通知的检索结果
+struct NoticeSearchHit {
+    1: required string title
+    2: required string url
+    3: required string date
+    4: optional string highlightTitle   // 标题命中时带 <em> 标记的标题
+    5: required list<string> highlights // 正文中命中的片段，关键词使用 <em> 标记
+}
+
+struct NoticeAttachment {
+    1: required string name
+    2: required string url              // 下载地址，已转存时为 OSS 地址，否则为教务处原始地址
+    3: required bool mirrored           // 是否已转存到 OSS
+}
+
+struct NoticeDetail {
+    1: required string title
+    2: required string url
+    3: required string date
+    4: required string content          // 清洗后的正文 HTML，附件链接已替换为下载地址
+    5: required list<NoticeAttachment> attachments
+}
+
+struct NoticeSubscription {
+    1: required string keyword
+    2: required string tag              // 客户端需向友盟注册的设备 tag，命中关键词的通知会推送到该 tag
+}
+
+// 通知中心收件箱中的一条通知
+struct Notification {
+    1: required i64 id
+    2: required string type             // 通知类型，例 score（成绩）、exam（考试）、teaching（教务处通知）
+    3: required string title
+    4: required string content
+    5: optional string deeplink         // 客户端跳转地址，与推送中的 deeplink 一致
+    6: required bool isRead
+    7: required i64 createdAt           // 通知时间，毫秒时间戳
 }
 
 struct Contributor {
@@ -289,6 +381,62 @@ struct Version{
     3: optional bool force
     4: optional string changelog
     5: optional string url
+    6: optional i64 released_at     // 发布时间，毫秒时间戳；仅版本历史返回
+    7: optional string status       // active / rolled_back；仅版本历史返回
+}
+
+struct VersionChangelog{
+    1: optional Version latest      // 请求者能更新到的最新版本，已是最新时为空
+    2: required string changelog    // 各版本更新日志按版本号从新到旧拼接
+    3: required list<Version> versions
+}
+
+struct LatestVersion{
+    1: optional Version version         // 请求者能更新到的最新版本，没有可用版本时为空
+    2: optional string store_url        // 正式版的应用商店链接：iOS 为 App Store，HarmonyOS 为 AppGallery
+    3: required bool force              // 当前客户端是否必须更新
+    4: required i64 min_supported_code  // 最低支持的版本号，低于该版本的客户端必须更新，0 表示不限制
+}
+
+struct CloudSettingPlanMatch{
+    1: required i64 index                       // 计划在配置中的位置，从 0 开始
+    2: optional string name                     // 计划名称
+    3: required bool matched                    // 条件是否满足该计划的所有规则
+    4: required list<string> mismatched_fields  // 不满足的字段
+}
+
+struct CloudSettingDryRun{
+    1: optional i64 matched_index               // 实际下发的计划，即第一个满足条件的计划，没有命中时为空
+    2: optional string plan                     // 命中计划下发给客户端的配置
+    3: required list<CloudSettingPlanMatch> plans
+}
+
+struct CloudSettingCriteria{
+    1: optional string account
+    2: optional string version
+    3: optional bool beta
+    4: optional string phone
+    5: optional bool isLogin
+    6: optional string loginType
+}
+
+struct CloudSettingPlanCoverage{
+    1: required i64 index                       // 计划在配置中的位置，从 0 开始
+    2: optional string name                     // 计划名称
+    3: required i64 hits                        // 实际下发该计划的样本数
+    4: required i64 eligible                    // 满足该计划所有规则的样本数，超出 hits 的部分被前面的计划截走
+    5: optional i64 shadowed_by                 // 使该计划永远不会下发的前序计划，为空时表示可达
+}
+
+struct CloudSettingUnmatched{
+    1: required CloudSettingCriteria criteria   // 没有命中任何计划的条件
+    2: required i64 count                       // 在样本中出现的次数
+}
+
+struct CloudSettingCoverage{
+    1: required i64 samples                     // 参与统计的样本数
+    2: required list<CloudSettingPlanCoverage> plans
+    3: required list<CloudSettingUnmatched> unmatched  // 按出现次数从多到少排列
 }
 
 // ====== OA ======
@@ -100,6 +100,43 @@ struct ReorderFriendListResponse {
     1: required model.BaseResp base,
 }
 
+struct RegisterDeviceRequest {
+    1: required string device_token,    // 友盟 device token
+    2: required string platform,        // android / ios / harmony
+    3: optional string app_version,
+}
+
+struct RegisterDeviceResponse {
+    1: required model.BaseResp base,
+}
+
+struct UnregisterDeviceRequest {
+    1: required string device_token,
+}
+
+struct UnregisterDeviceResponse {
+    1: required model.BaseResp base,
+}
+
+struct GetNotificationPreferenceRequest {
+}
+
+struct GetNotificationPreferenceResponse {
+    1: required model.BaseResp base,
+    2: required model.NotificationPreference data,
+}
+
+struct UpdateNotificationPreferenceRequest {
+    1: required list<string> disabled_types,
+    2: required string channel,
+    3: optional string quiet_start,
+    4: optional string quiet_end,
+}
+
+struct UpdateNotificationPreferenceResponse {
+    1: required model.BaseResp base,
+}
+
 service UserService {
     GetLoginDataResponse GetLoginData(1: GetLoginDataRequest req),
     GetUserInfoResponse GetUserInfo(1: GetUserInfoRequest request),
@@ -111,5 +148,9 @@ service UserService {
     VerifyFriendResponse VerifyFriend(1: VerifyFriendRequest request),
     CancelInviteResponse CancelInvite(1: CancelInviteRequest request),
     GetFriendMaxNumResponse GetFriendMaxNum(1: GetFriendMaxNumRequest request),
-    ReorderFriendListResponse ReorderFriendList(1: ReorderFriendListRequest request)
+    ReorderFriendListResponse ReorderFriendList(1: ReorderFriendListRequest request),
+    RegisterDeviceResponse RegisterDevice(1: RegisterDeviceRequest request),
+    UnregisterDeviceResponse UnregisterDevice(1: UnregisterDeviceRequest request),
+    GetNotificationPreferenceResponse GetNotificationPreference(1: GetNotificationPreferenceRequest request),
+    UpdateNotificationPreferenceResponse UpdateNotificationPreference(1: UpdateNotificationPreferenceRequest request)
 }
@@ -17,6 +17,9 @@ struct UploadRequest{
     5: required string type,
     6: required string password,
     7: required bool force,
+    8: optional string platform,            // android / ios / harmony，默认 android
+    9: optional i64 rollout_percent,        // 灰度比例 0-100，默认 100；以相同 code 和相同内容重新上传可调整比例
+    10: optional i64 min_supported_code,    // 最低支持的版本号，低于该版本的客户端强制更新
 
 }
 
@@ -52,6 +55,9 @@ struct DownloadBetaApkResponse{
 }
 
 struct GetReleaseVersionRequest{
+    1: optional string device_id,   // 设备标识，优先用于灰度分桶
+    2: optional string stu_id,      // 学号，未提供设备标识时用于灰度分桶
+    3: optional string code,        // 客户端当前版本号，低于最低支持版本时强制更新
 }
 
 struct GetReleaseVersionResponse{
@@ -64,6 +70,9 @@ struct GetReleaseVersionResponse{
 }
 
 struct GetBetaVersionRequest{
+    1: optional string device_id,   // 设备标识，优先用于灰度分桶
+    2: optional string stu_id,      // 学号，未提供设备标识时用于灰度分桶
+    3: optional string code,        // 客户端当前版本号，低于最低支持版本时强制更新
 }
 
 struct GetBetaVersionResponse{
@@ -130,6 +139,9 @@ struct GetDumpResponse{
 }
 
 struct AndroidGetVersioneRequest{
+    1: optional string device_id,   // 设备标识，优先用于灰度分桶
+    2: optional string stu_id,      // 学号，未提供设备标识时用于灰度分桶
+    3: optional string code,        // 客户端当前版本号，低于最低支持版本时强制更新
 }
 
 struct AndroidGetVersionResponse{
@@ -138,6 +150,82 @@ struct AndroidGetVersionResponse{
     3: optional model.Version beta,
 }
 
+struct RollbackVersionRequest{
+    1: required string password,
+    2: required string type,        // release / beta / nightly
+    3: optional string platform,    // 默认 android
+}
+
+struct RollbackVersionResponse{
+    1: model.BaseResp base,
+    2: optional model.Version data, // 回滚后生效的版本
+}
+
+struct ListVersionsRequest{
+    1: required string channel,     // release / beta / nightly
+    2: optional string platform,    // 默认 android
+    3: optional i64 page_num,       // 页码，默认为 1
+    4: optional i64 page_size,      // 每页条数，默认为 20，最大为 100
+}
+
+struct ListVersionsResponse{
+    1: model.BaseResp base,
+    2: list<model.Version> data,    // 按版本号从新到旧排列，包括已回滚的版本
+    3: i64 total,
+}
+
+struct GetVersionChangelogRequest{
+    1: required string channel,     // release / beta / nightly
+    2: required string code,        // 客户端当前版本号
+    3: optional string platform,    // 默认 android
+    4: optional string device_id,   // 设备标识，用于确定请求者在灰度下能更新到的版本
+    5: optional string stu_id,      // 学号，未提供设备标识时使用
+}
+
+struct GetVersionChangelogResponse{
+    1: model.BaseResp base,
+    2: optional model.VersionChangelog data,
+}
+
+struct GetLatestVersionRequest{
+    1: required string platform,    // android / ios / harmony
+    2: optional string channel,     // release / beta / nightly，默认 release
+    3: optional string device_id,   // 设备标识，优先用于灰度分桶
+    4: optional string stu_id,      // 学号，未提供设备标识时用于灰度分桶
+    5: optional string code,        // 客户端当前版本号，用于判断是否需要强制更新
+}
+
+struct GetLatestVersionResponse{
+    1: model.BaseResp base,
+    2: optional model.LatestVersion data,
+}
+
+struct DryRunCloudSettingRequest{
+    1: optional string account,
+    2: optional string version,
+    3: optional bool beta,
+    4: optional string phone,
+    5: optional bool isLogin,
+    6: optional string loginType,
+    7: optional string setting,     // 待发布的配置，为空时使用当前生效的配置
+}
+
+struct DryRunCloudSettingResponse{
+    1: model.BaseResp base,
+    2: optional model.CloudSettingDryRun data,
+}
+
+struct GetCloudSettingCoverageRequest{
+    1: required string password,
+    2: optional string setting,     // 待发布的配置，为空时使用当前生效的配置
+    3: optional i64 samples,        // 使用最近的多少条请求条件，默认且最多为 1000
+}
+
+struct GetCloudSettingCoverageResponse{
+    1: model.BaseResp base,
+    2: optional model.CloudSettingCoverage data,
+}
+
 service VersionService{
     LoginResponse Login(1:LoginRequest req)(api.post="/api/v1/url/login"),
     UploadResponse UploadVersion(1:UploadRequest req)(api.post="/api/v1/url/api/upload"),
@@ -152,6 +240,12 @@ service VersionService{
     SetCloudResponse SetCloud(1:SetCloudRequest req)(api.post="/api/v1/url/setcloud"),
     GetDumpResponse GetDump(1:GetDumpRequest req)(api.get="/api/v1/url/dump"),
     AndroidGetVersionResponse AndroidGetVersion(1:AndroidGetVersioneRequest req),
+    RollbackVersionResponse RollbackVersion(1:RollbackVersionRequest req),
+    ListVersionsResponse ListVersions(1:ListVersionsRequest req),
+    GetVersionChangelogResponse GetVersionChangelog(1:GetVersionChangelogRequest req),
+    GetLatestVersionResponse GetLatestVersion(1:GetLatestVersionRequest req),
+    DryRunCloudSettingResponse DryRunCloudSetting(1:DryRunCloudSettingRequest req),
+    GetCloudSettingCoverageResponse GetCloudSettingCoverage(1:GetCloudSettingCoverageRequest req),
 
 }
 
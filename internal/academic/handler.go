@@ -26,6 +26,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	metainfoContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
 	"github.com/west2-online/fzuhelper-server/pkg/singleflight"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -72,6 +73,19 @@ func (s *AcademicServiceImpl) GetScores(ctx context.Context, _ *academic.GetScor
 		scores, err := singleflight.Do(key, func() ([]*jwch.Mark, error) {
 			return service.NewAcademicService(ctx, s.ClientSet, s.taskQueue).GetScores(loginData)
 		})
+		if base.ShouldServeStale(err) {
+			// 教务处不可用时降级返回数据库中的成绩快照
+			snapshot, snapshotAt, snapshotErr := service.NewAcademicService(ctx, s.ClientSet, s.taskQueue).GetScoresSnapshot(loginData)
+			if snapshotErr != nil {
+				logger.Errorf("Academic.GetScores: get scores snapshot failed: %v", snapshotErr)
+			} else if snapshot != nil {
+				stale, snapshotTime := true, snapshotAt.UnixMilli()
+				resp.Base = base.BuildSuccessResp()
+				resp.Scores = pack.BuildScores(snapshot)
+				resp.Stale, resp.SnapshotTime = &stale, &snapshotTime
+				return resp, nil
+			}
+		}
 		if err != nil {
 			resp.Base = base.BuildBaseResp(err)
 			return resp, nil
@@ -18,10 +18,13 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/west2-online/fzuhelper-server/internal/academic/pack"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
 )
@@ -32,9 +35,14 @@ func (s *AcademicService) GetCreditV2() (*pack.CreditResponse, error) {
 	if err != nil {
 		return nil, fmt.Errorf("service.GetCreditV2: Get login data fail %w", err)
 	}
+	if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		return nil, fmt.Errorf("service.GetCreditV2: %w", err)
+	}
 	stu := jwch.NewStudent().WithLoginData(loginData.Id, utils.ParseCookies(loginData.Cookies))
 
+	start := time.Now()
 	majorCredits, minorCredits, err := stu.GetCreditV2()
+	metrics.ObserveUpstream(governor.HostJwch, "GetCreditV2", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return nil, fmt.Errorf("service.GetCreditV2: Get credit fail %w", err)
 	}
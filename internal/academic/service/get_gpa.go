@@ -18,9 +18,12 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
 )
@@ -30,8 +33,13 @@ func (s *AcademicService) GetGPA() (*jwch.GPABean, error) {
 	if err != nil {
 		return nil, fmt.Errorf("service.GetGPA: Get login data fail %w", err)
 	}
+	if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		return nil, fmt.Errorf("service.GetGPA: %w", err)
+	}
 	stu := jwch.NewStudent().WithLoginData(loginData.Id, utils.ParseCookies(loginData.Cookies))
+	start := time.Now()
 	gpa, err := stu.GetGPA()
+	metrics.ObserveUpstream(governor.HostJwch, "GetGPA", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return nil, fmt.Errorf("service.GetGPA: Get gpa info fail %w", err)
 	}
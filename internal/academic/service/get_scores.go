@@ -18,7 +18,9 @@ package service
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/bytedance/sonic"
 
@@ -29,9 +31,11 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/db/model"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
-	"github.com/west2-online/fzuhelper-server/pkg/umeng"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
 	"github.com/west2-online/yjsy"
@@ -52,8 +56,13 @@ func (s *AcademicService) GetScores(loginData *loginmodel.LoginData) ([]*jwch.Ma
 		}
 		return scores, nil
 	} else {
+		if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+			return nil, fmt.Errorf("service.GetScores: %w", err)
+		}
 		stu := jwch.NewStudent().WithLoginData(loginData.Id, utils.ParseCookies(loginData.Cookies))
+		start := time.Now()
 		scores, err := stu.GetMarks()
+		metrics.ObserveUpstream(governor.HostJwch, "GetMarks", start)
 		if err = base.HandleJwchError(err); err != nil {
 			return nil, fmt.Errorf("service.GetScores: Get scores info fail %w", err)
 		}
@@ -76,8 +85,13 @@ func (s *AcademicService) GetScoresYjsy(loginData *loginmodel.LoginData) ([]*yjs
 		}
 		return scores, nil
 	} else {
+		if err := governor.Acquire(s.ctx, governor.HostYjsy); err != nil {
+			return nil, fmt.Errorf("service.GetScoresYjsy: %w", err)
+		}
 		stu := yjsy.NewStudent().WithLoginData(utils.ParseCookies(loginData.Cookies))
+		start := time.Now()
 		scores, err := stu.GetMarks()
+		metrics.ObserveUpstream(governor.HostYjsy, "GetMarks", start)
 		if err = base.HandleYjsyError(err); err != nil {
 			return nil, fmt.Errorf("service.GetScoresYjsy: Get scores info fail %w", err)
 		}
@@ -113,25 +127,24 @@ func (s *AcademicService) checkScoreChange(stuId string, scores []*jwch.Mark) er
 		if err != nil {
 			return err
 		}
-		return nil
-	} else if oldSha256 != newSha256 {
-		// 处理推送逻辑
-		err = s.handleScoreChange(stuId, scores)
-		if err != nil {
-			return err
-		}
-		// 更新成绩信息
-		err = s.db.Academic.UpdateUserScores(s.ctx, &model.Score{
-			StuID:            stuId,
-			ScoresInfo:       json,
-			ScoresInfoSHA256: newSha256,
-		})
-		if err != nil {
-			return err
-		}
+		return s.syncScoreSubscribers(stuId, scores)
+	}
+	if oldSha256 == newSha256 {
+		// 成绩没有变化时仍记录课程，保证上线前已有成绩记录的学生也能收到之后的定向推送
+		return s.syncScoreSubscribers(stuId, scores)
 	}
 
-	return nil
+	// 处理推送逻辑
+	err = s.handleScoreChange(stuId, scores)
+	if err != nil {
+		return err
+	}
+	// 更新成绩信息
+	return s.db.Academic.UpdateUserScores(s.ctx, &model.Score{
+		StuID:            stuId,
+		ScoresInfo:       json,
+		ScoresInfoSHA256: newSha256,
+	})
 }
 
 func (s *AcademicService) handleScoreChange(stuID string, scores []*jwch.Mark) (err error) {
@@ -146,6 +159,14 @@ func (s *AcademicService) handleScoreChange(stuID string, scores []*jwch.Mark) (
 	if err != nil {
 		return err
 	}
+	// 先取出本次刷新前已记录的课程，再记录本次成绩单中的课程，前者用于判断该学生是否已经收到过首次通知
+	subscribed, err := s.db.Academic.ListScoreSubscribedTags(s.ctx, stuID)
+	if err != nil {
+		return err
+	}
+	if err = s.syncScoreSubscribers(stuID, scores); err != nil {
+		return err
+	}
 	// 反转 oldScores 和 t.scores，方便判断是新课程还是成绩更新
 	reverseScores := func(scores []*jwch.Mark) {
 		for i := 0; i < len(scores)/2; i++ {
@@ -170,19 +191,28 @@ func (s *AcademicService) handleScoreChange(stuID string, scores []*jwch.Mark) (
 			if err != nil {
 				return err
 			}
+			tag := scoreTag(scores[i])
+			msg := scoreNotification(scores[i].Name, tag)
+			msg.Unicast = true
+			switch {
+			case existingCourse == nil:
+				// 课程首次出现成绩变化，通知成绩单中有这门课的所有学生，推送按各自的通知偏好过滤；
+				// 同时按课程 tag 推送，送达还没有登记设备的客户端
+				if msg.StuIDs, err = s.db.Academic.ListScoreSubscriberIDs(s.ctx, tag); err != nil {
+					return err
+				}
+				msg.Tags = []string{tag}
+			case !slices.Contains(subscribed, tag):
+				// 首次通知发出时还没有记录该学生的这门课，单独补发
+				msg.StuIDs = []string{stuID}
+			}
+			if len(msg.StuIDs) != 0 || len(msg.Tags) != 0 {
+				if err = s.notifier.Notify(s.ctx, msg); err != nil {
+					logger.WithCtx(s.ctx).Errorf("notify score change failed, tag:%v, err:%v", tag, err)
+				}
+			}
 			// 课程信息不存在，说明还未发过通知
 			if existingCourse == nil {
-				// md5 作为tag
-				tag := utils.MD5(strings.Join([]string{
-					scores[i].Name, scores[i].Semester, scores[i].Teacher,
-					scores[i].ElectiveType, scores[i].Classroom,
-				}, "|"))
-				if ok := umeng.EnqueueAsync(func() error {
-					s.sendNotifications(scores[i].Name, tag)
-					return nil
-				}); !ok {
-					logger.WithCtx(s.ctx).Errorf("umeng async queue full, drop score notification, tag:%v", tag)
-				}
 				// 写入课程信息，代表发送过通知
 				_, err = s.db.Academic.CreateCourseOffering(s.ctx, &model.CourseOffering{
 					Name:         scores[i].Name,
@@ -201,12 +231,38 @@ func (s *AcademicService) handleScoreChange(stuID string, scores []*jwch.Mark) (
 	return nil
 }
 
-func (s *AcademicService) sendNotifications(courseName, tag string) {
-	// 这个函数由于放在 task queue 上跑，所以 logger 没有加 crx，防止把 trace 语义弄脏
-	title := "成绩更新啦"
-	text := courseName + "成绩已更新"
-	description := fmt.Sprintf("成绩更新%v", tag[:12])
-	umeng.PushByType(constants.UmengPushTypeScore, title, text, []string{courseName}, "", tag, description, constants.UmengGradeDeeplink)
+// syncScoreSubscribers 记录学生成绩单中的全部课程，作为之后成绩更新通知的接收者
+func (s *AcademicService) syncScoreSubscribers(stuID string, scores []*jwch.Mark) error {
+	subscribers := make([]*model.ScoreSubscriber, 0, len(scores))
+	for _, score := range scores {
+		id, err := s.sf.NextVal()
+		if err != nil {
+			return err
+		}
+		subscribers = append(subscribers, &model.ScoreSubscriber{
+			Id:    id,
+			Tag:   scoreTag(score),
+			StuId: stuID,
+		})
+	}
+	return s.db.Academic.CreateScoreSubscribers(s.ctx, subscribers)
+}
+
+// scoreTag 课程的唯一标识，取课程信息的 md5
+func scoreTag(score *jwch.Mark) string {
+	return utils.MD5(strings.Join([]string{
+		score.Name, score.Semester, score.Teacher,
+		score.ElectiveType, score.Classroom,
+	}, "|"))
+}
 
-	logger.Infof("task queue: send notice to app, tag:%v", tag)
+func scoreNotification(courseName, tag string) *notification.Message {
+	return &notification.Message{
+		Type:        constants.UmengPushTypeScore,
+		Title:       "成绩更新啦",
+		Text:        courseName + "成绩已更新",
+		Keywords:    []string{courseName},
+		Description: fmt.Sprintf("成绩更新%v", tag[:12]),
+		Deeplink:    constants.UmengGradeDeeplink,
+	}
 }
@@ -30,9 +30,12 @@ import (
 	baseContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
 	academicCache "github.com/west2-online/fzuhelper-server/pkg/cache/academic"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/db"
 	academicDB "github.com/west2-online/fzuhelper-server/pkg/db/academic"
 	dbModel "github.com/west2-online/fzuhelper-server/pkg/db/model"
+	notificationDB "github.com/west2-online/fzuhelper-server/pkg/db/notification"
+	userDB "github.com/west2-online/fzuhelper-server/pkg/db/user"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/umeng"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -270,9 +273,14 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			}, nil).Build()
 			defer createScorePatch.UnPatch()
 
+			// Mock 记录成绩单中的课程
+			createSubscribersPatch := mockey.Mock((*academicDB.DBAcademic).CreateScoreSubscribers).Return(nil).Build()
+			defer createSubscribersPatch.UnPatch()
+
 			ctx := context.Background()
 			mockClientSet := &base.ClientSet{
 				DBClient: &db.Database{},
+				SFClient: new(utils.Snowflake),
 			}
 			service := NewAcademicService(ctx, mockClientSet, &taskqueue.BaseTaskQueue{})
 
@@ -333,6 +341,10 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			getCourseByHashPatch := mockey.Mock((*academicDB.DBAcademic).GetCourseByHash).Return(nil, nil).Build()
 			defer getCourseByHashPatch.UnPatch()
 
+			// Mock 写入收件箱
+			createNotificationsPatch := mockey.Mock((*notificationDB.DBNotification).CreateNotifications).Return(nil).Build()
+			defer createNotificationsPatch.UnPatch()
+
 			// Mock 创建课程记录
 			createCoursePatch := mockey.Mock((*academicDB.DBAcademic).CreateCourseOffering).Return(&dbModel.CourseOffering{}, nil).Build()
 			defer createCoursePatch.UnPatch()
@@ -341,17 +353,21 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			updateScorePatch := mockey.Mock((*academicDB.DBAcademic).UpdateUserScores).Return(nil).Build()
 			defer updateScorePatch.UnPatch()
 
-			// Mock umeng 推送
-			umengAndroidPatch := mockey.Mock(umeng.SendAndroidGroupcastWithGoApp).Return(nil).Build()
-			defer umengAndroidPatch.UnPatch()
-
-			umengIOSPatch := mockey.Mock(umeng.SendIOSGroupcast).Return(nil).Build()
-			defer umengIOSPatch.UnPatch()
-			umengHarmonyPatch := mockey.Mock(umeng.SendHarmonyGroupcast).Return(nil).Build()
-			defer umengHarmonyPatch.UnPatch()
+			// Mock 课程的学生与设备推送
+			subscribedTagsPatch := mockey.Mock((*academicDB.DBAcademic).ListScoreSubscribedTags).Return([]string{}, nil).Build()
+			defer subscribedTagsPatch.UnPatch()
+			createSubscribersPatch := mockey.Mock((*academicDB.DBAcademic).CreateScoreSubscribers).Return(nil).Build()
+			defer createSubscribersPatch.UnPatch()
+			subscriberIDsPatch := mockey.Mock((*academicDB.DBAcademic).ListScoreSubscriberIDs).Return([]string{"222200311"}, nil).Build()
+			defer subscriberIDsPatch.UnPatch()
+			prefsPatch := mockey.Mock((*userDB.DBUser).ListNotificationPreferencesByStuIDs).Return(nil, nil).Build()
+			defer prefsPatch.UnPatch()
+			devicesPatch := mockey.Mock((*userDB.DBUser).ListDevicesByStuIDs).Return(nil, nil).Build()
+			defer devicesPatch.UnPatch()
 			ctx := context.Background()
 			mockClientSet := &base.ClientSet{
 				DBClient: &db.Database{},
+				SFClient: new(utils.Snowflake),
 			}
 			service := NewAcademicService(ctx, mockClientSet, &taskqueue.BaseTaskQueue{})
 
@@ -398,6 +414,22 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			}, nil).Build()
 			defer getCourseByHashPatch.UnPatch()
 
+			// Mock 该学生在首次通知发出前已记录这门课
+			subscribedTagsPatch := mockey.Mock((*academicDB.DBAcademic).ListScoreSubscribedTags).
+				Return([]string{scoreTag(testScores[0])}, nil).Build()
+			defer subscribedTagsPatch.UnPatch()
+			createSubscribersPatch := mockey.Mock((*academicDB.DBAcademic).CreateScoreSubscribers).Return(nil).Build()
+			defer createSubscribersPatch.UnPatch()
+
+			// Mock 写入收件箱
+			notified := false
+			createNotificationsPatch := mockey.Mock((*notificationDB.DBNotification).CreateNotifications).
+				To(func(_ context.Context, _ []*dbModel.Notification) error {
+					notified = true
+					return nil
+				}).Build()
+			defer createNotificationsPatch.UnPatch()
+
 			// Mock 更新成绩记录
 			updateScorePatch := mockey.Mock((*academicDB.DBAcademic).UpdateUserScores).Return(nil).Build()
 			defer updateScorePatch.UnPatch()
@@ -405,17 +437,19 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			ctx := context.Background()
 			mockClientSet := &base.ClientSet{
 				DBClient: &db.Database{},
+				SFClient: new(utils.Snowflake),
 			}
 			service := NewAcademicService(ctx, mockClientSet, &taskqueue.BaseTaskQueue{})
 
 			// When: 检查成绩变化
 			err := service.checkScoreChange("222200311", testScores)
 
-			// Then: 应该成功更新记录但不发送推送
+			// Then: 应该成功更新记录但不重复通知
 			So(err, ShouldBeNil)
+			So(notified, ShouldBeFalse)
 		})
 
-		Convey("should do nothing when scores have not changed", func() {
+		Convey("should only record subscribers when scores have not changed", func() {
 			// Given: 学生成绩没有变化
 			testScores := []*jwch.Mark{
 				{
@@ -433,18 +467,28 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			// Mock 返回相同的SHA256（表示成绩没有变化）
 			getSha256Patch := mockey.Mock((*academicDB.DBAcademic).GetScoreSha256ByStuId).Return(sha256, nil).Build()
 			defer getSha256Patch.UnPatch()
+			var subscribers []*dbModel.ScoreSubscriber
+			createSubscribersPatch := mockey.Mock((*academicDB.DBAcademic).CreateScoreSubscribers).
+				To(func(_ context.Context, list []*dbModel.ScoreSubscriber) error {
+					subscribers = list
+					return nil
+				}).Build()
+			defer createSubscribersPatch.UnPatch()
 
 			ctx := context.Background()
 			mockClientSet := &base.ClientSet{
 				DBClient: &db.Database{},
+				SFClient: new(utils.Snowflake),
 			}
 			service := NewAcademicService(ctx, mockClientSet, &taskqueue.BaseTaskQueue{})
 
 			// When: 检查成绩变化
 			err = service.checkScoreChange("222200311", testScores)
 
-			// Then: 应该不做任何操作
+			// Then: 不更新成绩，但记录成绩单中的课程，保证之后的定向推送能送达
 			So(err, ShouldBeNil)
+			So(len(subscribers), ShouldEqual, 1)
+			So(subscribers[0].Tag, ShouldEqual, scoreTag(testScores[0]))
 		})
 
 		Convey("should return error when GetScoreSha256ByStuId fails", func() {
@@ -465,6 +509,7 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			ctx := context.Background()
 			mockClientSet := &base.ClientSet{
 				DBClient: &db.Database{},
+				SFClient: new(utils.Snowflake),
 			}
 			service := NewAcademicService(ctx, mockClientSet, &taskqueue.BaseTaskQueue{})
 
@@ -498,6 +543,7 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			ctx := context.Background()
 			mockClientSet := &base.ClientSet{
 				DBClient: &db.Database{},
+				SFClient: new(utils.Snowflake),
 			}
 			service := NewAcademicService(ctx, mockClientSet, &taskqueue.BaseTaskQueue{})
 
@@ -534,6 +580,7 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			ctx := context.Background()
 			mockClientSet := &base.ClientSet{
 				DBClient: &db.Database{},
+				SFClient: new(utils.Snowflake),
 			}
 			service := NewAcademicService(ctx, mockClientSet, &taskqueue.BaseTaskQueue{})
 
@@ -575,6 +622,10 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			getCourseByHashPatch := mockey.Mock((*academicDB.DBAcademic).GetCourseByHash).Return(nil, nil).Build()
 			defer getCourseByHashPatch.UnPatch()
 
+			// Mock 写入收件箱
+			createNotificationsPatch := mockey.Mock((*notificationDB.DBNotification).CreateNotifications).Return(nil).Build()
+			defer createNotificationsPatch.UnPatch()
+
 			// Mock 创建课程记录
 			createCoursePatch := mockey.Mock((*academicDB.DBAcademic).CreateCourseOffering).Return(&dbModel.CourseOffering{
 				Name:         "数据结构",
@@ -589,18 +640,22 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 			updateScorePatch := mockey.Mock((*academicDB.DBAcademic).UpdateUserScores).Return(fmt.Errorf("update failed")).Build()
 			defer updateScorePatch.UnPatch()
 
-			// Mock umeng 推送
-			umengAndroidPatch := mockey.Mock(umeng.SendAndroidGroupcastWithGoApp).Return(nil).Build()
-			defer umengAndroidPatch.UnPatch()
-
-			umengIOSPatch := mockey.Mock(umeng.SendIOSGroupcast).Return(nil).Build()
-			defer umengIOSPatch.UnPatch()
-			umengHarmonyPatch := mockey.Mock(umeng.SendHarmonyGroupcast).Return(nil).Build()
-			defer umengHarmonyPatch.UnPatch()
+			// Mock 课程的学生与设备推送
+			subscribedTagsPatch := mockey.Mock((*academicDB.DBAcademic).ListScoreSubscribedTags).Return([]string{}, nil).Build()
+			defer subscribedTagsPatch.UnPatch()
+			createSubscribersPatch := mockey.Mock((*academicDB.DBAcademic).CreateScoreSubscribers).Return(nil).Build()
+			defer createSubscribersPatch.UnPatch()
+			subscriberIDsPatch := mockey.Mock((*academicDB.DBAcademic).ListScoreSubscriberIDs).Return([]string{"222200311"}, nil).Build()
+			defer subscriberIDsPatch.UnPatch()
+			prefsPatch := mockey.Mock((*userDB.DBUser).ListNotificationPreferencesByStuIDs).Return(nil, nil).Build()
+			defer prefsPatch.UnPatch()
+			devicesPatch := mockey.Mock((*userDB.DBUser).ListDevicesByStuIDs).Return(nil, nil).Build()
+			defer devicesPatch.UnPatch()
 
 			ctx := context.Background()
 			mockClientSet := &base.ClientSet{
 				DBClient: &db.Database{},
+				SFClient: new(utils.Snowflake),
 			}
 			service := NewAcademicService(ctx, mockClientSet, &taskqueue.BaseTaskQueue{})
 
@@ -614,32 +669,88 @@ func TestAcademicService_checkScoreChange(t *testing.T) {
 	})
 }
 
-func TestAcademicService_sendNotifications(t *testing.T) {
-	Convey("sendNotifications", t, func() {
-		courseName := "数据结构"
-		tag := "abcdefghijklmnopqrstuvwxyz123456"
-
-		tests := []struct {
-			name string
-		}{
-			{
-				name: "send to android, ios and harmony",
-			},
+func TestAcademicService_scoreChangeRespectsPreference(t *testing.T) {
+	Convey("score change push respects notification preference", t, func() {
+		// Given: 课程首次出现成绩变化，两名学生的成绩单中都有这门课，其中一名关闭了成绩推送
+		testScores := []*jwch.Mark{
+			{Name: "数据结构", Score: "95", Semester: "2024-1", Teacher: "张老师", ElectiveType: "必修"},
 		}
+		getScorePatch := mockey.Mock((*academicDB.DBAcademic).GetScoreByStuId).Return(&dbModel.Score{
+			StuID:      "102301001",
+			ScoresInfo: `[{"name":"数据结构","score":"90","semester":"2024-1","teacher":"张老师","electiveType":"必修"}]`,
+		}, nil).Build()
+		defer getScorePatch.UnPatch()
+		subscribedTagsPatch := mockey.Mock((*academicDB.DBAcademic).ListScoreSubscribedTags).Return([]string{}, nil).Build()
+		defer subscribedTagsPatch.UnPatch()
+		createSubscribersPatch := mockey.Mock((*academicDB.DBAcademic).CreateScoreSubscribers).Return(nil).Build()
+		defer createSubscribersPatch.UnPatch()
+		subscriberIDsPatch := mockey.Mock((*academicDB.DBAcademic).ListScoreSubscriberIDs).
+			Return([]string{"102301001", "102301002"}, nil).Build()
+		defer subscriberIDsPatch.UnPatch()
+		getCourseByHashPatch := mockey.Mock((*academicDB.DBAcademic).GetCourseByHash).Return(nil, nil).Build()
+		defer getCourseByHashPatch.UnPatch()
+		createCoursePatch := mockey.Mock((*academicDB.DBAcademic).CreateCourseOffering).Return(&dbModel.CourseOffering{}, nil).Build()
+		defer createCoursePatch.UnPatch()
+
+		var inbox []string
+		createNotificationsPatch := mockey.Mock((*notificationDB.DBNotification).CreateNotifications).
+			To(func(_ context.Context, list []*dbModel.Notification) error {
+				for _, row := range list {
+					inbox = append(inbox, row.StuId)
+				}
+				return nil
+			}).Build()
+		defer createNotificationsPatch.UnPatch()
+		prefsPatch := mockey.Mock((*userDB.DBUser).ListNotificationPreferencesByStuIDs).Return([]*dbModel.NotificationPreference{
+			{StuId: "102301001", DisabledTypes: constants.UmengPushTypeScore, Channel: constants.NotificationChannelAll},
+		}, nil).Build()
+		defer prefsPatch.UnPatch()
+		devicesPatch := mockey.Mock((*userDB.DBUser).ListDevicesByStuIDs).Return([]*dbModel.Device{
+			{StuId: "102301001", DeviceToken: "token-disabled", Platform: constants.DevicePlatformAndroid},
+			{StuId: "102301002", DeviceToken: "token-default", Platform: constants.DevicePlatformAndroid},
+		}, nil).Build()
+		defer devicesPatch.UnPatch()
+		var tasks []*umeng.Task
+		enqueuePatch := mockey.Mock(umeng.Enqueue).To(func(_ context.Context, task *umeng.Task) error {
+			tasks = append(tasks, task)
+			return nil
+		}).Build()
+		defer enqueuePatch.UnPatch()
+
+		service := NewAcademicService(context.Background(), &base.ClientSet{
+			DBClient: &db.Database{},
+			SFClient: new(utils.Snowflake),
+		}, &taskqueue.BaseTaskQueue{})
+
+		// When: 处理成绩变化
+		err := service.handleScoreChange("102301001", testScores)
+
+		// Then: 两名学生都写入收件箱，只有未关闭成绩推送的学生的设备被推送；
+		// 按课程 tag 的推送只送达未登记设备的客户端
+		So(err, ShouldBeNil)
+		So(inbox, ShouldResemble, []string{"102301001", "102301002"})
+		So(len(tasks), ShouldEqual, 2)
+		So(tasks[0].Tags, ShouldResemble, []string{scoreTag(testScores[0])})
+		So(tasks[0].ExcludeTags, ShouldResemble, []string{constants.UmengDeviceRegisteredTag})
+		So(tasks[1].Tags, ShouldBeEmpty)
+		So(tasks[1].PushType, ShouldEqual, constants.UmengPushTypeScore)
+		So(tasks[1].DeviceTokens, ShouldResemble, []string{"token-default"})
+	})
+}
 
-		for _, tt := range tests {
-			Convey(tt.name, func() {
-				// Mock umeng 推送，并断言按成绩类型下发
-				umengHarmonyPatch := mockey.Mock(umeng.PushByType).Return().Build()
-				defer umengHarmonyPatch.UnPatch()
+func TestScoreNotification(t *testing.T) {
+	Convey("scoreNotification", t, func() {
+		courseName := "数据结构"
+		tag := "abcdefghijklmnopqrstuvwxyz123456"
 
-				ctx := context.Background()
-				mockClientSet := &base.ClientSet{}
-				service := NewAcademicService(ctx, mockClientSet, &taskqueue.BaseTaskQueue{})
+		msg := scoreNotification(courseName, tag)
 
-				service.sendNotifications(courseName, tag)
-			})
-		}
+		So(msg.Type, ShouldEqual, constants.UmengPushTypeScore)
+		So(msg.Text, ShouldEqual, "数据结构成绩已更新")
+		So(msg.Description, ShouldEqual, "成绩更新abcdefghijkl")
+		So(msg.Deeplink, ShouldEqual, constants.UmengGradeDeeplink)
+		So(msg.Tags, ShouldBeEmpty)
+		So(msg.StuIDs, ShouldBeEmpty)
 	})
 }
 
@@ -18,9 +18,12 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
 )
@@ -30,12 +33,19 @@ func (s *AcademicService) GetUnifiedExam() ([]*jwch.UnifiedExam, error) {
 	if err != nil {
 		return nil, fmt.Errorf("service.GetUnifiedExam: Get login data fail %w", err)
 	}
+	if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		return nil, fmt.Errorf("service.GetUnifiedExam: %w", err)
+	}
 	stu := jwch.NewStudent().WithLoginData(loginData.Id, utils.ParseCookies(loginData.Cookies))
+	start := time.Now()
 	cet, err := stu.GetCET()
+	metrics.ObserveUpstream(governor.HostJwch, "GetCET", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return nil, fmt.Errorf("service.GetUnifiedExam: Get cet info fail %w", err)
 	}
+	start = time.Now()
 	js, err := stu.GetJS()
+	metrics.ObserveUpstream(governor.HostJwch, "GetJS", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return nil, fmt.Errorf("service.GetUnifiedExam: Get js info fail %w", err)
 	}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	loginmodel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	metainfoContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/jwch"
+)
+
+// GetScoresSnapshot 在教务处不可用时返回数据库中最近一次持久化的成绩及其更新时间，并在后台尝试重新拉取
+// 快照不存在时返回 nil
+func (s *AcademicService) GetScoresSnapshot(loginData *loginmodel.LoginData) ([]*jwch.Mark, time.Time, error) {
+	stuId := metainfoContext.ExtractIDFromLoginData(loginData)
+	snapshot, err := s.db.Academic.GetScoreByStuId(s.ctx, stuId)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("service.GetScoresSnapshot: %w", err)
+	}
+	if snapshot == nil {
+		return nil, time.Time{}, nil
+	}
+
+	var scores []*jwch.Mark
+	if err = sonic.UnmarshalString(snapshot.ScoresInfo, &scores); err != nil {
+		return nil, time.Time{}, errno.Errorf(errno.InternalJSONErrorCode, "service.GetScoresSnapshot: decode scores failed: %v", err)
+	}
+
+	s.refreshInBackground(fmt.Sprintf("refreshScores:%s", stuId), func(svc *AcademicService) error {
+		_, err := svc.GetScores(loginData)
+		return err
+	})
+	return scores, snapshot.UpdatedAt, nil
+}
+
+// refreshInBackground 使用脱离请求生命周期的服务副本在后台重新访问教务处
+func (s *AcademicService) refreshInBackground(key string, refresh func(svc *AcademicService) error) {
+	svc := *s
+	svc.ctx = context.WithoutCancel(s.ctx)
+	base.RefreshInBackground(s.taskQueue, key, func() error {
+		return refresh(&svc)
+	})
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	. "github.com/smartystreets/goconvey/convey"
+
+	loginmodel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	baseContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	academicDB "github.com/west2-online/fzuhelper-server/pkg/db/academic"
+	dbModel "github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/jwch"
+)
+
+func TestAcademicService_GetScoresSnapshot(t *testing.T) {
+	testLoginData := &loginmodel.LoginData{
+		Id:      "20241025133150102301317",
+		Cookies: "ASP.NET_SessionId=lzs1t42mpkml4ag2jrxvib4z",
+	}
+	newService := func() *AcademicService {
+		ctx := baseContext.WithLoginData(context.Background(), testLoginData)
+		return NewAcademicService(ctx, &base.ClientSet{DBClient: new(db.Database)}, &taskqueue.BaseTaskQueue{})
+	}
+
+	Convey("GetScoresSnapshot", t, func() {
+		Convey("should return error when database fails", func() {
+			defer mockey.Mock((*academicDB.DBAcademic).GetScoreByStuId).Return(nil, errno.RedisError).Build().UnPatch()
+
+			scores, _, err := newService().GetScoresSnapshot(testLoginData)
+			So(scores, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "service.GetScoresSnapshot")
+		})
+
+		Convey("should return nil when snapshot does not exist", func() {
+			defer mockey.Mock((*academicDB.DBAcademic).GetScoreByStuId).Return(nil, nil).Build().UnPatch()
+			addPatch := mockey.Mock((*taskqueue.BaseTaskQueue).Add).Return().Build()
+			defer addPatch.UnPatch()
+
+			scores, _, err := newService().GetScoresSnapshot(testLoginData)
+			So(err, ShouldBeNil)
+			So(scores, ShouldBeNil)
+			So(addPatch.Times(), ShouldEqual, 0)
+		})
+
+		Convey("should return snapshot and schedule background refresh", func() {
+			updatedAt := time.Date(2024, 9, 1, 8, 0, 0, 0, time.Local)
+			defer mockey.Mock((*academicDB.DBAcademic).GetScoreByStuId).Return(&dbModel.Score{
+				StuID:      "102301317",
+				ScoresInfo: `[{"Name":"数据结构","Score":"90"}]`,
+				UpdatedAt:  updatedAt,
+			}, nil).Build().UnPatch()
+
+			var task taskqueue.QueueTask
+			defer mockey.Mock((*taskqueue.BaseTaskQueue).Add).To(func(_ *taskqueue.BaseTaskQueue, key string, t taskqueue.QueueTask) {
+				So(key, ShouldEqual, "refreshScores:102301317")
+				task = t
+			}).Build().UnPatch()
+
+			scores, snapshotAt, err := newService().GetScoresSnapshot(testLoginData)
+			So(err, ShouldBeNil)
+			So(snapshotAt, ShouldEqual, updatedAt)
+			So(len(scores), ShouldEqual, 1)
+			So(scores[0].Name, ShouldEqual, "数据结构")
+			So(task.Execute, ShouldNotBeNil)
+
+			// 教务处仍不可用时交给任务队列重试
+			getScoresPatch := mockey.Mock((*AcademicService).GetScores).Return(nil, errno.UpstreamBreakerOpen).Build()
+			So(task.Execute(), ShouldNotBeNil)
+			getScoresPatch.UnPatch()
+
+			// cookie 过期等错误直接放弃
+			getScoresPatch = mockey.Mock((*AcademicService).GetScores).Return(nil,
+				errno.NewErrNo(errno.BizJwchCookieExceptionCode, "session expired")).Build()
+			So(task.Execute(), ShouldBeNil)
+			getScoresPatch.UnPatch()
+
+			getScoresPatch = mockey.Mock((*AcademicService).GetScores).Return([]*jwch.Mark{}, nil).Build()
+			So(task.Execute(), ShouldBeNil)
+			getScoresPatch.UnPatch()
+		})
+
+		Convey("should return error when snapshot is corrupted", func() {
+			defer mockey.Mock((*academicDB.DBAcademic).GetScoreByStuId).Return(&dbModel.Score{ScoresInfo: "{"}, nil).Build().UnPatch()
+
+			scores, _, err := newService().GetScoresSnapshot(testLoginData)
+			So(scores, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "decode scores failed")
+		})
+	})
+}
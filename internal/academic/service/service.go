@@ -22,6 +22,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
 	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
@@ -32,6 +33,7 @@ type AcademicService struct {
 	db        *db.Database
 	sf        *utils.Snowflake
 	taskQueue taskqueue.TaskQueue
+	notifier  notification.Notifier
 }
 
 func NewAcademicService(ctx context.Context, clientset *base.ClientSet, taskQueue taskqueue.TaskQueue) *AcademicService {
@@ -41,5 +43,6 @@ func NewAcademicService(ctx context.Context, clientset *base.ClientSet, taskQueu
 		db:        clientset.DBClient,
 		sf:        clientset.SFClient,
 		taskQueue: taskQueue,
+		notifier:  notification.New(clientset.DBClient),
 	}
 }
@@ -21,7 +21,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/west2-online/fzuhelper-server/internal/classroom/pack"
 	"github.com/west2-online/fzuhelper-server/internal/classroom/service"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
@@ -30,6 +29,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
 	"github.com/west2-online/fzuhelper-server/pkg/singleflight"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
 
@@ -42,43 +42,34 @@ const (
 // ClassroomServiceImpl implements the last service interface defined in the IDL.
 type ClassroomServiceImpl struct {
 	ClientSet *base.ClientSet
+	taskQueue taskqueue.TaskQueue
 }
 
-func NewClassroomService(clientSet *base.ClientSet) *ClassroomServiceImpl {
+func NewClassroomService(clientSet *base.ClientSet, taskQueue taskqueue.TaskQueue) *ClassroomServiceImpl {
 	return &ClassroomServiceImpl{
 		ClientSet: clientSet,
+		taskQueue: taskQueue,
 	}
 }
 
 // GetEmptyRoom implements the ClassroomServiceImpl interface.
 func (s *ClassroomServiceImpl) GetEmptyRoom(ctx context.Context, req *classroom.EmptyRoomRequest) (resp *classroom.EmptyRoomResponse, err error) {
 	resp = classroom.NewEmptyRoomResponse()
-	// 判断req.date只能从今天开始的七天内，在当前日期前或超过 7 天则报错
-	// 首先判断date的格式是否符合要求
-	requestDate, err := utils.TimeParse(req.Date)
-	if err != nil {
-		logger.WithCtx(ctx).Errorf("Classroom.GetEmptyRoom: date format error, err: %v", err)
-		resp.Base = base.BuildBaseResp(err)
-		return resp, nil
-	}
-	now := time.Now().Truncate(constants.ONE_DAY)
-	requestDate = requestDate.Truncate(constants.ONE_DAY)
-	dateDiff := requestDate.Sub(now).Hours() / HoursInADay
-	if dateDiff < MinDateDiff || dateDiff > MaxDateDiff {
-		err = fmt.Errorf("date out of range, date: %v", req.Date)
+	if err = checkDate(req.Date); err != nil {
 		logger.WithCtx(ctx).Infof("Classroom.GetEmptyRoom: %v", err)
 		resp.Base = base.BuildBaseResp(err)
 		return resp, nil
 	}
 
-	res, err := service.NewClassroomService(ctx, s.ClientSet).GetEmptyRoom(req)
+	res, status, err := service.NewClassroomService(ctx, s.ClientSet, s.taskQueue).GetEmptyRoom(req)
 	if err != nil {
 		logger.WithCtx(ctx).Infof("Classroom.GetEmptyRoom: GetEmptyRoom failed, err: %v", err)
 		resp.Base = base.BuildBaseResp(err)
 		return resp, nil
 	}
 	resp.Base = base.BuildSuccessResp()
-	resp.Rooms = pack.BuildClassRooms(res, req.Campus)
+	resp.Rooms = res
+	resp.SyncStatus = status
 	// logger.WithCtx(ctx).Info("Classroom.GetEmptyRoom: GetEmptyRoom success")
 	return resp, nil
 }
@@ -94,13 +85,26 @@ func (s *ClassroomServiceImpl) GetExamRoomInfo(ctx context.Context, req *classro
 	key := singleflight.Key(constants.SingleflightExamRoomsPrefix, stuId, req.GetTerm(), isGraduate)
 
 	rooms, err := singleflight.Do(key, func() ([]*model.ExamRoomInfo, error) {
-		svc := service.NewClassroomService(ctx, s.ClientSet)
+		svc := service.NewClassroomService(ctx, s.ClientSet, s.taskQueue)
 		if isGraduate {
 			return svc.GetExamRoomInfoYjsy(req, loginData)
 		} else {
 			return svc.GetExamRoomInfo(req, loginData)
 		}
 	})
+	if base.ShouldServeStale(err) {
+		// 教务处不可用时降级返回数据库中的考场快照
+		snapshot, snapshotAt, snapshotErr := service.NewClassroomService(ctx, s.ClientSet, s.taskQueue).GetExamRoomInfoSnapshot(req, loginData)
+		if snapshotErr != nil {
+			logger.Errorf("Classroom.GetExamRoomInfo: get exam room snapshot failed: %v", snapshotErr)
+		} else if snapshot != nil {
+			stale, snapshotTime := true, snapshotAt.UnixMilli()
+			resp.Base = base.BuildSuccessResp()
+			resp.Rooms = snapshot
+			resp.Stale, resp.SnapshotTime = &stale, &snapshotTime
+			return resp, nil
+		}
+	}
 	if err != nil {
 		resp.Base = base.BuildBaseResp(err)
 		return resp, nil
@@ -109,3 +113,65 @@ func (s *ClassroomServiceImpl) GetExamRoomInfo(ctx context.Context, req *classro
 	resp.Rooms = rooms
 	return resp, nil
 }
+
+// GetExamRoomChanges implements the ClassroomServiceImpl interface.
+func (s *ClassroomServiceImpl) GetExamRoomChanges(ctx context.Context, req *classroom.ExamRoomChangesRequest) (resp *classroom.ExamRoomChangesResponse, err error) {
+	resp = classroom.NewExamRoomChangesResponse()
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Classroom.GetExamRoomChanges: Get login data fail %w", err)
+	}
+
+	changes, err := service.NewClassroomService(ctx, s.ClientSet, s.taskQueue).GetExamRoomChanges(req, loginData)
+	if err != nil {
+		logger.WithCtx(ctx).Infof("Classroom.GetExamRoomChanges: GetExamRoomChanges failed, err: %v", err)
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	resp.Changes = changes
+	return resp, nil
+}
+
+// GetRoomSchedule implements the ClassroomServiceImpl interface.
+func (s *ClassroomServiceImpl) GetRoomSchedule(ctx context.Context, req *classroom.RoomScheduleRequest) (resp *classroom.RoomScheduleResponse, err error) {
+	resp = classroom.NewRoomScheduleResponse()
+	if err = checkDate(req.Date); err != nil {
+		logger.WithCtx(ctx).Infof("Classroom.GetRoomSchedule: %v", err)
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+
+	key := singleflight.Key(constants.SingleflightRoomSchedulePrefix, req.Room, req.Date)
+	res, err := singleflight.Do(key, func() (*classroom.RoomScheduleResponse, error) {
+		schedule, status, err := service.NewClassroomService(ctx, s.ClientSet, s.taskQueue).GetRoomSchedule(req)
+		if err != nil {
+			return nil, err
+		}
+		return &classroom.RoomScheduleResponse{Schedule: schedule, SyncStatus: status}, nil
+	})
+	if err != nil {
+		logger.WithCtx(ctx).Infof("Classroom.GetRoomSchedule: GetRoomSchedule failed, err: %v", err)
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	resp.Schedule = res.Schedule
+	resp.SyncStatus = res.SyncStatus
+	return resp, nil
+}
+
+// checkDate 判断 date 的格式，且只能是从今天开始的七天内，在当前日期前或超过 7 天则报错
+func checkDate(date string) error {
+	requestDate, err := utils.TimeParse(date)
+	if err != nil {
+		return fmt.Errorf("date format error: %w", err)
+	}
+	now := time.Now().Truncate(constants.ONE_DAY)
+	requestDate = requestDate.Truncate(constants.ONE_DAY)
+	dateDiff := requestDate.Sub(now).Hours() / HoursInADay
+	if dateDiff < MinDateDiff || dateDiff > MaxDateDiff {
+		return fmt.Errorf("date out of range, date: %v", date)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package meta 维护教室的结构化元数据（楼栋、楼层、容量、类型与坐标）
+// 教室的容量与类型来自空教室同步时教务处返回的教室信息，随代码发布的 classrooms.json 只记录教务处数据的更正与楼栋坐标，
+// 坐标必须来自实测，只登记已测得坐标的楼栋，不添加没有任何数据的条目；修改数据时需要同步递增其中的 version
+package meta
+
+import (
+	_ "embed"
+	"fmt"
+	"strconv"
+	"sync"
+	"unicode"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+//go:embed classrooms.json
+var catalogData []byte
+
+// 教室号至少三位时，去掉末两位即为楼层，例 旗山西3-104 为 1 楼
+const (
+	floorMinDigits = 3
+	floorDivisor   = 100
+)
+
+// Building 楼栋元数据，非旗山校区的楼栋名与校区名相同
+type Building struct {
+	Campus    string   `json:"campus"`
+	Build     string   `json:"build"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// Room 教室元数据的更正项，未填写的字段沿用教务处返回的信息
+type Room struct {
+	Location string `json:"location"`
+	Build    string `json:"build"`
+	Floor    int64  `json:"floor"`
+	Capacity int64  `json:"capacity"`
+	Type     string `json:"type"`
+}
+
+type Catalog struct {
+	Version   string     `json:"version"`
+	Buildings []Building `json:"buildings"`
+	Rooms     []Room     `json:"rooms"`
+
+	buildings map[string]*Building // campus.build -> 楼栋
+	rooms     map[string]*Room     // location -> 教室
+}
+
+var (
+	defaultCatalog *Catalog
+	once           sync.Once
+)
+
+// Default 返回内置数据文件对应的元数据，数据文件损坏时返回空的元数据，查询仍可使用教务处信息
+func Default() *Catalog {
+	once.Do(func() {
+		catalog, err := Parse(catalogData)
+		if err != nil {
+			logger.Errorf("meta.Default: parse classroom catalog failed: %v", err)
+			catalog = &Catalog{}
+			catalog.index()
+		}
+		defaultCatalog = catalog
+	})
+	return defaultCatalog
+}
+
+func Parse(data []byte) (*Catalog, error) {
+	catalog := new(Catalog)
+	if err := sonic.Unmarshal(data, catalog); err != nil {
+		return nil, fmt.Errorf("meta.Parse: unmarshal catalog failed: %w", err)
+	}
+	if catalog.Version == "" {
+		return nil, fmt.Errorf("meta.Parse: catalog version is empty")
+	}
+	catalog.index()
+	return catalog, nil
+}
+
+func (c *Catalog) index() {
+	c.buildings = make(map[string]*Building, len(c.Buildings))
+	for i := range c.Buildings {
+		b := &c.Buildings[i]
+		c.buildings[buildingKey(b.Campus, b.Build)] = b
+	}
+	c.rooms = make(map[string]*Room, len(c.Rooms))
+	for i := range c.Rooms {
+		c.rooms[c.Rooms[i].Location] = &c.Rooms[i]
+	}
+}
+
+// Enrich 用元数据补全教室信息，campus 为查询时的校区
+func (c *Catalog) Enrich(room *model.Classroom, campus string) {
+	if r, ok := c.rooms[room.Location]; ok {
+		if r.Build != "" {
+			room.Build = r.Build
+		}
+		if r.Floor != 0 {
+			room.Floor = &r.Floor
+		}
+		if r.Capacity != 0 {
+			room.Capacity = strconv.FormatInt(r.Capacity, 10)
+		}
+		if r.Type != "" {
+			room.Type = r.Type
+		}
+	}
+	if room.Floor == nil {
+		if floor, ok := parseFloor(room.Location); ok {
+			room.Floor = &floor
+		}
+	}
+	if b, ok := c.buildings[buildingKey(campus, room.Build)]; ok && b.Latitude != nil && b.Longitude != nil {
+		room.Latitude = b.Latitude
+		room.Longitude = b.Longitude
+	}
+}
+
+// parseFloor 从教室名末尾的教室号推断楼层，例 怡山北301 为 3 楼
+func parseFloor(location string) (int64, bool) {
+	runes := []rune(location)
+	end := len(runes)
+	for end > 0 && !unicode.IsDigit(runes[end-1]) {
+		end--
+	}
+	start := end
+	for start > 0 && unicode.IsDigit(runes[start-1]) {
+		start--
+	}
+	if end-start < floorMinDigits {
+		return 0, false
+	}
+	number, err := strconv.ParseInt(string(runes[start:end]), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return number / floorDivisor, true
+}
+
+func buildingKey(campus, build string) string {
+	return campus + "." + build
+}
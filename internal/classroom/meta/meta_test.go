@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+)
+
+func TestDefaultCatalog(t *testing.T) {
+	catalog := Default()
+	assert.NotEmpty(t, catalog.Version)
+	for _, b := range catalog.Buildings {
+		assert.NotEmpty(t, b.Campus)
+		assert.NotEmpty(t, b.Build)
+		// 登记的楼栋必须带有实测坐标
+		assert.NotNil(t, b.Latitude)
+		assert.NotNil(t, b.Longitude)
+	}
+	for _, r := range catalog.Rooms {
+		assert.NotEmpty(t, r.Location)
+	}
+}
+
+func TestParse(t *testing.T) {
+	_, err := Parse([]byte(`{"buildings": []}`))
+	assert.Error(t, err)
+	_, err = Parse([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestEnrich(t *testing.T) {
+	catalog, err := Parse([]byte(`{
+		"version": "test",
+		"buildings": [
+			{"campus": "旗山校区", "build": "西3", "latitude": 26.1, "longitude": 119.2},
+			{"campus": "旗山校区", "build": "东1"}
+		],
+		"rooms": [{"location": "旗山西3-104", "floor": 2, "capacity": 160, "type": "智慧教室"}]
+	}`))
+	assert.NoError(t, err)
+
+	type testCase struct {
+		name          string
+		room          *model.Classroom
+		expectBuild   string
+		expectFloor   *int64
+		expectCap     string
+		expectType    string
+		expectLocated bool
+	}
+	tests := []testCase{
+		{
+			name:          "RoomOverride",
+			room:          &model.Classroom{Build: "西3", Location: "旗山西3-104", Capacity: "153", Type: "多媒体"},
+			expectBuild:   "西3",
+			expectFloor:   new(int64(2)),
+			expectCap:     "160",
+			expectType:    "智慧教室",
+			expectLocated: true,
+		},
+		{
+			name:          "FloorFromLocation",
+			room:          &model.Classroom{Build: "西3", Location: "旗山西3-305", Capacity: "90", Type: "多媒体"},
+			expectBuild:   "西3",
+			expectFloor:   new(int64(3)),
+			expectCap:     "90",
+			expectType:    "多媒体",
+			expectLocated: true,
+		},
+		{
+			// 楼栋坐标未知时不返回坐标，容量与类型沿用教务处信息
+			name:        "BuildingWithoutCoordinates",
+			room:        &model.Classroom{Build: "东1", Location: "旗山东1-103", Capacity: "0", Type: "机房"},
+			expectBuild: "东1",
+			expectFloor: new(int64(1)),
+			expectCap:   "0",
+			expectType:  "机房",
+		},
+		{
+			name:        "UnknownBuilding",
+			room:        &model.Classroom{Build: "鼓浪屿校区", Location: "鼓浪屿多媒体1", Capacity: "0", Type: "多媒体"},
+			expectBuild: "鼓浪屿校区",
+			expectCap:   "0",
+			expectType:  "多媒体",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			catalog.Enrich(tc.room, "旗山校区")
+			assert.Equal(t, tc.expectBuild, tc.room.Build)
+			assert.Equal(t, tc.expectFloor, tc.room.Floor)
+			assert.Equal(t, tc.expectCap, tc.room.Capacity)
+			assert.Equal(t, tc.expectType, tc.room.Type)
+			assert.Equal(t, tc.expectLocated, tc.room.Latitude != nil && tc.room.Longitude != nil)
+		})
+	}
+}
@@ -59,13 +59,6 @@ func BuildClassroom(str string, campus string) *model.Classroom {
 	}
 }
 
-func BuildClassRooms(strs []string, campus string) (res []*model.Classroom) {
-	for _, str := range strs {
-		res = append(res, BuildClassroom(str, campus))
-	}
-	return res
-}
-
 func location2Build(location string) string {
 	runes := []rune(location)
 	if strings.Contains(location, "公语") {
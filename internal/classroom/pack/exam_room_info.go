@@ -18,6 +18,7 @@ package pack
 
 import (
 	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	db "github.com/west2-online/fzuhelper-server/pkg/db/model"
 	"github.com/west2-online/jwch"
 	"github.com/west2-online/yjsy"
 )
@@ -51,3 +52,28 @@ func BuildExamRoomInfoYjsy(rooms []*yjsy.ExamRoomInfo) []*model.ExamRoomInfo {
 	}
 	return res
 }
+
+func BuildExamRoomChanges(changes []*db.ExamRoomChange) []*model.ExamRoomChange {
+	res := make([]*model.ExamRoomChange, 0, len(changes))
+	for _, change := range changes {
+		item := &model.ExamRoomChange{
+			Name:       change.Name,
+			Credit:     change.Credit,
+			Teacher:    change.Teacher,
+			ChangeType: change.ChangeType,
+			DetectedAt: change.CreatedAt.UnixMilli(),
+		}
+		if change.ChangeType != db.ExamRoomChangeAdded {
+			item.PrevLocation = &change.OldLocation
+			item.PrevDate = &change.OldDate
+			item.PrevTime = &change.OldTime
+		}
+		if change.ChangeType != db.ExamRoomChangeRemoved {
+			item.Location = &change.NewLocation
+			item.Date = &change.NewDate
+			item.Time = &change.NewTime
+		}
+		res = append(res, item)
+	}
+	return res
+}
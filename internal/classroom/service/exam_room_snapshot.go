@@ -0,0 +1,236 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
+	kitexModel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	metainfoContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func examRoomIdentity(room *kitexModel.ExamRoomInfo) string {
+	return strings.Join([]string{room.Name, room.Teacher, room.Credit}, "|")
+}
+
+// examRoomTag 与课程服务的考试 tag 保持一致，用于在友盟后台的任务描述中区分课程
+func examRoomTag(name, teacher, credit string) string {
+	return utils.MD5(strings.Join([]string{name, teacher, credit}, "|"))
+}
+
+// sortExamRooms 返回按课程身份排序的副本，避免教务处返回顺序变化导致快照 hash 变化
+func sortExamRooms(rooms []*kitexModel.ExamRoomInfo) []*kitexModel.ExamRoomInfo {
+	ordered := make([]*kitexModel.ExamRoomInfo, 0, len(rooms))
+	for _, room := range rooms {
+		if room != nil {
+			ordered = append(ordered, room)
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		left, right := examRoomIdentity(ordered[i]), examRoomIdentity(ordered[j])
+		if left != right {
+			return left < right
+		}
+		return ordered[i].Date+ordered[i].Time < ordered[j].Date+ordered[j].Time
+	})
+	return ordered
+}
+
+// buildExamRoomChanges 按课程身份比较新旧考场，考场、日期、时间任一变化都视为变更
+func buildExamRoomChanges(stuId, term string, oldRooms, newRooms []*kitexModel.ExamRoomInfo) []*model.ExamRoomChange {
+	oldByIdentity := make(map[string]*kitexModel.ExamRoomInfo, len(oldRooms))
+	for _, room := range oldRooms {
+		oldByIdentity[examRoomIdentity(room)] = room
+	}
+	newByIdentity := make(map[string]*kitexModel.ExamRoomInfo, len(newRooms))
+	for _, room := range newRooms {
+		newByIdentity[examRoomIdentity(room)] = room
+	}
+
+	changes := make([]*model.ExamRoomChange, 0)
+	for identity, newRoom := range newByIdentity {
+		oldRoom, ok := oldByIdentity[identity]
+		switch {
+		case !ok:
+			changes = append(changes, &model.ExamRoomChange{
+				StuId: stuId, Term: term, Name: newRoom.Name, Teacher: newRoom.Teacher, Credit: newRoom.Credit,
+				ChangeType:  model.ExamRoomChangeAdded,
+				NewLocation: newRoom.Location, NewDate: newRoom.Date, NewTime: newRoom.Time,
+			})
+		case oldRoom.Location != newRoom.Location || oldRoom.Date != newRoom.Date || oldRoom.Time != newRoom.Time:
+			changes = append(changes, &model.ExamRoomChange{
+				StuId: stuId, Term: term, Name: newRoom.Name, Teacher: newRoom.Teacher, Credit: newRoom.Credit,
+				ChangeType:  model.ExamRoomChangeChanged,
+				OldLocation: oldRoom.Location, OldDate: oldRoom.Date, OldTime: oldRoom.Time,
+				NewLocation: newRoom.Location, NewDate: newRoom.Date, NewTime: newRoom.Time,
+			})
+		}
+	}
+	for identity, oldRoom := range oldByIdentity {
+		if _, ok := newByIdentity[identity]; ok {
+			continue
+		}
+		changes = append(changes, &model.ExamRoomChange{
+			StuId: stuId, Term: term, Name: oldRoom.Name, Teacher: oldRoom.Teacher, Credit: oldRoom.Credit,
+			ChangeType:  model.ExamRoomChangeRemoved,
+			OldLocation: oldRoom.Location, OldDate: oldRoom.Date, OldTime: oldRoom.Time,
+		})
+	}
+	// map 遍历顺序不固定，排序后保证变化记录和推送顺序稳定
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].ChangeType < changes[j].ChangeType
+	})
+	return changes
+}
+
+// putExamRoomSnapshot 保存考场快照并与上一次快照比对，记录变化并推送考场通知
+// 首次建立快照时只作为基线，不把已有考场当作变化
+// 考场按学生分配，同一门课的学生可能在不同考场，因此只通知快照发生变化的学生本人
+func (s *ClassroomService) putExamRoomSnapshot(stuId, term string, rooms []*kitexModel.ExamRoomInfo) error {
+	ordered := sortExamRooms(rooms)
+	roomsJSON, err := utils.JSONEncode(ordered)
+	if err != nil {
+		return errno.Errorf(errno.InternalJSONErrorCode,
+			"service.putExamRoomSnapshot: encode exam rooms failed: %v", err)
+	}
+	roomsSHA256 := utils.SHA256(roomsJSON)
+
+	old, err := s.db.Course.GetExamRoomSnapshot(s.ctx, stuId, term)
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		id, err := s.sf.NextVal()
+		if err != nil {
+			return err
+		}
+		return s.db.Course.CreateExamRoomSnapshot(s.ctx, &model.ExamRoomSnapshot{
+			Id:          id,
+			StuId:       stuId,
+			Term:        term,
+			Rooms:       roomsJSON,
+			RoomsSHA256: roomsSHA256,
+		})
+	}
+	if old.RoomsSHA256 == roomsSHA256 {
+		return nil
+	}
+
+	var oldRooms []*kitexModel.ExamRoomInfo
+	if err = sonic.Unmarshal([]byte(old.Rooms), &oldRooms); err != nil {
+		return errno.Errorf(errno.InternalJSONErrorCode,
+			"service.putExamRoomSnapshot: decode exam rooms failed: %v", err)
+	}
+	changes := buildExamRoomChanges(stuId, term, oldRooms, ordered)
+	for _, change := range changes {
+		if change.Id, err = s.sf.NextVal(); err != nil {
+			return err
+		}
+	}
+	old.Rooms = roomsJSON
+	old.RoomsSHA256 = roomsSHA256
+	if err = s.db.Course.UpdateExamRoomSnapshot(s.ctx, old, changes); err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		tag := examRoomTag(change.Name, change.Teacher, change.Credit)
+		msg := examRoomNotification(change, tag)
+		msg.StuIDs = []string{stuId}
+		if err = s.notifier.Notify(s.ctx, msg); err != nil {
+			logger.Errorf("service.putExamRoomSnapshot: notify exam room change failed, tag:%v, err:%v", tag, err)
+		}
+	}
+	return nil
+}
+
+// GetExamRoomInfoSnapshot 在教务处不可用时返回数据库中最近一次变化的考场快照及其更新时间，并在后台尝试重新拉取
+// 快照不存在时返回 nil
+func (s *ClassroomService) GetExamRoomInfoSnapshot(req *classroom.ExamRoomInfoRequest, loginData *kitexModel.LoginData) ([]*kitexModel.ExamRoomInfo, time.Time, error) {
+	stuId := metainfoContext.ExtractIDFromLoginData(loginData)
+	snapshot, err := s.db.Course.GetExamRoomSnapshot(s.ctx, stuId, req.GetTerm())
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("service.GetExamRoomInfoSnapshot: %w", err)
+	}
+	if snapshot == nil {
+		return nil, time.Time{}, nil
+	}
+	var rooms []*kitexModel.ExamRoomInfo
+	if err = sonic.Unmarshal([]byte(snapshot.Rooms), &rooms); err != nil {
+		return nil, time.Time{}, errno.Errorf(errno.InternalJSONErrorCode,
+			"service.GetExamRoomInfoSnapshot: decode exam rooms failed: %v", err)
+	}
+
+	s.refreshInBackground(fmt.Sprintf("refreshExamRoom:%s:%s", stuId, req.GetTerm()), func(svc *ClassroomService) error {
+		var err error
+		if utils.IsGraduate(loginData.GetId()) {
+			_, err = svc.GetExamRoomInfoYjsy(req, loginData)
+		} else {
+			_, err = svc.GetExamRoomInfo(req, loginData)
+		}
+		return err
+	})
+	return rooms, snapshot.UpdatedAt, nil
+}
+
+// refreshInBackground 复制一份不随请求取消的 service 用于后台刷新
+func (s *ClassroomService) refreshInBackground(key string, refresh func(svc *ClassroomService) error) {
+	svc := *s
+	svc.ctx = context.WithoutCancel(s.ctx)
+	base.RefreshInBackground(s.taskQueue, key, func() error {
+		return refresh(&svc)
+	})
+}
+
+func examRoomNotification(change *model.ExamRoomChange, tag string) *notification.Message {
+	// 与考试通知一致，按学号推送并遵循学生的通知偏好，推送失败仅由友盟任务队列记录，不影响快照
+	var text string
+	switch change.ChangeType {
+	case model.ExamRoomChangeAdded:
+		text = change.Name + "考场已公布"
+	case model.ExamRoomChangeRemoved:
+		text = change.Name + "考场信息已移除"
+	default:
+		text = change.Name + "考场信息已变更"
+	}
+	return &notification.Message{
+		Type:        constants.UmengPushTypeExam,
+		Title:       "考场更新啦",
+		Text:        text,
+		Keywords:    []string{change.Name},
+		Description: fmt.Sprintf("考场信息更新%v", tag[:12]),
+		Deeplink:    constants.UmengExamRoomDeeplink,
+		Unicast:     true,
+	}
+}
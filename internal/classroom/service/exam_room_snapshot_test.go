@@ -0,0 +1,288 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
+	kitexModel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	customContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	dbcourse "github.com/west2-online/fzuhelper-server/pkg/db/course"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbnotification "github.com/west2-online/fzuhelper-server/pkg/db/notification"
+	dbuser "github.com/west2-online/fzuhelper-server/pkg/db/user"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/fzuhelper-server/pkg/umeng"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestBuildExamRoomChanges(t *testing.T) {
+	oldRooms := []*kitexModel.ExamRoomInfo{
+		{Name: "数据结构", Teacher: "张老师", Credit: "4.0", Location: "旗山东1-101", Date: "2026-06-20", Time: "09:00-11:00"},
+		{Name: "高等数学", Teacher: "李老师", Credit: "5.0", Location: "旗山东3-201", Date: "2026-06-21", Time: "09:00-11:00"},
+		{Name: "大学物理", Teacher: "王老师", Credit: "3.0", Location: "旗山西2-101", Date: "2026-06-22", Time: "14:00-16:00"},
+	}
+	newRooms := []*kitexModel.ExamRoomInfo{
+		{Name: "数据结构", Teacher: "张老师", Credit: "4.0", Location: "旗山东1-201", Date: "2026-06-20", Time: "09:00-11:00"},
+		{Name: "高等数学", Teacher: "李老师", Credit: "5.0", Location: "旗山东3-201", Date: "2026-06-21", Time: "09:00-11:00"},
+		{Name: "线性代数", Teacher: "赵老师", Credit: "3.0", Location: "旗山东1-305", Date: "2026-06-23", Time: "09:00-11:00"},
+	}
+
+	changes := buildExamRoomChanges("102301517", "202401", oldRooms, newRooms)
+
+	assert.Equal(t, []*model.ExamRoomChange{
+		{
+			StuId: "102301517", Term: "202401", Name: "大学物理", Teacher: "王老师", Credit: "3.0",
+			ChangeType:  model.ExamRoomChangeRemoved,
+			OldLocation: "旗山西2-101", OldDate: "2026-06-22", OldTime: "14:00-16:00",
+		},
+		{
+			StuId: "102301517", Term: "202401", Name: "数据结构", Teacher: "张老师", Credit: "4.0",
+			ChangeType:  model.ExamRoomChangeChanged,
+			OldLocation: "旗山东1-101", OldDate: "2026-06-20", OldTime: "09:00-11:00",
+			NewLocation: "旗山东1-201", NewDate: "2026-06-20", NewTime: "09:00-11:00",
+		},
+		{
+			StuId: "102301517", Term: "202401", Name: "线性代数", Teacher: "赵老师", Credit: "3.0",
+			ChangeType:  model.ExamRoomChangeAdded,
+			NewLocation: "旗山东1-305", NewDate: "2026-06-23", NewTime: "09:00-11:00",
+		},
+	}, changes)
+}
+
+func TestPutExamRoomSnapshot(t *testing.T) {
+	rooms := []*kitexModel.ExamRoomInfo{
+		{Name: "数据结构", Teacher: "张老师", Credit: "4.0", Location: "旗山东1-201", Date: "2026-06-20", Time: "09:00-11:00"},
+	}
+	roomsJSON, err := utils.JSONEncode(sortExamRooms(rooms))
+	assert.NoError(t, err)
+	roomsSHA256 := utils.SHA256(roomsJSON)
+	oldRooms := `[{"name":"数据结构","credit":"4.0","teacher":"张老师","location":"旗山东1-101","time":"09:00-11:00","date":"2026-06-20"}]`
+
+	type testCase struct {
+		name             string
+		snapshot         *model.ExamRoomSnapshot
+		getError         error
+		expectError      bool
+		expectCreate     bool
+		expectUpdate     bool
+		expectChangeSize int
+		expectEnqueue    int
+	}
+
+	testCases := []testCase{
+		{
+			name:         "first snapshot is baseline",
+			expectCreate: true,
+		},
+		{
+			name:     "unchanged snapshot is skipped",
+			snapshot: &model.ExamRoomSnapshot{Id: 1, Rooms: roomsJSON, RoomsSHA256: roomsSHA256},
+		},
+		{
+			name:             "changed room is recorded and pushed to the student",
+			snapshot:         &model.ExamRoomSnapshot{Id: 1, Rooms: oldRooms, RoomsSHA256: "old"},
+			expectUpdate:     true,
+			expectChangeSize: 1,
+			expectEnqueue:    1,
+		},
+		{
+			name:        "broken snapshot",
+			snapshot:    &model.ExamRoomSnapshot{Id: 1, Rooms: "{", RoomsSHA256: "old"},
+			expectError: true,
+		},
+		{
+			name:        "query error",
+			getError:    assert.AnError,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				SFClient:    new(utils.Snowflake),
+				DBClient:    new(db.Database),
+				CacheClient: new(cache.Cache),
+			}
+			mockey.Mock((*utils.Snowflake).NextVal).Return(int64(1), nil).Build()
+			mockey.Mock((*dbcourse.DBCourse).GetExamRoomSnapshot).Return(tc.snapshot, tc.getError).Build()
+			created := false
+			mockey.Mock((*dbcourse.DBCourse).CreateExamRoomSnapshot).
+				To(func(_ context.Context, snapshot *model.ExamRoomSnapshot) error {
+					created = true
+					assert.Equal(t, roomsSHA256, snapshot.RoomsSHA256)
+					return nil
+				}).Build()
+			updated := false
+			var recorded []*model.ExamRoomChange
+			mockey.Mock((*dbcourse.DBCourse).UpdateExamRoomSnapshot).
+				To(func(_ context.Context, snapshot *model.ExamRoomSnapshot, changes []*model.ExamRoomChange) error {
+					updated = true
+					recorded = changes
+					assert.Equal(t, roomsSHA256, snapshot.RoomsSHA256)
+					return nil
+				}).Build()
+			mockey.Mock((*dbuser.DBUser).ListNotificationPreferencesByStuIDs).Return(nil, nil).Build()
+			mockey.Mock((*dbuser.DBUser).ListDevicesByStuIDs).Return([]*model.Device{
+				{StuId: "102301517", DeviceToken: "token-a", Platform: constants.DevicePlatformAndroid},
+			}, nil).Build()
+			inboxCount := 0
+			mockey.Mock((*dbnotification.DBNotification).CreateNotifications).
+				To(func(_ context.Context, list []*model.Notification) error {
+					inboxCount += len(list)
+					return nil
+				}).Build()
+			enqueueCount := 0
+			mockey.Mock(umeng.Enqueue).To(func(_ context.Context, task *umeng.Task) error {
+				enqueueCount++
+				// 只推送到该学生登记的设备，不按课程 tag 广播
+				assert.Empty(t, task.Tags)
+				assert.Equal(t, []string{"token-a"}, task.DeviceTokens)
+				return nil
+			}).Build()
+
+			err := NewClassroomService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue)).
+				putExamRoomSnapshot("102301517", "202401", rooms)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectCreate, created)
+			assert.Equal(t, tc.expectUpdate, updated)
+			assert.Len(t, recorded, tc.expectChangeSize)
+			assert.Equal(t, tc.expectEnqueue, enqueueCount)
+			assert.Equal(t, tc.expectChangeSize, inboxCount)
+		})
+	}
+}
+
+func TestGetExamRoomInfoSnapshot(t *testing.T) {
+	type testCase struct {
+		name           string
+		mockSnapshot   *model.ExamRoomSnapshot
+		mockDBError    error
+		expectLen      int
+		expectNil      bool
+		expectError    string
+		expectQueueKey string
+	}
+
+	updatedAt := time.Date(2026, 6, 1, 8, 0, 0, 0, time.Local)
+	testCases := []testCase{
+		{
+			name:        "db error",
+			mockDBError: assert.AnError,
+			expectError: "service.GetExamRoomInfoSnapshot",
+		},
+		{
+			name:      "snapshot not exist",
+			expectNil: true,
+		},
+		{
+			name: "success",
+			mockSnapshot: &model.ExamRoomSnapshot{
+				StuId:     "102301517",
+				Term:      "202401",
+				Rooms:     `[{"name":"数据结构","teacher":"张老师","location":"旗山东1-101"}]`,
+				UpdatedAt: updatedAt,
+			},
+			expectLen:      1,
+			expectQueueKey: "refreshExamRoom:102301517:202401",
+		},
+		{
+			name:         "corrupted snapshot",
+			mockSnapshot: &model.ExamRoomSnapshot{Rooms: "["},
+			expectError:  "decode exam rooms failed",
+		},
+	}
+
+	mockLoginData := &kitexModel.LoginData{
+		Id:      "20241025133150102301517",
+		Cookies: "cookie1=value1; cookie2=value2",
+	}
+	req := &classroom.ExamRoomInfoRequest{Term: "202401"}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				SFClient:    new(utils.Snowflake),
+				DBClient:    new(db.Database),
+				CacheClient: new(cache.Cache),
+			}
+			mockey.Mock((*dbcourse.DBCourse).GetExamRoomSnapshot).Return(tc.mockSnapshot, tc.mockDBError).Build()
+			queueKey := ""
+			mockey.Mock((*taskqueue.BaseTaskQueue).Add).To(func(btq *taskqueue.BaseTaskQueue, key string, task taskqueue.QueueTask) {
+				queueKey = key
+			}).Build()
+
+			ctx := customContext.WithLoginData(context.Background(), mockLoginData)
+			classroomService := NewClassroomService(ctx, mockClientSet, new(taskqueue.BaseTaskQueue))
+			result, snapshotAt, err := classroomService.GetExamRoomInfoSnapshot(req, mockLoginData)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expectNil {
+				assert.Nil(t, result)
+				assert.Empty(t, queueKey)
+				return
+			}
+			assert.Len(t, result, tc.expectLen)
+			assert.Equal(t, updatedAt, snapshotAt)
+			assert.Equal(t, tc.expectQueueKey, queueKey)
+		})
+	}
+}
+
+func TestExamRoomNotification(t *testing.T) {
+	testCases := []struct {
+		name       string
+		changeType string
+		expectText string
+	}{
+		{name: "added", changeType: model.ExamRoomChangeAdded, expectText: "数据结构考场已公布"},
+		{name: "changed", changeType: model.ExamRoomChangeChanged, expectText: "数据结构考场信息已变更"},
+		{name: "removed", changeType: model.ExamRoomChangeRemoved, expectText: "数据结构考场信息已移除"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tag := examRoomTag("数据结构", "张老师", "4.0")
+			msg := examRoomNotification(&model.ExamRoomChange{Name: "数据结构", ChangeType: tc.changeType}, tag)
+
+			assert.Equal(t, tc.expectText, msg.Text)
+			assert.Equal(t, constants.UmengExamRoomDeeplink, msg.Deeplink)
+			assert.Empty(t, msg.Tags)
+			assert.True(t, msg.Unicast)
+		})
+	}
+}
@@ -18,12 +18,16 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/west2-online/fzuhelper-server/internal/classroom/pack"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
 	"github.com/west2-online/yjsy"
@@ -40,14 +44,20 @@ func (s *ClassroomService) GetExamRoomInfo(req *classroom.ExamRoomInfoRequest, l
 		return examRooms, nil
 	}
 
+	if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		return nil, fmt.Errorf("service.GetExamRoomInfo: %w", err)
+	}
 	stu := jwch.NewStudent().WithLoginData(loginData.Id, utils.ParseCookies(loginData.Cookies))
+	start := time.Now()
 	rawRooms, err := stu.GetExamRoom(jwch.ExamRoomReq{Term: req.Term})
+	metrics.ObserveUpstream(governor.HostJwch, "GetExamRoom", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return nil, fmt.Errorf("service.GetExamRoomInfo: Get exam room info fail %w", err)
 	}
 	modelRooms := pack.BuildExamRoomInfo(rawRooms)
 	if len(rawRooms) > 0 {
 		go s.cache.Classroom.SetExamRoom(s.ctx, key, modelRooms)
+		s.enqueueExamRoomSnapshot(context.ExtractIDFromLoginData(loginData), req.GetTerm(), modelRooms)
 	}
 	return modelRooms, nil
 }
@@ -63,12 +73,28 @@ func (s *ClassroomService) GetExamRoomInfoYjsy(req *classroom.ExamRoomInfoReques
 		return examRooms, nil
 	}
 
+	if err := governor.Acquire(s.ctx, governor.HostYjsy); err != nil {
+		return nil, fmt.Errorf("service.GetExamRoomInfoYjsy: %w", err)
+	}
 	stu := yjsy.NewStudent().WithLoginData(utils.ParseCookies(loginData.Cookies))
+	start := time.Now()
 	rawRooms, err := stu.GetExamRoom(yjsy.ExamRoomReq{Term: req.Term})
+	metrics.ObserveUpstream(governor.HostYjsy, "GetExamRoom", start)
 	if err = base.HandleYjsyError(err); err != nil {
 		return nil, fmt.Errorf("service.GetExamRoomInfo: Get exam room info fail %w", err)
 	}
 	modelRooms := pack.BuildExamRoomInfoYjsy(rawRooms)
 	go s.cache.Classroom.SetExamRoom(s.ctx, key, modelRooms)
+	if len(rawRooms) > 0 {
+		s.enqueueExamRoomSnapshot(context.ExtractIDFromLoginData(loginData), req.GetTerm(), modelRooms)
+	}
 	return modelRooms, nil
 }
+
+// enqueueExamRoomSnapshot 异步保存考场快照并比对变化
+// 空结果可能是教务处暂时异常，不参与比对，避免误判为考场被移除
+func (s *ClassroomService) enqueueExamRoomSnapshot(stuId, term string, rooms []*model.ExamRoomInfo) {
+	s.taskQueue.Add(fmt.Sprintf("putExamRoom:%s:%s", stuId, term), taskqueue.QueueTask{Execute: func() error {
+		return s.putExamRoomSnapshot(stuId, term, rooms)
+	}})
+}
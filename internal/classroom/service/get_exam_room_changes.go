@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/west2-online/fzuhelper-server/internal/classroom/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+// GetExamRoomChanges 返回学生某学期的考场变化记录，按发现时间倒序
+func (s *ClassroomService) GetExamRoomChanges(req *classroom.ExamRoomChangesRequest, loginData *model.LoginData) ([]*model.ExamRoomChange, error) {
+	stuId := context.ExtractIDFromLoginData(loginData)
+	changes, err := s.db.Course.ListExamRoomChanges(s.ctx, stuId, req.GetTerm(), constants.ExamRoomChangeListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("service.GetExamRoomChanges: %w", err)
+	}
+	return pack.BuildExamRoomChanges(changes), nil
+}
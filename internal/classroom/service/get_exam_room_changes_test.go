@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
+	kitexModel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	dbcourse "github.com/west2-online/fzuhelper-server/pkg/db/course"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+)
+
+func TestGetExamRoomChanges(t *testing.T) {
+	detectedAt := time.UnixMilli(1750000000000)
+
+	type testCase struct {
+		name         string
+		mockReturn   []*model.ExamRoomChange
+		mockError    error
+		expectResult []*kitexModel.ExamRoomChange
+		expectError  bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			mockReturn: []*model.ExamRoomChange{
+				{
+					Name: "数据结构", Teacher: "张老师", Credit: "4.0", ChangeType: model.ExamRoomChangeChanged,
+					OldLocation: "旗山东1-101", OldDate: "2026-06-20", OldTime: "09:00-11:00",
+					NewLocation: "旗山东1-201", NewDate: "2026-06-20", NewTime: "09:00-11:00",
+					CreatedAt: detectedAt,
+				},
+				{
+					Name: "线性代数", Teacher: "赵老师", Credit: "3.0", ChangeType: model.ExamRoomChangeAdded,
+					NewLocation: "旗山东1-305", NewDate: "2026-06-23", NewTime: "09:00-11:00",
+					CreatedAt: detectedAt,
+				},
+			},
+			expectResult: []*kitexModel.ExamRoomChange{
+				{
+					Name: "数据结构", Teacher: "张老师", Credit: "4.0", ChangeType: model.ExamRoomChangeChanged,
+					PrevLocation: new("旗山东1-101"), PrevDate: new("2026-06-20"), PrevTime: new("09:00-11:00"),
+					Location: new("旗山东1-201"), Date: new("2026-06-20"), Time: new("09:00-11:00"),
+					DetectedAt: detectedAt.UnixMilli(),
+				},
+				{
+					Name: "线性代数", Teacher: "赵老师", Credit: "3.0", ChangeType: model.ExamRoomChangeAdded,
+					Location: new("旗山东1-305"), Date: new("2026-06-23"), Time: new("09:00-11:00"),
+					DetectedAt: detectedAt.UnixMilli(),
+				},
+			},
+		},
+		{
+			name:         "empty",
+			mockReturn:   []*model.ExamRoomChange{},
+			expectResult: []*kitexModel.ExamRoomChange{},
+		},
+		{
+			name:        "database error",
+			mockError:   assert.AnError,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{DBClient: new(db.Database)}
+			mockey.Mock((*dbcourse.DBCourse).ListExamRoomChanges).Return(tc.mockReturn, tc.mockError).Build()
+
+			result, err := NewClassroomService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue)).
+				GetExamRoomChanges(&classroom.ExamRoomChangesRequest{Term: "202401"}, &kitexModel.LoginData{Id: "0123456789102301517"})
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectResult, result)
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	customContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
 	classroomCache "github.com/west2-online/fzuhelper-server/pkg/cache/classroom"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/jwch"
 	"github.com/west2-online/yjsy"
 )
@@ -97,6 +98,7 @@ func TestGetExamRoomInfo(t *testing.T) {
 			mockey.Mock((*classroomCache.CacheClassroom).GetExamRoom).Return(tc.expectResult, tc.cacheGetError).Build()
 			mockey.Mock((*jwch.Student).WithLoginData).Return(jwch.NewStudent()).Build()
 			mockey.Mock((*jwch.Student).GetExamRoom).Return(tc.mockReturn, tc.mockError).Build()
+			mockey.Mock((*taskqueue.BaseTaskQueue).Add).Return().Build()
 			// mock login data
 			loginData := &model.LoginData{
 				Id:      "123456789",
@@ -104,7 +106,7 @@ func TestGetExamRoomInfo(t *testing.T) {
 			}
 
 			ctx := customContext.WithLoginData(context.Background(), loginData)
-			classroomService := NewClassroomService(ctx, mockClientSet)
+			classroomService := NewClassroomService(ctx, mockClientSet, new(taskqueue.BaseTaskQueue))
 			result, err := classroomService.GetExamRoomInfo(req, loginData)
 
 			if tc.expectError {
@@ -182,6 +184,7 @@ func TestGetExamRoomInfoYjsy(t *testing.T) {
 			mockey.Mock((*classroomCache.CacheClassroom).GetExamRoom).Return(tc.expectResult, tc.cacheGetError).Build()
 			mockey.Mock((*yjsy.Student).WithLoginData).Return(yjsy.NewStudent()).Build()
 			mockey.Mock((*yjsy.Student).GetExamRoom).Return(tc.mockReturn, tc.mockError).Build()
+			mockey.Mock((*taskqueue.BaseTaskQueue).Add).Return().Build()
 			// mock login data
 			loginData := &model.LoginData{
 				Id:      "123456789",
@@ -189,7 +192,7 @@ func TestGetExamRoomInfoYjsy(t *testing.T) {
 			}
 
 			ctx := customContext.WithLoginData(context.Background(), loginData)
-			classroomService := NewClassroomService(ctx, mockClientSet)
+			classroomService := NewClassroomService(ctx, mockClientSet, new(taskqueue.BaseTaskQueue))
 			result, err := classroomService.GetExamRoomInfoYjsy(req, loginData)
 
 			if tc.expectError {
@@ -17,21 +17,114 @@ limitations under the License.
 package service
 
 import (
-	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 
+	"github.com/west2-online/fzuhelper-server/internal/classroom/meta"
+	"github.com/west2-online/fzuhelper-server/internal/classroom/pack"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
 )
 
-func (s *ClassroomService) GetEmptyRoom(req *classroom.EmptyRoomRequest) ([]string, error) {
+// GetEmptyRoom 返回在 [StartTime, EndTime] 节次内全部空闲的教室，即位图包含整个区间掩码的教室
+// 结果会用教室元数据补全，并按楼栋、最少容量过滤，可选按请求节次之后的连续空闲时长排序
+// 同时返回数据的同步状态，当天数据缺失时回退到前一天的数据，教室的占用规律通常相近
+func (s *ClassroomService) GetEmptyRoom(req *classroom.EmptyRoomRequest) ([]*model.Classroom, *model.EmptyRoomSyncStatus, error) {
+	startTime, err := strconv.Atoi(req.StartTime)
+	if err != nil {
+		return nil, nil, errno.ParamError.WithMessage("invalid start time")
+	}
+	endTime, err := strconv.Atoi(req.EndTime)
+	if err != nil {
+		return nil, nil, errno.ParamError.WithMessage("invalid end time")
+	}
+	if startTime < 1 || endTime > constants.ClassroomPeriods || startTime > endTime {
+		return nil, nil, errno.ParamError.WithMessage("invalid period range")
+	}
+	if req.GetSortBy() != "" && req.GetSortBy() != constants.ClassroomSortFreeLongest {
+		return nil, nil, errno.ParamError.WithMessage("invalid sort by")
+	}
+
 	// 从redis中获取数据
-	key := fmt.Sprintf("%s.%s.%s.%s", req.Date, req.Campus, req.StartTime, req.EndTime)
-	if ok := s.cache.IsKeyExist(s.ctx, key); !ok {
-		return nil, errors.New("service.GetEmptyRoom: room info not exist")
+	key, status, err := s.resolveEmptyRoomKey(req.Date, req.Campus)
+	if err != nil {
+		return nil, nil, err
 	}
-	emptyRoomList, err := s.cache.Classroom.GetEmptyRoomCache(s.ctx, key)
+	bitmaps, err := s.cache.Classroom.GetEmptyRoomBitmap(s.ctx, key)
 	if err != nil {
-		return nil, fmt.Errorf("service.GetEmptyRoom: Get room info failed: %w", err)
+		return nil, nil, fmt.Errorf("service.GetEmptyRoom: Get room info failed: %w", err)
+	}
+
+	catalog := meta.Default()
+	mask := periodMask(startTime, endTime)
+	rooms := make([]*model.Classroom, 0, len(bitmaps))
+	for raw, bitmap := range bitmaps {
+		if bitmap&mask != mask {
+			continue
+		}
+		room := pack.BuildClassroom(raw, req.Campus)
+		catalog.Enrich(room, req.Campus)
+		if req.GetBuild() != "" && room.Build != req.GetBuild() {
+			continue
+		}
+		if req.GetMinCapacity() > 0 {
+			capacity, err := strconv.ParseInt(room.Capacity, 10, 64)
+			if err != nil || capacity < req.GetMinCapacity() {
+				continue
+			}
+		}
+		freeUntil := freeUntilPeriod(bitmap, endTime)
+		room.FreeUntil = &freeUntil
+		rooms = append(rooms, room)
+	}
+
+	sort.Slice(rooms, func(i, j int) bool {
+		if req.GetSortBy() == constants.ClassroomSortFreeLongest && rooms[i].GetFreeUntil() != rooms[j].GetFreeUntil() {
+			return rooms[i].GetFreeUntil() > rooms[j].GetFreeUntil()
+		}
+		return rooms[i].Location < rooms[j].Location
+	})
+	return rooms, status, nil
+}
+
+// resolveEmptyRoomKey 返回实际使用的空教室数据 key 及其同步状态
+// 回退时状态中的数据日期、成功时间和失败节次来自前一天，尝试时间和错误仍描述请求日期的同步，便于排查数据缺失的原因
+func (s *ClassroomService) resolveEmptyRoomKey(date, campus string) (string, *model.EmptyRoomSyncStatus, error) {
+	status := s.getSyncStatus(date, campus)
+	key := emptyRoomKey(date, campus)
+	if ok := s.cache.IsKeyExist(s.ctx, key); ok {
+		return key, status, nil
+	}
+
+	requestDate, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return "", nil, errno.ParamError.WithMessage("invalid date")
+	}
+	prevDate := requestDate.AddDate(0, 0, -1).Format(time.DateOnly)
+	key = emptyRoomKey(prevDate, campus)
+	if ok := s.cache.IsKeyExist(s.ctx, key); !ok {
+		msg := "room info not exist"
+		if status.Error != nil {
+			msg += ", last sync error: " + status.GetError()
+		}
+		return "", nil, errno.NewErrNo(errno.BizNotExist, msg)
+	}
+	prevStatus := s.getSyncStatus(prevDate, campus)
+	prevStatus.LastAttempt = status.LastAttempt
+	prevStatus.Error = status.Error
+	prevStatus.Fallback = true
+	return key, prevStatus, nil
+}
+
+// freeUntilPeriod 返回教室从 end 节开始连续空闲到的最后一节
+func freeUntilPeriod(bitmap uint16, end int) int64 {
+	last := end
+	for last < constants.ClassroomPeriods && bitmap&periodBit(last+1) != 0 {
+		last++
 	}
-	return emptyRoomList, nil
+	return int64(last)
 }
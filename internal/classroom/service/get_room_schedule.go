@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/west2-online/fzuhelper-server/internal/classroom/meta"
+	"github.com/west2-online/fzuhelper-server/internal/classroom/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+)
+
+// roomCampusPrefixes 教务处返回的教室名以校区简称开头，据此确定教室所属校区
+var roomCampusPrefixes = []struct {
+	prefix string
+	campus string
+}{
+	{"旗山", "旗山校区"},
+	{"铜盘", "铜盘校区"},
+	{"怡山", "怡山校区"},
+	{"晋江", "晋江校区"},
+	{"泉港", "泉港校区"},
+	{"鼓浪屿", "鼓浪屿校区"},
+	{"集美", "集美校区"},
+}
+
+// GetRoomSchedule 返回单个教室在指定日期各节次的占用情况及数据的同步状态，数据来自空教室同步任务写入的位图与教室目录
+// 与空教室查询一致，当天数据缺失时回退使用前一天的数据
+func (s *ClassroomService) GetRoomSchedule(req *classroom.RoomScheduleRequest) (*model.RoomSchedule, *model.EmptyRoomSyncStatus, error) {
+	room := strings.TrimSpace(req.Room)
+	if room == "" {
+		return nil, nil, errno.ParamError.WithMessage("room is empty")
+	}
+	location, campus := resolveRoomCampus(room)
+
+	key, status, err := s.resolveEmptyRoomKey(req.Date, campus)
+	if err != nil {
+		return nil, nil, err
+	}
+	// 占用情况按实际使用的数据日期缓存，回退时与前一天的查询共用缓存
+	scheduleKey := fmt.Sprintf("roomschedule:%s:%s", status.Date, location)
+	if ok := s.cache.IsKeyExist(s.ctx, scheduleKey); ok {
+		schedule, err := s.cache.Classroom.GetRoomSchedule(s.ctx, scheduleKey)
+		if err == nil {
+			return schedule, status, nil
+		}
+		logger.Errorf("service.GetRoomSchedule: get room schedule cache failed, fallback to bitmap: %v", err)
+	}
+
+	bitmaps, err := s.cache.Classroom.GetEmptyRoomBitmap(s.ctx, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("service.GetRoomSchedule: Get room info failed: %w", err)
+	}
+	for raw, bitmap := range bitmaps {
+		info := pack.BuildClassroom(raw, campus)
+		if info.Location != location {
+			continue
+		}
+		return s.cacheRoomSchedule(scheduleKey, info, campus, status.Date, bitmap), status, nil
+	}
+
+	// 全天都被占用的教室不会出现在任何一节的空教室结果中，需要通过教室目录判断教室是否存在
+	raw, err := s.cache.Classroom.GetRoomDirectoryEntry(s.ctx, roomDirectoryKey(campus), location)
+	if err != nil {
+		return nil, nil, fmt.Errorf("service.GetRoomSchedule: Get room directory failed: %w", err)
+	}
+	if raw == "" {
+		return nil, nil, errno.NewErrNo(errno.BizNotExist, "room does not exist")
+	}
+	return s.cacheRoomSchedule(scheduleKey, pack.BuildClassroom(raw, campus), campus, status.Date, 0), status, nil
+}
+
+// cacheRoomSchedule 补全教室元数据并生成占用情况，随后交由任务队列写入缓存
+func (s *ClassroomService) cacheRoomSchedule(key string, info *model.Classroom, campus, date string, bitmap uint16) *model.RoomSchedule {
+	meta.Default().Enrich(info, campus)
+	schedule := buildRoomSchedule(info, date, bitmap)
+	ctx := context.WithoutCancel(s.ctx)
+	s.taskQueue.Add(key, taskqueue.QueueTask{Execute: func() error {
+		s.cache.Classroom.SetRoomSchedule(ctx, key, schedule)
+		return nil
+	}})
+	return schedule
+}
+
+// resolveRoomCampus 补全教室名并返回所属校区，旗山校区的教室常省略前缀，例 西3-206
+func resolveRoomCampus(room string) (location, campus string) {
+	for _, p := range roomCampusPrefixes {
+		if strings.HasPrefix(room, p.prefix) {
+			return room, p.campus
+		}
+	}
+	return "旗山" + room, "旗山校区"
+}
+
+func buildRoomSchedule(room *model.Classroom, date string, bitmap uint16) *model.RoomSchedule {
+	schedule := &model.RoomSchedule{
+		Room:            room,
+		Date:            date,
+		FreePeriods:     make([]int64, 0, constants.ClassroomPeriods),
+		OccupiedPeriods: make([]int64, 0, constants.ClassroomPeriods),
+	}
+	for p := 1; p <= constants.ClassroomPeriods; p++ {
+		if bitmap&periodBit(p) != 0 {
+			schedule.FreePeriods = append(schedule.FreePeriods, int64(p))
+		} else {
+			schedule.OccupiedPeriods = append(schedule.OccupiedPeriods, int64(p))
+		}
+	}
+	return schedule
+}
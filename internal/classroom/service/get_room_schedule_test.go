@@ -0,0 +1,217 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	classroomCache "github.com/west2-online/fzuhelper-server/pkg/cache/classroom"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+)
+
+func TestGetRoomSchedule(t *testing.T) {
+	type testCase struct {
+		name           string
+		room           string
+		scheduleCached bool
+		cachedSchedule *model.RoomSchedule
+		bitmapExist    bool // 请求日期的空教室数据是否存在
+		prevExist      bool // 前一天的空教室数据是否存在
+		bitmaps        map[string]uint16
+		bitmapError    error
+		directory      map[string]string // 教室目录，教室名 -> 原始信息
+		directoryError error
+		expectKey      string // 读取的空教室位图 key
+		expectLocation string
+		expectFree     []int64
+		expectOccupied []int64
+		expectDate     string
+		expectFallback bool
+		expectError    bool
+	}
+
+	tests := []testCase{
+		{
+			name:        "EmptyRoom",
+			room:        "  ",
+			expectError: true,
+		},
+		{
+			name:           "ScheduleCached",
+			room:           "旗山西3-206",
+			bitmapExist:    true,
+			scheduleCached: true,
+			cachedSchedule: &model.RoomSchedule{
+				Room:            &model.Classroom{Location: "旗山西3-206"},
+				FreePeriods:     []int64{1},
+				OccupiedPeriods: []int64{2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			},
+			expectLocation: "旗山西3-206",
+			expectFree:     []int64{1},
+			expectOccupied: []int64{2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		},
+		{
+			name:        "BitmapNotExist",
+			room:        "旗山西3-206",
+			expectError: true,
+		},
+		{
+			name:        "BitmapGetError",
+			room:        "旗山西3-206",
+			bitmapExist: true,
+			bitmapError: assert.AnError,
+			expectError: true,
+		},
+		{
+			// 省略旗山前缀
+			name:        "DeriveFromBitmap",
+			room:        "西3-206",
+			bitmapExist: true,
+			bitmaps: map[string]uint16{
+				"旗山西3-206 100(50) 多媒体": 0b00110000011,
+				"旗山西3-207 100(50) 多媒体": 0b11111111111,
+			},
+			expectKey:      "emptyroom:2024-10-01:旗山校区",
+			expectLocation: "旗山西3-206",
+			expectFree:     []int64{1, 2, 8, 9},
+			expectOccupied: []int64{3, 4, 5, 6, 7, 10, 11},
+		},
+		{
+			// 当天数据缺失时与空教室查询一样回退到前一天
+			name:      "FallbackToPreviousDay",
+			room:      "西3-206",
+			prevExist: true,
+			bitmaps: map[string]uint16{
+				"旗山西3-206 100(50) 多媒体": 0b11,
+			},
+			expectKey:      "emptyroom:2024-09-30:旗山校区",
+			expectLocation: "旗山西3-206",
+			expectFree:     []int64{1, 2},
+			expectOccupied: []int64{3, 4, 5, 6, 7, 8, 9, 10, 11},
+			expectDate:     "2024-09-30",
+			expectFallback: true,
+		},
+		{
+			name:        "OtherCampus",
+			room:        "晋江A102",
+			bitmapExist: true,
+			bitmaps: map[string]uint16{
+				"晋江A102 150(75) 多媒体": 0b11111111111,
+			},
+			expectKey:      "emptyroom:2024-10-01:晋江校区",
+			expectLocation: "晋江A102",
+			expectFree:     []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			expectOccupied: []int64{},
+		},
+		{
+			// 全天被占用的教室不在位图中，但出现在教室目录里
+			name:        "FullyBooked",
+			room:        "旗山西3-208",
+			bitmapExist: true,
+			bitmaps:     map[string]uint16{"旗山西3-206 100(50) 多媒体": 0b1},
+			directory: map[string]string{
+				"旗山西3-208": "旗山西3-208 120(60) 多媒体",
+			},
+			expectLocation: "旗山西3-208",
+			expectFree:     []int64{},
+			expectOccupied: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		},
+		{
+			name:           "DirectoryGetError",
+			room:           "旗山西3-208",
+			bitmapExist:    true,
+			bitmaps:        map[string]uint16{"旗山西3-206 100(50) 多媒体": 0b1},
+			directoryError: assert.AnError,
+			expectError:    true,
+		},
+		{
+			name:        "RoomNotFound",
+			room:        "旗山西3-999",
+			bitmapExist: true,
+			bitmaps:     map[string]uint16{"旗山西3-206 100(50) 多媒体": 0b1},
+			directory: map[string]string{
+				"旗山西3-208": "旗山西3-208 120(60) 多媒体",
+			},
+			expectError: true,
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range tests {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				CacheClient: new(cache.Cache),
+			}
+			var bitmapKey, directoryKey string
+			mockey.Mock((*cache.Cache).IsKeyExist).To(func(_ *cache.Cache, _ context.Context, key string) bool {
+				switch {
+				case strings.HasPrefix(key, "roomschedule:"):
+					return tc.scheduleCached
+				case strings.HasPrefix(key, "emptyroom:2024-09-30:"):
+					return tc.prevExist
+				default:
+					return tc.bitmapExist
+				}
+			}).Build()
+			mockey.Mock((*classroomCache.CacheClassroom).GetEmptyRoomSyncStatus).Return(nil, nil).Build()
+			mockey.Mock((*classroomCache.CacheClassroom).GetRoomSchedule).Return(tc.cachedSchedule, nil).Build()
+			mockey.Mock((*taskqueue.BaseTaskQueue).Add).Return().Build()
+			mockey.Mock((*classroomCache.CacheClassroom).GetEmptyRoomBitmap).To(
+				func(_ *classroomCache.CacheClassroom, _ context.Context, key string) (map[string]uint16, error) {
+					bitmapKey = key
+					return tc.bitmaps, tc.bitmapError
+				}).Build()
+			mockey.Mock((*classroomCache.CacheClassroom).GetRoomDirectoryEntry).To(
+				func(_ *classroomCache.CacheClassroom, _ context.Context, key, location string) (string, error) {
+					directoryKey = key
+					return tc.directory[location], tc.directoryError
+				}).Build()
+
+			classroomService := NewClassroomService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			result, status, err := classroomService.GetRoomSchedule(&classroom.RoomScheduleRequest{Room: tc.room, Date: "2024-10-01"})
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expectKey != "" {
+				assert.Equal(t, tc.expectKey, bitmapKey)
+			}
+			if tc.directory != nil {
+				assert.Equal(t, "emptyroom:rooms:旗山校区", directoryKey)
+			}
+			assert.Equal(t, tc.expectLocation, result.Room.Location)
+			assert.Equal(t, tc.expectFree, result.FreePeriods)
+			assert.Equal(t, tc.expectOccupied, result.OccupiedPeriods)
+			assert.Equal(t, tc.expectFallback, status.Fallback)
+			if tc.expectDate != "" {
+				assert.Equal(t, tc.expectDate, result.Date)
+				assert.Equal(t, tc.expectDate, status.Date)
+			}
+		})
+	}
+}
@@ -18,15 +18,19 @@ package service
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/bytedance/mockey"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/west2-online/fzuhelper-server/kitex_gen/classroom"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
 	classroomCache "github.com/west2-online/fzuhelper-server/pkg/cache/classroom"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 )
 
 func TestGetEmptyRoom(t *testing.T) {
@@ -34,8 +38,13 @@ func TestGetEmptyRoom(t *testing.T) {
 	type testCase struct {
 		name          string
 		mockIsExist   bool
-		mockReturn    []string
-		expectResult  []string
+		prevIsExist   bool                                  // 前一天的数据是否存在
+		statuses      map[string]*model.EmptyRoomSyncStatus // 同步状态 key -> 状态
+		expectStatus  *model.EmptyRoomSyncStatus
+		req           *classroom.EmptyRoomRequest
+		mockReturn    map[string]uint16
+		expectResult  []string // 按返回顺序排列的教室
+		expectFree    []int64  // 与 expectResult 对应的连续空闲截止节次
 		expectError   bool
 		cacheGetError error
 	}
@@ -46,11 +55,118 @@ func TestGetEmptyRoom(t *testing.T) {
 			name:        "RoomInfoNotExist",
 			expectError: true,
 		},
+		{
+			name:        "RoomInfoNotExistWithSyncError",
+			statuses:    map[string]*model.EmptyRoomSyncStatus{"emptyroom:status:2024-10-01:旗山校区": {Error: new("timeout")}},
+			expectError: true,
+		},
+		{
+			name:        "FallbackToPreviousDay",
+			prevIsExist: true,
+			mockReturn:  map[string]uint16{"旗山东1-103 0(0) 机房": 0b11},
+			statuses: map[string]*model.EmptyRoomSyncStatus{
+				"emptyroom:status:2024-10-01:旗山校区": {Date: "2024-10-01", LastAttempt: 200, Error: new("timeout"), FailedPeriods: []int64{}},
+				"emptyroom:status:2024-09-30:旗山校区": {Date: "2024-09-30", LastSuccess: 100, LastAttempt: 100, FailedPeriods: []int64{3}},
+			},
+			expectResult: []string{"旗山东1-103"},
+			expectFree:   []int64{2},
+			expectStatus: &model.EmptyRoomSyncStatus{
+				Date:          "2024-09-30",
+				LastSuccess:   100,
+				LastAttempt:   200,
+				Error:         new("timeout"),
+				FailedPeriods: []int64{3},
+				Fallback:      true,
+			},
+		},
+		{
+			name:        "SyncStatusReturned",
+			mockIsExist: true,
+			mockReturn:  map[string]uint16{"旗山东1-103 0(0) 机房": 0b11},
+			statuses: map[string]*model.EmptyRoomSyncStatus{
+				"emptyroom:status:2024-10-01:旗山校区": {Date: "2024-10-01", LastSuccess: 100, LastAttempt: 100, FailedPeriods: []int64{}},
+			},
+			expectResult: []string{"旗山东1-103"},
+			expectFree:   []int64{2},
+			expectStatus: &model.EmptyRoomSyncStatus{Date: "2024-10-01", LastSuccess: 100, LastAttempt: 100, FailedPeriods: []int64{}},
+		},
 		{
 			name:         "RoomInfoExist",
 			mockIsExist:  true,
-			mockReturn:   []string{"旗山东1"},
-			expectResult: []string{"旗山东1"},
+			mockReturn:   map[string]uint16{"旗山东1-103 0(0) 机房": 0b11},
+			expectResult: []string{"旗山东1-103"},
+			expectFree:   []int64{2},
+		},
+		{
+			name:        "RangeIntersection",
+			mockIsExist: true,
+			req: &classroom.EmptyRoomRequest{
+				Date:      "2024-10-01",
+				Campus:    "旗山校区",
+				StartTime: "3",
+				EndTime:   "4",
+			},
+			mockReturn: map[string]uint16{
+				"旗山东3-101 80(40) 多媒体":   0b1100,
+				"旗山东1-201 120(60) 多媒体":  0b11111111111,
+				"旗山西1-101 100(50) 多媒体":  0b0100,
+				"旗山西2-101 100(50) 智慧教室": 0b1000,
+			},
+			expectResult: []string{"旗山东1-201", "旗山东3-101"},
+			expectFree:   []int64{11, 4},
+		},
+		{
+			name:        "FilterAndSortByFreeLongest",
+			mockIsExist: true,
+			req: &classroom.EmptyRoomRequest{
+				Date:        "2024-10-01",
+				Campus:      "旗山校区",
+				StartTime:   "3",
+				EndTime:     "4",
+				Build:       new("西3"),
+				MinCapacity: new(int64(100)),
+				SortBy:      new(constants.ClassroomSortFreeLongest),
+			},
+			mockReturn: map[string]uint16{
+				"旗山西3-101 150(75) 多媒体": 0b00001111100,
+				"旗山西3-201 100(50) 多媒体": 0b11111111100,
+				"旗山西3-301 60(30) 多媒体":  0b11111111100,
+				"旗山西3-401 200(100) 机房": 0b00000011100,
+				"旗山东1-101 200(100) 机房": 0b11111111111,
+			},
+			expectResult: []string{"旗山西3-201", "旗山西3-101", "旗山西3-401"},
+			expectFree:   []int64{11, 7, 5},
+		},
+		{
+			name: "InvalidSortBy",
+			req: &classroom.EmptyRoomRequest{
+				Date:      "2024-10-01",
+				Campus:    "旗山校区",
+				StartTime: "1",
+				EndTime:   "2",
+				SortBy:    new("capacity"),
+			},
+			expectError: true,
+		},
+		{
+			name: "InvalidPeriodRange",
+			req: &classroom.EmptyRoomRequest{
+				Date:      "2024-10-01",
+				Campus:    "旗山校区",
+				StartTime: "5",
+				EndTime:   "12",
+			},
+			expectError: true,
+		},
+		{
+			name: "InvalidStartTime",
+			req: &classroom.EmptyRoomRequest{
+				Date:      "2024-10-01",
+				Campus:    "旗山校区",
+				StartTime: "a",
+				EndTime:   "2",
+			},
+			expectError: true,
 		},
 		{
 			name:          "CacheGetError",
@@ -61,7 +177,7 @@ func TestGetEmptyRoom(t *testing.T) {
 	}
 
 	// 通用请求参数
-	req := &classroom.EmptyRoomRequest{
+	defaultReq := &classroom.EmptyRoomRequest{
 		Date:      "2024-10-01",
 		Campus:    "旗山校区",
 		StartTime: "1",
@@ -77,21 +193,42 @@ func TestGetEmptyRoom(t *testing.T) {
 				CacheClient: new(cache.Cache),
 			}
 			// 根据测试用例设置 Mock 行为
-			mockey.Mock((*cache.Cache).IsKeyExist).Return(tc.mockIsExist).Build()
-			if tc.mockIsExist {
-				mockey.Mock((*classroomCache.CacheClassroom).GetEmptyRoomCache).Return(tc.mockReturn, tc.cacheGetError).Build()
-			}
+			mockey.Mock((*cache.Cache).IsKeyExist).To(func(_ *cache.Cache, _ context.Context, key string) bool {
+				if strings.Contains(key, "2024-09-30") {
+					return tc.prevIsExist
+				}
+				return tc.mockIsExist
+			}).Build()
+			mockey.Mock((*classroomCache.CacheClassroom).GetEmptyRoomBitmap).Return(tc.mockReturn, tc.cacheGetError).Build()
+			mockey.Mock((*classroomCache.CacheClassroom).GetEmptyRoomSyncStatus).To(
+				func(_ *classroomCache.CacheClassroom, _ context.Context, key string) (*model.EmptyRoomSyncStatus, error) {
+					return tc.statuses[key], nil
+				}).Build()
 
-			classroomService := NewClassroomService(context.Background(), mockClientSet)
+			req := defaultReq
+			if tc.req != nil {
+				req = tc.req
+			}
+			classroomService := NewClassroomService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
 			// 调用 GetEmptyRoom 方法
-			result, err := classroomService.GetEmptyRoom(req)
+			result, status, err := classroomService.GetEmptyRoom(req)
 
 			// 根据预期的错误存在与否进行断言
 			if tc.expectError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectResult, result)
+				locations := make([]string, 0, len(result))
+				freeUntil := make([]int64, 0, len(result))
+				for _, room := range result {
+					locations = append(locations, room.Location)
+					freeUntil = append(freeUntil, room.GetFreeUntil())
+				}
+				assert.Equal(t, tc.expectResult, locations)
+				assert.Equal(t, tc.expectFree, freeUntil)
+				if tc.expectStatus != nil {
+					assert.Equal(t, tc.expectStatus, status)
+				}
 			}
 		})
 	}
@@ -21,16 +21,28 @@ import (
 
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
 
 type ClassroomService struct {
-	ctx   context.Context
-	cache *cache.Cache
+	ctx       context.Context
+	cache     *cache.Cache
+	db        *db.Database
+	sf        *utils.Snowflake
+	taskQueue taskqueue.TaskQueue
+	notifier  notification.Notifier
 }
 
-func NewClassroomService(ctx context.Context, clientset *base.ClientSet) *ClassroomService {
+func NewClassroomService(ctx context.Context, clientset *base.ClientSet, taskQueue taskqueue.TaskQueue) *ClassroomService {
 	return &ClassroomService{
-		ctx:   ctx,
-		cache: clientset.CacheClient,
+		ctx:       ctx,
+		cache:     clientset.CacheClient,
+		db:        clientset.DBClient,
+		sf:        clientset.SFClient,
+		taskQueue: taskQueue,
+		notifier:  notification.New(clientset.DBClient),
 	}
 }
@@ -0,0 +1,225 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+	"github.com/west2-online/jwch"
+)
+
+// SyncEmptyRoom 使用已登录的 stu 同步指定日期各校区的空教室
+// 每个校区只按单节拉取 11 次，合并为每个教室的节次位图后写入缓存，任意节次区间在查询时通过位运算求交得到
+// 单个校区失败不影响其余校区，只有存在校区完全同步失败时才返回错误
+func (s *ClassroomService) SyncEmptyRoom(stu *jwch.Student, date time.Time) error {
+	currentDate := date.Format(time.DateOnly)
+	var errs []error
+	for _, campus := range constants.CampusArray {
+		if err := s.syncCampusEmptyRoom(stu, campus, currentDate); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("service.SyncEmptyRoom: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// syncCampusEmptyRoom 同步单个校区的空教室并记录同步状态
+// 重试后仍失败的节次记入同步状态，其余节次照常写入，失败节次的教室按占用处理；
+// 所有节次都失败时保留原有数据，只更新同步状态中的尝试时间和错误
+func (s *ClassroomService) syncCampusEmptyRoom(stu *jwch.Student, campus, date string) error {
+	attemptTime := time.Now().UnixMilli()
+	// 子校区 -> 教室 -> 位图，预先放入子校区保证没有空教室时也会写入缓存
+	bitmaps := make(map[string]map[string]uint16)
+	for _, sub := range subCampuses(campus) {
+		bitmaps[sub] = make(map[string]uint16)
+	}
+	failedPeriods := make([]int64, 0)
+	var lastErr error
+	for period := 1; period <= constants.ClassroomPeriods; period++ {
+		rooms, err := s.fetchEmptyRoomWithRetry(stu, campus, date, period)
+		if err != nil {
+			logger.Errorf("service.SyncEmptyRoom: get empty room of %s %s period %d failed: %v", date, campus, period, err)
+			failedPeriods = append(failedPeriods, int64(period))
+			lastErr = err
+			continue
+		}
+		for _, room := range rooms {
+			sub := roomSubCampus(campus, room)
+			if _, ok := bitmaps[sub]; !ok {
+				continue
+			}
+			bitmaps[sub][room] |= periodBit(period)
+		}
+	}
+	synced := len(failedPeriods) < constants.ClassroomPeriods
+
+	var errs []error
+	if !synced {
+		errs = append(errs, fmt.Errorf("all periods of %s failed: %w", campus, lastErr))
+	}
+	for sub, rooms := range bitmaps {
+		status := s.getSyncStatus(date, sub)
+		status.LastAttempt = attemptTime
+		status.Error = nil
+		if lastErr != nil {
+			status.Error = new(lastErr.Error())
+		}
+		if synced {
+			if err := s.cache.Classroom.SetEmptyRoomBitmap(s.ctx, emptyRoomKey(date, sub), rooms); err != nil {
+				errs = append(errs, fmt.Errorf("set empty room bitmap of %s failed: %w", sub, err))
+				status.Error = new(err.Error())
+			} else {
+				// 失败节次描述的是当前缓存中的数据，因此只在写入新数据时更新
+				status.LastSuccess = time.Now().UnixMilli()
+				status.FailedPeriods = failedPeriods
+			}
+			if err := s.cache.Classroom.AddRoomDirectory(s.ctx, roomDirectoryKey(sub), roomDirectory(rooms)); err != nil {
+				errs = append(errs, fmt.Errorf("add room directory of %s failed: %w", sub, err))
+			}
+		}
+		if err := s.cache.Classroom.SetEmptyRoomSyncStatus(s.ctx, syncStatusKey(date, sub), status); err != nil {
+			errs = append(errs, fmt.Errorf("set sync status of %s failed: %w", sub, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getSyncStatus 读取同步状态，不存在或读取失败时返回未知状态
+func (s *ClassroomService) getSyncStatus(date, campus string) *model.EmptyRoomSyncStatus {
+	status, err := s.cache.Classroom.GetEmptyRoomSyncStatus(s.ctx, syncStatusKey(date, campus))
+	if err != nil {
+		logger.Errorf("service.getSyncStatus: get sync status of %s %s failed: %v", date, campus, err)
+	}
+	if status == nil {
+		status = &model.EmptyRoomSyncStatus{Date: date, FailedPeriods: make([]int64, 0)}
+	}
+	return status
+}
+
+// fetchEmptyRoomWithRetry 获取某一节的空教室，失败时按指数退避重试
+func (s *ClassroomService) fetchEmptyRoomWithRetry(stu *jwch.Student, campus, date string, period int) ([]string, error) {
+	var err error
+	delay := constants.ClassroomSyncRetryDelay
+	for attempt := 1; attempt <= constants.ClassroomSyncMaxAttempts; attempt++ {
+		var rooms []string
+		if rooms, err = s.fetchEmptyRoom(stu, campus, date, period); err == nil {
+			return rooms, nil
+		}
+		if attempt < constants.ClassroomSyncMaxAttempts {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+	return nil, fmt.Errorf("failed after %d attempts: %w", constants.ClassroomSyncMaxAttempts, err)
+}
+
+// fetchEmptyRoom 从教务处获取某一节的空教室
+func (s *ClassroomService) fetchEmptyRoom(stu *jwch.Student, campus, date string, period int) ([]string, error) {
+	if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		return nil, err
+	}
+	args := jwch.EmptyRoomReq{
+		Campus: campus,
+		Time:   date,
+		Start:  strconv.Itoa(period),
+		End:    strconv.Itoa(period),
+	}
+	var rooms []string
+	var err error
+	start := time.Now()
+	switch campus {
+	case "旗山校区":
+		rooms, err = stu.GetQiShanEmptyRoom(args)
+	default:
+		rooms, err = stu.GetEmptyRoom(args)
+	}
+	metrics.ObserveUpstream(governor.HostJwch, "GetEmptyRoom", start)
+	if err = base.HandleJwchError(err); err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+// subCampuses 返回教务处校区对外提供查询的校区
+func subCampuses(campus string) []string {
+	if campus == "厦门工艺美院" {
+		return constants.XiaMenSubCampusArray
+	}
+	return []string{campus}
+}
+
+// roomSubCampus 返回教室所属的对外校区，厦门工艺美院按教室名称拆分为鼓浪屿和集美
+func roomSubCampus(campus, room string) string {
+	if campus != "厦门工艺美院" {
+		return campus
+	}
+	for _, sub := range constants.XiaMenSubCampusArray {
+		if strings.Contains(room, strings.TrimSuffix(sub, "校区")) {
+			return sub
+		}
+	}
+	return ""
+}
+
+// periodBit 返回第 period 节在位图中对应的位
+func periodBit(period int) uint16 {
+	return 1 << (period - 1)
+}
+
+// periodMask 返回 [start, end] 节次区间对应的掩码
+func periodMask(start, end int) uint16 {
+	var mask uint16
+	for p := start; p <= end; p++ {
+		mask |= periodBit(p)
+	}
+	return mask
+}
+
+// roomDirectory 将教务处返回的原始教室信息按教室名索引
+func roomDirectory(bitmaps map[string]uint16) map[string]string {
+	rooms := make(map[string]string, len(bitmaps))
+	for raw := range bitmaps {
+		if fields := strings.Fields(raw); len(fields) > 0 {
+			rooms[fields[0]] = raw
+		}
+	}
+	return rooms
+}
+
+func emptyRoomKey(date, campus string) string {
+	return fmt.Sprintf("emptyroom:%s:%s", date, campus)
+}
+
+func syncStatusKey(date, campus string) string {
+	return fmt.Sprintf("emptyroom:status:%s:%s", date, campus)
+}
+
+func roomDirectoryKey(campus string) string {
+	return fmt.Sprintf("emptyroom:rooms:%s", campus)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	classroomCache "github.com/west2-online/fzuhelper-server/pkg/cache/classroom"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/jwch"
+)
+
+func TestSyncEmptyRoom(t *testing.T) {
+	type testCase struct {
+		name string
+		// 旗山校区第 failPeriod 节前 failTimes 次请求失败
+		failPeriod     string
+		failTimes      int
+		setError       error
+		expectError    bool
+		expectCalls    int
+		expectQiShan   map[string]uint16 // 写入的旗山校区位图，nil 表示未写入
+		expectFailed   []int64           // 旗山校区同步状态中的失败节次
+		expectErrorMsg bool              // 旗山校区同步状态中是否记录了错误
+		keepDirectory  bool              // 旗山校区的教室目录是否保持不变
+	}
+
+	allFree := uint16(0b11111111111)
+	tests := []testCase{
+		{
+			name:         "Success",
+			expectCalls:  66,
+			expectQiShan: map[string]uint16{"旗山东1-101": 0b11, "旗山西1-101": allFree},
+			expectFailed: []int64{},
+		},
+		{
+			name:         "TransientErrorRetried",
+			failPeriod:   "3",
+			failTimes:    2,
+			expectCalls:  68,
+			expectQiShan: map[string]uint16{"旗山东1-101": 0b11, "旗山西1-101": allFree},
+			expectFailed: []int64{},
+		},
+		{
+			// 第 3 节重试后仍失败，其余节次照常写入，第 3 节按占用处理
+			name:           "PartialFailure",
+			failPeriod:     "3",
+			failTimes:      3,
+			expectCalls:    68,
+			expectQiShan:   map[string]uint16{"旗山东1-101": 0b11, "旗山西1-101": allFree &^ 0b100},
+			expectFailed:   []int64{3},
+			expectErrorMsg: true,
+		},
+		{
+			// 所有节次都失败时保留原有数据，其余校区不受影响
+			name:           "CampusFailed",
+			failPeriod:     "*",
+			failTimes:      3,
+			expectError:    true,
+			expectCalls:    88,
+			expectFailed:   []int64{},
+			expectErrorMsg: true,
+			keepDirectory:  true,
+		},
+		{
+			name:           "SetCacheFailed",
+			setError:       assert.AnError,
+			expectError:    true,
+			expectCalls:    66,
+			expectFailed:   []int64{},
+			expectErrorMsg: true,
+		},
+	}
+
+	date := time.Date(2024, 10, 1, 0, 0, 0, 0, time.Local)
+
+	defer mockey.UnPatchAll()
+	for _, tc := range tests {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				CacheClient: new(cache.Cache),
+			}
+			calls := 0
+			failures := make(map[string]int)
+			saved := make(map[string]map[string]uint16)
+			statuses := make(map[string]*model.EmptyRoomSyncStatus)
+			directories := make(map[string]map[string]string)
+			mockey.Mock(time.Sleep).Return().Build()
+			mockey.Mock((*jwch.Student).GetQiShanEmptyRoom).To(func(_ *jwch.Student, req jwch.EmptyRoomReq) ([]string, error) {
+				calls++
+				if (tc.failPeriod == "*" || tc.failPeriod == req.Start) && failures[req.Start] < tc.failTimes {
+					failures[req.Start]++
+					return nil, assert.AnError
+				}
+				if req.Start == "1" || req.Start == "2" {
+					return []string{"旗山东1-101", "旗山西1-101"}, nil
+				}
+				return []string{"旗山西1-101"}, nil
+			}).Build()
+			mockey.Mock((*jwch.Student).GetEmptyRoom).To(func(_ *jwch.Student, req jwch.EmptyRoomReq) ([]string, error) {
+				calls++
+				if req.Campus == "厦门工艺美院" {
+					return []string{"鼓浪屿1-101", "集美1-101"}, nil
+				}
+				return nil, nil
+			}).Build()
+			mockey.Mock((*classroomCache.CacheClassroom).SetEmptyRoomBitmap).To(
+				func(_ *classroomCache.CacheClassroom, _ context.Context, key string, bitmaps map[string]uint16) error {
+					if tc.setError != nil {
+						return tc.setError
+					}
+					saved[key] = bitmaps
+					return nil
+				}).Build()
+			mockey.Mock((*classroomCache.CacheClassroom).AddRoomDirectory).To(
+				func(_ *classroomCache.CacheClassroom, _ context.Context, key string, rooms map[string]string) error {
+					directories[key] = rooms
+					return nil
+				}).Build()
+			mockey.Mock((*classroomCache.CacheClassroom).GetEmptyRoomSyncStatus).Return(nil, nil).Build()
+			mockey.Mock((*classroomCache.CacheClassroom).SetEmptyRoomSyncStatus).To(
+				func(_ *classroomCache.CacheClassroom, _ context.Context, key string, status *model.EmptyRoomSyncStatus) error {
+					statuses[key] = status
+					return nil
+				}).Build()
+
+			classroomService := NewClassroomService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			err := classroomService.SyncEmptyRoom(jwch.NewStudent(), date)
+
+			assert.Equal(t, tc.expectCalls, calls)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			// 每个对外校区都会记录同步状态
+			assert.Len(t, statuses, 7)
+			for key := range statuses {
+				assert.True(t, strings.HasPrefix(key, "emptyroom:status:2024-10-01:"))
+			}
+
+			qiShan := statuses["emptyroom:status:2024-10-01:旗山校区"]
+			assert.Equal(t, tc.expectFailed, qiShan.FailedPeriods)
+			assert.Equal(t, tc.expectErrorMsg, qiShan.Error != nil)
+			assert.NotZero(t, qiShan.LastAttempt)
+			assert.Equal(t, tc.expectQiShan, saved["emptyroom:2024-10-01:旗山校区"])
+			if tc.expectQiShan != nil {
+				assert.NotZero(t, qiShan.LastSuccess)
+				assert.Equal(t, map[string]uint16{"鼓浪屿1-101": allFree}, saved["emptyroom:2024-10-01:鼓浪屿校区"])
+				assert.Equal(t, map[string]uint16{"集美1-101": allFree}, saved["emptyroom:2024-10-01:集美校区"])
+				assert.Equal(t, map[string]uint16{}, saved["emptyroom:2024-10-01:泉港校区"])
+				assert.Equal(t, map[string]string{"集美1-101": "集美1-101"}, directories["emptyroom:rooms:集美校区"])
+			} else {
+				assert.Zero(t, qiShan.LastSuccess)
+			}
+			// 同步成功时记录出现过的教室，全部节次失败时不更新目录
+			if !tc.keepDirectory {
+				assert.Equal(t, map[string]string{"旗山东1-101": "旗山东1-101", "旗山西1-101": "旗山西1-101"},
+					directories["emptyroom:rooms:旗山校区"])
+			} else {
+				assert.NotContains(t, directories, "emptyroom:rooms:旗山校区")
+			}
+		})
+	}
+}
@@ -23,7 +23,9 @@ import (
 	"github.com/west2-online/fzuhelper-server/internal/common/pack"
 	"github.com/west2-online/fzuhelper-server/internal/common/service"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/common"
+	kitexModel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
+	metainfoContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/db/model"
 	"github.com/west2-online/fzuhelper-server/pkg/db/toolbox"
@@ -143,9 +145,9 @@ func (s *CommonServiceImpl) GetTerm(ctx context.Context, req *common.TermRequest
 
 func (s *CommonServiceImpl) GetNotices(ctx context.Context, req *common.NoticeRequest) (resp *common.NoticeResponse, err error) {
 	resp = new(common.NoticeResponse)
-	key := singleflight.Key(constants.SingleflightNoticePrefix, req.PageNum)
+	key := singleflight.Key(constants.SingleflightNoticePrefix, req.GetSource(), req.PageNum)
 	result, err := singleflight.Do(key, func() (noticeResult, error) {
-		list, total, err := service.NewCommonService(ctx, s.ClientSet, s.taskQueue).GetNotice(int(req.PageNum))
+		list, total, err := service.NewCommonService(ctx, s.ClientSet, s.taskQueue).GetNotice(int(req.PageNum), req.GetSource())
 		if err != nil {
 			return noticeResult{}, err
 		}
@@ -161,6 +163,161 @@ func (s *CommonServiceImpl) GetNotices(ctx context.Context, req *common.NoticeRe
 	return resp, err
 }
 
+// ListNoticeSources 列出已启用的通知来源
+func (s *CommonServiceImpl) ListNoticeSources(ctx context.Context, _ *common.ListNoticeSourcesRequest) (resp *common.ListNoticeSourcesResponse, err error) {
+	resp = new(common.ListNoticeSourcesResponse)
+	sources := service.NewCommonService(ctx, s.ClientSet, s.taskQueue).ListNoticeSources()
+	resp.Base = base.BuildSuccessResp()
+	resp.Sources = pack.BuildNoticeSources(sources)
+	return resp, nil
+}
+
+// SearchNotices 全文检索教务处通知
+func (s *CommonServiceImpl) SearchNotices(ctx context.Context, req *common.SearchNoticesRequest) (resp *common.SearchNoticesResponse, err error) {
+	resp = new(common.SearchNoticesResponse)
+	results, total, err := service.NewCommonService(ctx, s.ClientSet, s.taskQueue).SearchNotices(req)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	resp.Results = results
+	resp.Total = total
+	return resp, nil
+}
+
+// GetNoticeDetail 获取清洗后的通知正文和附件
+func (s *CommonServiceImpl) GetNoticeDetail(ctx context.Context, req *common.GetNoticeDetailRequest) (resp *common.GetNoticeDetailResponse, err error) {
+	resp = new(common.GetNoticeDetailResponse)
+	key := singleflight.Key(constants.SingleflightNoticeDetailPrefix, req.Url)
+	detail, err := singleflight.Do(key, func() (*kitexModel.NoticeDetail, error) {
+		return service.NewCommonService(ctx, s.ClientSet, s.taskQueue).GetNoticeDetail(req.Url)
+	})
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	resp.Detail = detail
+	return resp, nil
+}
+
+// ListNoticeSubscriptions 列出当前用户订阅的通知关键词
+func (s *CommonServiceImpl) ListNoticeSubscriptions(ctx context.Context,
+	_ *common.ListNoticeSubscriptionsRequest,
+) (resp *common.ListNoticeSubscriptionsResponse, err error) {
+	resp = new(common.ListNoticeSubscriptionsResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	subs, err := service.NewCommonService(ctx, s.ClientSet, s.taskQueue).
+		ListNoticeSubscriptions(metainfoContext.ExtractIDFromLoginData(loginData))
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	resp.Subscriptions = pack.BuildNoticeSubscriptions(subs)
+	return resp, nil
+}
+
+// SubscribeNotice 订阅通知关键词
+func (s *CommonServiceImpl) SubscribeNotice(ctx context.Context, req *common.SubscribeNoticeRequest) (resp *common.SubscribeNoticeResponse, err error) {
+	resp = new(common.SubscribeNoticeResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	sub, err := service.NewCommonService(ctx, s.ClientSet, s.taskQueue).
+		SubscribeNotice(metainfoContext.ExtractIDFromLoginData(loginData), req.Keyword)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	resp.Subscription = pack.BuildNoticeSubscription(sub)
+	return resp, nil
+}
+
+// UnsubscribeNotice 取消订阅通知关键词
+func (s *CommonServiceImpl) UnsubscribeNotice(ctx context.Context, req *common.UnsubscribeNoticeRequest) (resp *common.UnsubscribeNoticeResponse, err error) {
+	resp = new(common.UnsubscribeNoticeResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	err = service.NewCommonService(ctx, s.ClientSet, s.taskQueue).
+		UnsubscribeNotice(metainfoContext.ExtractIDFromLoginData(loginData), req.Keyword)
+	resp.Base = base.BuildBaseResp(err)
+	return resp, nil
+}
+
+// ListNotifications 分页列出当前用户的通知
+func (s *CommonServiceImpl) ListNotifications(ctx context.Context, req *common.ListNotificationsRequest) (resp *common.ListNotificationsResponse, err error) {
+	resp = new(common.ListNotificationsResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	notifications, total, err := service.NewCommonService(ctx, s.ClientSet, s.taskQueue).
+		ListNotifications(metainfoContext.ExtractIDFromLoginData(loginData), req)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	resp.Notifications = pack.BuildNotifications(notifications)
+	resp.Total = total
+	return resp, nil
+}
+
+// MarkNotificationsRead 标记当前用户的通知为已读
+func (s *CommonServiceImpl) MarkNotificationsRead(ctx context.Context,
+	req *common.MarkNotificationsReadRequest,
+) (resp *common.MarkNotificationsReadResponse, err error) {
+	resp = new(common.MarkNotificationsReadResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	marked, err := service.NewCommonService(ctx, s.ClientSet, s.taskQueue).
+		MarkNotificationsRead(metainfoContext.ExtractIDFromLoginData(loginData), req.Ids)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	resp.Marked = marked
+	return resp, nil
+}
+
+// GetUnreadNotificationCount 获取当前用户的未读通知数量
+func (s *CommonServiceImpl) GetUnreadNotificationCount(ctx context.Context,
+	_ *common.GetUnreadNotificationCountRequest,
+) (resp *common.GetUnreadNotificationCountResponse, err error) {
+	resp = new(common.GetUnreadNotificationCountResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	count, err := service.NewCommonService(ctx, s.ClientSet, s.taskQueue).
+		GetUnreadNotificationCount(metainfoContext.ExtractIDFromLoginData(loginData))
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	resp.Count = count
+	return resp, nil
+}
+
 func (s *CommonServiceImpl) GetContributorInfo(ctx context.Context,
 	_ *common.GetContributorInfoRequest,
 ) (resp *common.GetContributorInfoResponse, err error) {
@@ -19,15 +19,87 @@ package pack
 import (
 	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	db "github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/noticesource"
+	"github.com/west2-online/fzuhelper-server/pkg/umeng"
 )
 
 func BuildNoticeList(notices []db.Notice) []*model.NoticeInfo {
 	list := make([]*model.NoticeInfo, len(notices))
 	for i, notice := range notices {
 		list[i] = &model.NoticeInfo{
-			Title: &notice.Title,
-			Url:   &notice.URL,
-			Date:  &notice.PublishedAt,
+			Title:  &notice.Title,
+			Url:    &notice.URL,
+			Date:   &notice.PublishedAt,
+			Source: &notice.Source,
+		}
+	}
+	return list
+}
+
+func BuildNoticeSources(sources []noticesource.NoticeSource) []*model.NoticeSource {
+	list := make([]*model.NoticeSource, len(sources))
+	for i, src := range sources {
+		list[i] = &model.NoticeSource{
+			Name:        src.Name(),
+			DisplayName: src.DisplayName(),
+		}
+	}
+	return list
+}
+
+func BuildNoticeSubscription(sub *db.NoticeSubscription) *model.NoticeSubscription {
+	return &model.NoticeSubscription{
+		Keyword: sub.Keyword,
+		Tag:     umeng.NoticeSubscriptionTag(sub.Keyword),
+	}
+}
+
+func BuildNoticeSubscriptions(subs []*db.NoticeSubscription) []*model.NoticeSubscription {
+	list := make([]*model.NoticeSubscription, len(subs))
+	for i, sub := range subs {
+		list[i] = BuildNoticeSubscription(sub)
+	}
+	return list
+}
+
+func BuildNoticeAttachments(attachments []*db.NoticeAttachment) []*model.NoticeAttachment {
+	list := make([]*model.NoticeAttachment, len(attachments))
+	for i, attachment := range attachments {
+		list[i] = &model.NoticeAttachment{
+			Name:     attachment.Name,
+			Url:      attachment.SourceURL,
+			Mirrored: attachment.MirrorURL != "",
+		}
+		if attachment.MirrorURL != "" {
+			list[i].Url = attachment.MirrorURL
+		}
+	}
+	return list
+}
+
+func BuildNoticeDetail(notice *db.Notice, attachments []*db.NoticeAttachment) *model.NoticeDetail {
+	return &model.NoticeDetail{
+		Title:       notice.Title,
+		Url:         notice.URL,
+		Date:        notice.PublishedAt,
+		Content:     notice.Html,
+		Attachments: BuildNoticeAttachments(attachments),
+	}
+}
+
+func BuildNotifications(notifications []*db.Notification) []*model.Notification {
+	list := make([]*model.Notification, len(notifications))
+	for i, notification := range notifications {
+		list[i] = &model.Notification{
+			Id:        notification.Id,
+			Type:      notification.Type,
+			Title:     notification.Title,
+			Content:   notification.Content,
+			IsRead:    notification.ReadAt != nil,
+			CreatedAt: notification.CreatedAt.UnixMilli(),
+		}
+		if notification.Deeplink != "" {
+			list[i].Deeplink = &notification.Deeplink
 		}
 	}
 	return list
@@ -19,16 +19,33 @@ package service
 import (
 	"fmt"
 
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/db/model"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/noticesource"
 	"github.com/west2-online/jwch"
 )
 
-func (s *CommonService) GetNotice(pageNum int) (list []model.Notice, total int, err error) {
-	list, err = s.db.Notice.GetNoticeByPage(s.ctx, pageNum)
+// GetNotice 按来源分页获取通知，source 为空时为教务处
+func (s *CommonService) GetNotice(pageNum int, source string) (list []model.Notice, total int, err error) {
+	if source == "" {
+		source = constants.NoticeSourceJwch
+	}
+	if _, ok := noticesource.Get(source); !ok && source != constants.NoticeSourceJwch {
+		return nil, 0, errno.ParamError.WithMessage("unknown notice source")
+	}
+	list, err = s.db.Notice.GetNoticeByPage(s.ctx, source, pageNum)
 	if err != nil {
 		return nil, 0, fmt.Errorf("CommonService.GetNotice get notice from database:%w", err)
 	}
+	if source != constants.NoticeSourceJwch {
+		// 其他来源只同步列表第一页，总页数以数据库中已入库的数量为准
+		count, err := s.db.Notice.CountNotice(s.ctx, source)
+		if err != nil {
+			return nil, 0, fmt.Errorf("CommonService.GetNotice count notice:%w", err)
+		}
+		return list, int((count + constants.NoticePageSize - 1) / constants.NoticePageSize), nil
+	}
 	// 爬取总页数
 	_, total, err = jwch.NewStudent().GetNoticeInfo(&jwch.NoticeInfoReq{PageNum: 1})
 	if err != nil {
@@ -36,3 +53,8 @@ func (s *CommonService) GetNotice(pageNum int) (list []model.Notice, total int,
 	}
 	return list, total, nil
 }
+
+// ListNoticeSources 按注册顺序返回已启用的通知来源
+func (s *CommonService) ListNoticeSources() []noticesource.NoticeSource {
+	return noticesource.All()
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/west2-online/fzuhelper-server/internal/common/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+// GetNoticeDetail 返回清洗后的通知正文和附件下载地址，正文由同步任务预先抓取
+func (s *CommonService) GetNoticeDetail(url string) (*model.NoticeDetail, error) {
+	url = strings.TrimSpace(url)
+	if url == "" {
+		return nil, errno.ParamError.WithMessage("url is empty")
+	}
+
+	key := s.cache.Common.NoticeDetailKey(url)
+	if ok := s.cache.IsKeyExist(s.ctx, key); ok {
+		detail, err := s.cache.Common.GetNoticeDetail(s.ctx, key)
+		if err == nil {
+			return detail, nil
+		}
+		logger.Errorf("service.GetNoticeDetail: get notice detail cache failed, fallback to db: %v", err)
+	}
+
+	notice, err := s.db.Notice.GetNoticeByURL(s.ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("service.GetNoticeDetail: %w", err)
+	}
+	if notice == nil {
+		return nil, errno.NewErrNo(errno.BizNotExist, "notice not found")
+	}
+	if notice.Html == "" {
+		return nil, errno.NewErrNo(errno.BizNotExist, "notice detail is not synced yet")
+	}
+	attachments, err := s.db.Notice.ListNoticeAttachments(s.ctx, notice.Id)
+	if err != nil {
+		return nil, fmt.Errorf("service.GetNoticeDetail: %w", err)
+	}
+
+	detail := pack.BuildNoticeDetail(notice, attachments)
+	go s.cache.Common.SetNoticeDetail(s.ctx, key, detail)
+	return detail, nil
+}
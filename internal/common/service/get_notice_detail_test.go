@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	kitexModel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	commonCache "github.com/west2-online/fzuhelper-server/pkg/cache/common"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/db/notice"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+)
+
+func TestGetNoticeDetail(t *testing.T) {
+	type testCase struct {
+		name          string
+		url           string
+		cacheExist    bool
+		cacheDetail   *kitexModel.NoticeDetail
+		mockNotice    *model.Notice
+		mockDBError   error
+		mockAttach    []*model.NoticeAttachment
+		expectResult  *kitexModel.NoticeDetail
+		expectError   string
+		expectDBQuery bool
+	}
+
+	const url = "https://jwch.fzu.edu.cn/info/1039/1.htm"
+	synced := &model.Notice{Id: 1, Title: "考试安排", URL: url, PublishedAt: "2024-12-01", Html: "<p>见附件</p>"}
+	attachments := []*model.NoticeAttachment{
+		{NoticeId: 1, Name: "考场.xls", SourceURL: "https://jwch.fzu.edu.cn/d?id=1", MirrorURL: "https://oss/a.xls"},
+		{NoticeId: 1, Name: "名单.pdf", SourceURL: "https://jwch.fzu.edu.cn/d?id=2"},
+	}
+	expected := &kitexModel.NoticeDetail{
+		Title:   "考试安排",
+		Url:     url,
+		Date:    "2024-12-01",
+		Content: "<p>见附件</p>",
+		Attachments: []*kitexModel.NoticeAttachment{
+			{Name: "考场.xls", Url: "https://oss/a.xls", Mirrored: true},
+			{Name: "名单.pdf", Url: "https://jwch.fzu.edu.cn/d?id=2"},
+		},
+	}
+
+	testCases := []testCase{
+		{name: "CacheHit", url: url, cacheExist: true, cacheDetail: expected, expectResult: expected},
+		{name: "FromDB", url: " " + url, mockNotice: synced, mockAttach: attachments, expectResult: expected, expectDBQuery: true},
+		{name: "EmptyURL", url: " ", expectError: "url is empty"},
+		{name: "NotFound", url: url, expectError: "notice not found", expectDBQuery: true},
+		{name: "NotSynced", url: url, mockNotice: &model.Notice{Id: 1, URL: url}, expectError: "not synced", expectDBQuery: true},
+		{name: "DBError", url: url, mockDBError: assert.AnError, expectError: assert.AnError.Error(), expectDBQuery: true},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				DBClient:    &db.Database{Notice: new(notice.DBNotice)},
+				CacheClient: &cache.Cache{Common: new(commonCache.CacheCommon)},
+			}
+			mockey.Mock((*cache.Cache).IsKeyExist).Return(tc.cacheExist).Build()
+			mockey.Mock((*commonCache.CacheCommon).GetNoticeDetail).Return(tc.cacheDetail, nil).Build()
+			mockey.Mock((*commonCache.CacheCommon).SetNoticeDetail).Return().Build()
+			queried := false
+			mockey.Mock((*notice.DBNotice).GetNoticeByURL).To(func(_ *notice.DBNotice, _ context.Context, u string) (*model.Notice, error) {
+				queried = true
+				assert.Equal(t, url, u)
+				return tc.mockNotice, tc.mockDBError
+			}).Build()
+			mockey.Mock((*notice.DBNotice).ListNoticeAttachments).Return(tc.mockAttach, nil).Build()
+
+			commonService := NewCommonService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			result, err := commonService.GetNoticeDetail(tc.url)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectResult, result)
+			}
+			assert.Equal(t, tc.expectDBQuery, queried)
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/db"
 	"github.com/west2-online/fzuhelper-server/pkg/db/model"
 	"github.com/west2-online/fzuhelper-server/pkg/db/notice"
+	"github.com/west2-online/fzuhelper-server/pkg/noticesource"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/jwch"
 )
@@ -36,6 +37,8 @@ func TestGetNotice(t *testing.T) {
 	type testCase struct {
 		name          string
 		pageNum       int
+		source        string
+		mockCount     int64
 		mockDBResult  []model.Notice
 		mockDBError   error
 		mockJwchTotal int
@@ -60,6 +63,21 @@ func TestGetNotice(t *testing.T) {
 			expectList:    mockNotices,
 			expectTotal:   10,
 		},
+		{
+			name:         "OtherSource",
+			pageNum:      1,
+			source:       "library",
+			mockDBResult: mockNotices,
+			mockCount:    21,
+			expectList:   mockNotices,
+			expectTotal:  2,
+		},
+		{
+			name:        "UnknownSource",
+			pageNum:     1,
+			source:      "unknown",
+			expectError: "unknown notice source",
+		},
 		{
 			name:        "DBGetError",
 			pageNum:     1,
@@ -84,11 +102,15 @@ func TestGetNotice(t *testing.T) {
 
 			// Mock DB GetNoticeByPage
 			mockey.Mock((*notice.DBNotice).GetNoticeByPage).Return(tc.mockDBResult, tc.mockDBError).Build()
+			mockey.Mock((*notice.DBNotice).CountNotice).Return(tc.mockCount, nil).Build()
+			mockey.Mock(noticesource.Get).To(func(name string) (noticesource.NoticeSource, bool) {
+				return nil, name == "library"
+			}).Build()
 			// Mock jwch GetNoticeInfo
 			mockey.Mock((*jwch.Student).GetNoticeInfo).Return(nil, tc.mockJwchTotal, tc.mockJwchError).Build()
 
 			commonService := NewCommonService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
-			list, total, err := commonService.GetNotice(tc.pageNum)
+			list, total, err := commonService.GetNotice(tc.pageNum, tc.source)
 
 			if tc.expectError != "" {
 				assert.ErrorContains(t, err, tc.expectError)
@@ -0,0 +1,140 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
+	"github.com/west2-online/fzuhelper-server/pkg/umeng"
+)
+
+func (s *CommonService) ListNoticeSubscriptions(stuID string) ([]*model.NoticeSubscription, error) {
+	return s.db.Notice.ListNoticeSubscriptions(s.ctx, stuID)
+}
+
+// SubscribeNotice 订阅关键词，重复订阅同一关键词不会报错
+func (s *CommonService) SubscribeNotice(stuID, keyword string) (*model.NoticeSubscription, error) {
+	keyword, err := normalizeSubscriptionKeyword(keyword)
+	if err != nil {
+		return nil, err
+	}
+	subs, err := s.db.Notice.ListNoticeSubscriptions(s.ctx, stuID)
+	if err != nil {
+		return nil, fmt.Errorf("service.SubscribeNotice: %w", err)
+	}
+	for _, sub := range subs {
+		if sub.Keyword == keyword {
+			return sub, nil
+		}
+	}
+	if len(subs) >= constants.NoticeSubscriptionMaxPerUser {
+		return nil, errno.NewErrNo(errno.BizLimitCode, fmt.Sprintf("at most %d subscriptions are allowed", constants.NoticeSubscriptionMaxPerUser))
+	}
+
+	sub := &model.NoticeSubscription{StuId: stuID, Keyword: keyword}
+	if err = s.db.Notice.CreateNoticeSubscription(s.ctx, sub); err != nil {
+		return nil, fmt.Errorf("service.SubscribeNotice: %w", err)
+	}
+	return sub, nil
+}
+
+func (s *CommonService) UnsubscribeNotice(stuID, keyword string) error {
+	keyword, err := normalizeSubscriptionKeyword(keyword)
+	if err != nil {
+		return err
+	}
+	return s.db.Notice.DeleteNoticeSubscription(s.ctx, stuID, keyword)
+}
+
+// PushSubscribedNotice 将新通知的标题与所有订阅关键词匹配，命中时写入订阅者的收件箱并向对应关键词的 tag 推送
+// 多个关键词合并为一次 or 推送，同一设备只收到一次，且每批只占用 dispatcher 的一次配额
+func (s *CommonService) PushSubscribedNotice(notice *model.Notice) error {
+	keywords, err := s.db.Notice.ListSubscribedKeywords(s.ctx)
+	if err != nil {
+		return fmt.Errorf("service.PushSubscribedNotice: %w", err)
+	}
+	matched := make([]string, 0)
+	for _, keyword := range keywords {
+		if strings.Contains(notice.Title, keyword) {
+			matched = append(matched, keyword)
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+	sort.Strings(matched)
+
+	deeplink := constants.UmengJwchNoticeDeeplink + "?url=" + url.QueryEscape(notice.URL)
+	// 同时命中多个关键词的学生只写入一条收件箱通知
+	stuIDs, err := s.db.Notice.ListSubscriberIDs(s.ctx, matched)
+	if err != nil {
+		return fmt.Errorf("service.PushSubscribedNotice: %w", err)
+	}
+	if err = s.notifier.Notify(s.ctx, &notification.Message{
+		Type:     constants.UmengPushTypeTeaching,
+		Title:    "你订阅的关键词有新通知",
+		Text:     notice.Title,
+		Deeplink: deeplink,
+		StuIDs:   stuIDs,
+	}); err != nil {
+		logger.Errorf("service.PushSubscribedNotice: write inbox failed, keywords=%v err=%v", matched, err)
+	}
+
+	for start := 0; start < len(matched); start += constants.UmengMaxOrTags {
+		batch := matched[start:min(start+constants.UmengMaxOrTags, len(matched))]
+		tags := make([]string, len(batch))
+		for i, keyword := range batch {
+			tags[i] = umeng.NoticeSubscriptionTag(keyword)
+		}
+		title := "你订阅的「" + batch[0] + "」有新通知"
+		if len(batch) > 1 {
+			title = "你订阅的关键词有新通知"
+		}
+		if err = s.notifier.Notify(s.ctx, &notification.Message{
+			Type:        constants.UmengPushTypeTeaching,
+			Title:       title,
+			Text:        notice.Title,
+			Keywords:    []string{notice.Title},
+			Description: "通知订阅",
+			Deeplink:    deeplink,
+			Tags:        tags,
+		}); err != nil {
+			logger.Errorf("service.PushSubscribedNotice: drop subscription push, keywords=%v err=%v", batch, err)
+		}
+	}
+	return nil
+}
+
+func normalizeSubscriptionKeyword(keyword string) (string, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return "", errno.ParamError.WithMessage("keyword is empty")
+	}
+	if utf8.RuneCountInString(keyword) > constants.NoticeSubscriptionKeywordMaxLen {
+		return "", errno.ParamError.WithMessage("keyword is too long")
+	}
+	return keyword, nil
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/db/notice"
+	dbnotification "github.com/west2-online/fzuhelper-server/pkg/db/notification"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/fzuhelper-server/pkg/umeng"
+)
+
+func TestSubscribeNotice(t *testing.T) {
+	type testCase struct {
+		name         string
+		keyword      string
+		existing     []*model.NoticeSubscription
+		mockListErr  error
+		mockCreate   error
+		expectCreate bool
+		expectResult *model.NoticeSubscription
+		expectError  string
+	}
+
+	full := make([]*model.NoticeSubscription, constants.NoticeSubscriptionMaxPerUser)
+	for i := range full {
+		full[i] = &model.NoticeSubscription{StuId: "102301001", Keyword: strings.Repeat("k", i+1)}
+	}
+
+	testCases := []testCase{
+		{
+			name:         "Success",
+			keyword:      " 考试 ",
+			expectCreate: true,
+			expectResult: &model.NoticeSubscription{StuId: "102301001", Keyword: "考试"},
+		},
+		{
+			name:         "AlreadySubscribed",
+			keyword:      "考试",
+			existing:     []*model.NoticeSubscription{{Id: 1, StuId: "102301001", Keyword: "考试"}},
+			expectResult: &model.NoticeSubscription{Id: 1, StuId: "102301001", Keyword: "考试"},
+		},
+		{
+			name:        "EmptyKeyword",
+			keyword:     "  ",
+			expectError: "keyword is empty",
+		},
+		{
+			name:        "KeywordTooLong",
+			keyword:     strings.Repeat("考", constants.NoticeSubscriptionKeywordMaxLen+1),
+			expectError: "keyword is too long",
+		},
+		{
+			name:        "LimitReached",
+			keyword:     "奖学金",
+			existing:    full,
+			expectError: "at most",
+		},
+		{
+			name:        "ListError",
+			keyword:     "考试",
+			mockListErr: assert.AnError,
+			expectError: "service.SubscribeNotice",
+		},
+		{
+			name:         "CreateError",
+			keyword:      "考试",
+			mockCreate:   assert.AnError,
+			expectCreate: true,
+			expectError:  "service.SubscribeNotice",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				DBClient: &db.Database{Notice: new(notice.DBNotice)},
+			}
+			mockey.Mock((*notice.DBNotice).ListNoticeSubscriptions).Return(tc.existing, tc.mockListErr).Build()
+			createMock := mockey.Mock((*notice.DBNotice).CreateNoticeSubscription).Return(tc.mockCreate).Build()
+
+			commonService := NewCommonService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			result, err := commonService.SubscribeNotice("102301001", tc.keyword)
+
+			if tc.expectCreate {
+				assert.Equal(t, 1, createMock.Times())
+			} else {
+				assert.Equal(t, 0, createMock.Times())
+			}
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectResult, result)
+		})
+	}
+}
+
+func TestPushSubscribedNotice(t *testing.T) {
+	type testCase struct {
+		name           string
+		title          string
+		keywords       []string
+		mockDBError    error
+		expectPushTags [][]string
+		expectBatches  []int // 仅校验每批 tag 数量
+		expectInbox    int
+		expectError    bool
+	}
+
+	// 超过单次 or 推送上限的关键词需要拆分为多批推送
+	manyKeywords := make([]string, constants.UmengMaxOrTags+1)
+	for i := range manyKeywords {
+		manyKeywords[i] = fmt.Sprintf("k%02d", i)
+	}
+	manyTitle := strings.Join(manyKeywords, " ")
+
+	testCases := []testCase{
+		{
+			name:           "SingleMatch",
+			title:          "关于2024-2025学年第一学期期末考试安排的通知",
+			keywords:       []string{"考试", "奖学金"},
+			expectPushTags: [][]string{{umeng.NoticeSubscriptionTag("考试")}},
+			expectInbox:    2,
+		},
+		{
+			name:     "MultipleMatchMergedIntoOnePush",
+			title:    "计算机与大数据学院转专业考试安排",
+			keywords: []string{"转专业", "考试", "计算机与大数据学院"},
+			expectPushTags: [][]string{{
+				umeng.NoticeSubscriptionTag("考试"),
+				umeng.NoticeSubscriptionTag("计算机与大数据学院"),
+				umeng.NoticeSubscriptionTag("转专业"),
+			}},
+			expectInbox: 2,
+		},
+		{
+			name:     "NoMatch",
+			title:    "关于开展图书馆讲座的通知",
+			keywords: []string{"考试"},
+		},
+		{
+			name:        "DBError",
+			title:       "考试",
+			mockDBError: assert.AnError,
+			expectError: true,
+		},
+		{
+			name:          "SplitByMaxOrTags",
+			title:         manyTitle,
+			keywords:      manyKeywords,
+			expectBatches: []int{constants.UmengMaxOrTags, 1},
+			expectInbox:   2,
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				DBClient: &db.Database{Notice: new(notice.DBNotice)},
+			}
+			mockey.Mock((*notice.DBNotice).ListSubscribedKeywords).Return(tc.keywords, tc.mockDBError).Build()
+			var pushedTags [][]string
+			mockey.Mock((*notice.DBNotice).ListSubscriberIDs).Return([]string{"102301517", "102301518"}, nil).Build()
+			var inbox []*model.Notification
+			mockey.Mock((*dbnotification.DBNotification).CreateNotifications).
+				To(func(_ context.Context, list []*model.Notification) error {
+					inbox = append(inbox, list...)
+					return nil
+				}).Build()
+			mockey.Mock(umeng.Enqueue).To(func(_ context.Context, task *umeng.Task) error {
+				pushedTags = append(pushedTags, task.Tags)
+				return nil
+			}).Build()
+
+			commonService := NewCommonService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			err := commonService.PushSubscribedNotice(&model.Notice{Title: tc.title, URL: "https://jwch.fzu.edu.cn/info/1.htm"})
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			// 命中关键词时每名订阅者只写入一条收件箱通知
+			assert.Len(t, inbox, tc.expectInbox)
+			if tc.expectBatches != nil {
+				batches := make([]int, len(pushedTags))
+				for i, tags := range pushedTags {
+					batches[i] = len(tags)
+				}
+				assert.Equal(t, tc.expectBatches, batches)
+				return
+			}
+			assert.Equal(t, tc.expectPushTags, pushedTags)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/config"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/common"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+// ListNotifications 按时间倒序分页返回当前用户收件箱中的通知
+func (s *CommonService) ListNotifications(stuID string, req *common.ListNotificationsRequest) ([]*model.Notification, int64, error) {
+	pageNum := 1
+	if req.PageNum != nil {
+		if *req.PageNum < 1 {
+			return nil, 0, errno.ParamError.WithMessage("invalid page num")
+		}
+		pageNum = int(*req.PageNum)
+	}
+	return s.db.Notification.ListNotifications(s.ctx, stuID, req.GetUnreadOnly(), pageNum)
+}
+
+// MarkNotificationsRead 标记通知为已读，ids 为空时标记当前用户的全部通知
+func (s *CommonService) MarkNotificationsRead(stuID string, ids []int64) (int64, error) {
+	if len(ids) > constants.NotificationMarkReadMaxIDs {
+		return 0, errno.ParamError.WithMessage(fmt.Sprintf("at most %d ids are allowed", constants.NotificationMarkReadMaxIDs))
+	}
+	return s.db.Notification.MarkNotificationsRead(s.ctx, stuID, ids)
+}
+
+func (s *CommonService) GetUnreadNotificationCount(stuID string) (int64, error) {
+	return s.db.Notification.CountUnreadNotifications(s.ctx, stuID)
+}
+
+// CleanupNotifications 按保留策略分批删除过期通知，由定时任务调用
+func (s *CommonService) CleanupNotifications() error {
+	retention, readRetention := notificationRetention()
+	now := time.Now()
+	var total int64
+	for {
+		deleted, err := s.db.Notification.DeleteExpiredNotifications(s.ctx, now.Add(-retention), now.Add(-readRetention))
+		if err != nil {
+			return fmt.Errorf("service.CleanupNotifications: %w", err)
+		}
+		total += deleted
+		if deleted < constants.NotificationCleanupBatchSize {
+			break
+		}
+	}
+	logger.Infof("service.CleanupNotifications: %d expired notifications deleted", total)
+	return nil
+}
+
+// notificationRetention 返回通知和已读通知的保留时长，未配置时使用默认值
+func notificationRetention() (retention, readRetention time.Duration) {
+	retention, readRetention = constants.NotificationDefaultRetention, constants.NotificationDefaultReadRetention
+	if config.Notification == nil {
+		return retention, readRetention
+	}
+	if config.Notification.RetentionDays > 0 {
+		retention = time.Duration(config.Notification.RetentionDays) * constants.ONE_DAY
+	}
+	if config.Notification.ReadRetentionDays > 0 {
+		readRetention = time.Duration(config.Notification.ReadRetentionDays) * constants.ONE_DAY
+	}
+	// 已读通知的保留时间不会超过通知的最长保留时间
+	return retention, min(readRetention, retention)
+}
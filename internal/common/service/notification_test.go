@@ -0,0 +1,233 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/config"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/common"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbnotification "github.com/west2-online/fzuhelper-server/pkg/db/notification"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+)
+
+func TestListNotifications(t *testing.T) {
+	type testCase struct {
+		name         string
+		req          *common.ListNotificationsRequest
+		mockErr      error
+		expectPage   int
+		expectUnread bool
+		expectError  string
+	}
+
+	testCases := []testCase{
+		{
+			name:       "DefaultPage",
+			req:        &common.ListNotificationsRequest{},
+			expectPage: 1,
+		},
+		{
+			name:         "UnreadOnly",
+			req:          &common.ListNotificationsRequest{PageNum: new(int64(3)), UnreadOnly: new(true)},
+			expectPage:   3,
+			expectUnread: true,
+		},
+		{
+			name:        "InvalidPage",
+			req:         &common.ListNotificationsRequest{PageNum: new(int64(0))},
+			expectError: "invalid page num",
+		},
+		{
+			name:        "DBError",
+			req:         &common.ListNotificationsRequest{},
+			mockErr:     assert.AnError,
+			expectPage:  1,
+			expectError: assert.AnError.Error(),
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{DBClient: new(db.Database)}
+			mockey.Mock((*dbnotification.DBNotification).ListNotifications).
+				To(func(_ context.Context, stuID string, unreadOnly bool, pageNum int) ([]*model.Notification, int64, error) {
+					assert.Equal(t, "102301001", stuID)
+					assert.Equal(t, tc.expectUnread, unreadOnly)
+					assert.Equal(t, tc.expectPage, pageNum)
+					if tc.mockErr != nil {
+						return nil, 0, tc.mockErr
+					}
+					return []*model.Notification{{Id: 1}}, 1, nil
+				}).Build()
+
+			commonService := NewCommonService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			list, total, err := commonService.ListNotifications("102301001", tc.req)
+
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, list, 1)
+			assert.Equal(t, int64(1), total)
+		})
+	}
+}
+
+func TestMarkNotificationsRead(t *testing.T) {
+	tooMany := make([]int64, constants.NotificationMarkReadMaxIDs+1)
+
+	type testCase struct {
+		name        string
+		ids         []int64
+		expectMark  bool
+		expectError string
+	}
+
+	testCases := []testCase{
+		{name: "MarkAll", expectMark: true},
+		{name: "MarkByIDs", ids: []int64{1, 2}, expectMark: true},
+		{name: "TooManyIDs", ids: tooMany, expectError: "at most"},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{DBClient: new(db.Database)}
+			markMock := mockey.Mock((*dbnotification.DBNotification).MarkNotificationsRead).
+				To(func(_ context.Context, stuID string, ids []int64) (int64, error) {
+					assert.Equal(t, tc.ids, ids)
+					return int64(len(ids)), nil
+				}).Build()
+
+			commonService := NewCommonService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			marked, err := commonService.MarkNotificationsRead("102301001", tc.ids)
+
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				assert.Equal(t, 0, markMock.Times())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(tc.ids)), marked)
+		})
+	}
+}
+
+func TestCleanupNotifications(t *testing.T) {
+	type testCase struct {
+		name          string
+		deleted       []int64
+		mockErr       error
+		expectBatches int
+		expectError   bool
+	}
+
+	testCases := []testCase{
+		{name: "SingleBatch", deleted: []int64{10}, expectBatches: 1},
+		{name: "MultipleBatches", deleted: []int64{constants.NotificationCleanupBatchSize, 3}, expectBatches: 2},
+		{name: "DBError", mockErr: assert.AnError, expectBatches: 1, expectError: true},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{DBClient: new(db.Database)}
+			batches := 0
+			mockey.Mock((*dbnotification.DBNotification).DeleteExpiredNotifications).
+				To(func(_ context.Context, createdBefore, readBefore time.Time) (int64, error) {
+					batches++
+					assert.True(t, readBefore.After(createdBefore))
+					if tc.mockErr != nil {
+						return 0, tc.mockErr
+					}
+					return tc.deleted[batches-1], nil
+				}).Build()
+
+			commonService := NewCommonService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			err := commonService.CleanupNotifications()
+
+			assert.Equal(t, tc.expectBatches, batches)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNotificationRetention(t *testing.T) {
+	config.InitForTest("common")
+	defer func() {
+		config.Notification.RetentionDays = 0
+		config.Notification.ReadRetentionDays = 0
+	}()
+
+	type testCase struct {
+		name                string
+		retentionDays       int64
+		readRetentionDays   int64
+		expectRetention     time.Duration
+		expectReadRetention time.Duration
+	}
+
+	testCases := []testCase{
+		{
+			name:                "Default",
+			expectRetention:     constants.NotificationDefaultRetention,
+			expectReadRetention: constants.NotificationDefaultReadRetention,
+		},
+		{
+			name:                "Configured",
+			retentionDays:       90,
+			readRetentionDays:   7,
+			expectRetention:     90 * constants.ONE_DAY,
+			expectReadRetention: 7 * constants.ONE_DAY,
+		},
+		{
+			name:                "ReadRetentionCapped",
+			retentionDays:       10,
+			readRetentionDays:   30,
+			expectRetention:     10 * constants.ONE_DAY,
+			expectReadRetention: 10 * constants.ONE_DAY,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config.Notification.RetentionDays = tc.retentionDays
+			config.Notification.ReadRetentionDays = tc.readRetentionDays
+
+			retention, readRetention := notificationRetention()
+
+			assert.Equal(t, tc.expectRetention, retention)
+			assert.Equal(t, tc.expectReadRetention, readRetention)
+		})
+	}
+}
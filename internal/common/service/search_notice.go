@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"html"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/common"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/es"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+const (
+	highlightPre  = "<em>"
+	highlightPost = "</em>"
+)
+
+// SearchNotices 全文检索教务处通知，优先使用 Elasticsearch，未配置或请求失败时降级为数据库模糊匹配
+func (s *CommonService) SearchNotices(req *common.SearchNoticesRequest) ([]*model.NoticeSearchHit, int64, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, 0, errno.ParamError.WithMessage("query is empty")
+	}
+	if utf8.RuneCountInString(query) > constants.NoticeSearchMaxQueryLen {
+		return nil, 0, errno.ParamError.WithMessage("query is too long")
+	}
+	startDate, endDate := req.GetStartDate(), req.GetEndDate()
+	for _, date := range []string{startDate, endDate} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return nil, 0, errno.ParamError.WithMessage("invalid date " + date)
+		}
+	}
+	if startDate != "" && endDate != "" && startDate > endDate {
+		return nil, 0, errno.ParamError.WithMessage("start date is after end date")
+	}
+	pageNum := 1
+	if req.PageNum != nil {
+		if *req.PageNum < 1 {
+			return nil, 0, errno.ParamError.WithMessage("invalid page num")
+		}
+		pageNum = int(*req.PageNum)
+	}
+
+	if s.es != nil {
+		hits, total, err := es.SearchNotices(s.ctx, s.es, query, startDate, endDate, pageNum)
+		if err == nil {
+			return buildSearchResultsFromES(hits), total, nil
+		}
+		logger.Errorf("service.SearchNotices: elasticsearch search failed, fallback to database, err: %v", err)
+	}
+
+	list, total, err := s.db.Notice.SearchNotice(s.ctx, query, startDate, endDate, pageNum)
+	if err != nil {
+		return nil, 0, err
+	}
+	results := make([]*model.NoticeSearchHit, 0, len(list))
+	for _, n := range list {
+		r := &model.NoticeSearchHit{
+			Title:      n.Title,
+			Url:        n.URL,
+			Date:       n.PublishedAt,
+			Highlights: highlightSnippets(n.Content, query),
+		}
+		if strings.Contains(n.Title, query) {
+			r.HighlightTitle = new(highlight(n.Title, query))
+		}
+		results = append(results, r)
+	}
+	return results, total, nil
+}
+
+func buildSearchResultsFromES(hits []*es.NoticeHit) []*model.NoticeSearchHit {
+	results := make([]*model.NoticeSearchHit, 0, len(hits))
+	for _, h := range hits {
+		r := &model.NoticeSearchHit{
+			Title:      h.Title,
+			Url:        h.URL,
+			Date:       h.PublishedAt,
+			Highlights: h.Highlights,
+		}
+		if r.Highlights == nil {
+			r.Highlights = []string{}
+		}
+		if h.HighlightTitle != "" {
+			r.HighlightTitle = new(h.HighlightTitle)
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+// highlight 转义 text 中的 HTML 后用 <em> 标记所有命中的 query，避免通知正文中的标签被客户端当作 HTML 渲染
+func highlight(text, query string) string {
+	parts := strings.Split(text, query)
+	for i, part := range parts {
+		parts[i] = html.EscapeString(part)
+	}
+	return strings.Join(parts, highlightPre+html.EscapeString(query)+highlightPost)
+}
+
+// highlightSnippets 截取 content 中前若干处命中 query 的上下文，转义后用 <em> 标记关键词
+func highlightSnippets(content, query string) []string {
+	snippets := make([]string, 0)
+	rest := content
+	for len(snippets) < constants.NoticeSearchMaxHighlights {
+		idx := strings.Index(rest, query)
+		if idx < 0 {
+			break
+		}
+		before := []rune(rest[:idx])
+		if len(before) > constants.NoticeSearchSnippetRadius {
+			before = before[len(before)-constants.NoticeSearchSnippetRadius:]
+		}
+		after := []rune(rest[idx+len(query):])
+		next := len(after)
+		if next > constants.NoticeSearchSnippetRadius {
+			next = constants.NoticeSearchSnippetRadius
+		}
+		// 尾部上下文中的其他命中同样标记，并随该片段一起被消费
+		tail := highlight(string(after[:next]), query)
+		snippets = append(snippets, html.EscapeString(string(before))+highlightPre+html.EscapeString(query)+highlightPost+tail)
+		rest = string(after[next:])
+	}
+	return snippets
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	elastic "github.com/elastic/go-elasticsearch/v7"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/common"
+	kitexModel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/db/notice"
+	"github.com/west2-online/fzuhelper-server/pkg/es"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+)
+
+func TestSearchNotices(t *testing.T) {
+	type testCase struct {
+		name         string
+		req          *common.SearchNoticesRequest
+		withES       bool
+		mockESHits   []*es.NoticeHit
+		mockESTotal  int64
+		mockESError  error
+		mockDBResult []model.Notice
+		mockDBTotal  int64
+		mockDBError  error
+		expectDBCall bool
+		expectResult []*kitexModel.NoticeSearchHit
+		expectTotal  int64
+		expectError  string
+	}
+
+	dbNotices := []model.Notice{
+		{Id: 1, Title: "关于期末考试安排的通知", URL: "u1", PublishedAt: "2024-12-01", Content: "本学期期末考试将于第19周进行"},
+		{Id: 2, Title: "选课通知", URL: "u2", PublishedAt: "2024-11-01", Content: ""},
+	}
+
+	testCases := []testCase{
+		{
+			name:        "EmptyQuery",
+			req:         &common.SearchNoticesRequest{Query: "  "},
+			expectError: "query is empty",
+		},
+		{
+			name:        "QueryTooLong",
+			req:         &common.SearchNoticesRequest{Query: strings.Repeat("考", 65)},
+			expectError: "query is too long",
+		},
+		{
+			name:        "InvalidDate",
+			req:         &common.SearchNoticesRequest{Query: "考试", StartDate: new("2024/09/01")},
+			expectError: "invalid date",
+		},
+		{
+			name:        "DateRangeReversed",
+			req:         &common.SearchNoticesRequest{Query: "考试", StartDate: new("2024-10-01"), EndDate: new("2024-09-01")},
+			expectError: "start date is after end date",
+		},
+		{
+			name:        "InvalidPageNum",
+			req:         &common.SearchNoticesRequest{Query: "考试", PageNum: new(int64(0))},
+			expectError: "invalid page num",
+		},
+		{
+			name:         "DBFallbackWithoutES",
+			req:          &common.SearchNoticesRequest{Query: "考试"},
+			mockDBResult: dbNotices[:1],
+			mockDBTotal:  1,
+			expectDBCall: true,
+			expectResult: []*kitexModel.NoticeSearchHit{
+				{
+					Title:          "关于期末考试安排的通知",
+					Url:            "u1",
+					Date:           "2024-12-01",
+					HighlightTitle: new("关于期末<em>考试</em>安排的通知"),
+					Highlights:     []string{"本学期期末<em>考试</em>将于第19周进行"},
+				},
+			},
+			expectTotal: 1,
+		},
+		{
+			name:         "DBFallbackTitleOnly",
+			req:          &common.SearchNoticesRequest{Query: "选课"},
+			mockDBResult: dbNotices[1:],
+			mockDBTotal:  1,
+			expectDBCall: true,
+			expectResult: []*kitexModel.NoticeSearchHit{
+				{Title: "选课通知", Url: "u2", Date: "2024-11-01", HighlightTitle: new("<em>选课</em>通知"), Highlights: []string{}},
+			},
+			expectTotal: 1,
+		},
+		{
+			name:         "DBError",
+			req:          &common.SearchNoticesRequest{Query: "考试"},
+			mockDBError:  assert.AnError,
+			expectDBCall: true,
+			expectError:  assert.AnError.Error(),
+		},
+		{
+			name:   "ESSuccess",
+			req:    &common.SearchNoticesRequest{Query: "考试"},
+			withES: true,
+			mockESHits: []*es.NoticeHit{
+				{NoticeDoc: es.NoticeDoc{Title: "考试安排", URL: "u1", PublishedAt: "2024-12-01"}, HighlightTitle: "<em>考试</em>安排"},
+			},
+			mockESTotal: 30,
+			expectResult: []*kitexModel.NoticeSearchHit{
+				{Title: "考试安排", Url: "u1", Date: "2024-12-01", HighlightTitle: new("<em>考试</em>安排"), Highlights: []string{}},
+			},
+			expectTotal: 30,
+		},
+		{
+			name:         "ESErrorFallbackToDB",
+			req:          &common.SearchNoticesRequest{Query: "选课"},
+			withES:       true,
+			mockESError:  assert.AnError,
+			mockDBResult: dbNotices[1:],
+			mockDBTotal:  1,
+			expectDBCall: true,
+			expectResult: []*kitexModel.NoticeSearchHit{
+				{Title: "选课通知", Url: "u2", Date: "2024-11-01", HighlightTitle: new("<em>选课</em>通知"), Highlights: []string{}},
+			},
+			expectTotal: 1,
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				DBClient: &db.Database{Notice: new(notice.DBNotice)},
+			}
+			if tc.withES {
+				mockClientSet.ESClient = new(elastic.Client)
+			}
+
+			mockey.Mock(es.SearchNotices).Return(tc.mockESHits, tc.mockESTotal, tc.mockESError).Build()
+			dbMock := mockey.Mock((*notice.DBNotice).SearchNotice).Return(tc.mockDBResult, tc.mockDBTotal, tc.mockDBError).Build()
+
+			commonService := NewCommonService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			result, total, err := commonService.SearchNotices(tc.req)
+
+			if tc.expectDBCall {
+				assert.Equal(t, 1, dbMock.Times())
+			} else {
+				assert.Equal(t, 0, dbMock.Times())
+			}
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectResult, result)
+			assert.Equal(t, tc.expectTotal, total)
+		})
+	}
+}
+
+func TestHighlightSnippets(t *testing.T) {
+	long := strings.Repeat("甲", 40) + "考试" + strings.Repeat("乙", 40)
+	snippets := highlightSnippets(long, "考试")
+	assert.Equal(t, []string{strings.Repeat("甲", 30) + "<em>考试</em>" + strings.Repeat("乙", 30)}, snippets)
+
+	snippets = highlightSnippets("考试考试", "考试")
+	assert.Equal(t, []string{"<em>考试</em><em>考试</em>"}, snippets)
+
+	snippets = highlightSnippets(strings.Repeat("考试"+strings.Repeat("丙", 70), 5), "考试")
+	assert.Len(t, snippets, 3)
+
+	assert.Empty(t, highlightSnippets("没有命中", "考试"))
+
+	// 正文中的 HTML 需要转义，只保留高亮标签
+	snippets = highlightSnippets(`<img src=x onerror="alert(1)">考试<script>`, "考试")
+	assert.Equal(t, []string{`&lt;img src=x onerror=&#34;alert(1)&#34;&gt;<em>考试</em>&lt;script&gt;`}, snippets)
+}
+
+func TestHighlight(t *testing.T) {
+	assert.Equal(t, "<em>考试</em>&lt;b&gt;安排<em>考试</em>", highlight("考试<b>安排考试", "考试"))
+	assert.Equal(t, "<em>&lt;b&gt;</em>", highlight("<b>", "<b>"))
+}
@@ -20,10 +20,13 @@ import (
 	"context"
 
 	"github.com/cloudwego/hertz/pkg/app/client"
+	elastic "github.com/elastic/go-elasticsearch/v7"
 
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
 	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
+	"github.com/west2-online/fzuhelper-server/pkg/oss"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 )
 
@@ -37,16 +40,25 @@ type CommonService struct {
 	ctx        context.Context
 	db         *db.Database
 	cache      *cache.Cache
+	es         *elastic.Client // 可能为 nil，此时通知检索降级为数据库查询
 	httpClient *client.Client
+	ossClient  oss.NoticeAttachmentOSSRepo // 可能为 nil，此时通知附件只保留原始地址
 	taskQueue  taskqueue.TaskQueue
+	notifier   notification.Notifier
 }
 
 func NewCommonService(ctx context.Context, clientset *base.ClientSet, taskQueue taskqueue.TaskQueue) *CommonService {
-	return &CommonService{
+	s := &CommonService{
 		ctx:        ctx,
 		db:         clientset.DBClient,
 		cache:      clientset.CacheClient,
+		es:         clientset.ESClient,
 		httpClient: clientset.HzClient,
 		taskQueue:  taskQueue,
+		notifier:   notification.New(clientset.DBClient),
 	}
+	if clientset.OssSet != nil {
+		s.ossClient = oss.NewNoticeAttachmentOSSCli(clientset.OssSet.Upyun)
+	}
+	return s
 }
@@ -0,0 +1,222 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	hertzconfig "github.com/cloudwego/hertz/pkg/common/config"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/es"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+	"github.com/west2-online/fzuhelper-server/pkg/noticesource"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+// noticeAttachmentExts 校内通知中常见的附件类型
+var noticeAttachmentExts = []string{".xls", ".xlsx", ".doc", ".docx", ".pdf", ".zip", ".rar", ".7z", ".ppt", ".pptx", ".wps"}
+
+// errNoticeAttachmentTooLarge 附件超过转存上限，保留原始地址且不触发重试
+var errNoticeAttachmentTooLarge = errors.New("notice attachment too large")
+
+// SyncNoticeDetail 通过通知来源抓取正文，清洗 HTML 并转存附件后写回数据库，在配置了 Elasticsearch 时写入检索索引
+// 附件转存失败时仍会写入正文（保留原始地址），但返回错误交由任务队列重试
+func (s *CommonService) SyncNoticeDetail(src noticesource.NoticeSource, notice *model.Notice, item *noticesource.Item) error {
+	raw, err := src.FetchDetail(s.ctx, item)
+	if err != nil {
+		return fmt.Errorf("service.SyncNoticeDetail: source=%s url=%s: %w", src.Name(), notice.URL, err)
+	}
+
+	content := utils.HTMLToText(raw)
+	sanitized, links := utils.SanitizeHTML(raw, notice.URL)
+	mirrored, mirrorErr := s.syncNoticeAttachments(notice.Id, links)
+	for source, mirror := range mirrored {
+		sanitized = strings.ReplaceAll(sanitized, `href="`+html.EscapeString(source)+`"`, `href="`+html.EscapeString(mirror)+`"`)
+	}
+
+	if err = s.db.Notice.UpdateNoticeDetail(s.ctx, notice.Id, content, sanitized); err != nil {
+		return fmt.Errorf("service.SyncNoticeDetail: %w", err)
+	}
+	notice.Content = content
+	notice.Html = sanitized
+	if err = s.cache.Common.DeleteNoticeDetail(s.ctx, s.cache.Common.NoticeDetailKey(notice.URL)); err != nil {
+		logger.Errorf("service.SyncNoticeDetail: %v", err)
+	}
+
+	if s.es != nil {
+		err = es.IndexNotice(s.ctx, s.es, notice.Id, &es.NoticeDoc{
+			Title:       notice.Title,
+			Content:     content,
+			URL:         notice.URL,
+			PublishedAt: notice.PublishedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("service.SyncNoticeDetail: %w", err)
+		}
+	}
+	if mirrorErr != nil {
+		return fmt.Errorf("service.SyncNoticeDetail: %w", mirrorErr)
+	}
+	return nil
+}
+
+// syncNoticeAttachments 记录正文中的附件并转存到 OSS，已转存过的附件不会重复下载
+// 返回原始地址到转存地址的映射，以及第一个转存失败的错误
+func (s *CommonService) syncNoticeAttachments(noticeID int64, links []utils.HTMLLink) (map[string]string, error) {
+	existing, err := s.db.Notice.ListNoticeAttachments(s.ctx, noticeID)
+	if err != nil {
+		return nil, err
+	}
+	mirrored := make(map[string]string, len(existing))
+	for _, attachment := range existing {
+		if attachment.MirrorURL != "" {
+			mirrored[attachment.SourceURL] = attachment.MirrorURL
+		}
+	}
+
+	var firstErr error
+	seen := make(map[string]bool, len(links))
+	for _, link := range links {
+		ext, ok := noticeAttachmentExt(link)
+		if !ok || seen[link.Href] {
+			continue
+		}
+		seen[link.Href] = true
+
+		attachment := &model.NoticeAttachment{
+			NoticeId:  noticeID,
+			Name:      link.Text,
+			SourceURL: link.Href,
+			MirrorURL: mirrored[link.Href],
+		}
+		if attachment.Name == "" {
+			attachment.Name = path.Base(link.Href)
+		}
+		if attachment.MirrorURL == "" && s.ossClient != nil {
+			mirror, err := s.mirrorNoticeAttachment(noticeID, link.Href, ext)
+			switch {
+			case errors.Is(err, errNoticeAttachmentTooLarge):
+				logger.Infof("service.syncNoticeAttachments: skip large attachment, url=%s", link.Href)
+			case err != nil:
+				if firstErr == nil {
+					firstErr = err
+				}
+			default:
+				attachment.MirrorURL = mirror
+				mirrored[link.Href] = mirror
+			}
+		}
+		if err = s.db.Notice.UpsertNoticeAttachment(s.ctx, attachment); err != nil {
+			return nil, err
+		}
+	}
+	return mirrored, firstErr
+}
+
+// mirrorNoticeAttachment 下载附件并上传到 OSS，返回转存后的下载地址
+func (s *CommonService) mirrorNoticeAttachment(noticeID int64, sourceURL, ext string) (string, error) {
+	u, err := url.Parse(sourceURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid attachment url %s: %w", sourceURL, err)
+	}
+	upstream := governor.UpstreamNoticeAttachment
+	if u.Host == governor.HostJwch {
+		upstream = governor.HostJwch
+	}
+	if err = governor.Acquire(s.ctx, upstream); err != nil {
+		return "", err
+	}
+	req := protocol.AcquireRequest()
+	resp := protocol.AcquireResponse()
+	defer func() {
+		protocol.ReleaseRequest(req)
+		protocol.ReleaseResponse(resp)
+	}()
+	req.SetMethod(consts.MethodGet)
+	req.SetRequestURI(sourceURL)
+	req.SetOptions(
+		hertzconfig.WithDialTimeout(constants.NoticeAttachmentDownloadTimeout),
+		hertzconfig.WithReadTimeout(constants.NoticeAttachmentDownloadTimeout),
+		hertzconfig.WithRequestTimeout(constants.NoticeAttachmentDownloadTimeout),
+	)
+
+	start := time.Now()
+	err = s.httpClient.Do(s.ctx, req, resp)
+	metrics.ObserveUpstream(upstream, "DownloadNoticeAttachment", start)
+	governor.Report(upstream, err)
+	if err != nil {
+		return "", fmt.Errorf("download attachment failed, url=%s: %w", sourceURL, err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return "", fmt.Errorf("download attachment failed, url=%s: status %d", sourceURL, resp.StatusCode())
+	}
+	if resp.Header.ContentLength() > constants.NoticeAttachmentMaxSize {
+		return "", errNoticeAttachmentTooLarge
+	}
+	// 未声明长度（chunked）时边读边计数，多读一个字节用于判断是否超限
+	var stream io.Reader = bytes.NewReader(resp.BodyBytes())
+	if resp.IsBodyStream() {
+		stream = resp.BodyStream()
+	}
+	body, err := io.ReadAll(io.LimitReader(stream, constants.NoticeAttachmentMaxSize+1))
+	if err != nil {
+		return "", fmt.Errorf("read attachment failed, url=%s: %w", sourceURL, err)
+	}
+	if len(body) > constants.NoticeAttachmentMaxSize {
+		return "", errNoticeAttachmentTooLarge
+	}
+
+	mirrorURL, remotePath := s.ossClient.GenerateAttachmentPath(noticeID, sourceURL, ext)
+	if err = s.ossClient.Upload(body, remotePath); err != nil {
+		return "", fmt.Errorf("upload attachment failed, url=%s: %w", sourceURL, err)
+	}
+	return mirrorURL, nil
+}
+
+// noticeAttachmentExt 判断链接是否为附件并返回扩展名，教务处的附件多为 download.jsp 下载链接，扩展名只能从链接文字中获得
+func noticeAttachmentExt(link utils.HTMLLink) (string, bool) {
+	candidates := []string{strings.ToLower(path.Ext(link.Text))}
+	u, err := url.Parse(link.Href)
+	if err == nil {
+		candidates = append(candidates, strings.ToLower(path.Ext(u.Path)))
+	}
+	for _, ext := range candidates {
+		if slices.Contains(noticeAttachmentExts, ext) {
+			return ext, true
+		}
+	}
+	if err == nil && strings.HasSuffix(u.Path, "download.jsp") {
+		return "", true
+	}
+	return "", false
+}
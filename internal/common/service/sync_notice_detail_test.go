@@ -0,0 +1,296 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/cloudwego/hertz/pkg/app/client"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	elastic "github.com/elastic/go-elasticsearch/v7"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	commonCache "github.com/west2-online/fzuhelper-server/pkg/cache/common"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/db/notice"
+	"github.com/west2-online/fzuhelper-server/pkg/es"
+	"github.com/west2-online/fzuhelper-server/pkg/noticesource"
+	"github.com/west2-online/fzuhelper-server/pkg/oss"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+type fakeNoticeSource struct {
+	name   string
+	detail string
+	err    error
+}
+
+func (f *fakeNoticeSource) Name() string            { return f.name }
+func (f *fakeNoticeSource) DisplayName() string     { return f.name }
+func (f *fakeNoticeSource) Interval() time.Duration { return time.Hour }
+
+func (f *fakeNoticeSource) FetchPage(context.Context, int) ([]*noticesource.Item, int, error) {
+	return nil, 1, nil
+}
+
+func (f *fakeNoticeSource) FetchDetail(context.Context, *noticesource.Item) (string, error) {
+	return f.detail, f.err
+}
+
+func TestSyncNoticeDetail(t *testing.T) {
+	type testCase struct {
+		name             string
+		withES           bool
+		withOSS          bool
+		existing         []*model.NoticeAttachment
+		mockMirrorURL    string
+		mockMirrorError  error
+		mockFetchError   error
+		mockDBError      error
+		mockESError      error
+		expectMirrored   bool
+		expectIndexed    bool
+		expectHTMLHref   string
+		expectAttachment *model.NoticeAttachment
+		expectError      bool
+	}
+
+	const (
+		sourceURL = "https://jwch.fzu.edu.cn/system/_content/download.jsp?wbfileid=1"
+		mirrorURL = "https://oss.example.com/notice/1/a.xls"
+	)
+	const detail = `<p>期末<b>考试</b>&nbsp;安排</p><script>alert(1)</script><a href="/system/_content/download.jsp?wbfileid=1">考场.xls</a>`
+
+	testCases := []testCase{
+		{
+			name:             "SuccessWithoutOSS",
+			expectHTMLHref:   sourceURL,
+			expectAttachment: &model.NoticeAttachment{NoticeId: 1, Name: "考场.xls", SourceURL: sourceURL},
+		},
+		{
+			name:             "SuccessMirrored",
+			withOSS:          true,
+			withES:           true,
+			mockMirrorURL:    mirrorURL,
+			expectMirrored:   true,
+			expectIndexed:    true,
+			expectHTMLHref:   mirrorURL,
+			expectAttachment: &model.NoticeAttachment{NoticeId: 1, Name: "考场.xls", SourceURL: sourceURL, MirrorURL: mirrorURL},
+		},
+		{
+			name:             "AlreadyMirrored",
+			withOSS:          true,
+			existing:         []*model.NoticeAttachment{{NoticeId: 1, SourceURL: sourceURL, MirrorURL: mirrorURL}},
+			expectHTMLHref:   mirrorURL,
+			expectAttachment: &model.NoticeAttachment{NoticeId: 1, Name: "考场.xls", SourceURL: sourceURL, MirrorURL: mirrorURL},
+		},
+		{
+			name:             "AttachmentTooLarge",
+			withOSS:          true,
+			mockMirrorError:  errNoticeAttachmentTooLarge,
+			expectMirrored:   true,
+			expectHTMLHref:   sourceURL,
+			expectAttachment: &model.NoticeAttachment{NoticeId: 1, Name: "考场.xls", SourceURL: sourceURL},
+		},
+		{
+			name:             "MirrorErrorStillSavesDetail",
+			withOSS:          true,
+			mockMirrorError:  assert.AnError,
+			expectMirrored:   true,
+			expectHTMLHref:   sourceURL,
+			expectAttachment: &model.NoticeAttachment{NoticeId: 1, Name: "考场.xls", SourceURL: sourceURL},
+			expectError:      true,
+		},
+		{name: "FetchError", mockFetchError: assert.AnError, expectError: true},
+		{name: "DBError", mockDBError: assert.AnError, expectAttachment: &model.NoticeAttachment{NoticeId: 1, Name: "考场.xls", SourceURL: sourceURL}, expectError: true},
+		{
+			name:             "ESError",
+			withES:           true,
+			mockESError:      assert.AnError,
+			expectIndexed:    true,
+			expectHTMLHref:   sourceURL,
+			expectAttachment: &model.NoticeAttachment{NoticeId: 1, Name: "考场.xls", SourceURL: sourceURL},
+			expectError:      true,
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				DBClient:    &db.Database{Notice: new(notice.DBNotice)},
+				CacheClient: &cache.Cache{Common: new(commonCache.CacheCommon)},
+			}
+			if tc.withES {
+				mockClientSet.ESClient = new(elastic.Client)
+			}
+
+			src := &fakeNoticeSource{name: "jwch", detail: detail, err: tc.mockFetchError}
+			mockey.Mock((*notice.DBNotice).ListNoticeAttachments).Return(tc.existing, nil).Build()
+			var upserted *model.NoticeAttachment
+			mockey.Mock((*notice.DBNotice).UpsertNoticeAttachment).To(func(_ *notice.DBNotice, _ context.Context, a *model.NoticeAttachment) error {
+				upserted = a
+				return nil
+			}).Build()
+			mirrored := false
+			mockey.Mock((*CommonService).mirrorNoticeAttachment).To(func(_ *CommonService, _ int64, _, _ string) (string, error) {
+				mirrored = true
+				return tc.mockMirrorURL, tc.mockMirrorError
+			}).Build()
+			var savedContent, savedHTML string
+			mockey.Mock((*notice.DBNotice).UpdateNoticeDetail).To(func(_ *notice.DBNotice, _ context.Context, _ int64, content, html string) error {
+				savedContent, savedHTML = content, html
+				return tc.mockDBError
+			}).Build()
+			mockey.Mock((*commonCache.CacheCommon).DeleteNoticeDetail).Return(nil).Build()
+			var indexedDoc *es.NoticeDoc
+			mockey.Mock(es.IndexNotice).To(func(_ context.Context, _ *elastic.Client, _ int64, doc *es.NoticeDoc) error {
+				indexedDoc = doc
+				return tc.mockESError
+			}).Build()
+
+			n := &model.Notice{Id: 1, Title: "考试安排", URL: "https://jwch.fzu.edu.cn/info/1039/1.htm", PublishedAt: "2024-12-01"}
+			commonService := NewCommonService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue))
+			if tc.withOSS {
+				commonService.ossClient = new(oss.NoticeAttachmentOSSCli)
+			}
+			err := commonService.SyncNoticeDetail(src, n, &noticesource.Item{Title: n.Title, URL: n.URL})
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectMirrored, mirrored)
+			assert.Equal(t, tc.expectAttachment, upserted)
+			if tc.expectHTMLHref != "" {
+				assert.Equal(t, "期末 考试 安排 考场.xls", savedContent)
+				assert.NotContains(t, savedHTML, "script")
+				assert.Contains(t, savedHTML, `href="`+tc.expectHTMLHref+`"`)
+			}
+			if tc.expectIndexed {
+				assert.NotNil(t, indexedDoc)
+				assert.Equal(t, "期末 考试 安排 考场.xls", indexedDoc.Content)
+			} else {
+				assert.Nil(t, indexedDoc)
+			}
+		})
+	}
+}
+
+func TestNoticeAttachmentExt(t *testing.T) {
+	type testCase struct {
+		name     string
+		link     utils.HTMLLink
+		expectOK bool
+		expect   string
+	}
+	testCases := []testCase{
+		{name: "ExtFromText", link: utils.HTMLLink{Href: "https://jwch.fzu.edu.cn/system/_content/download.jsp?id=1", Text: "考场.XLSX"}, expectOK: true, expect: ".xlsx"},
+		{name: "ExtFromPath", link: utils.HTMLLink{Href: "https://jwch.fzu.edu.cn/files/a.pdf", Text: "附件"}, expectOK: true, expect: ".pdf"},
+		{name: "DownloadWithoutExt", link: utils.HTMLLink{Href: "https://jwch.fzu.edu.cn/system/_content/download.jsp?id=1", Text: "附件"}, expectOK: true},
+		{name: "NormalLink", link: utils.HTMLLink{Href: "https://jwch.fzu.edu.cn/info/1039/1.htm", Text: "上一条"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ext, ok := noticeAttachmentExt(tc.link)
+			assert.Equal(t, tc.expectOK, ok)
+			assert.Equal(t, tc.expect, ext)
+		})
+	}
+}
+
+func TestMirrorNoticeAttachment(t *testing.T) {
+	type testCase struct {
+		name          string
+		statusCode    int
+		contentLength int
+		body          string
+		expectUpload  bool
+		expectError   error
+	}
+	sourceURL := "https://jwch.fzu.edu.cn/system/_content/download.jsp?id=1"
+	testCases := []testCase{
+		{
+			name:         "Success",
+			statusCode:   http.StatusOK,
+			body:         "xls",
+			expectUpload: true,
+		},
+		{
+			name:          "DeclaredTooLarge",
+			statusCode:    http.StatusOK,
+			contentLength: constants.NoticeAttachmentMaxSize + 1,
+			body:          "xls",
+			expectError:   errNoticeAttachmentTooLarge,
+		},
+		{
+			name:       "NotFound",
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	httpClient, _ := client.NewClient()
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*client.Client).Do).To(
+				func(_ *client.Client, _ context.Context, _ *protocol.Request, resp *protocol.Response) error {
+					resp.SetStatusCode(tc.statusCode)
+					resp.SetBodyString(tc.body)
+					if tc.contentLength > 0 {
+						resp.Header.SetContentLength(tc.contentLength)
+					}
+					return nil
+				}).Build()
+			var uploaded []byte
+			mockey.Mock((*oss.NoticeAttachmentOSSCli).GenerateAttachmentPath).Return("https://oss.example.com/notice/1/a.xls", "notice/1/a.xls").Build()
+			mockey.Mock((*oss.NoticeAttachmentOSSCli).Upload).To(func(_ *oss.NoticeAttachmentOSSCli, file []byte, _ string) error {
+				uploaded = file
+				return nil
+			}).Build()
+
+			commonService := NewCommonService(context.Background(), &base.ClientSet{HzClient: httpClient}, new(taskqueue.BaseTaskQueue))
+			commonService.ossClient = new(oss.NoticeAttachmentOSSCli)
+			mirror, err := commonService.mirrorNoticeAttachment(1, sourceURL, ".xls")
+
+			switch {
+			case tc.expectError != nil:
+				assert.ErrorIs(t, err, tc.expectError)
+			case !tc.expectUpload:
+				assert.Error(t, err)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, "https://oss.example.com/notice/1/a.xls", mirror)
+			}
+			if tc.expectUpload {
+				assert.Equal(t, []byte(tc.body), uploaded)
+			} else {
+				assert.Nil(t, uploaded)
+			}
+		})
+	}
+}
@@ -18,11 +18,14 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/west2-online/fzuhelper-server/kitex_gen/common"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/jwch"
 )
 
@@ -38,8 +41,13 @@ func (s *CommonService) GetTermList() (*jwch.SchoolCalendar, error) {
 		}
 	}
 
+	if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		return nil, fmt.Errorf("service.GetTermList: %w", err)
+	}
 	// 校历页面不需要鉴权
+	start := time.Now()
 	calendar, err := jwch.NewStudent().GetSchoolCalendar()
+	metrics.ObserveUpstream(governor.HostJwch, "GetSchoolCalendar", start)
 	if err = base.HandleJwchError(err); err != nil {
 		logger.Errorf("service.GetTermList: fetch school calendar failed, err=%v", err)
 		return nil, fmt.Errorf("service.GetTermList: Get term list failed %w", err)
@@ -67,7 +75,12 @@ func (s *CommonService) GetTerm(req *common.TermRequest) (bool, *jwch.CalTermEve
 		return true, events, nil
 	}
 
+	if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		return false, nil, fmt.Errorf("service.GetTerm: %w", err)
+	}
+	start := time.Now()
 	events, err = jwch.NewStudent().GetTermEvents(req.Term)
+	metrics.ObserveUpstream(governor.HostJwch, "GetTermEvents", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return false, nil, fmt.Errorf("service.GetTerm: Get term  failed %w", err)
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	metainfoContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
 	"github.com/west2-online/fzuhelper-server/pkg/singleflight"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -67,6 +68,19 @@ func (s *CourseServiceImpl) GetCourseList(ctx context.Context, req *course.Cours
 			return svc.GetCourseList(req, loginData)
 		}
 	})
+	if base.ShouldServeStale(err) {
+		// 教务处不可用时降级返回数据库中的课表快照
+		snapshot, snapshotAt, snapshotErr := service.NewCourseService(ctx, s.ClientSet, s.taskQueue).GetCourseListSnapshot(req, loginData)
+		if snapshotErr != nil {
+			logger.Errorf("Course.GetCourseList: get course snapshot failed: %v", snapshotErr)
+		} else if snapshot != nil {
+			stale, snapshotTime := true, snapshotAt.UnixMilli()
+			resp.Base = base.BuildSuccessResp()
+			resp.Data = snapshot
+			resp.Stale, resp.SnapshotTime = &stale, &snapshotTime
+			return resp, nil
+		}
+	}
 	if err != nil {
 		resp.Base = base.BuildBaseResp(err)
 		return resp, nil
@@ -94,6 +108,19 @@ func (s *CourseServiceImpl) GetTermList(ctx context.Context, req *course.TermLis
 			return svc.GetTermsList(loginData)
 		}
 	})
+	if base.ShouldServeStale(err) {
+		// 教务处不可用时降级返回数据库中的学期列表快照
+		snapshot, snapshotAt, snapshotErr := service.NewCourseService(ctx, s.ClientSet, s.taskQueue).GetTermsListSnapshot(loginData)
+		if snapshotErr != nil {
+			logger.Errorf("Course.GetTermList: get terms snapshot failed: %v", snapshotErr)
+		} else if snapshot != nil {
+			stale, snapshotTime := true, snapshotAt.UnixMilli()
+			resp.Base = base.BuildSuccessResp()
+			resp.Data = snapshot
+			resp.Stale, resp.SnapshotTime = &stale, &snapshotTime
+			return resp, nil
+		}
+	}
 	if err != nil {
 		resp.Base = base.BuildBaseResp(err)
 		return resp, nil
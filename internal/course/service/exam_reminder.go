@@ -0,0 +1,250 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/config"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+// 教务处考试时间形如 "2026年6月20日 09:00-11:00 旗山校区"，只取开始时间
+var examStartPattern = regexp.MustCompile(`(\d{4})年(\d{1,2})月(\d{1,2})日\s*(\d{1,2}):(\d{2})`)
+
+// parseExamStartTime 解析考试开始时间，无法解析时返回 false，这类考试不安排提醒
+func parseExamStartTime(raw string) (time.Time, bool) {
+	match := examStartPattern.FindStringSubmatch(raw)
+	if match == nil {
+		return time.Time{}, false
+	}
+	parts := make([]int, 0, len(match)-1)
+	for _, s := range match[1:] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return time.Time{}, false
+		}
+		parts = append(parts, n)
+	}
+	start := time.Date(parts[0], time.Month(parts[1]), parts[2], parts[3], parts[4], 0, 0, constants.ChinaTZ)
+	// time.Date 会把越界的日期归一化，这里要求解析结果与原文一致
+	if start.Month() != time.Month(parts[1]) || start.Day() != parts[2] || start.Hour() != parts[3] {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// examReminderOffsets 返回配置的提醒提前量，未开启提醒时返回 nil
+func examReminderOffsets() []time.Duration {
+	if config.ExamReminder == nil || !config.ExamReminder.Enabled {
+		return nil
+	}
+	offsets := make([]time.Duration, 0, len(config.ExamReminder.OffsetMinutes))
+	for _, minutes := range config.ExamReminder.OffsetMinutes {
+		if minutes <= 0 {
+			continue
+		}
+		offsets = append(offsets, time.Duration(minutes)*time.Minute)
+	}
+	if len(offsets) == 0 {
+		offsets = append(offsets, constants.ExamReminderDefaultOffset)
+	}
+	return offsets
+}
+
+func examReminderKey(tag, term, examTime string, offset time.Duration) string {
+	return utils.SHA256(strings.Join([]string{tag, term, examTime, strconv.FormatInt(int64(offset/time.Minute), 10)}, "|"))
+}
+
+// syncExamReminders 根据新旧考试快照登记或取消考前提醒
+// 提醒按考试 tag 去重，同一门考试无论多少学生刷新课表都只会推送一次
+// 个别学生的快照可能过期或不完整，因此只有所有学生最近一次快照中都不再出现旧考试时间时才取消旧时间的提醒，
+// 调用前需要先通过 syncExamSubscribers 记录本次快照
+func (s *CourseService) syncExamReminders(term string, oldExams, exams []CourseExamInfo) error {
+	offsets := examReminderOffsets()
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	newByIdentity := make(map[string]CourseExamInfo, len(exams))
+	for _, exam := range exams {
+		newByIdentity[courseExamIdentity(exam)] = exam
+	}
+	// 考试时间变化或被清除时，确认没有学生的快照仍是旧时间后再取消旧时间下尚未推送的提醒
+	for _, oldExam := range oldExams {
+		newExam := newByIdentity[courseExamIdentity(oldExam)]
+		if newExam.ExamTime == oldExam.ExamTime {
+			continue
+		}
+		tag := courseExamTag(oldExam)
+		remaining, err := s.db.Course.CountExamSubscribers(s.ctx, tag, term, oldExam.ExamTime)
+		if err != nil {
+			return err
+		}
+		if remaining > 0 {
+			continue
+		}
+		if _, err = s.db.Course.CancelExamReminders(s.ctx, tag, term, oldExam.ExamTime); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	for _, exam := range exams {
+		start, ok := parseExamStartTime(exam.ExamTime)
+		if !ok || !start.After(now) {
+			continue
+		}
+		tag := courseExamTag(exam)
+		for _, offset := range offsets {
+			remindAt := start.Add(-offset)
+			if remindAt.Before(now) {
+				continue
+			}
+			id, err := s.sf.NextVal()
+			if err != nil {
+				return err
+			}
+			// 重复登记由 reminder_key 唯一索引保证幂等，已取消的提醒恢复为待推送
+			if _, err = s.db.Course.UpsertExamReminder(s.ctx, &model.ExamReminder{
+				Id:            id,
+				ReminderKey:   examReminderKey(tag, term, exam.ExamTime, offset),
+				Tag:           tag,
+				Term:          term,
+				Name:          exam.Name,
+				ExamTime:      exam.ExamTime,
+				OffsetMinutes: int64(offset / time.Minute),
+				RemindAt:      remindAt,
+				Status:        model.ExamReminderPending,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// syncExamSubscribers 记录学生最近一次快照中的课程及其考试时间，并删除快照中已不存在的课程
+func (s *CourseService) syncExamSubscribers(stuId, term string, courses []CourseExamInfo) error {
+	subscribers := make([]*model.ExamSubscriber, 0, len(courses))
+	tags := make([]string, 0, len(courses))
+	for _, course := range courses {
+		id, err := s.sf.NextVal()
+		if err != nil {
+			return err
+		}
+		tag := courseExamTag(course)
+		tags = append(tags, tag)
+		subscribers = append(subscribers, &model.ExamSubscriber{
+			Id:       id,
+			Tag:      tag,
+			Term:     term,
+			StuId:    stuId,
+			ExamTime: course.ExamTime,
+		})
+	}
+	if err := s.db.Course.UpsertExamSubscribers(s.ctx, subscribers); err != nil {
+		return err
+	}
+	return s.db.Course.DeleteExamSubscribers(s.ctx, stuId, term, tags)
+}
+
+// DispatchExamReminders 推送已到点的考前提醒，由定时任务调用
+// 同一考试同时到点的多条提醒只推送提前量最小的一条，其余直接标记为已取消
+func (s *CourseService) DispatchExamReminders() error {
+	reminders, err := s.db.Course.ListDueExamReminders(s.ctx, time.Now(), constants.ExamReminderBatchSize)
+	if err != nil {
+		return err
+	}
+
+	latest := make(map[string]*model.ExamReminder, len(reminders))
+	for _, reminder := range reminders {
+		key := reminder.Tag + "|" + reminder.Term + "|" + reminder.ExamTime
+		if cur, ok := latest[key]; !ok || reminder.OffsetMinutes < cur.OffsetMinutes {
+			latest[key] = reminder
+		}
+	}
+
+	now := time.Now()
+	for _, reminder := range reminders {
+		key := reminder.Tag + "|" + reminder.Term + "|" + reminder.ExamTime
+		start, ok := parseExamStartTime(reminder.ExamTime)
+		if latest[key] != reminder || !ok || !start.After(now) {
+			// 被更近的提醒覆盖或考试已经开始，不再推送
+			if _, err = s.db.Course.UpdateExamReminderStatus(s.ctx, reminder.Id,
+				model.ExamReminderPending, model.ExamReminderCancelled); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// 接收者为最近一次快照中仍是该考试时间或还没有考试时间的学生，查询失败时保持待推送，等待下一次扫描
+		stuIds, err := s.db.Course.ListExamSubscriberIDs(s.ctx, reminder.Tag, reminder.Term, reminder.ExamTime, "")
+		if err != nil {
+			return err
+		}
+		claimed, err := s.db.Course.UpdateExamReminderStatus(s.ctx, reminder.Id,
+			model.ExamReminderPending, model.ExamReminderSent)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		msg := examReminderNotification(reminder, start)
+		msg.StuIDs = stuIds
+		err = s.notifier.Notify(s.ctx, msg)
+		if errors.Is(err, notification.ErrPushQueueFull) {
+			// 友盟队列已满，退回待推送状态，等待下一次扫描
+			if _, err = s.db.Course.UpdateExamReminderStatus(s.ctx, reminder.Id,
+				model.ExamReminderSent, model.ExamReminderPending); err != nil {
+				return err
+			}
+			logger.Warnf("service.DispatchExamReminders: umeng queue is full, reminder %d deferred", reminder.Id)
+			return nil
+		}
+		if err != nil {
+			logger.Errorf("service.DispatchExamReminders: notify reminder %d failed: %v", reminder.Id, err)
+		}
+	}
+	return nil
+}
+
+// examReminderNotification 按学号推送到学生登记的设备，按各自的通知偏好过滤，同一平台的设备合并为一次列播；
+// 同时按考试 tag 推送，送达还没有登记设备的客户端。接收者由调用方根据考试快照填入 StuIDs
+func examReminderNotification(reminder *model.ExamReminder, start time.Time) *notification.Message {
+	return &notification.Message{
+		ID:          fmt.Sprintf("exam-reminder-%d", reminder.Id),
+		Type:        constants.UmengPushTypeExam,
+		Title:       "考试提醒",
+		Text:        fmt.Sprintf("%s将于%s开始考试", reminder.Name, start.Format("01月02日 15:04")),
+		Keywords:    []string{reminder.Name},
+		Description: fmt.Sprintf("考试提醒%v", reminder.Tag[:12]),
+		Deeplink:    constants.UmengExamRoomDeeplink,
+		Tags:        []string{reminder.Tag},
+		Unicast:     true,
+	}
+}
@@ -0,0 +1,592 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/config"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	dbcourse "github.com/west2-online/fzuhelper-server/pkg/db/course"
+	dbmodel "github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbnotification "github.com/west2-online/fzuhelper-server/pkg/db/notification"
+	dbuser "github.com/west2-online/fzuhelper-server/pkg/db/user"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/fzuhelper-server/pkg/umeng"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func formatExamTime(t time.Time) string {
+	t = t.In(constants.ChinaTZ)
+	return t.Format("2006年1月2日 15:04") + "-" + t.Add(2*time.Hour).Format("15:04") + " 旗山校区"
+}
+
+func TestParseExamStartTime(t *testing.T) {
+	testCases := []struct {
+		name     string
+		raw      string
+		expectOK bool
+		expected time.Time
+	}{
+		{
+			name:     "standard exam time",
+			raw:      "2026年6月20日 09:00-11:00 旗山校区",
+			expectOK: true,
+			expected: time.Date(2026, 6, 20, 9, 0, 0, 0, constants.ChinaTZ),
+		},
+		{
+			name:     "padded month and day",
+			raw:      "2026年06月02日 14:30-16:30",
+			expectOK: true,
+			expected: time.Date(2026, 6, 2, 14, 30, 0, 0, constants.ChinaTZ),
+		},
+		{name: "unparsable text", raw: "待定"},
+		{name: "invalid date", raw: "2026年2月30日 09:00-11:00"},
+		{name: "invalid hour", raw: "2026年6月20日 25:00-26:00"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, ok := parseExamStartTime(tc.raw)
+			assert.Equal(t, tc.expectOK, ok)
+			if tc.expectOK {
+				assert.True(t, tc.expected.Equal(start))
+			}
+		})
+	}
+}
+
+func TestExamReminderOffsets(t *testing.T) {
+	assert.NoError(t, config.InitForTest("course"))
+	cfg := config.ExamReminder
+
+	testCases := []struct {
+		name     string
+		missing  bool
+		enabled  bool
+		minutes  []int64
+		expected []time.Duration
+	}{
+		{name: "not configured", missing: true},
+		{name: "disabled", minutes: []int64{60}},
+		{name: "default offset", enabled: true, expected: []time.Duration{constants.ExamReminderDefaultOffset}},
+		{
+			name:     "configured offsets ignore invalid values",
+			enabled:  true,
+			minutes:  []int64{1440, 0, -5, 60},
+			expected: []time.Duration{24 * time.Hour, time.Hour},
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			defer func() { config.ExamReminder = cfg }()
+			config.ExamReminder.Enabled = tc.enabled
+			config.ExamReminder.OffsetMinutes = tc.minutes
+			if tc.missing {
+				config.ExamReminder = nil
+			}
+			assert.Equal(t, tc.expected, examReminderOffsets())
+		})
+	}
+}
+
+func TestSyncExamReminders(t *testing.T) {
+	assert.NoError(t, config.InitForTest("course"))
+	future := formatExamTime(time.Now().Add(72 * time.Hour))
+	soon := formatExamTime(time.Now().Add(30 * time.Minute))
+	past := formatExamTime(time.Now().Add(-time.Hour))
+
+	type testCase struct {
+		name          string
+		oldExams      []CourseExamInfo
+		exams         []CourseExamInfo
+		disabled      bool
+		remaining     int64 // 其他学生快照中仍为旧考试时间的人数
+		createError   error
+		cancelError   error
+		expectError   bool
+		expectCreated int
+		expectCancels []string
+	}
+
+	testCases := []testCase{
+		{
+			name:          "disabled reminders",
+			exams:         []CourseExamInfo{{Name: "数据结构", ExamTime: future}},
+			disabled:      true,
+			expectCreated: 0,
+		},
+		{
+			name:          "future exam registers every offset",
+			exams:         []CourseExamInfo{{Name: "数据结构", ExamTime: future}},
+			expectCreated: 2,
+		},
+		{
+			name:          "offsets already passed are skipped",
+			exams:         []CourseExamInfo{{Name: "数据结构", ExamTime: soon}},
+			expectCreated: 0,
+		},
+		{
+			name: "past and unparsable exams are skipped",
+			exams: []CourseExamInfo{
+				{Name: "数据结构", ExamTime: past},
+				{Name: "高等数学", ExamTime: "待定"},
+			},
+			expectCreated: 0,
+		},
+		{
+			name:          "confirmed change cancels reminders of old time",
+			oldExams:      []CourseExamInfo{{Name: "数据结构", ExamTime: "旧时间"}, {Name: "高等数学", ExamTime: "旧时间2"}},
+			exams:         []CourseExamInfo{{Name: "数据结构", ExamTime: future}},
+			expectCreated: 2,
+			expectCancels: []string{"旧时间", "旧时间2"},
+		},
+		{
+			// 其他学生的快照仍是旧时间，可能是本次快照过期或不完整，不取消共享的提醒
+			name:      "unconfirmed change keeps reminders of old time",
+			oldExams:  []CourseExamInfo{{Name: "数据结构", ExamTime: "旧时间"}},
+			exams:     []CourseExamInfo{},
+			remaining: 1,
+		},
+		{
+			name:          "unchanged exam does not cancel",
+			oldExams:      []CourseExamInfo{{Name: "数据结构", ExamTime: future}},
+			exams:         []CourseExamInfo{{Name: "数据结构", ExamTime: future}},
+			expectCreated: 2,
+		},
+		{
+			name:        "cancel error",
+			oldExams:    []CourseExamInfo{{Name: "数据结构", ExamTime: "旧时间"}},
+			cancelError: assert.AnError,
+			expectError: true,
+		},
+		{
+			name:        "create error",
+			exams:       []CourseExamInfo{{Name: "数据结构", ExamTime: future}},
+			createError: assert.AnError,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			config.ExamReminder.Enabled = !tc.disabled
+			config.ExamReminder.OffsetMinutes = []int64{1440, 60}
+
+			mockClientSet := &base.ClientSet{
+				SFClient:    new(utils.Snowflake),
+				DBClient:    new(db.Database),
+				CacheClient: new(cache.Cache),
+			}
+			mockey.Mock((*utils.Snowflake).NextVal).Return(int64(1), nil).Build()
+			created := make([]*dbmodel.ExamReminder, 0)
+			mockey.Mock((*dbcourse.DBCourse).UpsertExamReminder).
+				To(func(_ context.Context, reminder *dbmodel.ExamReminder) (bool, error) {
+					if tc.createError != nil {
+						return false, tc.createError
+					}
+					created = append(created, reminder)
+					return true, nil
+				}).Build()
+			cancels := make([]string, 0)
+			mockey.Mock((*dbcourse.DBCourse).CancelExamReminders).
+				To(func(_ context.Context, tag, term, examTime string) (int64, error) {
+					cancels = append(cancels, examTime)
+					return 1, tc.cancelError
+				}).Build()
+			mockey.Mock((*dbcourse.DBCourse).CountExamSubscribers).Return(tc.remaining, nil).Build()
+
+			err := NewCourseService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue)).
+				syncExamReminders("202401", tc.oldExams, tc.exams)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, created, tc.expectCreated)
+			for _, reminder := range created {
+				assert.Equal(t, "202401", reminder.Term)
+				assert.Equal(t, dbmodel.ExamReminderPending, reminder.Status)
+				assert.True(t, reminder.RemindAt.After(time.Now()))
+			}
+			if tc.expectCancels == nil {
+				assert.Empty(t, cancels)
+			} else {
+				assert.ElementsMatch(t, tc.expectCancels, cancels)
+			}
+		})
+	}
+}
+
+func TestSyncExamSubscribers(t *testing.T) {
+	type testCase struct {
+		name        string
+		courses     []CourseExamInfo
+		upsertError error
+		expectError bool
+		expectRows  int
+		expectKeep  []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "record every course",
+			courses: []CourseExamInfo{
+				{Name: "数据结构", ExamTime: "2026年6月20日 09:00-11:00"},
+				{Name: "高等数学"},
+			},
+			expectRows: 2,
+			expectKeep: []string{courseExamTag(CourseExamInfo{Name: "数据结构"}), courseExamTag(CourseExamInfo{Name: "高等数学"})},
+		},
+		{name: "empty snapshot removes all courses", expectKeep: []string{}},
+		{
+			name:        "upsert error",
+			courses:     []CourseExamInfo{{Name: "数据结构"}},
+			upsertError: assert.AnError,
+			expectError: true,
+			expectRows:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*utils.Snowflake).NextVal).Return(int64(1), nil).Build()
+			rows := 0
+			mockey.Mock((*dbcourse.DBCourse).UpsertExamSubscribers).
+				To(func(_ context.Context, subscribers []*dbmodel.ExamSubscriber) error {
+					for _, subscriber := range subscribers {
+						assert.Equal(t, "102301517", subscriber.StuId)
+						assert.Equal(t, "202401", subscriber.Term)
+					}
+					rows = len(subscribers)
+					return tc.upsertError
+				}).Build()
+			var keep []string
+			mockey.Mock((*dbcourse.DBCourse).DeleteExamSubscribers).
+				To(func(_ context.Context, stuId, term string, tags []string) error {
+					keep = tags
+					return nil
+				}).Build()
+
+			err := NewCourseService(context.Background(), &base.ClientSet{
+				SFClient: new(utils.Snowflake), DBClient: new(db.Database), CacheClient: new(cache.Cache),
+			}, new(taskqueue.BaseTaskQueue)).syncExamSubscribers("102301517", "202401", tc.courses)
+
+			assert.Equal(t, tc.expectRows, rows)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectKeep, keep)
+		})
+	}
+}
+
+// examReminderStore 按 DBCourse 中提醒与快照记录的约定在内存中模拟 exam_reminders 与 exam_subscribers
+type examReminderStore struct {
+	reminders   map[string]*dbmodel.ExamReminder // reminder_key -> 提醒
+	subscribers map[string]string                // stu_id -> 快照中的考试时间（只有一门考试）
+}
+
+func (m *examReminderStore) mock() {
+	mockey.Mock((*dbcourse.DBCourse).UpsertExamReminder).
+		To(func(_ context.Context, reminder *dbmodel.ExamReminder) (bool, error) {
+			cur, ok := m.reminders[reminder.ReminderKey]
+			if !ok {
+				m.reminders[reminder.ReminderKey] = reminder
+				return true, nil
+			}
+			if cur.Status == dbmodel.ExamReminderCancelled && cur.RemindAt.After(time.Now()) {
+				cur.Status = dbmodel.ExamReminderPending
+				return true, nil
+			}
+			return false, nil
+		}).Build()
+	mockey.Mock((*dbcourse.DBCourse).CancelExamReminders).
+		To(func(_ context.Context, tag, term, examTime string) (int64, error) {
+			var rows int64
+			for _, reminder := range m.reminders {
+				if reminder.ExamTime == examTime && reminder.Status == dbmodel.ExamReminderPending {
+					reminder.Status = dbmodel.ExamReminderCancelled
+					rows++
+				}
+			}
+			return rows, nil
+		}).Build()
+	mockey.Mock((*dbcourse.DBCourse).UpsertExamSubscribers).
+		To(func(_ context.Context, subscribers []*dbmodel.ExamSubscriber) error {
+			for _, subscriber := range subscribers {
+				m.subscribers[subscriber.StuId] = subscriber.ExamTime
+			}
+			return nil
+		}).Build()
+	mockey.Mock((*dbcourse.DBCourse).DeleteExamSubscribers).
+		To(func(_ context.Context, stuId, term string, keepTags []string) error {
+			if len(keepTags) == 0 {
+				delete(m.subscribers, stuId)
+			}
+			return nil
+		}).Build()
+	mockey.Mock((*dbcourse.DBCourse).CountExamSubscribers).
+		To(func(_ context.Context, tag, term, examTime string) (int64, error) {
+			var count int64
+			for _, cur := range m.subscribers {
+				if cur == examTime {
+					count++
+				}
+			}
+			return count, nil
+		}).Build()
+}
+
+// refreshExamSnapshot 按 putExamToDatabase 的顺序处理一次快照刷新
+func refreshExamSnapshot(svc *CourseService, stuId string, oldExams, exams []CourseExamInfo) error {
+	if err := svc.syncExamSubscribers(stuId, "202401", exams); err != nil {
+		return err
+	}
+	return svc.syncExamReminders("202401", oldExams, exams)
+}
+
+// statuses 返回某考试时间下各条提醒的状态
+func (m *examReminderStore) statuses(examTime string) []dbmodel.ExamReminderStatus {
+	statuses := make([]dbmodel.ExamReminderStatus, 0)
+	for _, reminder := range m.reminders {
+		if reminder.ExamTime == examTime {
+			statuses = append(statuses, reminder.Status)
+		}
+	}
+	return statuses
+}
+
+func TestSyncExamRemindersAcrossSnapshots(t *testing.T) {
+	assert.NoError(t, config.InitForTest("course"))
+	t1 := formatExamTime(time.Now().Add(72 * time.Hour))
+	t2 := formatExamTime(time.Now().Add(96 * time.Hour))
+	pending := []dbmodel.ExamReminderStatus{dbmodel.ExamReminderPending, dbmodel.ExamReminderPending}
+	cancelled := []dbmodel.ExamReminderStatus{dbmodel.ExamReminderCancelled, dbmodel.ExamReminderCancelled}
+	exam := func(examTime string) []CourseExamInfo {
+		return []CourseExamInfo{{Name: "数据结构", ExamTime: examTime}}
+	}
+
+	mockey.PatchConvey("exam moved away and back restores its reminders", t, func() {
+		config.ExamReminder.Enabled = true
+		config.ExamReminder.OffsetMinutes = []int64{1440, 60}
+		mockey.Mock((*utils.Snowflake).NextVal).Return(int64(1), nil).Build()
+		store := &examReminderStore{reminders: map[string]*dbmodel.ExamReminder{}, subscribers: map[string]string{}}
+		store.mock()
+		svc := NewCourseService(context.Background(), &base.ClientSet{
+			SFClient: new(utils.Snowflake), DBClient: new(db.Database), CacheClient: new(cache.Cache),
+		}, new(taskqueue.BaseTaskQueue))
+
+		assert.NoError(t, refreshExamSnapshot(svc, "stu1", nil, exam(t1)))
+		assert.NoError(t, refreshExamSnapshot(svc, "stu1", exam(t1), exam(t2)))
+		assert.Equal(t, cancelled, store.statuses(t1))
+		assert.Equal(t, pending, store.statuses(t2))
+
+		assert.NoError(t, refreshExamSnapshot(svc, "stu1", exam(t2), exam(t1)))
+		assert.Equal(t, pending, store.statuses(t1))
+		assert.Equal(t, cancelled, store.statuses(t2))
+	})
+
+	mockey.PatchConvey("one stale snapshot does not cancel the shared reminder", t, func() {
+		config.ExamReminder.Enabled = true
+		config.ExamReminder.OffsetMinutes = []int64{1440, 60}
+		mockey.Mock((*utils.Snowflake).NextVal).Return(int64(1), nil).Build()
+		store := &examReminderStore{reminders: map[string]*dbmodel.ExamReminder{}, subscribers: map[string]string{}}
+		store.mock()
+		svc := NewCourseService(context.Background(), &base.ClientSet{
+			SFClient: new(utils.Snowflake), DBClient: new(db.Database), CacheClient: new(cache.Cache),
+		}, new(taskqueue.BaseTaskQueue))
+
+		assert.NoError(t, refreshExamSnapshot(svc, "stu1", nil, exam(t1)))
+		assert.NoError(t, refreshExamSnapshot(svc, "stu2", nil, exam(t1)))
+		// stu2 的快照不完整，考试暂时消失
+		assert.NoError(t, refreshExamSnapshot(svc, "stu2", exam(t1), nil))
+		assert.Equal(t, pending, store.statuses(t1))
+
+		// 所有学生的快照都确认考试改期后才取消旧时间的提醒
+		assert.NoError(t, refreshExamSnapshot(svc, "stu1", exam(t1), exam(t2)))
+		assert.Equal(t, cancelled, store.statuses(t1))
+		assert.Equal(t, pending, store.statuses(t2))
+	})
+}
+
+func TestDispatchExamReminders(t *testing.T) {
+	future := formatExamTime(time.Now().Add(time.Hour))
+	past := formatExamTime(time.Now().Add(-time.Hour))
+
+	type testCase struct {
+		name           string
+		reminders      []*dbmodel.ExamReminder
+		listError      error
+		claimFailed    bool
+		enqueueFailed  bool
+		subscriberErr  error
+		expectError    bool
+		expectEnqueue  int
+		expectInbox    []string
+		expectStatuses map[int64]dbmodel.ExamReminderStatus
+	}
+
+	testCases := []testCase{
+		{
+			name:           "list error",
+			listError:      assert.AnError,
+			expectError:    true,
+			expectStatuses: map[int64]dbmodel.ExamReminderStatus{},
+		},
+		{
+			name: "due reminder is pushed",
+			reminders: []*dbmodel.ExamReminder{
+				{Id: 1, Tag: "0123456789abcdef", Term: "202401", Name: "数据结构", ExamTime: future, OffsetMinutes: 1440},
+			},
+			expectEnqueue:  2,
+			expectInbox:    []string{"102301517", "102301518"},
+			expectStatuses: map[int64]dbmodel.ExamReminderStatus{1: dbmodel.ExamReminderSent},
+		},
+		{
+			name: "subscriber lookup error keeps reminder pending",
+			reminders: []*dbmodel.ExamReminder{
+				{Id: 1, Tag: "0123456789abcdef", Term: "202401", Name: "数据结构", ExamTime: future, OffsetMinutes: 1440},
+			},
+			subscriberErr:  assert.AnError,
+			expectError:    true,
+			expectStatuses: map[int64]dbmodel.ExamReminderStatus{},
+		},
+		{
+			name: "only the nearest reminder of one exam is pushed",
+			reminders: []*dbmodel.ExamReminder{
+				{Id: 1, Tag: "0123456789abcdef", Term: "202401", Name: "数据结构", ExamTime: future, OffsetMinutes: 1440},
+				{Id: 2, Tag: "0123456789abcdef", Term: "202401", Name: "数据结构", ExamTime: future, OffsetMinutes: 60},
+			},
+			expectEnqueue: 2,
+			expectInbox:   []string{"102301517", "102301518"},
+			expectStatuses: map[int64]dbmodel.ExamReminderStatus{
+				1: dbmodel.ExamReminderCancelled,
+				2: dbmodel.ExamReminderSent,
+			},
+		},
+		{
+			name: "started exam is cancelled",
+			reminders: []*dbmodel.ExamReminder{
+				{Id: 1, Tag: "0123456789abcdef", Term: "202401", Name: "数据结构", ExamTime: past, OffsetMinutes: 60},
+			},
+			expectStatuses: map[int64]dbmodel.ExamReminderStatus{1: dbmodel.ExamReminderCancelled},
+		},
+		{
+			name: "reminder claimed by another instance is skipped",
+			reminders: []*dbmodel.ExamReminder{
+				{Id: 1, Tag: "0123456789abcdef", Term: "202401", Name: "数据结构", ExamTime: future, OffsetMinutes: 60},
+			},
+			claimFailed:    true,
+			expectStatuses: map[int64]dbmodel.ExamReminderStatus{},
+		},
+		{
+			name: "full queue restores pending status",
+			reminders: []*dbmodel.ExamReminder{
+				{Id: 1, Tag: "0123456789abcdef", Term: "202401", Name: "数据结构", ExamTime: future, OffsetMinutes: 60},
+			},
+			enqueueFailed:  true,
+			expectEnqueue:  2,
+			expectInbox:    []string{"102301517", "102301518"},
+			expectStatuses: map[int64]dbmodel.ExamReminderStatus{1: dbmodel.ExamReminderPending},
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				SFClient:    new(utils.Snowflake),
+				DBClient:    new(db.Database),
+				CacheClient: new(cache.Cache),
+			}
+			mockey.Mock((*dbcourse.DBCourse).ListDueExamReminders).Return(tc.reminders, tc.listError).Build()
+			statuses := make(map[int64]dbmodel.ExamReminderStatus)
+			mockey.Mock((*dbcourse.DBCourse).UpdateExamReminderStatus).
+				To(func(_ context.Context, id int64, from, to dbmodel.ExamReminderStatus) (bool, error) {
+					if tc.claimFailed && to == dbmodel.ExamReminderSent {
+						return false, nil
+					}
+					statuses[id] = to
+					return true, nil
+				}).Build()
+			mockey.Mock((*dbcourse.DBCourse).ListExamSubscriberIDs).
+				To(func(_ context.Context, tag, term string, examTimes ...string) ([]string, error) {
+					// 快照中还没有考试时间的学生同样需要提醒
+					assert.Equal(t, []string{future, ""}, examTimes)
+					return []string{"102301517", "102301518"}, tc.subscriberErr
+				}).Build()
+			mockey.Mock((*dbuser.DBUser).ListNotificationPreferencesByStuIDs).Return(nil, nil).Build()
+			mockey.Mock((*dbuser.DBUser).ListDevicesByStuIDs).Return([]*dbmodel.Device{
+				{StuId: "102301517", DeviceToken: "token-a", Platform: constants.DevicePlatformAndroid},
+				{StuId: "102301518", DeviceToken: "token-b", Platform: constants.DevicePlatformAndroid},
+			}, nil).Build()
+			inbox := make([]string, 0)
+			mockey.Mock((*dbnotification.DBNotification).CreateNotifications).
+				To(func(_ context.Context, list []*dbmodel.Notification) error {
+					for _, item := range list {
+						inbox = append(inbox, item.StuId)
+					}
+					return nil
+				}).Build()
+			enqueueCount := 0
+			mockey.Mock(umeng.Enqueue).To(func(_ context.Context, _ *umeng.Task) error {
+				enqueueCount++
+				if tc.enqueueFailed {
+					return umeng.ErrQueueFull
+				}
+				return nil
+			}).Build()
+
+			err := NewCourseService(context.Background(), mockClientSet, new(taskqueue.BaseTaskQueue)).
+				DispatchExamReminders()
+
+			assert.Equal(t, tc.expectStatuses, statuses)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectEnqueue, enqueueCount)
+			assert.ElementsMatch(t, tc.expectInbox, inbox)
+		})
+	}
+}
+
+func TestExamReminderNotification(t *testing.T) {
+	start := time.Date(2026, 6, 20, 9, 0, 0, 0, constants.ChinaTZ)
+	msg := examReminderNotification(&dbmodel.ExamReminder{Id: 7, Tag: "0123456789abcdef", Name: "数据结构"}, start)
+
+	// 同一条提醒重复入队时由推送队列按幂等键去重
+	assert.Equal(t, "exam-reminder-7", msg.ID)
+	// 未登记设备的旧版客户端通过考试 tag 收到提醒
+	assert.Equal(t, []string{"0123456789abcdef"}, msg.Tags)
+	assert.True(t, msg.Unicast)
+	assert.Equal(t, "数据结构将于06月20日 09:00开始考试", msg.Text)
+	assert.Empty(t, msg.StuIDs)
+}
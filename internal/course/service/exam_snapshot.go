@@ -58,6 +58,35 @@ func buildCourseExamInfo(courses []*jwch.Course) []CourseExamInfo {
 	return exams
 }
 
+// buildCourseEnrollment 提取课表中的全部课程，没有考试时间的课程 ExamTime 为空，用于记录考试通知的接收者
+func buildCourseEnrollment(courses []*jwch.Course) []CourseExamInfo {
+	byIdentity := make(map[string]CourseExamInfo, len(courses))
+	for _, course := range courses {
+		if course == nil {
+			continue
+		}
+		info := CourseExamInfo{
+			Name:     course.Name,
+			Teacher:  course.Teacher,
+			Credit:   course.Credits,
+			ExamTime: strings.TrimSpace(course.RawExamTime),
+		}
+		// 同一课程出现多次时保留带考试时间的一条
+		if cur, ok := byIdentity[courseExamIdentity(info)]; ok && cur.ExamTime != "" {
+			continue
+		}
+		byIdentity[courseExamIdentity(info)] = info
+	}
+	enrollment := make([]CourseExamInfo, 0, len(byIdentity))
+	for _, info := range byIdentity {
+		enrollment = append(enrollment, info)
+	}
+	sort.Slice(enrollment, func(i, j int) bool {
+		return courseExamIdentity(enrollment[i]) < courseExamIdentity(enrollment[j])
+	})
+	return enrollment
+}
+
 func courseExamIdentity(exam CourseExamInfo) string {
 	return strings.Join([]string{exam.Name, exam.Teacher, exam.Credit}, "|")
 }
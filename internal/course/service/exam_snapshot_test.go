@@ -19,11 +19,9 @@ package service
 import (
 	"testing"
 
-	"github.com/bytedance/mockey"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
-	"github.com/west2-online/fzuhelper-server/pkg/umeng"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
 )
@@ -160,48 +158,34 @@ func TestBuildCourseExamChanges(t *testing.T) {
 	}
 }
 
-func TestCourseServiceSendExamNotification(t *testing.T) {
-	tests := []struct {
-		name       string
-		androidErr error
-		iosErr     error
-	}{
-		{
-			name:       "AndroidErrorIgnored",
-			androidErr: assert.AnError,
-		},
-		{
-			name:   "IOSErrorIgnored",
-			iosErr: assert.AnError,
-		},
-	}
-
-	for _, tt := range tests {
-		mockey.PatchConvey(tt.name, t, func() {
-			mockey.Mock(umeng.SendAndroidGroupcastWithGoApp).To(
-				func(pushType, title, text, ticker, tag, description, deeplink string, keywords []string) error {
-					assert.Equal(t, constants.UmengPushTypeExam, pushType)
-					assert.Equal(t, "fzuhelper://exam-room", deeplink)
-					return tt.androidErr
-				},
-			).Build()
-			mockey.Mock(umeng.SendIOSGroupcast).To(
-				func(title, subtitle, body, tag, description, deeplink string) error {
-					assert.Equal(t, "fzuhelper://exam-room", deeplink)
-					return tt.iosErr
-				},
-			).Build()
-			mockey.Mock(umeng.SendHarmonyGroupcast).To(
-				func(title, text, tag, description, deeplink string) error {
-					assert.Equal(t, "fzuhelper://exam-room", deeplink)
-					return nil
-				},
-			).Build()
+func TestBuildCourseEnrollment(t *testing.T) {
+	enrollment := buildCourseEnrollment([]*jwch.Course{
+		{Name: "高等数学", Teacher: "李老师", Credits: "5.0"},
+		nil,
+		{Name: "数据结构", Teacher: "张老师", Credits: "4.0", RawExamTime: " 2026年6月20日 09:00-11:00 "},
+		{Name: "高等数学", Teacher: "李老师", Credits: "5.0", RawExamTime: "2026年6月21日 09:00-11:00"},
+		{Name: "高等数学", Teacher: "李老师", Credits: "5.0"},
+	})
+
+	// 没有考试时间的课程同样记录，同一课程出现多次时保留带考试时间的一条
+	assert.Equal(t, []CourseExamInfo{
+		{Name: "数据结构", Teacher: "张老师", Credit: "4.0", ExamTime: "2026年6月20日 09:00-11:00"},
+		{Name: "高等数学", Teacher: "李老师", Credit: "5.0", ExamTime: "2026年6月21日 09:00-11:00"},
+	}, enrollment)
+}
 
-			new(CourseService).sendExamNotification(courseExamChange{
-				Tag:  utils.MD5("数据结构|张老师|4.0"),
-				Exam: CourseExamInfo{Name: "数据结构"},
-			})
-		})
-	}
+func TestExamNotification(t *testing.T) {
+	tag := utils.MD5("数据结构|张老师|4.0")
+	msg := examNotification(courseExamChange{
+		Tag:  tag,
+		Exam: CourseExamInfo{Name: "数据结构"},
+	})
+
+	assert.Equal(t, constants.UmengPushTypeExam, msg.Type)
+	assert.Equal(t, "数据结构考试已更新", msg.Text)
+	assert.Equal(t, "考试信息更新"+tag[:12], msg.Description)
+	assert.Equal(t, constants.UmengExamRoomDeeplink, msg.Deeplink)
+	assert.Empty(t, msg.Tags)
+	assert.Empty(t, msg.StuIDs)
+	assert.True(t, msg.Unicast)
 }
@@ -22,6 +22,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/bytedance/sonic"
 
@@ -34,8 +35,11 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/db/model"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
-	"github.com/west2-online/fzuhelper-server/pkg/umeng"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
 	"github.com/west2-online/yjsy"
@@ -70,9 +74,16 @@ func (s *CourseService) GetCourseList(req *course.CourseListRequest, loginData *
 		}
 	}
 
+	if err = governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		// 教务处熔断或限流时由 handler 降级返回带 stale 标记的课表快照
+		return nil, fmt.Errorf("service.GetCourseList: %w", err)
+	}
+
 	stu := jwch.NewStudent().WithLoginData(loginData.GetId(), utils.ParseCookies(loginData.GetCookies()))
 
+	start := time.Now()
 	terms, err = stu.GetTerms()
+	metrics.ObserveUpstream(governor.HostJwch, "GetTerms", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return nil, fmt.Errorf("service.GetCourseList: Get terms failed: %w", err)
 	}
@@ -82,7 +93,9 @@ func (s *CourseService) GetCourseList(req *course.CourseListRequest, loginData *
 		return nil, errors.New("service.GetCourseList: Invalid term")
 	}
 
+	start = time.Now()
 	courses, err := stu.GetSemesterCourses(req.Term, terms.ViewState, terms.EventValidation)
+	metrics.ObserveUpstream(governor.HostJwch, "GetSemesterCourses", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return nil, fmt.Errorf("service.GetCourseList: Get semester courses failed: %w", err)
 	}
@@ -195,8 +208,10 @@ func (s *CourseService) putExamToDatabase(stuId string, term string, rawCourses
 	if old == nil {
 		return nil
 	}
+	enrollment := buildCourseEnrollment(rawCourses)
 	if old.ExamInfoSHA256 != nil && *old.ExamInfoSHA256 == examInfoSHA256 {
-		return nil
+		// 考试没有变化时仍记录选课，保证之后的考试通知和考前提醒能送达该学生
+		return s.syncExamSubscribers(stuId, term, enrollment)
 	}
 
 	var oldExams []CourseExamInfo
@@ -206,6 +221,18 @@ func (s *CourseService) putExamToDatabase(stuId string, term string, rawCourses
 				"service.putExamToDatabase: decode exam info failed: %v", err)
 		}
 	}
+	// 先取出本次刷新前已记录的课程，用于判断该学生是否已经收到过其他学生触发的通知
+	subscribed, err := s.db.Course.ListExamSubscribedTags(s.ctx, stuId, term)
+	if err != nil {
+		return err
+	}
+	// 考前提醒与快照在同一任务中更新，提醒登记失败时由任务队列整体重试
+	if err = s.syncExamSubscribers(stuId, term, enrollment); err != nil {
+		return err
+	}
+	if err = s.syncExamReminders(term, oldExams, exams); err != nil {
+		return err
+	}
 	if old.ExamInfoSHA256 == nil || *old.ExamInfoSHA256 == "" {
 		// 历史数据没有考试快照时只建立基线，不把已有考试信息当作新增变化通知。
 		return s.updateExamSnapshot(old.Id, examInfo, examInfoSHA256)
@@ -217,10 +244,10 @@ func (s *CourseService) putExamToDatabase(stuId string, term string, rawCourses
 		return s.updateExamSnapshot(old.Id, examInfo, examInfoSHA256)
 	}
 
-	claimed := make([]courseExamChange, 0, len(changes))
 	for _, change := range changes {
 		// CreateExamOffering 依赖 exam_hash 唯一索引原子抢占发送资格。
-		// 返回 nil 表示其他用户已经处理过相同变化，本次不再重复推送。
+		// 抢占成功时通知所有选了这门课的学生；返回 nil 表示其他学生已经触发过相同变化，
+		// 该学生当时已有选课记录时已经收到通知，否则单独补发。
 		offering, createErr := s.db.Course.CreateExamOffering(s.ctx, &model.ExamOffering{
 			ExamHash: change.ExamHash,
 			Tag:      change.Tag,
@@ -228,22 +255,23 @@ func (s *CourseService) putExamToDatabase(stuId string, term string, rawCourses
 		if createErr != nil {
 			return createErr
 		}
-		if offering != nil {
-			claimed = append(claimed, change)
+		msg := examNotification(change)
+		switch {
+		case offering != nil:
+			if msg.StuIDs, err = s.db.Course.ListExamSubscriberIDs(s.ctx, change.Tag, term); err != nil {
+				return err
+			}
+			// 同时按考试 tag 推送，送达还没有登记设备的客户端
+			msg.Tags = []string{change.Tag}
+		case !slices.Contains(subscribed, change.Tag):
+			msg.StuIDs = []string{stuId}
+		default:
+			continue
 		}
-	}
-
-	if len(claimed) == 0 {
-		// 所有变化都已被其他任务去重，本次只同步本地快照，不重复发送通知。
-		return s.updateExamSnapshot(old.Id, examInfo, examInfoSHA256)
-	}
-
-	for _, change := range claimed {
 		// 单个考试变化对应一个 dispatcher task，避免一批变化绕过 Umeng 限流。
-		_ = umeng.EnqueueAsync(func() error {
-			s.sendExamNotification(change)
-			return nil
-		})
+		if err = s.notifier.Notify(s.ctx, msg); err != nil {
+			logger.Errorf("service.putExamToDatabase: notify exam change failed, tag:%v, err:%v", change.Tag, err)
+		}
 	}
 
 	return s.updateExamSnapshot(old.Id, examInfo, examInfoSHA256)
@@ -259,13 +287,17 @@ func (s *CourseService) updateExamSnapshot(id int64, examInfo, examInfoSHA256 st
 	return err
 }
 
-func (s *CourseService) sendExamNotification(change courseExamChange) {
-	// 与成绩通知一致，推送失败仅由 Umeng 任务队列统一记录，不影响业务快照。
-	// 这里直接不返回错误了,直接打印错误日志,因为就是安卓跟iOS都直推送一次,如果错过就直接算了
-	title := "考试更新啦"
-	text := change.Exam.Name + "考试已更新"
-	description := fmt.Sprintf("考试信息更新%v", change.Tag[:12])
-	umeng.PushByType(constants.UmengPushTypeExam, title, text, []string{change.Exam.Name}, "", change.Tag, description, constants.UmengExamRoomDeeplink)
+func examNotification(change courseExamChange) *notification.Message {
+	// 与成绩通知一致，按学号推送并遵循学生的通知偏好，推送失败仅由 Umeng 任务队列统一记录，不影响业务快照。
+	return &notification.Message{
+		Type:        constants.UmengPushTypeExam,
+		Title:       "考试更新啦",
+		Text:        change.Exam.Name + "考试已更新",
+		Keywords:    []string{change.Exam.Name},
+		Description: fmt.Sprintf("考试信息更新%v", change.Tag[:12]),
+		Deeplink:    constants.UmengExamRoomDeeplink,
+		Unicast:     true,
+	}
 }
 
 func (s *CourseService) GetCourseListYjsy(req *course.CourseListRequest, loginData *kitexModel.LoginData) ([]*kitexModel.Course, error) {
@@ -297,9 +329,16 @@ func (s *CourseService) GetCourseListYjsy(req *course.CourseListRequest, loginDa
 		}
 	}
 
+	if err = governor.Acquire(s.ctx, governor.HostYjsy); err != nil {
+		// 教务处熔断或限流时由 handler 降级返回带 stale 标记的课表快照
+		return nil, fmt.Errorf("service.GetCourseListYjsy: %w", err)
+	}
+
 	// 获取学期信息
 	stu := yjsy.NewStudent().WithLoginData(utils.ParseCookies(loginData.Cookies))
+	start := time.Now()
 	terms, err = stu.GetTerms()
+	metrics.ObserveUpstream(governor.HostYjsy, "GetTerms", start)
 	if err = base.HandleYjsyError(err); err != nil {
 		return nil, fmt.Errorf("service.GetCourseListYjsy: Get terms failed: %w", err)
 	}
@@ -310,7 +349,9 @@ func (s *CourseService) GetCourseListYjsy(req *course.CourseListRequest, loginDa
 	}
 
 	// 获取该学期的课程
+	start = time.Now()
 	courses, err := stu.GetSemesterCourses(req.Term)
+	metrics.ObserveUpstream(governor.HostYjsy, "GetSemesterCourses", start)
 	if err = base.HandleYjsyError(err); err != nil {
 		return nil, fmt.Errorf("service.GetCourseListYjsy: Get semester courses failed: %w", err)
 	}
@@ -375,21 +416,38 @@ func (s *CourseService) getSemesterCourses(stuID string, term string, isGraduate
 		}
 		return s.removeDuplicateCourses(pack.BuildCourse(courses)), nil
 	}
+	list, snapshot, err := s.loadCourseSnapshot(stuID, term, isGraduate)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, "service.GetSemesterCourses: there is no course in database, please login app and retry")
+	}
+
+	// 写入 cache
+	s.taskQueue.Add(courseKey, taskqueue.QueueTask{Execute: func() error {
+		return cache.SetSliceCache(s.cache, s.ctx, courseKey, list,
+			constants.CourseTermsKeyExpire, "Course.SetCourseCache")
+	}})
+	return list, nil
+}
+
+// loadCourseSnapshot 读取数据库中持久化的课表并应用调课信息，快照不存在时返回 nil
+func (s *CourseService) loadCourseSnapshot(stuID string, term string, isGraduate bool) ([]*kitexModel.Course, *model.UserCourse, error) {
 	// 从数据中获取课程表
-	var courses *model.UserCourse
-	courses, err = s.db.Course.GetUserTermCourseByStuIdAndTerm(s.ctx, stuID, term)
+	courses, err := s.db.Course.GetUserTermCourseByStuIdAndTerm(s.ctx, stuID, term)
 	if err != nil {
-		return nil, fmt.Errorf("service.GetSemesterCourses: Get courses fail: %w", err)
+		return nil, nil, fmt.Errorf("service.GetSemesterCourses: Get courses fail: %w", err)
 	}
 	if courses == nil {
-		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, "service.GetSemesterCourses: there is no course in database, please login app and retry")
+		return nil, nil, nil
 	}
 	// 将数据库中的课程表进行解析转化
 	list := make([]*kitexModel.Course, 0)
 
 	if courses.TermCourses != "" {
 		if err = sonic.Unmarshal([]byte(courses.TermCourses), &list); err != nil {
-			return nil, fmt.Errorf("service.GetSemesterCourses: Unmarshal fail: %w", err)
+			return nil, nil, fmt.Errorf("service.GetSemesterCourses: Unmarshal fail: %w", err)
 		}
 	}
 
@@ -397,7 +455,7 @@ func (s *CourseService) getSemesterCourses(stuID string, term string, isGraduate
 	if !isGraduate {
 		adjustCourses, err := s.GetAutoAdjustCourseList(term)
 		if err != nil {
-			return nil, fmt.Errorf("service.getSemesterCourses: Get adjust course failed: %w", err)
+			return nil, nil, fmt.Errorf("service.getSemesterCourses: Get adjust course failed: %w", err)
 		}
 
 		for _, c := range list {
@@ -407,12 +465,7 @@ func (s *CourseService) getSemesterCourses(stuID string, term string, isGraduate
 		}
 	}
 
-	// 写入 cache
-	s.taskQueue.Add(courseKey, taskqueue.QueueTask{Execute: func() error {
-		return cache.SetSliceCache(s.cache, s.ctx, courseKey, list,
-			constants.CourseTermsKeyExpire, "Course.SetCourseCache")
-	}})
-	return list, nil
+	return list, courses, nil
 }
 
 func getAdjustRules(scheduleRules []jwch.CourseScheduleRule, adjustCourses []*model.AutoAdjustCourse) (adjustRules []jwch.CourseAdjustRule) {
@@ -30,9 +30,14 @@ import (
 	customContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
 	coursecache "github.com/west2-online/fzuhelper-server/pkg/cache/course"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/db"
 	dbcourse "github.com/west2-online/fzuhelper-server/pkg/db/course"
 	dbmodel "github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbnotification "github.com/west2-online/fzuhelper-server/pkg/db/notification"
+	dbuser "github.com/west2-online/fzuhelper-server/pkg/db/user"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/umeng"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -57,6 +62,7 @@ func TestGetCourseList(t *testing.T) {
 		cacheTermsList       []string
 		term                 string
 		isRefresh            *bool
+		governorError        error
 	}
 
 	mockTerm := &jwch.Term{
@@ -173,6 +179,18 @@ func TestGetCourseList(t *testing.T) {
 			expectResult:      mockResult,
 			isRefresh:         func() *bool { b := true; return &b }(),
 		},
+		{
+			name:          "breaker open with isRefresh leaves fallback to the snapshot",
+			cacheExist:    true,
+			isRefresh:     func() *bool { b := true; return &b }(),
+			governorError: errno.UpstreamBreakerOpen,
+			expectError:   "[50004]",
+		},
+		{
+			name:          "breaker open without cache",
+			governorError: errno.UpstreamBreakerOpen,
+			expectError:   "[50004]",
+		},
 		{
 			name:              "duplicate courses are removed",
 			mockTermsReturn:   mockTerm,
@@ -195,6 +213,8 @@ func TestGetCourseList(t *testing.T) {
 				CacheClient: new(cache.Cache),
 			}
 
+			mockey.Mock(governor.Acquire).Return(tc.governorError).Build()
+
 			mockey.Mock((*jwch.Student).GetTerms).Return(tc.mockTermsReturn, tc.mockTermsError).Build()
 
 			mockey.Mock((*jwch.Student).GetSemesterCourses).Return(tc.mockCoursesReturn, tc.mockCoursesError).Build()
@@ -644,9 +664,13 @@ func TestPutExamToDatabase(t *testing.T) {
 		rawCourses     []*jwch.Course
 		oldCourse      *dbmodel.UserCourse
 		queryError     error
+		offeringTaken  bool
+		subscribed     []string // 本次刷新前已记录的课程
 		expectError    bool
 		expectUpdate   bool
+		expectSynced   bool
 		expectEnqueue  int
+		expectInbox    int
 		expectExamInfo string
 		expectExamHash string
 	}
@@ -656,6 +680,7 @@ func TestPutExamToDatabase(t *testing.T) {
 			name:           "exam snapshot is created after course snapshot",
 			oldCourse:      &dbmodel.UserCourse{Id: 1},
 			expectUpdate:   true,
+			expectSynced:   true,
 			expectExamInfo: examInfo,
 			expectExamHash: examInfoSHA256,
 		},
@@ -663,6 +688,7 @@ func TestPutExamToDatabase(t *testing.T) {
 			name:          "unchanged exam snapshot is not updated",
 			oldCourse:     &dbmodel.UserCourse{Id: 1, ExamInfoSHA256: &examInfoSHA256},
 			expectUpdate:  false,
+			expectSynced:  true,
 			expectEnqueue: 0,
 		},
 		{
@@ -671,7 +697,7 @@ func TestPutExamToDatabase(t *testing.T) {
 			expectError: true,
 		},
 		{
-			name: "changed exams are enqueued one by one",
+			name: "changed exams are pushed to every enrolled student",
 			rawCourses: []*jwch.Course{
 				{Name: "数据结构", Teacher: "张老师", Credits: "4.0", RawExamTime: "新时间"},
 				{Name: "高等数学", Teacher: "李老师", Credits: "5.0", RawExamTime: "新时间2"},
@@ -682,7 +708,47 @@ func TestPutExamToDatabase(t *testing.T) {
 				ExamInfoSHA256: &oldExamInfoSHA256,
 			},
 			expectUpdate:  true,
+			expectSynced:  true,
+			expectEnqueue: 4, // 每个变化按考试 tag 与设备各推送一次
+			expectInbox:   4,
+		},
+		{
+			name: "changes already pushed to the student are skipped",
+			rawCourses: []*jwch.Course{
+				{Name: "数据结构", Teacher: "张老师", Credits: "4.0", RawExamTime: "新时间"},
+				{Name: "高等数学", Teacher: "李老师", Credits: "5.0", RawExamTime: "新时间2"},
+			},
+			oldCourse: &dbmodel.UserCourse{
+				Id:             1,
+				ExamInfo:       &oldExamInfo,
+				ExamInfoSHA256: &oldExamInfoSHA256,
+			},
+			offeringTaken: true,
+			subscribed: []string{
+				courseExamTag(CourseExamInfo{Name: "数据结构", Teacher: "张老师", Credit: "4.0"}),
+				courseExamTag(CourseExamInfo{Name: "高等数学", Teacher: "李老师", Credit: "5.0"}),
+			},
+			expectUpdate:  true,
+			expectSynced:  true,
+			expectEnqueue: 0,
+			expectInbox:   0,
+		},
+		{
+			name: "changes pushed before the student was recorded are sent to the student",
+			rawCourses: []*jwch.Course{
+				{Name: "数据结构", Teacher: "张老师", Credits: "4.0", RawExamTime: "新时间"},
+				{Name: "高等数学", Teacher: "李老师", Credits: "5.0", RawExamTime: "新时间2"},
+			},
+			oldCourse: &dbmodel.UserCourse{
+				Id:             1,
+				ExamInfo:       &oldExamInfo,
+				ExamInfoSHA256: &oldExamInfoSHA256,
+			},
+			offeringTaken: true,
+			expectUpdate:  true,
+			expectSynced:  true,
 			expectEnqueue: 2,
+			expectInbox:   2,
 		},
 	}
 
@@ -698,8 +764,29 @@ func TestPutExamToDatabase(t *testing.T) {
 
 			mockey.Mock((*dbcourse.DBCourse).GetUserTermCourseByStuIdAndTerm).
 				Return(tc.oldCourse, tc.queryError).Build()
+			mockey.Mock((*CourseService).syncExamReminders).Return(nil).Build()
+			synced := false
+			mockey.Mock((*CourseService).syncExamSubscribers).
+				To(func(_ *CourseService, stuId, term string, courses []CourseExamInfo) error {
+					synced = true
+					return nil
+				}).Build()
+			mockey.Mock((*dbcourse.DBCourse).ListExamSubscribedTags).Return(tc.subscribed, nil).Build()
+			mockey.Mock((*dbcourse.DBCourse).ListExamSubscriberIDs).Return([]string{"102301517", "102301518"}, nil).Build()
+			mockey.Mock((*dbuser.DBUser).ListNotificationPreferencesByStuIDs).Return(nil, nil).Build()
+			mockey.Mock((*dbuser.DBUser).ListDevicesByStuIDs).
+				To(func(_ context.Context, stuIDs []string) ([]*dbmodel.Device, error) {
+					devices := make([]*dbmodel.Device, len(stuIDs))
+					for i, stuID := range stuIDs {
+						devices[i] = &dbmodel.Device{StuId: stuID, DeviceToken: "token-" + stuID, Platform: constants.DevicePlatformAndroid}
+					}
+					return devices, nil
+				}).Build()
 			mockey.Mock((*dbcourse.DBCourse).CreateExamOffering).
 				To(func(_ context.Context, offering *dbmodel.ExamOffering) (*dbmodel.ExamOffering, error) {
+					if tc.offeringTaken {
+						return nil, nil
+					}
 					return offering, nil
 				}).Build()
 			var updatedCourse *dbmodel.UserCourse
@@ -708,10 +795,16 @@ func TestPutExamToDatabase(t *testing.T) {
 					updatedCourse = course
 					return course, nil
 				}).Build()
+			inboxCount := 0
+			mockey.Mock((*dbnotification.DBNotification).CreateNotifications).
+				To(func(_ context.Context, list []*dbmodel.Notification) error {
+					inboxCount += len(list)
+					return nil
+				}).Build()
 			enqueueCount := 0
-			mockey.Mock(umeng.EnqueueAsync).To(func(_ func() error) bool {
+			mockey.Mock(umeng.Enqueue).To(func(_ context.Context, _ *umeng.Task) error {
 				enqueueCount++
-				return true
+				return nil
 			}).Build()
 
 			courses := rawCourses
@@ -726,7 +819,9 @@ func TestPutExamToDatabase(t *testing.T) {
 				return
 			}
 			assert.NoError(t, err)
+			assert.Equal(t, tc.expectSynced, synced)
 			assert.Equal(t, tc.expectEnqueue, enqueueCount)
+			assert.Equal(t, tc.expectInbox, inboxCount)
 			if !tc.expectUpdate {
 				assert.Nil(t, updatedCourse)
 				return
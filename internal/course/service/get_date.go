@@ -23,6 +23,8 @@ import (
 	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/jwch"
 )
 
@@ -54,7 +56,12 @@ func (s *CourseService) GetLocateDate() (*model.LocateDate, error) {
 
 func (s *CourseService) fetchAndCacheNewDate(formattedCurrentDate string) (*model.LocateDate, error) {
 	// 缓存不存在或者跨周,重新获取数据
+	if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		return nil, fmt.Errorf("service.GetLocateDate: %w", err)
+	}
+	start := time.Now()
 	locateDate, err := jwch.NewStudent().GetLocateDate()
+	metrics.ObserveUpstream(governor.HostJwch, "GetLocateDate", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return nil, fmt.Errorf("service.GetLocateDate: Get locate date fail %w", err)
 	}
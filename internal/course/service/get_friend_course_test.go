@@ -94,6 +94,26 @@ func (m *mockUserClient) ReorderFriendList(context.Context, *user.ReorderFriendL
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockUserClient) RegisterDevice(context.Context, *user.RegisterDeviceRequest, ...callopt.Option) (*user.RegisterDeviceResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockUserClient) UnregisterDevice(context.Context, *user.UnregisterDeviceRequest, ...callopt.Option) (*user.UnregisterDeviceResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockUserClient) GetNotificationPreference(context.Context, *user.GetNotificationPreferenceRequest, ...callopt.Option) (
+	*user.GetNotificationPreferenceResponse, error,
+) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockUserClient) UpdateNotificationPreference(context.Context, *user.UpdateNotificationPreferenceRequest, ...callopt.Option) (
+	*user.UpdateNotificationPreferenceResponse, error,
+) {
+	return nil, errors.New("not implemented")
+}
+
 func TestGetFriendCourse(t *testing.T) {
 	type testCase struct {
 		name            string
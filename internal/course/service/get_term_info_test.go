@@ -60,6 +60,58 @@ func (m *mockCommonClient) GetNotices(context.Context, *common.NoticeRequest, ..
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockCommonClient) SearchNotices(context.Context, *common.SearchNoticesRequest, ...callopt.Option) (*common.SearchNoticesResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCommonClient) ListNoticeSources(
+	context.Context,
+	*common.ListNoticeSourcesRequest,
+	...callopt.Option,
+) (*common.ListNoticeSourcesResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCommonClient) GetNoticeDetail(context.Context, *common.GetNoticeDetailRequest, ...callopt.Option) (*common.GetNoticeDetailResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCommonClient) ListNoticeSubscriptions(
+	context.Context,
+	*common.ListNoticeSubscriptionsRequest,
+	...callopt.Option,
+) (*common.ListNoticeSubscriptionsResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCommonClient) SubscribeNotice(context.Context, *common.SubscribeNoticeRequest, ...callopt.Option) (*common.SubscribeNoticeResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCommonClient) UnsubscribeNotice(context.Context, *common.UnsubscribeNoticeRequest, ...callopt.Option) (*common.UnsubscribeNoticeResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCommonClient) ListNotifications(context.Context, *common.ListNotificationsRequest, ...callopt.Option) (*common.ListNotificationsResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCommonClient) MarkNotificationsRead(
+	context.Context,
+	*common.MarkNotificationsReadRequest,
+	...callopt.Option,
+) (*common.MarkNotificationsReadResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCommonClient) GetUnreadNotificationCount(
+	context.Context,
+	*common.GetUnreadNotificationCountRequest,
+	...callopt.Option,
+) (*common.GetUnreadNotificationCountResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockCommonClient) GetSignedLocationApiUrl(
 	context.Context,
 	*common.GetSignedLocationApiUrlRequest,
@@ -18,12 +18,15 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/west2-online/fzuhelper-server/internal/course/pack"
 	loginmodel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/base/context"
 	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
@@ -43,8 +46,13 @@ func (s *CourseService) GetTermsList(loginData *loginmodel.LoginData) ([]string,
 		return terms, nil
 	}
 
+	if err := governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		return nil, fmt.Errorf("service.GetTermList: %w", err)
+	}
 	stu := jwch.NewStudent().WithLoginData(loginData.GetId(), utils.ParseCookies(loginData.GetCookies()))
+	start := time.Now()
 	terms, err := stu.GetTerms()
+	metrics.ObserveUpstream(governor.HostJwch, "GetTerms", start)
 	if err = base.HandleJwchError(err); err != nil {
 		return nil, fmt.Errorf("service.GetTermList: Get terms fail: %w", err)
 	}
@@ -71,8 +79,13 @@ func (s *CourseService) GetTermsListYjsy(loginData *loginmodel.LoginData) ([]str
 		return terms, nil
 	}
 
+	if err := governor.Acquire(s.ctx, governor.HostYjsy); err != nil {
+		return nil, fmt.Errorf("service.GetTermListYjsy: %w", err)
+	}
 	stu := yjsy.NewStudent().WithLoginData(utils.ParseCookies(loginData.Cookies))
+	start := time.Now()
 	terms, err := stu.GetTerms()
+	metrics.ObserveUpstream(governor.HostYjsy, "GetTerms", start)
 	if err = base.HandleYjsyError(err); err != nil {
 		return nil, fmt.Errorf("service.GetTermListYjsy: Get terms fail: %w", err)
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/base"
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
 	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
@@ -36,6 +37,7 @@ type CourseService struct {
 	taskQueue    taskqueue.TaskQueue
 	commonClient commonservice.Client
 	userClient   userservice.Client
+	notifier     notification.Notifier
 }
 
 func NewCourseService(ctx context.Context, clientset *base.ClientSet, taskQueue taskqueue.TaskQueue) *CourseService {
@@ -47,5 +49,6 @@ func NewCourseService(ctx context.Context, clientset *base.ClientSet, taskQueue
 		taskQueue:    taskQueue,
 		commonClient: clientset.CommonClient,
 		userClient:   clientset.UserClient,
+		notifier:     notification.New(clientset.DBClient),
 	}
 }
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/internal/course/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/course"
+	kitexModel "github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	metainfoContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+// GetCourseListSnapshot 在教务处不可用时返回数据库中最近一次持久化的课表及其更新时间，并在后台尝试重新拉取
+// 快照不存在时返回 nil
+func (s *CourseService) GetCourseListSnapshot(req *course.CourseListRequest, loginData *kitexModel.LoginData) ([]*kitexModel.Course, time.Time, error) {
+	stuId := metainfoContext.ExtractIDFromLoginData(loginData)
+	isGraduate := utils.IsGraduate(loginData.GetId())
+	list, snapshot, err := s.loadCourseSnapshot(stuId, req.Term, isGraduate)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("service.GetCourseListSnapshot: %w", err)
+	}
+	if snapshot == nil {
+		return nil, time.Time{}, nil
+	}
+
+	// 后台刷新需要绕过缓存，直接访问教务处
+	isRefresh := true
+	refreshReq := &course.CourseListRequest{Term: req.Term, IsRefresh: &isRefresh}
+	s.refreshInBackground(fmt.Sprintf("refreshCourse:%s:%s", stuId, req.Term), func(svc *CourseService) error {
+		var err error
+		if isGraduate {
+			_, err = svc.GetCourseListYjsy(refreshReq, loginData)
+		} else {
+			_, err = svc.GetCourseList(refreshReq, loginData)
+		}
+		return err
+	})
+	return s.removeDuplicateCourses(list), snapshot.UpdatedAt, nil
+}
+
+// GetTermsListSnapshot 在教务处不可用时返回数据库中最近一次持久化的学期列表及其更新时间，并在后台尝试重新拉取
+// 快照不存在时返回 nil
+func (s *CourseService) GetTermsListSnapshot(loginData *kitexModel.LoginData) ([]string, time.Time, error) {
+	stuId := metainfoContext.ExtractIDFromLoginData(loginData)
+	snapshot, err := s.db.Course.GetUserTermByStuId(s.ctx, stuId)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("service.GetTermsListSnapshot: %w", err)
+	}
+	if snapshot == nil || snapshot.TermTime == "" {
+		return nil, time.Time{}, nil
+	}
+
+	s.refreshInBackground(fmt.Sprintf("refreshTerms:%s", stuId), func(svc *CourseService) error {
+		var err error
+		if utils.IsGraduate(loginData.GetId()) {
+			_, err = svc.GetTermsListYjsy(loginData)
+		} else {
+			_, err = svc.GetTermsList(loginData)
+		}
+		return err
+	})
+	return pack.ParseTerm(snapshot.TermTime), snapshot.UpdatedAt, nil
+}
+
+// refreshInBackground 使用脱离请求生命周期的服务副本在后台重新访问教务处
+func (s *CourseService) refreshInBackground(key string, refresh func(svc *CourseService) error) {
+	svc := *s
+	svc.ctx = context.WithoutCancel(s.ctx)
+	base.RefreshInBackground(s.taskQueue, key, func() error {
+		return refresh(&svc)
+	})
+}
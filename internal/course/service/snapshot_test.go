@@ -0,0 +1,178 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/course"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	customContext "github.com/west2-online/fzuhelper-server/pkg/base/context"
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	dbcourse "github.com/west2-online/fzuhelper-server/pkg/db/course"
+	dbmodel "github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestGetCourseListSnapshot(t *testing.T) {
+	type testCase struct {
+		name           string
+		mockSnapshot   *dbmodel.UserCourse
+		mockDBError    error
+		expectLen      int
+		expectNil      bool
+		expectError    string
+		expectQueueKey string
+	}
+
+	updatedAt := time.Date(2024, 9, 1, 8, 0, 0, 0, time.Local)
+	testCases := []testCase{
+		{
+			name:        "db error",
+			mockDBError: assert.AnError,
+			expectError: "service.GetCourseListSnapshot",
+		},
+		{
+			name:      "snapshot not exist",
+			expectNil: true,
+		},
+		{
+			name: "success",
+			mockSnapshot: &dbmodel.UserCourse{
+				StuId:       "102301517",
+				Term:        "202401",
+				TermCourses: `[{"name":"数据结构","teacher":"张三"}]`,
+				UpdatedAt:   updatedAt,
+			},
+			expectLen:      1,
+			expectQueueKey: "refreshCourse:102301517:202401",
+		},
+		{
+			name:         "corrupted snapshot",
+			mockSnapshot: &dbmodel.UserCourse{TermCourses: "["},
+			expectError:  "Unmarshal fail",
+		},
+	}
+
+	mockLoginData := &model.LoginData{
+		Id:      "20241025133150102301517",
+		Cookies: "cookie1=value1; cookie2=value2",
+	}
+	req := &course.CourseListRequest{Term: "202401"}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				SFClient:    new(utils.Snowflake),
+				DBClient:    new(db.Database),
+				CacheClient: new(cache.Cache),
+			}
+			mockey.Mock((*dbcourse.DBCourse).GetUserTermCourseByStuIdAndTerm).Return(tc.mockSnapshot, tc.mockDBError).Build()
+			mockey.Mock((*CourseService).GetAutoAdjustCourseList).Return([]*dbmodel.AutoAdjustCourse{}, nil).Build()
+			queueKey := ""
+			mockey.Mock((*taskqueue.BaseTaskQueue).Add).To(func(btq *taskqueue.BaseTaskQueue, key string, task taskqueue.QueueTask) {
+				queueKey = key
+			}).Build()
+
+			ctx := customContext.WithLoginData(context.Background(), mockLoginData)
+			courseService := NewCourseService(ctx, mockClientSet, new(taskqueue.BaseTaskQueue))
+			result, snapshotAt, err := courseService.GetCourseListSnapshot(req, mockLoginData)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expectNil {
+				assert.Nil(t, result)
+				assert.Empty(t, queueKey)
+				return
+			}
+			assert.Len(t, result, tc.expectLen)
+			assert.Equal(t, updatedAt, snapshotAt)
+			assert.Equal(t, tc.expectQueueKey, queueKey)
+		})
+	}
+}
+
+func TestGetTermsListSnapshot(t *testing.T) {
+	type testCase struct {
+		name         string
+		mockSnapshot *dbmodel.UserTerm
+		mockDBError  error
+		expectResult []string
+		expectError  string
+	}
+
+	testCases := []testCase{
+		{
+			name:        "db error",
+			mockDBError: assert.AnError,
+			expectError: "service.GetTermsListSnapshot",
+		},
+		{
+			name:         "snapshot not exist",
+			expectResult: nil,
+		},
+		{
+			name:         "empty snapshot",
+			mockSnapshot: &dbmodel.UserTerm{},
+			expectResult: nil,
+		},
+		{
+			name:         "success",
+			mockSnapshot: &dbmodel.UserTerm{TermTime: "202401|202302"},
+			expectResult: []string{"202401", "202302"},
+		},
+	}
+
+	mockLoginData := &model.LoginData{
+		Id:      "20241025133150102301517",
+		Cookies: "cookie1=value1; cookie2=value2",
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				SFClient:    new(utils.Snowflake),
+				DBClient:    new(db.Database),
+				CacheClient: new(cache.Cache),
+			}
+			mockey.Mock((*dbcourse.DBCourse).GetUserTermByStuId).Return(tc.mockSnapshot, tc.mockDBError).Build()
+			mockey.Mock((*taskqueue.BaseTaskQueue).Add).Return().Build()
+
+			ctx := customContext.WithLoginData(context.Background(), mockLoginData)
+			courseService := NewCourseService(ctx, mockClientSet, new(taskqueue.BaseTaskQueue))
+			result, _, err := courseService.GetTermsListSnapshot(mockLoginData)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectResult, result)
+		})
+	}
+}
@@ -262,3 +262,84 @@ func (s *UserServiceImpl) ReorderFriendList(ctx context.Context, request *user.R
 	resp.Base = base.BuildSuccessResp()
 	return resp, nil
 }
+
+// RegisterDevice implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RegisterDevice(ctx context.Context, request *user.RegisterDeviceRequest) (
+	resp *user.RegisterDeviceResponse, err error,
+) {
+	resp = new(user.RegisterDeviceResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	l := service.NewUserService(ctx, loginData.Id, utils.ParseCookies(loginData.Cookies), s.ClientSet, s.taskQueue)
+	err = l.RegisterDevice(metainfoContext.ExtractIDFromLoginData(loginData), request)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	return resp, nil
+}
+
+// UnregisterDevice implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UnregisterDevice(ctx context.Context, request *user.UnregisterDeviceRequest) (
+	resp *user.UnregisterDeviceResponse, err error,
+) {
+	resp = new(user.UnregisterDeviceResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	l := service.NewUserService(ctx, loginData.Id, utils.ParseCookies(loginData.Cookies), s.ClientSet, s.taskQueue)
+	err = l.UnregisterDevice(metainfoContext.ExtractIDFromLoginData(loginData), request.DeviceToken)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	return resp, nil
+}
+
+// GetNotificationPreference implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetNotificationPreference(ctx context.Context, request *user.GetNotificationPreferenceRequest) (
+	resp *user.GetNotificationPreferenceResponse, err error,
+) {
+	resp = new(user.GetNotificationPreferenceResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	l := service.NewUserService(ctx, loginData.Id, utils.ParseCookies(loginData.Cookies), s.ClientSet, s.taskQueue)
+	pref, err := l.GetNotificationPreference(metainfoContext.ExtractIDFromLoginData(loginData))
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Data = pack.BuildNotificationPreference(pref)
+	resp.Base = base.BuildSuccessResp()
+	return resp, nil
+}
+
+// UpdateNotificationPreference implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateNotificationPreference(ctx context.Context, request *user.UpdateNotificationPreferenceRequest) (
+	resp *user.UpdateNotificationPreferenceResponse, err error,
+) {
+	resp = new(user.UpdateNotificationPreferenceResponse)
+	loginData, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	l := service.NewUserService(ctx, loginData.Id, utils.ParseCookies(loginData.Cookies), s.ClientSet, s.taskQueue)
+	err = l.UpdateNotificationPreference(metainfoContext.ExtractIDFromLoginData(loginData), request)
+	if err != nil {
+		resp.Base = base.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Base = base.BuildSuccessResp()
+	return resp, nil
+}
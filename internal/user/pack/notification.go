@@ -0,0 +1,43 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pack
+
+import (
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	db "github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
+)
+
+// BuildNotificationPreference 未开启免打扰时不返回免打扰时段
+func BuildNotificationPreference(pref *db.NotificationPreference) *model.NotificationPreference {
+	resp := &model.NotificationPreference{
+		DisabledTypes: notification.DisabledTypes(pref),
+		Channel:       pref.Channel,
+	}
+	if pref.QuietStart != pref.QuietEnd {
+		resp.QuietStart = new(formatQuietTime(pref.QuietStart))
+		resp.QuietEnd = new(formatQuietTime(pref.QuietEnd))
+	}
+	return resp
+}
+
+func formatQuietTime(minute int) string {
+	return time.Time{}.Add(time.Duration(minute) * time.Minute).Format(constants.NotificationQuietTimeLayout)
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	db "github.com/west2-online/fzuhelper-server/pkg/db/model"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/jwch"
 	"github.com/west2-online/yjsy"
@@ -56,10 +58,25 @@ func (s *UserService) GetUserInfo(stuId string) (*db.Student, error) {
 		IsUpdate = true
 	}
 
+	if err = governor.Acquire(s.ctx, governor.HostJwch); err != nil {
+		// 教务处不可用时返回数据库中已过期的信息
+		if exist {
+			return stuInfo, nil
+		}
+		return nil, fmt.Errorf("service.GetUserInfo: %w", err)
+	}
+
 	// 将学生信息插入/更新
 	stu := jwch.NewStudent().WithLoginData(s.Identifier, s.cookies)
+	start := time.Now()
 	resp, err := stu.GetInfo()
+	metrics.ObserveUpstream(governor.HostJwch, "GetInfo", start)
+	governor.Report(governor.HostJwch, err)
 	if err != nil {
+		// 请求时教务处故障同样降级为数据库中已过期的信息
+		if exist && governor.IsUpstreamFailure(err) {
+			return stuInfo, nil
+		}
 		return nil, errno.Errorf(errno.InternalServiceErrorCode, "service.GetUserInfo: jwch failed: %v", err)
 	}
 	grade, _ := strconv.Atoi(resp.Grade)
@@ -116,10 +133,25 @@ func (s *UserService) GetUserInfoYjsy(stuId string) (*db.Student, error) {
 		IsUpdate = true
 	}
 
+	if err = governor.Acquire(s.ctx, governor.HostYjsy); err != nil {
+		// 教务处不可用时返回数据库中已过期的信息
+		if exist {
+			return stuInfo, nil
+		}
+		return nil, fmt.Errorf("service.GetUserInfo: %w", err)
+	}
+
 	// 将学生信息插入/更新
 	stu := yjsy.NewStudent().WithLoginData(s.cookies)
+	start := time.Now()
 	resp, err := stu.GetStudentInfo()
+	metrics.ObserveUpstream(governor.HostYjsy, "GetStudentInfo", start)
+	governor.Report(governor.HostYjsy, err)
 	if err != nil {
+		// 请求时教务处故障同样降级为数据库中已过期的信息
+		if exist && governor.IsUpstreamFailure(err) {
+			return stuInfo, nil
+		}
 		return nil, errno.Errorf(errno.InternalServiceErrorCode, "service.GetUserInfo: yjsy failed: %v", err)
 	}
 	grade, _ := strconv.Atoi(resp.Grade)
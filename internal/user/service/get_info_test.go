@@ -37,7 +37,9 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 	"github.com/west2-online/jwch"
+	jwchErrno "github.com/west2-online/jwch/errno"
 	"github.com/west2-online/yjsy"
+	yjsyErrno "github.com/west2-online/yjsy/errno"
 )
 
 func TestGetUserInfo(t *testing.T) {
@@ -52,6 +54,7 @@ func TestGetUserInfo(t *testing.T) {
 		mockDBCreateError error
 		mockJwchError     error
 		expectError       string
+		expired           bool // 数据库中的信息是否已过期
 
 		// 新增字段：用于控制缓存的场景
 		cacheExist    bool             // 是否在 Redis 中存在这个 Key
@@ -113,6 +116,21 @@ func TestGetUserInfo(t *testing.T) {
 			mockJwchError: errno.InternalServiceError,
 			expectError:   errno.InternalServiceError.ErrorMsg,
 		},
+		{
+			name:          "db expired, jwch unavailable, serve expired info",
+			expectExist:   true,
+			expired:       true,
+			expectInfo:    info,
+			mockJwchError: jwchErrno.HTTPQueryError,
+		},
+		{
+			name:          "db expired, jwch business error",
+			expectExist:   true,
+			expired:       true,
+			expectInfo:    info,
+			mockJwchError: jwchErrno.CookieError,
+			expectError:   "service.GetUserInfo: jwch failed",
+		},
 		{
 			name:              "db create error",
 			expectExist:       false,
@@ -156,7 +174,7 @@ func TestGetUserInfo(t *testing.T) {
 			}).Build()
 			mockey.Mock((*cache.Cache).IsKeyExist).Return(tc.cacheExist).Build()
 
-			mockey.Mock(time.Time.After).Return(true).Build()
+			mockey.Mock(time.Time.After).Return(!tc.expired).Build()
 
 			// 如果缓存存在，则 Mock GetStuInfoCache
 			if tc.cacheExist {
@@ -214,6 +232,7 @@ func TestGetUserInfoYjsy(t *testing.T) {
 		mockDBCreateError error
 		mockYjsyError     error
 		expectError       string
+		expired           bool // 数据库中的信息是否已过期
 
 		// 新增字段：用于控制缓存的场景
 		cacheExist    bool             // 是否在 Redis 中存在这个 Key
@@ -275,6 +294,21 @@ func TestGetUserInfoYjsy(t *testing.T) {
 			expectYjsy:    stuInfo,
 			mockYjsyError: errno.InternalServiceError,
 		},
+		{
+			name:          "db expired, yjsy unavailable, serve expired info",
+			expectExist:   true,
+			expired:       true,
+			expectInfo:    info,
+			mockYjsyError: yjsyErrno.HTTPQueryError,
+		},
+		{
+			name:          "db expired, yjsy business error",
+			expectExist:   true,
+			expired:       true,
+			expectInfo:    info,
+			mockYjsyError: yjsyErrno.CookieError,
+			expectError:   "service.GetUserInfo: yjsy failed",
+		},
 		{
 			name:              "db create error",
 			expectExist:       false,
@@ -317,7 +351,7 @@ func TestGetUserInfoYjsy(t *testing.T) {
 			}).Build()
 			mockey.Mock((*cache.Cache).IsKeyExist).Return(tc.cacheExist).Build()
 
-			mockey.Mock(time.Time.After).Return(true).Build()
+			mockey.Mock(time.Time.After).Return(!tc.expired).Build()
 
 			// 如果缓存存在，则 Mock GetStuInfoCache
 			if tc.cacheExist {
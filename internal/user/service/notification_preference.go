@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/user"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/notification"
+)
+
+// preferenceTypes 允许关闭推送的通知类型，只包含按学号定向推送的类型
+// 教务处通知与通知订阅（teaching）按 tag 广播，无法逐人应用偏好，只遵循全局免打扰时段，因此不接受关闭
+var preferenceTypes = []string{constants.UmengPushTypeScore, constants.UmengPushTypeExam}
+
+// GetNotificationPreference 获取学生的通知偏好，未设置过时返回默认偏好
+func (s *UserService) GetNotificationPreference(stuId string) (*model.NotificationPreference, error) {
+	pref, err := s.db.User.GetNotificationPreference(s.ctx, stuId)
+	if err != nil {
+		return nil, fmt.Errorf("service.GetNotificationPreference: %w", err)
+	}
+	if pref == nil {
+		return notification.DefaultPreference(stuId), nil
+	}
+	// 早期允许关闭的广播类型实际不生效，不再返回给客户端
+	disabled := slices.DeleteFunc(notification.DisabledTypes(pref), func(t string) bool {
+		return !slices.Contains(preferenceTypes, t)
+	})
+	pref.DisabledTypes = strings.Join(disabled, ",")
+	return pref, nil
+}
+
+// UpdateNotificationPreference 覆盖学生的通知偏好，免打扰开始与结束时间需同时设置或同时为空
+func (s *UserService) UpdateNotificationPreference(stuId string, req *user.UpdateNotificationPreferenceRequest) error {
+	switch req.Channel {
+	case constants.NotificationChannelAll, constants.NotificationChannelInbox:
+	default:
+		return errno.ParamError.WithMessage("不支持的推送渠道")
+	}
+	disabled := make([]string, 0, len(req.DisabledTypes))
+	for _, t := range req.DisabledTypes {
+		if !slices.Contains(preferenceTypes, t) {
+			return errno.ParamError.WithMessage("不支持的通知类型: " + t)
+		}
+		if !slices.Contains(disabled, t) {
+			disabled = append(disabled, t)
+		}
+	}
+	quietStart, quietEnd, err := parseQuietHours(req.GetQuietStart(), req.GetQuietEnd())
+	if err != nil {
+		return err
+	}
+
+	err = s.db.User.UpsertNotificationPreference(s.ctx, &model.NotificationPreference{
+		StuId:         stuId,
+		DisabledTypes: strings.Join(disabled, ","),
+		Channel:       req.Channel,
+		QuietStart:    quietStart,
+		QuietEnd:      quietEnd,
+	})
+	if err != nil {
+		return fmt.Errorf("service.UpdateNotificationPreference: %w", err)
+	}
+	return nil
+}
+
+// parseQuietHours 将 HH:MM 格式的免打扰时段转换为距零点的分钟数，均为空时返回 0, 0 表示不开启免打扰
+func parseQuietHours(start, end string) (int, int, error) {
+	if start == "" && end == "" {
+		return 0, 0, nil
+	}
+	startMinute, err := parseQuietTime(start)
+	if err != nil {
+		return 0, 0, err
+	}
+	endMinute, err := parseQuietTime(end)
+	if err != nil {
+		return 0, 0, err
+	}
+	if startMinute == endMinute {
+		return 0, 0, errno.ParamError.WithMessage("免打扰开始时间与结束时间不能相同")
+	}
+	return startMinute, endMinute, nil
+}
+
+func parseQuietTime(value string) (int, error) {
+	t, err := time.Parse(constants.NotificationQuietTimeLayout, value)
+	if err != nil {
+		return 0, errno.ParamError.WithMessage("免打扰时间格式应为 HH:MM")
+	}
+	return int(t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())) / time.Minute), nil
+}
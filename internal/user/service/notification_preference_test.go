@@ -0,0 +1,221 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/user"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	userDB "github.com/west2-online/fzuhelper-server/pkg/db/user"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestGetNotificationPreference(t *testing.T) {
+	type testCase struct {
+		name          string
+		mockPref      *model.NotificationPreference
+		dbError       error
+		expectChannel string
+		expectTypes   string
+		expectError   string
+	}
+
+	testCases := []testCase{
+		{
+			name:          "default preference",
+			expectChannel: constants.NotificationChannelAll,
+		},
+		{
+			name:          "saved preference",
+			mockPref:      &model.NotificationPreference{StuId: "102301001", Channel: constants.NotificationChannelInbox},
+			expectChannel: constants.NotificationChannelInbox,
+		},
+		{
+			name: "broadcast type saved before is dropped",
+			mockPref: &model.NotificationPreference{
+				StuId: "102301001", Channel: constants.NotificationChannelAll, DisabledTypes: "score,teaching",
+			},
+			expectChannel: constants.NotificationChannelAll,
+			expectTypes:   "score",
+		},
+		{
+			name:        "db error",
+			dbError:     gorm.ErrInvalidDB,
+			expectError: "service.GetNotificationPreference:",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				SFClient: new(utils.Snowflake),
+				DBClient: new(db.Database),
+			}
+			userService := NewUserService(context.Background(), "", nil, mockClientSet, new(taskqueue.BaseTaskQueue))
+			mockey.Mock((*userDB.DBUser).GetNotificationPreference).Return(tc.mockPref, tc.dbError).Build()
+
+			pref, err := userService.GetNotificationPreference("102301001")
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "102301001", pref.StuId)
+			assert.Equal(t, tc.expectChannel, pref.Channel)
+			assert.Equal(t, tc.expectTypes, pref.DisabledTypes)
+		})
+	}
+}
+
+func TestUpdateNotificationPreference(t *testing.T) {
+	type testCase struct {
+		name         string
+		req          *user.UpdateNotificationPreferenceRequest
+		dbError      error
+		expectPref   *model.NotificationPreference
+		expectError  string
+		expectUpsert bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			req: &user.UpdateNotificationPreferenceRequest{
+				DisabledTypes: []string{constants.UmengPushTypeScore, constants.UmengPushTypeExam, constants.UmengPushTypeScore},
+				Channel:       constants.NotificationChannelAll,
+				QuietStart:    new("23:00"),
+				QuietEnd:      new("07:30"),
+			},
+			expectPref: &model.NotificationPreference{
+				StuId:         "102301001",
+				DisabledTypes: "score,exam",
+				Channel:       constants.NotificationChannelAll,
+				QuietStart:    23 * 60,
+				QuietEnd:      7*60 + 30,
+			},
+			expectUpsert: true,
+		},
+		{
+			name: "disable quiet hours",
+			req: &user.UpdateNotificationPreferenceRequest{
+				DisabledTypes: []string{},
+				Channel:       constants.NotificationChannelInbox,
+			},
+			expectPref: &model.NotificationPreference{
+				StuId:   "102301001",
+				Channel: constants.NotificationChannelInbox,
+			},
+			expectUpsert: true,
+		},
+		{
+			name:        "unsupported channel",
+			req:         &user.UpdateNotificationPreferenceRequest{Channel: "sms"},
+			expectError: "不支持的推送渠道",
+		},
+		{
+			name: "unsupported type",
+			req: &user.UpdateNotificationPreferenceRequest{
+				DisabledTypes: []string{"marketing"},
+				Channel:       constants.NotificationChannelAll,
+			},
+			expectError: "不支持的通知类型",
+		},
+		{
+			name: "broadcast type cannot be disabled",
+			req: &user.UpdateNotificationPreferenceRequest{
+				DisabledTypes: []string{constants.UmengPushTypeTeaching},
+				Channel:       constants.NotificationChannelAll,
+			},
+			expectError: "不支持的通知类型: teaching",
+		},
+		{
+			name: "only quiet start",
+			req: &user.UpdateNotificationPreferenceRequest{
+				Channel:    constants.NotificationChannelAll,
+				QuietStart: new("23:00"),
+			},
+			expectError: "免打扰时间格式应为 HH:MM",
+		},
+		{
+			name: "invalid quiet time",
+			req: &user.UpdateNotificationPreferenceRequest{
+				Channel:    constants.NotificationChannelAll,
+				QuietStart: new("25:00"),
+				QuietEnd:   new("07:00"),
+			},
+			expectError: "免打扰时间格式应为 HH:MM",
+		},
+		{
+			name: "same quiet start and end",
+			req: &user.UpdateNotificationPreferenceRequest{
+				Channel:    constants.NotificationChannelAll,
+				QuietStart: new("07:00"),
+				QuietEnd:   new("07:00"),
+			},
+			expectError: "免打扰开始时间与结束时间不能相同",
+		},
+		{
+			name: "db error",
+			req: &user.UpdateNotificationPreferenceRequest{
+				Channel: constants.NotificationChannelAll,
+			},
+			dbError:      gorm.ErrInvalidDB,
+			expectError:  "service.UpdateNotificationPreference:",
+			expectUpsert: true,
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				SFClient: new(utils.Snowflake),
+				DBClient: new(db.Database),
+			}
+			userService := NewUserService(context.Background(), "", nil, mockClientSet, new(taskqueue.BaseTaskQueue))
+
+			var upserted *model.NotificationPreference
+			mockey.Mock((*userDB.DBUser).UpsertNotificationPreference).
+				To(func(ctx context.Context, pref *model.NotificationPreference) error {
+					upserted = pref
+					return tc.dbError
+				}).Build()
+
+			err := userService.UpdateNotificationPreference("102301001", tc.req)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectUpsert, upserted != nil)
+			if tc.expectPref != nil {
+				assert.Equal(t, tc.expectPref, upserted)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/user"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// RegisterDevice 登记当前学生的设备，客户端在登录后上报，重复上报同一 device token 时更新归属与版本
+// 登记成功后客户端需要订阅 constants.UmengDeviceRegisteredTag，课程相关的 tag 推送会排除这些设备，改由按学号推送送达
+func (s *UserService) RegisterDevice(stuId string, req *user.RegisterDeviceRequest) error {
+	if err := validateDeviceToken(req.DeviceToken); err != nil {
+		return err
+	}
+	switch req.Platform {
+	case constants.DevicePlatformAndroid, constants.DevicePlatformIOS, constants.DevicePlatformHarmony:
+	default:
+		return errno.ParamError.WithMessage("不支持的设备平台")
+	}
+	appVersion := req.GetAppVersion()
+	if len(appVersion) > constants.DeviceAppVersionMax {
+		return errno.ParamError.WithMessage("客户端版本号过长")
+	}
+
+	err := s.db.User.UpsertDevice(s.ctx, &model.Device{
+		StuId:       stuId,
+		DeviceToken: req.DeviceToken,
+		Platform:    req.Platform,
+		AppVersion:  appVersion,
+	})
+	if err != nil {
+		return fmt.Errorf("service.RegisterDevice: %w", err)
+	}
+	return nil
+}
+
+// UnregisterDevice 注销当前学生的设备，客户端退出登录时调用，设备不存在时视为成功
+func (s *UserService) UnregisterDevice(stuId, deviceToken string) error {
+	if err := validateDeviceToken(deviceToken); err != nil {
+		return err
+	}
+	if err := s.db.User.DeleteDevice(s.ctx, stuId, deviceToken); err != nil {
+		return fmt.Errorf("service.UnregisterDevice: %w", err)
+	}
+	return nil
+}
+
+func validateDeviceToken(deviceToken string) error {
+	if deviceToken == "" || len(deviceToken) > constants.DeviceTokenMaxLength {
+		return errno.ParamError.WithMessage("device token 无效")
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/user"
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	userDB "github.com/west2-online/fzuhelper-server/pkg/db/user"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestRegisterDevice(t *testing.T) {
+	type testCase struct {
+		name         string
+		req          *user.RegisterDeviceRequest
+		dbError      error
+		expectUpsert bool
+		expectError  string
+	}
+
+	testCases := []testCase{
+		{
+			name: "success",
+			req: &user.RegisterDeviceRequest{
+				DeviceToken: "token-a",
+				Platform:    constants.DevicePlatformAndroid,
+				AppVersion:  new("8.0.0"),
+			},
+			expectUpsert: true,
+		},
+		{
+			name: "empty token",
+			req: &user.RegisterDeviceRequest{
+				Platform: constants.DevicePlatformIOS,
+			},
+			expectError: "device token 无效",
+		},
+		{
+			name: "token too long",
+			req: &user.RegisterDeviceRequest{
+				DeviceToken: strings.Repeat("a", constants.DeviceTokenMaxLength+1),
+				Platform:    constants.DevicePlatformIOS,
+			},
+			expectError: "device token 无效",
+		},
+		{
+			name: "unsupported platform",
+			req: &user.RegisterDeviceRequest{
+				DeviceToken: "token-a",
+				Platform:    "windows",
+			},
+			expectError: "不支持的设备平台",
+		},
+		{
+			name: "db error",
+			req: &user.RegisterDeviceRequest{
+				DeviceToken: "token-a",
+				Platform:    constants.DevicePlatformHarmony,
+			},
+			dbError:      gorm.ErrInvalidDB,
+			expectUpsert: true,
+			expectError:  "service.RegisterDevice:",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				SFClient: new(utils.Snowflake),
+				DBClient: new(db.Database),
+			}
+			userService := NewUserService(context.Background(), "", nil, mockClientSet, new(taskqueue.BaseTaskQueue))
+
+			var upserted *model.Device
+			mockey.Mock((*userDB.DBUser).UpsertDevice).To(func(ctx context.Context, device *model.Device) error {
+				upserted = device
+				return tc.dbError
+			}).Build()
+
+			err := userService.RegisterDevice("102301001", tc.req)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectUpsert, upserted != nil)
+			if upserted != nil {
+				assert.Equal(t, "102301001", upserted.StuId)
+				assert.Equal(t, tc.req.DeviceToken, upserted.DeviceToken)
+				assert.Equal(t, tc.req.Platform, upserted.Platform)
+				assert.Equal(t, tc.req.GetAppVersion(), upserted.AppVersion)
+			}
+		})
+	}
+}
+
+func TestUnregisterDevice(t *testing.T) {
+	type testCase struct {
+		name        string
+		deviceToken string
+		dbError     error
+		expectError string
+	}
+
+	testCases := []testCase{
+		{
+			name:        "success",
+			deviceToken: "token-a",
+		},
+		{
+			name:        "empty token",
+			deviceToken: "",
+			expectError: "device token 无效",
+		},
+		{
+			name:        "db error",
+			deviceToken: "token-a",
+			dbError:     gorm.ErrInvalidDB,
+			expectError: "service.UnregisterDevice:",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockClientSet := &base.ClientSet{
+				SFClient: new(utils.Snowflake),
+				DBClient: new(db.Database),
+			}
+			userService := NewUserService(context.Background(), "", nil, mockClientSet, new(taskqueue.BaseTaskQueue))
+
+			mockey.Mock((*userDB.DBUser).DeleteDevice).Return(tc.dbError).Build()
+
+			err := userService.UnregisterDevice("102301001", tc.deviceToken)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
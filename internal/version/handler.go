@@ -28,11 +28,6 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/singleflight"
 )
 
-type androidVersionResult struct {
-	release *pack.Version
-	beta    *pack.Version
-}
-
 // VersionServiceImpl implements the last service interface defined in the IDL.
 type VersionServiceImpl struct {
 	ClientSet *base.ClientSet
@@ -113,9 +108,7 @@ func (s *VersionServiceImpl) GetReleaseVersion(ctx context.Context, req *version
 	resp *version.GetReleaseVersionResponse, err error,
 ) {
 	resp = new(version.GetReleaseVersionResponse)
-	res, err := singleflight.Do(constants.SingleflightReleaseVersionKey, func() (*pack.Version, error) {
-		return service.NewVersionService(ctx, s.ClientSet).GetReleaseVersion()
-	})
+	res, err := service.NewVersionService(ctx, s.ClientSet).GetReleaseVersion(req)
 	resp.Base = base.BuildBaseResp(err)
 	if err != nil {
 		logger.WithCtx(ctx).Infof("Version.GetReleaseVersion: %v", err)
@@ -132,9 +125,7 @@ func (s *VersionServiceImpl) GetReleaseVersion(ctx context.Context, req *version
 // GetBetaVersion implements the VersionServiceImpl interface.
 func (s *VersionServiceImpl) GetBetaVersion(ctx context.Context, req *version.GetBetaVersionRequest) (resp *version.GetBetaVersionResponse, err error) {
 	resp = new(version.GetBetaVersionResponse)
-	res, err := singleflight.Do(constants.SingleflightBetaVersionKey, func() (*pack.Version, error) {
-		return service.NewVersionService(ctx, s.ClientSet).GetBetaVersion()
-	})
+	res, err := service.NewVersionService(ctx, s.ClientSet).GetBetaVersion(req)
 	resp.Base = base.BuildBaseResp(err)
 	if err != nil {
 		logger.WithCtx(ctx).Infof("Version.GetBetaVersion: %v", err)
@@ -155,6 +146,7 @@ func (s *VersionServiceImpl) GetSetting(ctx context.Context, req *version.GetSet
 	resp.Base = base.BuildBaseResp(err)
 	if err != nil {
 		logger.WithCtx(ctx).Infof("Version.GetSetting: %v", err)
+		return resp, nil
 	}
 	resp.CloudSetting = *setting
 	return resp, nil
@@ -167,6 +159,7 @@ func (s *VersionServiceImpl) GetTest(ctx context.Context, req *version.GetTestRe
 	resp.Base = base.BuildBaseResp(err)
 	if err != nil {
 		logger.WithCtx(ctx).Infof("Version.GetTest: %v", err)
+		return resp, nil
 	}
 	resp.CloudSetting = *setting
 	return resp, nil
@@ -175,9 +168,7 @@ func (s *VersionServiceImpl) GetTest(ctx context.Context, req *version.GetTestRe
 // GetCloud implements the VersionServiceImpl interface.
 func (s *VersionServiceImpl) GetCloud(ctx context.Context, req *version.GetCloudRequest) (resp *version.GetCloudResponse, err error) {
 	resp = new(version.GetCloudResponse)
-	setting, err := singleflight.Do(constants.SingleflightCloudKey, func() (*[]byte, error) {
-		return service.NewVersionService(ctx, s.ClientSet).GetAllCloudSetting()
-	})
+	setting, err := service.NewVersionService(ctx, s.ClientSet).GetAllCloudSetting()
 	resp.Base = base.BuildBaseResp(err)
 	if err != nil {
 		logger.WithCtx(ctx).Infof("Version.GetCloud: %v", err)
@@ -213,19 +204,102 @@ func (s *VersionServiceImpl) AndroidGetVersion(ctx context.Context, req *version
 	resp *version.AndroidGetVersionResponse, err error,
 ) {
 	resp = new(version.AndroidGetVersionResponse)
-	result, err := singleflight.Do(constants.SingleflightAndroidVersionKey, func() (androidVersionResult, error) {
-		r, b, err := service.NewVersionService(ctx, s.ClientSet).AndroidGetVersion()
-		if err != nil {
-			return androidVersionResult{}, err
-		}
-		return androidVersionResult{release: r, beta: b}, nil
-	})
+	release, beta, err := service.NewVersionService(ctx, s.ClientSet).AndroidGetVersion(req)
 	resp.Base = base.BuildBaseResp(err)
 	if err != nil {
 		logger.WithCtx(ctx).Infof("Version.AndroidGetVersion: %v", err)
 		return resp, nil
 	}
-	resp.Release = pack.BuildVersion(result.release)
-	resp.Beta = pack.BuildVersion(result.beta)
+	resp.Release = pack.BuildVersion(release)
+	resp.Beta = pack.BuildVersion(beta)
 	return resp, err
 }
+
+// RollbackVersion implements the VersionServiceImpl interface.
+func (s *VersionServiceImpl) RollbackVersion(ctx context.Context, req *version.RollbackVersionRequest) (
+	resp *version.RollbackVersionResponse, err error,
+) {
+	resp = new(version.RollbackVersionResponse)
+	v, err := service.NewVersionService(ctx, s.ClientSet).RollbackVersion(req)
+	resp.Base = base.BuildBaseResp(err)
+	if err != nil {
+		logger.WithCtx(ctx).Infof("Version.RollbackVersion: %v", err)
+		return resp, nil
+	}
+	resp.Data = pack.BuildVersion(v)
+	return resp, nil
+}
+
+// ListVersions implements the VersionServiceImpl interface.
+func (s *VersionServiceImpl) ListVersions(ctx context.Context, req *version.ListVersionsRequest) (resp *version.ListVersionsResponse, err error) {
+	resp = new(version.ListVersionsResponse)
+	versions, total, err := service.NewVersionService(ctx, s.ClientSet).ListVersions(req)
+	resp.Base = base.BuildBaseResp(err)
+	if err != nil {
+		logger.WithCtx(ctx).Infof("Version.ListVersions: %v", err)
+		return resp, nil
+	}
+	resp.Data = pack.BuildVersionRecords(versions)
+	resp.Total = total
+	return resp, nil
+}
+
+// GetVersionChangelog implements the VersionServiceImpl interface.
+func (s *VersionServiceImpl) GetVersionChangelog(ctx context.Context, req *version.GetVersionChangelogRequest) (
+	resp *version.GetVersionChangelogResponse, err error,
+) {
+	resp = new(version.GetVersionChangelogResponse)
+	changelog, err := service.NewVersionService(ctx, s.ClientSet).GetVersionChangelog(req)
+	resp.Base = base.BuildBaseResp(err)
+	if err != nil {
+		logger.WithCtx(ctx).Infof("Version.GetVersionChangelog: %v", err)
+		return resp, nil
+	}
+	resp.Data = changelog
+	return resp, nil
+}
+
+// GetLatestVersion implements the VersionServiceImpl interface.
+func (s *VersionServiceImpl) GetLatestVersion(ctx context.Context, req *version.GetLatestVersionRequest) (
+	resp *version.GetLatestVersionResponse, err error,
+) {
+	resp = new(version.GetLatestVersionResponse)
+	latest, err := service.NewVersionService(ctx, s.ClientSet).GetLatestVersion(req)
+	resp.Base = base.BuildBaseResp(err)
+	if err != nil {
+		logger.WithCtx(ctx).Infof("Version.GetLatestVersion: %v", err)
+		return resp, nil
+	}
+	resp.Data = latest
+	return resp, nil
+}
+
+// DryRunCloudSetting implements the VersionServiceImpl interface.
+func (s *VersionServiceImpl) DryRunCloudSetting(ctx context.Context, req *version.DryRunCloudSettingRequest) (
+	resp *version.DryRunCloudSettingResponse, err error,
+) {
+	resp = new(version.DryRunCloudSettingResponse)
+	dryRun, err := service.NewVersionService(ctx, s.ClientSet).DryRunCloudSetting(req)
+	resp.Base = base.BuildBaseResp(err)
+	if err != nil {
+		logger.WithCtx(ctx).Infof("Version.DryRunCloudSetting: %v", err)
+		return resp, nil
+	}
+	resp.Data = dryRun
+	return resp, nil
+}
+
+// GetCloudSettingCoverage implements the VersionServiceImpl interface.
+func (s *VersionServiceImpl) GetCloudSettingCoverage(ctx context.Context, req *version.GetCloudSettingCoverageRequest) (
+	resp *version.GetCloudSettingCoverageResponse, err error,
+) {
+	resp = new(version.GetCloudSettingCoverageResponse)
+	coverage, err := service.NewVersionService(ctx, s.ClientSet).GetCloudSettingCoverage(req)
+	resp.Base = base.BuildBaseResp(err)
+	if err != nil {
+		logger.WithCtx(ctx).Infof("Version.GetCloudSettingCoverage: %v", err)
+		return resp, nil
+	}
+	resp.Data = coverage
+	return resp, nil
+}
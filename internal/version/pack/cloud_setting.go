@@ -16,18 +16,72 @@ limitations under the License.
 
 package pack
 
-import "encoding/json"
-
-type Plan struct {
-	Name      *string
-	Account   *string
-	Version   *string
-	Beta      *bool
-	Phone     *string
-	IsLogin   *bool
-	LoginType *string
-	Plan      json.RawMessage
+import (
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
+)
+
+// BuildCloudSettingDryRun 第一个满足条件的计划即为实际下发的计划
+func BuildCloudSettingDryRun(results []cloudsetting.PlanResult) *model.CloudSettingDryRun {
+	dryRun := &model.CloudSettingDryRun{
+		Plans: make([]*model.CloudSettingPlanMatch, 0, len(results)),
+	}
+	for _, r := range results {
+		index := int64(r.Plan.Index)
+		match := &model.CloudSettingPlanMatch{
+			Index:            index,
+			Matched:          r.Matched,
+			MismatchedFields: r.Mismatched,
+		}
+		if r.Plan.Name != "" {
+			match.Name = &r.Plan.Name
+		}
+		if r.Matched && dryRun.MatchedIndex == nil {
+			plan := string(r.Plan.Plan)
+			dryRun.MatchedIndex = &index
+			dryRun.Plan = &plan
+		}
+		dryRun.Plans = append(dryRun.Plans, match)
+	}
+	return dryRun
 }
-type CloudSetting struct {
-	Plans []Plan
+
+func BuildCloudSettingCoverage(coverage *cloudsetting.Coverage) *model.CloudSettingCoverage {
+	plans := make([]*model.CloudSettingPlanCoverage, 0, len(coverage.Plans))
+	for _, p := range coverage.Plans {
+		plan := &model.CloudSettingPlanCoverage{
+			Index:    int64(p.Plan.Index),
+			Hits:     int64(p.Hits),
+			Eligible: int64(p.Eligible),
+		}
+		if p.Plan.Name != "" {
+			plan.Name = &p.Plan.Name
+		}
+		if p.ShadowedBy != nil {
+			shadowedBy := int64(p.ShadowedBy.Index)
+			plan.ShadowedBy = &shadowedBy
+		}
+		plans = append(plans, plan)
+	}
+
+	unmatched := make([]*model.CloudSettingUnmatched, 0, len(coverage.Unmatched))
+	for _, u := range coverage.Unmatched {
+		unmatched = append(unmatched, &model.CloudSettingUnmatched{
+			Criteria: &model.CloudSettingCriteria{
+				Account:   u.Criteria.Account,
+				Version:   u.Criteria.Version,
+				Beta:      u.Criteria.Beta,
+				Phone:     u.Criteria.Phone,
+				IsLogin:   u.Criteria.IsLogin,
+				LoginType: u.Criteria.LoginType,
+			},
+			Count: int64(u.Count),
+		})
+	}
+
+	return &model.CloudSettingCoverage{
+		Samples:   int64(coverage.Samples),
+		Plans:     plans,
+		Unmatched: unmatched,
+	}
 }
@@ -17,7 +17,11 @@ limitations under the License.
 package pack
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	dbmodel "github.com/west2-online/fzuhelper-server/pkg/db/model"
 )
 
 type Version struct {
@@ -37,3 +41,83 @@ func BuildVersion(version *Version) *model.Version {
 		Url:         &version.Url,
 	}
 }
+
+// BuildAppVersion 将数据库中的版本记录转换为下发给客户端的版本信息
+func BuildAppVersion(v *dbmodel.AppVersion) *Version {
+	return &Version{
+		Version: v.Version,
+		Code:    strconv.FormatInt(v.Code, 10),
+		Url:     v.Url,
+		Feature: v.Feature,
+		Force:   v.Force,
+	}
+}
+
+// BuildVersionRecord 将版本历史中的一条记录转换为 RPC 模型
+func BuildVersionRecord(v *dbmodel.AppVersion) *model.Version {
+	code := strconv.FormatInt(v.Code, 10)
+	releasedAt := v.CreatedAt.UnixMilli()
+	return &model.Version{
+		VersionCode: &code,
+		VersionName: &v.Version,
+		Force:       &v.Force,
+		Changelog:   &v.Feature,
+		Url:         &v.Url,
+		ReleasedAt:  &releasedAt,
+		Status:      &v.Status,
+	}
+}
+
+func BuildVersionRecords(versions []*dbmodel.AppVersion) []*model.Version {
+	records := make([]*model.Version, 0, len(versions))
+	for _, v := range versions {
+		records = append(records, BuildVersionRecord(v))
+	}
+	return records
+}
+
+// BuildVersionChangelog 拼接从新到旧排列的各版本更新日志，每个版本以版本名开头，版本之间空一行
+// force 为最新版本是否需要强制更新，由调用方结合客户端版本计算
+func BuildVersionChangelog(versions []*dbmodel.AppVersion, force bool) *model.VersionChangelog {
+	changelog := &model.VersionChangelog{
+		Versions: BuildVersionRecords(versions),
+	}
+	if len(versions) == 0 {
+		return changelog
+	}
+	changelog.Latest = BuildVersionRecord(versions[0])
+	changelog.Latest.Force = &force
+
+	var sb strings.Builder
+	for i, v := range versions {
+		if i > 0 {
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString(v.Version)
+		sb.WriteString("\n")
+		sb.WriteString(v.Feature)
+	}
+	changelog.Changelog = sb.String()
+	return changelog
+}
+
+// BuildLatestVersion 构造请求者能更新到的最新版本，storeURL 为空时不返回商店链接
+func BuildLatestVersion(v *dbmodel.AppVersion, storeURL string, force bool) *model.LatestVersion {
+	latest := &model.LatestVersion{
+		Version:          BuildVersionRecord(v),
+		Force:            force,
+		MinSupportedCode: v.MinSupportedCode,
+	}
+	if storeURL != "" {
+		latest.StoreUrl = &storeURL
+	}
+	return latest
+}
+
+// BuildLegacyLatestVersion 由又拍云上的版本文件构造最新版本，版本文件没有最低支持版本
+func BuildLegacyLatestVersion(v *Version, force bool) *model.LatestVersion {
+	return &model.LatestVersion{
+		Version: BuildVersion(v),
+		Force:   force,
+	}
+}
@@ -19,22 +19,18 @@ package service
 import (
 	"fmt"
 
-	"github.com/bytedance/sonic"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/west2-online/fzuhelper-server/internal/version/pack"
-	"github.com/west2-online/fzuhelper-server/pkg/upyun"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
 )
 
-func (s *VersionService) AndroidGetVersion() (r *pack.Version, b *pack.Version, err error) {
+func (s *VersionService) AndroidGetVersion(req *version.AndroidGetVersioneRequest) (r *pack.Version, b *pack.Version, err error) {
+	requester := newRequester(req.DeviceId, req.StuId, req.Code)
 	eg := errgroup.Group{}
 	eg.Go(func() error {
-		jsonBytes, err := upyun.URlGetFile(upyun.JoinFileName(releaseVersionFileName))
-		if err != nil {
-			return fmt.Errorf("VersionService.AndroidGetVersion.GetReleaseVersion error:%w", err)
-		}
-		version := new(pack.Version)
-		err = sonic.Unmarshal(*jsonBytes, version)
+		version, err := s.getVersion(constants.VersionChannelRelease, requester)
 		if err != nil {
 			return fmt.Errorf("VersionService.AndroidGetVersion.GetReleaseVersion error:%w", err)
 		}
@@ -42,12 +38,7 @@ func (s *VersionService) AndroidGetVersion() (r *pack.Version, b *pack.Version,
 		return nil
 	})
 	eg.Go(func() error {
-		jsonBytes, err := upyun.URlGetFile(upyun.JoinFileName(betaVersionFileName))
-		if err != nil {
-			return fmt.Errorf("VersionService.AndroidGetVersion.GetBetaVersion error:%w", err)
-		}
-		version := new(pack.Version)
-		err = sonic.Unmarshal(*jsonBytes, version)
+		version, err := s.getVersion(constants.VersionChannelBeta, requester)
 		if err != nil {
 			return fmt.Errorf("VersionService.AndroidGetVersion.GetBetaVersion error:%w", err)
 		}
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
 	"github.com/west2-online/fzuhelper-server/pkg/upyun"
 )
 
@@ -90,6 +91,7 @@ func TestAndroidGetVersion(t *testing.T) {
 
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*VersionService).resolveVersion).Return(nil, nil).Build()
 			mockey.Mock(upyun.URlGetFile).To(func(filename string) (*[]byte, error) {
 				if filename == releaseVersionFileName {
 					return tc.mockReleaseBytes, tc.mockReleaseError
@@ -101,7 +103,7 @@ func TestAndroidGetVersion(t *testing.T) {
 			}).Build()
 
 			urlService := &VersionService{}
-			release, beta, err := urlService.AndroidGetVersion()
+			release, beta, err := urlService.AndroidGetVersion(&version.AndroidGetVersioneRequest{})
 			if tc.expectError != "" {
 				assert.NotNil(t, err)
 				assert.ErrorContains(t, err, tc.expectError)
@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/singleflight"
+	"github.com/west2-online/fzuhelper-server/pkg/upyun"
+)
+
+// compiledSetting 最近一次编译的云控配置，原文未变化时复用编译结果，避免每次请求重新校验和编译规则
+var compiledSetting struct {
+	sync.RWMutex
+	content string
+	legacy  bool
+	setting *cloudsetting.Setting
+}
+
+// loadCloudSetting 获取当前生效的云控配置，并发请求合并为一次读取
+func (s *VersionService) loadCloudSetting() (*cloudsetting.Setting, error) {
+	return singleflight.Do(constants.SingleflightCloudKey, func() (*cloudsetting.Setting, error) {
+		content, legacy, err := s.getCloudSettingContent()
+		if err != nil {
+			return nil, err
+		}
+		return compileCloudSetting(content, legacy)
+	})
+}
+
+// getCloudSettingContent 依次从缓存、数据库读取配置原文，数据库中还没有配置时回退到又拍云上的历史配置文件
+// legacy 表示配置来自又拍云，未经过 JSON Schema 校验，需要按历史规则宽松解析
+func (s *VersionService) getCloudSettingContent() (content string, legacy bool, err error) {
+	content, err = s.cache.Version.GetCloudSetting(s.ctx)
+	if err == nil {
+		return content, false, nil
+	}
+
+	setting, err := s.db.Version.GetLatestCloudSetting(s.ctx)
+	if err != nil {
+		return "", false, fmt.Errorf("VersionService.getCloudSettingContent: %w", err)
+	}
+	if setting != nil {
+		if err = s.cache.Version.SetCloudSetting(s.ctx, setting.Content); err != nil {
+			logger.WithCtx(s.ctx).Warnf("VersionService.getCloudSettingContent: set cache failed: %v", err)
+		}
+		return setting.Content, false, nil
+	}
+
+	file, err := upyun.URlGetFile(upyun.JoinFileName(cloudSettingFileName))
+	if err != nil {
+		return "", false, fmt.Errorf("VersionService.getCloudSettingContent: %w", err)
+	}
+	return string(*file), true, nil
+}
+
+func compileCloudSetting(content string, legacy bool) (*cloudsetting.Setting, error) {
+	compiledSetting.RLock()
+	setting := compiledSetting.setting
+	hit := setting != nil && compiledSetting.content == content && compiledSetting.legacy == legacy
+	compiledSetting.RUnlock()
+	if hit {
+		return setting, nil
+	}
+
+	parse := cloudsetting.Parse
+	if legacy {
+		parse = cloudsetting.ParseLegacy
+	}
+	setting, err := parse([]byte(content))
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.compileCloudSetting: %w", err)
+	}
+
+	compiledSetting.Lock()
+	compiledSetting.content = content
+	compiledSetting.legacy = legacy
+	compiledSetting.setting = setting
+	compiledSetting.Unlock()
+	return setting, nil
+}
+
+// parseDraftSetting 解析待发布的配置，配置无效时返回参数错误
+func parseDraftSetting(content string) (*cloudsetting.Setting, error) {
+	setting, err := cloudsetting.Parse([]byte(content))
+	if err != nil {
+		return nil, errno.ParamError.WithMessage(err.Error())
+	}
+	return setting, nil
+}
+
+// recordCloudSettingSample 记录客户端的匹配条件，供覆盖率报告使用，记录失败不影响下发配置
+// 样本不保存学号，覆盖率报告中 Account 规则对所有样本都视为满足
+func (s *VersionService) recordCloudSettingSample(c *cloudsetting.Criteria) {
+	anonymous := *c
+	anonymous.Account = nil
+	sample, err := json.Marshal(&anonymous)
+	if err != nil {
+		logger.WithCtx(s.ctx).Warnf("VersionService.recordCloudSettingSample: marshal failed: %v", err)
+		return
+	}
+	if err = s.cache.Version.AddCloudSettingSample(s.ctx, string(sample)); err != nil {
+		logger.WithCtx(s.ctx).Warnf("VersionService.recordCloudSettingSample: %v", err)
+	}
+}
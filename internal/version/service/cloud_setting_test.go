@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	versionCache "github.com/west2-online/fzuhelper-server/pkg/cache/version"
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbversion "github.com/west2-online/fzuhelper-server/pkg/db/version"
+	"github.com/west2-online/fzuhelper-server/pkg/upyun"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func mustParseSetting(t *testing.T, content string) *cloudsetting.Setting {
+	t.Helper()
+	setting, err := cloudsetting.Parse([]byte(content))
+	if err != nil {
+		t.Fatalf("parse setting: %v", err)
+	}
+	return setting
+}
+
+func TestLoadCloudSetting(t *testing.T) {
+	type testCase struct {
+		name          string
+		mockCache     string
+		mockCacheErr  error
+		mockDB        *model.CloudSetting
+		mockDBErr     error
+		mockFile      *[]byte
+		mockFileErr   error
+		expectPlan    string
+		expectedError string
+	}
+
+	dbSetting := `{"Plans": [{"Name": "db", "Version": ">= 5.0", "Plan": {"from": "db"}}]}`
+	// 又拍云上的历史配置按正则匹配版本号，也不做 JSON Schema 校验
+	legacyFile := []byte(`{"Plans": [{"Name": "upyun", "Version": "5\\..*", "Plan": {"from": "upyun"}}]} // legacy`)
+
+	testCases := []testCase{
+		{
+			name:       "CacheHit",
+			mockCache:  `{"Plans": [{"Name": "cache", "Plan": {"from": "cache"}}]}`,
+			expectPlan: "cache",
+		},
+		{
+			name:         "LoadFromDB",
+			mockCacheErr: fmt.Errorf("redis: nil"),
+			mockDB:       &model.CloudSetting{Id: 1, Content: dbSetting},
+			expectPlan:   "db",
+		},
+		{
+			name:          "DBError",
+			mockCacheErr:  fmt.Errorf("redis: nil"),
+			mockDBErr:     fmt.Errorf("db error"),
+			expectedError: "db error",
+		},
+		{
+			name:         "FallbackToUpyun",
+			mockCacheErr: fmt.Errorf("redis: nil"),
+			mockFile:     &legacyFile,
+			expectPlan:   "upyun",
+		},
+		{
+			name:          "UpyunError",
+			mockCacheErr:  fmt.Errorf("redis: nil"),
+			mockFileErr:   fmt.Errorf("network error"),
+			expectedError: "network error",
+		},
+		{
+			name:          "InvalidCachedSetting",
+			mockCache:     `{"Plans": [{"Name": "cache"}]}`,
+			expectedError: "schema validation failed",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*versionCache.CacheVersion).GetCloudSetting).Return(tc.mockCache, tc.mockCacheErr).Build()
+			mockey.Mock((*versionCache.CacheVersion).SetCloudSetting).Return(nil).Build()
+			mockey.Mock((*dbversion.DBVersion).GetLatestCloudSetting).Return(tc.mockDB, tc.mockDBErr).Build()
+			mockey.Mock(upyun.URlGetFile).Return(tc.mockFile, tc.mockFileErr).Build()
+			mockey.Mock(upyun.JoinFileName).To(func(filename string) string {
+				return filename
+			}).Build()
+
+			versionService := &VersionService{db: new(db.Database), cache: new(cache.Cache)}
+			setting, err := versionService.loadCloudSetting()
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				assert.Nil(t, setting)
+				return
+			}
+			assert.NoError(t, err)
+			plan, err := setting.Match(&cloudsetting.Criteria{Version: strPtr("5.1.0")})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectPlan, plan.Name)
+		})
+	}
+}
+
+func TestCompileCloudSettingReuse(t *testing.T) {
+	content := `{"Plans": [{"Name": "default", "Plan": {}}]}`
+
+	first, err := compileCloudSetting(content, false)
+	assert.NoError(t, err)
+	second, err := compileCloudSetting(content, false)
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+
+	// 同一原文按历史规则解析时结果不同，不能复用
+	legacy, err := compileCloudSetting(content, true)
+	assert.NoError(t, err)
+	assert.NotSame(t, first, legacy)
+}
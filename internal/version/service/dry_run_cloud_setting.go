@@ -0,0 +1,44 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
+)
+
+// DryRunCloudSetting 返回每个计划对条件的匹配结果，以及实际会下发的计划
+func (s *VersionService) DryRunCloudSetting(req *version.DryRunCloudSettingRequest) (*model.CloudSettingDryRun, error) {
+	setting, err := s.testTarget(req.Setting)
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.DryRunCloudSetting error:%w", err)
+	}
+
+	results := setting.Explain(&cloudsetting.Criteria{
+		Account:   req.Account,
+		Version:   req.Version,
+		Beta:      req.Beta,
+		Phone:     req.Phone,
+		IsLogin:   req.IsLogin,
+		LoginType: req.LoginType,
+	})
+	return pack.BuildCloudSettingDryRun(results), nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
+)
+
+func TestDryRunCloudSetting(t *testing.T) {
+	type testCase struct {
+		name          string
+		mockLoadError error
+		request       *version.DryRunCloudSettingRequest
+		expectResult  *model.CloudSettingDryRun
+		expectError   string
+	}
+
+	active := `{"Plans": [
+		{"Name": "beta", "Beta": true, "Version": ">= 6.0", "Plan": {"key":"beta"}},
+		{"Name": "release", "Beta": false, "Plan": {"key":"release"}},
+		{"Plan": {"key":"default"}}
+	]}`
+
+	testCases := []testCase{
+		{
+			name:    "MatchLaterPlan",
+			request: &version.DryRunCloudSettingRequest{Beta: boolPtr(false), Version: strPtr("5.1.0")},
+			expectResult: &model.CloudSettingDryRun{
+				MatchedIndex: new(int64(1)),
+				Plan:         new(`{"key":"release"}`),
+				Plans: []*model.CloudSettingPlanMatch{
+					{Index: 0, Name: new("beta"), Matched: false, MismatchedFields: []string{"Version", "Beta"}},
+					{Index: 1, Name: new("release"), Matched: true, MismatchedFields: []string{}},
+					{Index: 2, Matched: true, MismatchedFields: []string{}},
+				},
+			},
+		},
+		{
+			name: "DraftWithoutMatch",
+			request: &version.DryRunCloudSettingRequest{
+				Phone:   strPtr("iPhone"),
+				Setting: strPtr(`{"Plans": [{"Name": "android", "Phone": "Android", "Plan": {}}]}`),
+			},
+			expectResult: &model.CloudSettingDryRun{
+				Plans: []*model.CloudSettingPlanMatch{
+					{Index: 0, Name: new("android"), Matched: false, MismatchedFields: []string{"Phone"}},
+				},
+			},
+		},
+		{
+			name:        "InvalidDraft",
+			request:     &version.DryRunCloudSettingRequest{Setting: strPtr(`{"Plans": {}}`)},
+			expectError: "schema validation failed",
+		},
+		{
+			name:          "LoadError",
+			mockLoadError: fmt.Errorf("db error"),
+			request:       &version.DryRunCloudSettingRequest{},
+			expectError:   "VersionService.DryRunCloudSetting error:db error",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*VersionService).loadCloudSetting).To(func() (*cloudsetting.Setting, error) {
+				if tc.mockLoadError != nil {
+					return nil, tc.mockLoadError
+				}
+				return mustParseSetting(t, active), nil
+			}).Build()
+
+			versionService := &VersionService{}
+			result, err := versionService.DryRunCloudSetting(tc.request)
+
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				assert.Nil(t, result)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.expectResult, result)
+			}
+		})
+	}
+}
@@ -16,24 +16,15 @@ limitations under the License.
 
 package service
 
-import (
-	"fmt"
-
-	"github.com/west2-online/fzuhelper-server/pkg/upyun"
-)
+import "fmt"
 
 func (s *VersionService) GetAllCloudSetting() (*[]byte, error) {
-	// 获得Json
-	settingJson, err := upyun.URlGetFile(upyun.JoinFileName(cloudSettingFileName))
-	if err != nil {
-		return nil, fmt.Errorf("VersionService.GetAllCloudSetting error:%w", err)
-	}
-	noCommentSettingJson, err := getJSONWithoutComments(string(*settingJson))
+	setting, err := s.loadCloudSetting()
 	if err != nil {
 		return nil, fmt.Errorf("VersionService.GetAllCloudSetting error:%w", err)
 	}
 
-	returnPlan := []byte(noCommentSettingJson)
+	returnPlan := setting.Content
 
 	return &returnPlan, nil
 }
@@ -23,49 +23,29 @@ import (
 	"github.com/bytedance/mockey"
 	"github.com/stretchr/testify/assert"
 
-	"github.com/west2-online/fzuhelper-server/pkg/upyun"
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
 )
 
 func TestGetAllCloudSetting(t *testing.T) {
 	type testCase struct {
-		name               string  // 测试用例名称
-		mockSettingJson    *[]byte // mock返回的设置 JSON 数据
-		mockError          error   // mock返回的错误
-		expectResult       *[]byte // 期望返回的结果
-		expectError        string  // 期望的错误信息
-		mockCommentedJson  string  // 模拟带注释的 JSON 数据
-		mockCommentedError error   // 模拟去掉注释过程的错误
+		name          string // 测试用例名称
+		mockLoadError error  // mock返回的错误
+		expectResult  string // 期望返回的结果
+		expectError   string // 期望的错误信息
 	}
 
-	mockResult := []byte(`{"key": "value"}`)
+	content := "{\"Plans\": [ // 注释\n{\"Plan\": {\"key\": \"value\"}}]}"
 
 	// 测试用例
 	testCases := []testCase{
 		{
-			name:               "SuccessCase",
-			mockSettingJson:    &mockResult,
-			mockError:          nil,
-			expectResult:       &mockResult,
-			mockCommentedJson:  `{"key": "value"}`,
-			mockCommentedError: nil,
+			name:         "SuccessCase",
+			expectResult: "{\"Plans\": [ \n{\"Plan\": {\"key\": \"value\"}}]}",
 		},
 		{
-			name:               "FileNotFound",
-			mockSettingJson:    nil,
-			mockError:          fmt.Errorf("file not found"),
-			expectResult:       nil,
-			expectError:        "VersionService.GetAllCloudSetting error:file not found",
-			mockCommentedJson:  "",
-			mockCommentedError: nil,
-		},
-		{
-			name:               "RemoveCommentsError",
-			mockSettingJson:    &mockResult,
-			mockError:          nil,
-			expectResult:       nil,
-			expectError:        "VersionService.GetAllCloudSetting error:invalid JSON format",
-			mockCommentedJson:  "",
-			mockCommentedError: fmt.Errorf("invalid JSON format"),
+			name:          "LoadError",
+			mockLoadError: fmt.Errorf("file not found"),
+			expectError:   "VersionService.GetAllCloudSetting error:file not found",
 		},
 	}
 
@@ -73,29 +53,22 @@ func TestGetAllCloudSetting(t *testing.T) {
 
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
-			// Mock upyun.URlGetFile 方法
-			mockey.Mock(upyun.URlGetFile).Return(tc.mockSettingJson, tc.mockError).Build()
-			mockey.Mock(upyun.JoinFileName).To(func(filename string) string {
-				return filename
+			mockey.Mock((*VersionService).loadCloudSetting).To(func() (*cloudsetting.Setting, error) {
+				if tc.mockLoadError != nil {
+					return nil, tc.mockLoadError
+				}
+				return mustParseSetting(t, content), nil
 			}).Build()
 
-			// Mock getJSONWithoutComments 方法
-			mockey.Mock(getJSONWithoutComments).Return(tc.mockCommentedJson, tc.mockCommentedError).Build()
-
-			// 初始化UrlService实例
 			versionService := &VersionService{}
-
-			// 调用方法
 			result, err := versionService.GetAllCloudSetting()
 
 			if tc.expectError != "" {
-				// 如果期望抛错，检查错误信息
 				assert.ErrorContains(t, err, tc.expectError)
-				assert.Equal(t, tc.expectResult, result)
+				assert.Nil(t, result)
 			} else {
-				// 如果不期望抛错，验证结果
 				assert.Nil(t, err)
-				assert.Equal(t, tc.expectResult, result)
+				assert.Equal(t, tc.expectResult, string(*result))
 			}
 		})
 	}
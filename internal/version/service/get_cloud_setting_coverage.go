@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+// GetCloudSettingCoverage 用最近的客户端匹配条件模拟匹配，生成配置的覆盖率报告
+// 传入待发布的配置时评估该配置，用于在 SetCloud 前确认各计划的影响范围
+// 样本不含学号，按 Account 定向的计划的 Eligible 与 Hits 会偏高
+func (s *VersionService) GetCloudSettingCoverage(req *version.GetCloudSettingCoverageRequest) (*model.CloudSettingCoverage, error) {
+	if !utils.CheckPwd(req.Password) {
+		return nil, buildAuthFailedError()
+	}
+	setting, err := s.testTarget(req.Setting)
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.GetCloudSettingCoverage error:%w", err)
+	}
+
+	limit := req.GetSamples()
+	if limit <= 0 || limit > constants.CloudSettingSampleSize {
+		limit = constants.CloudSettingSampleSize
+	}
+	raw, err := s.cache.Version.GetCloudSettingSamples(s.ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.GetCloudSettingCoverage error:%w", err)
+	}
+	samples := make([]*cloudsetting.Criteria, 0, len(raw))
+	for _, r := range raw {
+		c := new(cloudsetting.Criteria)
+		// 跳过无法解析的样本，不影响其余样本的统计
+		if err = json.Unmarshal([]byte(r), c); err != nil {
+			continue
+		}
+		samples = append(samples, c)
+	}
+	return pack.BuildCloudSettingCoverage(setting.Coverage(samples)), nil
+}
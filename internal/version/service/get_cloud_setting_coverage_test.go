@@ -0,0 +1,164 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	versionCache "github.com/west2-online/fzuhelper-server/pkg/cache/version"
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestGetCloudSettingCoverage(t *testing.T) {
+	type testCase struct {
+		name           string
+		mockCheckPwd   bool
+		mockSamples    []string
+		mockSamplesErr error
+		request        *version.GetCloudSettingCoverageRequest
+		expectLimit    int64
+		expectResult   *model.CloudSettingCoverage
+		expectError    string
+	}
+
+	active := `{"Plans": [
+		{"Name": "beta", "Beta": true, "Plan": {}},
+		{"Name": "beta-new", "Beta": true, "Version": ">= 6.0", "Plan": {}},
+		{"Name": "release", "Beta": false, "Version": ">= 5.0", "Plan": {}}
+	]}`
+	samples := []string{
+		`{"beta":true,"version":"6.0.0"}`,
+		`{"beta":false,"version":"5.1.0"}`,
+		`{"beta":false,"version":"4.0.0","phone":"Android"}`,
+		`not json`,
+		`{"beta":false,"version":"4.0.0","phone":"Android"}`,
+	}
+
+	testCases := []testCase{
+		{
+			name:         "ActiveSetting",
+			mockCheckPwd: true,
+			mockSamples:  samples,
+			request:      &version.GetCloudSettingCoverageRequest{Password: "pwd"},
+			expectLimit:  constants.CloudSettingSampleSize,
+			expectResult: &model.CloudSettingCoverage{
+				Samples: 4,
+				Plans: []*model.CloudSettingPlanCoverage{
+					{Index: 0, Name: new("beta"), Hits: 1, Eligible: 1},
+					{Index: 1, Name: new("beta-new"), Hits: 0, Eligible: 1, ShadowedBy: new(int64(0))},
+					{Index: 2, Name: new("release"), Hits: 1, Eligible: 1},
+				},
+				Unmatched: []*model.CloudSettingUnmatched{
+					{
+						Criteria: &model.CloudSettingCriteria{Beta: new(false), Version: new("4.0.0"), Phone: new("Android")},
+						Count:    2,
+					},
+				},
+			},
+		},
+		{
+			name:         "DraftSetting",
+			mockCheckPwd: true,
+			mockSamples:  samples[:2],
+			request: &version.GetCloudSettingCoverageRequest{
+				Password: "pwd",
+				Setting:  new(`{"Plans": [{"Plan": {}}]}`),
+				Samples:  new(int64(2)),
+			},
+			expectLimit: 2,
+			expectResult: &model.CloudSettingCoverage{
+				Samples:   2,
+				Plans:     []*model.CloudSettingPlanCoverage{{Index: 0, Hits: 2, Eligible: 2}},
+				Unmatched: []*model.CloudSettingUnmatched{},
+			},
+		},
+		{
+			name:         "SampleLimitExceeded",
+			mockCheckPwd: true,
+			request: &version.GetCloudSettingCoverageRequest{
+				Password: "pwd",
+				Samples:  new(int64(constants.CloudSettingSampleSize + 1)),
+			},
+			expectLimit: constants.CloudSettingSampleSize,
+			expectResult: &model.CloudSettingCoverage{
+				Plans: []*model.CloudSettingPlanCoverage{
+					{Index: 0, Name: new("beta")},
+					{Index: 1, Name: new("beta-new"), ShadowedBy: new(int64(0))},
+					{Index: 2, Name: new("release")},
+				},
+				Unmatched: []*model.CloudSettingUnmatched{},
+			},
+		},
+		{
+			name:         "InvalidPassword",
+			mockCheckPwd: false,
+			request:      &version.GetCloudSettingCoverageRequest{Password: "wrong"},
+			expectError:  "[401] authorization failed",
+		},
+		{
+			name:         "InvalidDraft",
+			mockCheckPwd: true,
+			request:      &version.GetCloudSettingCoverageRequest{Password: "pwd", Setting: new(`{"Plans": [{}]}`)},
+			expectError:  "schema validation failed",
+		},
+		{
+			name:           "GetSamplesError",
+			mockCheckPwd:   true,
+			mockSamplesErr: fmt.Errorf("redis error"),
+			request:        &version.GetCloudSettingCoverageRequest{Password: "pwd"},
+			expectLimit:    constants.CloudSettingSampleSize,
+			expectError:    "VersionService.GetCloudSettingCoverage error:redis error",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(utils.CheckPwd).Return(tc.mockCheckPwd).Build()
+			mockey.Mock((*VersionService).loadCloudSetting).To(func() (*cloudsetting.Setting, error) {
+				return mustParseSetting(t, active), nil
+			}).Build()
+			mockey.Mock((*versionCache.CacheVersion).GetCloudSettingSamples).To(
+				func(_ *versionCache.CacheVersion, _ context.Context, limit int64) ([]string, error) {
+					assert.Equal(t, tc.expectLimit, limit)
+					return tc.mockSamples, tc.mockSamplesErr
+				}).Build()
+
+			versionService := &VersionService{cache: new(cache.Cache)}
+			result, err := versionService.GetCloudSettingCoverage(tc.request)
+
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				assert.Nil(t, result)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.expectResult, result)
+			}
+		})
+	}
+}
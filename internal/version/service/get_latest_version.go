@@ -0,0 +1,90 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/west2-online/fzuhelper-server/config"
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+// GetLatestVersion 获取请求者在某平台、渠道下能更新到的最新版本及强制更新策略
+// Android 正式版、内测版在数据库中没有命中的版本时，回退到又拍云上的版本文件，与旧的 apk 接口保持一致
+func (s *VersionService) GetLatestVersion(req *version.GetLatestVersionRequest) (*model.LatestVersion, error) {
+	channel := constants.VersionChannelRelease
+	if req.Channel != nil && *req.Channel != "" {
+		channel = *req.Channel
+	}
+	platform, err := checkVersionTarget(&req.Platform, channel)
+	if err != nil {
+		return nil, err
+	}
+	r := newRequester(req.DeviceId, req.StuId, req.Code)
+	v, err := s.resolveAppVersion(platform, channel, r.id)
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.GetLatestVersion: %w", err)
+	}
+	if v != nil {
+		force, err := s.mustUpdateTo(platform, channel, r.code, v)
+		if err != nil {
+			return nil, fmt.Errorf("VersionService.GetLatestVersion: %w", err)
+		}
+		return pack.BuildLatestVersion(v, storeURL(platform, channel, v.Url), force), nil
+	}
+	if platform != constants.DevicePlatformAndroid || channel == constants.VersionChannelNightly {
+		return &model.LatestVersion{}, nil
+	}
+
+	legacy, err := getVersionFile(channel)
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.GetLatestVersion: %w", err)
+	}
+	force := legacy.Force
+	if code, err := strconv.ParseInt(legacy.Code, 10, 64); err == nil {
+		force = mustUpdate(r.code, code, 0, legacy.Force)
+	}
+	return pack.BuildLegacyLatestVersion(legacy, force), nil
+}
+
+// storeURL 正式版引导用户前往应用商店，商店链接未配置时使用发布时上传的链接
+// Android 通过 apk 更新，内测版、每日构建通过发布时上传的链接分发，均没有商店链接
+func storeURL(platform, channel, uploaded string) string {
+	if channel != constants.VersionChannelRelease {
+		return ""
+	}
+	switch platform {
+	case constants.DevicePlatformIOS, constants.DevicePlatformHarmony:
+		if link := appStoreLink(platform); link != "" {
+			return link
+		}
+		return uploaded
+	default:
+		return ""
+	}
+}
+
+func appStoreLink(platform string) string {
+	if platform == constants.DevicePlatformIOS {
+		return config.AppStore.IOS
+	}
+	return config.AppStore.Harmony
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbversion "github.com/west2-online/fzuhelper-server/pkg/db/version"
+)
+
+func TestGetLatestVersion(t *testing.T) {
+	type testCase struct {
+		name              string
+		request           *version.GetLatestVersionRequest
+		mockResolved      *model.AppVersion
+		mockResolveErr    error
+		mockLegacy        *pack.Version
+		mockLegacyErr     error
+		mockStoreLink     string
+		mockSkippedForced bool
+		expectVersion     string
+		expectStoreURL    string
+		expectForce       bool
+		expectMinCode     int64
+		expectNoVersion   bool
+		expectError       string
+	}
+
+	ios := &model.AppVersion{Platform: "ios", Code: 30, Version: "3.0.0", Url: "https://apps.apple.com/cn/app/id1", MinSupportedCode: 20}
+	harmonyBeta := &model.AppVersion{Platform: "harmony", Channel: "beta", Code: 31, Version: "3.1.0-beta", Url: "https://example.com/beta"}
+	legacy := &pack.Version{Version: "2.0.0", Code: "20", Url: "http://example.com/release.apk", Force: true}
+
+	testCases := []testCase{
+		{
+			name:           "IOSUseConfiguredStoreLink",
+			request:        &version.GetLatestVersionRequest{Platform: "ios", Code: new("10")},
+			mockResolved:   ios,
+			mockStoreLink:  "https://apps.apple.com/cn/app/id2",
+			expectVersion:  "3.0.0",
+			expectStoreURL: "https://apps.apple.com/cn/app/id2",
+			expectForce:    true,
+			expectMinCode:  20,
+		},
+		{
+			name:           "IOSFallbackToUploadedLink",
+			request:        &version.GetLatestVersionRequest{Platform: "ios", Code: new("25")},
+			mockResolved:   ios,
+			expectVersion:  "3.0.0",
+			expectStoreURL: "https://apps.apple.com/cn/app/id1",
+			expectMinCode:  20,
+		},
+		{
+			// 跳过了要求强制更新的中间版本时，即使最新版本不要求强制更新也必须更新
+			name:              "IOSSkippedForcedVersion",
+			request:           &version.GetLatestVersionRequest{Platform: "ios", Code: new("25")},
+			mockResolved:      ios,
+			mockSkippedForced: true,
+			expectVersion:     "3.0.0",
+			expectStoreURL:    "https://apps.apple.com/cn/app/id1",
+			expectForce:       true,
+			expectMinCode:     20,
+		},
+		{
+			// 已是最新版本时不再判断最低支持版本
+			name:           "IOSAlreadyLatest",
+			request:        &version.GetLatestVersionRequest{Platform: "ios", Code: new("30")},
+			mockResolved:   ios,
+			mockStoreLink:  "https://apps.apple.com/cn/app/id2",
+			expectVersion:  "3.0.0",
+			expectStoreURL: "https://apps.apple.com/cn/app/id2",
+			expectMinCode:  20,
+		},
+		{
+			name:          "HarmonyBetaHasNoStoreLink",
+			request:       &version.GetLatestVersionRequest{Platform: "harmony", Channel: new("beta")},
+			mockResolved:  harmonyBeta,
+			mockStoreLink: "https://appgallery.huawei.com/app/C1",
+			expectVersion: "3.1.0-beta",
+		},
+		{
+			name:            "IOSNoVersion",
+			request:         &version.GetLatestVersionRequest{Platform: "ios"},
+			expectNoVersion: true,
+		},
+		{
+			name:          "AndroidFallbackToVersionFile",
+			request:       &version.GetLatestVersionRequest{Platform: "android", Code: new("10")},
+			mockLegacy:    legacy,
+			expectVersion: "2.0.0",
+			expectForce:   true,
+		},
+		{
+			name:          "AndroidVersionFileAlreadyLatest",
+			request:       &version.GetLatestVersionRequest{Platform: "android", Code: new("20")},
+			mockLegacy:    legacy,
+			expectVersion: "2.0.0",
+		},
+		{
+			name:          "AndroidVersionFileError",
+			request:       &version.GetLatestVersionRequest{Platform: "android"},
+			mockLegacyErr: fmt.Errorf("file not found"),
+			expectError:   "VersionService.GetLatestVersion: file not found",
+		},
+		{
+			name:            "AndroidNightlyNoVersion",
+			request:         &version.GetLatestVersionRequest{Platform: "android", Channel: new("nightly")},
+			expectNoVersion: true,
+		},
+		{
+			name:           "ResolveError",
+			request:        &version.GetLatestVersionRequest{Platform: "ios"},
+			mockResolveErr: fmt.Errorf("db fail"),
+			expectError:    "VersionService.GetLatestVersion: db fail",
+		},
+		{
+			name:        "InvalidPlatform",
+			request:     &version.GetLatestVersionRequest{Platform: "windows"},
+			expectError: "不支持的平台",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*VersionService).resolveAppVersion).Return(tc.mockResolved, tc.mockResolveErr).Build()
+			mockey.Mock(getVersionFile).Return(tc.mockLegacy, tc.mockLegacyErr).Build()
+			mockey.Mock(appStoreLink).Return(tc.mockStoreLink).Build()
+			mockey.Mock((*dbversion.DBVersion).HasForcedAppVersionBetween).Return(tc.mockSkippedForced, nil).Build()
+
+			versionService := &VersionService{db: new(db.Database)}
+			result, err := versionService.GetLatestVersion(tc.request)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				assert.Nil(t, result)
+				return
+			}
+			assert.Nil(t, err)
+			if tc.expectNoVersion {
+				assert.Nil(t, result.Version)
+				assert.False(t, result.Force)
+				return
+			}
+			assert.Equal(t, tc.expectVersion, result.Version.GetVersionName())
+			assert.Equal(t, tc.expectStoreURL, result.GetStoreUrl())
+			assert.Equal(t, tc.expectForce, result.Force)
+			assert.Equal(t, tc.expectMinCode, result.MinSupportedCode)
+		})
+	}
+}
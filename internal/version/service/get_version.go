@@ -22,31 +22,57 @@ import (
 	"github.com/bytedance/sonic"
 
 	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/singleflight"
 	"github.com/west2-online/fzuhelper-server/pkg/upyun"
 )
 
-func (s *VersionService) GetReleaseVersion() (*pack.Version, error) {
-	jsonBytes, err := upyun.URlGetFile(upyun.JoinFileName(releaseVersionFileName))
+func (s *VersionService) GetReleaseVersion(req *version.GetReleaseVersionRequest) (*pack.Version, error) {
+	v, err := s.getVersion(constants.VersionChannelRelease, newRequester(req.DeviceId, req.StuId, req.Code))
 	if err != nil {
 		return nil, fmt.Errorf("VersionService.GetReleaseVersion error:%w", err)
 	}
-	version := new(pack.Version)
-	err = sonic.Unmarshal(*jsonBytes, version)
-	if err != nil {
-		return nil, fmt.Errorf("VersionService.GetReleaseVersion error:%w", err)
-	}
-	return version, nil
+	return v, nil
 }
 
-func (s *VersionService) GetBetaVersion() (*pack.Version, error) {
-	jsonBytes, err := upyun.URlGetFile(upyun.JoinFileName(betaVersionFileName))
+func (s *VersionService) GetBetaVersion(req *version.GetBetaVersionRequest) (*pack.Version, error) {
+	v, err := s.getVersion(constants.VersionChannelBeta, newRequester(req.DeviceId, req.StuId, req.Code))
 	if err != nil {
 		return nil, fmt.Errorf("VersionService.GetBetaVersion error:%w", err)
 	}
-	version := new(pack.Version)
-	err = sonic.Unmarshal(*jsonBytes, version)
+	return v, nil
+}
+
+// getVersion 优先按灰度从数据库中选出 Android 版本
+// 数据库不可用或请求者没有命中任何版本时，读取又拍云上最近一次全量发布的版本文件
+func (s *VersionService) getVersion(channel string, r requester) (*pack.Version, error) {
+	v, err := s.resolveVersion(constants.DevicePlatformAndroid, channel, r)
 	if err != nil {
-		return nil, fmt.Errorf("VersionService.GetBetaVersion error:%w", err)
+		logger.WithCtx(s.ctx).Warnf("VersionService.getVersion: resolve %s version failed, fallback to upyun: %v", channel, err)
+	}
+	if v != nil {
+		return v, nil
+	}
+	return getVersionFile(channel)
+}
+
+// getVersionFile 读取又拍云上的版本文件，该文件与所有请求者无关，并发请求合并为一次读取
+func getVersionFile(channel string) (*pack.Version, error) {
+	fileName, key := releaseVersionFileName, constants.SingleflightReleaseVersionKey
+	if channel == constants.VersionChannelBeta {
+		fileName, key = betaVersionFileName, constants.SingleflightBetaVersionKey
 	}
-	return version, nil
+	return singleflight.Do(key, func() (*pack.Version, error) {
+		jsonBytes, err := upyun.URlGetFile(upyun.JoinFileName(fileName))
+		if err != nil {
+			return nil, err
+		}
+		version := new(pack.Version)
+		if err = sonic.Unmarshal(*jsonBytes, version); err != nil {
+			return nil, err
+		}
+		return version, nil
+	})
 }
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// GetVersionChangelog 获取客户端当前版本之后、到请求者在灰度下能更新到的版本为止的所有更新日志
+// 已回滚的版本不计入；请求者已是最新版本时返回空的更新日志
+func (s *VersionService) GetVersionChangelog(req *version.GetVersionChangelogRequest) (*model.VersionChangelog, error) {
+	platform, err := checkVersionTarget(req.Platform, req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	code, err := strconv.ParseInt(req.Code, 10, 64)
+	if err != nil {
+		return nil, errno.ParamError.WithMessage("版本号必须为整数")
+	}
+	latest, err := s.resolveAppVersion(platform, req.Channel, newRequester(req.DeviceId, req.StuId, nil).id)
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.GetVersionChangelog: %w", err)
+	}
+	if latest == nil || latest.Code <= code {
+		return pack.BuildVersionChangelog(nil, false), nil
+	}
+	versions, err := s.db.Version.ListAppVersionsBetween(s.ctx, platform, req.Channel, code, latest.Code, constants.VersionChangelogMaxVersions)
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.GetVersionChangelog: %w", err)
+	}
+	force, err := s.mustUpdateTo(platform, req.Channel, code, latest)
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.GetVersionChangelog: %w", err)
+	}
+	return pack.BuildVersionChangelog(versions, force), nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbversion "github.com/west2-online/fzuhelper-server/pkg/db/version"
+)
+
+func TestGetVersionChangelog(t *testing.T) {
+	type testCase struct {
+		name              string
+		request           *version.GetVersionChangelogRequest
+		mockLatest        *model.AppVersion
+		mockResolveErr    error
+		mockVersions      []*model.AppVersion
+		mockBetweenErr    error
+		mockSkippedForced bool
+		expectChangelog   string
+		expectForce       bool
+		expectLatest      bool
+		expectError       string
+	}
+
+	v3 := &model.AppVersion{Code: 3, Version: "3.0.0", Feature: "新增成绩提醒", MinSupportedCode: 2}
+	v2 := &model.AppVersion{Code: 2, Version: "2.0.0", Feature: "修复课表显示"}
+
+	testCases := []testCase{
+		{
+			name:            "SkippedVersions",
+			request:         &version.GetVersionChangelogRequest{Channel: "release", Code: "1"},
+			mockLatest:      v3,
+			mockVersions:    []*model.AppVersion{v3, v2},
+			expectChangelog: "3.0.0\n新增成绩提醒\n\n2.0.0\n修复课表显示",
+			expectForce:     true,
+			expectLatest:    true,
+		},
+		{
+			name:            "OneVersionBehind",
+			request:         &version.GetVersionChangelogRequest{Channel: "release", Code: "2"},
+			mockLatest:      v3,
+			mockVersions:    []*model.AppVersion{v3},
+			expectChangelog: "3.0.0\n新增成绩提醒",
+			expectLatest:    true,
+		},
+		{
+			name:              "SkippedForcedVersion",
+			request:           &version.GetVersionChangelogRequest{Channel: "release", Code: "2"},
+			mockLatest:        v3,
+			mockVersions:      []*model.AppVersion{v3},
+			mockSkippedForced: true,
+			expectChangelog:   "3.0.0\n新增成绩提醒",
+			expectForce:       true,
+			expectLatest:      true,
+		},
+		{
+			name:       "AlreadyLatest",
+			request:    &version.GetVersionChangelogRequest{Channel: "release", Code: "3"},
+			mockLatest: v3,
+		},
+		{
+			name:    "NoVersion",
+			request: &version.GetVersionChangelogRequest{Channel: "beta", Code: "3"},
+		},
+		{
+			name:        "InvalidCode",
+			request:     &version.GetVersionChangelogRequest{Channel: "release", Code: "v1"},
+			expectError: "版本号必须为整数",
+		},
+		{
+			name:           "ResolveError",
+			request:        &version.GetVersionChangelogRequest{Channel: "release", Code: "1"},
+			mockResolveErr: fmt.Errorf("db fail"),
+			expectError:    "VersionService.GetVersionChangelog: db fail",
+		},
+		{
+			name:           "BetweenError",
+			request:        &version.GetVersionChangelogRequest{Channel: "release", Code: "1"},
+			mockLatest:     v3,
+			mockBetweenErr: fmt.Errorf("db fail"),
+			expectError:    "VersionService.GetVersionChangelog: db fail",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*VersionService).resolveAppVersion).Return(tc.mockLatest, tc.mockResolveErr).Build()
+			mockey.Mock((*dbversion.DBVersion).ListAppVersionsBetween).Return(tc.mockVersions, tc.mockBetweenErr).Build()
+			mockey.Mock((*dbversion.DBVersion).HasForcedAppVersionBetween).Return(tc.mockSkippedForced, nil).Build()
+
+			versionService := &VersionService{db: new(db.Database)}
+			result, err := versionService.GetVersionChangelog(tc.request)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				assert.Nil(t, result)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectChangelog, result.Changelog)
+			assert.Len(t, result.Versions, len(tc.mockVersions))
+			if !tc.expectLatest {
+				assert.Nil(t, result.Latest)
+				assert.Empty(t, result.Versions)
+				return
+			}
+			assert.Equal(t, tc.mockLatest.Version, result.Latest.GetVersionName())
+			assert.Equal(t, tc.expectForce, result.Latest.GetForce())
+		})
+	}
+}
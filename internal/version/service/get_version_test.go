@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
 	"github.com/west2-online/fzuhelper-server/pkg/upyun"
 )
 
@@ -33,6 +34,7 @@ func TestGetReleaseVersion(t *testing.T) {
 		name          string        // 测试用例名称
 		mockJsonBytes *[]byte       // mock返回的JSON数据
 		mockError     error         // mock返回的错误
+		mockResolved  *pack.Version // mock灰度命中的版本，为 nil 时回退到又拍云上的版本文件
 		expectResult  *pack.Version // 期望返回的结果
 		expectError   string        // 期望的错误信息
 	}
@@ -40,6 +42,7 @@ func TestGetReleaseVersion(t *testing.T) {
 	// 模拟数据
 	mockVersion := &pack.Version{Url: "http://example.com/release.apk", Version: "1.0.0"}
 	mockVersionBytes, _ := json.Marshal(mockVersion)
+	rolloutVersion := &pack.Version{Url: "http://example.com/release-rollout.apk", Version: "1.1.0", Code: "110"}
 
 	testCases := []testCase{
 		{
@@ -48,6 +51,11 @@ func TestGetReleaseVersion(t *testing.T) {
 			mockError:     nil,
 			expectResult:  mockVersion,
 		},
+		{
+			name:         "RolloutHit",
+			mockResolved: rolloutVersion,
+			expectResult: rolloutVersion,
+		},
 		{
 			name:          "FileNotFound",
 			mockJsonBytes: nil,
@@ -69,6 +77,7 @@ func TestGetReleaseVersion(t *testing.T) {
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
 			// Mock upyun.URlGetFile 方法
+			mockey.Mock((*VersionService).resolveVersion).Return(tc.mockResolved, nil).Build()
 			mockey.Mock(upyun.URlGetFile).Return(tc.mockJsonBytes, tc.mockError).Build()
 			mockey.Mock(upyun.JoinFileName).To(func(filename string) string {
 				return filename
@@ -78,7 +87,7 @@ func TestGetReleaseVersion(t *testing.T) {
 			urlService := &VersionService{}
 
 			// 调用方法
-			result, err := urlService.GetReleaseVersion()
+			result, err := urlService.GetReleaseVersion(&version.GetReleaseVersionRequest{DeviceId: new("device")})
 
 			if tc.expectError != "" {
 				// 如果期望抛错，检查错误信息
@@ -99,6 +108,7 @@ func TestGetBetaVersion(t *testing.T) {
 		name          string        // 测试用例名称
 		mockJsonBytes *[]byte       // mock返回的JSON数据
 		mockError     error         // mock返回的错误
+		mockResolved  *pack.Version // mock灰度命中的版本，为 nil 时回退到又拍云上的版本文件
 		expectResult  *pack.Version // 期望返回的结果
 		expectError   string        // 期望的错误信息
 	}
@@ -106,6 +116,7 @@ func TestGetBetaVersion(t *testing.T) {
 	// 模拟数据
 	mockVersion := &pack.Version{Url: "http://example.com/beta.apk", Version: "1.0.0"}
 	mockVersionBytes, _ := json.Marshal(mockVersion)
+	rolloutVersion := &pack.Version{Url: "http://example.com/beta-rollout.apk", Version: "1.1.0", Code: "110"}
 
 	testCases := []testCase{
 		{
@@ -114,6 +125,11 @@ func TestGetBetaVersion(t *testing.T) {
 			mockError:     nil,
 			expectResult:  mockVersion,
 		},
+		{
+			name:         "RolloutHit",
+			mockResolved: rolloutVersion,
+			expectResult: rolloutVersion,
+		},
 		{
 			name:          "FileNotFound",
 			mockJsonBytes: nil,
@@ -135,6 +151,7 @@ func TestGetBetaVersion(t *testing.T) {
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
 			// Mock upyun.URlGetFile 方法
+			mockey.Mock((*VersionService).resolveVersion).Return(tc.mockResolved, nil).Build()
 			mockey.Mock(upyun.URlGetFile).Return(tc.mockJsonBytes, tc.mockError).Build()
 			mockey.Mock(upyun.JoinFileName).To(func(filename string) string {
 				return filename
@@ -144,7 +161,7 @@ func TestGetBetaVersion(t *testing.T) {
 			urlService := &VersionService{}
 
 			// 调用方法
-			result, err := urlService.GetBetaVersion()
+			result, err := urlService.GetBetaVersion(&version.GetBetaVersionRequest{DeviceId: new("device")})
 
 			if tc.expectError != "" {
 				// 如果期望抛错，检查错误信息
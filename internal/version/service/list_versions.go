@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+)
+
+const defaultVersionListPageNum = 1
+
+// ListVersions 分页获取版本历史，已回滚的版本保留在历史中并标明状态
+func (s *VersionService) ListVersions(req *version.ListVersionsRequest) ([]*model.AppVersion, int64, error) {
+	platform, err := checkVersionTarget(req.Platform, req.Channel)
+	if err != nil {
+		return nil, 0, err
+	}
+	pageNum, pageSize := req.GetPageNum(), req.GetPageSize()
+	if pageNum <= 0 {
+		pageNum = defaultVersionListPageNum
+	}
+	if pageSize <= 0 || pageSize > constants.VersionListMaxPageSize {
+		pageSize = constants.VersionListDefaultPageSize
+	}
+	versions, total, err := s.db.Version.ListAppVersionHistory(s.ctx, platform, req.Channel, int(pageNum), int(pageSize))
+	if err != nil {
+		return nil, 0, fmt.Errorf("VersionService.ListVersions: %w", err)
+	}
+	return versions, total, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbversion "github.com/west2-online/fzuhelper-server/pkg/db/version"
+)
+
+func TestListVersions(t *testing.T) {
+	type testCase struct {
+		name           string
+		request        *version.ListVersionsRequest
+		mockVersions   []*model.AppVersion
+		mockTotal      int64
+		mockError      error
+		expectPlatform string
+		expectPageNum  int
+		expectPageSize int
+		expectError    string
+	}
+
+	versions := []*model.AppVersion{{Code: 2, Version: "2.0.0"}, {Code: 1, Version: "1.0.0"}}
+
+	testCases := []testCase{
+		{
+			name:           "DefaultPage",
+			request:        &version.ListVersionsRequest{Channel: "release"},
+			mockVersions:   versions,
+			mockTotal:      2,
+			expectPlatform: "android",
+			expectPageNum:  1,
+			expectPageSize: 20,
+		},
+		{
+			name:           "PageSizeTooLarge",
+			request:        &version.ListVersionsRequest{Channel: "beta", Platform: new("ios"), PageNum: new(int64(3)), PageSize: new(int64(1000))},
+			mockVersions:   versions,
+			mockTotal:      2,
+			expectPlatform: "ios",
+			expectPageNum:  3,
+			expectPageSize: 20,
+		},
+		{
+			name:        "InvalidChannel",
+			request:     &version.ListVersionsRequest{Channel: "alpha"},
+			expectError: "参数错误",
+		},
+		{
+			name:        "DBError",
+			request:     &version.ListVersionsRequest{Channel: "release"},
+			mockError:   fmt.Errorf("db fail"),
+			expectError: "VersionService.ListVersions: db fail",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*dbversion.DBVersion).ListAppVersionHistory).To(
+				func(_ *dbversion.DBVersion, _ context.Context, platform, channel string, pageNum, pageSize int) ([]*model.AppVersion, int64, error) {
+					if tc.mockError == nil {
+						assert.Equal(t, tc.expectPlatform, platform)
+						assert.Equal(t, tc.expectPageNum, pageNum)
+						assert.Equal(t, tc.expectPageSize, pageSize)
+					}
+					return tc.mockVersions, tc.mockTotal, tc.mockError
+				}).Build()
+
+			versionService := &VersionService{db: new(db.Database)}
+			result, total, err := versionService.ListVersions(tc.request)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.mockVersions, result)
+			assert.Equal(t, tc.mockTotal, total)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+// RollbackVersion 将某平台、渠道的最新生效版本标记为已回滚，返回回滚后全量生效的版本
+// 回滚目标是更早的版本中最近一个全量发布的版本，其间仍在灰度的版本保持生效，只下发给各自的灰度人群；
+// 在最近 VersionRolloutCandidates 个版本中找不到全量发布的版本时拒绝回滚
+// Android 的正式版、内测版同时把又拍云上的版本文件恢复为该全量版本
+func (s *VersionService) RollbackVersion(req *version.RollbackVersionRequest) (*pack.Version, error) {
+	if !utils.CheckPwd(req.Password) {
+		return nil, buildAuthFailedError()
+	}
+	platform, err := checkVersionTarget(req.Platform, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.db.Version.ListActiveAppVersions(s.ctx, platform, req.Type, constants.VersionRolloutCandidates)
+	if err != nil {
+		return nil, fmt.Errorf("VersionService.RollbackVersion: %w", err)
+	}
+	if len(versions) < 2 {
+		return nil, errno.ParamError.WithMessage("没有可回滚的上一个版本")
+	}
+	i := slices.IndexFunc(versions[1:], func(v *model.AppVersion) bool {
+		return v.RolloutPercent >= constants.VersionRolloutFull
+	})
+	if i < 0 {
+		return nil, errno.ParamError.WithMessage("没有可回滚的全量发布版本")
+	}
+	if err = s.db.Version.UpdateAppVersionStatus(s.ctx, versions[0].Id, constants.VersionStatusRolledBack); err != nil {
+		return nil, fmt.Errorf("VersionService.RollbackVersion: %w", err)
+	}
+	target := pack.BuildAppVersion(versions[i+1])
+	if err = writeVersionFile(platform, req.Type, target); err != nil {
+		return nil, fmt.Errorf("VersionService.RollbackVersion: restore version file: %w", err)
+	}
+	return target, nil
+}
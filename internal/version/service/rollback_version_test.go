@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbversion "github.com/west2-online/fzuhelper-server/pkg/db/version"
+	"github.com/west2-online/fzuhelper-server/pkg/upyun"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestRollbackVersion(t *testing.T) {
+	type testCase struct {
+		name            string
+		mockCheckPwd    bool
+		mockVersions    []*model.AppVersion
+		mockListError   error
+		mockUpdateError error
+		mockUploadError error
+		request         *version.RollbackVersionRequest
+		expectResult    *pack.Version
+		expectError     string
+	}
+
+	current := &model.AppVersion{Id: 2, Code: 2, Version: "2.0.0", RolloutPercent: 100}
+	previous := &model.AppVersion{Id: 1, Code: 1, Version: "1.0.0", RolloutPercent: 100}
+	partial := &model.AppVersion{Id: 3, Code: 3, Version: "3.0.0", RolloutPercent: 20}
+	latest := &model.AppVersion{Id: 4, Code: 4, Version: "4.0.0", RolloutPercent: 100}
+
+	testCases := []testCase{
+		{
+			name:         "Success",
+			mockCheckPwd: true,
+			mockVersions: []*model.AppVersion{current, previous},
+			request:      &version.RollbackVersionRequest{Password: "validpassword", Type: apkTypeRelease},
+			expectResult: &pack.Version{Version: "1.0.0", Code: "1"},
+		},
+		{
+			name:         "SkipsPartialRollout",
+			mockCheckPwd: true,
+			mockVersions: []*model.AppVersion{latest, partial, current, previous},
+			request:      &version.RollbackVersionRequest{Password: "validpassword", Type: apkTypeRelease},
+			expectResult: &pack.Version{Version: "2.0.0", Code: "2"},
+		},
+		{
+			name:         "NoFullRolloutTarget",
+			mockCheckPwd: true,
+			mockVersions: []*model.AppVersion{latest, partial},
+			request:      &version.RollbackVersionRequest{Password: "validpassword", Type: apkTypeRelease},
+			expectError:  "没有可回滚的全量发布版本",
+		},
+		{
+			name:         "InvalidPassword",
+			mockCheckPwd: false,
+			request:      &version.RollbackVersionRequest{Password: "invalidpassword", Type: apkTypeRelease},
+			expectError:  "[401] authorization failed",
+		},
+		{
+			name:         "NoPreviousVersion",
+			mockCheckPwd: true,
+			mockVersions: []*model.AppVersion{current},
+			request:      &version.RollbackVersionRequest{Password: "validpassword", Type: apkTypeRelease},
+			expectError:  "没有可回滚的上一个版本",
+		},
+		{
+			name:          "ListError",
+			mockCheckPwd:  true,
+			mockListError: fmt.Errorf("db fail"),
+			request:       &version.RollbackVersionRequest{Password: "validpassword", Type: apkTypeBeta},
+			expectError:   "VersionService.RollbackVersion: db fail",
+		},
+		{
+			name:            "UpdateError",
+			mockCheckPwd:    true,
+			mockVersions:    []*model.AppVersion{current, previous},
+			mockUpdateError: fmt.Errorf("db fail"),
+			request:         &version.RollbackVersionRequest{Password: "validpassword", Type: apkTypeRelease},
+			expectError:     "VersionService.RollbackVersion: db fail",
+		},
+		{
+			name:            "RestoreVersionFileError",
+			mockCheckPwd:    true,
+			mockVersions:    []*model.AppVersion{current, previous},
+			mockUploadError: fmt.Errorf("upload fail"),
+			request:         &version.RollbackVersionRequest{Password: "validpassword", Type: apkTypeRelease},
+			expectError:     "restore version file: upload fail",
+		},
+		{
+			name:            "IOSSkipsVersionFile",
+			mockCheckPwd:    true,
+			mockVersions:    []*model.AppVersion{current, previous},
+			mockUploadError: fmt.Errorf("upload fail"),
+			request:         &version.RollbackVersionRequest{Password: "validpassword", Type: apkTypeRelease, Platform: new("ios")},
+			expectResult:    &pack.Version{Version: "1.0.0", Code: "1"},
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock(utils.CheckPwd).Return(tc.mockCheckPwd).Build()
+			mockey.Mock((*dbversion.DBVersion).ListActiveAppVersions).Return(tc.mockVersions, tc.mockListError).Build()
+			mockey.Mock((*dbversion.DBVersion).UpdateAppVersionStatus).Return(tc.mockUpdateError).Build()
+			mockey.Mock(upyun.URlUploadFile).Return(tc.mockUploadError).Build()
+			mockey.Mock(upyun.JoinFileName).To(func(filename string) string {
+				return filename
+			}).Build()
+
+			versionService := &VersionService{db: new(db.Database)}
+			result, err := versionService.RollbackVersion(tc.request)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				assert.Nil(t, result)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectResult, result)
+		})
+	}
+}
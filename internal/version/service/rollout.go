@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"hash/fnv"
+	"strconv"
+
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/singleflight"
+)
+
+// requester 获取版本信息的客户端，用于灰度分桶与最低支持版本判断
+type requester struct {
+	id   string // 优先使用设备 ID，未上报时使用学号；为空时只能命中全量发布的版本
+	code int64  // 客户端当前版本号，未上报或无法解析时为 0，不参与最低支持版本判断
+}
+
+func newRequester(deviceID, stuID, code *string) requester {
+	r := requester{}
+	switch {
+	case deviceID != nil && *deviceID != "":
+		r.id = *deviceID
+	case stuID != nil && *stuID != "":
+		r.id = *stuID
+	}
+	if code != nil {
+		r.code, _ = strconv.ParseInt(*code, 10, 64)
+	}
+	return r
+}
+
+// inRollout 判断请求者是否落在版本的灰度范围内
+// 分桶时拼接版本号，使不同版本的灰度人群相互独立；同一版本提高灰度比例时已命中的请求者保持命中
+func inRollout(id string, v *model.AppVersion) bool {
+	if v.RolloutPercent >= constants.VersionRolloutFull {
+		return true
+	}
+	if id == "" {
+		return false
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(id + ":" + strconv.FormatInt(v.Code, 10)))
+	return int64(h.Sum32()%constants.VersionRolloutFull) < v.RolloutPercent
+}
+
+// rolloutCandidates 获取参与灰度计算的最新生效版本，同一平台、渠道的并发请求合并为一次查询
+func (s *VersionService) rolloutCandidates(platform, channel string) ([]*model.AppVersion, error) {
+	key := singleflight.Key(constants.SingleflightVersionCandidatesPrefix, platform, channel)
+	return singleflight.Do(key, func() ([]*model.AppVersion, error) {
+		return s.db.Version.ListActiveAppVersions(s.ctx, platform, channel, constants.VersionRolloutCandidates)
+	})
+}
+
+// resolveAppVersion 从新到旧选出请求者命中的第一个版本，没有命中的版本时返回 nil
+func (s *VersionService) resolveAppVersion(platform, channel, id string) (*model.AppVersion, error) {
+	candidates, err := s.rolloutCandidates(platform, channel)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range candidates {
+		if inRollout(id, v) {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+// resolveVersion 选出下发给请求者的版本，没有命中的版本时返回 nil，由调用方回退到又拍云上的版本文件
+func (s *VersionService) resolveVersion(platform, channel string, r requester) (*pack.Version, error) {
+	v, err := s.resolveAppVersion(platform, channel, r.id)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	force, err := s.mustUpdateTo(platform, channel, r.code, v)
+	if err != nil {
+		return nil, err
+	}
+	version := pack.BuildAppVersion(v)
+	version.Force = force
+	return version, nil
+}
+
+// belowMinSupported 客户端版本低于最低支持版本时需要强制更新，未上报版本号的客户端不做判断
+func belowMinSupported(code, minSupportedCode int64) bool {
+	return code > 0 && code < minSupportedCode
+}
+
+// mustUpdate 判断客户端是否必须更新到 latestCode
+// 已是最新版本时不需要更新；否则该版本要求强制更新，或客户端低于最低支持版本时必须更新
+func mustUpdate(code, latestCode, minSupportedCode int64, force bool) bool {
+	if code > 0 && code >= latestCode {
+		return false
+	}
+	return force || belowMinSupported(code, minSupportedCode)
+}
+
+// mustUpdateTo 判断客户端是否必须更新到 latest
+// 除 mustUpdate 的判断外，客户端跳过的版本 (code, latest.Code] 中只要有一个要求强制更新也必须更新，
+// 避免之后发布的非强制版本让跳过强制版本的客户端不再被强制更新；未上报版本号的客户端只看 latest 本身
+func (s *VersionService) mustUpdateTo(platform, channel string, code int64, latest *model.AppVersion) (bool, error) {
+	if mustUpdate(code, latest.Code, latest.MinSupportedCode, latest.Force) {
+		return true, nil
+	}
+	if code <= 0 || code >= latest.Code {
+		return false, nil
+	}
+	return s.db.Version.HasForcedAppVersionBetween(s.ctx, platform, channel, code, latest.Code)
+}
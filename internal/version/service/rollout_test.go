@@ -0,0 +1,266 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbversion "github.com/west2-online/fzuhelper-server/pkg/db/version"
+)
+
+func TestNewRequester(t *testing.T) {
+	type testCase struct {
+		name     string
+		deviceID *string
+		stuID    *string
+		code     *string
+		expected requester
+	}
+
+	testCases := []testCase{
+		{
+			name:     "PreferDeviceID",
+			deviceID: new("device"),
+			stuID:    new("102301517"),
+			code:     new("633001"),
+			expected: requester{id: "device", code: 633001},
+		},
+		{
+			name:     "FallbackToStuID",
+			deviceID: new(""),
+			stuID:    new("102301517"),
+			expected: requester{id: "102301517"},
+		},
+		{
+			name:     "InvalidCode",
+			code:     new("1.0.0"),
+			expected: requester{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, newRequester(tc.deviceID, tc.stuID, tc.code))
+		})
+	}
+}
+
+func TestInRollout(t *testing.T) {
+	full := &model.AppVersion{Code: 1, RolloutPercent: 100}
+	none := &model.AppVersion{Code: 1, RolloutPercent: 0}
+	half := &model.AppVersion{Code: 1, RolloutPercent: 50}
+
+	assert.True(t, inRollout("", full))
+	assert.False(t, inRollout("", half))
+	assert.False(t, inRollout("device", none))
+
+	// 灰度比例为 50 时，大量请求者中命中的比例应接近一半，且同一请求者的结果稳定
+	hit := 0
+	for i := range 1000 {
+		id := fmt.Sprintf("device-%d", i)
+		if inRollout(id, half) {
+			hit++
+		}
+		assert.Equal(t, inRollout(id, half), inRollout(id, half))
+	}
+	assert.InDelta(t, 500, hit, 100)
+
+	// 提高灰度比例后，已命中的请求者保持命中
+	more := &model.AppVersion{Code: 1, RolloutPercent: 80}
+	for i := range 1000 {
+		id := fmt.Sprintf("device-%d", i)
+		if inRollout(id, half) {
+			assert.True(t, inRollout(id, more))
+		}
+	}
+}
+
+func TestMustUpdate(t *testing.T) {
+	type testCase struct {
+		name             string
+		code             int64
+		latestCode       int64
+		minSupportedCode int64
+		force            bool
+		expected         bool
+	}
+
+	testCases := []testCase{
+		{name: "AlreadyLatest", code: 3, latestCode: 3, minSupportedCode: 5, force: true, expected: false},
+		{name: "ForcedVersion", code: 2, latestCode: 3, force: true, expected: true},
+		{name: "BelowMinSupported", code: 1, latestCode: 3, minSupportedCode: 2, expected: true},
+		{name: "OptionalUpdate", code: 2, latestCode: 3, minSupportedCode: 2, expected: false},
+		{name: "UnknownCode", latestCode: 3, minSupportedCode: 2, expected: false},
+		{name: "UnknownCodeForcedVersion", latestCode: 3, force: true, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mustUpdate(tc.code, tc.latestCode, tc.minSupportedCode, tc.force))
+		})
+	}
+}
+
+func TestMustUpdateTo(t *testing.T) {
+	type testCase struct {
+		name              string
+		code              int64
+		latest            *model.AppVersion
+		mockSkippedForced bool
+		mockError         error
+		expected          bool
+		expectError       string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "LatestForced",
+			code:     1,
+			latest:   &model.AppVersion{Code: 3, Force: true},
+			expected: true,
+		},
+		{
+			name:              "SkippedForcedVersion",
+			code:              1,
+			latest:            &model.AppVersion{Code: 3},
+			mockSkippedForced: true,
+			expected:          true,
+		},
+		{
+			name:     "NoSkippedForcedVersion",
+			code:     1,
+			latest:   &model.AppVersion{Code: 3},
+			expected: false,
+		},
+		{
+			name:              "UpToDate",
+			code:              3,
+			latest:            &model.AppVersion{Code: 3},
+			mockSkippedForced: true,
+			expected:          false,
+		},
+		{
+			name:              "UnknownClientCode",
+			latest:            &model.AppVersion{Code: 3},
+			mockSkippedForced: true,
+			expected:          false,
+		},
+		{
+			name:        "DBError",
+			code:        1,
+			latest:      &model.AppVersion{Code: 3},
+			mockError:   fmt.Errorf("db fail"),
+			expectError: "db fail",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*dbversion.DBVersion).HasForcedAppVersionBetween).Return(tc.mockSkippedForced, tc.mockError).Build()
+
+			versionService := &VersionService{db: new(db.Database)}
+			result, err := versionService.mustUpdateTo("android", "release", tc.code, tc.latest)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	type testCase struct {
+		name              string
+		mockCandidates    []*model.AppVersion
+		mockError         error
+		mockSkippedForced bool
+		requester         requester
+		expectResult      *pack.Version
+		expectError       string
+	}
+
+	latest := &model.AppVersion{Code: 3, Version: "3.0.0", RolloutPercent: 0}
+	stable := &model.AppVersion{Code: 2, Version: "2.0.0", RolloutPercent: 100, MinSupportedCode: 2}
+
+	testCases := []testCase{
+		{
+			name:           "SkipVersionOutOfRollout",
+			mockCandidates: []*model.AppVersion{latest, stable},
+			requester:      requester{id: "device", code: 2},
+			expectResult:   &pack.Version{Version: "2.0.0", Code: "2"},
+		},
+		{
+			name:           "ForceBelowMinSupported",
+			mockCandidates: []*model.AppVersion{stable},
+			requester:      requester{id: "device", code: 1},
+			expectResult:   &pack.Version{Version: "2.0.0", Code: "2", Force: true},
+		},
+		{
+			name:              "ForceSkippedForcedVersion",
+			mockCandidates:    []*model.AppVersion{stable},
+			mockSkippedForced: true,
+			requester:         requester{id: "device", code: 1},
+			expectResult:      &pack.Version{Version: "2.0.0", Code: "2", Force: true},
+		},
+		{
+			name:           "UnknownClientCodeNotForced",
+			mockCandidates: []*model.AppVersion{stable},
+			requester:      requester{id: "device"},
+			expectResult:   &pack.Version{Version: "2.0.0", Code: "2"},
+		},
+		{
+			name:           "NoVersionHit",
+			mockCandidates: []*model.AppVersion{latest},
+			requester:      requester{id: "device"},
+			expectResult:   nil,
+		},
+		{
+			name:        "DBError",
+			mockError:   fmt.Errorf("db fail"),
+			expectError: "db fail",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*dbversion.DBVersion).ListActiveAppVersions).Return(tc.mockCandidates, tc.mockError).Build()
+			mockey.Mock((*dbversion.DBVersion).HasForcedAppVersionBetween).Return(tc.mockSkippedForced, nil).Build()
+
+			versionService := &VersionService{db: new(db.Database)}
+			result, err := versionService.resolveVersion("android", "release", tc.requester)
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectResult, result)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+// SeedLegacyVersions 将又拍云上 Android 正式版、内测版的版本文件导入版本记录，避免迁移后版本历史从空开始
+// 只在该渠道还没有任何版本记录时导入，重复执行不会产生多余的记录
+func (s *VersionService) SeedLegacyVersions() error {
+	for _, channel := range []string{constants.VersionChannelRelease, constants.VersionChannelBeta} {
+		if err := s.seedLegacyVersion(channel); err != nil {
+			return fmt.Errorf("VersionService.SeedLegacyVersions: %s: %w", channel, err)
+		}
+	}
+	return nil
+}
+
+func (s *VersionService) seedLegacyVersion(channel string) error {
+	_, total, err := s.db.Version.ListAppVersionHistory(s.ctx, constants.DevicePlatformAndroid, channel, 1, 1)
+	if err != nil {
+		return err
+	}
+	if total > 0 {
+		return nil
+	}
+	legacy, err := getVersionFile(channel)
+	if err != nil {
+		return err
+	}
+	code, err := strconv.ParseInt(legacy.Code, 10, 64)
+	if err != nil {
+		logger.Warnf("VersionService.SeedLegacyVersions: skip %s version file with invalid code %q", channel, legacy.Code)
+		return nil
+	}
+	return s.db.Version.CreateAppVersion(s.ctx, &model.AppVersion{
+		Platform:       constants.DevicePlatformAndroid,
+		Channel:        channel,
+		Code:           code,
+		Version:        legacy.Version,
+		Url:            legacy.Url,
+		Feature:        legacy.Feature,
+		Force:          legacy.Force,
+		RolloutPercent: constants.VersionRolloutFull,
+	})
+}
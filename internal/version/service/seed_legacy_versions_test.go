@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/internal/version/pack"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbversion "github.com/west2-online/fzuhelper-server/pkg/db/version"
+)
+
+func TestSeedLegacyVersions(t *testing.T) {
+	type testCase struct {
+		name          string
+		mockTotal     int64
+		mockListErr   error
+		mockLegacy    *pack.Version
+		mockLegacyErr error
+		mockCreateErr error
+		expectSeeded  []*model.AppVersion
+		expectError   string
+	}
+
+	legacy := &pack.Version{Version: "2.0.0", Code: "20", Url: "http://example.com/release.apk", Feature: "修复课表显示", Force: true}
+
+	testCases := []testCase{
+		{
+			name:       "SeedEmptyChannels",
+			mockLegacy: legacy,
+			expectSeeded: []*model.AppVersion{
+				{Platform: "android", Channel: "release", Code: 20, Version: "2.0.0", Url: "http://example.com/release.apk", Feature: "修复课表显示", Force: true, RolloutPercent: 100},
+				{Platform: "android", Channel: "beta", Code: 20, Version: "2.0.0", Url: "http://example.com/release.apk", Feature: "修复课表显示", Force: true, RolloutPercent: 100},
+			},
+		},
+		{
+			name:       "SkipSeededChannels",
+			mockTotal:  1,
+			mockLegacy: legacy,
+		},
+		{
+			name:       "SkipInvalidCode",
+			mockLegacy: &pack.Version{Version: "2.0.0", Code: "v20"},
+		},
+		{
+			name:        "ListError",
+			mockListErr: fmt.Errorf("db fail"),
+			expectError: "VersionService.SeedLegacyVersions: release: db fail",
+		},
+		{
+			name:          "VersionFileError",
+			mockLegacyErr: fmt.Errorf("file not found"),
+			expectError:   "VersionService.SeedLegacyVersions: release: file not found",
+		},
+		{
+			name:          "CreateError",
+			mockLegacy:    legacy,
+			mockCreateErr: fmt.Errorf("db fail"),
+			expectError:   "VersionService.SeedLegacyVersions: release: db fail",
+		},
+	}
+
+	defer mockey.UnPatchAll()
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			var seeded []*model.AppVersion
+			mockey.Mock((*dbversion.DBVersion).ListAppVersionHistory).Return(nil, tc.mockTotal, tc.mockListErr).Build()
+			mockey.Mock(getVersionFile).Return(tc.mockLegacy, tc.mockLegacyErr).Build()
+			mockey.Mock((*dbversion.DBVersion).CreateAppVersion).To(func(_ *dbversion.DBVersion, _ context.Context, v *model.AppVersion) error {
+				seeded = append(seeded, v)
+				return tc.mockCreateErr
+			}).Build()
+
+			versionService := &VersionService{db: new(db.Database)}
+			err := versionService.SeedLegacyVersions()
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectSeeded, seeded)
+		})
+	}
+}
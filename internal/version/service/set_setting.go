@@ -17,14 +17,26 @@ limitations under the License.
 package service
 
 import (
+	"fmt"
+
 	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
-	"github.com/west2-online/fzuhelper-server/pkg/upyun"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
 
+// SetSetting 校验并保存新的云控配置，保存后删除缓存使所有实例重新加载
 func (s *VersionService) SetSetting(req *version.SetCloudRequest) error {
 	if !utils.CheckPwd(req.Password) {
 		return buildAuthFailedError()
 	}
-	return upyun.URlUploadFile([]byte(req.Setting), upyun.JoinFileName(cloudSettingFileName))
+	if _, err := parseDraftSetting(req.Setting); err != nil {
+		return err
+	}
+
+	if _, err := s.db.Version.CreateCloudSetting(s.ctx, req.Setting); err != nil {
+		return fmt.Errorf("VersionService.SetSetting: %w", err)
+	}
+	if err := s.cache.Version.DeleteCloudSetting(s.ctx); err != nil {
+		return fmt.Errorf("VersionService.SetSetting: %w", err)
+	}
+	return nil
 }
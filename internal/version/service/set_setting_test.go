@@ -17,6 +17,7 @@ limitations under the License.
 package service
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -24,7 +25,11 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
-	"github.com/west2-online/fzuhelper-server/pkg/upyun"
+	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	versionCache "github.com/west2-online/fzuhelper-server/pkg/cache/version"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbversion "github.com/west2-online/fzuhelper-server/pkg/db/version"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
 
@@ -32,40 +37,72 @@ func TestSetSetting(t *testing.T) {
 	type testCase struct {
 		name            string                   // 测试用例名称
 		mockCheckPwd    bool                     // 模拟 CheckPwd 的返回值
-		mockUploadError error                    // 模拟 URlUploadFile 的错误
+		mockCreateError error                    // 模拟保存配置的错误
+		mockDeleteError error                    // 模拟删除缓存的错误
 		request         *version.SetCloudRequest // 输入的请求
+		expectSaved     bool                     // 是否保存了配置
 		expectError     string                   // 期望的错误信息
 	}
 
+	validSetting := "{\"Plans\": [ // 默认计划\n{\"Plan\": {\"key\": \"value\"}}]}"
+
 	testCases := []testCase{
 		{
-			name:            "ValidPasswordAndSuccessfulUpload",
-			mockCheckPwd:    true,
-			mockUploadError: nil,
+			name:         "ValidPasswordAndSuccessfulSave",
+			mockCheckPwd: true,
 			request: &version.SetCloudRequest{
 				Password: "validpassword",
-				Setting:  "{\"key\": \"value\"}",
+				Setting:  validSetting,
 			},
+			expectSaved: true,
 		},
 		{
-			name:            "InvalidPassword",
-			mockCheckPwd:    false,
-			mockUploadError: nil,
+			name:         "InvalidPassword",
+			mockCheckPwd: false,
 			request: &version.SetCloudRequest{
 				Password: "invalidpassword",
-				Setting:  "{\"key\": \"value\"}",
+				Setting:  validSetting,
 			},
 			expectError: "[401] authorization failed", // 假设 buildAuthFailedError 返回这个错误信息
 		},
 		{
-			name:            "ValidPasswordButUploadFails",
-			mockCheckPwd:    true,
-			mockUploadError: fmt.Errorf("upload failed"),
+			name:         "SchemaValidationFailed",
+			mockCheckPwd: true,
 			request: &version.SetCloudRequest{
 				Password: "validpassword",
 				Setting:  "{\"key\": \"value\"}",
 			},
-			expectError: "upload failed",
+			expectError: "schema validation failed",
+		},
+		{
+			name:         "InvalidRegexp",
+			mockCheckPwd: true,
+			request: &version.SetCloudRequest{
+				Password: "validpassword",
+				Setting:  "{\"Plans\": [{\"Phone\": \"[\", \"Plan\": {}}]}",
+			},
+			expectError: "Plans[0].Phone: invalid regexp",
+		},
+		{
+			name:            "SaveFails",
+			mockCheckPwd:    true,
+			mockCreateError: fmt.Errorf("db error"),
+			request: &version.SetCloudRequest{
+				Password: "validpassword",
+				Setting:  validSetting,
+			},
+			expectError: "db error",
+		},
+		{
+			name:            "DeleteCacheFails",
+			mockCheckPwd:    true,
+			mockDeleteError: fmt.Errorf("redis error"),
+			request: &version.SetCloudRequest{
+				Password: "validpassword",
+				Setting:  validSetting,
+			},
+			expectSaved: true,
+			expectError: "redis error",
 		},
 	}
 
@@ -73,29 +110,34 @@ func TestSetSetting(t *testing.T) {
 
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
-			// Mock utils.CheckPwd 方法
 			mockey.Mock(utils.CheckPwd).Return(tc.mockCheckPwd).Build()
 
-			// Mock upyun.URlUploadFile 方法
-			mockey.Mock(upyun.URlUploadFile).Return(tc.mockUploadError).Build()
-			mockey.Mock(upyun.JoinFileName).To(func(filename string) string {
-				return filename
-			}).Build()
+			var saved string
+			mockey.Mock((*dbversion.DBVersion).CreateCloudSetting).To(
+				func(_ *dbversion.DBVersion, _ context.Context, content string) (*model.CloudSetting, error) {
+					if tc.mockCreateError != nil {
+						return nil, tc.mockCreateError
+					}
+					saved = content
+					return &model.CloudSetting{Id: 1, Content: content}, nil
+				}).Build()
+			mockey.Mock((*versionCache.CacheVersion).DeleteCloudSetting).Return(tc.mockDeleteError).Build()
 
-			// 初始化 UrlService 实例
-			versionService := &VersionService{}
-
-			// 调用方法
+			versionService := &VersionService{db: new(db.Database), cache: new(cache.Cache)}
 			err := versionService.SetSetting(tc.request)
 
 			if tc.expectError != "" {
-				// 如果期望抛错，检查错误信息
 				assert.NotNil(t, err)
 				assert.ErrorContains(t, err, tc.expectError)
 			} else {
-				// 如果不期望抛错，验证结果
 				assert.Nil(t, err)
 			}
+			// 保存原文，保留注释
+			if tc.expectSaved {
+				assert.Equal(t, tc.request.Setting, saved)
+			} else {
+				assert.Empty(t, saved)
+			}
 		})
 	}
 }
@@ -17,44 +17,38 @@ limitations under the License.
 package service
 
 import (
-	"encoding/json"
 	"fmt"
 
-	"github.com/west2-online/fzuhelper-server/internal/version/pack"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
-	"github.com/west2-online/fzuhelper-server/pkg/upyun"
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
 )
 
+// TestSetting 返回条件命中的计划，传入待发布的配置时使用该配置，否则使用当前生效的配置
 func (s *VersionService) TestSetting(req *version.GetTestRequest) (*[]byte, error) {
-	// 获得Json
-	settingJson, err := upyun.URlGetFile(upyun.JoinFileName(cloudSettingFileName))
-	if err != nil {
-		return nil, fmt.Errorf("VersionService.TestSetting error:%w", err)
-	}
-	noCommentSettingJson, err := getJSONWithoutComments(string(*settingJson))
+	setting, err := s.testTarget(req.Setting)
 	if err != nil {
 		return nil, fmt.Errorf("VersionService.TestSetting error:%w", err)
 	}
 
-	// 绑定结构体
-	cloudSettings := new(pack.CloudSetting)
-	err = json.Unmarshal([]byte(noCommentSettingJson), cloudSettings)
-	if err != nil {
-		return nil, fmt.Errorf("VersionService.TestSetting error:%w", err)
-	}
-
-	criteria := &pack.Plan{
+	plan, err := setting.Match(&cloudsetting.Criteria{
 		Account:   req.Account,
 		Version:   req.Version,
 		Beta:      req.Beta,
 		Phone:     req.Phone,
 		IsLogin:   req.IsLogin,
 		LoginType: req.LoginType,
-	}
-	plan, err := findMatchingPlan(&cloudSettings.Plans, criteria)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("VersionService.TestSetting error:%w", err)
 	}
 	returnPlan := []byte(plan.Plan)
 	return &returnPlan, nil
 }
+
+// testTarget 获取试运行使用的配置
+func (s *VersionService) testTarget(draft *string) (*cloudsetting.Setting, error) {
+	if draft != nil && *draft != "" {
+		return parseDraftSetting(*draft)
+	}
+	return s.loadCloudSetting()
+}
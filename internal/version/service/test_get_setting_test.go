@@ -17,94 +17,61 @@ limitations under the License.
 package service
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
-	"strings"
 	"testing"
 
 	"github.com/bytedance/mockey"
 	"github.com/stretchr/testify/assert"
 
-	"github.com/west2-online/fzuhelper-server/internal/version/pack"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/cloudsetting"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
-	"github.com/west2-online/fzuhelper-server/pkg/upyun"
 )
 
 func TestTestSetting(t *testing.T) {
 	type testCase struct {
-		name                string
-		mockCloudSetting    *[]byte
-		mockCloudSettingErr error
-		mockNoCommentJson   string
-		mockNoCommentError  error
-		mockCriteria        *pack.Plan
-		mockPlanList        []pack.Plan
-		expectResult        *[]byte
-		expectError         string
+		name          string
+		mockLoadError error
+		request       *version.GetTestRequest
+		expectResult  *[]byte
+		expectError   string
 	}
 
-	mockSettings := []byte(`{"Plans":[{"Name":"Test Plan","Plan":{"key":"value"}}]}`)
-	mockPlanResult := []byte(`{"key":"value"}`)
+	activePlan := []byte(`{"key":"active"}`)
+	draftPlan := []byte(`{"key":"draft"}`)
 
 	testCases := []testCase{
 		{
-			name:                "SuccessCase",
-			mockCloudSetting:    &mockSettings,
-			mockCloudSettingErr: nil,
-			mockNoCommentJson:   `{"Plans":[{"Name":"Test Plan","Plan":{"key":"value"}}]}`,
-			mockNoCommentError:  nil,
-			mockCriteria:        &pack.Plan{Name: strPtr("Test Plan")},
-			mockPlanList:        []pack.Plan{{Name: strPtr("Test Plan"), Plan: json.RawMessage(mockPlanResult)}},
-			expectResult:        &mockPlanResult,
+			name:         "ActiveSetting",
+			request:      &version.GetTestRequest{Account: strPtr("102301000")},
+			expectResult: &activePlan,
 		},
 		{
-			name:                "NoMatchingPlan",
-			mockCloudSetting:    &mockSettings,
-			mockCloudSettingErr: nil,
-			mockNoCommentJson:   `{"Plans":[{"Name":"Other Plan","Plan":{"key":"value"}}]}`,
-			mockNoCommentError:  nil,
-			mockCriteria:        &pack.Plan{Name: strPtr("Non-Matching Plan")},
-			mockPlanList:        []pack.Plan{{Name: strPtr("Other Plan"), Plan: json.RawMessage(mockPlanResult)}},
-			expectResult:        nil,
-			expectError: strings.Join([]string{
-				"VersionService.TestSetting error:[",
-				strconv.Itoa(int(errno.NoMatchingPlanError.ErrorCode)), "] ", errno.NoMatchingPlanError.ErrorMsg,
-			}, ""),
+			name: "DraftSetting",
+			request: &version.GetTestRequest{
+				Account: strPtr("102301000"),
+				Setting: strPtr(`{"Plans": [{"Account": "^1023", "Plan": {"key":"draft"}}]}`),
+			},
+			expectResult: &draftPlan,
 		},
 		{
-			name:                "URlGetFileError",
-			mockCloudSetting:    nil,
-			mockCloudSettingErr: fmt.Errorf("network error"),
-			mockNoCommentJson:   "",
-			mockNoCommentError:  nil,
-			mockCriteria:        &pack.Plan{Name: strPtr("Test Plan")},
-			mockPlanList:        []pack.Plan{},
-			expectResult:        nil,
-			expectError:         "VersionService.TestSetting error:network error",
+			name: "InvalidDraftSetting",
+			request: &version.GetTestRequest{
+				Setting: strPtr(`{"Plans": [{"Version": "5\\..*", "Plan": {}}]}`),
+			},
+			expectError: "Plans[0].Version: invalid version range",
 		},
 		{
-			name:                "GetJSONWithoutCommentsError",
-			mockCloudSetting:    &mockSettings,
-			mockCloudSettingErr: nil,
-			mockNoCommentJson:   "",
-			mockNoCommentError:  fmt.Errorf("json processing error"),
-			mockCriteria:        &pack.Plan{Name: strPtr("Test Plan")},
-			mockPlanList:        []pack.Plan{},
-			expectResult:        nil,
-			expectError:         "VersionService.TestSetting error:json processing error",
+			name:        "NoMatchingPlan",
+			request:     &version.GetTestRequest{Account: strPtr("052301000")},
+			expectError: "[" + strconv.Itoa(int(errno.NoMatchingPlanError.ErrorCode)) + "] " + errno.NoMatchingPlanError.ErrorMsg,
 		},
 		{
-			name:                "UnmarshalError",
-			mockCloudSetting:    &mockSettings,
-			mockCloudSettingErr: nil,
-			mockNoCommentJson:   `this is not valid json`,
-			mockNoCommentError:  nil,
-			mockCriteria:        &pack.Plan{Name: strPtr("Test Plan")},
-			mockPlanList:        []pack.Plan{},
-			expectResult:        nil,
-			expectError:         "VersionService.TestSetting error",
+			name:          "LoadError",
+			mockLoadError: fmt.Errorf("network error"),
+			request:       &version.GetTestRequest{},
+			expectError:   "VersionService.TestSetting error:network error",
 		},
 	}
 
@@ -112,37 +79,15 @@ func TestTestSetting(t *testing.T) {
 
 	for _, tc := range testCases {
 		mockey.PatchConvey(tc.name, t, func() {
-			// Mock upyun.URlGetFile for cloud settings
-			mockey.Mock(upyun.URlGetFile).Return(tc.mockCloudSetting, tc.mockCloudSettingErr).Build()
-
-			// Mock upyun.JoinFileName
-			mockey.Mock(upyun.JoinFileName).To(func(filename string) string {
-				return filename
-			}).Build()
-
-			// Mock getJSONWithoutComments
-			mockey.Mock(getJSONWithoutComments).Return(tc.mockNoCommentJson, tc.mockNoCommentError).Build()
-
-			// Mock findMatchingPlan
-			mockey.Mock(findMatchingPlan).To(func(planList *[]pack.Plan, criteria *pack.Plan) (*pack.Plan, error) {
-				if len(tc.mockPlanList) == 0 || tc.mockCriteria == nil || *tc.mockCriteria.Name != *tc.mockPlanList[0].Name {
-					return nil, errno.NoMatchingPlanError
+			mockey.Mock((*VersionService).loadCloudSetting).To(func() (*cloudsetting.Setting, error) {
+				if tc.mockLoadError != nil {
+					return nil, tc.mockLoadError
 				}
-				return &tc.mockPlanList[0], nil
+				return mustParseSetting(t, `{"Plans": [{"Account": "^1023", "Plan": {"key":"active"}}]}`), nil
 			}).Build()
 
-			// Initialize VersionService
 			versionService := &VersionService{}
-
-			// Call the method
-			result, err := versionService.TestSetting(&version.GetTestRequest{
-				Account:   tc.mockCriteria.Account,
-				Version:   tc.mockCriteria.Version,
-				Beta:      tc.mockCriteria.Beta,
-				Phone:     tc.mockCriteria.Phone,
-				IsLogin:   tc.mockCriteria.IsLogin,
-				LoginType: tc.mockCriteria.LoginType,
-			})
+			result, err := versionService.TestSetting(tc.request)
 
 			if tc.expectError != "" {
 				assert.NotNil(t, err)
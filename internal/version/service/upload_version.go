@@ -19,44 +19,122 @@ package service
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/west2-online/fzuhelper-server/internal/version/pack"
 	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
 	"github.com/west2-online/fzuhelper-server/pkg/upyun"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
 
+// UploadVersion 发布版本，版本记录写入数据库并按灰度比例下发
+// 版本记录只追加不覆盖：以相同 code 重新上传只能调整生效版本的灰度比例，版本内容不同或该版本已回滚时拒绝
+// 全量发布的 Android 正式版、内测版同时写入又拍云上的版本文件，供未命中灰度的请求与旧的下载接口使用
 func (s *VersionService) UploadVersion(req *version.UploadRequest) error {
 	if !utils.CheckPwd(req.Password) {
 		return buildAuthFailedError()
 	}
-	v := &pack.Version{
-		Version: req.Version,
-		Code:    req.Code,
-		Url:     req.Url,
-		Feature: req.Feature,
-		Force:   req.Force,
+	platform, err := checkVersionTarget(req.Platform, req.Type)
+	if err != nil {
+		return err
 	}
-	jsonBytes, err := json.Marshal(v)
+	code, err := strconv.ParseInt(req.Code, 10, 64)
 	if err != nil {
+		return errno.ParamError.WithMessage("版本号必须为整数")
+	}
+	rollout := int64(constants.VersionRolloutFull)
+	if req.RolloutPercent != nil {
+		rollout = *req.RolloutPercent
+	}
+	if rollout < 0 || rollout > constants.VersionRolloutFull {
+		return errno.ParamError.WithMessage("灰度比例需在 0-100 之间")
+	}
+	v := &model.AppVersion{
+		Platform:         platform,
+		Channel:          req.Type,
+		Code:             code,
+		Version:          req.Version,
+		Url:              req.Url,
+		Feature:          req.Feature,
+		Force:            req.Force,
+		RolloutPercent:   rollout,
+		MinSupportedCode: req.GetMinSupportedCode(),
+	}
+	if err = s.saveAppVersion(v); err != nil {
+		return fmt.Errorf("VersionService.UploadVersion: %w", err)
+	}
+	if rollout < constants.VersionRolloutFull {
+		return nil
+	}
+	if err = writeVersionFile(platform, req.Type, pack.BuildAppVersion(v)); err != nil {
 		return fmt.Errorf("VersionService.UploadVersion json marshal err: %w", err)
 	}
+	return nil
+}
 
-	switch req.Type {
-	case apkTypeRelease:
-		err = upyun.URlUploadFile(jsonBytes, upyun.JoinFileName(releaseVersionFileName))
-		if err != nil {
-			return fmt.Errorf("VersionService.UploadVersion json marshal err: %w", err)
-		}
-		return nil
-	case apkTypeBeta:
-		err = upyun.URlUploadFile(jsonBytes, upyun.JoinFileName(betaVersionFileName))
-		if err != nil {
-			return fmt.Errorf("VersionService.UploadVersion json marshal err: %w", err)
-		}
+// saveAppVersion 写入新版本；同一版本号已发布时只允许调整灰度比例
+func (s *VersionService) saveAppVersion(v *model.AppVersion) error {
+	existing, err := s.db.Version.GetAppVersionByCode(s.ctx, v.Platform, v.Channel, v.Code)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return s.db.Version.CreateAppVersion(s.ctx, v)
+	}
+	if existing.Status != constants.VersionStatusActive {
+		return errno.ParamError.WithMessage("该版本号已回滚，请使用新的版本号发布")
+	}
+	if !sameRelease(existing, v) {
+		return errno.ParamError.WithMessage("该版本号已发布，版本内容不可修改，请使用新的版本号")
+	}
+	return s.db.Version.UpdateAppVersionRollout(s.ctx, existing.Id, v.RolloutPercent)
+}
+
+// sameRelease 判断两次上传的版本内容是否一致，灰度比例不计入
+func sameRelease(a, b *model.AppVersion) bool {
+	return a.Version == b.Version && a.Url == b.Url && a.Feature == b.Feature &&
+		a.Force == b.Force && a.MinSupportedCode == b.MinSupportedCode
+}
+
+// checkVersionTarget 校验发布的平台与渠道，未指定平台时默认为 Android
+func checkVersionTarget(platform *string, channel string) (string, error) {
+	p := constants.DevicePlatformAndroid
+	if platform != nil && *platform != "" {
+		p = *platform
+	}
+	switch p {
+	case constants.DevicePlatformAndroid, constants.DevicePlatformIOS, constants.DevicePlatformHarmony:
+	default:
+		return "", errno.ParamError.WithMessage("不支持的平台")
+	}
+	switch channel {
+	case constants.VersionChannelRelease, constants.VersionChannelBeta, constants.VersionChannelNightly:
+	default:
+		return "", errno.ParamError
+	}
+	return p, nil
+}
+
+// writeVersionFile 覆盖又拍云上的版本文件，只有 Android 的正式版、内测版存在版本文件
+func writeVersionFile(platform, channel string, v *pack.Version) error {
+	if platform != constants.DevicePlatformAndroid {
 		return nil
+	}
+	var fileName string
+	switch channel {
+	case constants.VersionChannelRelease:
+		fileName = releaseVersionFileName
+	case constants.VersionChannelBeta:
+		fileName = betaVersionFileName
 	default:
-		return errno.ParamError
+		return nil
+	}
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return err
 	}
+	return upyun.URlUploadFile(jsonBytes, upyun.JoinFileName(fileName))
 }
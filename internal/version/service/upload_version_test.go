@@ -17,6 +17,7 @@ limitations under the License.
 package service
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -25,6 +26,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/west2-online/fzuhelper-server/kitex_gen/version"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbversion "github.com/west2-online/fzuhelper-server/pkg/db/version"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
 	"github.com/west2-online/fzuhelper-server/pkg/upyun"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
@@ -36,10 +41,18 @@ func TestUploadVersion(t *testing.T) {
 		mockCheckPwd     bool                   // 模拟 CheckPwd 的返回值
 		mockUploadError  error                  // 模拟 URlUploadFile 的错误
 		mockMarshalError error                  // 模拟 JSON Marshal 的错误
+		mockDBError      error                  // 模拟写入数据库的错误
+		mockExisting     *model.AppVersion      // 模拟数据库中已发布的同一版本号
 		request          *version.UploadRequest // 请求参数
+		expectRollout    int64                  // 期望调整后的灰度比例，0 表示不调整
 		expectError      string                 // 期望的错误信息
 	}
 
+	published := &model.AppVersion{
+		Id: 1, Code: 633001, Version: "1.0.0", Url: "http://example.com/release.apk", Feature: "New features",
+		RolloutPercent: 10, Status: constants.VersionStatusActive,
+	}
+
 	// 测试用例
 	testCases := []testCase{
 		{
@@ -145,6 +158,147 @@ func TestUploadVersion(t *testing.T) {
 			},
 			expectError: "VersionService.UploadVersion json marshal err: upload fail",
 		},
+		{
+			name:         "InvalidCode",
+			mockCheckPwd: true,
+			request: &version.UploadRequest{
+				Password: "validpassword",
+				Version:  "1.0.0",
+				Code:     "1.0.0",
+				Url:      "http://example.com/release.apk",
+				Feature:  "New features",
+				Type:     apkTypeRelease,
+			},
+			expectError: "版本号必须为整数",
+		},
+		{
+			name:         "InvalidPlatform",
+			mockCheckPwd: true,
+			request: &version.UploadRequest{
+				Password: "validpassword",
+				Version:  "1.0.0",
+				Code:     "633001",
+				Url:      "http://example.com/release.apk",
+				Feature:  "New features",
+				Type:     apkTypeRelease,
+				Platform: new("windows"),
+			},
+			expectError: "不支持的平台",
+		},
+		{
+			name:         "InvalidRolloutPercent",
+			mockCheckPwd: true,
+			request: &version.UploadRequest{
+				Password:       "validpassword",
+				Version:        "1.0.0",
+				Code:           "633001",
+				Url:            "http://example.com/release.apk",
+				Feature:        "New features",
+				Type:           apkTypeRelease,
+				RolloutPercent: new(int64(101)),
+			},
+			expectError: "灰度比例需在 0-100 之间",
+		},
+		{
+			name:         "DBError",
+			mockCheckPwd: true,
+			mockDBError:  fmt.Errorf("db fail"),
+			request: &version.UploadRequest{
+				Password: "validpassword",
+				Version:  "1.0.0",
+				Code:     "633001",
+				Url:      "http://example.com/release.apk",
+				Feature:  "New features",
+				Type:     apkTypeRelease,
+			},
+			expectError: "VersionService.UploadVersion: db fail",
+		},
+		{
+			// 以相同 code 和相同内容重新上传只调整灰度比例
+			name:         "AdjustRolloutOfPublishedVersion",
+			mockCheckPwd: true,
+			mockExisting: published,
+			request: &version.UploadRequest{
+				Password: "validpassword",
+				Version:  "1.0.0",
+				Code:     "633001",
+				Url:      "http://example.com/release.apk",
+				Feature:  "New features",
+				Type:     apkTypeRelease,
+			},
+			expectRollout: 100,
+		},
+		{
+			name:         "ChangedContentRejected",
+			mockCheckPwd: true,
+			mockExisting: published,
+			request: &version.UploadRequest{
+				Password: "validpassword",
+				Version:  "1.0.0",
+				Code:     "633001",
+				Url:      "http://example.com/release.apk",
+				Feature:  "Other features",
+				Type:     apkTypeRelease,
+			},
+			expectError: "版本内容不可修改",
+		},
+		{
+			name:         "RolledBackCodeRejected",
+			mockCheckPwd: true,
+			mockExisting: &model.AppVersion{Id: 1, Code: 633001, Status: constants.VersionStatusRolledBack},
+			request: &version.UploadRequest{
+				Password: "validpassword",
+				Version:  "1.0.0",
+				Code:     "633001",
+				Url:      "http://example.com/release.apk",
+				Feature:  "New features",
+				Type:     apkTypeRelease,
+			},
+			expectError: "该版本号已回滚",
+		},
+		{
+			// 并发上传同一版本号时由唯一索引兜底
+			name:         "DuplicatedCode",
+			mockCheckPwd: true,
+			mockDBError:  errno.NewErrNo(errno.BizLogicCode, "该版本号已发布，请使用新的版本号"),
+			request: &version.UploadRequest{
+				Password: "validpassword",
+				Version:  "1.0.0",
+				Code:     "633001",
+				Url:      "http://example.com/release.apk",
+				Feature:  "New features",
+				Type:     apkTypeRelease,
+			},
+			expectError: "该版本号已发布，请使用新的版本号",
+		},
+		{
+			// 灰度发布不覆盖又拍云上的版本文件，上传失败不会影响结果
+			name:            "PartialRolloutSkipsVersionFile",
+			mockCheckPwd:    true,
+			mockUploadError: fmt.Errorf("upload fail"),
+			request: &version.UploadRequest{
+				Password:       "validpassword",
+				Version:        "1.0.0",
+				Code:           "633001",
+				Url:            "http://example.com/release.apk",
+				Feature:        "New features",
+				Type:           apkTypeRelease,
+				RolloutPercent: new(int64(10)),
+			},
+		},
+		{
+			name:            "NightlySkipsVersionFile",
+			mockCheckPwd:    true,
+			mockUploadError: fmt.Errorf("upload fail"),
+			request: &version.UploadRequest{
+				Password: "validpassword",
+				Version:  "1.0.0",
+				Code:     "633001",
+				Url:      "http://example.com/nightly.apk",
+				Feature:  "Nightly build",
+				Type:     "nightly",
+			},
+		},
 	}
 
 	defer mockey.UnPatchAll() // 清理所有mock
@@ -157,6 +311,14 @@ func TestUploadVersion(t *testing.T) {
 			// Mock json.Marshal when needed
 			mockey.Mock(json.Marshal).Return(nil, tc.mockMarshalError).Build()
 
+			var rollout int64
+			mockey.Mock((*dbversion.DBVersion).GetAppVersionByCode).Return(tc.mockExisting, nil).Build()
+			mockey.Mock((*dbversion.DBVersion).CreateAppVersion).Return(tc.mockDBError).Build()
+			mockey.Mock((*dbversion.DBVersion).UpdateAppVersionRollout).To(func(_ *dbversion.DBVersion, _ context.Context, _, percent int64) error {
+				rollout = percent
+				return nil
+			}).Build()
+
 			// Mock upyun.URlUploadFile 方法
 			mockey.Mock(upyun.URlUploadFile).Return(tc.mockUploadError).Build()
 			mockey.Mock(upyun.JoinFileName).To(func(filename string) string {
@@ -164,7 +326,7 @@ func TestUploadVersion(t *testing.T) {
 			}).Build()
 
 			// 初始化 UrlService 实例
-			versionService := &VersionService{}
+			versionService := &VersionService{db: new(db.Database)}
 
 			// 调用方法
 			err := versionService.UploadVersion(tc.request)
@@ -176,6 +338,7 @@ func TestUploadVersion(t *testing.T) {
 			} else {
 				// 如果不期望抛错，验证结果
 				assert.Nil(t, err)
+				assert.Equal(t, tc.expectRollout, rollout)
 			}
 		})
 	}
@@ -43,8 +43,10 @@ func (p *GetScoresRequest) String() string {
 }
 
 type GetScoresResponse struct {
-	Base   *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
-	Scores []*model.Score  `thrift:"scores,2,optional" frugal:"2,optional,list<model.Score>" json:"scores,omitempty"`
+	Base         *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Scores       []*model.Score  `thrift:"scores,2,optional" frugal:"2,optional,list<model.Score>" json:"scores,omitempty"`
+	Stale        *bool           `thrift:"stale,3,optional" frugal:"3,optional,bool" json:"stale,omitempty"`
+	SnapshotTime *int64          `thrift:"snapshot_time,4,optional" frugal:"4,optional,i64" json:"snapshot_time,omitempty"`
 }
 
 func NewGetScoresResponse() *GetScoresResponse {
@@ -71,12 +73,36 @@ func (p *GetScoresResponse) GetScores() (v []*model.Score) {
 	}
 	return p.Scores
 }
+
+var GetScoresResponse_Stale_DEFAULT bool
+
+func (p *GetScoresResponse) GetStale() (v bool) {
+	if !p.IsSetStale() {
+		return GetScoresResponse_Stale_DEFAULT
+	}
+	return *p.Stale
+}
+
+var GetScoresResponse_SnapshotTime_DEFAULT int64
+
+func (p *GetScoresResponse) GetSnapshotTime() (v int64) {
+	if !p.IsSetSnapshotTime() {
+		return GetScoresResponse_SnapshotTime_DEFAULT
+	}
+	return *p.SnapshotTime
+}
 func (p *GetScoresResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 func (p *GetScoresResponse) SetScores(val []*model.Score) {
 	p.Scores = val
 }
+func (p *GetScoresResponse) SetStale(val *bool) {
+	p.Stale = val
+}
+func (p *GetScoresResponse) SetSnapshotTime(val *int64) {
+	p.SnapshotTime = val
+}
 
 func (p *GetScoresResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -86,6 +112,14 @@ func (p *GetScoresResponse) IsSetScores() bool {
 	return p.Scores != nil
 }
 
+func (p *GetScoresResponse) IsSetStale() bool {
+	return p.Stale != nil
+}
+
+func (p *GetScoresResponse) IsSetSnapshotTime() bool {
+	return p.SnapshotTime != nil
+}
+
 func (p *GetScoresResponse) String() string {
 	if p == nil {
 		return "<nil>"
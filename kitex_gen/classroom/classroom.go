@@ -26,10 +26,13 @@ import (
 )
 
 type EmptyRoomRequest struct {
-	Date      string `thrift:"date,1,required" frugal:"1,required,string" json:"date"`
-	Campus    string `thrift:"campus,2,required" frugal:"2,required,string" json:"campus"`
-	StartTime string `thrift:"startTime,3,required" frugal:"3,required,string" json:"startTime"`
-	EndTime   string `thrift:"endTime,4,required" frugal:"4,required,string" json:"endTime"`
+	Date        string  `thrift:"date,1,required" frugal:"1,required,string" json:"date"`
+	Campus      string  `thrift:"campus,2,required" frugal:"2,required,string" json:"campus"`
+	StartTime   string  `thrift:"startTime,3,required" frugal:"3,required,string" json:"startTime"`
+	EndTime     string  `thrift:"endTime,4,required" frugal:"4,required,string" json:"endTime"`
+	Build       *string `thrift:"build,5,optional" frugal:"5,optional,string" json:"build,omitempty"`
+	MinCapacity *int64  `thrift:"minCapacity,6,optional" frugal:"6,optional,i64" json:"minCapacity,omitempty"`
+	SortBy      *string `thrift:"sortBy,7,optional" frugal:"7,optional,string" json:"sortBy,omitempty"`
 }
 
 func NewEmptyRoomRequest() *EmptyRoomRequest {
@@ -54,6 +57,33 @@ func (p *EmptyRoomRequest) GetStartTime() (v string) {
 func (p *EmptyRoomRequest) GetEndTime() (v string) {
 	return p.EndTime
 }
+
+var EmptyRoomRequest_Build_DEFAULT string
+
+func (p *EmptyRoomRequest) GetBuild() (v string) {
+	if !p.IsSetBuild() {
+		return EmptyRoomRequest_Build_DEFAULT
+	}
+	return *p.Build
+}
+
+var EmptyRoomRequest_MinCapacity_DEFAULT int64
+
+func (p *EmptyRoomRequest) GetMinCapacity() (v int64) {
+	if !p.IsSetMinCapacity() {
+		return EmptyRoomRequest_MinCapacity_DEFAULT
+	}
+	return *p.MinCapacity
+}
+
+var EmptyRoomRequest_SortBy_DEFAULT string
+
+func (p *EmptyRoomRequest) GetSortBy() (v string) {
+	if !p.IsSetSortBy() {
+		return EmptyRoomRequest_SortBy_DEFAULT
+	}
+	return *p.SortBy
+}
 func (p *EmptyRoomRequest) SetDate(val string) {
 	p.Date = val
 }
@@ -66,6 +96,27 @@ func (p *EmptyRoomRequest) SetStartTime(val string) {
 func (p *EmptyRoomRequest) SetEndTime(val string) {
 	p.EndTime = val
 }
+func (p *EmptyRoomRequest) SetBuild(val *string) {
+	p.Build = val
+}
+func (p *EmptyRoomRequest) SetMinCapacity(val *int64) {
+	p.MinCapacity = val
+}
+func (p *EmptyRoomRequest) SetSortBy(val *string) {
+	p.SortBy = val
+}
+
+func (p *EmptyRoomRequest) IsSetBuild() bool {
+	return p.Build != nil
+}
+
+func (p *EmptyRoomRequest) IsSetMinCapacity() bool {
+	return p.MinCapacity != nil
+}
+
+func (p *EmptyRoomRequest) IsSetSortBy() bool {
+	return p.SortBy != nil
+}
 
 func (p *EmptyRoomRequest) String() string {
 	if p == nil {
@@ -75,8 +126,9 @@ func (p *EmptyRoomRequest) String() string {
 }
 
 type EmptyRoomResponse struct {
-	Base  *model.BaseResp    `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
-	Rooms []*model.Classroom `thrift:"rooms,2,optional" frugal:"2,optional,list<model.Classroom>" json:"rooms,omitempty"`
+	Base       *model.BaseResp            `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Rooms      []*model.Classroom         `thrift:"rooms,2,optional" frugal:"2,optional,list<model.Classroom>" json:"rooms,omitempty"`
+	SyncStatus *model.EmptyRoomSyncStatus `thrift:"syncStatus,3,optional" frugal:"3,optional,model.EmptyRoomSyncStatus" json:"syncStatus,omitempty"`
 }
 
 func NewEmptyRoomResponse() *EmptyRoomResponse {
@@ -103,12 +155,24 @@ func (p *EmptyRoomResponse) GetRooms() (v []*model.Classroom) {
 	}
 	return p.Rooms
 }
+
+var EmptyRoomResponse_SyncStatus_DEFAULT *model.EmptyRoomSyncStatus
+
+func (p *EmptyRoomResponse) GetSyncStatus() (v *model.EmptyRoomSyncStatus) {
+	if !p.IsSetSyncStatus() {
+		return EmptyRoomResponse_SyncStatus_DEFAULT
+	}
+	return p.SyncStatus
+}
 func (p *EmptyRoomResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 func (p *EmptyRoomResponse) SetRooms(val []*model.Classroom) {
 	p.Rooms = val
 }
+func (p *EmptyRoomResponse) SetSyncStatus(val *model.EmptyRoomSyncStatus) {
+	p.SyncStatus = val
+}
 
 func (p *EmptyRoomResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -118,6 +182,10 @@ func (p *EmptyRoomResponse) IsSetRooms() bool {
 	return p.Rooms != nil
 }
 
+func (p *EmptyRoomResponse) IsSetSyncStatus() bool {
+	return p.SyncStatus != nil
+}
+
 func (p *EmptyRoomResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -125,6 +193,107 @@ func (p *EmptyRoomResponse) String() string {
 	return fmt.Sprintf("EmptyRoomResponse(%+v)", *p)
 }
 
+type RoomScheduleRequest struct {
+	Room string `thrift:"room,1,required" frugal:"1,required,string" json:"room"`
+	Date string `thrift:"date,2,required" frugal:"2,required,string" json:"date"`
+}
+
+func NewRoomScheduleRequest() *RoomScheduleRequest {
+	return &RoomScheduleRequest{}
+}
+
+func (p *RoomScheduleRequest) InitDefault() {
+}
+
+func (p *RoomScheduleRequest) GetRoom() (v string) {
+	return p.Room
+}
+
+func (p *RoomScheduleRequest) GetDate() (v string) {
+	return p.Date
+}
+func (p *RoomScheduleRequest) SetRoom(val string) {
+	p.Room = val
+}
+func (p *RoomScheduleRequest) SetDate(val string) {
+	p.Date = val
+}
+
+func (p *RoomScheduleRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoomScheduleRequest(%+v)", *p)
+}
+
+type RoomScheduleResponse struct {
+	Base       *model.BaseResp            `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Schedule   *model.RoomSchedule        `thrift:"schedule,2,optional" frugal:"2,optional,model.RoomSchedule" json:"schedule,omitempty"`
+	SyncStatus *model.EmptyRoomSyncStatus `thrift:"syncStatus,3,optional" frugal:"3,optional,model.EmptyRoomSyncStatus" json:"syncStatus,omitempty"`
+}
+
+func NewRoomScheduleResponse() *RoomScheduleResponse {
+	return &RoomScheduleResponse{}
+}
+
+func (p *RoomScheduleResponse) InitDefault() {
+}
+
+var RoomScheduleResponse_Base_DEFAULT *model.BaseResp
+
+func (p *RoomScheduleResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RoomScheduleResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var RoomScheduleResponse_Schedule_DEFAULT *model.RoomSchedule
+
+func (p *RoomScheduleResponse) GetSchedule() (v *model.RoomSchedule) {
+	if !p.IsSetSchedule() {
+		return RoomScheduleResponse_Schedule_DEFAULT
+	}
+	return p.Schedule
+}
+
+var RoomScheduleResponse_SyncStatus_DEFAULT *model.EmptyRoomSyncStatus
+
+func (p *RoomScheduleResponse) GetSyncStatus() (v *model.EmptyRoomSyncStatus) {
+	if !p.IsSetSyncStatus() {
+		return RoomScheduleResponse_SyncStatus_DEFAULT
+	}
+	return p.SyncStatus
+}
+func (p *RoomScheduleResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *RoomScheduleResponse) SetSchedule(val *model.RoomSchedule) {
+	p.Schedule = val
+}
+func (p *RoomScheduleResponse) SetSyncStatus(val *model.EmptyRoomSyncStatus) {
+	p.SyncStatus = val
+}
+
+func (p *RoomScheduleResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RoomScheduleResponse) IsSetSchedule() bool {
+	return p.Schedule != nil
+}
+
+func (p *RoomScheduleResponse) IsSetSyncStatus() bool {
+	return p.SyncStatus != nil
+}
+
+func (p *RoomScheduleResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoomScheduleResponse(%+v)", *p)
+}
+
 type ExamRoomInfoRequest struct {
 	Term string `thrift:"term,1,required" frugal:"1,required,string" json:"term"`
 }
@@ -151,8 +320,10 @@ func (p *ExamRoomInfoRequest) String() string {
 }
 
 type ExamRoomInfoResponse struct {
-	Base  *model.BaseResp       `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
-	Rooms []*model.ExamRoomInfo `thrift:"rooms,2,optional" frugal:"2,optional,list<model.ExamRoomInfo>" json:"rooms,omitempty"`
+	Base         *model.BaseResp       `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Rooms        []*model.ExamRoomInfo `thrift:"rooms,2,optional" frugal:"2,optional,list<model.ExamRoomInfo>" json:"rooms,omitempty"`
+	Stale        *bool                 `thrift:"stale,3,optional" frugal:"3,optional,bool" json:"stale,omitempty"`
+	SnapshotTime *int64                `thrift:"snapshot_time,4,optional" frugal:"4,optional,i64" json:"snapshot_time,omitempty"`
 }
 
 func NewExamRoomInfoResponse() *ExamRoomInfoResponse {
@@ -179,12 +350,36 @@ func (p *ExamRoomInfoResponse) GetRooms() (v []*model.ExamRoomInfo) {
 	}
 	return p.Rooms
 }
+
+var ExamRoomInfoResponse_Stale_DEFAULT bool
+
+func (p *ExamRoomInfoResponse) GetStale() (v bool) {
+	if !p.IsSetStale() {
+		return ExamRoomInfoResponse_Stale_DEFAULT
+	}
+	return *p.Stale
+}
+
+var ExamRoomInfoResponse_SnapshotTime_DEFAULT int64
+
+func (p *ExamRoomInfoResponse) GetSnapshotTime() (v int64) {
+	if !p.IsSetSnapshotTime() {
+		return ExamRoomInfoResponse_SnapshotTime_DEFAULT
+	}
+	return *p.SnapshotTime
+}
 func (p *ExamRoomInfoResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 func (p *ExamRoomInfoResponse) SetRooms(val []*model.ExamRoomInfo) {
 	p.Rooms = val
 }
+func (p *ExamRoomInfoResponse) SetStale(val *bool) {
+	p.Stale = val
+}
+func (p *ExamRoomInfoResponse) SetSnapshotTime(val *int64) {
+	p.SnapshotTime = val
+}
 
 func (p *ExamRoomInfoResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -194,6 +389,14 @@ func (p *ExamRoomInfoResponse) IsSetRooms() bool {
 	return p.Rooms != nil
 }
 
+func (p *ExamRoomInfoResponse) IsSetStale() bool {
+	return p.Stale != nil
+}
+
+func (p *ExamRoomInfoResponse) IsSetSnapshotTime() bool {
+	return p.SnapshotTime != nil
+}
+
 func (p *ExamRoomInfoResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -201,8 +404,88 @@ func (p *ExamRoomInfoResponse) String() string {
 	return fmt.Sprintf("ExamRoomInfoResponse(%+v)", *p)
 }
 
+type ExamRoomChangesRequest struct {
+	Term string `thrift:"term,1,required" frugal:"1,required,string" json:"term"`
+}
+
+func NewExamRoomChangesRequest() *ExamRoomChangesRequest {
+	return &ExamRoomChangesRequest{}
+}
+
+func (p *ExamRoomChangesRequest) InitDefault() {
+}
+
+func (p *ExamRoomChangesRequest) GetTerm() (v string) {
+	return p.Term
+}
+func (p *ExamRoomChangesRequest) SetTerm(val string) {
+	p.Term = val
+}
+
+func (p *ExamRoomChangesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExamRoomChangesRequest(%+v)", *p)
+}
+
+type ExamRoomChangesResponse struct {
+	Base    *model.BaseResp         `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Changes []*model.ExamRoomChange `thrift:"changes,2,optional" frugal:"2,optional,list<model.ExamRoomChange>" json:"changes,omitempty"`
+}
+
+func NewExamRoomChangesResponse() *ExamRoomChangesResponse {
+	return &ExamRoomChangesResponse{}
+}
+
+func (p *ExamRoomChangesResponse) InitDefault() {
+}
+
+var ExamRoomChangesResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ExamRoomChangesResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ExamRoomChangesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var ExamRoomChangesResponse_Changes_DEFAULT []*model.ExamRoomChange
+
+func (p *ExamRoomChangesResponse) GetChanges() (v []*model.ExamRoomChange) {
+	if !p.IsSetChanges() {
+		return ExamRoomChangesResponse_Changes_DEFAULT
+	}
+	return p.Changes
+}
+func (p *ExamRoomChangesResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ExamRoomChangesResponse) SetChanges(val []*model.ExamRoomChange) {
+	p.Changes = val
+}
+
+func (p *ExamRoomChangesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExamRoomChangesResponse) IsSetChanges() bool {
+	return p.Changes != nil
+}
+
+func (p *ExamRoomChangesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExamRoomChangesResponse(%+v)", *p)
+}
+
 type ClassroomService interface {
 	GetEmptyRoom(ctx context.Context, req *EmptyRoomRequest) (r *EmptyRoomResponse, err error)
 
 	GetExamRoomInfo(ctx context.Context, req *ExamRoomInfoRequest) (r *ExamRoomInfoResponse, err error)
+
+	GetRoomSchedule(ctx context.Context, req *RoomScheduleRequest) (r *RoomScheduleResponse, err error)
+
+	GetExamRoomChanges(ctx context.Context, req *ExamRoomChangesRequest) (r *ExamRoomChangesResponse, err error)
 }
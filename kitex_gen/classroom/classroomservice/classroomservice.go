@@ -45,6 +45,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetRoomSchedule": kitex.NewMethodInfo(
+		getRoomScheduleHandler,
+		newClassroomServiceGetRoomScheduleArgs,
+		newClassroomServiceGetRoomScheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetExamRoomChanges": kitex.NewMethodInfo(
+		getExamRoomChangesHandler,
+		newClassroomServiceGetExamRoomChangesArgs,
+		newClassroomServiceGetExamRoomChangesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -147,6 +161,42 @@ func newClassroomServiceGetExamRoomInfoResult() interface{} {
 	return classroom.NewClassroomServiceGetExamRoomInfoResult()
 }
 
+func getRoomScheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*classroom.ClassroomServiceGetRoomScheduleArgs)
+	realResult := result.(*classroom.ClassroomServiceGetRoomScheduleResult)
+	success, err := handler.(classroom.ClassroomService).GetRoomSchedule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newClassroomServiceGetRoomScheduleArgs() interface{} {
+	return classroom.NewClassroomServiceGetRoomScheduleArgs()
+}
+
+func newClassroomServiceGetRoomScheduleResult() interface{} {
+	return classroom.NewClassroomServiceGetRoomScheduleResult()
+}
+
+func getExamRoomChangesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*classroom.ClassroomServiceGetExamRoomChangesArgs)
+	realResult := result.(*classroom.ClassroomServiceGetExamRoomChangesResult)
+	success, err := handler.(classroom.ClassroomService).GetExamRoomChanges(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newClassroomServiceGetExamRoomChangesArgs() interface{} {
+	return classroom.NewClassroomServiceGetExamRoomChangesArgs()
+}
+
+func newClassroomServiceGetExamRoomChangesResult() interface{} {
+	return classroom.NewClassroomServiceGetExamRoomChangesResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -176,3 +226,23 @@ func (p *kClient) GetExamRoomInfo(ctx context.Context, req *classroom.ExamRoomIn
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) GetRoomSchedule(ctx context.Context, req *classroom.RoomScheduleRequest) (r *classroom.RoomScheduleResponse, err error) {
+	var _args classroom.ClassroomServiceGetRoomScheduleArgs
+	_args.Req = req
+	var _result classroom.ClassroomServiceGetRoomScheduleResult
+	if err = p.c.Call(ctx, "GetRoomSchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetExamRoomChanges(ctx context.Context, req *classroom.ExamRoomChangesRequest) (r *classroom.ExamRoomChangesResponse, err error) {
+	var _args classroom.ClassroomServiceGetExamRoomChangesArgs
+	_args.Req = req
+	var _result classroom.ClassroomServiceGetExamRoomChangesResult
+	if err = p.c.Call(ctx, "GetExamRoomChanges", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -31,6 +31,8 @@ import (
 type Client interface {
 	GetEmptyRoom(ctx context.Context, req *classroom.EmptyRoomRequest, callOptions ...callopt.Option) (r *classroom.EmptyRoomResponse, err error)
 	GetExamRoomInfo(ctx context.Context, req *classroom.ExamRoomInfoRequest, callOptions ...callopt.Option) (r *classroom.ExamRoomInfoResponse, err error)
+	GetRoomSchedule(ctx context.Context, req *classroom.RoomScheduleRequest, callOptions ...callopt.Option) (r *classroom.RoomScheduleResponse, err error)
+	GetExamRoomChanges(ctx context.Context, req *classroom.ExamRoomChangesRequest, callOptions ...callopt.Option) (r *classroom.ExamRoomChangesResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -71,3 +73,13 @@ func (p *kClassroomServiceClient) GetExamRoomInfo(ctx context.Context, req *clas
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetExamRoomInfo(ctx, req)
 }
+
+func (p *kClassroomServiceClient) GetRoomSchedule(ctx context.Context, req *classroom.RoomScheduleRequest, callOptions ...callopt.Option) (r *classroom.RoomScheduleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetRoomSchedule(ctx, req)
+}
+
+func (p *kClassroomServiceClient) GetExamRoomChanges(ctx context.Context, req *classroom.ExamRoomChangesRequest, callOptions ...callopt.Option) (r *classroom.ExamRoomChangesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetExamRoomChanges(ctx, req)
+}
@@ -193,3 +193,155 @@ func (p *ClassroomServiceGetExamRoomInfoResult) String() string {
 func (p *ClassroomServiceGetExamRoomInfoResult) GetResult() interface{} {
 	return p.Success
 }
+
+type ClassroomServiceGetRoomScheduleArgs struct {
+	Req *RoomScheduleRequest `thrift:"req,1" frugal:"1,default,RoomScheduleRequest" json:"req"`
+}
+
+func NewClassroomServiceGetRoomScheduleArgs() *ClassroomServiceGetRoomScheduleArgs {
+	return &ClassroomServiceGetRoomScheduleArgs{}
+}
+
+func (p *ClassroomServiceGetRoomScheduleArgs) InitDefault() {
+}
+
+var ClassroomServiceGetRoomScheduleArgs_Req_DEFAULT *RoomScheduleRequest
+
+func (p *ClassroomServiceGetRoomScheduleArgs) GetReq() (v *RoomScheduleRequest) {
+	if !p.IsSetReq() {
+		return ClassroomServiceGetRoomScheduleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ClassroomServiceGetRoomScheduleArgs) SetReq(val *RoomScheduleRequest) {
+	p.Req = val
+}
+
+func (p *ClassroomServiceGetRoomScheduleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ClassroomServiceGetRoomScheduleArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClassroomServiceGetRoomScheduleArgs(%+v)", *p)
+}
+
+func (p *ClassroomServiceGetRoomScheduleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ClassroomServiceGetRoomScheduleResult struct {
+	Success *RoomScheduleResponse `thrift:"success,0,optional" frugal:"0,optional,RoomScheduleResponse" json:"success,omitempty"`
+}
+
+func NewClassroomServiceGetRoomScheduleResult() *ClassroomServiceGetRoomScheduleResult {
+	return &ClassroomServiceGetRoomScheduleResult{}
+}
+
+func (p *ClassroomServiceGetRoomScheduleResult) InitDefault() {
+}
+
+var ClassroomServiceGetRoomScheduleResult_Success_DEFAULT *RoomScheduleResponse
+
+func (p *ClassroomServiceGetRoomScheduleResult) GetSuccess() (v *RoomScheduleResponse) {
+	if !p.IsSetSuccess() {
+		return ClassroomServiceGetRoomScheduleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ClassroomServiceGetRoomScheduleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RoomScheduleResponse)
+}
+
+func (p *ClassroomServiceGetRoomScheduleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ClassroomServiceGetRoomScheduleResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClassroomServiceGetRoomScheduleResult(%+v)", *p)
+}
+
+func (p *ClassroomServiceGetRoomScheduleResult) GetResult() interface{} {
+	return p.Success
+}
+
+type ClassroomServiceGetExamRoomChangesArgs struct {
+	Req *ExamRoomChangesRequest `thrift:"req,1" frugal:"1,default,ExamRoomChangesRequest" json:"req"`
+}
+
+func NewClassroomServiceGetExamRoomChangesArgs() *ClassroomServiceGetExamRoomChangesArgs {
+	return &ClassroomServiceGetExamRoomChangesArgs{}
+}
+
+func (p *ClassroomServiceGetExamRoomChangesArgs) InitDefault() {
+}
+
+var ClassroomServiceGetExamRoomChangesArgs_Req_DEFAULT *ExamRoomChangesRequest
+
+func (p *ClassroomServiceGetExamRoomChangesArgs) GetReq() (v *ExamRoomChangesRequest) {
+	if !p.IsSetReq() {
+		return ClassroomServiceGetExamRoomChangesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ClassroomServiceGetExamRoomChangesArgs) SetReq(val *ExamRoomChangesRequest) {
+	p.Req = val
+}
+
+func (p *ClassroomServiceGetExamRoomChangesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ClassroomServiceGetExamRoomChangesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClassroomServiceGetExamRoomChangesArgs(%+v)", *p)
+}
+
+func (p *ClassroomServiceGetExamRoomChangesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ClassroomServiceGetExamRoomChangesResult struct {
+	Success *ExamRoomChangesResponse `thrift:"success,0,optional" frugal:"0,optional,ExamRoomChangesResponse" json:"success,omitempty"`
+}
+
+func NewClassroomServiceGetExamRoomChangesResult() *ClassroomServiceGetExamRoomChangesResult {
+	return &ClassroomServiceGetExamRoomChangesResult{}
+}
+
+func (p *ClassroomServiceGetExamRoomChangesResult) InitDefault() {
+}
+
+var ClassroomServiceGetExamRoomChangesResult_Success_DEFAULT *ExamRoomChangesResponse
+
+func (p *ClassroomServiceGetExamRoomChangesResult) GetSuccess() (v *ExamRoomChangesResponse) {
+	if !p.IsSetSuccess() {
+		return ClassroomServiceGetExamRoomChangesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ClassroomServiceGetExamRoomChangesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExamRoomChangesResponse)
+}
+
+func (p *ClassroomServiceGetExamRoomChangesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ClassroomServiceGetExamRoomChangesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClassroomServiceGetExamRoomChangesResult(%+v)", *p)
+}
+
+func (p *ClassroomServiceGetExamRoomChangesResult) GetResult() interface{} {
+	return p.Success
+}
@@ -296,7 +296,8 @@ func (p *TermListResponse) String() string {
 }
 
 type NoticeRequest struct {
-	PageNum int64 `thrift:"pageNum,1,required" frugal:"1,required,i64" json:"pageNum"`
+	PageNum int64   `thrift:"pageNum,1,required" frugal:"1,required,i64" json:"pageNum"`
+	Source  *string `thrift:"source,2,optional" frugal:"2,optional,string" json:"source,omitempty"`
 }
 
 func NewNoticeRequest() *NoticeRequest {
@@ -309,9 +310,25 @@ func (p *NoticeRequest) InitDefault() {
 func (p *NoticeRequest) GetPageNum() (v int64) {
 	return p.PageNum
 }
+
+var NoticeRequest_Source_DEFAULT string
+
+func (p *NoticeRequest) GetSource() (v string) {
+	if !p.IsSetSource() {
+		return NoticeRequest_Source_DEFAULT
+	}
+	return *p.Source
+}
 func (p *NoticeRequest) SetPageNum(val int64) {
 	p.PageNum = val
 }
+func (p *NoticeRequest) SetSource(val *string) {
+	p.Source = val
+}
+
+func (p *NoticeRequest) IsSetSource() bool {
+	return p.Source != nil
+}
 
 func (p *NoticeRequest) String() string {
 	if p == nil {
@@ -379,6 +396,733 @@ func (p *NoticeResponse) String() string {
 	return fmt.Sprintf("NoticeResponse(%+v)", *p)
 }
 
+type SearchNoticesRequest struct {
+	Query     string  `thrift:"query,1,required" frugal:"1,required,string" json:"query"`
+	StartDate *string `thrift:"startDate,2,optional" frugal:"2,optional,string" json:"startDate,omitempty"`
+	EndDate   *string `thrift:"endDate,3,optional" frugal:"3,optional,string" json:"endDate,omitempty"`
+	PageNum   *int64  `thrift:"pageNum,4,optional" frugal:"4,optional,i64" json:"pageNum,omitempty"`
+}
+
+func NewSearchNoticesRequest() *SearchNoticesRequest {
+	return &SearchNoticesRequest{}
+}
+
+func (p *SearchNoticesRequest) InitDefault() {
+}
+
+func (p *SearchNoticesRequest) GetQuery() (v string) {
+	return p.Query
+}
+
+var SearchNoticesRequest_StartDate_DEFAULT string
+
+func (p *SearchNoticesRequest) GetStartDate() (v string) {
+	if !p.IsSetStartDate() {
+		return SearchNoticesRequest_StartDate_DEFAULT
+	}
+	return *p.StartDate
+}
+
+var SearchNoticesRequest_EndDate_DEFAULT string
+
+func (p *SearchNoticesRequest) GetEndDate() (v string) {
+	if !p.IsSetEndDate() {
+		return SearchNoticesRequest_EndDate_DEFAULT
+	}
+	return *p.EndDate
+}
+
+var SearchNoticesRequest_PageNum_DEFAULT int64
+
+func (p *SearchNoticesRequest) GetPageNum() (v int64) {
+	if !p.IsSetPageNum() {
+		return SearchNoticesRequest_PageNum_DEFAULT
+	}
+	return *p.PageNum
+}
+func (p *SearchNoticesRequest) SetQuery(val string) {
+	p.Query = val
+}
+func (p *SearchNoticesRequest) SetStartDate(val *string) {
+	p.StartDate = val
+}
+func (p *SearchNoticesRequest) SetEndDate(val *string) {
+	p.EndDate = val
+}
+func (p *SearchNoticesRequest) SetPageNum(val *int64) {
+	p.PageNum = val
+}
+
+func (p *SearchNoticesRequest) IsSetStartDate() bool {
+	return p.StartDate != nil
+}
+
+func (p *SearchNoticesRequest) IsSetEndDate() bool {
+	return p.EndDate != nil
+}
+
+func (p *SearchNoticesRequest) IsSetPageNum() bool {
+	return p.PageNum != nil
+}
+
+func (p *SearchNoticesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchNoticesRequest(%+v)", *p)
+}
+
+type SearchNoticesResponse struct {
+	Base    *model.BaseResp          `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Results []*model.NoticeSearchHit `thrift:"results,2,optional" frugal:"2,optional,list<model.NoticeSearchHit>" json:"results,omitempty"`
+	Total   int64                    `thrift:"total,3,required" frugal:"3,required,i64" json:"total"`
+}
+
+func NewSearchNoticesResponse() *SearchNoticesResponse {
+	return &SearchNoticesResponse{}
+}
+
+func (p *SearchNoticesResponse) InitDefault() {
+}
+
+var SearchNoticesResponse_Base_DEFAULT *model.BaseResp
+
+func (p *SearchNoticesResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return SearchNoticesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var SearchNoticesResponse_Results_DEFAULT []*model.NoticeSearchHit
+
+func (p *SearchNoticesResponse) GetResults() (v []*model.NoticeSearchHit) {
+	if !p.IsSetResults() {
+		return SearchNoticesResponse_Results_DEFAULT
+	}
+	return p.Results
+}
+
+func (p *SearchNoticesResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *SearchNoticesResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *SearchNoticesResponse) SetResults(val []*model.NoticeSearchHit) {
+	p.Results = val
+}
+func (p *SearchNoticesResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *SearchNoticesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *SearchNoticesResponse) IsSetResults() bool {
+	return p.Results != nil
+}
+
+func (p *SearchNoticesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchNoticesResponse(%+v)", *p)
+}
+
+type ListNoticeSourcesRequest struct {
+}
+
+func NewListNoticeSourcesRequest() *ListNoticeSourcesRequest {
+	return &ListNoticeSourcesRequest{}
+}
+
+func (p *ListNoticeSourcesRequest) InitDefault() {
+}
+
+func (p *ListNoticeSourcesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNoticeSourcesRequest(%+v)", *p)
+}
+
+type ListNoticeSourcesResponse struct {
+	Base    *model.BaseResp       `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Sources []*model.NoticeSource `thrift:"sources,2,optional" frugal:"2,optional,list<model.NoticeSource>" json:"sources,omitempty"`
+}
+
+func NewListNoticeSourcesResponse() *ListNoticeSourcesResponse {
+	return &ListNoticeSourcesResponse{}
+}
+
+func (p *ListNoticeSourcesResponse) InitDefault() {
+}
+
+var ListNoticeSourcesResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ListNoticeSourcesResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListNoticeSourcesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var ListNoticeSourcesResponse_Sources_DEFAULT []*model.NoticeSource
+
+func (p *ListNoticeSourcesResponse) GetSources() (v []*model.NoticeSource) {
+	if !p.IsSetSources() {
+		return ListNoticeSourcesResponse_Sources_DEFAULT
+	}
+	return p.Sources
+}
+func (p *ListNoticeSourcesResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListNoticeSourcesResponse) SetSources(val []*model.NoticeSource) {
+	p.Sources = val
+}
+
+func (p *ListNoticeSourcesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListNoticeSourcesResponse) IsSetSources() bool {
+	return p.Sources != nil
+}
+
+func (p *ListNoticeSourcesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNoticeSourcesResponse(%+v)", *p)
+}
+
+type GetNoticeDetailRequest struct {
+	Url string `thrift:"url,1,required" frugal:"1,required,string" json:"url"`
+}
+
+func NewGetNoticeDetailRequest() *GetNoticeDetailRequest {
+	return &GetNoticeDetailRequest{}
+}
+
+func (p *GetNoticeDetailRequest) InitDefault() {
+}
+
+func (p *GetNoticeDetailRequest) GetUrl() (v string) {
+	return p.Url
+}
+func (p *GetNoticeDetailRequest) SetUrl(val string) {
+	p.Url = val
+}
+
+func (p *GetNoticeDetailRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNoticeDetailRequest(%+v)", *p)
+}
+
+type GetNoticeDetailResponse struct {
+	Base   *model.BaseResp     `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Detail *model.NoticeDetail `thrift:"detail,2,optional" frugal:"2,optional,model.NoticeDetail" json:"detail,omitempty"`
+}
+
+func NewGetNoticeDetailResponse() *GetNoticeDetailResponse {
+	return &GetNoticeDetailResponse{}
+}
+
+func (p *GetNoticeDetailResponse) InitDefault() {
+}
+
+var GetNoticeDetailResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetNoticeDetailResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetNoticeDetailResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetNoticeDetailResponse_Detail_DEFAULT *model.NoticeDetail
+
+func (p *GetNoticeDetailResponse) GetDetail() (v *model.NoticeDetail) {
+	if !p.IsSetDetail() {
+		return GetNoticeDetailResponse_Detail_DEFAULT
+	}
+	return p.Detail
+}
+func (p *GetNoticeDetailResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetNoticeDetailResponse) SetDetail(val *model.NoticeDetail) {
+	p.Detail = val
+}
+
+func (p *GetNoticeDetailResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetNoticeDetailResponse) IsSetDetail() bool {
+	return p.Detail != nil
+}
+
+func (p *GetNoticeDetailResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNoticeDetailResponse(%+v)", *p)
+}
+
+type ListNoticeSubscriptionsRequest struct {
+}
+
+func NewListNoticeSubscriptionsRequest() *ListNoticeSubscriptionsRequest {
+	return &ListNoticeSubscriptionsRequest{}
+}
+
+func (p *ListNoticeSubscriptionsRequest) InitDefault() {
+}
+
+func (p *ListNoticeSubscriptionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNoticeSubscriptionsRequest(%+v)", *p)
+}
+
+type ListNoticeSubscriptionsResponse struct {
+	Base          *model.BaseResp             `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Subscriptions []*model.NoticeSubscription `thrift:"subscriptions,2,optional" frugal:"2,optional,list<model.NoticeSubscription>" json:"subscriptions,omitempty"`
+}
+
+func NewListNoticeSubscriptionsResponse() *ListNoticeSubscriptionsResponse {
+	return &ListNoticeSubscriptionsResponse{}
+}
+
+func (p *ListNoticeSubscriptionsResponse) InitDefault() {
+}
+
+var ListNoticeSubscriptionsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ListNoticeSubscriptionsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListNoticeSubscriptionsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var ListNoticeSubscriptionsResponse_Subscriptions_DEFAULT []*model.NoticeSubscription
+
+func (p *ListNoticeSubscriptionsResponse) GetSubscriptions() (v []*model.NoticeSubscription) {
+	if !p.IsSetSubscriptions() {
+		return ListNoticeSubscriptionsResponse_Subscriptions_DEFAULT
+	}
+	return p.Subscriptions
+}
+func (p *ListNoticeSubscriptionsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListNoticeSubscriptionsResponse) SetSubscriptions(val []*model.NoticeSubscription) {
+	p.Subscriptions = val
+}
+
+func (p *ListNoticeSubscriptionsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListNoticeSubscriptionsResponse) IsSetSubscriptions() bool {
+	return p.Subscriptions != nil
+}
+
+func (p *ListNoticeSubscriptionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNoticeSubscriptionsResponse(%+v)", *p)
+}
+
+type SubscribeNoticeRequest struct {
+	Keyword string `thrift:"keyword,1,required" frugal:"1,required,string" json:"keyword"`
+}
+
+func NewSubscribeNoticeRequest() *SubscribeNoticeRequest {
+	return &SubscribeNoticeRequest{}
+}
+
+func (p *SubscribeNoticeRequest) InitDefault() {
+}
+
+func (p *SubscribeNoticeRequest) GetKeyword() (v string) {
+	return p.Keyword
+}
+func (p *SubscribeNoticeRequest) SetKeyword(val string) {
+	p.Keyword = val
+}
+
+func (p *SubscribeNoticeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubscribeNoticeRequest(%+v)", *p)
+}
+
+type SubscribeNoticeResponse struct {
+	Base         *model.BaseResp           `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Subscription *model.NoticeSubscription `thrift:"subscription,2,optional" frugal:"2,optional,model.NoticeSubscription" json:"subscription,omitempty"`
+}
+
+func NewSubscribeNoticeResponse() *SubscribeNoticeResponse {
+	return &SubscribeNoticeResponse{}
+}
+
+func (p *SubscribeNoticeResponse) InitDefault() {
+}
+
+var SubscribeNoticeResponse_Base_DEFAULT *model.BaseResp
+
+func (p *SubscribeNoticeResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return SubscribeNoticeResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var SubscribeNoticeResponse_Subscription_DEFAULT *model.NoticeSubscription
+
+func (p *SubscribeNoticeResponse) GetSubscription() (v *model.NoticeSubscription) {
+	if !p.IsSetSubscription() {
+		return SubscribeNoticeResponse_Subscription_DEFAULT
+	}
+	return p.Subscription
+}
+func (p *SubscribeNoticeResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *SubscribeNoticeResponse) SetSubscription(val *model.NoticeSubscription) {
+	p.Subscription = val
+}
+
+func (p *SubscribeNoticeResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *SubscribeNoticeResponse) IsSetSubscription() bool {
+	return p.Subscription != nil
+}
+
+func (p *SubscribeNoticeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubscribeNoticeResponse(%+v)", *p)
+}
+
+type UnsubscribeNoticeRequest struct {
+	Keyword string `thrift:"keyword,1,required" frugal:"1,required,string" json:"keyword"`
+}
+
+func NewUnsubscribeNoticeRequest() *UnsubscribeNoticeRequest {
+	return &UnsubscribeNoticeRequest{}
+}
+
+func (p *UnsubscribeNoticeRequest) InitDefault() {
+}
+
+func (p *UnsubscribeNoticeRequest) GetKeyword() (v string) {
+	return p.Keyword
+}
+func (p *UnsubscribeNoticeRequest) SetKeyword(val string) {
+	p.Keyword = val
+}
+
+func (p *UnsubscribeNoticeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnsubscribeNoticeRequest(%+v)", *p)
+}
+
+type UnsubscribeNoticeResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewUnsubscribeNoticeResponse() *UnsubscribeNoticeResponse {
+	return &UnsubscribeNoticeResponse{}
+}
+
+func (p *UnsubscribeNoticeResponse) InitDefault() {
+}
+
+var UnsubscribeNoticeResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UnsubscribeNoticeResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UnsubscribeNoticeResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UnsubscribeNoticeResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UnsubscribeNoticeResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UnsubscribeNoticeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnsubscribeNoticeResponse(%+v)", *p)
+}
+
+type ListNotificationsRequest struct {
+	PageNum    *int64 `thrift:"pageNum,1,optional" frugal:"1,optional,i64" json:"pageNum,omitempty"`
+	UnreadOnly *bool  `thrift:"unreadOnly,2,optional" frugal:"2,optional,bool" json:"unreadOnly,omitempty"`
+}
+
+func NewListNotificationsRequest() *ListNotificationsRequest {
+	return &ListNotificationsRequest{}
+}
+
+func (p *ListNotificationsRequest) InitDefault() {
+}
+
+var ListNotificationsRequest_PageNum_DEFAULT int64
+
+func (p *ListNotificationsRequest) GetPageNum() (v int64) {
+	if !p.IsSetPageNum() {
+		return ListNotificationsRequest_PageNum_DEFAULT
+	}
+	return *p.PageNum
+}
+
+var ListNotificationsRequest_UnreadOnly_DEFAULT bool
+
+func (p *ListNotificationsRequest) GetUnreadOnly() (v bool) {
+	if !p.IsSetUnreadOnly() {
+		return ListNotificationsRequest_UnreadOnly_DEFAULT
+	}
+	return *p.UnreadOnly
+}
+func (p *ListNotificationsRequest) SetPageNum(val *int64) {
+	p.PageNum = val
+}
+func (p *ListNotificationsRequest) SetUnreadOnly(val *bool) {
+	p.UnreadOnly = val
+}
+
+func (p *ListNotificationsRequest) IsSetPageNum() bool {
+	return p.PageNum != nil
+}
+
+func (p *ListNotificationsRequest) IsSetUnreadOnly() bool {
+	return p.UnreadOnly != nil
+}
+
+func (p *ListNotificationsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNotificationsRequest(%+v)", *p)
+}
+
+type ListNotificationsResponse struct {
+	Base          *model.BaseResp       `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Notifications []*model.Notification `thrift:"notifications,2,optional" frugal:"2,optional,list<model.Notification>" json:"notifications,omitempty"`
+	Total         int64                 `thrift:"total,3,required" frugal:"3,required,i64" json:"total"`
+}
+
+func NewListNotificationsResponse() *ListNotificationsResponse {
+	return &ListNotificationsResponse{}
+}
+
+func (p *ListNotificationsResponse) InitDefault() {
+}
+
+var ListNotificationsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ListNotificationsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListNotificationsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var ListNotificationsResponse_Notifications_DEFAULT []*model.Notification
+
+func (p *ListNotificationsResponse) GetNotifications() (v []*model.Notification) {
+	if !p.IsSetNotifications() {
+		return ListNotificationsResponse_Notifications_DEFAULT
+	}
+	return p.Notifications
+}
+
+func (p *ListNotificationsResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *ListNotificationsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListNotificationsResponse) SetNotifications(val []*model.Notification) {
+	p.Notifications = val
+}
+func (p *ListNotificationsResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *ListNotificationsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListNotificationsResponse) IsSetNotifications() bool {
+	return p.Notifications != nil
+}
+
+func (p *ListNotificationsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNotificationsResponse(%+v)", *p)
+}
+
+type MarkNotificationsReadRequest struct {
+	Ids []int64 `thrift:"ids,1,optional" frugal:"1,optional,list<i64>" json:"ids,omitempty"`
+}
+
+func NewMarkNotificationsReadRequest() *MarkNotificationsReadRequest {
+	return &MarkNotificationsReadRequest{}
+}
+
+func (p *MarkNotificationsReadRequest) InitDefault() {
+}
+
+var MarkNotificationsReadRequest_Ids_DEFAULT []int64
+
+func (p *MarkNotificationsReadRequest) GetIds() (v []int64) {
+	if !p.IsSetIds() {
+		return MarkNotificationsReadRequest_Ids_DEFAULT
+	}
+	return p.Ids
+}
+func (p *MarkNotificationsReadRequest) SetIds(val []int64) {
+	p.Ids = val
+}
+
+func (p *MarkNotificationsReadRequest) IsSetIds() bool {
+	return p.Ids != nil
+}
+
+func (p *MarkNotificationsReadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkNotificationsReadRequest(%+v)", *p)
+}
+
+type MarkNotificationsReadResponse struct {
+	Base   *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Marked int64           `thrift:"marked,2,required" frugal:"2,required,i64" json:"marked"`
+}
+
+func NewMarkNotificationsReadResponse() *MarkNotificationsReadResponse {
+	return &MarkNotificationsReadResponse{}
+}
+
+func (p *MarkNotificationsReadResponse) InitDefault() {
+}
+
+var MarkNotificationsReadResponse_Base_DEFAULT *model.BaseResp
+
+func (p *MarkNotificationsReadResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return MarkNotificationsReadResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *MarkNotificationsReadResponse) GetMarked() (v int64) {
+	return p.Marked
+}
+func (p *MarkNotificationsReadResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *MarkNotificationsReadResponse) SetMarked(val int64) {
+	p.Marked = val
+}
+
+func (p *MarkNotificationsReadResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *MarkNotificationsReadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkNotificationsReadResponse(%+v)", *p)
+}
+
+type GetUnreadNotificationCountRequest struct {
+}
+
+func NewGetUnreadNotificationCountRequest() *GetUnreadNotificationCountRequest {
+	return &GetUnreadNotificationCountRequest{}
+}
+
+func (p *GetUnreadNotificationCountRequest) InitDefault() {
+}
+
+func (p *GetUnreadNotificationCountRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUnreadNotificationCountRequest(%+v)", *p)
+}
+
+type GetUnreadNotificationCountResponse struct {
+	Base  *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Count int64           `thrift:"count,2,required" frugal:"2,required,i64" json:"count"`
+}
+
+func NewGetUnreadNotificationCountResponse() *GetUnreadNotificationCountResponse {
+	return &GetUnreadNotificationCountResponse{}
+}
+
+func (p *GetUnreadNotificationCountResponse) InitDefault() {
+}
+
+var GetUnreadNotificationCountResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetUnreadNotificationCountResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetUnreadNotificationCountResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetUnreadNotificationCountResponse) GetCount() (v int64) {
+	return p.Count
+}
+func (p *GetUnreadNotificationCountResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetUnreadNotificationCountResponse) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *GetUnreadNotificationCountResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetUnreadNotificationCountResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUnreadNotificationCountResponse(%+v)", *p)
+}
+
 type GetContributorInfoRequest struct {
 }
 
@@ -1511,6 +2255,24 @@ type CommonService interface {
 
 	GetNotices(ctx context.Context, req *NoticeRequest) (r *NoticeResponse, err error)
 
+	ListNoticeSources(ctx context.Context, req *ListNoticeSourcesRequest) (r *ListNoticeSourcesResponse, err error)
+
+	SearchNotices(ctx context.Context, req *SearchNoticesRequest) (r *SearchNoticesResponse, err error)
+
+	GetNoticeDetail(ctx context.Context, req *GetNoticeDetailRequest) (r *GetNoticeDetailResponse, err error)
+
+	ListNoticeSubscriptions(ctx context.Context, req *ListNoticeSubscriptionsRequest) (r *ListNoticeSubscriptionsResponse, err error)
+
+	SubscribeNotice(ctx context.Context, req *SubscribeNoticeRequest) (r *SubscribeNoticeResponse, err error)
+
+	UnsubscribeNotice(ctx context.Context, req *UnsubscribeNoticeRequest) (r *UnsubscribeNoticeResponse, err error)
+
+	ListNotifications(ctx context.Context, req *ListNotificationsRequest) (r *ListNotificationsResponse, err error)
+
+	MarkNotificationsRead(ctx context.Context, req *MarkNotificationsReadRequest) (r *MarkNotificationsReadResponse, err error)
+
+	GetUnreadNotificationCount(ctx context.Context, req *GetUnreadNotificationCountRequest) (r *GetUnreadNotificationCountResponse, err error)
+
 	GetContributorInfo(ctx context.Context, req *GetContributorInfoRequest) (r *GetContributorInfoResponse, err error)
 
 	GetToolboxConfig(ctx context.Context, req *GetToolboxConfigRequest) (r *GetToolboxConfigResponse, err error)
@@ -35,6 +35,15 @@ type Client interface {
 	GetTermsList(ctx context.Context, req *common.TermListRequest, callOptions ...callopt.Option) (r *common.TermListResponse, err error)
 	GetTerm(ctx context.Context, req *common.TermRequest, callOptions ...callopt.Option) (r *common.TermResponse, err error)
 	GetNotices(ctx context.Context, req *common.NoticeRequest, callOptions ...callopt.Option) (r *common.NoticeResponse, err error)
+	ListNoticeSources(ctx context.Context, req *common.ListNoticeSourcesRequest, callOptions ...callopt.Option) (r *common.ListNoticeSourcesResponse, err error)
+	SearchNotices(ctx context.Context, req *common.SearchNoticesRequest, callOptions ...callopt.Option) (r *common.SearchNoticesResponse, err error)
+	GetNoticeDetail(ctx context.Context, req *common.GetNoticeDetailRequest, callOptions ...callopt.Option) (r *common.GetNoticeDetailResponse, err error)
+	ListNoticeSubscriptions(ctx context.Context, req *common.ListNoticeSubscriptionsRequest, callOptions ...callopt.Option) (r *common.ListNoticeSubscriptionsResponse, err error)
+	SubscribeNotice(ctx context.Context, req *common.SubscribeNoticeRequest, callOptions ...callopt.Option) (r *common.SubscribeNoticeResponse, err error)
+	UnsubscribeNotice(ctx context.Context, req *common.UnsubscribeNoticeRequest, callOptions ...callopt.Option) (r *common.UnsubscribeNoticeResponse, err error)
+	ListNotifications(ctx context.Context, req *common.ListNotificationsRequest, callOptions ...callopt.Option) (r *common.ListNotificationsResponse, err error)
+	MarkNotificationsRead(ctx context.Context, req *common.MarkNotificationsReadRequest, callOptions ...callopt.Option) (r *common.MarkNotificationsReadResponse, err error)
+	GetUnreadNotificationCount(ctx context.Context, req *common.GetUnreadNotificationCountRequest, callOptions ...callopt.Option) (r *common.GetUnreadNotificationCountResponse, err error)
 	GetContributorInfo(ctx context.Context, req *common.GetContributorInfoRequest, callOptions ...callopt.Option) (r *common.GetContributorInfoResponse, err error)
 	GetToolboxConfig(ctx context.Context, req *common.GetToolboxConfigRequest, callOptions ...callopt.Option) (r *common.GetToolboxConfigResponse, err error)
 	CreateToolboxConfig(ctx context.Context, req *common.CreateToolboxConfigRequest, callOptions ...callopt.Option) (r *common.CreateToolboxConfigResponse, err error)
@@ -105,6 +114,51 @@ func (p *kCommonServiceClient) GetNotices(ctx context.Context, req *common.Notic
 	return p.kClient.GetNotices(ctx, req)
 }
 
+func (p *kCommonServiceClient) ListNoticeSources(ctx context.Context, req *common.ListNoticeSourcesRequest, callOptions ...callopt.Option) (r *common.ListNoticeSourcesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListNoticeSources(ctx, req)
+}
+
+func (p *kCommonServiceClient) SearchNotices(ctx context.Context, req *common.SearchNoticesRequest, callOptions ...callopt.Option) (r *common.SearchNoticesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchNotices(ctx, req)
+}
+
+func (p *kCommonServiceClient) GetNoticeDetail(ctx context.Context, req *common.GetNoticeDetailRequest, callOptions ...callopt.Option) (r *common.GetNoticeDetailResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNoticeDetail(ctx, req)
+}
+
+func (p *kCommonServiceClient) ListNoticeSubscriptions(ctx context.Context, req *common.ListNoticeSubscriptionsRequest, callOptions ...callopt.Option) (r *common.ListNoticeSubscriptionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListNoticeSubscriptions(ctx, req)
+}
+
+func (p *kCommonServiceClient) SubscribeNotice(ctx context.Context, req *common.SubscribeNoticeRequest, callOptions ...callopt.Option) (r *common.SubscribeNoticeResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubscribeNotice(ctx, req)
+}
+
+func (p *kCommonServiceClient) UnsubscribeNotice(ctx context.Context, req *common.UnsubscribeNoticeRequest, callOptions ...callopt.Option) (r *common.UnsubscribeNoticeResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnsubscribeNotice(ctx, req)
+}
+
+func (p *kCommonServiceClient) ListNotifications(ctx context.Context, req *common.ListNotificationsRequest, callOptions ...callopt.Option) (r *common.ListNotificationsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListNotifications(ctx, req)
+}
+
+func (p *kCommonServiceClient) MarkNotificationsRead(ctx context.Context, req *common.MarkNotificationsReadRequest, callOptions ...callopt.Option) (r *common.MarkNotificationsReadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MarkNotificationsRead(ctx, req)
+}
+
+func (p *kCommonServiceClient) GetUnreadNotificationCount(ctx context.Context, req *common.GetUnreadNotificationCountRequest, callOptions ...callopt.Option) (r *common.GetUnreadNotificationCountResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUnreadNotificationCount(ctx, req)
+}
+
 func (p *kCommonServiceClient) GetContributorInfo(ctx context.Context, req *common.GetContributorInfoRequest, callOptions ...callopt.Option) (r *common.GetContributorInfoResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetContributorInfo(ctx, req)
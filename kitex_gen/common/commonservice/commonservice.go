@@ -73,6 +73,69 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListNoticeSources": kitex.NewMethodInfo(
+		listNoticeSourcesHandler,
+		newCommonServiceListNoticeSourcesArgs,
+		newCommonServiceListNoticeSourcesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SearchNotices": kitex.NewMethodInfo(
+		searchNoticesHandler,
+		newCommonServiceSearchNoticesArgs,
+		newCommonServiceSearchNoticesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetNoticeDetail": kitex.NewMethodInfo(
+		getNoticeDetailHandler,
+		newCommonServiceGetNoticeDetailArgs,
+		newCommonServiceGetNoticeDetailResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListNoticeSubscriptions": kitex.NewMethodInfo(
+		listNoticeSubscriptionsHandler,
+		newCommonServiceListNoticeSubscriptionsArgs,
+		newCommonServiceListNoticeSubscriptionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SubscribeNotice": kitex.NewMethodInfo(
+		subscribeNoticeHandler,
+		newCommonServiceSubscribeNoticeArgs,
+		newCommonServiceSubscribeNoticeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UnsubscribeNotice": kitex.NewMethodInfo(
+		unsubscribeNoticeHandler,
+		newCommonServiceUnsubscribeNoticeArgs,
+		newCommonServiceUnsubscribeNoticeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListNotifications": kitex.NewMethodInfo(
+		listNotificationsHandler,
+		newCommonServiceListNotificationsArgs,
+		newCommonServiceListNotificationsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"MarkNotificationsRead": kitex.NewMethodInfo(
+		markNotificationsReadHandler,
+		newCommonServiceMarkNotificationsReadArgs,
+		newCommonServiceMarkNotificationsReadResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetUnreadNotificationCount": kitex.NewMethodInfo(
+		getUnreadNotificationCountHandler,
+		newCommonServiceGetUnreadNotificationCountArgs,
+		newCommonServiceGetUnreadNotificationCountResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"GetContributorInfo": kitex.NewMethodInfo(
 		getContributorInfoHandler,
 		newCommonServiceGetContributorInfoArgs,
@@ -196,7 +259,7 @@ func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreami
 		HandlerType:     handlerType,
 		Methods:         methods,
 		PayloadCodec:    kitex.Thrift,
-		KiteXGenVersion: "v0.16.1",
+		KiteXGenVersion: "v0.15.4",
 		Extra:           extra,
 	}
 	return svcInfo
@@ -310,6 +373,168 @@ func newCommonServiceGetNoticesResult() interface{} {
 	return common.NewCommonServiceGetNoticesResult()
 }
 
+func listNoticeSourcesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*common.CommonServiceListNoticeSourcesArgs)
+	realResult := result.(*common.CommonServiceListNoticeSourcesResult)
+	success, err := handler.(common.CommonService).ListNoticeSources(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommonServiceListNoticeSourcesArgs() interface{} {
+	return common.NewCommonServiceListNoticeSourcesArgs()
+}
+
+func newCommonServiceListNoticeSourcesResult() interface{} {
+	return common.NewCommonServiceListNoticeSourcesResult()
+}
+
+func searchNoticesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*common.CommonServiceSearchNoticesArgs)
+	realResult := result.(*common.CommonServiceSearchNoticesResult)
+	success, err := handler.(common.CommonService).SearchNotices(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommonServiceSearchNoticesArgs() interface{} {
+	return common.NewCommonServiceSearchNoticesArgs()
+}
+
+func newCommonServiceSearchNoticesResult() interface{} {
+	return common.NewCommonServiceSearchNoticesResult()
+}
+
+func getNoticeDetailHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*common.CommonServiceGetNoticeDetailArgs)
+	realResult := result.(*common.CommonServiceGetNoticeDetailResult)
+	success, err := handler.(common.CommonService).GetNoticeDetail(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommonServiceGetNoticeDetailArgs() interface{} {
+	return common.NewCommonServiceGetNoticeDetailArgs()
+}
+
+func newCommonServiceGetNoticeDetailResult() interface{} {
+	return common.NewCommonServiceGetNoticeDetailResult()
+}
+
+func listNoticeSubscriptionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*common.CommonServiceListNoticeSubscriptionsArgs)
+	realResult := result.(*common.CommonServiceListNoticeSubscriptionsResult)
+	success, err := handler.(common.CommonService).ListNoticeSubscriptions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommonServiceListNoticeSubscriptionsArgs() interface{} {
+	return common.NewCommonServiceListNoticeSubscriptionsArgs()
+}
+
+func newCommonServiceListNoticeSubscriptionsResult() interface{} {
+	return common.NewCommonServiceListNoticeSubscriptionsResult()
+}
+
+func subscribeNoticeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*common.CommonServiceSubscribeNoticeArgs)
+	realResult := result.(*common.CommonServiceSubscribeNoticeResult)
+	success, err := handler.(common.CommonService).SubscribeNotice(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommonServiceSubscribeNoticeArgs() interface{} {
+	return common.NewCommonServiceSubscribeNoticeArgs()
+}
+
+func newCommonServiceSubscribeNoticeResult() interface{} {
+	return common.NewCommonServiceSubscribeNoticeResult()
+}
+
+func unsubscribeNoticeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*common.CommonServiceUnsubscribeNoticeArgs)
+	realResult := result.(*common.CommonServiceUnsubscribeNoticeResult)
+	success, err := handler.(common.CommonService).UnsubscribeNotice(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommonServiceUnsubscribeNoticeArgs() interface{} {
+	return common.NewCommonServiceUnsubscribeNoticeArgs()
+}
+
+func newCommonServiceUnsubscribeNoticeResult() interface{} {
+	return common.NewCommonServiceUnsubscribeNoticeResult()
+}
+
+func listNotificationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*common.CommonServiceListNotificationsArgs)
+	realResult := result.(*common.CommonServiceListNotificationsResult)
+	success, err := handler.(common.CommonService).ListNotifications(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommonServiceListNotificationsArgs() interface{} {
+	return common.NewCommonServiceListNotificationsArgs()
+}
+
+func newCommonServiceListNotificationsResult() interface{} {
+	return common.NewCommonServiceListNotificationsResult()
+}
+
+func markNotificationsReadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*common.CommonServiceMarkNotificationsReadArgs)
+	realResult := result.(*common.CommonServiceMarkNotificationsReadResult)
+	success, err := handler.(common.CommonService).MarkNotificationsRead(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommonServiceMarkNotificationsReadArgs() interface{} {
+	return common.NewCommonServiceMarkNotificationsReadArgs()
+}
+
+func newCommonServiceMarkNotificationsReadResult() interface{} {
+	return common.NewCommonServiceMarkNotificationsReadResult()
+}
+
+func getUnreadNotificationCountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*common.CommonServiceGetUnreadNotificationCountArgs)
+	realResult := result.(*common.CommonServiceGetUnreadNotificationCountResult)
+	success, err := handler.(common.CommonService).GetUnreadNotificationCount(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCommonServiceGetUnreadNotificationCountArgs() interface{} {
+	return common.NewCommonServiceGetUnreadNotificationCountArgs()
+}
+
+func newCommonServiceGetUnreadNotificationCountResult() interface{} {
+	return common.NewCommonServiceGetUnreadNotificationCountResult()
+}
+
 func getContributorInfoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*common.CommonServiceGetContributorInfoArgs)
 	realResult := result.(*common.CommonServiceGetContributorInfoResult)
@@ -542,6 +767,96 @@ func (p *kClient) GetNotices(ctx context.Context, req *common.NoticeRequest) (r
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ListNoticeSources(ctx context.Context, req *common.ListNoticeSourcesRequest) (r *common.ListNoticeSourcesResponse, err error) {
+	var _args common.CommonServiceListNoticeSourcesArgs
+	_args.Req = req
+	var _result common.CommonServiceListNoticeSourcesResult
+	if err = p.c.Call(ctx, "ListNoticeSources", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchNotices(ctx context.Context, req *common.SearchNoticesRequest) (r *common.SearchNoticesResponse, err error) {
+	var _args common.CommonServiceSearchNoticesArgs
+	_args.Req = req
+	var _result common.CommonServiceSearchNoticesResult
+	if err = p.c.Call(ctx, "SearchNotices", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNoticeDetail(ctx context.Context, req *common.GetNoticeDetailRequest) (r *common.GetNoticeDetailResponse, err error) {
+	var _args common.CommonServiceGetNoticeDetailArgs
+	_args.Req = req
+	var _result common.CommonServiceGetNoticeDetailResult
+	if err = p.c.Call(ctx, "GetNoticeDetail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListNoticeSubscriptions(ctx context.Context, req *common.ListNoticeSubscriptionsRequest) (r *common.ListNoticeSubscriptionsResponse, err error) {
+	var _args common.CommonServiceListNoticeSubscriptionsArgs
+	_args.Req = req
+	var _result common.CommonServiceListNoticeSubscriptionsResult
+	if err = p.c.Call(ctx, "ListNoticeSubscriptions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubscribeNotice(ctx context.Context, req *common.SubscribeNoticeRequest) (r *common.SubscribeNoticeResponse, err error) {
+	var _args common.CommonServiceSubscribeNoticeArgs
+	_args.Req = req
+	var _result common.CommonServiceSubscribeNoticeResult
+	if err = p.c.Call(ctx, "SubscribeNotice", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnsubscribeNotice(ctx context.Context, req *common.UnsubscribeNoticeRequest) (r *common.UnsubscribeNoticeResponse, err error) {
+	var _args common.CommonServiceUnsubscribeNoticeArgs
+	_args.Req = req
+	var _result common.CommonServiceUnsubscribeNoticeResult
+	if err = p.c.Call(ctx, "UnsubscribeNotice", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListNotifications(ctx context.Context, req *common.ListNotificationsRequest) (r *common.ListNotificationsResponse, err error) {
+	var _args common.CommonServiceListNotificationsArgs
+	_args.Req = req
+	var _result common.CommonServiceListNotificationsResult
+	if err = p.c.Call(ctx, "ListNotifications", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MarkNotificationsRead(ctx context.Context, req *common.MarkNotificationsReadRequest) (r *common.MarkNotificationsReadResponse, err error) {
+	var _args common.CommonServiceMarkNotificationsReadArgs
+	_args.Req = req
+	var _result common.CommonServiceMarkNotificationsReadResult
+	if err = p.c.Call(ctx, "MarkNotificationsRead", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUnreadNotificationCount(ctx context.Context, req *common.GetUnreadNotificationCountRequest) (r *common.GetUnreadNotificationCountResponse, err error) {
+	var _args common.CommonServiceGetUnreadNotificationCountArgs
+	_args.Req = req
+	var _result common.CommonServiceGetUnreadNotificationCountResult
+	if err = p.c.Call(ctx, "GetUnreadNotificationCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetContributorInfo(ctx context.Context, req *common.GetContributorInfoRequest) (r *common.GetContributorInfoResponse, err error) {
 	var _args common.CommonServiceGetContributorInfoArgs
 	_args.Req = req
@@ -498,6 +498,690 @@ func (p *CommonServiceGetNoticesResult) GetResult() interface{} {
 	return p.Success
 }
 
+type CommonServiceListNoticeSourcesArgs struct {
+	Req *ListNoticeSourcesRequest `thrift:"req,1" frugal:"1,default,ListNoticeSourcesRequest" json:"req"`
+}
+
+func NewCommonServiceListNoticeSourcesArgs() *CommonServiceListNoticeSourcesArgs {
+	return &CommonServiceListNoticeSourcesArgs{}
+}
+
+func (p *CommonServiceListNoticeSourcesArgs) InitDefault() {
+}
+
+var CommonServiceListNoticeSourcesArgs_Req_DEFAULT *ListNoticeSourcesRequest
+
+func (p *CommonServiceListNoticeSourcesArgs) GetReq() (v *ListNoticeSourcesRequest) {
+	if !p.IsSetReq() {
+		return CommonServiceListNoticeSourcesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommonServiceListNoticeSourcesArgs) SetReq(val *ListNoticeSourcesRequest) {
+	p.Req = val
+}
+
+func (p *CommonServiceListNoticeSourcesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommonServiceListNoticeSourcesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceListNoticeSourcesArgs(%+v)", *p)
+}
+
+func (p *CommonServiceListNoticeSourcesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CommonServiceListNoticeSourcesResult struct {
+	Success *ListNoticeSourcesResponse `thrift:"success,0,optional" frugal:"0,optional,ListNoticeSourcesResponse" json:"success,omitempty"`
+}
+
+func NewCommonServiceListNoticeSourcesResult() *CommonServiceListNoticeSourcesResult {
+	return &CommonServiceListNoticeSourcesResult{}
+}
+
+func (p *CommonServiceListNoticeSourcesResult) InitDefault() {
+}
+
+var CommonServiceListNoticeSourcesResult_Success_DEFAULT *ListNoticeSourcesResponse
+
+func (p *CommonServiceListNoticeSourcesResult) GetSuccess() (v *ListNoticeSourcesResponse) {
+	if !p.IsSetSuccess() {
+		return CommonServiceListNoticeSourcesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommonServiceListNoticeSourcesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListNoticeSourcesResponse)
+}
+
+func (p *CommonServiceListNoticeSourcesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommonServiceListNoticeSourcesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceListNoticeSourcesResult(%+v)", *p)
+}
+
+func (p *CommonServiceListNoticeSourcesResult) GetResult() interface{} {
+	return p.Success
+}
+
+type CommonServiceSearchNoticesArgs struct {
+	Req *SearchNoticesRequest `thrift:"req,1" frugal:"1,default,SearchNoticesRequest" json:"req"`
+}
+
+func NewCommonServiceSearchNoticesArgs() *CommonServiceSearchNoticesArgs {
+	return &CommonServiceSearchNoticesArgs{}
+}
+
+func (p *CommonServiceSearchNoticesArgs) InitDefault() {
+}
+
+var CommonServiceSearchNoticesArgs_Req_DEFAULT *SearchNoticesRequest
+
+func (p *CommonServiceSearchNoticesArgs) GetReq() (v *SearchNoticesRequest) {
+	if !p.IsSetReq() {
+		return CommonServiceSearchNoticesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommonServiceSearchNoticesArgs) SetReq(val *SearchNoticesRequest) {
+	p.Req = val
+}
+
+func (p *CommonServiceSearchNoticesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommonServiceSearchNoticesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceSearchNoticesArgs(%+v)", *p)
+}
+
+func (p *CommonServiceSearchNoticesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CommonServiceSearchNoticesResult struct {
+	Success *SearchNoticesResponse `thrift:"success,0,optional" frugal:"0,optional,SearchNoticesResponse" json:"success,omitempty"`
+}
+
+func NewCommonServiceSearchNoticesResult() *CommonServiceSearchNoticesResult {
+	return &CommonServiceSearchNoticesResult{}
+}
+
+func (p *CommonServiceSearchNoticesResult) InitDefault() {
+}
+
+var CommonServiceSearchNoticesResult_Success_DEFAULT *SearchNoticesResponse
+
+func (p *CommonServiceSearchNoticesResult) GetSuccess() (v *SearchNoticesResponse) {
+	if !p.IsSetSuccess() {
+		return CommonServiceSearchNoticesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommonServiceSearchNoticesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchNoticesResponse)
+}
+
+func (p *CommonServiceSearchNoticesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommonServiceSearchNoticesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceSearchNoticesResult(%+v)", *p)
+}
+
+func (p *CommonServiceSearchNoticesResult) GetResult() interface{} {
+	return p.Success
+}
+
+type CommonServiceGetNoticeDetailArgs struct {
+	Req *GetNoticeDetailRequest `thrift:"req,1" frugal:"1,default,GetNoticeDetailRequest" json:"req"`
+}
+
+func NewCommonServiceGetNoticeDetailArgs() *CommonServiceGetNoticeDetailArgs {
+	return &CommonServiceGetNoticeDetailArgs{}
+}
+
+func (p *CommonServiceGetNoticeDetailArgs) InitDefault() {
+}
+
+var CommonServiceGetNoticeDetailArgs_Req_DEFAULT *GetNoticeDetailRequest
+
+func (p *CommonServiceGetNoticeDetailArgs) GetReq() (v *GetNoticeDetailRequest) {
+	if !p.IsSetReq() {
+		return CommonServiceGetNoticeDetailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommonServiceGetNoticeDetailArgs) SetReq(val *GetNoticeDetailRequest) {
+	p.Req = val
+}
+
+func (p *CommonServiceGetNoticeDetailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommonServiceGetNoticeDetailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceGetNoticeDetailArgs(%+v)", *p)
+}
+
+func (p *CommonServiceGetNoticeDetailArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CommonServiceGetNoticeDetailResult struct {
+	Success *GetNoticeDetailResponse `thrift:"success,0,optional" frugal:"0,optional,GetNoticeDetailResponse" json:"success,omitempty"`
+}
+
+func NewCommonServiceGetNoticeDetailResult() *CommonServiceGetNoticeDetailResult {
+	return &CommonServiceGetNoticeDetailResult{}
+}
+
+func (p *CommonServiceGetNoticeDetailResult) InitDefault() {
+}
+
+var CommonServiceGetNoticeDetailResult_Success_DEFAULT *GetNoticeDetailResponse
+
+func (p *CommonServiceGetNoticeDetailResult) GetSuccess() (v *GetNoticeDetailResponse) {
+	if !p.IsSetSuccess() {
+		return CommonServiceGetNoticeDetailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommonServiceGetNoticeDetailResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetNoticeDetailResponse)
+}
+
+func (p *CommonServiceGetNoticeDetailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommonServiceGetNoticeDetailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceGetNoticeDetailResult(%+v)", *p)
+}
+
+func (p *CommonServiceGetNoticeDetailResult) GetResult() interface{} {
+	return p.Success
+}
+
+type CommonServiceListNoticeSubscriptionsArgs struct {
+	Req *ListNoticeSubscriptionsRequest `thrift:"req,1" frugal:"1,default,ListNoticeSubscriptionsRequest" json:"req"`
+}
+
+func NewCommonServiceListNoticeSubscriptionsArgs() *CommonServiceListNoticeSubscriptionsArgs {
+	return &CommonServiceListNoticeSubscriptionsArgs{}
+}
+
+func (p *CommonServiceListNoticeSubscriptionsArgs) InitDefault() {
+}
+
+var CommonServiceListNoticeSubscriptionsArgs_Req_DEFAULT *ListNoticeSubscriptionsRequest
+
+func (p *CommonServiceListNoticeSubscriptionsArgs) GetReq() (v *ListNoticeSubscriptionsRequest) {
+	if !p.IsSetReq() {
+		return CommonServiceListNoticeSubscriptionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommonServiceListNoticeSubscriptionsArgs) SetReq(val *ListNoticeSubscriptionsRequest) {
+	p.Req = val
+}
+
+func (p *CommonServiceListNoticeSubscriptionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommonServiceListNoticeSubscriptionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceListNoticeSubscriptionsArgs(%+v)", *p)
+}
+
+func (p *CommonServiceListNoticeSubscriptionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CommonServiceListNoticeSubscriptionsResult struct {
+	Success *ListNoticeSubscriptionsResponse `thrift:"success,0,optional" frugal:"0,optional,ListNoticeSubscriptionsResponse" json:"success,omitempty"`
+}
+
+func NewCommonServiceListNoticeSubscriptionsResult() *CommonServiceListNoticeSubscriptionsResult {
+	return &CommonServiceListNoticeSubscriptionsResult{}
+}
+
+func (p *CommonServiceListNoticeSubscriptionsResult) InitDefault() {
+}
+
+var CommonServiceListNoticeSubscriptionsResult_Success_DEFAULT *ListNoticeSubscriptionsResponse
+
+func (p *CommonServiceListNoticeSubscriptionsResult) GetSuccess() (v *ListNoticeSubscriptionsResponse) {
+	if !p.IsSetSuccess() {
+		return CommonServiceListNoticeSubscriptionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommonServiceListNoticeSubscriptionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListNoticeSubscriptionsResponse)
+}
+
+func (p *CommonServiceListNoticeSubscriptionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommonServiceListNoticeSubscriptionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceListNoticeSubscriptionsResult(%+v)", *p)
+}
+
+func (p *CommonServiceListNoticeSubscriptionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+type CommonServiceSubscribeNoticeArgs struct {
+	Req *SubscribeNoticeRequest `thrift:"req,1" frugal:"1,default,SubscribeNoticeRequest" json:"req"`
+}
+
+func NewCommonServiceSubscribeNoticeArgs() *CommonServiceSubscribeNoticeArgs {
+	return &CommonServiceSubscribeNoticeArgs{}
+}
+
+func (p *CommonServiceSubscribeNoticeArgs) InitDefault() {
+}
+
+var CommonServiceSubscribeNoticeArgs_Req_DEFAULT *SubscribeNoticeRequest
+
+func (p *CommonServiceSubscribeNoticeArgs) GetReq() (v *SubscribeNoticeRequest) {
+	if !p.IsSetReq() {
+		return CommonServiceSubscribeNoticeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommonServiceSubscribeNoticeArgs) SetReq(val *SubscribeNoticeRequest) {
+	p.Req = val
+}
+
+func (p *CommonServiceSubscribeNoticeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommonServiceSubscribeNoticeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceSubscribeNoticeArgs(%+v)", *p)
+}
+
+func (p *CommonServiceSubscribeNoticeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CommonServiceSubscribeNoticeResult struct {
+	Success *SubscribeNoticeResponse `thrift:"success,0,optional" frugal:"0,optional,SubscribeNoticeResponse" json:"success,omitempty"`
+}
+
+func NewCommonServiceSubscribeNoticeResult() *CommonServiceSubscribeNoticeResult {
+	return &CommonServiceSubscribeNoticeResult{}
+}
+
+func (p *CommonServiceSubscribeNoticeResult) InitDefault() {
+}
+
+var CommonServiceSubscribeNoticeResult_Success_DEFAULT *SubscribeNoticeResponse
+
+func (p *CommonServiceSubscribeNoticeResult) GetSuccess() (v *SubscribeNoticeResponse) {
+	if !p.IsSetSuccess() {
+		return CommonServiceSubscribeNoticeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommonServiceSubscribeNoticeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SubscribeNoticeResponse)
+}
+
+func (p *CommonServiceSubscribeNoticeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommonServiceSubscribeNoticeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceSubscribeNoticeResult(%+v)", *p)
+}
+
+func (p *CommonServiceSubscribeNoticeResult) GetResult() interface{} {
+	return p.Success
+}
+
+type CommonServiceUnsubscribeNoticeArgs struct {
+	Req *UnsubscribeNoticeRequest `thrift:"req,1" frugal:"1,default,UnsubscribeNoticeRequest" json:"req"`
+}
+
+func NewCommonServiceUnsubscribeNoticeArgs() *CommonServiceUnsubscribeNoticeArgs {
+	return &CommonServiceUnsubscribeNoticeArgs{}
+}
+
+func (p *CommonServiceUnsubscribeNoticeArgs) InitDefault() {
+}
+
+var CommonServiceUnsubscribeNoticeArgs_Req_DEFAULT *UnsubscribeNoticeRequest
+
+func (p *CommonServiceUnsubscribeNoticeArgs) GetReq() (v *UnsubscribeNoticeRequest) {
+	if !p.IsSetReq() {
+		return CommonServiceUnsubscribeNoticeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommonServiceUnsubscribeNoticeArgs) SetReq(val *UnsubscribeNoticeRequest) {
+	p.Req = val
+}
+
+func (p *CommonServiceUnsubscribeNoticeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommonServiceUnsubscribeNoticeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceUnsubscribeNoticeArgs(%+v)", *p)
+}
+
+func (p *CommonServiceUnsubscribeNoticeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CommonServiceUnsubscribeNoticeResult struct {
+	Success *UnsubscribeNoticeResponse `thrift:"success,0,optional" frugal:"0,optional,UnsubscribeNoticeResponse" json:"success,omitempty"`
+}
+
+func NewCommonServiceUnsubscribeNoticeResult() *CommonServiceUnsubscribeNoticeResult {
+	return &CommonServiceUnsubscribeNoticeResult{}
+}
+
+func (p *CommonServiceUnsubscribeNoticeResult) InitDefault() {
+}
+
+var CommonServiceUnsubscribeNoticeResult_Success_DEFAULT *UnsubscribeNoticeResponse
+
+func (p *CommonServiceUnsubscribeNoticeResult) GetSuccess() (v *UnsubscribeNoticeResponse) {
+	if !p.IsSetSuccess() {
+		return CommonServiceUnsubscribeNoticeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommonServiceUnsubscribeNoticeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UnsubscribeNoticeResponse)
+}
+
+func (p *CommonServiceUnsubscribeNoticeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommonServiceUnsubscribeNoticeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceUnsubscribeNoticeResult(%+v)", *p)
+}
+
+func (p *CommonServiceUnsubscribeNoticeResult) GetResult() interface{} {
+	return p.Success
+}
+
+type CommonServiceListNotificationsArgs struct {
+	Req *ListNotificationsRequest `thrift:"req,1" frugal:"1,default,ListNotificationsRequest" json:"req"`
+}
+
+func NewCommonServiceListNotificationsArgs() *CommonServiceListNotificationsArgs {
+	return &CommonServiceListNotificationsArgs{}
+}
+
+func (p *CommonServiceListNotificationsArgs) InitDefault() {
+}
+
+var CommonServiceListNotificationsArgs_Req_DEFAULT *ListNotificationsRequest
+
+func (p *CommonServiceListNotificationsArgs) GetReq() (v *ListNotificationsRequest) {
+	if !p.IsSetReq() {
+		return CommonServiceListNotificationsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommonServiceListNotificationsArgs) SetReq(val *ListNotificationsRequest) {
+	p.Req = val
+}
+
+func (p *CommonServiceListNotificationsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommonServiceListNotificationsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceListNotificationsArgs(%+v)", *p)
+}
+
+func (p *CommonServiceListNotificationsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CommonServiceListNotificationsResult struct {
+	Success *ListNotificationsResponse `thrift:"success,0,optional" frugal:"0,optional,ListNotificationsResponse" json:"success,omitempty"`
+}
+
+func NewCommonServiceListNotificationsResult() *CommonServiceListNotificationsResult {
+	return &CommonServiceListNotificationsResult{}
+}
+
+func (p *CommonServiceListNotificationsResult) InitDefault() {
+}
+
+var CommonServiceListNotificationsResult_Success_DEFAULT *ListNotificationsResponse
+
+func (p *CommonServiceListNotificationsResult) GetSuccess() (v *ListNotificationsResponse) {
+	if !p.IsSetSuccess() {
+		return CommonServiceListNotificationsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommonServiceListNotificationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListNotificationsResponse)
+}
+
+func (p *CommonServiceListNotificationsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommonServiceListNotificationsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceListNotificationsResult(%+v)", *p)
+}
+
+func (p *CommonServiceListNotificationsResult) GetResult() interface{} {
+	return p.Success
+}
+
+type CommonServiceMarkNotificationsReadArgs struct {
+	Req *MarkNotificationsReadRequest `thrift:"req,1" frugal:"1,default,MarkNotificationsReadRequest" json:"req"`
+}
+
+func NewCommonServiceMarkNotificationsReadArgs() *CommonServiceMarkNotificationsReadArgs {
+	return &CommonServiceMarkNotificationsReadArgs{}
+}
+
+func (p *CommonServiceMarkNotificationsReadArgs) InitDefault() {
+}
+
+var CommonServiceMarkNotificationsReadArgs_Req_DEFAULT *MarkNotificationsReadRequest
+
+func (p *CommonServiceMarkNotificationsReadArgs) GetReq() (v *MarkNotificationsReadRequest) {
+	if !p.IsSetReq() {
+		return CommonServiceMarkNotificationsReadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommonServiceMarkNotificationsReadArgs) SetReq(val *MarkNotificationsReadRequest) {
+	p.Req = val
+}
+
+func (p *CommonServiceMarkNotificationsReadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommonServiceMarkNotificationsReadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceMarkNotificationsReadArgs(%+v)", *p)
+}
+
+func (p *CommonServiceMarkNotificationsReadArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CommonServiceMarkNotificationsReadResult struct {
+	Success *MarkNotificationsReadResponse `thrift:"success,0,optional" frugal:"0,optional,MarkNotificationsReadResponse" json:"success,omitempty"`
+}
+
+func NewCommonServiceMarkNotificationsReadResult() *CommonServiceMarkNotificationsReadResult {
+	return &CommonServiceMarkNotificationsReadResult{}
+}
+
+func (p *CommonServiceMarkNotificationsReadResult) InitDefault() {
+}
+
+var CommonServiceMarkNotificationsReadResult_Success_DEFAULT *MarkNotificationsReadResponse
+
+func (p *CommonServiceMarkNotificationsReadResult) GetSuccess() (v *MarkNotificationsReadResponse) {
+	if !p.IsSetSuccess() {
+		return CommonServiceMarkNotificationsReadResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommonServiceMarkNotificationsReadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MarkNotificationsReadResponse)
+}
+
+func (p *CommonServiceMarkNotificationsReadResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommonServiceMarkNotificationsReadResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceMarkNotificationsReadResult(%+v)", *p)
+}
+
+func (p *CommonServiceMarkNotificationsReadResult) GetResult() interface{} {
+	return p.Success
+}
+
+type CommonServiceGetUnreadNotificationCountArgs struct {
+	Req *GetUnreadNotificationCountRequest `thrift:"req,1" frugal:"1,default,GetUnreadNotificationCountRequest" json:"req"`
+}
+
+func NewCommonServiceGetUnreadNotificationCountArgs() *CommonServiceGetUnreadNotificationCountArgs {
+	return &CommonServiceGetUnreadNotificationCountArgs{}
+}
+
+func (p *CommonServiceGetUnreadNotificationCountArgs) InitDefault() {
+}
+
+var CommonServiceGetUnreadNotificationCountArgs_Req_DEFAULT *GetUnreadNotificationCountRequest
+
+func (p *CommonServiceGetUnreadNotificationCountArgs) GetReq() (v *GetUnreadNotificationCountRequest) {
+	if !p.IsSetReq() {
+		return CommonServiceGetUnreadNotificationCountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CommonServiceGetUnreadNotificationCountArgs) SetReq(val *GetUnreadNotificationCountRequest) {
+	p.Req = val
+}
+
+func (p *CommonServiceGetUnreadNotificationCountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CommonServiceGetUnreadNotificationCountArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceGetUnreadNotificationCountArgs(%+v)", *p)
+}
+
+func (p *CommonServiceGetUnreadNotificationCountArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CommonServiceGetUnreadNotificationCountResult struct {
+	Success *GetUnreadNotificationCountResponse `thrift:"success,0,optional" frugal:"0,optional,GetUnreadNotificationCountResponse" json:"success,omitempty"`
+}
+
+func NewCommonServiceGetUnreadNotificationCountResult() *CommonServiceGetUnreadNotificationCountResult {
+	return &CommonServiceGetUnreadNotificationCountResult{}
+}
+
+func (p *CommonServiceGetUnreadNotificationCountResult) InitDefault() {
+}
+
+var CommonServiceGetUnreadNotificationCountResult_Success_DEFAULT *GetUnreadNotificationCountResponse
+
+func (p *CommonServiceGetUnreadNotificationCountResult) GetSuccess() (v *GetUnreadNotificationCountResponse) {
+	if !p.IsSetSuccess() {
+		return CommonServiceGetUnreadNotificationCountResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CommonServiceGetUnreadNotificationCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetUnreadNotificationCountResponse)
+}
+
+func (p *CommonServiceGetUnreadNotificationCountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CommonServiceGetUnreadNotificationCountResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommonServiceGetUnreadNotificationCountResult(%+v)", *p)
+}
+
+func (p *CommonServiceGetUnreadNotificationCountResult) GetResult() interface{} {
+	return p.Success
+}
+
 type CommonServiceGetContributorInfoArgs struct {
 	Req *GetContributorInfoRequest `thrift:"req,1" frugal:"1,default,GetContributorInfoRequest" json:"req"`
 }
@@ -43,8 +43,10 @@ func (p *TermListRequest) String() string {
 }
 
 type TermListResponse struct {
-	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
-	Data []string        `thrift:"data,2,required" frugal:"2,required,list<string>" json:"data"`
+	Base         *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Data         []string        `thrift:"data,2,required" frugal:"2,required,list<string>" json:"data"`
+	Stale        *bool           `thrift:"stale,3,optional" frugal:"3,optional,bool" json:"stale,omitempty"`
+	SnapshotTime *int64          `thrift:"snapshot_time,4,optional" frugal:"4,optional,i64" json:"snapshot_time,omitempty"`
 }
 
 func NewTermListResponse() *TermListResponse {
@@ -66,17 +68,49 @@ func (p *TermListResponse) GetBase() (v *model.BaseResp) {
 func (p *TermListResponse) GetData() (v []string) {
 	return p.Data
 }
+
+var TermListResponse_Stale_DEFAULT bool
+
+func (p *TermListResponse) GetStale() (v bool) {
+	if !p.IsSetStale() {
+		return TermListResponse_Stale_DEFAULT
+	}
+	return *p.Stale
+}
+
+var TermListResponse_SnapshotTime_DEFAULT int64
+
+func (p *TermListResponse) GetSnapshotTime() (v int64) {
+	if !p.IsSetSnapshotTime() {
+		return TermListResponse_SnapshotTime_DEFAULT
+	}
+	return *p.SnapshotTime
+}
 func (p *TermListResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 func (p *TermListResponse) SetData(val []string) {
 	p.Data = val
 }
+func (p *TermListResponse) SetStale(val *bool) {
+	p.Stale = val
+}
+func (p *TermListResponse) SetSnapshotTime(val *int64) {
+	p.SnapshotTime = val
+}
 
 func (p *TermListResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
+func (p *TermListResponse) IsSetStale() bool {
+	return p.Stale != nil
+}
+
+func (p *TermListResponse) IsSetSnapshotTime() bool {
+	return p.SnapshotTime != nil
+}
+
 func (p *TermListResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -127,8 +161,10 @@ func (p *CourseListRequest) String() string {
 }
 
 type CourseListResponse struct {
-	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
-	Data []*model.Course `thrift:"data,2,required" frugal:"2,required,list<model.Course>" json:"data"`
+	Base         *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Data         []*model.Course `thrift:"data,2,required" frugal:"2,required,list<model.Course>" json:"data"`
+	Stale        *bool           `thrift:"stale,3,optional" frugal:"3,optional,bool" json:"stale,omitempty"`
+	SnapshotTime *int64          `thrift:"snapshot_time,4,optional" frugal:"4,optional,i64" json:"snapshot_time,omitempty"`
 }
 
 func NewCourseListResponse() *CourseListResponse {
@@ -150,17 +186,49 @@ func (p *CourseListResponse) GetBase() (v *model.BaseResp) {
 func (p *CourseListResponse) GetData() (v []*model.Course) {
 	return p.Data
 }
+
+var CourseListResponse_Stale_DEFAULT bool
+
+func (p *CourseListResponse) GetStale() (v bool) {
+	if !p.IsSetStale() {
+		return CourseListResponse_Stale_DEFAULT
+	}
+	return *p.Stale
+}
+
+var CourseListResponse_SnapshotTime_DEFAULT int64
+
+func (p *CourseListResponse) GetSnapshotTime() (v int64) {
+	if !p.IsSetSnapshotTime() {
+		return CourseListResponse_SnapshotTime_DEFAULT
+	}
+	return *p.SnapshotTime
+}
 func (p *CourseListResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 func (p *CourseListResponse) SetData(val []*model.Course) {
 	p.Data = val
 }
+func (p *CourseListResponse) SetStale(val *bool) {
+	p.Stale = val
+}
+func (p *CourseListResponse) SetSnapshotTime(val *int64) {
+	p.SnapshotTime = val
+}
 
 func (p *CourseListResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
+func (p *CourseListResponse) IsSetStale() bool {
+	return p.Stale != nil
+}
+
+func (p *CourseListResponse) IsSetSnapshotTime() bool {
+	return p.SnapshotTime != nil
+}
+
 func (p *CourseListResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -261,11 +261,82 @@ func (p *FriendMaxNumInfo) String() string {
 	return fmt.Sprintf("FriendMaxNumInfo(%+v)", *p)
 }
 
+type NotificationPreference struct {
+	DisabledTypes []string `thrift:"disabled_types,1,required" frugal:"1,required,list<string>" json:"disabled_types"`
+	Channel       string   `thrift:"channel,2,required" frugal:"2,required,string" json:"channel"`
+	QuietStart    *string  `thrift:"quiet_start,3,optional" frugal:"3,optional,string" json:"quiet_start,omitempty"`
+	QuietEnd      *string  `thrift:"quiet_end,4,optional" frugal:"4,optional,string" json:"quiet_end,omitempty"`
+}
+
+func NewNotificationPreference() *NotificationPreference {
+	return &NotificationPreference{}
+}
+
+func (p *NotificationPreference) InitDefault() {
+}
+
+func (p *NotificationPreference) GetDisabledTypes() (v []string) {
+	return p.DisabledTypes
+}
+
+func (p *NotificationPreference) GetChannel() (v string) {
+	return p.Channel
+}
+
+var NotificationPreference_QuietStart_DEFAULT string
+
+func (p *NotificationPreference) GetQuietStart() (v string) {
+	if !p.IsSetQuietStart() {
+		return NotificationPreference_QuietStart_DEFAULT
+	}
+	return *p.QuietStart
+}
+
+var NotificationPreference_QuietEnd_DEFAULT string
+
+func (p *NotificationPreference) GetQuietEnd() (v string) {
+	if !p.IsSetQuietEnd() {
+		return NotificationPreference_QuietEnd_DEFAULT
+	}
+	return *p.QuietEnd
+}
+func (p *NotificationPreference) SetDisabledTypes(val []string) {
+	p.DisabledTypes = val
+}
+func (p *NotificationPreference) SetChannel(val string) {
+	p.Channel = val
+}
+func (p *NotificationPreference) SetQuietStart(val *string) {
+	p.QuietStart = val
+}
+func (p *NotificationPreference) SetQuietEnd(val *string) {
+	p.QuietEnd = val
+}
+
+func (p *NotificationPreference) IsSetQuietStart() bool {
+	return p.QuietStart != nil
+}
+
+func (p *NotificationPreference) IsSetQuietEnd() bool {
+	return p.QuietEnd != nil
+}
+
+func (p *NotificationPreference) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NotificationPreference(%+v)", *p)
+}
+
 type Classroom struct {
-	Build    string `thrift:"build,1,required" frugal:"1,required,string" json:"build"`
-	Location string `thrift:"location,2,required" frugal:"2,required,string" json:"location"`
-	Capacity string `thrift:"capacity,3,required" frugal:"3,required,string" json:"capacity"`
-	Type     string `thrift:"type,4,required" frugal:"4,required,string" json:"type"`
+	Build     string   `thrift:"build,1,required" frugal:"1,required,string" json:"build"`
+	Location  string   `thrift:"location,2,required" frugal:"2,required,string" json:"location"`
+	Capacity  string   `thrift:"capacity,3,required" frugal:"3,required,string" json:"capacity"`
+	Type      string   `thrift:"type,4,required" frugal:"4,required,string" json:"type"`
+	Floor     *int64   `thrift:"floor,5,optional" frugal:"5,optional,i64" json:"floor,omitempty"`
+	Latitude  *float64 `thrift:"latitude,6,optional" frugal:"6,optional,double" json:"latitude,omitempty"`
+	Longitude *float64 `thrift:"longitude,7,optional" frugal:"7,optional,double" json:"longitude,omitempty"`
+	FreeUntil *int64   `thrift:"freeUntil,8,optional" frugal:"8,optional,i64" json:"freeUntil,omitempty"`
 }
 
 func NewClassroom() *Classroom {
@@ -290,6 +361,42 @@ func (p *Classroom) GetCapacity() (v string) {
 func (p *Classroom) GetType() (v string) {
 	return p.Type
 }
+
+var Classroom_Floor_DEFAULT int64
+
+func (p *Classroom) GetFloor() (v int64) {
+	if !p.IsSetFloor() {
+		return Classroom_Floor_DEFAULT
+	}
+	return *p.Floor
+}
+
+var Classroom_Latitude_DEFAULT float64
+
+func (p *Classroom) GetLatitude() (v float64) {
+	if !p.IsSetLatitude() {
+		return Classroom_Latitude_DEFAULT
+	}
+	return *p.Latitude
+}
+
+var Classroom_Longitude_DEFAULT float64
+
+func (p *Classroom) GetLongitude() (v float64) {
+	if !p.IsSetLongitude() {
+		return Classroom_Longitude_DEFAULT
+	}
+	return *p.Longitude
+}
+
+var Classroom_FreeUntil_DEFAULT int64
+
+func (p *Classroom) GetFreeUntil() (v int64) {
+	if !p.IsSetFreeUntil() {
+		return Classroom_FreeUntil_DEFAULT
+	}
+	return *p.FreeUntil
+}
 func (p *Classroom) SetBuild(val string) {
 	p.Build = val
 }
@@ -302,6 +409,34 @@ func (p *Classroom) SetCapacity(val string) {
 func (p *Classroom) SetType(val string) {
 	p.Type = val
 }
+func (p *Classroom) SetFloor(val *int64) {
+	p.Floor = val
+}
+func (p *Classroom) SetLatitude(val *float64) {
+	p.Latitude = val
+}
+func (p *Classroom) SetLongitude(val *float64) {
+	p.Longitude = val
+}
+func (p *Classroom) SetFreeUntil(val *int64) {
+	p.FreeUntil = val
+}
+
+func (p *Classroom) IsSetFloor() bool {
+	return p.Floor != nil
+}
+
+func (p *Classroom) IsSetLatitude() bool {
+	return p.Latitude != nil
+}
+
+func (p *Classroom) IsSetLongitude() bool {
+	return p.Longitude != nil
+}
+
+func (p *Classroom) IsSetFreeUntil() bool {
+	return p.FreeUntil != nil
+}
 
 func (p *Classroom) String() string {
 	if p == nil {
@@ -310,6 +445,138 @@ func (p *Classroom) String() string {
 	return fmt.Sprintf("Classroom(%+v)", *p)
 }
 
+type EmptyRoomSyncStatus struct {
+	Date          string  `thrift:"date,1,required" frugal:"1,required,string" json:"date"`
+	LastSuccess   int64   `thrift:"lastSuccess,2,required" frugal:"2,required,i64" json:"lastSuccess"`
+	LastAttempt   int64   `thrift:"lastAttempt,3,required" frugal:"3,required,i64" json:"lastAttempt"`
+	Error         *string `thrift:"error,4,optional" frugal:"4,optional,string" json:"error,omitempty"`
+	FailedPeriods []int64 `thrift:"failedPeriods,5,required" frugal:"5,required,list<i64>" json:"failedPeriods"`
+	Fallback      bool    `thrift:"fallback,6,required" frugal:"6,required,bool" json:"fallback"`
+}
+
+func NewEmptyRoomSyncStatus() *EmptyRoomSyncStatus {
+	return &EmptyRoomSyncStatus{}
+}
+
+func (p *EmptyRoomSyncStatus) InitDefault() {
+}
+
+func (p *EmptyRoomSyncStatus) GetDate() (v string) {
+	return p.Date
+}
+
+func (p *EmptyRoomSyncStatus) GetLastSuccess() (v int64) {
+	return p.LastSuccess
+}
+
+func (p *EmptyRoomSyncStatus) GetLastAttempt() (v int64) {
+	return p.LastAttempt
+}
+
+var EmptyRoomSyncStatus_Error_DEFAULT string
+
+func (p *EmptyRoomSyncStatus) GetError() (v string) {
+	if !p.IsSetError() {
+		return EmptyRoomSyncStatus_Error_DEFAULT
+	}
+	return *p.Error
+}
+
+func (p *EmptyRoomSyncStatus) GetFailedPeriods() (v []int64) {
+	return p.FailedPeriods
+}
+
+func (p *EmptyRoomSyncStatus) GetFallback() (v bool) {
+	return p.Fallback
+}
+func (p *EmptyRoomSyncStatus) SetDate(val string) {
+	p.Date = val
+}
+func (p *EmptyRoomSyncStatus) SetLastSuccess(val int64) {
+	p.LastSuccess = val
+}
+func (p *EmptyRoomSyncStatus) SetLastAttempt(val int64) {
+	p.LastAttempt = val
+}
+func (p *EmptyRoomSyncStatus) SetError(val *string) {
+	p.Error = val
+}
+func (p *EmptyRoomSyncStatus) SetFailedPeriods(val []int64) {
+	p.FailedPeriods = val
+}
+func (p *EmptyRoomSyncStatus) SetFallback(val bool) {
+	p.Fallback = val
+}
+
+func (p *EmptyRoomSyncStatus) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *EmptyRoomSyncStatus) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EmptyRoomSyncStatus(%+v)", *p)
+}
+
+type RoomSchedule struct {
+	Room            *Classroom `thrift:"room,1,required" frugal:"1,required,Classroom" json:"room"`
+	Date            string     `thrift:"date,2,required" frugal:"2,required,string" json:"date"`
+	FreePeriods     []int64    `thrift:"freePeriods,3,required" frugal:"3,required,list<i64>" json:"freePeriods"`
+	OccupiedPeriods []int64    `thrift:"occupiedPeriods,4,required" frugal:"4,required,list<i64>" json:"occupiedPeriods"`
+}
+
+func NewRoomSchedule() *RoomSchedule {
+	return &RoomSchedule{}
+}
+
+func (p *RoomSchedule) InitDefault() {
+}
+
+var RoomSchedule_Room_DEFAULT *Classroom
+
+func (p *RoomSchedule) GetRoom() (v *Classroom) {
+	if !p.IsSetRoom() {
+		return RoomSchedule_Room_DEFAULT
+	}
+	return p.Room
+}
+
+func (p *RoomSchedule) GetDate() (v string) {
+	return p.Date
+}
+
+func (p *RoomSchedule) GetFreePeriods() (v []int64) {
+	return p.FreePeriods
+}
+
+func (p *RoomSchedule) GetOccupiedPeriods() (v []int64) {
+	return p.OccupiedPeriods
+}
+func (p *RoomSchedule) SetRoom(val *Classroom) {
+	p.Room = val
+}
+func (p *RoomSchedule) SetDate(val string) {
+	p.Date = val
+}
+func (p *RoomSchedule) SetFreePeriods(val []int64) {
+	p.FreePeriods = val
+}
+func (p *RoomSchedule) SetOccupiedPeriods(val []int64) {
+	p.OccupiedPeriods = val
+}
+
+func (p *RoomSchedule) IsSetRoom() bool {
+	return p.Room != nil
+}
+
+func (p *RoomSchedule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoomSchedule(%+v)", *p)
+}
+
 type ExamRoomInfo struct {
 	Name     string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
 	Credit   string `thrift:"credit,2,required" frugal:"2,required,string" json:"credit"`
@@ -375,6 +642,165 @@ func (p *ExamRoomInfo) String() string {
 	return fmt.Sprintf("ExamRoomInfo(%+v)", *p)
 }
 
+type ExamRoomChange struct {
+	Name         string  `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Credit       string  `thrift:"credit,2,required" frugal:"2,required,string" json:"credit"`
+	Teacher      string  `thrift:"teacher,3,required" frugal:"3,required,string" json:"teacher"`
+	ChangeType   string  `thrift:"changeType,4,required" frugal:"4,required,string" json:"changeType"`
+	PrevLocation *string `thrift:"prevLocation,5,optional" frugal:"5,optional,string" json:"prevLocation,omitempty"`
+	PrevDate     *string `thrift:"prevDate,6,optional" frugal:"6,optional,string" json:"prevDate,omitempty"`
+	PrevTime     *string `thrift:"prevTime,7,optional" frugal:"7,optional,string" json:"prevTime,omitempty"`
+	Location     *string `thrift:"location,8,optional" frugal:"8,optional,string" json:"location,omitempty"`
+	Date         *string `thrift:"date,9,optional" frugal:"9,optional,string" json:"date,omitempty"`
+	Time         *string `thrift:"time,10,optional" frugal:"10,optional,string" json:"time,omitempty"`
+	DetectedAt   int64   `thrift:"detectedAt,11,required" frugal:"11,required,i64" json:"detectedAt"`
+}
+
+func NewExamRoomChange() *ExamRoomChange {
+	return &ExamRoomChange{}
+}
+
+func (p *ExamRoomChange) InitDefault() {
+}
+
+func (p *ExamRoomChange) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ExamRoomChange) GetCredit() (v string) {
+	return p.Credit
+}
+
+func (p *ExamRoomChange) GetTeacher() (v string) {
+	return p.Teacher
+}
+
+func (p *ExamRoomChange) GetChangeType() (v string) {
+	return p.ChangeType
+}
+
+var ExamRoomChange_PrevLocation_DEFAULT string
+
+func (p *ExamRoomChange) GetPrevLocation() (v string) {
+	if !p.IsSetPrevLocation() {
+		return ExamRoomChange_PrevLocation_DEFAULT
+	}
+	return *p.PrevLocation
+}
+
+var ExamRoomChange_PrevDate_DEFAULT string
+
+func (p *ExamRoomChange) GetPrevDate() (v string) {
+	if !p.IsSetPrevDate() {
+		return ExamRoomChange_PrevDate_DEFAULT
+	}
+	return *p.PrevDate
+}
+
+var ExamRoomChange_PrevTime_DEFAULT string
+
+func (p *ExamRoomChange) GetPrevTime() (v string) {
+	if !p.IsSetPrevTime() {
+		return ExamRoomChange_PrevTime_DEFAULT
+	}
+	return *p.PrevTime
+}
+
+var ExamRoomChange_Location_DEFAULT string
+
+func (p *ExamRoomChange) GetLocation() (v string) {
+	if !p.IsSetLocation() {
+		return ExamRoomChange_Location_DEFAULT
+	}
+	return *p.Location
+}
+
+var ExamRoomChange_Date_DEFAULT string
+
+func (p *ExamRoomChange) GetDate() (v string) {
+	if !p.IsSetDate() {
+		return ExamRoomChange_Date_DEFAULT
+	}
+	return *p.Date
+}
+
+var ExamRoomChange_Time_DEFAULT string
+
+func (p *ExamRoomChange) GetTime() (v string) {
+	if !p.IsSetTime() {
+		return ExamRoomChange_Time_DEFAULT
+	}
+	return *p.Time
+}
+
+func (p *ExamRoomChange) GetDetectedAt() (v int64) {
+	return p.DetectedAt
+}
+func (p *ExamRoomChange) SetName(val string) {
+	p.Name = val
+}
+func (p *ExamRoomChange) SetCredit(val string) {
+	p.Credit = val
+}
+func (p *ExamRoomChange) SetTeacher(val string) {
+	p.Teacher = val
+}
+func (p *ExamRoomChange) SetChangeType(val string) {
+	p.ChangeType = val
+}
+func (p *ExamRoomChange) SetPrevLocation(val *string) {
+	p.PrevLocation = val
+}
+func (p *ExamRoomChange) SetPrevDate(val *string) {
+	p.PrevDate = val
+}
+func (p *ExamRoomChange) SetPrevTime(val *string) {
+	p.PrevTime = val
+}
+func (p *ExamRoomChange) SetLocation(val *string) {
+	p.Location = val
+}
+func (p *ExamRoomChange) SetDate(val *string) {
+	p.Date = val
+}
+func (p *ExamRoomChange) SetTime(val *string) {
+	p.Time = val
+}
+func (p *ExamRoomChange) SetDetectedAt(val int64) {
+	p.DetectedAt = val
+}
+
+func (p *ExamRoomChange) IsSetPrevLocation() bool {
+	return p.PrevLocation != nil
+}
+
+func (p *ExamRoomChange) IsSetPrevDate() bool {
+	return p.PrevDate != nil
+}
+
+func (p *ExamRoomChange) IsSetPrevTime() bool {
+	return p.PrevTime != nil
+}
+
+func (p *ExamRoomChange) IsSetLocation() bool {
+	return p.Location != nil
+}
+
+func (p *ExamRoomChange) IsSetDate() bool {
+	return p.Date != nil
+}
+
+func (p *ExamRoomChange) IsSetTime() bool {
+	return p.Time != nil
+}
+
+func (p *ExamRoomChange) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExamRoomChange(%+v)", *p)
+}
+
 type CourseScheduleRule struct {
 	Location   string `thrift:"location,1,required" frugal:"1,required,string" json:"location"`
 	StartClass int64  `thrift:"startClass,2,required" frugal:"2,required,i64" json:"startClass"`
@@ -1711,9 +2137,10 @@ func (p *TermInfo) String() string {
 }
 
 type NoticeInfo struct {
-	Title *string `thrift:"title,1,optional" frugal:"1,optional,string" json:"title,omitempty"`
-	Url   *string `thrift:"url,2,optional" frugal:"2,optional,string" json:"url,omitempty"`
-	Date  *string `thrift:"date,3,optional" frugal:"3,optional,string" json:"date,omitempty"`
+	Title  *string `thrift:"title,1,optional" frugal:"1,optional,string" json:"title,omitempty"`
+	Url    *string `thrift:"url,2,optional" frugal:"2,optional,string" json:"url,omitempty"`
+	Date   *string `thrift:"date,3,optional" frugal:"3,optional,string" json:"date,omitempty"`
+	Source *string `thrift:"source,4,optional" frugal:"4,optional,string" json:"source,omitempty"`
 }
 
 func NewNoticeInfo() *NoticeInfo {
@@ -1749,6 +2176,15 @@ func (p *NoticeInfo) GetDate() (v string) {
 	}
 	return *p.Date
 }
+
+var NoticeInfo_Source_DEFAULT string
+
+func (p *NoticeInfo) GetSource() (v string) {
+	if !p.IsSetSource() {
+		return NoticeInfo_Source_DEFAULT
+	}
+	return *p.Source
+}
 func (p *NoticeInfo) SetTitle(val *string) {
 	p.Title = val
 }
@@ -1758,6 +2194,9 @@ func (p *NoticeInfo) SetUrl(val *string) {
 func (p *NoticeInfo) SetDate(val *string) {
 	p.Date = val
 }
+func (p *NoticeInfo) SetSource(val *string) {
+	p.Source = val
+}
 
 func (p *NoticeInfo) IsSetTitle() bool {
 	return p.Title != nil
@@ -1771,6 +2210,10 @@ func (p *NoticeInfo) IsSetDate() bool {
 	return p.Date != nil
 }
 
+func (p *NoticeInfo) IsSetSource() bool {
+	return p.Source != nil
+}
+
 func (p *NoticeInfo) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1778,77 +2221,389 @@ func (p *NoticeInfo) String() string {
 	return fmt.Sprintf("NoticeInfo(%+v)", *p)
 }
 
-type Contributor struct {
-	Name          string `thrift:"name,1" frugal:"1,default,string" json:"name"`
-	AvatarUrl     string `thrift:"avatar_url,2" frugal:"2,default,string" json:"avatar_url"`
-	Url           string `thrift:"url,3" frugal:"3,default,string" json:"url"`
-	Contributions int64  `thrift:"contributions,4" frugal:"4,default,i64" json:"contributions"`
+type NoticeSource struct {
+	Name        string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	DisplayName string `thrift:"displayName,2,required" frugal:"2,required,string" json:"displayName"`
 }
 
-func NewContributor() *Contributor {
-	return &Contributor{}
+func NewNoticeSource() *NoticeSource {
+	return &NoticeSource{}
 }
 
-func (p *Contributor) InitDefault() {
+func (p *NoticeSource) InitDefault() {
 }
 
-func (p *Contributor) GetName() (v string) {
+func (p *NoticeSource) GetName() (v string) {
 	return p.Name
 }
 
-func (p *Contributor) GetAvatarUrl() (v string) {
-	return p.AvatarUrl
-}
-
-func (p *Contributor) GetUrl() (v string) {
-	return p.Url
-}
-
-func (p *Contributor) GetContributions() (v int64) {
-	return p.Contributions
+func (p *NoticeSource) GetDisplayName() (v string) {
+	return p.DisplayName
 }
-func (p *Contributor) SetName(val string) {
+func (p *NoticeSource) SetName(val string) {
 	p.Name = val
 }
-func (p *Contributor) SetAvatarUrl(val string) {
-	p.AvatarUrl = val
-}
-func (p *Contributor) SetUrl(val string) {
-	p.Url = val
-}
-func (p *Contributor) SetContributions(val int64) {
-	p.Contributions = val
+func (p *NoticeSource) SetDisplayName(val string) {
+	p.DisplayName = val
 }
 
-func (p *Contributor) String() string {
+func (p *NoticeSource) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Contributor(%+v)", *p)
+	return fmt.Sprintf("NoticeSource(%+v)", *p)
 }
 
-type ToolboxConfig struct {
-	ToolId    int64   `thrift:"tool_id,1,required" frugal:"1,required,i64" json:"tool_id"`
-	Visible   *bool   `thrift:"visible,2,optional" frugal:"2,optional,bool" json:"visible,omitempty"`
-	Name      *string `thrift:"name,3,optional" frugal:"3,optional,string" json:"name,omitempty"`
-	Icon      *string `thrift:"icon,4,optional" frugal:"4,optional,string" json:"icon,omitempty"`
-	Type      *string `thrift:"type,5,optional" frugal:"5,optional,string" json:"type,omitempty"`
-	Message   *string `thrift:"message,6,optional" frugal:"6,optional,string" json:"message,omitempty"`
-	Extra     *string `thrift:"extra,7,optional" frugal:"7,optional,string" json:"extra,omitempty"`
-	Platform  *string `thrift:"platform,8,optional" frugal:"8,optional,string" json:"platform,omitempty"`
-	Version   *int64  `thrift:"version,9,optional" frugal:"9,optional,i64" json:"version,omitempty"`
-	ConfigId  *int64  `thrift:"config_id,10,optional" frugal:"10,optional,i64" json:"config_id,omitempty"`
-	StudentId *string `thrift:"student_id,11,optional" frugal:"11,optional,string" json:"student_id,omitempty"`
+type NoticeSearchHit struct {
+	Title          string   `thrift:"title,1,required" frugal:"1,required,string" json:"title"`
+	Url            string   `thrift:"url,2,required" frugal:"2,required,string" json:"url"`
+	Date           string   `thrift:"date,3,required" frugal:"3,required,string" json:"date"`
+	HighlightTitle *string  `thrift:"highlightTitle,4,optional" frugal:"4,optional,string" json:"highlightTitle,omitempty"`
+	Highlights     []string `thrift:"highlights,5,required" frugal:"5,required,list<string>" json:"highlights"`
 }
 
-func NewToolboxConfig() *ToolboxConfig {
-	return &ToolboxConfig{}
+func NewNoticeSearchHit() *NoticeSearchHit {
+	return &NoticeSearchHit{}
 }
 
-func (p *ToolboxConfig) InitDefault() {
+func (p *NoticeSearchHit) InitDefault() {
 }
 
-func (p *ToolboxConfig) GetToolId() (v int64) {
+func (p *NoticeSearchHit) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *NoticeSearchHit) GetUrl() (v string) {
+	return p.Url
+}
+
+func (p *NoticeSearchHit) GetDate() (v string) {
+	return p.Date
+}
+
+var NoticeSearchHit_HighlightTitle_DEFAULT string
+
+func (p *NoticeSearchHit) GetHighlightTitle() (v string) {
+	if !p.IsSetHighlightTitle() {
+		return NoticeSearchHit_HighlightTitle_DEFAULT
+	}
+	return *p.HighlightTitle
+}
+
+func (p *NoticeSearchHit) GetHighlights() (v []string) {
+	return p.Highlights
+}
+func (p *NoticeSearchHit) SetTitle(val string) {
+	p.Title = val
+}
+func (p *NoticeSearchHit) SetUrl(val string) {
+	p.Url = val
+}
+func (p *NoticeSearchHit) SetDate(val string) {
+	p.Date = val
+}
+func (p *NoticeSearchHit) SetHighlightTitle(val *string) {
+	p.HighlightTitle = val
+}
+func (p *NoticeSearchHit) SetHighlights(val []string) {
+	p.Highlights = val
+}
+
+func (p *NoticeSearchHit) IsSetHighlightTitle() bool {
+	return p.HighlightTitle != nil
+}
+
+func (p *NoticeSearchHit) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoticeSearchHit(%+v)", *p)
+}
+
+type NoticeAttachment struct {
+	Name     string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	Url      string `thrift:"url,2,required" frugal:"2,required,string" json:"url"`
+	Mirrored bool   `thrift:"mirrored,3,required" frugal:"3,required,bool" json:"mirrored"`
+}
+
+func NewNoticeAttachment() *NoticeAttachment {
+	return &NoticeAttachment{}
+}
+
+func (p *NoticeAttachment) InitDefault() {
+}
+
+func (p *NoticeAttachment) GetName() (v string) {
+	return p.Name
+}
+
+func (p *NoticeAttachment) GetUrl() (v string) {
+	return p.Url
+}
+
+func (p *NoticeAttachment) GetMirrored() (v bool) {
+	return p.Mirrored
+}
+func (p *NoticeAttachment) SetName(val string) {
+	p.Name = val
+}
+func (p *NoticeAttachment) SetUrl(val string) {
+	p.Url = val
+}
+func (p *NoticeAttachment) SetMirrored(val bool) {
+	p.Mirrored = val
+}
+
+func (p *NoticeAttachment) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoticeAttachment(%+v)", *p)
+}
+
+type NoticeDetail struct {
+	Title       string              `thrift:"title,1,required" frugal:"1,required,string" json:"title"`
+	Url         string              `thrift:"url,2,required" frugal:"2,required,string" json:"url"`
+	Date        string              `thrift:"date,3,required" frugal:"3,required,string" json:"date"`
+	Content     string              `thrift:"content,4,required" frugal:"4,required,string" json:"content"`
+	Attachments []*NoticeAttachment `thrift:"attachments,5,required" frugal:"5,required,list<NoticeAttachment>" json:"attachments"`
+}
+
+func NewNoticeDetail() *NoticeDetail {
+	return &NoticeDetail{}
+}
+
+func (p *NoticeDetail) InitDefault() {
+}
+
+func (p *NoticeDetail) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *NoticeDetail) GetUrl() (v string) {
+	return p.Url
+}
+
+func (p *NoticeDetail) GetDate() (v string) {
+	return p.Date
+}
+
+func (p *NoticeDetail) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *NoticeDetail) GetAttachments() (v []*NoticeAttachment) {
+	return p.Attachments
+}
+func (p *NoticeDetail) SetTitle(val string) {
+	p.Title = val
+}
+func (p *NoticeDetail) SetUrl(val string) {
+	p.Url = val
+}
+func (p *NoticeDetail) SetDate(val string) {
+	p.Date = val
+}
+func (p *NoticeDetail) SetContent(val string) {
+	p.Content = val
+}
+func (p *NoticeDetail) SetAttachments(val []*NoticeAttachment) {
+	p.Attachments = val
+}
+
+func (p *NoticeDetail) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoticeDetail(%+v)", *p)
+}
+
+type NoticeSubscription struct {
+	Keyword string `thrift:"keyword,1,required" frugal:"1,required,string" json:"keyword"`
+	Tag     string `thrift:"tag,2,required" frugal:"2,required,string" json:"tag"`
+}
+
+func NewNoticeSubscription() *NoticeSubscription {
+	return &NoticeSubscription{}
+}
+
+func (p *NoticeSubscription) InitDefault() {
+}
+
+func (p *NoticeSubscription) GetKeyword() (v string) {
+	return p.Keyword
+}
+
+func (p *NoticeSubscription) GetTag() (v string) {
+	return p.Tag
+}
+func (p *NoticeSubscription) SetKeyword(val string) {
+	p.Keyword = val
+}
+func (p *NoticeSubscription) SetTag(val string) {
+	p.Tag = val
+}
+
+func (p *NoticeSubscription) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoticeSubscription(%+v)", *p)
+}
+
+type Notification struct {
+	Id        int64   `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	Type      string  `thrift:"type,2,required" frugal:"2,required,string" json:"type"`
+	Title     string  `thrift:"title,3,required" frugal:"3,required,string" json:"title"`
+	Content   string  `thrift:"content,4,required" frugal:"4,required,string" json:"content"`
+	Deeplink  *string `thrift:"deeplink,5,optional" frugal:"5,optional,string" json:"deeplink,omitempty"`
+	IsRead    bool    `thrift:"isRead,6,required" frugal:"6,required,bool" json:"isRead"`
+	CreatedAt int64   `thrift:"createdAt,7,required" frugal:"7,required,i64" json:"createdAt"`
+}
+
+func NewNotification() *Notification {
+	return &Notification{}
+}
+
+func (p *Notification) InitDefault() {
+}
+
+func (p *Notification) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Notification) GetType() (v string) {
+	return p.Type
+}
+
+func (p *Notification) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *Notification) GetContent() (v string) {
+	return p.Content
+}
+
+var Notification_Deeplink_DEFAULT string
+
+func (p *Notification) GetDeeplink() (v string) {
+	if !p.IsSetDeeplink() {
+		return Notification_Deeplink_DEFAULT
+	}
+	return *p.Deeplink
+}
+
+func (p *Notification) GetIsRead() (v bool) {
+	return p.IsRead
+}
+
+func (p *Notification) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *Notification) SetId(val int64) {
+	p.Id = val
+}
+func (p *Notification) SetType(val string) {
+	p.Type = val
+}
+func (p *Notification) SetTitle(val string) {
+	p.Title = val
+}
+func (p *Notification) SetContent(val string) {
+	p.Content = val
+}
+func (p *Notification) SetDeeplink(val *string) {
+	p.Deeplink = val
+}
+func (p *Notification) SetIsRead(val bool) {
+	p.IsRead = val
+}
+func (p *Notification) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *Notification) IsSetDeeplink() bool {
+	return p.Deeplink != nil
+}
+
+func (p *Notification) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Notification(%+v)", *p)
+}
+
+type Contributor struct {
+	Name          string `thrift:"name,1" frugal:"1,default,string" json:"name"`
+	AvatarUrl     string `thrift:"avatar_url,2" frugal:"2,default,string" json:"avatar_url"`
+	Url           string `thrift:"url,3" frugal:"3,default,string" json:"url"`
+	Contributions int64  `thrift:"contributions,4" frugal:"4,default,i64" json:"contributions"`
+}
+
+func NewContributor() *Contributor {
+	return &Contributor{}
+}
+
+func (p *Contributor) InitDefault() {
+}
+
+func (p *Contributor) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Contributor) GetAvatarUrl() (v string) {
+	return p.AvatarUrl
+}
+
+func (p *Contributor) GetUrl() (v string) {
+	return p.Url
+}
+
+func (p *Contributor) GetContributions() (v int64) {
+	return p.Contributions
+}
+func (p *Contributor) SetName(val string) {
+	p.Name = val
+}
+func (p *Contributor) SetAvatarUrl(val string) {
+	p.AvatarUrl = val
+}
+func (p *Contributor) SetUrl(val string) {
+	p.Url = val
+}
+func (p *Contributor) SetContributions(val int64) {
+	p.Contributions = val
+}
+
+func (p *Contributor) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Contributor(%+v)", *p)
+}
+
+type ToolboxConfig struct {
+	ToolId    int64   `thrift:"tool_id,1,required" frugal:"1,required,i64" json:"tool_id"`
+	Visible   *bool   `thrift:"visible,2,optional" frugal:"2,optional,bool" json:"visible,omitempty"`
+	Name      *string `thrift:"name,3,optional" frugal:"3,optional,string" json:"name,omitempty"`
+	Icon      *string `thrift:"icon,4,optional" frugal:"4,optional,string" json:"icon,omitempty"`
+	Type      *string `thrift:"type,5,optional" frugal:"5,optional,string" json:"type,omitempty"`
+	Message   *string `thrift:"message,6,optional" frugal:"6,optional,string" json:"message,omitempty"`
+	Extra     *string `thrift:"extra,7,optional" frugal:"7,optional,string" json:"extra,omitempty"`
+	Platform  *string `thrift:"platform,8,optional" frugal:"8,optional,string" json:"platform,omitempty"`
+	Version   *int64  `thrift:"version,9,optional" frugal:"9,optional,i64" json:"version,omitempty"`
+	ConfigId  *int64  `thrift:"config_id,10,optional" frugal:"10,optional,i64" json:"config_id,omitempty"`
+	StudentId *string `thrift:"student_id,11,optional" frugal:"11,optional,string" json:"student_id,omitempty"`
+}
+
+func NewToolboxConfig() *ToolboxConfig {
+	return &ToolboxConfig{}
+}
+
+func (p *ToolboxConfig) InitDefault() {
+}
+
+func (p *ToolboxConfig) GetToolId() (v int64) {
 	return p.ToolId
 }
 
@@ -2205,6 +2960,8 @@ type Version struct {
 	Force       *bool   `thrift:"force,3,optional" frugal:"3,optional,bool" json:"force,omitempty"`
 	Changelog   *string `thrift:"changelog,4,optional" frugal:"4,optional,string" json:"changelog,omitempty"`
 	Url         *string `thrift:"url,5,optional" frugal:"5,optional,string" json:"url,omitempty"`
+	ReleasedAt  *int64  `thrift:"released_at,6,optional" frugal:"6,optional,i64" json:"released_at,omitempty"`
+	Status      *string `thrift:"status,7,optional" frugal:"7,optional,string" json:"status,omitempty"`
 }
 
 func NewVersion() *Version {
@@ -2258,6 +3015,24 @@ func (p *Version) GetUrl() (v string) {
 	}
 	return *p.Url
 }
+
+var Version_ReleasedAt_DEFAULT int64
+
+func (p *Version) GetReleasedAt() (v int64) {
+	if !p.IsSetReleasedAt() {
+		return Version_ReleasedAt_DEFAULT
+	}
+	return *p.ReleasedAt
+}
+
+var Version_Status_DEFAULT string
+
+func (p *Version) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return Version_Status_DEFAULT
+	}
+	return *p.Status
+}
 func (p *Version) SetVersionCode(val *string) {
 	p.VersionCode = val
 }
@@ -2273,6 +3048,12 @@ func (p *Version) SetChangelog(val *string) {
 func (p *Version) SetUrl(val *string) {
 	p.Url = val
 }
+func (p *Version) SetReleasedAt(val *int64) {
+	p.ReleasedAt = val
+}
+func (p *Version) SetStatus(val *string) {
+	p.Status = val
+}
 
 func (p *Version) IsSetVersionCode() bool {
 	return p.VersionCode != nil
@@ -2294,6 +3075,14 @@ func (p *Version) IsSetUrl() bool {
 	return p.Url != nil
 }
 
+func (p *Version) IsSetReleasedAt() bool {
+	return p.ReleasedAt != nil
+}
+
+func (p *Version) IsSetStatus() bool {
+	return p.Status != nil
+}
+
 func (p *Version) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2301,6 +3090,517 @@ func (p *Version) String() string {
 	return fmt.Sprintf("Version(%+v)", *p)
 }
 
+type VersionChangelog struct {
+	Latest    *Version   `thrift:"latest,1,optional" frugal:"1,optional,Version" json:"latest,omitempty"`
+	Changelog string     `thrift:"changelog,2,required" frugal:"2,required,string" json:"changelog"`
+	Versions  []*Version `thrift:"versions,3,required" frugal:"3,required,list<Version>" json:"versions"`
+}
+
+func NewVersionChangelog() *VersionChangelog {
+	return &VersionChangelog{}
+}
+
+func (p *VersionChangelog) InitDefault() {
+}
+
+var VersionChangelog_Latest_DEFAULT *Version
+
+func (p *VersionChangelog) GetLatest() (v *Version) {
+	if !p.IsSetLatest() {
+		return VersionChangelog_Latest_DEFAULT
+	}
+	return p.Latest
+}
+
+func (p *VersionChangelog) GetChangelog() (v string) {
+	return p.Changelog
+}
+
+func (p *VersionChangelog) GetVersions() (v []*Version) {
+	return p.Versions
+}
+func (p *VersionChangelog) SetLatest(val *Version) {
+	p.Latest = val
+}
+func (p *VersionChangelog) SetChangelog(val string) {
+	p.Changelog = val
+}
+func (p *VersionChangelog) SetVersions(val []*Version) {
+	p.Versions = val
+}
+
+func (p *VersionChangelog) IsSetLatest() bool {
+	return p.Latest != nil
+}
+
+func (p *VersionChangelog) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionChangelog(%+v)", *p)
+}
+
+type LatestVersion struct {
+	Version          *Version `thrift:"version,1,optional" frugal:"1,optional,Version" json:"version,omitempty"`
+	StoreUrl         *string  `thrift:"store_url,2,optional" frugal:"2,optional,string" json:"store_url,omitempty"`
+	Force            bool     `thrift:"force,3,required" frugal:"3,required,bool" json:"force"`
+	MinSupportedCode int64    `thrift:"min_supported_code,4,required" frugal:"4,required,i64" json:"min_supported_code"`
+}
+
+func NewLatestVersion() *LatestVersion {
+	return &LatestVersion{}
+}
+
+func (p *LatestVersion) InitDefault() {
+}
+
+var LatestVersion_Version_DEFAULT *Version
+
+func (p *LatestVersion) GetVersion() (v *Version) {
+	if !p.IsSetVersion() {
+		return LatestVersion_Version_DEFAULT
+	}
+	return p.Version
+}
+
+var LatestVersion_StoreUrl_DEFAULT string
+
+func (p *LatestVersion) GetStoreUrl() (v string) {
+	if !p.IsSetStoreUrl() {
+		return LatestVersion_StoreUrl_DEFAULT
+	}
+	return *p.StoreUrl
+}
+
+func (p *LatestVersion) GetForce() (v bool) {
+	return p.Force
+}
+
+func (p *LatestVersion) GetMinSupportedCode() (v int64) {
+	return p.MinSupportedCode
+}
+func (p *LatestVersion) SetVersion(val *Version) {
+	p.Version = val
+}
+func (p *LatestVersion) SetStoreUrl(val *string) {
+	p.StoreUrl = val
+}
+func (p *LatestVersion) SetForce(val bool) {
+	p.Force = val
+}
+func (p *LatestVersion) SetMinSupportedCode(val int64) {
+	p.MinSupportedCode = val
+}
+
+func (p *LatestVersion) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *LatestVersion) IsSetStoreUrl() bool {
+	return p.StoreUrl != nil
+}
+
+func (p *LatestVersion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LatestVersion(%+v)", *p)
+}
+
+type CloudSettingPlanMatch struct {
+	Index            int64    `thrift:"index,1,required" frugal:"1,required,i64" json:"index"`
+	Name             *string  `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	Matched          bool     `thrift:"matched,3,required" frugal:"3,required,bool" json:"matched"`
+	MismatchedFields []string `thrift:"mismatched_fields,4,required" frugal:"4,required,list<string>" json:"mismatched_fields"`
+}
+
+func NewCloudSettingPlanMatch() *CloudSettingPlanMatch {
+	return &CloudSettingPlanMatch{}
+}
+
+func (p *CloudSettingPlanMatch) InitDefault() {
+}
+
+func (p *CloudSettingPlanMatch) GetIndex() (v int64) {
+	return p.Index
+}
+
+var CloudSettingPlanMatch_Name_DEFAULT string
+
+func (p *CloudSettingPlanMatch) GetName() (v string) {
+	if !p.IsSetName() {
+		return CloudSettingPlanMatch_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+func (p *CloudSettingPlanMatch) GetMatched() (v bool) {
+	return p.Matched
+}
+
+func (p *CloudSettingPlanMatch) GetMismatchedFields() (v []string) {
+	return p.MismatchedFields
+}
+func (p *CloudSettingPlanMatch) SetIndex(val int64) {
+	p.Index = val
+}
+func (p *CloudSettingPlanMatch) SetName(val *string) {
+	p.Name = val
+}
+func (p *CloudSettingPlanMatch) SetMatched(val bool) {
+	p.Matched = val
+}
+func (p *CloudSettingPlanMatch) SetMismatchedFields(val []string) {
+	p.MismatchedFields = val
+}
+
+func (p *CloudSettingPlanMatch) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *CloudSettingPlanMatch) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingPlanMatch(%+v)", *p)
+}
+
+type CloudSettingDryRun struct {
+	MatchedIndex *int64                   `thrift:"matched_index,1,optional" frugal:"1,optional,i64" json:"matched_index,omitempty"`
+	Plan         *string                  `thrift:"plan,2,optional" frugal:"2,optional,string" json:"plan,omitempty"`
+	Plans        []*CloudSettingPlanMatch `thrift:"plans,3,required" frugal:"3,required,list<CloudSettingPlanMatch>" json:"plans"`
+}
+
+func NewCloudSettingDryRun() *CloudSettingDryRun {
+	return &CloudSettingDryRun{}
+}
+
+func (p *CloudSettingDryRun) InitDefault() {
+}
+
+var CloudSettingDryRun_MatchedIndex_DEFAULT int64
+
+func (p *CloudSettingDryRun) GetMatchedIndex() (v int64) {
+	if !p.IsSetMatchedIndex() {
+		return CloudSettingDryRun_MatchedIndex_DEFAULT
+	}
+	return *p.MatchedIndex
+}
+
+var CloudSettingDryRun_Plan_DEFAULT string
+
+func (p *CloudSettingDryRun) GetPlan() (v string) {
+	if !p.IsSetPlan() {
+		return CloudSettingDryRun_Plan_DEFAULT
+	}
+	return *p.Plan
+}
+
+func (p *CloudSettingDryRun) GetPlans() (v []*CloudSettingPlanMatch) {
+	return p.Plans
+}
+func (p *CloudSettingDryRun) SetMatchedIndex(val *int64) {
+	p.MatchedIndex = val
+}
+func (p *CloudSettingDryRun) SetPlan(val *string) {
+	p.Plan = val
+}
+func (p *CloudSettingDryRun) SetPlans(val []*CloudSettingPlanMatch) {
+	p.Plans = val
+}
+
+func (p *CloudSettingDryRun) IsSetMatchedIndex() bool {
+	return p.MatchedIndex != nil
+}
+
+func (p *CloudSettingDryRun) IsSetPlan() bool {
+	return p.Plan != nil
+}
+
+func (p *CloudSettingDryRun) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingDryRun(%+v)", *p)
+}
+
+type CloudSettingCriteria struct {
+	Account   *string `thrift:"account,1,optional" frugal:"1,optional,string" json:"account,omitempty"`
+	Version   *string `thrift:"version,2,optional" frugal:"2,optional,string" json:"version,omitempty"`
+	Beta      *bool   `thrift:"beta,3,optional" frugal:"3,optional,bool" json:"beta,omitempty"`
+	Phone     *string `thrift:"phone,4,optional" frugal:"4,optional,string" json:"phone,omitempty"`
+	IsLogin   *bool   `thrift:"isLogin,5,optional" frugal:"5,optional,bool" json:"isLogin,omitempty"`
+	LoginType *string `thrift:"loginType,6,optional" frugal:"6,optional,string" json:"loginType,omitempty"`
+}
+
+func NewCloudSettingCriteria() *CloudSettingCriteria {
+	return &CloudSettingCriteria{}
+}
+
+func (p *CloudSettingCriteria) InitDefault() {
+}
+
+var CloudSettingCriteria_Account_DEFAULT string
+
+func (p *CloudSettingCriteria) GetAccount() (v string) {
+	if !p.IsSetAccount() {
+		return CloudSettingCriteria_Account_DEFAULT
+	}
+	return *p.Account
+}
+
+var CloudSettingCriteria_Version_DEFAULT string
+
+func (p *CloudSettingCriteria) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return CloudSettingCriteria_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var CloudSettingCriteria_Beta_DEFAULT bool
+
+func (p *CloudSettingCriteria) GetBeta() (v bool) {
+	if !p.IsSetBeta() {
+		return CloudSettingCriteria_Beta_DEFAULT
+	}
+	return *p.Beta
+}
+
+var CloudSettingCriteria_Phone_DEFAULT string
+
+func (p *CloudSettingCriteria) GetPhone() (v string) {
+	if !p.IsSetPhone() {
+		return CloudSettingCriteria_Phone_DEFAULT
+	}
+	return *p.Phone
+}
+
+var CloudSettingCriteria_IsLogin_DEFAULT bool
+
+func (p *CloudSettingCriteria) GetIsLogin() (v bool) {
+	if !p.IsSetIsLogin() {
+		return CloudSettingCriteria_IsLogin_DEFAULT
+	}
+	return *p.IsLogin
+}
+
+var CloudSettingCriteria_LoginType_DEFAULT string
+
+func (p *CloudSettingCriteria) GetLoginType() (v string) {
+	if !p.IsSetLoginType() {
+		return CloudSettingCriteria_LoginType_DEFAULT
+	}
+	return *p.LoginType
+}
+func (p *CloudSettingCriteria) SetAccount(val *string) {
+	p.Account = val
+}
+func (p *CloudSettingCriteria) SetVersion(val *string) {
+	p.Version = val
+}
+func (p *CloudSettingCriteria) SetBeta(val *bool) {
+	p.Beta = val
+}
+func (p *CloudSettingCriteria) SetPhone(val *string) {
+	p.Phone = val
+}
+func (p *CloudSettingCriteria) SetIsLogin(val *bool) {
+	p.IsLogin = val
+}
+func (p *CloudSettingCriteria) SetLoginType(val *string) {
+	p.LoginType = val
+}
+
+func (p *CloudSettingCriteria) IsSetAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CloudSettingCriteria) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *CloudSettingCriteria) IsSetBeta() bool {
+	return p.Beta != nil
+}
+
+func (p *CloudSettingCriteria) IsSetPhone() bool {
+	return p.Phone != nil
+}
+
+func (p *CloudSettingCriteria) IsSetIsLogin() bool {
+	return p.IsLogin != nil
+}
+
+func (p *CloudSettingCriteria) IsSetLoginType() bool {
+	return p.LoginType != nil
+}
+
+func (p *CloudSettingCriteria) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingCriteria(%+v)", *p)
+}
+
+type CloudSettingPlanCoverage struct {
+	Index      int64   `thrift:"index,1,required" frugal:"1,required,i64" json:"index"`
+	Name       *string `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	Hits       int64   `thrift:"hits,3,required" frugal:"3,required,i64" json:"hits"`
+	Eligible   int64   `thrift:"eligible,4,required" frugal:"4,required,i64" json:"eligible"`
+	ShadowedBy *int64  `thrift:"shadowed_by,5,optional" frugal:"5,optional,i64" json:"shadowed_by,omitempty"`
+}
+
+func NewCloudSettingPlanCoverage() *CloudSettingPlanCoverage {
+	return &CloudSettingPlanCoverage{}
+}
+
+func (p *CloudSettingPlanCoverage) InitDefault() {
+}
+
+func (p *CloudSettingPlanCoverage) GetIndex() (v int64) {
+	return p.Index
+}
+
+var CloudSettingPlanCoverage_Name_DEFAULT string
+
+func (p *CloudSettingPlanCoverage) GetName() (v string) {
+	if !p.IsSetName() {
+		return CloudSettingPlanCoverage_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+func (p *CloudSettingPlanCoverage) GetHits() (v int64) {
+	return p.Hits
+}
+
+func (p *CloudSettingPlanCoverage) GetEligible() (v int64) {
+	return p.Eligible
+}
+
+var CloudSettingPlanCoverage_ShadowedBy_DEFAULT int64
+
+func (p *CloudSettingPlanCoverage) GetShadowedBy() (v int64) {
+	if !p.IsSetShadowedBy() {
+		return CloudSettingPlanCoverage_ShadowedBy_DEFAULT
+	}
+	return *p.ShadowedBy
+}
+func (p *CloudSettingPlanCoverage) SetIndex(val int64) {
+	p.Index = val
+}
+func (p *CloudSettingPlanCoverage) SetName(val *string) {
+	p.Name = val
+}
+func (p *CloudSettingPlanCoverage) SetHits(val int64) {
+	p.Hits = val
+}
+func (p *CloudSettingPlanCoverage) SetEligible(val int64) {
+	p.Eligible = val
+}
+func (p *CloudSettingPlanCoverage) SetShadowedBy(val *int64) {
+	p.ShadowedBy = val
+}
+
+func (p *CloudSettingPlanCoverage) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *CloudSettingPlanCoverage) IsSetShadowedBy() bool {
+	return p.ShadowedBy != nil
+}
+
+func (p *CloudSettingPlanCoverage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingPlanCoverage(%+v)", *p)
+}
+
+type CloudSettingUnmatched struct {
+	Criteria *CloudSettingCriteria `thrift:"criteria,1,required" frugal:"1,required,CloudSettingCriteria" json:"criteria"`
+	Count    int64                 `thrift:"count,2,required" frugal:"2,required,i64" json:"count"`
+}
+
+func NewCloudSettingUnmatched() *CloudSettingUnmatched {
+	return &CloudSettingUnmatched{}
+}
+
+func (p *CloudSettingUnmatched) InitDefault() {
+}
+
+var CloudSettingUnmatched_Criteria_DEFAULT *CloudSettingCriteria
+
+func (p *CloudSettingUnmatched) GetCriteria() (v *CloudSettingCriteria) {
+	if !p.IsSetCriteria() {
+		return CloudSettingUnmatched_Criteria_DEFAULT
+	}
+	return p.Criteria
+}
+
+func (p *CloudSettingUnmatched) GetCount() (v int64) {
+	return p.Count
+}
+func (p *CloudSettingUnmatched) SetCriteria(val *CloudSettingCriteria) {
+	p.Criteria = val
+}
+func (p *CloudSettingUnmatched) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *CloudSettingUnmatched) IsSetCriteria() bool {
+	return p.Criteria != nil
+}
+
+func (p *CloudSettingUnmatched) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingUnmatched(%+v)", *p)
+}
+
+type CloudSettingCoverage struct {
+	Samples   int64                       `thrift:"samples,1,required" frugal:"1,required,i64" json:"samples"`
+	Plans     []*CloudSettingPlanCoverage `thrift:"plans,2,required" frugal:"2,required,list<CloudSettingPlanCoverage>" json:"plans"`
+	Unmatched []*CloudSettingUnmatched    `thrift:"unmatched,3,required" frugal:"3,required,list<CloudSettingUnmatched>" json:"unmatched"`
+}
+
+func NewCloudSettingCoverage() *CloudSettingCoverage {
+	return &CloudSettingCoverage{}
+}
+
+func (p *CloudSettingCoverage) InitDefault() {
+}
+
+func (p *CloudSettingCoverage) GetSamples() (v int64) {
+	return p.Samples
+}
+
+func (p *CloudSettingCoverage) GetPlans() (v []*CloudSettingPlanCoverage) {
+	return p.Plans
+}
+
+func (p *CloudSettingCoverage) GetUnmatched() (v []*CloudSettingUnmatched) {
+	return p.Unmatched
+}
+func (p *CloudSettingCoverage) SetSamples(val int64) {
+	p.Samples = val
+}
+func (p *CloudSettingCoverage) SetPlans(val []*CloudSettingPlanCoverage) {
+	p.Plans = val
+}
+func (p *CloudSettingCoverage) SetUnmatched(val []*CloudSettingUnmatched) {
+	p.Unmatched = val
+}
+
+func (p *CloudSettingCoverage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudSettingCoverage(%+v)", *p)
+}
+
 type Feedback struct {
 	ReportId       int64  `thrift:"report_id,1,required" frugal:"1,required,i64" json:"report_id"`
 	StuId          string `thrift:"stu_id,2,required" frugal:"2,required,string" json:"stu_id"`
@@ -877,3 +877,307 @@ func (p *UserServiceReorderFriendListResult) String() string {
 func (p *UserServiceReorderFriendListResult) GetResult() interface{} {
 	return p.Success
 }
+
+type UserServiceRegisterDeviceArgs struct {
+	Request *RegisterDeviceRequest `thrift:"request,1" frugal:"1,default,RegisterDeviceRequest" json:"request"`
+}
+
+func NewUserServiceRegisterDeviceArgs() *UserServiceRegisterDeviceArgs {
+	return &UserServiceRegisterDeviceArgs{}
+}
+
+func (p *UserServiceRegisterDeviceArgs) InitDefault() {
+}
+
+var UserServiceRegisterDeviceArgs_Request_DEFAULT *RegisterDeviceRequest
+
+func (p *UserServiceRegisterDeviceArgs) GetRequest() (v *RegisterDeviceRequest) {
+	if !p.IsSetRequest() {
+		return UserServiceRegisterDeviceArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *UserServiceRegisterDeviceArgs) SetRequest(val *RegisterDeviceRequest) {
+	p.Request = val
+}
+
+func (p *UserServiceRegisterDeviceArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserServiceRegisterDeviceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterDeviceArgs(%+v)", *p)
+}
+
+func (p *UserServiceRegisterDeviceArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+type UserServiceRegisterDeviceResult struct {
+	Success *RegisterDeviceResponse `thrift:"success,0,optional" frugal:"0,optional,RegisterDeviceResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceRegisterDeviceResult() *UserServiceRegisterDeviceResult {
+	return &UserServiceRegisterDeviceResult{}
+}
+
+func (p *UserServiceRegisterDeviceResult) InitDefault() {
+}
+
+var UserServiceRegisterDeviceResult_Success_DEFAULT *RegisterDeviceResponse
+
+func (p *UserServiceRegisterDeviceResult) GetSuccess() (v *RegisterDeviceResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceRegisterDeviceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRegisterDeviceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RegisterDeviceResponse)
+}
+
+func (p *UserServiceRegisterDeviceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRegisterDeviceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterDeviceResult(%+v)", *p)
+}
+
+func (p *UserServiceRegisterDeviceResult) GetResult() interface{} {
+	return p.Success
+}
+
+type UserServiceUnregisterDeviceArgs struct {
+	Request *UnregisterDeviceRequest `thrift:"request,1" frugal:"1,default,UnregisterDeviceRequest" json:"request"`
+}
+
+func NewUserServiceUnregisterDeviceArgs() *UserServiceUnregisterDeviceArgs {
+	return &UserServiceUnregisterDeviceArgs{}
+}
+
+func (p *UserServiceUnregisterDeviceArgs) InitDefault() {
+}
+
+var UserServiceUnregisterDeviceArgs_Request_DEFAULT *UnregisterDeviceRequest
+
+func (p *UserServiceUnregisterDeviceArgs) GetRequest() (v *UnregisterDeviceRequest) {
+	if !p.IsSetRequest() {
+		return UserServiceUnregisterDeviceArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *UserServiceUnregisterDeviceArgs) SetRequest(val *UnregisterDeviceRequest) {
+	p.Request = val
+}
+
+func (p *UserServiceUnregisterDeviceArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserServiceUnregisterDeviceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUnregisterDeviceArgs(%+v)", *p)
+}
+
+func (p *UserServiceUnregisterDeviceArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+type UserServiceUnregisterDeviceResult struct {
+	Success *UnregisterDeviceResponse `thrift:"success,0,optional" frugal:"0,optional,UnregisterDeviceResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceUnregisterDeviceResult() *UserServiceUnregisterDeviceResult {
+	return &UserServiceUnregisterDeviceResult{}
+}
+
+func (p *UserServiceUnregisterDeviceResult) InitDefault() {
+}
+
+var UserServiceUnregisterDeviceResult_Success_DEFAULT *UnregisterDeviceResponse
+
+func (p *UserServiceUnregisterDeviceResult) GetSuccess() (v *UnregisterDeviceResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUnregisterDeviceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUnregisterDeviceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UnregisterDeviceResponse)
+}
+
+func (p *UserServiceUnregisterDeviceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUnregisterDeviceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUnregisterDeviceResult(%+v)", *p)
+}
+
+func (p *UserServiceUnregisterDeviceResult) GetResult() interface{} {
+	return p.Success
+}
+
+type UserServiceGetNotificationPreferenceArgs struct {
+	Request *GetNotificationPreferenceRequest `thrift:"request,1" frugal:"1,default,GetNotificationPreferenceRequest" json:"request"`
+}
+
+func NewUserServiceGetNotificationPreferenceArgs() *UserServiceGetNotificationPreferenceArgs {
+	return &UserServiceGetNotificationPreferenceArgs{}
+}
+
+func (p *UserServiceGetNotificationPreferenceArgs) InitDefault() {
+}
+
+var UserServiceGetNotificationPreferenceArgs_Request_DEFAULT *GetNotificationPreferenceRequest
+
+func (p *UserServiceGetNotificationPreferenceArgs) GetRequest() (v *GetNotificationPreferenceRequest) {
+	if !p.IsSetRequest() {
+		return UserServiceGetNotificationPreferenceArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *UserServiceGetNotificationPreferenceArgs) SetRequest(val *GetNotificationPreferenceRequest) {
+	p.Request = val
+}
+
+func (p *UserServiceGetNotificationPreferenceArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserServiceGetNotificationPreferenceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetNotificationPreferenceArgs(%+v)", *p)
+}
+
+func (p *UserServiceGetNotificationPreferenceArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+type UserServiceGetNotificationPreferenceResult struct {
+	Success *GetNotificationPreferenceResponse `thrift:"success,0,optional" frugal:"0,optional,GetNotificationPreferenceResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceGetNotificationPreferenceResult() *UserServiceGetNotificationPreferenceResult {
+	return &UserServiceGetNotificationPreferenceResult{}
+}
+
+func (p *UserServiceGetNotificationPreferenceResult) InitDefault() {
+}
+
+var UserServiceGetNotificationPreferenceResult_Success_DEFAULT *GetNotificationPreferenceResponse
+
+func (p *UserServiceGetNotificationPreferenceResult) GetSuccess() (v *GetNotificationPreferenceResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetNotificationPreferenceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetNotificationPreferenceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetNotificationPreferenceResponse)
+}
+
+func (p *UserServiceGetNotificationPreferenceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetNotificationPreferenceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetNotificationPreferenceResult(%+v)", *p)
+}
+
+func (p *UserServiceGetNotificationPreferenceResult) GetResult() interface{} {
+	return p.Success
+}
+
+type UserServiceUpdateNotificationPreferenceArgs struct {
+	Request *UpdateNotificationPreferenceRequest `thrift:"request,1" frugal:"1,default,UpdateNotificationPreferenceRequest" json:"request"`
+}
+
+func NewUserServiceUpdateNotificationPreferenceArgs() *UserServiceUpdateNotificationPreferenceArgs {
+	return &UserServiceUpdateNotificationPreferenceArgs{}
+}
+
+func (p *UserServiceUpdateNotificationPreferenceArgs) InitDefault() {
+}
+
+var UserServiceUpdateNotificationPreferenceArgs_Request_DEFAULT *UpdateNotificationPreferenceRequest
+
+func (p *UserServiceUpdateNotificationPreferenceArgs) GetRequest() (v *UpdateNotificationPreferenceRequest) {
+	if !p.IsSetRequest() {
+		return UserServiceUpdateNotificationPreferenceArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *UserServiceUpdateNotificationPreferenceArgs) SetRequest(val *UpdateNotificationPreferenceRequest) {
+	p.Request = val
+}
+
+func (p *UserServiceUpdateNotificationPreferenceArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserServiceUpdateNotificationPreferenceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateNotificationPreferenceArgs(%+v)", *p)
+}
+
+func (p *UserServiceUpdateNotificationPreferenceArgs) GetFirstArgument() interface{} {
+	return p.Request
+}
+
+type UserServiceUpdateNotificationPreferenceResult struct {
+	Success *UpdateNotificationPreferenceResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateNotificationPreferenceResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateNotificationPreferenceResult() *UserServiceUpdateNotificationPreferenceResult {
+	return &UserServiceUpdateNotificationPreferenceResult{}
+}
+
+func (p *UserServiceUpdateNotificationPreferenceResult) InitDefault() {
+}
+
+var UserServiceUpdateNotificationPreferenceResult_Success_DEFAULT *UpdateNotificationPreferenceResponse
+
+func (p *UserServiceUpdateNotificationPreferenceResult) GetSuccess() (v *UpdateNotificationPreferenceResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateNotificationPreferenceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateNotificationPreferenceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateNotificationPreferenceResponse)
+}
+
+func (p *UserServiceUpdateNotificationPreferenceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateNotificationPreferenceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateNotificationPreferenceResult(%+v)", *p)
+}
+
+func (p *UserServiceUpdateNotificationPreferenceResult) GetResult() interface{} {
+	return p.Success
+}
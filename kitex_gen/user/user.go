@@ -782,6 +782,318 @@ func (p *ReorderFriendListResponse) String() string {
 	return fmt.Sprintf("ReorderFriendListResponse(%+v)", *p)
 }
 
+type RegisterDeviceRequest struct {
+	DeviceToken string  `thrift:"device_token,1,required" frugal:"1,required,string" json:"device_token"`
+	Platform    string  `thrift:"platform,2,required" frugal:"2,required,string" json:"platform"`
+	AppVersion  *string `thrift:"app_version,3,optional" frugal:"3,optional,string" json:"app_version,omitempty"`
+}
+
+func NewRegisterDeviceRequest() *RegisterDeviceRequest {
+	return &RegisterDeviceRequest{}
+}
+
+func (p *RegisterDeviceRequest) InitDefault() {
+}
+
+func (p *RegisterDeviceRequest) GetDeviceToken() (v string) {
+	return p.DeviceToken
+}
+
+func (p *RegisterDeviceRequest) GetPlatform() (v string) {
+	return p.Platform
+}
+
+var RegisterDeviceRequest_AppVersion_DEFAULT string
+
+func (p *RegisterDeviceRequest) GetAppVersion() (v string) {
+	if !p.IsSetAppVersion() {
+		return RegisterDeviceRequest_AppVersion_DEFAULT
+	}
+	return *p.AppVersion
+}
+func (p *RegisterDeviceRequest) SetDeviceToken(val string) {
+	p.DeviceToken = val
+}
+func (p *RegisterDeviceRequest) SetPlatform(val string) {
+	p.Platform = val
+}
+func (p *RegisterDeviceRequest) SetAppVersion(val *string) {
+	p.AppVersion = val
+}
+
+func (p *RegisterDeviceRequest) IsSetAppVersion() bool {
+	return p.AppVersion != nil
+}
+
+func (p *RegisterDeviceRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RegisterDeviceRequest(%+v)", *p)
+}
+
+type RegisterDeviceResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewRegisterDeviceResponse() *RegisterDeviceResponse {
+	return &RegisterDeviceResponse{}
+}
+
+func (p *RegisterDeviceResponse) InitDefault() {
+}
+
+var RegisterDeviceResponse_Base_DEFAULT *model.BaseResp
+
+func (p *RegisterDeviceResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RegisterDeviceResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RegisterDeviceResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *RegisterDeviceResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RegisterDeviceResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RegisterDeviceResponse(%+v)", *p)
+}
+
+type UnregisterDeviceRequest struct {
+	DeviceToken string `thrift:"device_token,1,required" frugal:"1,required,string" json:"device_token"`
+}
+
+func NewUnregisterDeviceRequest() *UnregisterDeviceRequest {
+	return &UnregisterDeviceRequest{}
+}
+
+func (p *UnregisterDeviceRequest) InitDefault() {
+}
+
+func (p *UnregisterDeviceRequest) GetDeviceToken() (v string) {
+	return p.DeviceToken
+}
+func (p *UnregisterDeviceRequest) SetDeviceToken(val string) {
+	p.DeviceToken = val
+}
+
+func (p *UnregisterDeviceRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnregisterDeviceRequest(%+v)", *p)
+}
+
+type UnregisterDeviceResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewUnregisterDeviceResponse() *UnregisterDeviceResponse {
+	return &UnregisterDeviceResponse{}
+}
+
+func (p *UnregisterDeviceResponse) InitDefault() {
+}
+
+var UnregisterDeviceResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UnregisterDeviceResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UnregisterDeviceResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UnregisterDeviceResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UnregisterDeviceResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UnregisterDeviceResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnregisterDeviceResponse(%+v)", *p)
+}
+
+type GetNotificationPreferenceRequest struct {
+}
+
+func NewGetNotificationPreferenceRequest() *GetNotificationPreferenceRequest {
+	return &GetNotificationPreferenceRequest{}
+}
+
+func (p *GetNotificationPreferenceRequest) InitDefault() {
+}
+
+func (p *GetNotificationPreferenceRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationPreferenceRequest(%+v)", *p)
+}
+
+type GetNotificationPreferenceResponse struct {
+	Base *model.BaseResp               `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+	Data *model.NotificationPreference `thrift:"data,2,required" frugal:"2,required,model.NotificationPreference" json:"data"`
+}
+
+func NewGetNotificationPreferenceResponse() *GetNotificationPreferenceResponse {
+	return &GetNotificationPreferenceResponse{}
+}
+
+func (p *GetNotificationPreferenceResponse) InitDefault() {
+}
+
+var GetNotificationPreferenceResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetNotificationPreferenceResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetNotificationPreferenceResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetNotificationPreferenceResponse_Data_DEFAULT *model.NotificationPreference
+
+func (p *GetNotificationPreferenceResponse) GetData() (v *model.NotificationPreference) {
+	if !p.IsSetData() {
+		return GetNotificationPreferenceResponse_Data_DEFAULT
+	}
+	return p.Data
+}
+func (p *GetNotificationPreferenceResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetNotificationPreferenceResponse) SetData(val *model.NotificationPreference) {
+	p.Data = val
+}
+
+func (p *GetNotificationPreferenceResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetNotificationPreferenceResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *GetNotificationPreferenceResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationPreferenceResponse(%+v)", *p)
+}
+
+type UpdateNotificationPreferenceRequest struct {
+	DisabledTypes []string `thrift:"disabled_types,1,required" frugal:"1,required,list<string>" json:"disabled_types"`
+	Channel       string   `thrift:"channel,2,required" frugal:"2,required,string" json:"channel"`
+	QuietStart    *string  `thrift:"quiet_start,3,optional" frugal:"3,optional,string" json:"quiet_start,omitempty"`
+	QuietEnd      *string  `thrift:"quiet_end,4,optional" frugal:"4,optional,string" json:"quiet_end,omitempty"`
+}
+
+func NewUpdateNotificationPreferenceRequest() *UpdateNotificationPreferenceRequest {
+	return &UpdateNotificationPreferenceRequest{}
+}
+
+func (p *UpdateNotificationPreferenceRequest) InitDefault() {
+}
+
+func (p *UpdateNotificationPreferenceRequest) GetDisabledTypes() (v []string) {
+	return p.DisabledTypes
+}
+
+func (p *UpdateNotificationPreferenceRequest) GetChannel() (v string) {
+	return p.Channel
+}
+
+var UpdateNotificationPreferenceRequest_QuietStart_DEFAULT string
+
+func (p *UpdateNotificationPreferenceRequest) GetQuietStart() (v string) {
+	if !p.IsSetQuietStart() {
+		return UpdateNotificationPreferenceRequest_QuietStart_DEFAULT
+	}
+	return *p.QuietStart
+}
+
+var UpdateNotificationPreferenceRequest_QuietEnd_DEFAULT string
+
+func (p *UpdateNotificationPreferenceRequest) GetQuietEnd() (v string) {
+	if !p.IsSetQuietEnd() {
+		return UpdateNotificationPreferenceRequest_QuietEnd_DEFAULT
+	}
+	return *p.QuietEnd
+}
+func (p *UpdateNotificationPreferenceRequest) SetDisabledTypes(val []string) {
+	p.DisabledTypes = val
+}
+func (p *UpdateNotificationPreferenceRequest) SetChannel(val string) {
+	p.Channel = val
+}
+func (p *UpdateNotificationPreferenceRequest) SetQuietStart(val *string) {
+	p.QuietStart = val
+}
+func (p *UpdateNotificationPreferenceRequest) SetQuietEnd(val *string) {
+	p.QuietEnd = val
+}
+
+func (p *UpdateNotificationPreferenceRequest) IsSetQuietStart() bool {
+	return p.QuietStart != nil
+}
+
+func (p *UpdateNotificationPreferenceRequest) IsSetQuietEnd() bool {
+	return p.QuietEnd != nil
+}
+
+func (p *UpdateNotificationPreferenceRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNotificationPreferenceRequest(%+v)", *p)
+}
+
+type UpdateNotificationPreferenceResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" frugal:"1,required,model.BaseResp" json:"base"`
+}
+
+func NewUpdateNotificationPreferenceResponse() *UpdateNotificationPreferenceResponse {
+	return &UpdateNotificationPreferenceResponse{}
+}
+
+func (p *UpdateNotificationPreferenceResponse) InitDefault() {
+}
+
+var UpdateNotificationPreferenceResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UpdateNotificationPreferenceResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateNotificationPreferenceResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateNotificationPreferenceResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UpdateNotificationPreferenceResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateNotificationPreferenceResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNotificationPreferenceResponse(%+v)", *p)
+}
+
 type UserService interface {
 	GetLoginData(ctx context.Context, req *GetLoginDataRequest) (r *GetLoginDataResponse, err error)
 
@@ -804,4 +1116,12 @@ type UserService interface {
 	GetFriendMaxNum(ctx context.Context, request *GetFriendMaxNumRequest) (r *GetFriendMaxNumResponse, err error)
 
 	ReorderFriendList(ctx context.Context, request *ReorderFriendListRequest) (r *ReorderFriendListResponse, err error)
+
+	RegisterDevice(ctx context.Context, request *RegisterDeviceRequest) (r *RegisterDeviceResponse, err error)
+
+	UnregisterDevice(ctx context.Context, request *UnregisterDeviceRequest) (r *UnregisterDeviceResponse, err error)
+
+	GetNotificationPreference(ctx context.Context, request *GetNotificationPreferenceRequest) (r *GetNotificationPreferenceResponse, err error)
+
+	UpdateNotificationPreference(ctx context.Context, request *UpdateNotificationPreferenceRequest) (r *UpdateNotificationPreferenceResponse, err error)
 }
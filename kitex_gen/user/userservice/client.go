@@ -40,6 +40,10 @@ type Client interface {
 	CancelInvite(ctx context.Context, request *user.CancelInviteRequest, callOptions ...callopt.Option) (r *user.CancelInviteResponse, err error)
 	GetFriendMaxNum(ctx context.Context, request *user.GetFriendMaxNumRequest, callOptions ...callopt.Option) (r *user.GetFriendMaxNumResponse, err error)
 	ReorderFriendList(ctx context.Context, request *user.ReorderFriendListRequest, callOptions ...callopt.Option) (r *user.ReorderFriendListResponse, err error)
+	RegisterDevice(ctx context.Context, request *user.RegisterDeviceRequest, callOptions ...callopt.Option) (r *user.RegisterDeviceResponse, err error)
+	UnregisterDevice(ctx context.Context, request *user.UnregisterDeviceRequest, callOptions ...callopt.Option) (r *user.UnregisterDeviceResponse, err error)
+	GetNotificationPreference(ctx context.Context, request *user.GetNotificationPreferenceRequest, callOptions ...callopt.Option) (r *user.GetNotificationPreferenceResponse, err error)
+	UpdateNotificationPreference(ctx context.Context, request *user.UpdateNotificationPreferenceRequest, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferenceResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -125,3 +129,23 @@ func (p *kUserServiceClient) ReorderFriendList(ctx context.Context, request *use
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ReorderFriendList(ctx, request)
 }
+
+func (p *kUserServiceClient) RegisterDevice(ctx context.Context, request *user.RegisterDeviceRequest, callOptions ...callopt.Option) (r *user.RegisterDeviceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RegisterDevice(ctx, request)
+}
+
+func (p *kUserServiceClient) UnregisterDevice(ctx context.Context, request *user.UnregisterDeviceRequest, callOptions ...callopt.Option) (r *user.UnregisterDeviceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnregisterDevice(ctx, request)
+}
+
+func (p *kUserServiceClient) GetNotificationPreference(ctx context.Context, request *user.GetNotificationPreferenceRequest, callOptions ...callopt.Option) (r *user.GetNotificationPreferenceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNotificationPreference(ctx, request)
+}
+
+func (p *kUserServiceClient) UpdateNotificationPreference(ctx context.Context, request *user.UpdateNotificationPreferenceRequest, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferenceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateNotificationPreference(ctx, request)
+}
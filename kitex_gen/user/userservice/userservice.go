@@ -108,6 +108,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"RegisterDevice": kitex.NewMethodInfo(
+		registerDeviceHandler,
+		newUserServiceRegisterDeviceArgs,
+		newUserServiceRegisterDeviceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UnregisterDevice": kitex.NewMethodInfo(
+		unregisterDeviceHandler,
+		newUserServiceUnregisterDeviceArgs,
+		newUserServiceUnregisterDeviceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetNotificationPreference": kitex.NewMethodInfo(
+		getNotificationPreferenceHandler,
+		newUserServiceGetNotificationPreferenceArgs,
+		newUserServiceGetNotificationPreferenceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateNotificationPreference": kitex.NewMethodInfo(
+		updateNotificationPreferenceHandler,
+		newUserServiceUpdateNotificationPreferenceArgs,
+		newUserServiceUpdateNotificationPreferenceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -372,6 +400,78 @@ func newUserServiceReorderFriendListResult() interface{} {
 	return user.NewUserServiceReorderFriendListResult()
 }
 
+func registerDeviceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRegisterDeviceArgs)
+	realResult := result.(*user.UserServiceRegisterDeviceResult)
+	success, err := handler.(user.UserService).RegisterDevice(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRegisterDeviceArgs() interface{} {
+	return user.NewUserServiceRegisterDeviceArgs()
+}
+
+func newUserServiceRegisterDeviceResult() interface{} {
+	return user.NewUserServiceRegisterDeviceResult()
+}
+
+func unregisterDeviceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUnregisterDeviceArgs)
+	realResult := result.(*user.UserServiceUnregisterDeviceResult)
+	success, err := handler.(user.UserService).UnregisterDevice(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUnregisterDeviceArgs() interface{} {
+	return user.NewUserServiceUnregisterDeviceArgs()
+}
+
+func newUserServiceUnregisterDeviceResult() interface{} {
+	return user.NewUserServiceUnregisterDeviceResult()
+}
+
+func getNotificationPreferenceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetNotificationPreferenceArgs)
+	realResult := result.(*user.UserServiceGetNotificationPreferenceResult)
+	success, err := handler.(user.UserService).GetNotificationPreference(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetNotificationPreferenceArgs() interface{} {
+	return user.NewUserServiceGetNotificationPreferenceArgs()
+}
+
+func newUserServiceGetNotificationPreferenceResult() interface{} {
+	return user.NewUserServiceGetNotificationPreferenceResult()
+}
+
+func updateNotificationPreferenceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUpdateNotificationPreferenceArgs)
+	realResult := result.(*user.UserServiceUpdateNotificationPreferenceResult)
+	success, err := handler.(user.UserService).UpdateNotificationPreference(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdateNotificationPreferenceArgs() interface{} {
+	return user.NewUserServiceUpdateNotificationPreferenceArgs()
+}
+
+func newUserServiceUpdateNotificationPreferenceResult() interface{} {
+	return user.NewUserServiceUpdateNotificationPreferenceResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -491,3 +591,43 @@ func (p *kClient) ReorderFriendList(ctx context.Context, request *user.ReorderFr
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) RegisterDevice(ctx context.Context, request *user.RegisterDeviceRequest) (r *user.RegisterDeviceResponse, err error) {
+	var _args user.UserServiceRegisterDeviceArgs
+	_args.Request = request
+	var _result user.UserServiceRegisterDeviceResult
+	if err = p.c.Call(ctx, "RegisterDevice", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnregisterDevice(ctx context.Context, request *user.UnregisterDeviceRequest) (r *user.UnregisterDeviceResponse, err error) {
+	var _args user.UserServiceUnregisterDeviceArgs
+	_args.Request = request
+	var _result user.UserServiceUnregisterDeviceResult
+	if err = p.c.Call(ctx, "UnregisterDevice", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNotificationPreference(ctx context.Context, request *user.GetNotificationPreferenceRequest) (r *user.GetNotificationPreferenceResponse, err error) {
+	var _args user.UserServiceGetNotificationPreferenceArgs
+	_args.Request = request
+	var _result user.UserServiceGetNotificationPreferenceResult
+	if err = p.c.Call(ctx, "GetNotificationPreference", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateNotificationPreference(ctx context.Context, request *user.UpdateNotificationPreferenceRequest) (r *user.UpdateNotificationPreferenceResponse, err error) {
+	var _args user.UserServiceUpdateNotificationPreferenceArgs
+	_args.Request = request
+	var _result user.UserServiceUpdateNotificationPreferenceResult
+	if err = p.c.Call(ctx, "UpdateNotificationPreference", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -1029,3 +1029,459 @@ func (p *VersionServiceAndroidGetVersionResult) String() string {
 func (p *VersionServiceAndroidGetVersionResult) GetResult() interface{} {
 	return p.Success
 }
+
+type VersionServiceRollbackVersionArgs struct {
+	Req *RollbackVersionRequest `thrift:"req,1" frugal:"1,default,RollbackVersionRequest" json:"req"`
+}
+
+func NewVersionServiceRollbackVersionArgs() *VersionServiceRollbackVersionArgs {
+	return &VersionServiceRollbackVersionArgs{}
+}
+
+func (p *VersionServiceRollbackVersionArgs) InitDefault() {
+}
+
+var VersionServiceRollbackVersionArgs_Req_DEFAULT *RollbackVersionRequest
+
+func (p *VersionServiceRollbackVersionArgs) GetReq() (v *RollbackVersionRequest) {
+	if !p.IsSetReq() {
+		return VersionServiceRollbackVersionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VersionServiceRollbackVersionArgs) SetReq(val *RollbackVersionRequest) {
+	p.Req = val
+}
+
+func (p *VersionServiceRollbackVersionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VersionServiceRollbackVersionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceRollbackVersionArgs(%+v)", *p)
+}
+
+func (p *VersionServiceRollbackVersionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VersionServiceRollbackVersionResult struct {
+	Success *RollbackVersionResponse `thrift:"success,0,optional" frugal:"0,optional,RollbackVersionResponse" json:"success,omitempty"`
+}
+
+func NewVersionServiceRollbackVersionResult() *VersionServiceRollbackVersionResult {
+	return &VersionServiceRollbackVersionResult{}
+}
+
+func (p *VersionServiceRollbackVersionResult) InitDefault() {
+}
+
+var VersionServiceRollbackVersionResult_Success_DEFAULT *RollbackVersionResponse
+
+func (p *VersionServiceRollbackVersionResult) GetSuccess() (v *RollbackVersionResponse) {
+	if !p.IsSetSuccess() {
+		return VersionServiceRollbackVersionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VersionServiceRollbackVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RollbackVersionResponse)
+}
+
+func (p *VersionServiceRollbackVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VersionServiceRollbackVersionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceRollbackVersionResult(%+v)", *p)
+}
+
+func (p *VersionServiceRollbackVersionResult) GetResult() interface{} {
+	return p.Success
+}
+
+type VersionServiceListVersionsArgs struct {
+	Req *ListVersionsRequest `thrift:"req,1" frugal:"1,default,ListVersionsRequest" json:"req"`
+}
+
+func NewVersionServiceListVersionsArgs() *VersionServiceListVersionsArgs {
+	return &VersionServiceListVersionsArgs{}
+}
+
+func (p *VersionServiceListVersionsArgs) InitDefault() {
+}
+
+var VersionServiceListVersionsArgs_Req_DEFAULT *ListVersionsRequest
+
+func (p *VersionServiceListVersionsArgs) GetReq() (v *ListVersionsRequest) {
+	if !p.IsSetReq() {
+		return VersionServiceListVersionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VersionServiceListVersionsArgs) SetReq(val *ListVersionsRequest) {
+	p.Req = val
+}
+
+func (p *VersionServiceListVersionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VersionServiceListVersionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceListVersionsArgs(%+v)", *p)
+}
+
+func (p *VersionServiceListVersionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VersionServiceListVersionsResult struct {
+	Success *ListVersionsResponse `thrift:"success,0,optional" frugal:"0,optional,ListVersionsResponse" json:"success,omitempty"`
+}
+
+func NewVersionServiceListVersionsResult() *VersionServiceListVersionsResult {
+	return &VersionServiceListVersionsResult{}
+}
+
+func (p *VersionServiceListVersionsResult) InitDefault() {
+}
+
+var VersionServiceListVersionsResult_Success_DEFAULT *ListVersionsResponse
+
+func (p *VersionServiceListVersionsResult) GetSuccess() (v *ListVersionsResponse) {
+	if !p.IsSetSuccess() {
+		return VersionServiceListVersionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VersionServiceListVersionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListVersionsResponse)
+}
+
+func (p *VersionServiceListVersionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VersionServiceListVersionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceListVersionsResult(%+v)", *p)
+}
+
+func (p *VersionServiceListVersionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+type VersionServiceGetVersionChangelogArgs struct {
+	Req *GetVersionChangelogRequest `thrift:"req,1" frugal:"1,default,GetVersionChangelogRequest" json:"req"`
+}
+
+func NewVersionServiceGetVersionChangelogArgs() *VersionServiceGetVersionChangelogArgs {
+	return &VersionServiceGetVersionChangelogArgs{}
+}
+
+func (p *VersionServiceGetVersionChangelogArgs) InitDefault() {
+}
+
+var VersionServiceGetVersionChangelogArgs_Req_DEFAULT *GetVersionChangelogRequest
+
+func (p *VersionServiceGetVersionChangelogArgs) GetReq() (v *GetVersionChangelogRequest) {
+	if !p.IsSetReq() {
+		return VersionServiceGetVersionChangelogArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VersionServiceGetVersionChangelogArgs) SetReq(val *GetVersionChangelogRequest) {
+	p.Req = val
+}
+
+func (p *VersionServiceGetVersionChangelogArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VersionServiceGetVersionChangelogArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceGetVersionChangelogArgs(%+v)", *p)
+}
+
+func (p *VersionServiceGetVersionChangelogArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VersionServiceGetVersionChangelogResult struct {
+	Success *GetVersionChangelogResponse `thrift:"success,0,optional" frugal:"0,optional,GetVersionChangelogResponse" json:"success,omitempty"`
+}
+
+func NewVersionServiceGetVersionChangelogResult() *VersionServiceGetVersionChangelogResult {
+	return &VersionServiceGetVersionChangelogResult{}
+}
+
+func (p *VersionServiceGetVersionChangelogResult) InitDefault() {
+}
+
+var VersionServiceGetVersionChangelogResult_Success_DEFAULT *GetVersionChangelogResponse
+
+func (p *VersionServiceGetVersionChangelogResult) GetSuccess() (v *GetVersionChangelogResponse) {
+	if !p.IsSetSuccess() {
+		return VersionServiceGetVersionChangelogResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VersionServiceGetVersionChangelogResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetVersionChangelogResponse)
+}
+
+func (p *VersionServiceGetVersionChangelogResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VersionServiceGetVersionChangelogResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceGetVersionChangelogResult(%+v)", *p)
+}
+
+func (p *VersionServiceGetVersionChangelogResult) GetResult() interface{} {
+	return p.Success
+}
+
+type VersionServiceGetLatestVersionArgs struct {
+	Req *GetLatestVersionRequest `thrift:"req,1" frugal:"1,default,GetLatestVersionRequest" json:"req"`
+}
+
+func NewVersionServiceGetLatestVersionArgs() *VersionServiceGetLatestVersionArgs {
+	return &VersionServiceGetLatestVersionArgs{}
+}
+
+func (p *VersionServiceGetLatestVersionArgs) InitDefault() {
+}
+
+var VersionServiceGetLatestVersionArgs_Req_DEFAULT *GetLatestVersionRequest
+
+func (p *VersionServiceGetLatestVersionArgs) GetReq() (v *GetLatestVersionRequest) {
+	if !p.IsSetReq() {
+		return VersionServiceGetLatestVersionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VersionServiceGetLatestVersionArgs) SetReq(val *GetLatestVersionRequest) {
+	p.Req = val
+}
+
+func (p *VersionServiceGetLatestVersionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VersionServiceGetLatestVersionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceGetLatestVersionArgs(%+v)", *p)
+}
+
+func (p *VersionServiceGetLatestVersionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VersionServiceGetLatestVersionResult struct {
+	Success *GetLatestVersionResponse `thrift:"success,0,optional" frugal:"0,optional,GetLatestVersionResponse" json:"success,omitempty"`
+}
+
+func NewVersionServiceGetLatestVersionResult() *VersionServiceGetLatestVersionResult {
+	return &VersionServiceGetLatestVersionResult{}
+}
+
+func (p *VersionServiceGetLatestVersionResult) InitDefault() {
+}
+
+var VersionServiceGetLatestVersionResult_Success_DEFAULT *GetLatestVersionResponse
+
+func (p *VersionServiceGetLatestVersionResult) GetSuccess() (v *GetLatestVersionResponse) {
+	if !p.IsSetSuccess() {
+		return VersionServiceGetLatestVersionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VersionServiceGetLatestVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetLatestVersionResponse)
+}
+
+func (p *VersionServiceGetLatestVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VersionServiceGetLatestVersionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceGetLatestVersionResult(%+v)", *p)
+}
+
+func (p *VersionServiceGetLatestVersionResult) GetResult() interface{} {
+	return p.Success
+}
+
+type VersionServiceDryRunCloudSettingArgs struct {
+	Req *DryRunCloudSettingRequest `thrift:"req,1" frugal:"1,default,DryRunCloudSettingRequest" json:"req"`
+}
+
+func NewVersionServiceDryRunCloudSettingArgs() *VersionServiceDryRunCloudSettingArgs {
+	return &VersionServiceDryRunCloudSettingArgs{}
+}
+
+func (p *VersionServiceDryRunCloudSettingArgs) InitDefault() {
+}
+
+var VersionServiceDryRunCloudSettingArgs_Req_DEFAULT *DryRunCloudSettingRequest
+
+func (p *VersionServiceDryRunCloudSettingArgs) GetReq() (v *DryRunCloudSettingRequest) {
+	if !p.IsSetReq() {
+		return VersionServiceDryRunCloudSettingArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VersionServiceDryRunCloudSettingArgs) SetReq(val *DryRunCloudSettingRequest) {
+	p.Req = val
+}
+
+func (p *VersionServiceDryRunCloudSettingArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VersionServiceDryRunCloudSettingArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceDryRunCloudSettingArgs(%+v)", *p)
+}
+
+func (p *VersionServiceDryRunCloudSettingArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VersionServiceDryRunCloudSettingResult struct {
+	Success *DryRunCloudSettingResponse `thrift:"success,0,optional" frugal:"0,optional,DryRunCloudSettingResponse" json:"success,omitempty"`
+}
+
+func NewVersionServiceDryRunCloudSettingResult() *VersionServiceDryRunCloudSettingResult {
+	return &VersionServiceDryRunCloudSettingResult{}
+}
+
+func (p *VersionServiceDryRunCloudSettingResult) InitDefault() {
+}
+
+var VersionServiceDryRunCloudSettingResult_Success_DEFAULT *DryRunCloudSettingResponse
+
+func (p *VersionServiceDryRunCloudSettingResult) GetSuccess() (v *DryRunCloudSettingResponse) {
+	if !p.IsSetSuccess() {
+		return VersionServiceDryRunCloudSettingResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VersionServiceDryRunCloudSettingResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DryRunCloudSettingResponse)
+}
+
+func (p *VersionServiceDryRunCloudSettingResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VersionServiceDryRunCloudSettingResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceDryRunCloudSettingResult(%+v)", *p)
+}
+
+func (p *VersionServiceDryRunCloudSettingResult) GetResult() interface{} {
+	return p.Success
+}
+
+type VersionServiceGetCloudSettingCoverageArgs struct {
+	Req *GetCloudSettingCoverageRequest `thrift:"req,1" frugal:"1,default,GetCloudSettingCoverageRequest" json:"req"`
+}
+
+func NewVersionServiceGetCloudSettingCoverageArgs() *VersionServiceGetCloudSettingCoverageArgs {
+	return &VersionServiceGetCloudSettingCoverageArgs{}
+}
+
+func (p *VersionServiceGetCloudSettingCoverageArgs) InitDefault() {
+}
+
+var VersionServiceGetCloudSettingCoverageArgs_Req_DEFAULT *GetCloudSettingCoverageRequest
+
+func (p *VersionServiceGetCloudSettingCoverageArgs) GetReq() (v *GetCloudSettingCoverageRequest) {
+	if !p.IsSetReq() {
+		return VersionServiceGetCloudSettingCoverageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VersionServiceGetCloudSettingCoverageArgs) SetReq(val *GetCloudSettingCoverageRequest) {
+	p.Req = val
+}
+
+func (p *VersionServiceGetCloudSettingCoverageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VersionServiceGetCloudSettingCoverageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceGetCloudSettingCoverageArgs(%+v)", *p)
+}
+
+func (p *VersionServiceGetCloudSettingCoverageArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VersionServiceGetCloudSettingCoverageResult struct {
+	Success *GetCloudSettingCoverageResponse `thrift:"success,0,optional" frugal:"0,optional,GetCloudSettingCoverageResponse" json:"success,omitempty"`
+}
+
+func NewVersionServiceGetCloudSettingCoverageResult() *VersionServiceGetCloudSettingCoverageResult {
+	return &VersionServiceGetCloudSettingCoverageResult{}
+}
+
+func (p *VersionServiceGetCloudSettingCoverageResult) InitDefault() {
+}
+
+var VersionServiceGetCloudSettingCoverageResult_Success_DEFAULT *GetCloudSettingCoverageResponse
+
+func (p *VersionServiceGetCloudSettingCoverageResult) GetSuccess() (v *GetCloudSettingCoverageResponse) {
+	if !p.IsSetSuccess() {
+		return VersionServiceGetCloudSettingCoverageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VersionServiceGetCloudSettingCoverageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCloudSettingCoverageResponse)
+}
+
+func (p *VersionServiceGetCloudSettingCoverageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VersionServiceGetCloudSettingCoverageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionServiceGetCloudSettingCoverageResult(%+v)", *p)
+}
+
+func (p *VersionServiceGetCloudSettingCoverageResult) GetResult() interface{} {
+	return p.Success
+}
@@ -85,13 +85,16 @@ func (p *LoginResponse) String() string {
 }
 
 type UploadRequest struct {
-	Version  string `thrift:"version,1,required" frugal:"1,required,string" json:"version"`
-	Code     string `thrift:"code,2,required" frugal:"2,required,string" json:"code"`
-	Url      string `thrift:"url,3,required" frugal:"3,required,string" json:"url"`
-	Feature  string `thrift:"feature,4,required" frugal:"4,required,string" json:"feature"`
-	Type     string `thrift:"type,5,required" frugal:"5,required,string" json:"type"`
-	Password string `thrift:"password,6,required" frugal:"6,required,string" json:"password"`
-	Force    bool   `thrift:"force,7,required" frugal:"7,required,bool" json:"force"`
+	Version          string  `thrift:"version,1,required" frugal:"1,required,string" json:"version"`
+	Code             string  `thrift:"code,2,required" frugal:"2,required,string" json:"code"`
+	Url              string  `thrift:"url,3,required" frugal:"3,required,string" json:"url"`
+	Feature          string  `thrift:"feature,4,required" frugal:"4,required,string" json:"feature"`
+	Type             string  `thrift:"type,5,required" frugal:"5,required,string" json:"type"`
+	Password         string  `thrift:"password,6,required" frugal:"6,required,string" json:"password"`
+	Force            bool    `thrift:"force,7,required" frugal:"7,required,bool" json:"force"`
+	Platform         *string `thrift:"platform,8,optional" frugal:"8,optional,string" json:"platform,omitempty"`
+	RolloutPercent   *int64  `thrift:"rollout_percent,9,optional" frugal:"9,optional,i64" json:"rollout_percent,omitempty"`
+	MinSupportedCode *int64  `thrift:"min_supported_code,10,optional" frugal:"10,optional,i64" json:"min_supported_code,omitempty"`
 }
 
 func NewUploadRequest() *UploadRequest {
@@ -128,6 +131,33 @@ func (p *UploadRequest) GetPassword() (v string) {
 func (p *UploadRequest) GetForce() (v bool) {
 	return p.Force
 }
+
+var UploadRequest_Platform_DEFAULT string
+
+func (p *UploadRequest) GetPlatform() (v string) {
+	if !p.IsSetPlatform() {
+		return UploadRequest_Platform_DEFAULT
+	}
+	return *p.Platform
+}
+
+var UploadRequest_RolloutPercent_DEFAULT int64
+
+func (p *UploadRequest) GetRolloutPercent() (v int64) {
+	if !p.IsSetRolloutPercent() {
+		return UploadRequest_RolloutPercent_DEFAULT
+	}
+	return *p.RolloutPercent
+}
+
+var UploadRequest_MinSupportedCode_DEFAULT int64
+
+func (p *UploadRequest) GetMinSupportedCode() (v int64) {
+	if !p.IsSetMinSupportedCode() {
+		return UploadRequest_MinSupportedCode_DEFAULT
+	}
+	return *p.MinSupportedCode
+}
 func (p *UploadRequest) SetVersion(val string) {
 	p.Version = val
 }
@@ -149,6 +179,27 @@ func (p *UploadRequest) SetPassword(val string) {
 func (p *UploadRequest) SetForce(val bool) {
 	p.Force = val
 }
+func (p *UploadRequest) SetPlatform(val *string) {
+	p.Platform = val
+}
+func (p *UploadRequest) SetRolloutPercent(val *int64) {
+	p.RolloutPercent = val
+}
+func (p *UploadRequest) SetMinSupportedCode(val *int64) {
+	p.MinSupportedCode = val
+}
+
+func (p *UploadRequest) IsSetPlatform() bool {
+	return p.Platform != nil
+}
+
+func (p *UploadRequest) IsSetRolloutPercent() bool {
+	return p.RolloutPercent != nil
+}
+
+func (p *UploadRequest) IsSetMinSupportedCode() bool {
+	return p.MinSupportedCode != nil
+}
 
 func (p *UploadRequest) String() string {
 	if p == nil {
@@ -403,6 +454,9 @@ func (p *DownloadBetaApkResponse) String() string {
 }
 
 type GetReleaseVersionRequest struct {
+	DeviceId *string `thrift:"device_id,1,optional" frugal:"1,optional,string" json:"device_id,omitempty"`
+	StuId    *string `thrift:"stu_id,2,optional" frugal:"2,optional,string" json:"stu_id,omitempty"`
+	Code     *string `thrift:"code,3,optional" frugal:"3,optional,string" json:"code,omitempty"`
 }
 
 func NewGetReleaseVersionRequest() *GetReleaseVersionRequest {
@@ -412,6 +466,54 @@ func NewGetReleaseVersionRequest() *GetReleaseVersionRequest {
 func (p *GetReleaseVersionRequest) InitDefault() {
 }
 
+var GetReleaseVersionRequest_DeviceId_DEFAULT string
+
+func (p *GetReleaseVersionRequest) GetDeviceId() (v string) {
+	if !p.IsSetDeviceId() {
+		return GetReleaseVersionRequest_DeviceId_DEFAULT
+	}
+	return *p.DeviceId
+}
+
+var GetReleaseVersionRequest_StuId_DEFAULT string
+
+func (p *GetReleaseVersionRequest) GetStuId() (v string) {
+	if !p.IsSetStuId() {
+		return GetReleaseVersionRequest_StuId_DEFAULT
+	}
+	return *p.StuId
+}
+
+var GetReleaseVersionRequest_Code_DEFAULT string
+
+func (p *GetReleaseVersionRequest) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return GetReleaseVersionRequest_Code_DEFAULT
+	}
+	return *p.Code
+}
+func (p *GetReleaseVersionRequest) SetDeviceId(val *string) {
+	p.DeviceId = val
+}
+func (p *GetReleaseVersionRequest) SetStuId(val *string) {
+	p.StuId = val
+}
+func (p *GetReleaseVersionRequest) SetCode(val *string) {
+	p.Code = val
+}
+
+func (p *GetReleaseVersionRequest) IsSetDeviceId() bool {
+	return p.DeviceId != nil
+}
+
+func (p *GetReleaseVersionRequest) IsSetStuId() bool {
+	return p.StuId != nil
+}
+
+func (p *GetReleaseVersionRequest) IsSetCode() bool {
+	return p.Code != nil
+}
+
 func (p *GetReleaseVersionRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -539,6 +641,9 @@ func (p *GetReleaseVersionResponse) String() string {
 }
 
 type GetBetaVersionRequest struct {
+	DeviceId *string `thrift:"device_id,1,optional" frugal:"1,optional,string" json:"device_id,omitempty"`
+	StuId    *string `thrift:"stu_id,2,optional" frugal:"2,optional,string" json:"stu_id,omitempty"`
+	Code     *string `thrift:"code,3,optional" frugal:"3,optional,string" json:"code,omitempty"`
 }
 
 func NewGetBetaVersionRequest() *GetBetaVersionRequest {
@@ -548,6 +653,54 @@ func NewGetBetaVersionRequest() *GetBetaVersionRequest {
 func (p *GetBetaVersionRequest) InitDefault() {
 }
 
+var GetBetaVersionRequest_DeviceId_DEFAULT string
+
+func (p *GetBetaVersionRequest) GetDeviceId() (v string) {
+	if !p.IsSetDeviceId() {
+		return GetBetaVersionRequest_DeviceId_DEFAULT
+	}
+	return *p.DeviceId
+}
+
+var GetBetaVersionRequest_StuId_DEFAULT string
+
+func (p *GetBetaVersionRequest) GetStuId() (v string) {
+	if !p.IsSetStuId() {
+		return GetBetaVersionRequest_StuId_DEFAULT
+	}
+	return *p.StuId
+}
+
+var GetBetaVersionRequest_Code_DEFAULT string
+
+func (p *GetBetaVersionRequest) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return GetBetaVersionRequest_Code_DEFAULT
+	}
+	return *p.Code
+}
+func (p *GetBetaVersionRequest) SetDeviceId(val *string) {
+	p.DeviceId = val
+}
+func (p *GetBetaVersionRequest) SetStuId(val *string) {
+	p.StuId = val
+}
+func (p *GetBetaVersionRequest) SetCode(val *string) {
+	p.Code = val
+}
+
+func (p *GetBetaVersionRequest) IsSetDeviceId() bool {
+	return p.DeviceId != nil
+}
+
+func (p *GetBetaVersionRequest) IsSetStuId() bool {
+	return p.StuId != nil
+}
+
+func (p *GetBetaVersionRequest) IsSetCode() bool {
+	return p.Code != nil
+}
+
 func (p *GetBetaVersionRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1199,6 +1352,9 @@ func (p *GetDumpResponse) String() string {
 }
 
 type AndroidGetVersioneRequest struct {
+	DeviceId *string `thrift:"device_id,1,optional" frugal:"1,optional,string" json:"device_id,omitempty"`
+	StuId    *string `thrift:"stu_id,2,optional" frugal:"2,optional,string" json:"stu_id,omitempty"`
+	Code     *string `thrift:"code,3,optional" frugal:"3,optional,string" json:"code,omitempty"`
 }
 
 func NewAndroidGetVersioneRequest() *AndroidGetVersioneRequest {
@@ -1208,6 +1364,54 @@ func NewAndroidGetVersioneRequest() *AndroidGetVersioneRequest {
 func (p *AndroidGetVersioneRequest) InitDefault() {
 }
 
+var AndroidGetVersioneRequest_DeviceId_DEFAULT string
+
+func (p *AndroidGetVersioneRequest) GetDeviceId() (v string) {
+	if !p.IsSetDeviceId() {
+		return AndroidGetVersioneRequest_DeviceId_DEFAULT
+	}
+	return *p.DeviceId
+}
+
+var AndroidGetVersioneRequest_StuId_DEFAULT string
+
+func (p *AndroidGetVersioneRequest) GetStuId() (v string) {
+	if !p.IsSetStuId() {
+		return AndroidGetVersioneRequest_StuId_DEFAULT
+	}
+	return *p.StuId
+}
+
+var AndroidGetVersioneRequest_Code_DEFAULT string
+
+func (p *AndroidGetVersioneRequest) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return AndroidGetVersioneRequest_Code_DEFAULT
+	}
+	return *p.Code
+}
+func (p *AndroidGetVersioneRequest) SetDeviceId(val *string) {
+	p.DeviceId = val
+}
+func (p *AndroidGetVersioneRequest) SetStuId(val *string) {
+	p.StuId = val
+}
+func (p *AndroidGetVersioneRequest) SetCode(val *string) {
+	p.Code = val
+}
+
+func (p *AndroidGetVersioneRequest) IsSetDeviceId() bool {
+	return p.DeviceId != nil
+}
+
+func (p *AndroidGetVersioneRequest) IsSetStuId() bool {
+	return p.StuId != nil
+}
+
+func (p *AndroidGetVersioneRequest) IsSetCode() bool {
+	return p.Code != nil
+}
+
 func (p *AndroidGetVersioneRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1283,30 +1487,845 @@ func (p *AndroidGetVersionResponse) String() string {
 	return fmt.Sprintf("AndroidGetVersionResponse(%+v)", *p)
 }
 
-type VersionService interface {
-	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
+type RollbackVersionRequest struct {
+	Password string  `thrift:"password,1,required" frugal:"1,required,string" json:"password"`
+	Type     string  `thrift:"type,2,required" frugal:"2,required,string" json:"type"`
+	Platform *string `thrift:"platform,3,optional" frugal:"3,optional,string" json:"platform,omitempty"`
+}
 
-	UploadVersion(ctx context.Context, req *UploadRequest) (r *UploadResponse, err error)
+func NewRollbackVersionRequest() *RollbackVersionRequest {
+	return &RollbackVersionRequest{}
+}
 
-	UploadParams(ctx context.Context, req *UploadParamsRequest) (r *UploadParamsResponse, err error)
+func (p *RollbackVersionRequest) InitDefault() {
+}
 
-	DownloadReleaseApk(ctx context.Context, req *DownloadReleaseApkRequest) (r *DownloadReleaseApkResponse, err error)
+func (p *RollbackVersionRequest) GetPassword() (v string) {
+	return p.Password
+}
 
-	DownloadBetaApk(ctx context.Context, req *DownloadBetaApkRequest) (r *DownloadBetaApkResponse, err error)
+func (p *RollbackVersionRequest) GetType() (v string) {
+	return p.Type
+}
 
-	GetReleaseVersion(ctx context.Context, req *GetReleaseVersionRequest) (r *GetReleaseVersionResponse, err error)
+var RollbackVersionRequest_Platform_DEFAULT string
 
-	GetBetaVersion(ctx context.Context, req *GetBetaVersionRequest) (r *GetBetaVersionResponse, err error)
+func (p *RollbackVersionRequest) GetPlatform() (v string) {
+	if !p.IsSetPlatform() {
+		return RollbackVersionRequest_Platform_DEFAULT
+	}
+	return *p.Platform
+}
+func (p *RollbackVersionRequest) SetPassword(val string) {
+	p.Password = val
+}
+func (p *RollbackVersionRequest) SetType(val string) {
+	p.Type = val
+}
+func (p *RollbackVersionRequest) SetPlatform(val *string) {
+	p.Platform = val
+}
 
-	GetSetting(ctx context.Context, req *GetSettingRequest) (r *GetSettingResponse, err error)
+func (p *RollbackVersionRequest) IsSetPlatform() bool {
+	return p.Platform != nil
+}
 
-	GetTest(ctx context.Context, req *GetTestRequest) (r *GetTestResponse, err error)
+func (p *RollbackVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RollbackVersionRequest(%+v)", *p)
+}
 
-	GetCloud(ctx context.Context, req *GetCloudRequest) (r *GetCloudResponse, err error)
+type RollbackVersionResponse struct {
+	Base *model.BaseResp `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Data *model.Version  `thrift:"data,2,optional" frugal:"2,optional,model.Version" json:"data,omitempty"`
+}
 
-	SetCloud(ctx context.Context, req *SetCloudRequest) (r *SetCloudResponse, err error)
+func NewRollbackVersionResponse() *RollbackVersionResponse {
+	return &RollbackVersionResponse{}
+}
 
-	GetDump(ctx context.Context, req *GetDumpRequest) (r *GetDumpResponse, err error)
+func (p *RollbackVersionResponse) InitDefault() {
+}
 
-	AndroidGetVersion(ctx context.Context, req *AndroidGetVersioneRequest) (r *AndroidGetVersionResponse, err error)
+var RollbackVersionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *RollbackVersionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RollbackVersionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var RollbackVersionResponse_Data_DEFAULT *model.Version
+
+func (p *RollbackVersionResponse) GetData() (v *model.Version) {
+	if !p.IsSetData() {
+		return RollbackVersionResponse_Data_DEFAULT
+	}
+	return p.Data
+}
+func (p *RollbackVersionResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *RollbackVersionResponse) SetData(val *model.Version) {
+	p.Data = val
+}
+
+func (p *RollbackVersionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RollbackVersionResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *RollbackVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RollbackVersionResponse(%+v)", *p)
+}
+
+type ListVersionsRequest struct {
+	Channel  string  `thrift:"channel,1,required" frugal:"1,required,string" json:"channel"`
+	Platform *string `thrift:"platform,2,optional" frugal:"2,optional,string" json:"platform,omitempty"`
+	PageNum  *int64  `thrift:"page_num,3,optional" frugal:"3,optional,i64" json:"page_num,omitempty"`
+	PageSize *int64  `thrift:"page_size,4,optional" frugal:"4,optional,i64" json:"page_size,omitempty"`
+}
+
+func NewListVersionsRequest() *ListVersionsRequest {
+	return &ListVersionsRequest{}
+}
+
+func (p *ListVersionsRequest) InitDefault() {
+}
+
+func (p *ListVersionsRequest) GetChannel() (v string) {
+	return p.Channel
+}
+
+var ListVersionsRequest_Platform_DEFAULT string
+
+func (p *ListVersionsRequest) GetPlatform() (v string) {
+	if !p.IsSetPlatform() {
+		return ListVersionsRequest_Platform_DEFAULT
+	}
+	return *p.Platform
+}
+
+var ListVersionsRequest_PageNum_DEFAULT int64
+
+func (p *ListVersionsRequest) GetPageNum() (v int64) {
+	if !p.IsSetPageNum() {
+		return ListVersionsRequest_PageNum_DEFAULT
+	}
+	return *p.PageNum
+}
+
+var ListVersionsRequest_PageSize_DEFAULT int64
+
+func (p *ListVersionsRequest) GetPageSize() (v int64) {
+	if !p.IsSetPageSize() {
+		return ListVersionsRequest_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+func (p *ListVersionsRequest) SetChannel(val string) {
+	p.Channel = val
+}
+func (p *ListVersionsRequest) SetPlatform(val *string) {
+	p.Platform = val
+}
+func (p *ListVersionsRequest) SetPageNum(val *int64) {
+	p.PageNum = val
+}
+func (p *ListVersionsRequest) SetPageSize(val *int64) {
+	p.PageSize = val
+}
+
+func (p *ListVersionsRequest) IsSetPlatform() bool {
+	return p.Platform != nil
+}
+
+func (p *ListVersionsRequest) IsSetPageNum() bool {
+	return p.PageNum != nil
+}
+
+func (p *ListVersionsRequest) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *ListVersionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListVersionsRequest(%+v)", *p)
+}
+
+type ListVersionsResponse struct {
+	Base  *model.BaseResp  `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Data  []*model.Version `thrift:"data,2" frugal:"2,default,list<model.Version>" json:"data"`
+	Total int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+}
+
+func NewListVersionsResponse() *ListVersionsResponse {
+	return &ListVersionsResponse{}
+}
+
+func (p *ListVersionsResponse) InitDefault() {
+}
+
+var ListVersionsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ListVersionsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListVersionsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListVersionsResponse) GetData() (v []*model.Version) {
+	return p.Data
+}
+
+func (p *ListVersionsResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *ListVersionsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListVersionsResponse) SetData(val []*model.Version) {
+	p.Data = val
+}
+func (p *ListVersionsResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *ListVersionsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListVersionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListVersionsResponse(%+v)", *p)
+}
+
+type GetVersionChangelogRequest struct {
+	Channel  string  `thrift:"channel,1,required" frugal:"1,required,string" json:"channel"`
+	Code     string  `thrift:"code,2,required" frugal:"2,required,string" json:"code"`
+	Platform *string `thrift:"platform,3,optional" frugal:"3,optional,string" json:"platform,omitempty"`
+	DeviceId *string `thrift:"device_id,4,optional" frugal:"4,optional,string" json:"device_id,omitempty"`
+	StuId    *string `thrift:"stu_id,5,optional" frugal:"5,optional,string" json:"stu_id,omitempty"`
+}
+
+func NewGetVersionChangelogRequest() *GetVersionChangelogRequest {
+	return &GetVersionChangelogRequest{}
+}
+
+func (p *GetVersionChangelogRequest) InitDefault() {
+}
+
+func (p *GetVersionChangelogRequest) GetChannel() (v string) {
+	return p.Channel
+}
+
+func (p *GetVersionChangelogRequest) GetCode() (v string) {
+	return p.Code
+}
+
+var GetVersionChangelogRequest_Platform_DEFAULT string
+
+func (p *GetVersionChangelogRequest) GetPlatform() (v string) {
+	if !p.IsSetPlatform() {
+		return GetVersionChangelogRequest_Platform_DEFAULT
+	}
+	return *p.Platform
+}
+
+var GetVersionChangelogRequest_DeviceId_DEFAULT string
+
+func (p *GetVersionChangelogRequest) GetDeviceId() (v string) {
+	if !p.IsSetDeviceId() {
+		return GetVersionChangelogRequest_DeviceId_DEFAULT
+	}
+	return *p.DeviceId
+}
+
+var GetVersionChangelogRequest_StuId_DEFAULT string
+
+func (p *GetVersionChangelogRequest) GetStuId() (v string) {
+	if !p.IsSetStuId() {
+		return GetVersionChangelogRequest_StuId_DEFAULT
+	}
+	return *p.StuId
+}
+func (p *GetVersionChangelogRequest) SetChannel(val string) {
+	p.Channel = val
+}
+func (p *GetVersionChangelogRequest) SetCode(val string) {
+	p.Code = val
+}
+func (p *GetVersionChangelogRequest) SetPlatform(val *string) {
+	p.Platform = val
+}
+func (p *GetVersionChangelogRequest) SetDeviceId(val *string) {
+	p.DeviceId = val
+}
+func (p *GetVersionChangelogRequest) SetStuId(val *string) {
+	p.StuId = val
+}
+
+func (p *GetVersionChangelogRequest) IsSetPlatform() bool {
+	return p.Platform != nil
+}
+
+func (p *GetVersionChangelogRequest) IsSetDeviceId() bool {
+	return p.DeviceId != nil
+}
+
+func (p *GetVersionChangelogRequest) IsSetStuId() bool {
+	return p.StuId != nil
+}
+
+func (p *GetVersionChangelogRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetVersionChangelogRequest(%+v)", *p)
+}
+
+type GetVersionChangelogResponse struct {
+	Base *model.BaseResp         `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Data *model.VersionChangelog `thrift:"data,2,optional" frugal:"2,optional,model.VersionChangelog" json:"data,omitempty"`
+}
+
+func NewGetVersionChangelogResponse() *GetVersionChangelogResponse {
+	return &GetVersionChangelogResponse{}
+}
+
+func (p *GetVersionChangelogResponse) InitDefault() {
+}
+
+var GetVersionChangelogResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetVersionChangelogResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetVersionChangelogResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetVersionChangelogResponse_Data_DEFAULT *model.VersionChangelog
+
+func (p *GetVersionChangelogResponse) GetData() (v *model.VersionChangelog) {
+	if !p.IsSetData() {
+		return GetVersionChangelogResponse_Data_DEFAULT
+	}
+	return p.Data
+}
+func (p *GetVersionChangelogResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetVersionChangelogResponse) SetData(val *model.VersionChangelog) {
+	p.Data = val
+}
+
+func (p *GetVersionChangelogResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetVersionChangelogResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *GetVersionChangelogResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetVersionChangelogResponse(%+v)", *p)
+}
+
+type GetLatestVersionRequest struct {
+	Platform string  `thrift:"platform,1,required" frugal:"1,required,string" json:"platform"`
+	Channel  *string `thrift:"channel,2,optional" frugal:"2,optional,string" json:"channel,omitempty"`
+	DeviceId *string `thrift:"device_id,3,optional" frugal:"3,optional,string" json:"device_id,omitempty"`
+	StuId    *string `thrift:"stu_id,4,optional" frugal:"4,optional,string" json:"stu_id,omitempty"`
+	Code     *string `thrift:"code,5,optional" frugal:"5,optional,string" json:"code,omitempty"`
+}
+
+func NewGetLatestVersionRequest() *GetLatestVersionRequest {
+	return &GetLatestVersionRequest{}
+}
+
+func (p *GetLatestVersionRequest) InitDefault() {
+}
+
+func (p *GetLatestVersionRequest) GetPlatform() (v string) {
+	return p.Platform
+}
+
+var GetLatestVersionRequest_Channel_DEFAULT string
+
+func (p *GetLatestVersionRequest) GetChannel() (v string) {
+	if !p.IsSetChannel() {
+		return GetLatestVersionRequest_Channel_DEFAULT
+	}
+	return *p.Channel
+}
+
+var GetLatestVersionRequest_DeviceId_DEFAULT string
+
+func (p *GetLatestVersionRequest) GetDeviceId() (v string) {
+	if !p.IsSetDeviceId() {
+		return GetLatestVersionRequest_DeviceId_DEFAULT
+	}
+	return *p.DeviceId
+}
+
+var GetLatestVersionRequest_StuId_DEFAULT string
+
+func (p *GetLatestVersionRequest) GetStuId() (v string) {
+	if !p.IsSetStuId() {
+		return GetLatestVersionRequest_StuId_DEFAULT
+	}
+	return *p.StuId
+}
+
+var GetLatestVersionRequest_Code_DEFAULT string
+
+func (p *GetLatestVersionRequest) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return GetLatestVersionRequest_Code_DEFAULT
+	}
+	return *p.Code
+}
+func (p *GetLatestVersionRequest) SetPlatform(val string) {
+	p.Platform = val
+}
+func (p *GetLatestVersionRequest) SetChannel(val *string) {
+	p.Channel = val
+}
+func (p *GetLatestVersionRequest) SetDeviceId(val *string) {
+	p.DeviceId = val
+}
+func (p *GetLatestVersionRequest) SetStuId(val *string) {
+	p.StuId = val
+}
+func (p *GetLatestVersionRequest) SetCode(val *string) {
+	p.Code = val
+}
+
+func (p *GetLatestVersionRequest) IsSetChannel() bool {
+	return p.Channel != nil
+}
+
+func (p *GetLatestVersionRequest) IsSetDeviceId() bool {
+	return p.DeviceId != nil
+}
+
+func (p *GetLatestVersionRequest) IsSetStuId() bool {
+	return p.StuId != nil
+}
+
+func (p *GetLatestVersionRequest) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *GetLatestVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetLatestVersionRequest(%+v)", *p)
+}
+
+type GetLatestVersionResponse struct {
+	Base *model.BaseResp      `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Data *model.LatestVersion `thrift:"data,2,optional" frugal:"2,optional,model.LatestVersion" json:"data,omitempty"`
+}
+
+func NewGetLatestVersionResponse() *GetLatestVersionResponse {
+	return &GetLatestVersionResponse{}
+}
+
+func (p *GetLatestVersionResponse) InitDefault() {
+}
+
+var GetLatestVersionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetLatestVersionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetLatestVersionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetLatestVersionResponse_Data_DEFAULT *model.LatestVersion
+
+func (p *GetLatestVersionResponse) GetData() (v *model.LatestVersion) {
+	if !p.IsSetData() {
+		return GetLatestVersionResponse_Data_DEFAULT
+	}
+	return p.Data
+}
+func (p *GetLatestVersionResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetLatestVersionResponse) SetData(val *model.LatestVersion) {
+	p.Data = val
+}
+
+func (p *GetLatestVersionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetLatestVersionResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *GetLatestVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetLatestVersionResponse(%+v)", *p)
+}
+
+type DryRunCloudSettingRequest struct {
+	Account   *string `thrift:"account,1,optional" frugal:"1,optional,string" json:"account,omitempty"`
+	Version   *string `thrift:"version,2,optional" frugal:"2,optional,string" json:"version,omitempty"`
+	Beta      *bool   `thrift:"beta,3,optional" frugal:"3,optional,bool" json:"beta,omitempty"`
+	Phone     *string `thrift:"phone,4,optional" frugal:"4,optional,string" json:"phone,omitempty"`
+	IsLogin   *bool   `thrift:"isLogin,5,optional" frugal:"5,optional,bool" json:"isLogin,omitempty"`
+	LoginType *string `thrift:"loginType,6,optional" frugal:"6,optional,string" json:"loginType,omitempty"`
+	Setting   *string `thrift:"setting,7,optional" frugal:"7,optional,string" json:"setting,omitempty"`
+}
+
+func NewDryRunCloudSettingRequest() *DryRunCloudSettingRequest {
+	return &DryRunCloudSettingRequest{}
+}
+
+func (p *DryRunCloudSettingRequest) InitDefault() {
+}
+
+var DryRunCloudSettingRequest_Account_DEFAULT string
+
+func (p *DryRunCloudSettingRequest) GetAccount() (v string) {
+	if !p.IsSetAccount() {
+		return DryRunCloudSettingRequest_Account_DEFAULT
+	}
+	return *p.Account
+}
+
+var DryRunCloudSettingRequest_Version_DEFAULT string
+
+func (p *DryRunCloudSettingRequest) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return DryRunCloudSettingRequest_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var DryRunCloudSettingRequest_Beta_DEFAULT bool
+
+func (p *DryRunCloudSettingRequest) GetBeta() (v bool) {
+	if !p.IsSetBeta() {
+		return DryRunCloudSettingRequest_Beta_DEFAULT
+	}
+	return *p.Beta
+}
+
+var DryRunCloudSettingRequest_Phone_DEFAULT string
+
+func (p *DryRunCloudSettingRequest) GetPhone() (v string) {
+	if !p.IsSetPhone() {
+		return DryRunCloudSettingRequest_Phone_DEFAULT
+	}
+	return *p.Phone
+}
+
+var DryRunCloudSettingRequest_IsLogin_DEFAULT bool
+
+func (p *DryRunCloudSettingRequest) GetIsLogin() (v bool) {
+	if !p.IsSetIsLogin() {
+		return DryRunCloudSettingRequest_IsLogin_DEFAULT
+	}
+	return *p.IsLogin
+}
+
+var DryRunCloudSettingRequest_LoginType_DEFAULT string
+
+func (p *DryRunCloudSettingRequest) GetLoginType() (v string) {
+	if !p.IsSetLoginType() {
+		return DryRunCloudSettingRequest_LoginType_DEFAULT
+	}
+	return *p.LoginType
+}
+
+var DryRunCloudSettingRequest_Setting_DEFAULT string
+
+func (p *DryRunCloudSettingRequest) GetSetting() (v string) {
+	if !p.IsSetSetting() {
+		return DryRunCloudSettingRequest_Setting_DEFAULT
+	}
+	return *p.Setting
+}
+func (p *DryRunCloudSettingRequest) SetAccount(val *string) {
+	p.Account = val
+}
+func (p *DryRunCloudSettingRequest) SetVersion(val *string) {
+	p.Version = val
+}
+func (p *DryRunCloudSettingRequest) SetBeta(val *bool) {
+	p.Beta = val
+}
+func (p *DryRunCloudSettingRequest) SetPhone(val *string) {
+	p.Phone = val
+}
+func (p *DryRunCloudSettingRequest) SetIsLogin(val *bool) {
+	p.IsLogin = val
+}
+func (p *DryRunCloudSettingRequest) SetLoginType(val *string) {
+	p.LoginType = val
+}
+func (p *DryRunCloudSettingRequest) SetSetting(val *string) {
+	p.Setting = val
+}
+
+func (p *DryRunCloudSettingRequest) IsSetAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DryRunCloudSettingRequest) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *DryRunCloudSettingRequest) IsSetBeta() bool {
+	return p.Beta != nil
+}
+
+func (p *DryRunCloudSettingRequest) IsSetPhone() bool {
+	return p.Phone != nil
+}
+
+func (p *DryRunCloudSettingRequest) IsSetIsLogin() bool {
+	return p.IsLogin != nil
+}
+
+func (p *DryRunCloudSettingRequest) IsSetLoginType() bool {
+	return p.LoginType != nil
+}
+
+func (p *DryRunCloudSettingRequest) IsSetSetting() bool {
+	return p.Setting != nil
+}
+
+func (p *DryRunCloudSettingRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DryRunCloudSettingRequest(%+v)", *p)
+}
+
+type DryRunCloudSettingResponse struct {
+	Base *model.BaseResp           `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Data *model.CloudSettingDryRun `thrift:"data,2,optional" frugal:"2,optional,model.CloudSettingDryRun" json:"data,omitempty"`
+}
+
+func NewDryRunCloudSettingResponse() *DryRunCloudSettingResponse {
+	return &DryRunCloudSettingResponse{}
+}
+
+func (p *DryRunCloudSettingResponse) InitDefault() {
+}
+
+var DryRunCloudSettingResponse_Base_DEFAULT *model.BaseResp
+
+func (p *DryRunCloudSettingResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return DryRunCloudSettingResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var DryRunCloudSettingResponse_Data_DEFAULT *model.CloudSettingDryRun
+
+func (p *DryRunCloudSettingResponse) GetData() (v *model.CloudSettingDryRun) {
+	if !p.IsSetData() {
+		return DryRunCloudSettingResponse_Data_DEFAULT
+	}
+	return p.Data
+}
+func (p *DryRunCloudSettingResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *DryRunCloudSettingResponse) SetData(val *model.CloudSettingDryRun) {
+	p.Data = val
+}
+
+func (p *DryRunCloudSettingResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DryRunCloudSettingResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *DryRunCloudSettingResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DryRunCloudSettingResponse(%+v)", *p)
+}
+
+type GetCloudSettingCoverageRequest struct {
+	Password string  `thrift:"password,1,required" frugal:"1,required,string" json:"password"`
+	Setting  *string `thrift:"setting,2,optional" frugal:"2,optional,string" json:"setting,omitempty"`
+	Samples  *int64  `thrift:"samples,3,optional" frugal:"3,optional,i64" json:"samples,omitempty"`
+}
+
+func NewGetCloudSettingCoverageRequest() *GetCloudSettingCoverageRequest {
+	return &GetCloudSettingCoverageRequest{}
+}
+
+func (p *GetCloudSettingCoverageRequest) InitDefault() {
+}
+
+func (p *GetCloudSettingCoverageRequest) GetPassword() (v string) {
+	return p.Password
+}
+
+var GetCloudSettingCoverageRequest_Setting_DEFAULT string
+
+func (p *GetCloudSettingCoverageRequest) GetSetting() (v string) {
+	if !p.IsSetSetting() {
+		return GetCloudSettingCoverageRequest_Setting_DEFAULT
+	}
+	return *p.Setting
+}
+
+var GetCloudSettingCoverageRequest_Samples_DEFAULT int64
+
+func (p *GetCloudSettingCoverageRequest) GetSamples() (v int64) {
+	if !p.IsSetSamples() {
+		return GetCloudSettingCoverageRequest_Samples_DEFAULT
+	}
+	return *p.Samples
+}
+func (p *GetCloudSettingCoverageRequest) SetPassword(val string) {
+	p.Password = val
+}
+func (p *GetCloudSettingCoverageRequest) SetSetting(val *string) {
+	p.Setting = val
+}
+func (p *GetCloudSettingCoverageRequest) SetSamples(val *int64) {
+	p.Samples = val
+}
+
+func (p *GetCloudSettingCoverageRequest) IsSetSetting() bool {
+	return p.Setting != nil
+}
+
+func (p *GetCloudSettingCoverageRequest) IsSetSamples() bool {
+	return p.Samples != nil
+}
+
+func (p *GetCloudSettingCoverageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCloudSettingCoverageRequest(%+v)", *p)
+}
+
+type GetCloudSettingCoverageResponse struct {
+	Base *model.BaseResp             `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Data *model.CloudSettingCoverage `thrift:"data,2,optional" frugal:"2,optional,model.CloudSettingCoverage" json:"data,omitempty"`
+}
+
+func NewGetCloudSettingCoverageResponse() *GetCloudSettingCoverageResponse {
+	return &GetCloudSettingCoverageResponse{}
+}
+
+func (p *GetCloudSettingCoverageResponse) InitDefault() {
+}
+
+var GetCloudSettingCoverageResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetCloudSettingCoverageResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetCloudSettingCoverageResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetCloudSettingCoverageResponse_Data_DEFAULT *model.CloudSettingCoverage
+
+func (p *GetCloudSettingCoverageResponse) GetData() (v *model.CloudSettingCoverage) {
+	if !p.IsSetData() {
+		return GetCloudSettingCoverageResponse_Data_DEFAULT
+	}
+	return p.Data
+}
+func (p *GetCloudSettingCoverageResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetCloudSettingCoverageResponse) SetData(val *model.CloudSettingCoverage) {
+	p.Data = val
+}
+
+func (p *GetCloudSettingCoverageResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetCloudSettingCoverageResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *GetCloudSettingCoverageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCloudSettingCoverageResponse(%+v)", *p)
+}
+
+type VersionService interface {
+	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
+
+	UploadVersion(ctx context.Context, req *UploadRequest) (r *UploadResponse, err error)
+
+	UploadParams(ctx context.Context, req *UploadParamsRequest) (r *UploadParamsResponse, err error)
+
+	DownloadReleaseApk(ctx context.Context, req *DownloadReleaseApkRequest) (r *DownloadReleaseApkResponse, err error)
+
+	DownloadBetaApk(ctx context.Context, req *DownloadBetaApkRequest) (r *DownloadBetaApkResponse, err error)
+
+	GetReleaseVersion(ctx context.Context, req *GetReleaseVersionRequest) (r *GetReleaseVersionResponse, err error)
+
+	GetBetaVersion(ctx context.Context, req *GetBetaVersionRequest) (r *GetBetaVersionResponse, err error)
+
+	GetSetting(ctx context.Context, req *GetSettingRequest) (r *GetSettingResponse, err error)
+
+	GetTest(ctx context.Context, req *GetTestRequest) (r *GetTestResponse, err error)
+
+	GetCloud(ctx context.Context, req *GetCloudRequest) (r *GetCloudResponse, err error)
+
+	SetCloud(ctx context.Context, req *SetCloudRequest) (r *SetCloudResponse, err error)
+
+	GetDump(ctx context.Context, req *GetDumpRequest) (r *GetDumpResponse, err error)
+
+	AndroidGetVersion(ctx context.Context, req *AndroidGetVersioneRequest) (r *AndroidGetVersionResponse, err error)
+
+	RollbackVersion(ctx context.Context, req *RollbackVersionRequest) (r *RollbackVersionResponse, err error)
+
+	ListVersions(ctx context.Context, req *ListVersionsRequest) (r *ListVersionsResponse, err error)
+
+	GetVersionChangelog(ctx context.Context, req *GetVersionChangelogRequest) (r *GetVersionChangelogResponse, err error)
+
+	GetLatestVersion(ctx context.Context, req *GetLatestVersionRequest) (r *GetLatestVersionResponse, err error)
+
+	DryRunCloudSetting(ctx context.Context, req *DryRunCloudSettingRequest) (r *DryRunCloudSettingResponse, err error)
+
+	GetCloudSettingCoverage(ctx context.Context, req *GetCloudSettingCoverageRequest) (r *GetCloudSettingCoverageResponse, err error)
 }
@@ -42,6 +42,12 @@ type Client interface {
 	SetCloud(ctx context.Context, req *version.SetCloudRequest, callOptions ...callopt.Option) (r *version.SetCloudResponse, err error)
 	GetDump(ctx context.Context, req *version.GetDumpRequest, callOptions ...callopt.Option) (r *version.GetDumpResponse, err error)
 	AndroidGetVersion(ctx context.Context, req *version.AndroidGetVersioneRequest, callOptions ...callopt.Option) (r *version.AndroidGetVersionResponse, err error)
+	RollbackVersion(ctx context.Context, req *version.RollbackVersionRequest, callOptions ...callopt.Option) (r *version.RollbackVersionResponse, err error)
+	ListVersions(ctx context.Context, req *version.ListVersionsRequest, callOptions ...callopt.Option) (r *version.ListVersionsResponse, err error)
+	GetVersionChangelog(ctx context.Context, req *version.GetVersionChangelogRequest, callOptions ...callopt.Option) (r *version.GetVersionChangelogResponse, err error)
+	GetLatestVersion(ctx context.Context, req *version.GetLatestVersionRequest, callOptions ...callopt.Option) (r *version.GetLatestVersionResponse, err error)
+	DryRunCloudSetting(ctx context.Context, req *version.DryRunCloudSettingRequest, callOptions ...callopt.Option) (r *version.DryRunCloudSettingResponse, err error)
+	GetCloudSettingCoverage(ctx context.Context, req *version.GetCloudSettingCoverageRequest, callOptions ...callopt.Option) (r *version.GetCloudSettingCoverageResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -137,3 +143,33 @@ func (p *kVersionServiceClient) AndroidGetVersion(ctx context.Context, req *vers
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.AndroidGetVersion(ctx, req)
 }
+
+func (p *kVersionServiceClient) RollbackVersion(ctx context.Context, req *version.RollbackVersionRequest, callOptions ...callopt.Option) (r *version.RollbackVersionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RollbackVersion(ctx, req)
+}
+
+func (p *kVersionServiceClient) ListVersions(ctx context.Context, req *version.ListVersionsRequest, callOptions ...callopt.Option) (r *version.ListVersionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListVersions(ctx, req)
+}
+
+func (p *kVersionServiceClient) GetVersionChangelog(ctx context.Context, req *version.GetVersionChangelogRequest, callOptions ...callopt.Option) (r *version.GetVersionChangelogResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetVersionChangelog(ctx, req)
+}
+
+func (p *kVersionServiceClient) GetLatestVersion(ctx context.Context, req *version.GetLatestVersionRequest, callOptions ...callopt.Option) (r *version.GetLatestVersionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetLatestVersion(ctx, req)
+}
+
+func (p *kVersionServiceClient) DryRunCloudSetting(ctx context.Context, req *version.DryRunCloudSettingRequest, callOptions ...callopt.Option) (r *version.DryRunCloudSettingResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DryRunCloudSetting(ctx, req)
+}
+
+func (p *kVersionServiceClient) GetCloudSettingCoverage(ctx context.Context, req *version.GetCloudSettingCoverageRequest, callOptions ...callopt.Option) (r *version.GetCloudSettingCoverageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCloudSettingCoverage(ctx, req)
+}
@@ -122,6 +122,48 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"RollbackVersion": kitex.NewMethodInfo(
+		rollbackVersionHandler,
+		newVersionServiceRollbackVersionArgs,
+		newVersionServiceRollbackVersionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListVersions": kitex.NewMethodInfo(
+		listVersionsHandler,
+		newVersionServiceListVersionsArgs,
+		newVersionServiceListVersionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetVersionChangelog": kitex.NewMethodInfo(
+		getVersionChangelogHandler,
+		newVersionServiceGetVersionChangelogArgs,
+		newVersionServiceGetVersionChangelogResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetLatestVersion": kitex.NewMethodInfo(
+		getLatestVersionHandler,
+		newVersionServiceGetLatestVersionArgs,
+		newVersionServiceGetLatestVersionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DryRunCloudSetting": kitex.NewMethodInfo(
+		dryRunCloudSettingHandler,
+		newVersionServiceDryRunCloudSettingArgs,
+		newVersionServiceDryRunCloudSettingResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCloudSettingCoverage": kitex.NewMethodInfo(
+		getCloudSettingCoverageHandler,
+		newVersionServiceGetCloudSettingCoverageArgs,
+		newVersionServiceGetCloudSettingCoverageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -422,6 +464,114 @@ func newVersionServiceAndroidGetVersionResult() interface{} {
 	return version.NewVersionServiceAndroidGetVersionResult()
 }
 
+func rollbackVersionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*version.VersionServiceRollbackVersionArgs)
+	realResult := result.(*version.VersionServiceRollbackVersionResult)
+	success, err := handler.(version.VersionService).RollbackVersion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVersionServiceRollbackVersionArgs() interface{} {
+	return version.NewVersionServiceRollbackVersionArgs()
+}
+
+func newVersionServiceRollbackVersionResult() interface{} {
+	return version.NewVersionServiceRollbackVersionResult()
+}
+
+func listVersionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*version.VersionServiceListVersionsArgs)
+	realResult := result.(*version.VersionServiceListVersionsResult)
+	success, err := handler.(version.VersionService).ListVersions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVersionServiceListVersionsArgs() interface{} {
+	return version.NewVersionServiceListVersionsArgs()
+}
+
+func newVersionServiceListVersionsResult() interface{} {
+	return version.NewVersionServiceListVersionsResult()
+}
+
+func getVersionChangelogHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*version.VersionServiceGetVersionChangelogArgs)
+	realResult := result.(*version.VersionServiceGetVersionChangelogResult)
+	success, err := handler.(version.VersionService).GetVersionChangelog(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVersionServiceGetVersionChangelogArgs() interface{} {
+	return version.NewVersionServiceGetVersionChangelogArgs()
+}
+
+func newVersionServiceGetVersionChangelogResult() interface{} {
+	return version.NewVersionServiceGetVersionChangelogResult()
+}
+
+func getLatestVersionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*version.VersionServiceGetLatestVersionArgs)
+	realResult := result.(*version.VersionServiceGetLatestVersionResult)
+	success, err := handler.(version.VersionService).GetLatestVersion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVersionServiceGetLatestVersionArgs() interface{} {
+	return version.NewVersionServiceGetLatestVersionArgs()
+}
+
+func newVersionServiceGetLatestVersionResult() interface{} {
+	return version.NewVersionServiceGetLatestVersionResult()
+}
+
+func dryRunCloudSettingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*version.VersionServiceDryRunCloudSettingArgs)
+	realResult := result.(*version.VersionServiceDryRunCloudSettingResult)
+	success, err := handler.(version.VersionService).DryRunCloudSetting(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVersionServiceDryRunCloudSettingArgs() interface{} {
+	return version.NewVersionServiceDryRunCloudSettingArgs()
+}
+
+func newVersionServiceDryRunCloudSettingResult() interface{} {
+	return version.NewVersionServiceDryRunCloudSettingResult()
+}
+
+func getCloudSettingCoverageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*version.VersionServiceGetCloudSettingCoverageArgs)
+	realResult := result.(*version.VersionServiceGetCloudSettingCoverageResult)
+	success, err := handler.(version.VersionService).GetCloudSettingCoverage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVersionServiceGetCloudSettingCoverageArgs() interface{} {
+	return version.NewVersionServiceGetCloudSettingCoverageArgs()
+}
+
+func newVersionServiceGetCloudSettingCoverageResult() interface{} {
+	return version.NewVersionServiceGetCloudSettingCoverageResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -561,3 +711,63 @@ func (p *kClient) AndroidGetVersion(ctx context.Context, req *version.AndroidGet
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) RollbackVersion(ctx context.Context, req *version.RollbackVersionRequest) (r *version.RollbackVersionResponse, err error) {
+	var _args version.VersionServiceRollbackVersionArgs
+	_args.Req = req
+	var _result version.VersionServiceRollbackVersionResult
+	if err = p.c.Call(ctx, "RollbackVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListVersions(ctx context.Context, req *version.ListVersionsRequest) (r *version.ListVersionsResponse, err error) {
+	var _args version.VersionServiceListVersionsArgs
+	_args.Req = req
+	var _result version.VersionServiceListVersionsResult
+	if err = p.c.Call(ctx, "ListVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetVersionChangelog(ctx context.Context, req *version.GetVersionChangelogRequest) (r *version.GetVersionChangelogResponse, err error) {
+	var _args version.VersionServiceGetVersionChangelogArgs
+	_args.Req = req
+	var _result version.VersionServiceGetVersionChangelogResult
+	if err = p.c.Call(ctx, "GetVersionChangelog", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetLatestVersion(ctx context.Context, req *version.GetLatestVersionRequest) (r *version.GetLatestVersionResponse, err error) {
+	var _args version.VersionServiceGetLatestVersionArgs
+	_args.Req = req
+	var _result version.VersionServiceGetLatestVersionResult
+	if err = p.c.Call(ctx, "GetLatestVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DryRunCloudSetting(ctx context.Context, req *version.DryRunCloudSettingRequest) (r *version.DryRunCloudSettingResponse, err error) {
+	var _args version.VersionServiceDryRunCloudSettingArgs
+	_args.Req = req
+	var _result version.VersionServiceDryRunCloudSettingResult
+	if err = p.c.Call(ctx, "DryRunCloudSetting", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCloudSettingCoverage(ctx context.Context, req *version.GetCloudSettingCoverageRequest) (r *version.GetCloudSettingCoverageResponse, err error) {
+	var _args version.VersionServiceGetCloudSettingCoverageArgs
+	_args.Req = req
+	var _result version.VersionServiceGetCloudSettingCoverageResult
+	if err = p.c.Call(ctx, "GetCloudSettingCoverage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -17,14 +17,19 @@ limitations under the License.
 package base
 
 import (
+	"time"
+
 	cli "github.com/cloudwego/hertz/pkg/app/client"
 
 	"github.com/west2-online/fzuhelper-server/config"
 	"github.com/west2-online/fzuhelper-server/pkg/base/client"
 	"github.com/west2-online/fzuhelper-server/pkg/cache"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
 	"github.com/west2-online/fzuhelper-server/pkg/oss"
+	"github.com/west2-online/fzuhelper-server/pkg/umeng"
 	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
 
@@ -47,6 +52,59 @@ func WithRedisClient(dbName int) Option {
 	}
 }
 
+// WithGovernor 初始化访问教务处的全局出口治理，Redis 连接失败时只保留进程内熔断
+func WithGovernor() Option {
+	return func(clientSet *ClientSet) {
+		cfg := config.Governor
+		if cfg == nil || !cfg.Enabled {
+			return
+		}
+		governorCfg := governor.Config{
+			Enabled:          cfg.Enabled,
+			Rate:             cfg.Rate,
+			Burst:            cfg.Burst,
+			MaxWait:          time.Duration(cfg.MaxWaitMillis) * time.Millisecond,
+			FailureThreshold: cfg.FailureThreshold,
+			FailureWindow:    time.Duration(cfg.FailureWindowSec) * time.Second,
+			OpenDuration:     time.Duration(cfg.OpenSeconds) * time.Second,
+		}
+
+		redisClient, err := client.NewRedisClient(constants.RedisDBGovernor)
+		if err != nil {
+			logger.Errorf("init governor redis failed, outbound throttling disabled, err: %v", err)
+			governor.Init(governorCfg, nil)
+			return
+		}
+		clientSet.cleanups = append(clientSet.cleanups, func() {
+			if err := redisClient.Close(); err != nil {
+				logger.Errorf("close governor redis failed, err: %v", err)
+			}
+		})
+		governor.Init(governorCfg, redisClient)
+
+		logger.Infof("Governor Redis Connect Success")
+	}
+}
+
+// WithUmengQueue 使用 Redis 持久化友盟推送队列，所有推送通知的服务共享同一个队列；Redis 连接失败时退化为进程内队列
+func WithUmengQueue() Option {
+	return func(clientSet *ClientSet) {
+		redisClient, err := client.NewRedisClient(constants.RedisDBUmeng)
+		if err != nil {
+			logger.Errorf("init umeng queue redis failed, fallback to in-memory queue, err: %v", err)
+			return
+		}
+		clientSet.cleanups = append(clientSet.cleanups, func() {
+			if err := redisClient.Close(); err != nil {
+				logger.Errorf("close umeng queue redis failed, err: %v", err)
+			}
+		})
+		umeng.Init(redisClient)
+
+		logger.Infof("Umeng Queue Redis Connect Success")
+	}
+}
+
 // WithDBClient will create database object
 func WithDBClient() Option {
 	return func(clientSet *ClientSet) {
@@ -80,9 +138,31 @@ func WithElasticSearch() Option {
 	}
 }
 
+// WithOptionalElasticSearch 在配置了 Elasticsearch 且可连通时初始化客户端，否则保持 ESClient 为 nil，由调用方降级处理
+func WithOptionalElasticSearch() Option {
+	return func(clientSet *ClientSet) {
+		if config.Elasticsearch == nil || config.Elasticsearch.Addr == "" {
+			logger.Infof("ElasticSearch not configured, skip")
+			return
+		}
+		es, err := client.NewEsClient()
+		if err != nil {
+			logger.Errorf("init elastic search client failed, skip, err: %v", err)
+			return
+		}
+		if !client.IsESConnected(es) {
+			logger.Errorf("elastic search not reachable, skip")
+			return
+		}
+		clientSet.ESClient = es
+		logger.Infof("ElasticSearch Connect Success")
+	}
+}
+
 func WithHzClient() Option {
 	return func(clientSet *ClientSet) {
-		hz, err := cli.NewClient()
+		// 以流的形式读取响应体，下载附件等大响应时调用方可在读入内存前检查大小
+		hz, err := cli.NewClient(cli.WithResponseBodyStream(true))
 		if err != nil {
 			logger.Fatalf("init Hertz client error: %v", err)
 		}
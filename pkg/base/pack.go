@@ -19,13 +19,17 @@ package base
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 	jwchErrno "github.com/west2-online/jwch/errno"
 	yjsyErrno "github.com/west2-online/yjsy/errno"
 )
@@ -38,6 +42,7 @@ func BuildBaseResp(err error) *model.BaseResp {
 		}
 	}
 	Errno := errno.ConvertErr(err)
+	metrics.RecordErrno(Errno.ErrorCode)
 	return &model.BaseResp{
 		Code: Errno.ErrorCode,
 		Msg:  Errno.ErrorMsg,
@@ -70,6 +75,7 @@ func BuildRespAndLog(err error) *model.BaseResp {
 	}
 
 	Errno := errno.ConvertErr(err)
+	metrics.RecordErrno(Errno.ErrorCode)
 	if Errno.StackTrace() != nil {
 		logger.LError(err.Error(), zap.String(constants.StackTraceKey, fmt.Sprintf("%+v", Errno.StackTrace())))
 	} else {
@@ -82,7 +88,9 @@ func BuildRespAndLog(err error) *model.BaseResp {
 }
 
 // HandleJwchError 对于jwch库返回的错误类型，需要使用 HandleJwchError 来保留 cookie 异常
+// 同时会把错误上报给出口治理，持续的超时/5xx 会触发熔断
 func HandleJwchError(err error) error {
+	governor.Report(governor.HostJwch, err)
 	var jwchErr jwchErrno.ErrNo
 	if errors.As(err, &jwchErr) {
 		if errors.Is(jwchErr, jwchErrno.EvaluationNotFoundError) {
@@ -99,6 +107,7 @@ func HandleJwchError(err error) error {
 
 // HandleYjsyError 对于yjsy库返回的错误类型，需要使用 HandleYjsyError 来保留 cookie 异常
 func HandleYjsyError(err error) error {
+	governor.Report(governor.HostYjsy, err)
 	var yjsyErr yjsyErrno.ErrNo
 	if errors.As(err, &yjsyErr) {
 		if errors.Is(yjsyErr, yjsyErrno.CookieError) {
@@ -108,6 +117,49 @@ func HandleYjsyError(err error) error {
 	return err
 }
 
+// ShouldServeStale 判断错误是否由教务处不可用（熔断、限流、超时、5xx）导致，此时可以降级返回数据库中的快照
+// cookie 过期等需要用户处理的错误不降级
+// 降级只覆盖数据库中有快照的接口：课表、学期列表、成绩、考场和用户信息；
+// 绩点、学分、统考成绩、校历和当前学期没有快照，教务处不可用时直接返回 InternalNetworkErrorCode 错误
+func ShouldServeStale(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errno.ConvertErr(err).ErrorCode == errno.InternalNetworkErrorCode {
+		return true
+	}
+	return governor.IsUpstreamFailure(err)
+}
+
+// RefreshInBackground 在任务队列中重新访问教务处，成功后由原有逻辑刷新缓存和数据库
+// 只有教务处仍不可用时才重试，重试间隔熔断器的打开时长，避免在熔断期间反复失败；cookie 过期等错误直接放弃
+func RefreshInBackground(queue taskqueue.TaskQueue, key string, refresh func() error) {
+	if queue == nil {
+		return
+	}
+	attempts := 0
+	queue.Add(key, taskqueue.QueueTask{
+		Execute: func() error {
+			attempts++
+			err := refresh()
+			if err == nil {
+				return nil
+			}
+			if ShouldServeStale(err) && attempts < constants.StaleRefreshMaxAttempts {
+				return err
+			}
+			logger.Warnf("base.RefreshInBackground: give up refreshing %s after %d attempts, err: %v", key, attempts, err)
+			return nil
+		},
+		RetryDelay: staleRefreshDelay,
+	})
+}
+
+// staleRefreshDelay 返回后台刷新的重试间隔，不短于熔断器的打开时长
+func staleRefreshDelay() time.Duration {
+	return max(governor.OpenDuration(), constants.StaleRefreshMinDelay)
+}
+
 func BuildTypeList[T any, U any](items []U, buildFunc func(U) T) []T {
 	if len(items) == 0 {
 		return nil
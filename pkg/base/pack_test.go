@@ -17,13 +17,19 @@ limitations under the License.
 package base
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/bytedance/mockey"
 	. "github.com/smartystreets/goconvey/convey"
 
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/taskqueue"
 )
 
 func TestBuildBaseResp(t *testing.T) {
@@ -85,3 +91,66 @@ func TestBuildTypeList(t *testing.T) {
 		So([]string{"1", "2", "3", "4"}, ShouldResemble, strs)
 	})
 }
+
+func TestShouldServeStale(t *testing.T) {
+	Convey("Test ShouldServeStale", t, func() {
+		So(ShouldServeStale(nil), ShouldBeFalse)
+		So(ShouldServeStale(fmt.Errorf("service.GetScores: %w", errno.UpstreamBreakerOpen)), ShouldBeTrue)
+		So(ShouldServeStale(fmt.Errorf("get marks: %w", context.DeadlineExceeded)), ShouldBeTrue)
+		So(ShouldServeStale(errno.NewErrNo(errno.BizJwchCookieExceptionCode, "session expired")), ShouldBeFalse)
+		So(ShouldServeStale(fmt.Errorf("invalid term")), ShouldBeFalse)
+	})
+}
+
+func TestRefreshInBackground(t *testing.T) {
+	type testCase struct {
+		name        string
+		refreshErr  error
+		expectRetry []bool
+	}
+
+	testCases := []testCase{
+		{
+			name:        "success",
+			expectRetry: []bool{false},
+		},
+		{
+			name:        "upstream unavailable retries until max attempts",
+			refreshErr:  errno.UpstreamBreakerOpen,
+			expectRetry: []bool{true, true, true, true, false},
+		},
+		{
+			name:        "cookie error gives up",
+			refreshErr:  errno.NewErrNo(errno.BizJwchCookieExceptionCode, "cookie expired"),
+			expectRetry: []bool{false},
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			var task taskqueue.QueueTask
+			mockey.Mock((*taskqueue.BaseTaskQueue).Add).To(func(_ *taskqueue.BaseTaskQueue, _ string, t taskqueue.QueueTask) {
+				task = t
+			}).Build()
+
+			RefreshInBackground(new(taskqueue.BaseTaskQueue), "refresh", func() error {
+				return tc.refreshErr
+			})
+			for _, retry := range tc.expectRetry {
+				So(task.Execute() != nil, ShouldEqual, retry)
+			}
+			So(task.RetryDelay(), ShouldEqual, constants.StaleRefreshMinDelay)
+		})
+	}
+
+	Convey("retry delay follows the breaker open duration", t, func() {
+		governor.Init(governor.Config{Enabled: true, OpenDuration: 5 * time.Minute}, nil)
+		defer governor.Init(governor.Config{}, nil)
+		So(staleRefreshDelay(), ShouldEqual, 5*time.Minute)
+	})
+
+	Convey("nil queue is ignored", t, func() {
+		So(func() { RefreshInBackground(nil, "refresh", func() error { return nil }) }, ShouldNotPanic)
+	})
+}
@@ -28,6 +28,7 @@ import (
 	kitextracing "github.com/kitex-contrib/obs-opentelemetry/tracing"
 
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 )
 
 // config.go RPC 服务器配置，配置应当只在 cmd 包中调用
@@ -44,6 +45,7 @@ func AssembleCommonServerConfig(serviceName string, addr net.Addr, r registry.Re
 		server.WithServiceAddr(addr),
 		server.WithRegistry(r),
 		server.WithSuite(kitextracing.NewServerSuite()),
+		server.WithTracer(metrics.NewRPCTracer()),
 	)
 	return opts
 }
@@ -19,6 +19,7 @@ package cache
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/bytedance/sonic"
@@ -35,6 +36,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/cache/version"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 )
 
 type Cache struct {
@@ -64,8 +66,15 @@ func NewCache(client *redis.Client) *Cache {
 }
 
 // IsKeyExist will check if key exists
+// 各服务都通过它判断缓存是否可用，因此在这里统计缓存命中率
 func (c *Cache) IsKeyExist(ctx context.Context, key string) bool {
-	return c.client.Exists(ctx, key).Val() == 1
+	exist := c.client.Exists(ctx, key).Val() == 1
+	result := metrics.CacheMiss
+	if exist {
+		result = metrics.CacheHit
+	}
+	metrics.CacheRequests.WithLabelValues(strconv.Itoa(c.client.Options().DB), result).Inc()
+	return exist
 }
 
 // SetSliceCache 处理指针类型的切片
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classroom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/west2-online/fzuhelper-server/pkg/base/environment"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+// SetEmptyRoomBitmap 保存某天某校区所有教室的节次位图，key 为教室，value 的第 i 位表示第 i+1 节空闲
+func (c *CacheClassroom) SetEmptyRoomBitmap(ctx context.Context, key string, bitmaps map[string]uint16) error {
+	if environment.IsTestEnvironment() {
+		return nil
+	}
+	data, err := sonic.Marshal(bitmaps)
+	if err != nil {
+		return fmt.Errorf("dal.SetEmptyRoomBitmap: Marshal room bitmaps failed: %w", err)
+	}
+	if err = c.client.Set(ctx, key, data, constants.ClassroomKeyExpire).Err(); err != nil {
+		return fmt.Errorf("dal.SetEmptyRoomBitmap: Set room bitmaps failed: %w", err)
+	}
+	return nil
+}
+
+func (c *CacheClassroom) GetEmptyRoomBitmap(ctx context.Context, key string) (map[string]uint16, error) {
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("dal.GetEmptyRoomBitmap: Get room bitmaps failed: %w", err)
+	}
+	bitmaps := make(map[string]uint16)
+	if err = sonic.Unmarshal(data, &bitmaps); err != nil {
+		return nil, fmt.Errorf("dal.GetEmptyRoomBitmap: Unmarshal room bitmaps failed: %w", err)
+	}
+	return bitmaps, nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classroom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base/environment"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+// SetEmptyRoomSyncStatus 保存某天某校区空教室的同步状态，过期时间与空教室数据一致
+func (c *CacheClassroom) SetEmptyRoomSyncStatus(ctx context.Context, key string, status *model.EmptyRoomSyncStatus) error {
+	if environment.IsTestEnvironment() {
+		return nil
+	}
+	data, err := sonic.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("dal.SetEmptyRoomSyncStatus: Marshal sync status failed: %w", err)
+	}
+	if err = c.client.Set(ctx, key, data, constants.ClassroomKeyExpire).Err(); err != nil {
+		return fmt.Errorf("dal.SetEmptyRoomSyncStatus: Set sync status failed: %w", err)
+	}
+	return nil
+}
+
+// GetEmptyRoomSyncStatus 获取同步状态，从未同步过时返回 nil
+func (c *CacheClassroom) GetEmptyRoomSyncStatus(ctx context.Context, key string) (*model.EmptyRoomSyncStatus, error) {
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("dal.GetEmptyRoomSyncStatus: Get sync status failed: %w", err)
+	}
+	status := new(model.EmptyRoomSyncStatus)
+	if err = sonic.Unmarshal(data, status); err != nil {
+		return nil, fmt.Errorf("dal.GetEmptyRoomSyncStatus: Unmarshal sync status failed: %w", err)
+	}
+	return status, nil
+}
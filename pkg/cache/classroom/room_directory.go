@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classroom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/fzuhelper-server/pkg/base/environment"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+// AddRoomDirectory 记录同步中出现过的教室，field 为教室名，value 为教务处返回的原始信息
+// 每次同步都会刷新过期时间，因此全天被占用的教室只要近期空闲过就能被查到
+func (c *CacheClassroom) AddRoomDirectory(ctx context.Context, key string, rooms map[string]string) error {
+	if environment.IsTestEnvironment() || len(rooms) == 0 {
+		return nil
+	}
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, key, rooms)
+	pipe.Expire(ctx, key, constants.RoomDirectoryKeyExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("dal.AddRoomDirectory: HSet room directory failed: %w", err)
+	}
+	return nil
+}
+
+// GetRoomDirectoryEntry 获取教室的原始信息，教室不在目录中时返回空字符串
+func (c *CacheClassroom) GetRoomDirectoryEntry(ctx context.Context, key, location string) (string, error) {
+	raw, err := c.client.HGet(ctx, key, location).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+		return "", fmt.Errorf("dal.GetRoomDirectoryEntry: HGet room directory failed: %w", err)
+	}
+	return raw, nil
+}
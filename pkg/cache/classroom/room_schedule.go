@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classroom
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base/environment"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+func (c *CacheClassroom) SetRoomSchedule(ctx context.Context, key string, schedule *model.RoomSchedule) {
+	if environment.IsTestEnvironment() {
+		return
+	}
+	data, err := sonic.Marshal(schedule)
+	if err != nil {
+		logger.Errorf("dal.SetRoomSchedule: marshal room schedule failed, err: %v", err)
+		return
+	}
+	if err = c.client.Set(ctx, key, data, constants.RoomScheduleKeyExpire).Err(); err != nil {
+		logger.Errorf("dal.SetRoomSchedule: set room schedule failed, err: %v", err)
+	}
+}
+
+func (c *CacheClassroom) GetRoomSchedule(ctx context.Context, key string) (*model.RoomSchedule, error) {
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalRedisErrorCode, "dal.GetRoomSchedule: Get room schedule failed: %v", err)
+	}
+	schedule := new(model.RoomSchedule)
+	if err = sonic.Unmarshal(data, schedule); err != nil {
+		return nil, errno.Errorf(errno.InternalJSONErrorCode, "dal.GetRoomSchedule: Unmarshal room schedule failed: %v", err)
+	}
+	return schedule, nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/west2-online/fzuhelper-server/kitex_gen/model"
+	"github.com/west2-online/fzuhelper-server/pkg/base/environment"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+func (c *CacheCommon) SetNoticeDetail(ctx context.Context, key string, detail *model.NoticeDetail) {
+	if environment.IsTestEnvironment() {
+		return
+	}
+	data, err := sonic.Marshal(detail)
+	if err != nil {
+		logger.Errorf("dal.SetNoticeDetail: marshal notice detail failed, err: %v", err)
+		return
+	}
+	if err = c.client.Set(ctx, key, data, constants.NoticeDetailKeyExpire).Err(); err != nil {
+		logger.Errorf("dal.SetNoticeDetail: set notice detail failed, err: %v", err)
+	}
+}
+
+func (c *CacheCommon) GetNoticeDetail(ctx context.Context, key string) (*model.NoticeDetail, error) {
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalRedisErrorCode, "dal.GetNoticeDetail: Get notice detail failed: %v", err)
+	}
+	detail := new(model.NoticeDetail)
+	if err = sonic.Unmarshal(data, detail); err != nil {
+		return nil, errno.Errorf(errno.InternalJSONErrorCode, "dal.GetNoticeDetail: Unmarshal notice detail failed: %v", err)
+	}
+	return detail, nil
+}
+
+// DeleteNoticeDetail 通知正文或附件重新同步后删除旧缓存
+func (c *CacheCommon) DeleteNoticeDetail(ctx context.Context, key string) error {
+	if environment.IsTestEnvironment() {
+		return nil
+	}
+	if err := c.client.Del(ctx, key).Err(); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "dal.DeleteNoticeDetail: Del notice detail failed: %v", err)
+	}
+	return nil
+}
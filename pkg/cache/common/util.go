@@ -16,8 +16,18 @@ limitations under the License.
 
 package common
 
-import "fmt"
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+)
 
 func (c *CacheCommon) TermInfoKey(term string) string {
 	return fmt.Sprintf("common:term:%s", term)
 }
+
+// NoticeDetailKey 通知 url 较长且含查询参数，使用 md5 作为 key
+func (c *CacheCommon) NoticeDetailKey(url string) string {
+	sum := md5.Sum([]byte(url))
+	return fmt.Sprintf("common:notice_detail:%s", hex.EncodeToString(sum[:]))
+}
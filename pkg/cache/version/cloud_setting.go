@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/base/environment"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// GetCloudSetting 获取缓存的云控配置原文
+func (c *CacheVersion) GetCloudSetting(ctx context.Context) (string, error) {
+	data, err := c.client.Get(ctx, constants.CloudSettingKey).Result()
+	if err != nil {
+		return "", errno.Errorf(errno.InternalRedisErrorCode, "version.GetCloudSetting error: %v", err)
+	}
+	return data, nil
+}
+
+func (c *CacheVersion) SetCloudSetting(ctx context.Context, content string) error {
+	if environment.IsTestEnvironment() {
+		return nil
+	}
+	if err := c.client.Set(ctx, constants.CloudSettingKey, content, constants.CloudSettingKeyExpire).Err(); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "version.SetCloudSetting error: %v", err)
+	}
+	return nil
+}
+
+// DeleteCloudSetting 修改配置后删除缓存，其他实例在下次读取时从数据库加载
+func (c *CacheVersion) DeleteCloudSetting(ctx context.Context) error {
+	if environment.IsTestEnvironment() {
+		return nil
+	}
+	if err := c.client.Del(ctx, constants.CloudSettingKey).Err(); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "version.DeleteCloudSetting error: %v", err)
+	}
+	return nil
+}
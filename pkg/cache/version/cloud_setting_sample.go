@@ -0,0 +1,49 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/base/environment"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// AddCloudSettingSample 记录一次客户端的匹配条件，只保留最近的 constants.CloudSettingSampleSize 条
+func (c *CacheVersion) AddCloudSettingSample(ctx context.Context, sample string) error {
+	if environment.IsTestEnvironment() {
+		return nil
+	}
+	pipe := c.client.Pipeline()
+	pipe.LPush(ctx, constants.CloudSettingSampleKey, sample)
+	pipe.LTrim(ctx, constants.CloudSettingSampleKey, 0, constants.CloudSettingSampleSize-1)
+	pipe.Expire(ctx, constants.CloudSettingSampleKey, constants.CloudSettingSampleKeyExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "version.AddCloudSettingSample error: %v", err)
+	}
+	return nil
+}
+
+// GetCloudSettingSamples 获取最近的 limit 条匹配条件，新的在前
+func (c *CacheVersion) GetCloudSettingSamples(ctx context.Context, limit int64) ([]string, error) {
+	samples, err := c.client.LRange(ctx, constants.CloudSettingSampleKey, 0, limit-1).Result()
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalRedisErrorCode, "version.GetCloudSettingSamples error: %v", err)
+	}
+	return samples, nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package cloudsetting 云控配置的解析、校验与匹配
+// 配置由若干计划组成，客户端按上报的条件从前往后匹配，命中的第一个计划下发给客户端
+package cloudsetting
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// Criteria 客户端上报的匹配条件，为 nil 的字段不参与匹配
+type Criteria struct {
+	Name      *string `json:"name,omitempty"`
+	Account   *string `json:"account,omitempty"`
+	Version   *string `json:"version,omitempty"`
+	Beta      *bool   `json:"beta,omitempty"`
+	Phone     *string `json:"phone,omitempty"`
+	IsLogin   *bool   `json:"isLogin,omitempty"`
+	LoginType *string `json:"loginType,omitempty"`
+}
+
+// planSpec 配置文件中的计划，字段名与历史配置保持一致
+type planSpec struct {
+	Name      *string
+	Account   *string
+	Version   *string
+	Beta      *bool
+	Phone     *string
+	IsLogin   *bool
+	LoginType *string
+	Plan      json.RawMessage
+}
+
+type settingSpec struct {
+	Plans []planSpec
+}
+
+// Plan 编译后的计划
+type Plan struct {
+	Index int             // 在配置中的位置，从 0 开始
+	Name  string          // 计划名称，未配置时为空
+	Plan  json.RawMessage // 下发给客户端的配置
+	rules []rule
+}
+
+// Setting 编译后的云控配置，解析后只读，可在多个请求间共享
+type Setting struct {
+	Content []byte // 去除注释后的配置
+	Plans   []*Plan
+}
+
+// PlanResult 单个计划的匹配结果
+type PlanResult struct {
+	Plan       *Plan
+	Matched    bool
+	Mismatched []string // 不满足的字段
+}
+
+// Parse 解析并校验配置：去除注释、校验 JSON Schema 并编译所有规则，任一规则无效时返回错误
+func Parse(content []byte) (*Setting, error) {
+	clean := []byte(StripComments(string(content)))
+	if err := validateSchema(clean); err != nil {
+		return nil, err
+	}
+	return compile(clean, false)
+}
+
+// ParseLegacy 解析又拍云上的历史配置，保持原有的宽松行为：不校验 JSON Schema，
+// 版本号不是合法的版本范围时按正则表达式匹配，无法编译的正则在客户端上报该字段时视为不满足
+func ParseLegacy(content []byte) (*Setting, error) {
+	return compile([]byte(StripComments(string(content))), true)
+}
+
+func compile(clean []byte, legacy bool) (*Setting, error) {
+	spec := new(settingSpec)
+	if err := json.Unmarshal(clean, spec); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+	setting := &Setting{
+		Content: clean,
+		Plans:   make([]*Plan, 0, len(spec.Plans)),
+	}
+	for i, p := range spec.Plans {
+		plan, err := compilePlan(i, p, legacy)
+		if err != nil {
+			return nil, fmt.Errorf("Plans[%d].%w", i, err)
+		}
+		setting.Plans = append(setting.Plans, plan)
+	}
+	return setting, nil
+}
+
+func compilePlan(index int, p planSpec, legacy bool) (*Plan, error) {
+	plan := &Plan{Index: index, Plan: p.Plan}
+	if p.Name != nil {
+		plan.Name = *p.Name
+	}
+
+	regexFields := []struct {
+		name    string
+		pattern *string
+		value   func(c *Criteria) *string
+	}{
+		{FieldName, p.Name, func(c *Criteria) *string { return c.Name }},
+		{FieldAccount, p.Account, func(c *Criteria) *string { return c.Account }},
+		{FieldPhone, p.Phone, func(c *Criteria) *string { return c.Phone }},
+		{FieldLoginType, p.LoginType, func(c *Criteria) *string { return c.LoginType }},
+	}
+	for _, f := range regexFields {
+		if f.pattern == nil {
+			continue
+		}
+		re, err := regexp.Compile(*f.pattern)
+		if err != nil && !legacy {
+			return nil, fmt.Errorf("%s: invalid regexp: %w", f.name, err)
+		}
+		plan.rules = append(plan.rules, &regexRule{name: f.name, pattern: *f.pattern, re: re, value: f.value})
+	}
+
+	if p.Version != nil {
+		versions, err := ParseRange(*p.Version)
+		switch {
+		case err == nil:
+			plan.rules = append(plan.rules, &versionRule{raw: *p.Version, versions: versions})
+		case legacy:
+			re, _ := regexp.Compile(*p.Version)
+			plan.rules = append(plan.rules, &regexRule{
+				name: FieldVersion, pattern: *p.Version, re: re, value: func(c *Criteria) *string { return c.Version },
+			})
+		default:
+			return nil, fmt.Errorf("%s: %w", FieldVersion, err)
+		}
+	}
+
+	if p.Beta != nil {
+		plan.rules = append(plan.rules, &boolRule{name: FieldBeta, want: *p.Beta, value: func(c *Criteria) *bool { return c.Beta }})
+	}
+	if p.IsLogin != nil {
+		plan.rules = append(plan.rules, &boolRule{name: FieldIsLogin, want: *p.IsLogin, value: func(c *Criteria) *bool { return c.IsLogin }})
+	}
+	return plan, nil
+}
+
+// Match 返回条件命中的第一个计划，没有命中时返回 errno.NoMatchingPlanError
+func (s *Setting) Match(c *Criteria) (*Plan, error) {
+	in := newInput(c)
+	for _, plan := range s.Plans {
+		if plan.matches(in) {
+			return plan, nil
+		}
+	}
+	return nil, errno.NoMatchingPlanError
+}
+
+// Explain 返回每个计划对条件的匹配结果，用于试运行与排查配置
+func (s *Setting) Explain(c *Criteria) []PlanResult {
+	in := newInput(c)
+	results := make([]PlanResult, 0, len(s.Plans))
+	for _, plan := range s.Plans {
+		result := PlanResult{Plan: plan, Mismatched: make([]string, 0)}
+		for _, r := range plan.rules {
+			if !r.match(in) {
+				result.Mismatched = append(result.Mismatched, r.field())
+			}
+		}
+		result.Matched = len(result.Mismatched) == 0
+		results = append(results, result)
+	}
+	return results
+}
+
+func (p *Plan) matches(in *input) bool {
+	for _, r := range p.rules {
+		if !r.match(in) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsetting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestParse(t *testing.T) {
+	type testCase struct {
+		name          string
+		content       string
+		expectPlans   int
+		expectedError string
+	}
+
+	testCases := []testCase{
+		{
+			name: "ValidWithComments",
+			content: `{
+				"Plans": [
+					// 内测用户
+					{"Name": "beta", "Beta": true, "Version": ">= 5.0, < 5.2 || 6.0.0", "Plan": {"key": "beta"}},
+					{"Name": "default", "Plan": {"key": "default"}}
+				]
+			}`,
+			expectPlans: 2,
+		},
+		{
+			name:          "InvalidJSON",
+			content:       `{"Plans": [`,
+			expectedError: "invalid json",
+		},
+		{
+			name:          "UnknownField",
+			content:       `{"Plans": [{"Nmae": "typo", "Plan": {}}]}`,
+			expectedError: "schema validation failed",
+		},
+		{
+			name:          "MissingPlan",
+			content:       `{"Plans": [{"Name": "default"}]}`,
+			expectedError: "schema validation failed",
+		},
+		{
+			name:          "WrongType",
+			content:       `{"Plans": [{"Beta": "true", "Plan": {}}]}`,
+			expectedError: "schema validation failed",
+		},
+		{
+			name:          "InvalidRegexp",
+			content:       `{"Plans": [{"Account": "(", "Plan": {}}]}`,
+			expectedError: "Plans[0].Account: invalid regexp",
+		},
+		{
+			name:          "InvalidVersionRange",
+			content:       `{"Plans": [{"Plan": {}}, {"Version": "5\\.1.*", "Plan": {}}]}`,
+			expectedError: "Plans[1].Version: invalid version range",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setting, err := Parse([]byte(tc.content))
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				assert.Nil(t, setting)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, setting.Plans, tc.expectPlans)
+			assert.NotContains(t, string(setting.Content), "内测用户")
+		})
+	}
+}
+
+func TestParseLegacy(t *testing.T) {
+	content := `{"Plans": [
+		{"Name": "broken", "Account": "(", "Plan": {"key": "broken"}},
+		{"Name": "regex", "Version": "5\\.1.*", "Plan": {"key": "regex"}},
+		{"Name": "default", "Plan": {"key": "default"}}
+	]}`
+
+	setting, err := ParseLegacy([]byte(content))
+	assert.NoError(t, err)
+
+	// 无法编译的正则只在客户端上报该字段时不满足
+	plan, err := setting.Match(&Criteria{})
+	assert.NoError(t, err)
+	assert.Equal(t, "broken", plan.Name)
+
+	// 不是合法版本范围的版本号按正则匹配
+	plan, err = setting.Match(&Criteria{Account: strPtr("102301000"), Version: strPtr("5.1.3")})
+	assert.NoError(t, err)
+	assert.Equal(t, "regex", plan.Name)
+
+	plan, err = setting.Match(&Criteria{Account: strPtr("102301000"), Version: strPtr("5.2.0")})
+	assert.NoError(t, err)
+	assert.Equal(t, "default", plan.Name)
+}
+
+func TestMatch(t *testing.T) {
+	setting, err := Parse([]byte(`{"Plans": [
+		{"Name": "old", "Version": "< 5.0", "Plan": {"key": "old"}},
+		{"Name": "beta", "Beta": true, "IsLogin": true, "Plan": {"key": "beta"}},
+		{"Name": "staff", "Account": "^0523", "LoginType": "jwch", "Plan": {"key": "staff"}},
+		{"Name": "huawei", "Phone": "(?i)huawei", "Plan": {"key": "huawei"}}
+	]}`))
+	assert.NoError(t, err)
+
+	type testCase struct {
+		name          string
+		criteria      *Criteria
+		expectPlan    string
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			name:       "VersionRange",
+			criteria:   &Criteria{Version: strPtr("4.9.1"), Phone: strPtr("Xiaomi")},
+			expectPlan: "old",
+		},
+		{
+			name:       "UnreportedFieldsMatch",
+			criteria:   &Criteria{},
+			expectPlan: "old",
+		},
+		{
+			name:       "BoolFields",
+			criteria:   &Criteria{Version: strPtr("5.1.0"), Beta: boolPtr(true), IsLogin: boolPtr(true)},
+			expectPlan: "beta",
+		},
+		{
+			name: "RegexFields",
+			criteria: &Criteria{
+				Version: strPtr("5.1.0"), Beta: boolPtr(false), Account: strPtr("052306000"), LoginType: strPtr("jwch"),
+			},
+			expectPlan: "staff",
+		},
+		{
+			name:       "UnparsableVersion",
+			criteria:   &Criteria{Version: strPtr("unknown"), Beta: boolPtr(false), Account: strPtr("1"), Phone: strPtr("HUAWEI P60")},
+			expectPlan: "huawei",
+		},
+		{
+			name:          "NoMatchingPlan",
+			criteria:      &Criteria{Version: strPtr("5.1.0"), Beta: boolPtr(false), Account: strPtr("1"), Phone: strPtr("Xiaomi")},
+			expectedError: errno.NoMatchingPlanError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan, err := setting.Match(tc.criteria)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				assert.Nil(t, plan)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectPlan, plan.Name)
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	setting, err := Parse([]byte(`{"Plans": [
+		{"Name": "beta", "Beta": true, "Version": ">= 6.0", "Plan": {"key": "beta"}},
+		{"Name": "default", "Plan": {"key": "default"}}
+	]}`))
+	assert.NoError(t, err)
+
+	results := setting.Explain(&Criteria{Version: strPtr("5.1.0"), Beta: boolPtr(false)})
+	assert.Len(t, results, 2)
+	assert.False(t, results[0].Matched)
+	assert.Equal(t, []string{FieldVersion, FieldBeta}, results[0].Mismatched)
+	assert.True(t, results[1].Matched)
+	assert.Empty(t, results[1].Mismatched)
+	assert.Equal(t, 1, results[1].Plan.Index)
+}
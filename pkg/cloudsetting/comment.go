@@ -0,0 +1,52 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsetting
+
+import "strings"
+
+// StripComments 去除配置中的 // 注释与空行，字符串中的 // （例如 url）会被保留
+func StripComments(input string) string {
+	lines := strings.Split(input, "\n")
+	cleanLines := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if cleanLine := removeComments(line); cleanLine != "" {
+			cleanLines = append(cleanLines, cleanLine)
+		}
+	}
+	return strings.Join(cleanLines, "\n")
+}
+
+// removeComments 去除一行中字符串之外的 // 注释
+func removeComments(line string) string {
+	inString := false
+	var stringChar byte
+
+	for i := 0; i < len(line); i++ {
+		if line[i] == '"' || line[i] == '\'' {
+			if !inString {
+				inString = true
+				stringChar = line[i]
+			} else if stringChar == line[i] {
+				inString = false
+			}
+		}
+		if i+1 < len(line) && line[i:i+2] == "//" && !inString {
+			return line[:i]
+		}
+	}
+	return line
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsetting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripComments(t *testing.T) {
+	type testCase struct {
+		name          string
+		input         string
+		checkContains []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "NoComments",
+			input: `{
+				"key": "value",
+				"number": 123
+			}`,
+			checkContains: []string{`"key": "value"`, `"number": 123`},
+		},
+		{
+			name: "WithComments",
+			input: `{
+				"key": "value", // This is a comment
+				"number": 123 // Another comment
+			}`,
+			checkContains: []string{`"key": "value"`, `"number": 123`},
+		},
+		{
+			name: "CommentsInString",
+			input: `{
+				"url": "http://example.com", // URL should not be affected
+				"comment": "// This is not a comment"
+			}`,
+			checkContains: []string{`"url": "http://example.com"`, `"comment": "// This is not a comment"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := StripComments(tc.input)
+			assert.NotContains(t, result, "This is a comment")
+			assert.NotContains(t, result, "URL should not be affected")
+			for _, contain := range tc.checkContains {
+				assert.Contains(t, result, contain)
+			}
+		})
+	}
+}
+
+func TestRemoveComments(t *testing.T) {
+	type testCase struct {
+		name         string
+		input        string
+		expectOutput string
+	}
+
+	testCases := []testCase{
+		{
+			name:         "NoComments",
+			input:        `"key": "value"`,
+			expectOutput: `"key": "value"`,
+		},
+		{
+			name:         "WithComment",
+			input:        `"key": "value" // This is a comment`,
+			expectOutput: `"key": "value" `,
+		},
+		{
+			name:         "URLNotAffected",
+			input:        `"url": "http://example.com"`,
+			expectOutput: `"url": "http://example.com"`,
+		},
+		{
+			name:         "CommentInString",
+			input:        `"text": "some // text" // actual comment`,
+			expectOutput: `"text": "some // text" `,
+		},
+		{
+			name:         "EmptyString",
+			input:        `""`,
+			expectOutput: `""`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := removeComments(tc.input)
+			assert.Equal(t, tc.expectOutput, result)
+		})
+	}
+}
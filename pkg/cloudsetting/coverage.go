@@ -0,0 +1,118 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsetting
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// PlanCoverage 单个计划在样本中的覆盖情况
+type PlanCoverage struct {
+	Plan     *Plan
+	Hits     int // 实际下发该计划的样本数
+	Eligible int // 满足该计划所有规则的样本数，超出 Hits 的部分被前面的计划截走
+	// ShadowedBy 前面某个计划的规则是该计划规则的子集时，满足该计划的条件一定先命中那个计划，该计划永远不会下发
+	ShadowedBy *Plan
+}
+
+// UnmatchedCriteria 没有命中任何计划的条件及其在样本中的出现次数
+type UnmatchedCriteria struct {
+	Criteria *Criteria
+	Count    int
+}
+
+// Coverage 用样本条件模拟匹配得到的覆盖率报告
+type Coverage struct {
+	Samples   int
+	Plans     []PlanCoverage
+	Unmatched []UnmatchedCriteria // 按出现次数从多到少排列
+}
+
+// Coverage 统计样本条件在各计划上的分布，并找出不可达的计划与没有命中任何计划的条件
+func (s *Setting) Coverage(samples []*Criteria) *Coverage {
+	report := &Coverage{
+		Samples:   len(samples),
+		Plans:     make([]PlanCoverage, len(s.Plans)),
+		Unmatched: make([]UnmatchedCriteria, 0),
+	}
+	for i, plan := range s.Plans {
+		report.Plans[i] = PlanCoverage{Plan: plan, ShadowedBy: s.shadowedBy(i)}
+	}
+
+	unmatched := make(map[string]int) // 条件 -> 在 report.Unmatched 中的下标
+	for _, c := range samples {
+		in := newInput(c)
+		hit := false
+		for i, plan := range s.Plans {
+			if !plan.matches(in) {
+				continue
+			}
+			report.Plans[i].Eligible++
+			if !hit {
+				report.Plans[i].Hits++
+				hit = true
+			}
+		}
+		if hit {
+			continue
+		}
+		key := criteriaKey(c)
+		if idx, ok := unmatched[key]; ok {
+			report.Unmatched[idx].Count++
+			continue
+		}
+		unmatched[key] = len(report.Unmatched)
+		report.Unmatched = append(report.Unmatched, UnmatchedCriteria{Criteria: c, Count: 1})
+	}
+
+	sort.SliceStable(report.Unmatched, func(i, j int) bool {
+		return report.Unmatched[i].Count > report.Unmatched[j].Count
+	})
+	return report
+}
+
+// shadowedBy 返回使第 index 个计划不可达的第一个计划
+// 客户端不会上报计划名称，前面计划的 Name 规则不限制匹配，不参与判断
+// 只识别规则完全相同的情况，例如版本范围互相包含但写法不同时不会被判定为不可达，此时可参考样本中的 Eligible 与 Hits
+func (s *Setting) shadowedBy(index int) *Plan {
+	keys := make(map[string]struct{}, len(s.Plans[index].rules))
+	for _, r := range s.Plans[index].rules {
+		keys[r.key()] = struct{}{}
+	}
+	for _, earlier := range s.Plans[:index] {
+		covered := true
+		for _, r := range earlier.rules {
+			if r.field() == FieldName {
+				continue
+			}
+			if _, ok := keys[r.key()]; !ok {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return earlier
+		}
+	}
+	return nil
+}
+
+func criteriaKey(c *Criteria) string {
+	key, _ := json.Marshal(c)
+	return string(key)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsetting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoverage(t *testing.T) {
+	setting, err := Parse([]byte(`{"Plans": [
+		{"Name": "beta", "Beta": true, "Plan": {"key": "beta"}},
+		{"Name": "beta-new", "Beta": true, "Version": ">= 6.0", "Plan": {"key": "beta-new"}},
+		{"Name": "new", "Version": ">= 6.0", "Plan": {"key": "new"}},
+		{"Name": "login", "IsLogin": true, "Plan": {"key": "login"}}
+	]}`))
+	assert.NoError(t, err)
+
+	samples := []*Criteria{
+		{Beta: boolPtr(true), Version: strPtr("6.1.0")},
+		{Beta: boolPtr(false), Version: strPtr("6.0.0")},
+		{Beta: boolPtr(false), Version: strPtr("5.0.0"), IsLogin: boolPtr(true)},
+		{Beta: boolPtr(false), Version: strPtr("5.0.0"), IsLogin: boolPtr(false)},
+		{Beta: boolPtr(false), Version: strPtr("5.0.0"), IsLogin: boolPtr(false)},
+		{Beta: boolPtr(false), Version: strPtr("4.0.0"), IsLogin: boolPtr(false)},
+	}
+
+	report := setting.Coverage(samples)
+	assert.Equal(t, 6, report.Samples)
+
+	type planCoverage struct {
+		hits, eligible int
+		shadowedBy     string
+	}
+	expectPlans := []planCoverage{
+		{hits: 1, eligible: 1},
+		{hits: 0, eligible: 1, shadowedBy: "beta"},
+		{hits: 1, eligible: 2},
+		{hits: 1, eligible: 3}, // 没有上报 IsLogin 的样本也满足
+	}
+	for i, expect := range expectPlans {
+		plan := report.Plans[i]
+		assert.Equal(t, i, plan.Plan.Index)
+		assert.Equal(t, expect.hits, plan.Hits, plan.Plan.Name)
+		assert.Equal(t, expect.eligible, plan.Eligible, plan.Plan.Name)
+		if expect.shadowedBy == "" {
+			assert.Nil(t, plan.ShadowedBy, plan.Plan.Name)
+		} else {
+			assert.Equal(t, expect.shadowedBy, plan.ShadowedBy.Name)
+		}
+	}
+
+	// 相同的条件合并计数，出现次数多的在前
+	assert.Len(t, report.Unmatched, 2)
+	assert.Equal(t, 2, report.Unmatched[0].Count)
+	assert.Equal(t, "5.0.0", *report.Unmatched[0].Criteria.Version)
+	assert.Equal(t, 1, report.Unmatched[1].Count)
+	assert.Equal(t, "4.0.0", *report.Unmatched[1].Criteria.Version)
+}
+
+func TestShadowedBy(t *testing.T) {
+	type testCase struct {
+		name         string
+		content      string
+		expectShadow []int // 每个计划被哪个计划遮蔽，-1 表示可达
+	}
+
+	testCases := []testCase{
+		{
+			name: "CatchAllShadowsLaterPlans",
+			content: `{"Plans": [
+				{"Name": "default", "Plan": {}},
+				{"Name": "beta", "Beta": true, "Plan": {}}
+			]}`,
+			expectShadow: []int{-1, 0},
+		},
+		{
+			name: "NameDoesNotRestrict",
+			content: `{"Plans": [
+				{"Name": "first", "Account": "^1023", "Plan": {}},
+				{"Name": "second", "Account": "^1023", "Phone": "Android", "Plan": {}}
+			]}`,
+			expectShadow: []int{-1, 0},
+		},
+		{
+			name: "DifferentRules",
+			content: `{"Plans": [
+				{"Beta": true, "Plan": {}},
+				{"Beta": false, "Plan": {}},
+				{"Version": ">= 5.0", "Plan": {}},
+				{"Version": ">=5.0, < 7.0", "Plan": {}}
+			]}`,
+			expectShadow: []int{-1, -1, -1, -1},
+		},
+		{
+			name: "IdenticalPlans",
+			content: `{"Plans": [
+				{"IsLogin": true, "LoginType": "jwch", "Plan": {}},
+				{"LoginType": "jwch", "IsLogin": true, "Plan": {}}
+			]}`,
+			expectShadow: []int{-1, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setting, err := Parse([]byte(tc.content))
+			assert.NoError(t, err)
+			for i, expect := range tc.expectShadow {
+				shadow := setting.shadowedBy(i)
+				if expect < 0 {
+					assert.Nil(t, shadow, "plan %d", i)
+				} else {
+					assert.Equal(t, expect, shadow.Index, "plan %d", i)
+				}
+			}
+		})
+	}
+}
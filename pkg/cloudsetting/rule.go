@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsetting
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/go-version"
+)
+
+// 计划中可配置的匹配字段
+const (
+	FieldName      = "Name"
+	FieldAccount   = "Account"
+	FieldVersion   = "Version"
+	FieldBeta      = "Beta"
+	FieldPhone     = "Phone"
+	FieldIsLogin   = "IsLogin"
+	FieldLoginType = "LoginType"
+)
+
+// input 一次匹配的输入，客户端版本号只解析一次，供所有计划的版本规则使用
+type input struct {
+	criteria *Criteria
+	version  *version.Version // 客户端未上报版本号或版本号无法解析时为 nil
+}
+
+func newInput(c *Criteria) *input {
+	in := &input{criteria: c}
+	if c.Version != nil {
+		in.version, _ = version.NewVersion(*c.Version)
+	}
+	return in
+}
+
+// rule 计划中单个字段的匹配规则，客户端没有上报该字段时视为满足
+type rule interface {
+	field() string
+	// key 规则的规范表示，key 相同的两条规则匹配结果一定相同
+	key() string
+	match(in *input) bool
+}
+
+// regexRule 字符串字段按正则表达式匹配；re 为 nil 表示旧配置中无法编译的正则，客户端上报该字段时一律不满足
+type regexRule struct {
+	name    string
+	pattern string
+	re      *regexp.Regexp
+	value   func(c *Criteria) *string
+}
+
+func (r *regexRule) field() string { return r.name }
+
+func (r *regexRule) key() string { return r.name + " ~ " + r.pattern }
+
+func (r *regexRule) match(in *input) bool {
+	v := r.value(in.criteria)
+	if v == nil {
+		return true
+	}
+	return r.re != nil && r.re.MatchString(*v)
+}
+
+type boolRule struct {
+	name  string
+	want  bool
+	value func(c *Criteria) *bool
+}
+
+func (r *boolRule) field() string { return r.name }
+
+func (r *boolRule) key() string { return r.name + " = " + strconv.FormatBool(r.want) }
+
+func (r *boolRule) match(in *input) bool {
+	v := r.value(in.criteria)
+	return v == nil || *v == r.want
+}
+
+// versionRule 版本号按语义化版本范围匹配，客户端上报的版本号无法解析时不满足
+type versionRule struct {
+	raw      string
+	versions Range
+}
+
+func (r *versionRule) field() string { return FieldVersion }
+
+func (r *versionRule) key() string { return FieldVersion + " in " + r.raw }
+
+func (r *versionRule) match(in *input) bool {
+	if in.criteria.Version == nil {
+		return true
+	}
+	return in.version != nil && r.versions.Contains(in.version)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsetting
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+//go:embed schema.json
+var schemaJSON []byte
+
+// Schema 云控配置的 JSON Schema，供管理端编辑配置时参考
+func Schema() []byte {
+	return schemaJSON
+}
+
+var resolvedSchema = mustResolveSchema()
+
+func mustResolveSchema() *jsonschema.Resolved {
+	schema := new(jsonschema.Schema)
+	if err := json.Unmarshal(schemaJSON, schema); err != nil {
+		panic(fmt.Sprintf("cloudsetting: unmarshal schema: %v", err))
+	}
+	resolved, err := schema.Resolve(nil)
+	if err != nil {
+		panic(fmt.Sprintf("cloudsetting: resolve schema: %v", err))
+	}
+	return resolved
+}
+
+// validateSchema 校验去除注释后的配置是否符合 JSON Schema
+func validateSchema(content []byte) error {
+	var instance any
+	if err := json.Unmarshal(content, &instance); err != nil {
+		return fmt.Errorf("invalid json: %w", err)
+	}
+	if err := resolvedSchema.Validate(instance); err != nil {
+		return fmt.Errorf("schema validation failed: %w", err)
+	}
+	return nil
+}
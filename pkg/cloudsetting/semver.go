@@ -0,0 +1,51 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsetting
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// Range 版本范围，由 || 分隔的若干组约束构成，满足任一组即可
+// 组内以逗号分隔的约束需同时满足，例如 ">= 5.0, < 5.2 || 6.0.0"；支持 =、!=、>、>=、<、<=、~> 运算符
+type Range []version.Constraints
+
+// ParseRange 解析版本范围
+func ParseRange(s string) (Range, error) {
+	var r Range
+	for group := range strings.SplitSeq(s, "||") {
+		constraints, err := version.NewConstraint(strings.TrimSpace(group))
+		if err != nil {
+			return nil, fmt.Errorf("invalid version range %q: %w", s, err)
+		}
+		r = append(r, constraints)
+	}
+	return r, nil
+}
+
+// Contains 判断版本是否在范围内
+func (r Range) Contains(v *version.Version) bool {
+	for _, constraints := range r {
+		if constraints.Check(v) {
+			return true
+		}
+	}
+	return false
+}
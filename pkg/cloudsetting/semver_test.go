@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsetting
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeContains(t *testing.T) {
+	type testCase struct {
+		name          string
+		versionRange  string
+		version       string
+		expectResult  bool
+		expectedError bool
+	}
+
+	testCases := []testCase{
+		{
+			name:         "InRange",
+			versionRange: ">= 5.0, < 5.2",
+			version:      "5.1.3",
+			expectResult: true,
+		},
+		{
+			name:         "UpperBoundExcluded",
+			versionRange: ">= 5.0, < 5.2",
+			version:      "5.2.0",
+			expectResult: false,
+		},
+		{
+			name:         "SecondGroup",
+			versionRange: ">= 5.0, < 5.2 || 6.0.0",
+			version:      "6.0.0",
+			expectResult: true,
+		},
+		{
+			name:         "PessimisticConstraint",
+			versionRange: "~> 4.1",
+			version:      "4.9.0",
+			expectResult: true,
+		},
+		{
+			name:          "InvalidRange",
+			versionRange:  "5.*",
+			expectedError: true,
+		},
+		{
+			name:          "EmptyGroup",
+			versionRange:  ">= 5.0 ||",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := ParseRange(tc.versionRange)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectResult, r.Contains(version.Must(version.NewVersion(tc.version))))
+		})
+	}
+}
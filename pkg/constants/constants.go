@@ -35,9 +35,34 @@ const (
 	VersionVisitDefaultPageSize = 10 // 读取的条目
 )
 
+// 版本发布
+const (
+	VersionChannelRelease = "release"
+	VersionChannelBeta    = "beta"
+	VersionChannelNightly = "nightly"
+
+	VersionStatusActive     = "active"      // 生效中，参与灰度计算
+	VersionStatusRolledBack = "rolled_back" // 已回滚，不再下发但保留在版本历史中；版本号不可复用，修复后需以新的 code 发布
+
+	VersionRolloutFull       = 100 // 全量发布的灰度比例
+	VersionRolloutCandidates = 10  // 灰度计算时最多向前查找的版本数，超出后回退到又拍云上的版本文件
+
+	VersionListDefaultPageSize  = 20  // 版本历史默认每页条数
+	VersionListMaxPageSize      = 100 // 版本历史每页最大条数
+	VersionChangelogMaxVersions = 50  // 更新日志最多拼接的版本数，跳过更多版本时只展示最新的部分
+
+	CloudSettingSampleSize = 1000 // 保留最近的客户端匹配条件数量，用于生成云控计划覆盖率报告
+)
+
+// ClassroomSortFreeLongest 空教室按请求节次之后的连续空闲时长倒序排列
+const ClassroomSortFreeLongest = "free_longest"
+
 // CampusArray 校区数组
 var CampusArray = []string{"旗山校区", "厦门工艺美院", "铜盘校区", "怡山校区", "晋江校区", "泉港校区"}
 
+// XiaMenSubCampusArray 厦门工艺美院在教务处是一个校区，对外按鼓浪屿、集美两个校区提供空教室
+var XiaMenSubCampusArray = []string{"鼓浪屿校区", "集美校区"}
+
 var IgnoreUpyunDir = map[string]bool{
 	"upyun_storage_log_AhYIBW15": true,
 	"test":                       true,
@@ -31,10 +31,15 @@ const (
 	UserRelationTableName        = "follow_relation"
 	CourseTableName              = "course"
 	ExamOfferingsTableName       = "exam_offerings"
+	ExamRemindersTableName       = "exam_reminders"
+	ExamSubscribersTableName     = "exam_subscribers"
+	ExamRoomSnapshotTableName    = "exam_room_snapshot"
+	ExamRoomChangeTableName      = "exam_room_change"
 	TermTableName                = "term"
 	LaunchScreenTableName        = "launch_screen"
 	NoticeTableName              = "notice"
 	ScoreTableName               = "scores"
+	ScoreSubscribersTableName    = "score_subscribers"
 	VisitTableName               = "visit"
 	CourseOfferingsTableName     = "course_offerings"
 	ToolboxConfigTableName       = "toolbox_config"
@@ -42,6 +47,13 @@ const (
 	FriendConfigTableName        = "friend_config"
 	CourseTeacherScoresTableName = "course_teacher_scores"
 	AutoAdjustCourseTableName    = "auto_adjust_course"
+	NoticeSubscriptionTableName  = "notice_subscription"
+	NoticeAttachmentTableName    = "notice_attachment"
+	NotificationTableName        = "notification"
+	DeviceTableName              = "device"
+	NotificationPrefTableName    = "notification_preference"
+	AppVersionTableName          = "app_version"
+	CloudSettingTableName        = "cloud_setting"
 )
 
 // Biz
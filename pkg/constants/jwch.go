@@ -27,4 +27,9 @@ const (
 	// 学号第3-4位为入学年份（如 22/25/26），年份 >= StudentIDYearThreshold 时为10位新学号。
 	StudentIDLengthNew     = 10
 	StudentIDYearThreshold = 26
+
+	// 教务处不可用时返回数据库快照，并在后台重新拉取，超过次数后放弃
+	// 每次重试间隔熔断器的打开时长，未启用熔断时至少间隔 StaleRefreshMinDelay
+	StaleRefreshMaxAttempts = 5
+	StaleRefreshMinDelay    = 30 * ONE_SECOND
 )
@@ -30,6 +30,8 @@ const (
 // Expire Time
 const (
 	ClassroomKeyExpire          = 2 * ONE_DAY     // [classroom] 空教室
+	RoomScheduleKeyExpire       = 1 * ONE_HOUR    // [classroom] 单个教室的占用情况，需短于当天空教室的同步间隔
+	RoomDirectoryKeyExpire      = 30 * ONE_DAY    // [classroom] 同步中出现过的教室目录
 	LaunchScreenKeyExpire       = 2 * ONE_DAY     // [launch_screen] 开屏页
 	UserInfoKeyExpire           = 1 * ONE_WEEK    // [user] 用户信息
 	CommonTermListKeyExpire     = 1 * ONE_WEEK    // [common] 学期列表
@@ -43,6 +45,9 @@ const (
 	UserInvitationCodeKeyExpire = 1 * ONE_DAY     // [user] 邀请码
 	UserFriendKeyExpire         = 3 * ONE_DAY     // [user] 好友列表
 	AutoAdjustCourseKeyExpire   = 1 * ONE_DAY     // [common] 调课信息
+	NoticeDetailKeyExpire       = 1 * ONE_DAY     // [common] 通知详情，重新同步正文时会主动删除
+	CloudSettingKeyExpire       = 1 * ONE_HOUR    // [version] 云控配置，修改配置时会主动删除
+	CloudSettingSampleKeyExpire = 1 * ONE_DAY     // [version] 客户端匹配条件样本，每次写入时刷新
 )
 
 // Key Name
@@ -54,6 +59,17 @@ const (
 	ContributorFzuhelperServerKey = "contributor:fzuhelper-server" // [common]
 	LastLaunchScreenIdKey         = "last_launch_screen_id"        // [launch_screen]
 	LocateDateKey                 = "locateDate"                   // [course]
+	RateLimitKeyPrefix            = "ratelimit"                    // [api]
+	GovernorKeyPrefix             = "governor"                     // [jwch/yjsy 出口治理]
+	UmengTaskStreamKey            = "umeng:tasks"                  // [umeng 推送队列] 后接优先级名称，每个优先级一个队列
+	UmengTaskRetryKey             = "umeng:retry"                  // [umeng 推送队列] 等待重试的任务，后接优先级名称，score 为重试时间
+	UmengTaskDeadKey              = "umeng:tasks:dead"             // [umeng 推送队列] 死信
+	UmengTaskDoneKeyPrefix        = "umeng:done"                   // [umeng 推送队列] 幂等标记
+	UmengConsumerGroup            = "umeng-dispatcher"             // [umeng 推送队列] 消费者组
+	UmengQuotaCountKeyPrefix      = "umeng:quota:count"            // [umeng 推送配额] 当天已发送次数，后接日期
+	UmengQuotaLeaseKey            = "umeng:quota:lease"            // [umeng 推送配额] 发送间隔租约，存在时其他实例需要等待
+	CloudSettingKey               = "cloud_setting"                // [version] 当前生效的云控配置原文
+	CloudSettingSampleKey         = "cloud_setting:samples"        // [version] 最近的客户端匹配条件，list，新的在前
 )
 
 // DB Name
@@ -67,4 +83,7 @@ const (
 	RedisDBAcademic     = 6
 	RedisDBVersion      = 7
 	RedisDBOA           = 8
+	RedisDBRateLimit    = 9
+	RedisDBGovernor     = 10 // 所有访问教务处的服务共享
+	RedisDBUmeng        = 11 // 所有推送通知的服务共享
 )
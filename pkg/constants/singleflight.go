@@ -30,9 +30,6 @@ const (
 	SingleflightBetaVersionKey    = "beta_version"
 
 	SingleflightCloudKey = "cloud"
-
-	// Android 接口一次返回 release 和 beta 两份数据，使用独立 key 避免和单独版本查询混用。
-	SingleflightAndroidVersionKey = "android_version"
 )
 
 // 此处为动态key的 prefix，需调用sf.key函数生成完整 key
@@ -43,17 +40,24 @@ const (
 	// 考场结果按学期和身份区分，同一学生不同学期或不同身份不能复用结果。
 	SingleflightExamRoomsPrefix = "classroom:exam_rooms"
 
+	// 同一教室同一天的占用情况对所有用户相同，按教室和日期合并请求。
+	SingleflightRoomSchedulePrefix = "classroom:room_schedule"
+
 	// 将刷新标记纳入 key，避免强刷请求复用普通请求的 singleflight 结果。
 	SingleflightCourseListPrefix = "course:list"
 
 	// 本科和研究生学期来源不同，同一学号也要按身份隔离。
 	SingleflightCourseTermsPrefix = "course:terms"
 
-	SingleflightTermPrefix       = "common:term"
-	SingleflightNoticePrefix     = "common:notice"
-	SingleflightPaperDirPrefix   = "paper:dir"
-	SingleflightFriendListPrefix = "user:friend_list"
+	SingleflightTermPrefix         = "common:term"
+	SingleflightNoticePrefix       = "common:notice"
+	SingleflightNoticeDetailPrefix = "common:notice_detail"
+	SingleflightPaperDirPrefix     = "paper:dir"
+	SingleflightFriendListPrefix   = "user:friend_list"
 
 	// 本科和研究生用户信息来自不同上游，按身份隔离避免复用到错误来源的数据。
 	SingleflightUserInfoPrefix = "user:info"
+
+	// 灰度候选版本对同一平台、渠道的所有请求者相同，灰度分桶在合并后的结果上逐个请求计算。
+	SingleflightVersionCandidatesPrefix = "version:candidates"
 )
@@ -22,6 +22,12 @@ import "time"
 const (
 	ClassroomScheduledTime = ONE_DAY      // 空教室非当天同步时间
 	ClassroomUpdatedTime   = 6 * ONE_HOUR // 当天空教室更新间隔
+	ClassroomPeriods       = 11           // 每天的节次数，空教室位图的第 i 位表示第 i+1 节空闲
+
+	ClassroomSyncMaxAttempts = 3               // 单节空教室请求的最大尝试次数
+	ClassroomSyncRetryDelay  = 2 * time.Second // 单节空教室请求首次重试的等待时间，之后指数退避
+
+	ExamRoomChangeListLimit = 100 // 考场变化记录单次最多返回的条数
 )
 
 // notice 教务处教学通知
@@ -29,12 +35,49 @@ const (
 	NoticeTaskKey    = "notice"
 	NoticeUpdateTime = 1 * time.Hour // (notice) 通知更新间隔
 	NoticePageSize   = 20            // 教务处教学通知一页大小固定 20
+
+	NoticeSourceJwch          = "jwch"           // 教务处通知来源，未指定来源时的默认值
+	NoticeSourceMinInterval   = 10 * time.Minute // 校内网站通知来源的最短同步间隔
+	NoticeSourceDefaultPeriod = 1 * time.Hour    // 校内网站通知来源未配置间隔时的同步间隔
+	NoticeSourceFetchTimeout  = 15 * time.Second // 抓取校内网站列表页或正文的超时时间
+	NoticeSourceMaxNameLen    = 32               // 通知来源标识的最大长度，与 notice.source 列一致
+
+	NoticeDetailTaskKeyPrefix  = "notice_detail:"   // 通知正文抓取、附件转存与索引任务的 key 前缀，后接通知 id
+	NoticeDetailBackfillLimit  = 200                // 每次启动时每个来源最多补抓正文的历史通知数
+	NoticeDetailBackfillWindow = 90 * ONE_DAY       // 仅为该时长内发布的历史通知补抓正文
+	NoticeIndexName            = "fzuhelper-notice" // 通知全文检索的 Elasticsearch 索引
+	NoticeSearchMaxQueryLen    = 64                 // 通知搜索关键词的最大长度（按字符计）
+	NoticeSearchSnippetRadius  = 30                 // 数据库兜底搜索时，高亮片段在命中位置前后保留的字符数
+	NoticeSearchMaxHighlights  = 3                  // 每条搜索结果最多返回的正文高亮片段数
+
+	NoticeSubscriptionMaxPerUser    = 20 // 每名学生最多订阅的关键词数
+	NoticeSubscriptionKeywordMaxLen = 16 // 订阅关键词的最大长度（按字符计）
+
+	NoticeAttachmentMaxSize         = 50 * MB          // 单个附件转存到 OSS 的大小上限，超过时保留原始地址
+	NoticeAttachmentDownloadTimeout = 30 * time.Second // 从教务处下载单个附件的超时时间
+)
+
+// notification 通知中心
+const (
+	NotificationCleanupTaskKey       = "notificationCleanup"
+	NotificationCleanupInterval      = 24 * time.Hour // 清理过期通知的间隔
+	NotificationCleanupBatchSize     = 1000           // 单次删除的最大通知数，避免长时间锁表
+	NotificationInsertBatchSize      = 500            // 批量写入收件箱时单条 INSERT 的最大行数
+	NotificationPageSize             = 20             // 通知列表一页大小
+	NotificationMarkReadMaxIDs       = 100            // 单次按 id 标记已读的最大数量
+	NotificationDefaultRetention     = 180 * ONE_DAY  // 未配置时通知的最长保留时间
+	NotificationDefaultReadRetention = 30 * ONE_DAY   // 未配置时已读通知的保留时间
 )
 
 // course 课程信息
 const (
 	LocateDateTaskKey    = "locateDate"
 	LocateDateUpdateTime = 30 * time.Minute // (locateDate) 定位日期更新间隔
+
+	ExamReminderTaskKey       = "examReminder"
+	ExamReminderScanInterval  = 5 * time.Minute // 扫描到点考试提醒的间隔
+	ExamReminderBatchSize     = 100             // 单次扫描最多处理的到点提醒数
+	ExamReminderDefaultOffset = 24 * time.Hour  // 未配置提前量时默认考前一天提醒
 )
 
 // version
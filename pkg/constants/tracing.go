@@ -16,6 +16,8 @@ limitations under the License.
 
 package constants
 
+import "time"
+
 const (
 	AttributeStuId = "stu_id"
 )
@@ -27,3 +29,18 @@ const (
 	AttributeTaskQueueType     = "taskqueue.type"
 	AttributeTaskQueueRequeues = "taskqueue.requeues"
 )
+
+// metrics
+const (
+	MetricsPath              = "/metrics"
+	MetricsReadHeaderTimeout = 5 * time.Second
+	MetricsShutdownTimeout   = 3 * time.Second
+	TaskQueueMetricsName     = "taskqueue" // workqueue 只为具名队列上报指标
+)
+
+// api monitor
+const (
+	APIMonitorSampleTraces = 5                // 每条告警携带的 trace id 数量
+	APIMonitorTopCodes     = 3                // 每条告警携带的错误码数量
+	APIMonitorSinkTimeout  = 10 * time.Second // 单次告警发送超时
+)
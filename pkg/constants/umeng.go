@@ -24,11 +24,50 @@ const (
 	UmengRateLimitDelay    = 1 * time.Minute                     // 用于在发送通知中等待，防止被友盟限流
 	UmengAsyncQueueSize    = 500                                 // 异步发送通知的队列大小
 	UmengDailyLimit        = 500                                 // 每日最大请求数
+	UmengMaxOrTags         = 20                                  // 单次推送 or 条件中的最大 tag 数量
+	UmengMaxListcastTokens = 500                                 // 单次列播的最大 device token 数量
+)
+
+// 推送队列
+const (
+	UmengQueueMaxLength      = 10000            // 持久化队列中最多积压的任务数，超过后拒绝入队
+	UmengMaxAttempts         = 5                // 单个任务最多发送的次数，仍失败时移入死信
+	UmengRetryBaseDelay      = 1 * time.Minute  // 首次重试的退避时间，之后每次翻倍
+	UmengRetryMaxDelay       = 1 * time.Hour    // 重试退避时间的上限
+	UmengQueueBlockTime      = 5 * time.Second  // 读取队列时最长阻塞的时间
+	UmengClaimIdleTime       = 10 * time.Minute // 任务被消费者持有超过该时间仍未确认时，视为消费者已退出，由其他实例接管
+	UmengDeadLetterMaxLength = 1000             // 死信中最多保留的任务数
+	UmengTaskDoneExpire      = 3 * ONE_DAY      // 已发送任务的幂等标记保留时间，与推送消息过期时间一致
+)
+
+// 设备平台，客户端登记设备时上报，决定定向推送使用哪一端的 appkey
+const (
+	DevicePlatformAndroid = "android"
+	DevicePlatformIOS     = "ios"
+	DevicePlatformHarmony = "harmony"
+
+	DeviceTokenMaxLength = 128 // device token 的最大长度，与 device 表字段长度一致
+	DeviceAppVersionMax  = 32  // 客户端版本号的最大长度
+)
+
+// 通知偏好
+const (
+	NotificationChannelAll   = "all"   // 写入收件箱并推送到设备
+	NotificationChannelInbox = "inbox" // 只写入收件箱，不推送到设备
+
+	NotificationDisabledTypesMax = 64      // 关闭推送的通知类型拼接后的最大长度，与 notification_preference 表字段长度一致
+	NotificationQuietTimeLayout  = "15:04" // 免打扰时间的格式
+
+	// 按 tag 推送时无法得知接收者，统一在该时段内免打扰，单位为距零点的分钟数（北京时间）
+	NotificationBroadcastQuietStart = 23 * 60
+	NotificationBroadcastQuietEnd   = 7 * 60
 )
 
 // Tag
 const (
-	UmengJwchNoticeTag = "jwch-notice" // 教务处通知的tag
+	UmengJwchNoticeTag               = "jwch-notice"       // 教务处通知的tag
+	UmengNoticeSubscriptionTagPrefix = "notice-sub-"       // 通知订阅关键词的tag前缀，后接关键词 md5 的前 16 位
+	UmengDeviceRegisteredTag         = "device-registered" // 登记了设备的客户端订阅的tag，同时按学号推送的消息在按 tag 推送时排除这些设备
 )
 
 const (
@@ -37,6 +76,19 @@ const (
 	UmengJwchNoticeDeeplink = "fzuhelper://office_notice" // 教务处通知的deeplink
 )
 
+// 推送优先级，数值越小优先级越高；队列按优先级出队，每日配额不足时保留给高优先级的推送
+const (
+	UmengPriorityExam      = iota // 考试相关推送，可以使用全部每日配额
+	UmengPriorityScore            // 成绩推送
+	UmengPriorityMarketing        // 教务处通知等其余推送
+	UmengPriorityCount            // 优先级数量
+)
+
+const (
+	UmengScoreQuotaPercent     = 90 // 成绩推送最多使用每日配额的百分比，剩余部分保留给考试推送
+	UmengMarketingQuotaPercent = 70 // 其余推送最多使用每日配额的百分比
+)
+
 // 推送类型，用于按业务场景选择对应的推送模板
 const (
 	UmengPushTypeScore    = "score"    // 推送类型：成绩通知
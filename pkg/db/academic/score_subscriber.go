@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package academic
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// CreateScoreSubscribers 记录学生成绩单中的课程，已记录过的课程直接忽略
+func (c *DBAcademic) CreateScoreSubscribers(ctx context.Context, subscribers []*model.ScoreSubscriber) error {
+	if len(subscribers) == 0 {
+		return nil
+	}
+	if err := c.client.WithContext(ctx).
+		Table(constants.ScoreSubscribersTableName).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&subscribers).Error; err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateScoreSubscribers error: %v", err)
+	}
+	return nil
+}
+
+// ListScoreSubscriberIDs 返回成绩单中出现过该课程的学生学号
+func (c *DBAcademic) ListScoreSubscriberIDs(ctx context.Context, tag string) ([]string, error) {
+	stuIds := make([]string, 0)
+	if err := c.client.WithContext(ctx).
+		Table(constants.ScoreSubscribersTableName).
+		Where("tag = ?", tag).
+		Pluck("stu_id", &stuIds).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListScoreSubscriberIDs error: %v", err)
+	}
+	return stuIds, nil
+}
+
+// ListScoreSubscribedTags 返回学生已记录的课程 tag
+func (c *DBAcademic) ListScoreSubscribedTags(ctx context.Context, stuId string) ([]string, error) {
+	tags := make([]string, 0)
+	if err := c.client.WithContext(ctx).
+		Table(constants.ScoreSubscribersTableName).
+		Where("stu_id = ?", stuId).
+		Pluck("tag", &tags).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListScoreSubscribedTags error: %v", err)
+	}
+	return tags, nil
+}
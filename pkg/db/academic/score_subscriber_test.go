@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package academic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestDBAcademic_CreateScoreSubscribers(t *testing.T) {
+	testCases := []struct {
+		name        string
+		subscribers []*model.ScoreSubscriber
+		createError error
+		expectError bool
+		expectCall  bool
+	}{
+		{name: "empty", subscribers: []*model.ScoreSubscriber{}},
+		{name: "success", subscribers: []*model.ScoreSubscriber{{Tag: "score-tag"}}, expectCall: true},
+		{
+			name:        "database error",
+			subscribers: []*model.ScoreSubscriber{{Tag: "score-tag"}},
+			createError: errors.New("insert failed"),
+			expectError: true,
+			expectCall:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Clauses).Return(mockDB).Build()
+			called := false
+			mockey.Mock((*gorm.DB).Create).To(func(_ *gorm.DB, _ interface{}) *gorm.DB {
+				called = true
+				return &gorm.DB{Error: tc.createError}
+			}).Build()
+
+			err := NewDBAcademic(mockDB, new(utils.Snowflake)).CreateScoreSubscribers(context.Background(), tc.subscribers)
+
+			assert.Equal(t, tc.expectCall, called)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.CreateScoreSubscribers error")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDBAcademic_ListScoreSubscribers(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pluckError  error
+		expectError bool
+	}{
+		{name: "success"},
+		{name: "database error", pluckError: errors.New("query failed"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Pluck).To(func(_ *gorm.DB, _ string, dest interface{}) *gorm.DB {
+				*dest.(*[]string) = []string{"102301517"}
+				return &gorm.DB{Error: tc.pluckError}
+			}).Build()
+			dbAcademic := NewDBAcademic(mockDB, new(utils.Snowflake))
+
+			stuIds, err := dbAcademic.ListScoreSubscriberIDs(context.Background(), "score-tag")
+			tags, tagsErr := dbAcademic.ListScoreSubscribedTags(context.Background(), "102301517")
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, "dal.ListScoreSubscriberIDs error")
+				assert.ErrorContains(t, tagsErr, "dal.ListScoreSubscribedTags error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, tagsErr)
+			assert.Equal(t, []string{"102301517"}, stuIds)
+			assert.Equal(t, []string{"102301517"}, tags)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package course
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+func (c *DBCourse) UpsertExamReminder(ctx context.Context, reminder *model.ExamReminder) (bool, error) {
+	// 依靠 reminder_key 唯一索引去重；同一考试被多名学生的快照重复登记时只保留一条。
+	// 已取消且尚未到点的提醒恢复为待推送，例如考试时间改到别处后又改回原时间；已推送的提醒保持不变。
+	// 返回是否新登记或恢复了提醒。
+	result := c.client.WithContext(ctx).
+		Table(constants.ExamRemindersTableName).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "reminder_key"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"status": gorm.Expr("IF(status = ? AND remind_at > ?, ?, status)",
+					model.ExamReminderCancelled, time.Now(), model.ExamReminderPending),
+			}),
+		}).
+		Create(reminder)
+	if result.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpsertExamReminder error: %v", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (c *DBCourse) CancelExamReminders(ctx context.Context, tag, term, examTime string) (int64, error) {
+	// 考试时间的变化得到确认后，取消该考试旧时间下尚未推送的提醒。
+	result := c.client.WithContext(ctx).
+		Table(constants.ExamRemindersTableName).
+		Where("tag = ? AND term = ? AND exam_time = ? AND status = ?", tag, term, examTime, model.ExamReminderPending).
+		Update("status", model.ExamReminderCancelled)
+	if result.Error != nil {
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CancelExamReminders error: %v", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func (c *DBCourse) ListDueExamReminders(ctx context.Context, now time.Time, limit int) ([]*model.ExamReminder, error) {
+	reminders := make([]*model.ExamReminder, 0)
+	if err := c.client.WithContext(ctx).
+		Table(constants.ExamRemindersTableName).
+		Where("status = ? AND remind_at <= ?", model.ExamReminderPending, now).
+		Order("remind_at ASC").
+		Limit(limit).
+		Find(&reminders).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListDueExamReminders error: %v", err)
+	}
+	return reminders, nil
+}
+
+func (c *DBCourse) UpdateExamReminderStatus(ctx context.Context, id int64, from, to model.ExamReminderStatus) (bool, error) {
+	// 带上原状态做条件更新，多实例同时扫描时只有一个实例能抢到发送资格。
+	result := c.client.WithContext(ctx).
+		Table(constants.ExamRemindersTableName).
+		Where("id = ? AND status = ?", id, from).
+		Update("status", to)
+	if result.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpdateExamReminderStatus error: %v", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package course
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestDBCourse_UpsertExamReminder(t *testing.T) {
+	testCases := []struct {
+		name         string
+		createResult *gorm.DB
+		expectError  bool
+		expectSaved  bool
+	}{
+		{name: "created", createResult: &gorm.DB{RowsAffected: 1}, expectSaved: true},
+		{name: "cancelled reminder restored", createResult: &gorm.DB{RowsAffected: 2}, expectSaved: true},
+		{name: "existing reminder unchanged", createResult: &gorm.DB{}},
+		{name: "database error", createResult: &gorm.DB{Error: errors.New("insert failed")}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			var conflict clause.OnConflict
+			mockey.Mock((*gorm.DB).Clauses).To(func(_ *gorm.DB, conds ...clause.Expression) *gorm.DB {
+				conflict = conds[0].(clause.OnConflict)
+				return mockDB
+			}).Build()
+			mockey.Mock((*gorm.DB).Create).Return(tc.createResult).Build()
+
+			saved, err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				UpsertExamReminder(context.Background(), &model.ExamReminder{ReminderKey: "reminder-key", Tag: "exam-tag"})
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.UpsertExamReminder error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectSaved, saved)
+			// 冲突时只允许把已取消的提醒恢复为待推送
+			assert.Equal(t, []clause.Column{{Name: "reminder_key"}}, conflict.Columns)
+			assert.Len(t, conflict.DoUpdates, 1)
+			assert.Equal(t, "status", conflict.DoUpdates[0].Column.Name)
+			expr := conflict.DoUpdates[0].Value.(clause.Expr)
+			assert.Equal(t, model.ExamReminderCancelled, expr.Vars[0])
+			assert.Equal(t, model.ExamReminderPending, expr.Vars[2])
+		})
+	}
+}
+
+func TestDBCourse_CancelExamReminders(t *testing.T) {
+	testCases := []struct {
+		name         string
+		updateResult *gorm.DB
+		expectError  bool
+		expectRows   int64
+	}{
+		{name: "success", updateResult: &gorm.DB{RowsAffected: 2}, expectRows: 2},
+		{name: "database error", updateResult: &gorm.DB{Error: errors.New("update failed")}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Update).Return(tc.updateResult).Build()
+
+			rows, err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				CancelExamReminders(context.Background(), "exam-tag", "202401", "2026年6月20日 09:00-11:00")
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.CancelExamReminders error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectRows, rows)
+		})
+	}
+}
+
+func TestDBCourse_ListDueExamReminders(t *testing.T) {
+	testCases := []struct {
+		name        string
+		findError   error
+		expectError bool
+	}{
+		{name: "success"},
+		{name: "database error", findError: errors.New("query failed"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Order).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Limit).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Find).To(func(_ *gorm.DB, dest interface{}, _ ...interface{}) *gorm.DB {
+				if tc.findError != nil {
+					return &gorm.DB{Error: tc.findError}
+				}
+				reminders := dest.(*[]*model.ExamReminder)
+				*reminders = append(*reminders, &model.ExamReminder{Id: 1})
+				return mockDB
+			}).Build()
+
+			reminders, err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				ListDueExamReminders(context.Background(), time.Now(), 10)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.ListDueExamReminders error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, reminders, 1)
+		})
+	}
+}
+
+func TestDBCourse_UpdateExamReminderStatus(t *testing.T) {
+	testCases := []struct {
+		name         string
+		updateResult *gorm.DB
+		expectError  bool
+		expectOK     bool
+	}{
+		{name: "claimed", updateResult: &gorm.DB{RowsAffected: 1}, expectOK: true},
+		{name: "already claimed", updateResult: &gorm.DB{RowsAffected: 0}},
+		{name: "database error", updateResult: &gorm.DB{Error: errors.New("update failed")}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Update).Return(tc.updateResult).Build()
+
+			ok, err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				UpdateExamReminderStatus(context.Background(), 1, model.ExamReminderPending, model.ExamReminderSent)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.UpdateExamReminderStatus error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectOK, ok)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package course
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// GetExamRoomSnapshot 获取学生某学期的考场快照，不存在时返回 nil
+func (c *DBCourse) GetExamRoomSnapshot(ctx context.Context, stuId, term string) (*model.ExamRoomSnapshot, error) {
+	snapshot := new(model.ExamRoomSnapshot)
+	if err := c.client.WithContext(ctx).
+		Table(constants.ExamRoomSnapshotTableName).
+		Where("stu_id = ? AND term = ?", stuId, term).
+		First(snapshot).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.GetExamRoomSnapshot error: %v", err)
+	}
+	return snapshot, nil
+}
+
+func (c *DBCourse) CreateExamRoomSnapshot(ctx context.Context, snapshot *model.ExamRoomSnapshot) error {
+	if err := c.client.WithContext(ctx).
+		Table(constants.ExamRoomSnapshotTableName).
+		Create(snapshot).Error; err != nil {
+		// 同一学生并发刷新时另一个请求已经建立了基线
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil
+		}
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateExamRoomSnapshot error: %v", err)
+	}
+	return nil
+}
+
+// UpdateExamRoomSnapshot 在同一事务中更新考场快照并写入变化记录，避免只记录了一半的变化
+func (c *DBCourse) UpdateExamRoomSnapshot(ctx context.Context, snapshot *model.ExamRoomSnapshot, changes []*model.ExamRoomChange) error {
+	err := c.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(constants.ExamRoomSnapshotTableName).
+			Where("id = ?", snapshot.Id).
+			Updates(map[string]any{"rooms": snapshot.Rooms, "rooms_sha256": snapshot.RoomsSHA256}).Error; err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		return tx.Table(constants.ExamRoomChangeTableName).Create(&changes).Error
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpdateExamRoomSnapshot error: %v", err)
+	}
+	return nil
+}
+
+// ListExamRoomChanges 按发现时间倒序返回学生某学期的考场变化记录
+func (c *DBCourse) ListExamRoomChanges(ctx context.Context, stuId, term string, limit int) ([]*model.ExamRoomChange, error) {
+	changes := make([]*model.ExamRoomChange, 0)
+	if err := c.client.WithContext(ctx).
+		Table(constants.ExamRoomChangeTableName).
+		Where("stu_id = ? AND term = ?", stuId, term).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&changes).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListExamRoomChanges error: %v", err)
+	}
+	return changes, nil
+}
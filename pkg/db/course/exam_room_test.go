@@ -0,0 +1,198 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package course
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestDBCourse_GetExamRoomSnapshot(t *testing.T) {
+	testCases := []struct {
+		name        string
+		firstError  error
+		expectError bool
+		expectNil   bool
+	}{
+		{name: "success"},
+		{name: "not found", firstError: gorm.ErrRecordNotFound, expectNil: true},
+		{name: "database error", firstError: errors.New("query failed"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).First).To(func(_ *gorm.DB, dest interface{}, _ ...interface{}) *gorm.DB {
+				if tc.firstError != nil {
+					return &gorm.DB{Error: tc.firstError}
+				}
+				dest.(*model.ExamRoomSnapshot).Id = 1
+				return mockDB
+			}).Build()
+
+			snapshot, err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				GetExamRoomSnapshot(context.Background(), "102301517", "202401")
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.GetExamRoomSnapshot error")
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expectNil {
+				assert.Nil(t, snapshot)
+				return
+			}
+			assert.Equal(t, int64(1), snapshot.Id)
+		})
+	}
+}
+
+func TestDBCourse_CreateExamRoomSnapshot(t *testing.T) {
+	testCases := []struct {
+		name        string
+		createError error
+		expectError bool
+	}{
+		{name: "success"},
+		{name: "duplicated", createError: gorm.ErrDuplicatedKey},
+		{name: "database error", createError: errors.New("insert failed"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Create).Return(&gorm.DB{Error: tc.createError}).Build()
+
+			err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				CreateExamRoomSnapshot(context.Background(), &model.ExamRoomSnapshot{Id: 1})
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.CreateExamRoomSnapshot error")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDBCourse_UpdateExamRoomSnapshot(t *testing.T) {
+	testCases := []struct {
+		name         string
+		changes      []*model.ExamRoomChange
+		updateError  error
+		createError  error
+		expectError  bool
+		expectCreate bool
+	}{
+		{name: "update without changes"},
+		{name: "update with changes", changes: []*model.ExamRoomChange{{Id: 1}}, expectCreate: true},
+		{name: "update error", updateError: errors.New("update failed"), expectError: true},
+		{
+			name:         "create changes error",
+			changes:      []*model.ExamRoomChange{{Id: 1}},
+			createError:  errors.New("insert failed"),
+			expectError:  true,
+			expectCreate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Transaction).To(func(_ *gorm.DB, fc func(tx *gorm.DB) error, _ ...*sql.TxOptions) error {
+				return fc(mockDB)
+			}).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Updates).Return(&gorm.DB{Error: tc.updateError}).Build()
+			created := false
+			mockey.Mock((*gorm.DB).Create).To(func(_ *gorm.DB, _ interface{}) *gorm.DB {
+				created = true
+				return &gorm.DB{Error: tc.createError}
+			}).Build()
+
+			err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				UpdateExamRoomSnapshot(context.Background(), &model.ExamRoomSnapshot{Id: 1}, tc.changes)
+
+			assert.Equal(t, tc.expectCreate, created)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.UpdateExamRoomSnapshot error")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDBCourse_ListExamRoomChanges(t *testing.T) {
+	testCases := []struct {
+		name        string
+		findError   error
+		expectError bool
+	}{
+		{name: "success"},
+		{name: "database error", findError: errors.New("query failed"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Order).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Limit).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Find).To(func(_ *gorm.DB, dest interface{}, _ ...interface{}) *gorm.DB {
+				if tc.findError != nil {
+					return &gorm.DB{Error: tc.findError}
+				}
+				changes := dest.(*[]*model.ExamRoomChange)
+				*changes = append(*changes, &model.ExamRoomChange{Id: 1})
+				return mockDB
+			}).Build()
+
+			changes, err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				ListExamRoomChanges(context.Background(), "102301517", "202401", 10)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.ListExamRoomChanges error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, changes, 1)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package course
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// UpsertExamSubscribers 记录学生快照中的课程，同一学生、学期、课程已存在时更新为最新的考试时间
+func (c *DBCourse) UpsertExamSubscribers(ctx context.Context, subscribers []*model.ExamSubscriber) error {
+	if len(subscribers) == 0 {
+		return nil
+	}
+	if err := c.client.WithContext(ctx).
+		Table(constants.ExamSubscribersTableName).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tag"}, {Name: "term"}, {Name: "stu_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"exam_time"}),
+		}).
+		Create(&subscribers).Error; err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpsertExamSubscribers error: %v", err)
+	}
+	return nil
+}
+
+// DeleteExamSubscribers 删除学生某学期快照中已不存在的课程，keepTags 为空时删除该学期的全部记录
+func (c *DBCourse) DeleteExamSubscribers(ctx context.Context, stuId, term string, keepTags []string) error {
+	query := c.client.WithContext(ctx).
+		Table(constants.ExamSubscribersTableName).
+		Where("stu_id = ? AND term = ?", stuId, term)
+	if len(keepTags) != 0 {
+		query = query.Where("tag NOT IN ?", keepTags)
+	}
+	if err := query.Delete(&model.ExamSubscriber{}).Error; err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.DeleteExamSubscribers error: %v", err)
+	}
+	return nil
+}
+
+// ListExamSubscribedTags 返回学生某学期已记录的课程 tag
+func (c *DBCourse) ListExamSubscribedTags(ctx context.Context, stuId, term string) ([]string, error) {
+	tags := make([]string, 0)
+	if err := c.client.WithContext(ctx).
+		Table(constants.ExamSubscribersTableName).
+		Where("stu_id = ? AND term = ?", stuId, term).
+		Pluck("tag", &tags).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListExamSubscribedTags error: %v", err)
+	}
+	return tags, nil
+}
+
+// CountExamSubscribers 统计最近一次快照中某门考试仍为 examTime 的学生数
+func (c *DBCourse) CountExamSubscribers(ctx context.Context, tag, term, examTime string) (int64, error) {
+	var count int64
+	if err := c.client.WithContext(ctx).
+		Table(constants.ExamSubscribersTableName).
+		Where("tag = ? AND term = ? AND exam_time = ?", tag, term, examTime).
+		Count(&count).Error; err != nil {
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CountExamSubscribers error: %v", err)
+	}
+	return count, nil
+}
+
+// ListExamSubscriberIDs 返回最近一次快照中有该课程的学生学号，examTimes 非空时只返回快照中考试时间为其中之一的学生
+func (c *DBCourse) ListExamSubscriberIDs(ctx context.Context, tag, term string, examTimes ...string) ([]string, error) {
+	stuIds := make([]string, 0)
+	query := c.client.WithContext(ctx).
+		Table(constants.ExamSubscribersTableName).
+		Where("tag = ? AND term = ?", tag, term)
+	if len(examTimes) != 0 {
+		query = query.Where("exam_time IN ?", examTimes)
+	}
+	if err := query.Pluck("stu_id", &stuIds).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListExamSubscriberIDs error: %v", err)
+	}
+	return stuIds, nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package course
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestDBCourse_UpsertExamSubscribers(t *testing.T) {
+	testCases := []struct {
+		name        string
+		subscribers []*model.ExamSubscriber
+		createError error
+		expectError bool
+		expectCall  bool
+	}{
+		{name: "empty", subscribers: []*model.ExamSubscriber{}},
+		{name: "success", subscribers: []*model.ExamSubscriber{{Tag: "exam-tag"}}, expectCall: true},
+		{
+			name:        "database error",
+			subscribers: []*model.ExamSubscriber{{Tag: "exam-tag"}},
+			createError: errors.New("insert failed"),
+			expectError: true,
+			expectCall:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Clauses).Return(mockDB).Build()
+			called := false
+			mockey.Mock((*gorm.DB).Create).To(func(_ *gorm.DB, _ interface{}) *gorm.DB {
+				called = true
+				return &gorm.DB{Error: tc.createError}
+			}).Build()
+
+			err := NewDBCourse(mockDB, new(utils.Snowflake)).UpsertExamSubscribers(context.Background(), tc.subscribers)
+
+			assert.Equal(t, tc.expectCall, called)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.UpsertExamSubscribers error")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDBCourse_DeleteExamSubscribers(t *testing.T) {
+	testCases := []struct {
+		name        string
+		keepTags    []string
+		deleteError error
+		expectError bool
+		expectWhere int
+	}{
+		{name: "remove whole term", expectWhere: 1},
+		{name: "keep current courses", keepTags: []string{"exam-tag"}, expectWhere: 2},
+		{name: "database error", keepTags: []string{"exam-tag"}, deleteError: errors.New("delete failed"), expectError: true, expectWhere: 2},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			where := 0
+			mockey.Mock((*gorm.DB).Where).To(func(_ *gorm.DB, _ interface{}, _ ...interface{}) *gorm.DB {
+				where++
+				return mockDB
+			}).Build()
+			mockey.Mock((*gorm.DB).Delete).Return(&gorm.DB{Error: tc.deleteError}).Build()
+
+			err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				DeleteExamSubscribers(context.Background(), "102301517", "202401", tc.keepTags)
+
+			assert.Equal(t, tc.expectWhere, where)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.DeleteExamSubscribers error")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDBCourse_CountExamSubscribers(t *testing.T) {
+	testCases := []struct {
+		name        string
+		countError  error
+		expectError bool
+	}{
+		{name: "success"},
+		{name: "database error", countError: errors.New("query failed"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Count).To(func(_ *gorm.DB, count *int64) *gorm.DB {
+				*count = 2
+				return &gorm.DB{Error: tc.countError}
+			}).Build()
+
+			count, err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				CountExamSubscribers(context.Background(), "exam-tag", "202401", "2026年6月20日 09:00-11:00")
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.CountExamSubscribers error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), count)
+		})
+	}
+}
+
+func TestDBCourse_ListExamSubscriberIDs(t *testing.T) {
+	testCases := []struct {
+		name        string
+		examTimes   []string
+		pluckError  error
+		expectError bool
+		expectWhere int
+	}{
+		{name: "all students of the course", expectWhere: 1},
+		{name: "filter by exam time", examTimes: []string{"2026年6月20日 09:00-11:00", ""}, expectWhere: 2},
+		{name: "database error", pluckError: errors.New("query failed"), expectError: true, expectWhere: 1},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			where := 0
+			mockey.Mock((*gorm.DB).Where).To(func(_ *gorm.DB, _ interface{}, _ ...interface{}) *gorm.DB {
+				where++
+				return mockDB
+			}).Build()
+			mockey.Mock((*gorm.DB).Pluck).To(func(_ *gorm.DB, _ string, dest interface{}) *gorm.DB {
+				*dest.(*[]string) = []string{"102301517"}
+				return &gorm.DB{Error: tc.pluckError}
+			}).Build()
+
+			stuIds, err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				ListExamSubscriberIDs(context.Background(), "exam-tag", "202401", tc.examTimes...)
+
+			assert.Equal(t, tc.expectWhere, where)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.ListExamSubscriberIDs error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"102301517"}, stuIds)
+		})
+	}
+}
+
+func TestDBCourse_ListExamSubscribedTags(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pluckError  error
+		expectError bool
+	}{
+		{name: "success"},
+		{name: "database error", pluckError: errors.New("query failed"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Pluck).To(func(_ *gorm.DB, _ string, dest interface{}) *gorm.DB {
+				*dest.(*[]string) = []string{"exam-tag"}
+				return &gorm.DB{Error: tc.pluckError}
+			}).Build()
+
+			tags, err := NewDBCourse(mockDB, new(utils.Snowflake)).
+				ListExamSubscribedTags(context.Background(), "102301517", "202401")
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.ListExamSubscribedTags error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"exam-tag"}, tags)
+		})
+	}
+}
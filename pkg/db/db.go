@@ -24,6 +24,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/db/friend_config"
 	"github.com/west2-online/fzuhelper-server/pkg/db/launch_screen"
 	"github.com/west2-online/fzuhelper-server/pkg/db/notice"
+	"github.com/west2-online/fzuhelper-server/pkg/db/notification"
 	"github.com/west2-online/fzuhelper-server/pkg/db/oa"
 	"github.com/west2-online/fzuhelper-server/pkg/db/toolbox"
 	"github.com/west2-online/fzuhelper-server/pkg/db/user"
@@ -37,6 +38,7 @@ type Database struct {
 	Course       *course.DBCourse
 	LaunchScreen *launch_screen.DBLaunchScreen
 	Notice       *notice.DBNotice
+	Notification *notification.DBNotification
 	User         *user.DBUser
 	Academic     *academic.DBAcademic
 	Version      *version.DBVersion
@@ -52,6 +54,7 @@ func NewDatabase(client *gorm.DB, sf *utils.Snowflake) *Database {
 		Course:       course.NewDBCourse(client, sf),
 		LaunchScreen: launch_screen.NewDBLaunchScreen(client, sf),
 		Notice:       notice.NewDBNotice(client, sf),
+		Notification: notification.NewDBNotification(client, sf),
 		User:         user.NewDBUser(client, sf),
 		Academic:     academic.NewDBAcademic(client, sf),
 		Version:      version.NewDBVersion(client, sf),
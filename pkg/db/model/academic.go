@@ -31,6 +31,14 @@ type Score struct {
 	DeletedAt        gorm.DeletedAt `json:"deleted_at,omitempty"`
 }
 
+// ScoreSubscriber 学生成绩单中出现过的课程，同一学生、课程 tag 只保留一条，用于确定成绩更新通知的接收者
+type ScoreSubscriber struct {
+	Id        int64
+	Tag       string
+	StuId     string
+	CreatedAt time.Time
+}
+
 type CourseOffering struct {
 	ID           int64          `json:"id"`
 	Name         string         `json:"name"`
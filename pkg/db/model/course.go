@@ -44,6 +44,80 @@ type ExamOffering struct {
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty"`
 }
 
+type ExamReminderStatus int64
+
+const (
+	ExamReminderPending   ExamReminderStatus = 0 // 等待到点推送
+	ExamReminderSent      ExamReminderStatus = 1 // 已交给友盟队列推送
+	ExamReminderCancelled ExamReminderStatus = 2 // 考试时间变化或已过期，不再推送；考试改回原时间且未到点时恢复为待推送
+)
+
+// ExamReminder 考试提醒，同一考试（按 tag、学期、考试时间）在同一提前量下只保留一条
+type ExamReminder struct {
+	Id            int64
+	ReminderKey   string
+	Tag           string
+	Term          string
+	Name          string
+	ExamTime      string
+	OffsetMinutes int64
+	RemindAt      time.Time
+	Status        ExamReminderStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `sql:"index"`
+}
+
+// ExamSubscriber 学生最近一次课表快照中的某门课程，同一学生、学期、考试 tag 只保留一条，课程还没有考试时间时 ExamTime 为空
+// 用于确认考试时间变化是否已被所有学生的快照印证，以及确定考试通知和考前提醒的接收者
+type ExamSubscriber struct {
+	Id        int64
+	Tag       string
+	Term      string
+	StuId     string
+	ExamTime  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ExamRoomSnapshot 学生某学期考场信息的快照，用于和下一次查询结果比对
+type ExamRoomSnapshot struct {
+	Id          int64
+	StuId       string
+	Term        string
+	Rooms       string
+	RoomsSHA256 string `gorm:"column:rooms_sha256"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `sql:"index"`
+}
+
+const (
+	ExamRoomChangeAdded   = "added"   // 新公布的考场
+	ExamRoomChangeChanged = "changed" // 考场、日期或时间发生变化
+	ExamRoomChangeRemoved = "removed" // 考场信息被移除
+)
+
+// ExamRoomChange 单个学生的考场变化记录
+type ExamRoomChange struct {
+	Id          int64
+	StuId       string
+	Term        string
+	Name        string
+	Teacher     string
+	Credit      string
+	ChangeType  string
+	OldLocation string
+	OldDate     string
+	OldTime     string
+	NewLocation string
+	NewDate     string
+	NewTime     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `sql:"index"`
+}
+
 type UserTerm struct {
 	Id        int64
 	StuId     string
@@ -27,7 +27,46 @@ type Notice struct {
 	Title       string `gorm:"type:varchar(255);not null"`
 	URL         string `gorm:"type:text;not null"`
 	PublishedAt string `gorm:"type:varchar(10);not null"`
+	Source      string `gorm:"type:varchar(32);not null;default:jwch"` // 通知来源，例 jwch、library
+	Content     string `gorm:"type:mediumtext"`                        // 正文纯文本，用于全文检索
+	Html        string `gorm:"type:mediumtext"`                        // 清洗后的正文 HTML，用于通知详情
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
+
+// NoticeSubscription 学生订阅的通知关键词，新通知标题命中关键词时推送给订阅者
+type NoticeSubscription struct {
+	Id        int64
+	StuId     string `gorm:"type:varchar(20);not null"`
+	Keyword   string `gorm:"type:varchar(32);not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// NoticeAttachment 通知正文中的附件，MirrorURL 为空表示尚未转存到 OSS
+type NoticeAttachment struct {
+	Id        int64
+	NoticeId  int64  `gorm:"not null"`
+	Name      string `gorm:"type:varchar(255);not null"`
+	SourceURL string `gorm:"type:varchar(512);not null"`
+	MirrorURL string `gorm:"type:varchar(512)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// Notification 学生收件箱中的通知，每次推送都会为相关学生落库一份，设备错过推送时仍可在通知中心查看
+// 过期通知由定时任务物理删除，因此不使用软删除
+type Notification struct {
+	Id        int64
+	StuId     string     `gorm:"type:varchar(20);not null"`
+	Type      string     `gorm:"type:varchar(16);not null"` // 通知类型，与友盟推送类型一致，例 score、exam
+	Title     string     `gorm:"type:varchar(255);not null"`
+	Content   string     `gorm:"type:varchar(1024);not null"`
+	Deeplink  string     `gorm:"type:varchar(512)"`
+	ReadAt    *time.Time // 为 nil 表示未读
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -51,3 +51,27 @@ type UserFriend struct {
 	OrderSeq  int64
 	CreatedAt time.Time
 }
+
+// Device 学生登记的友盟设备，用于按学生定向推送
+// device_token 全局唯一，同一台设备切换账号登录时归属到新的学生；注销设备时直接物理删除
+type Device struct {
+	Id          int64
+	StuId       string `gorm:"type:varchar(20);not null"`
+	DeviceToken string `gorm:"type:varchar(128);not null"`
+	Platform    string `gorm:"type:varchar(16);not null"` // android / ios / harmony，对应 constants.DevicePlatform*
+	AppVersion  string `gorm:"type:varchar(32)"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NotificationPreference 学生的通知偏好，仅对按学号定向的推送生效
+// 未设置过偏好的学生没有记录，按默认偏好处理：推送全部类型、不开启免打扰
+type NotificationPreference struct {
+	StuId         string `gorm:"primaryKey;type:varchar(20)"`
+	DisabledTypes string `gorm:"type:varchar(64);not null"` // 关闭推送的通知类型，逗号分隔，对应 constants.UmengPushType*
+	Channel       string `gorm:"type:varchar(16);not null"` // 对应 constants.NotificationChannel*
+	QuietStart    int    // 免打扰开始时间，距零点的分钟数（北京时间），与 QuietEnd 相等时不开启免打扰
+	QuietEnd      int    // 免打扰结束时间，小于 QuietStart 时表示跨零点
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -16,8 +16,35 @@ limitations under the License.
 
 package model
 
+import "time"
+
 type Visit struct {
 	Id     int64
 	Date   string
 	Visits int64
 }
+
+// AppVersion 客户端版本发布记录，每个平台、渠道、版本号一条记录
+// 客户端获取版本信息时，从最新的生效版本开始按灰度比例逐个判断，命中的第一个版本即为下发的版本
+type AppVersion struct {
+	Id               int64
+	Platform         string `gorm:"type:varchar(16);not null"` // 对应 constants.DevicePlatform*
+	Channel          string `gorm:"type:varchar(16);not null"` // 对应 constants.VersionChannel*
+	Code             int64  // 版本号，同一平台、渠道内递增
+	Version          string `gorm:"type:varchar(32);not null"` // 版本名
+	Url              string `gorm:"type:varchar(255);not null"`
+	Feature          string `gorm:"type:text"` // 更新日志
+	Force            bool
+	RolloutPercent   int64  // 灰度比例 0-100
+	MinSupportedCode int64  // 低于该版本号的客户端强制更新，0 表示不限制
+	Status           string `gorm:"type:varchar(16);not null"` // 对应 constants.VersionStatus*
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// CloudSetting 云控配置，每次修改追加一条记录，id 最大的一条生效
+type CloudSetting struct {
+	Id        int64
+	Content   string `gorm:"type:mediumtext;not null"` // 原始配置，保留注释
+	CreatedAt time.Time
+}
@@ -14,22 +14,25 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package classroom
+package notice
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
 )
 
-func (c *CacheClassroom) GetEmptyRoomCache(ctx context.Context, key string) (emptyRoomList []string, err error) {
-	data, err := c.client.Get(ctx, key).Result()
-	if err != nil {
-		return nil, fmt.Errorf("dal.GetEmptyRoomCache: Get rooms info failed: %w", err)
-	}
-	err = json.Unmarshal([]byte(data), &emptyRoomList)
+// CountNotice 统计指定来源的通知数量
+func (d *DBNotice) CountNotice(ctx context.Context, source string) (int64, error) {
+	var count int64
+	err := d.client.WithContext(ctx).
+		Table(constants.NoticeTableName).
+		Where("source = ?", source).
+		Count(&count).
+		Error
 	if err != nil {
-		return nil, fmt.Errorf("dal.GetEmptyRoomCache: Unmarshal rooms info failed: %w", err)
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CountNotice error: %s", err)
 	}
-	return emptyRoomList, nil
+	return count, nil
 }
@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notice
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// CreateNoticeSubscription 重复订阅同一关键词时只恢复被软删除的记录
+func (d *DBNotice) CreateNoticeSubscription(ctx context.Context, sub *model.NoticeSubscription) error {
+	id, err := d.sf.NextVal()
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateNoticeSubscription: NextVal error: %s", err)
+	}
+	sub.Id = id
+
+	err = d.client.WithContext(ctx).
+		Table(constants.NoticeSubscriptionTableName).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: "stu_id"},
+				{Name: "keyword"},
+			},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"deleted_at": nil,
+				"updated_at": gorm.Expr("CURRENT_TIMESTAMP"),
+			}),
+		}).
+		Create(sub).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateNoticeSubscription error: %s", err)
+	}
+	return nil
+}
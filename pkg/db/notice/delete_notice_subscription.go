@@ -14,28 +14,24 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package classroom
+package notice
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
-	"github.com/west2-online/fzuhelper-server/pkg/base/environment"
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
 )
 
-func (c *CacheClassroom) SetEmptyRoomCache(ctx context.Context, key string, emptyRoomList []string) error {
-	if environment.IsTestEnvironment() {
-		return nil
-	}
-	emptyRoomJson, err := json.Marshal(emptyRoomList)
-	if err != nil {
-		return fmt.Errorf("dal.SetEmptyRoomCache: Marshal rooms info failed: %w", err)
-	}
-	err = c.client.Set(ctx, key, emptyRoomJson, constants.ClassroomKeyExpire).Err()
+func (d *DBNotice) DeleteNoticeSubscription(ctx context.Context, stuID string, keyword string) error {
+	err := d.client.WithContext(ctx).
+		Table(constants.NoticeSubscriptionTableName).
+		Where("stu_id = ? AND keyword = ?", stuID, keyword).
+		Delete(&model.NoticeSubscription{}).
+		Error
 	if err != nil {
-		return fmt.Errorf("dal.SetEmptyRoomCache: Set rooms info failed: %w", err)
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.DeleteNoticeSubscription error: %s", err)
 	}
 	return nil
 }
@@ -24,11 +24,13 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
 )
 
-func (d *DBNotice) GetNoticeByPage(ctx context.Context, pageNum int) (list []model.Notice, err error) {
+func (d *DBNotice) GetNoticeByPage(ctx context.Context, source string, pageNum int) (list []model.Notice, err error) {
 	// 不使用[]*的原因：Find 返回多个结果时，只能使用[]
 	offset := (pageNum - 1) * constants.NoticePageSize
 	if err := d.client.WithContext(ctx).
 		Table(constants.NoticeTableName).
+		Omit("content", "html").
+		Where("source = ?", source).
 		Order("published_at DESC, id DESC").
 		Limit(constants.NoticePageSize).Offset(offset).
 		Find(&list).
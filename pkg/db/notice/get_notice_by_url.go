@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notice
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// GetNoticeByURL 根据 url 查询通知，不存在时返回 nil
+func (d *DBNotice) GetNoticeByURL(ctx context.Context, url string) (*model.Notice, error) {
+	notice := new(model.Notice)
+	err := d.client.WithContext(ctx).
+		Table(constants.NoticeTableName).
+		Where("url = ?", url).
+		Order("id DESC").
+		First(notice).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.GetNoticeByURL error: %s", err)
+	}
+	return notice, nil
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notice
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// ListSubscribedKeywords 返回至少有一名学生订阅的全部关键词（去重）
+func (d *DBNotice) ListSubscribedKeywords(ctx context.Context) ([]string, error) {
+	var keywords []string
+	err := d.client.WithContext(ctx).
+		Table(constants.NoticeSubscriptionTableName).
+		Where("deleted_at IS NULL").
+		Distinct().
+		Pluck("keyword", &keywords).
+		Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListSubscribedKeywords error: %s", err)
+	}
+	return keywords, nil
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notice
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// ListSubscriberIDs 返回订阅了任一关键词的学生学号，同一学生只出现一次
+func (d *DBNotice) ListSubscriberIDs(ctx context.Context, keywords []string) ([]string, error) {
+	var stuIDs []string
+	if len(keywords) == 0 {
+		return stuIDs, nil
+	}
+	err := d.client.WithContext(ctx).
+		Table(constants.NoticeSubscriptionTableName).
+		Where("keyword IN ? AND deleted_at IS NULL", keywords).
+		Distinct().
+		Pluck("stu_id", &stuIDs).
+		Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListSubscriberIDs error: %s", err)
+	}
+	return stuIDs, nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notice
+
+import (
+	"context"
+	"strings"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// likeEscaper 转义 LIKE 中的通配符，避免用户输入的 % 和 _ 被当作通配符
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchNotice 在标题和正文中模糊匹配 query，startDate 和 endDate 为空时不限制发布日期
+// 仅在未配置 Elasticsearch 或其不可用时使用
+func (d *DBNotice) SearchNotice(ctx context.Context, query, startDate, endDate string, pageNum int) (list []model.Notice, total int64, err error) {
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+	db := d.client.WithContext(ctx).
+		Table(constants.NoticeTableName).
+		Where("title LIKE ? OR content LIKE ?", pattern, pattern)
+	if startDate != "" {
+		db = db.Where("published_at >= ?", startDate)
+	}
+	if endDate != "" {
+		db = db.Where("published_at <= ?", endDate)
+	}
+	if err = db.Count(&total).Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.SearchNotice count error: %s", err)
+	}
+	offset := (pageNum - 1) * constants.NoticePageSize
+	if err = db.Omit("html").Order("published_at DESC, id DESC").
+		Limit(constants.NoticePageSize).Offset(offset).
+		Find(&list).
+		Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.SearchNotice error: %s", err)
+	}
+	return list, total, nil
+}
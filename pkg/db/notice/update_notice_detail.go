@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notice
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// UpdateNoticeDetail 写入通知正文，content 为纯文本，html 为清洗后的 HTML
+func (d *DBNotice) UpdateNoticeDetail(ctx context.Context, id int64, content, html string) error {
+	err := d.client.WithContext(ctx).
+		Table(constants.NoticeTableName).
+		Where("id = ?", id).
+		Updates(map[string]any{"content": content, "html": html}).
+		Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpdateNoticeDetail error: %s", err)
+	}
+	return nil
+}
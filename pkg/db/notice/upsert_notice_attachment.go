@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notice
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// UpsertNoticeAttachment 以 notice_id 和 source_url 作为唯一索引，已存在时更新名称和转存地址
+func (d *DBNotice) UpsertNoticeAttachment(ctx context.Context, attachment *model.NoticeAttachment) error {
+	id, err := d.sf.NextVal()
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpsertNoticeAttachment: NextVal error: %s", err)
+	}
+	attachment.Id = id
+
+	err = d.client.WithContext(ctx).
+		Table(constants.NoticeAttachmentTableName).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: "notice_id"},
+				{Name: "source_url"},
+			},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"name":       attachment.Name,
+				"mirror_url": attachment.MirrorURL,
+				"deleted_at": nil,
+				"updated_at": gorm.Expr("CURRENT_TIMESTAMP"),
+			}),
+		}).
+		Create(attachment).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpsertNoticeAttachment error: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+func (d *DBNotification) CountUnreadNotifications(ctx context.Context, stuID string) (int64, error) {
+	var count int64
+	err := d.client.WithContext(ctx).
+		Table(constants.NotificationTableName).
+		Where("stu_id = ? AND read_at IS NULL", stuID).
+		Count(&count).
+		Error
+	if err != nil {
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CountUnreadNotifications error: %s", err)
+	}
+	return count, nil
+}
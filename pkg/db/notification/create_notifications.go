@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// CreateNotifications 批量写入收件箱，同一条消息的多名接收者在一次插入中完成
+func (d *DBNotification) CreateNotifications(ctx context.Context, list []*model.Notification) error {
+	if len(list) == 0 {
+		return nil
+	}
+	for _, notification := range list {
+		id, err := d.sf.NextVal()
+		if err != nil {
+			return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateNotifications: NextVal error: %s", err)
+		}
+		notification.Id = id
+	}
+	err := d.client.WithContext(ctx).
+		Table(constants.NotificationTableName).
+		CreateInBatches(list, constants.NotificationInsertBatchSize).
+		Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateNotifications error: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// DeleteExpiredNotifications 删除 createdBefore 之前的通知，以及 readBefore 之前已读的通知
+// 每次最多删除 NotificationCleanupBatchSize 条，调用方根据返回的删除数量决定是否继续
+func (d *DBNotification) DeleteExpiredNotifications(ctx context.Context, createdBefore, readBefore time.Time) (int64, error) {
+	result := d.client.WithContext(ctx).
+		Table(constants.NotificationTableName).
+		Where("created_at < ? OR read_at < ?", createdBefore, readBefore).
+		Limit(constants.NotificationCleanupBatchSize).
+		Delete(&model.Notification{})
+	if result.Error != nil {
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.DeleteExpiredNotifications error: %s", result.Error)
+	}
+	return result.RowsAffected, nil
+}
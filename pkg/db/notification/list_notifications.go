@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// ListNotifications 按时间倒序分页返回学生的通知，unreadOnly 为 true 时只返回未读通知
+func (d *DBNotification) ListNotifications(ctx context.Context, stuID string, unreadOnly bool, pageNum int) (list []*model.Notification, total int64, err error) {
+	db := d.client.WithContext(ctx).
+		Table(constants.NotificationTableName).
+		Where("stu_id = ?", stuID)
+	if unreadOnly {
+		db = db.Where("read_at IS NULL")
+	}
+	if err = db.Count(&total).Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListNotifications count error: %s", err)
+	}
+	offset := (pageNum - 1) * constants.NotificationPageSize
+	if err = db.Order("id DESC").
+		Limit(constants.NotificationPageSize).Offset(offset).
+		Find(&list).
+		Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListNotifications error: %s", err)
+	}
+	return list, total, nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// MarkNotificationsRead 将学生的未读通知标记为已读，ids 为空时标记全部通知，返回本次新标记的数量
+// 条件中始终带上 stu_id，避免通过 id 修改其他学生的通知
+func (d *DBNotification) MarkNotificationsRead(ctx context.Context, stuID string, ids []int64) (int64, error) {
+	db := d.client.WithContext(ctx).
+		Table(constants.NotificationTableName).
+		Where("stu_id = ? AND read_at IS NULL", stuID)
+	if len(ids) > 0 {
+		db = db.Where("id IN ?", ids)
+	}
+	result := db.Update("read_at", time.Now())
+	if result.Error != nil {
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.MarkNotificationsRead error: %s", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestDBNotification_CreateNotifications(t *testing.T) {
+	testCases := []struct {
+		name        string
+		list        []*model.Notification
+		createError error
+		expectError bool
+	}{
+		{name: "empty list"},
+		{name: "success", list: []*model.Notification{{StuId: "102301001"}, {StuId: "102301002"}}},
+		{name: "database error", list: []*model.Notification{{StuId: "102301001"}}, createError: errors.New("insert failed"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*utils.Snowflake).NextVal).Return(int64(1), nil).Build()
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			createMock := mockey.Mock((*gorm.DB).CreateInBatches).Return(&gorm.DB{Error: tc.createError}).Build()
+
+			err := NewDBNotification(mockDB, new(utils.Snowflake)).CreateNotifications(context.Background(), tc.list)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, "dal.CreateNotifications error")
+				return
+			}
+			assert.NoError(t, err)
+			if len(tc.list) == 0 {
+				assert.Equal(t, 0, createMock.Times())
+				return
+			}
+			for _, notification := range tc.list {
+				assert.Equal(t, int64(1), notification.Id)
+			}
+		})
+	}
+}
+
+func TestDBNotification_MarkNotificationsRead(t *testing.T) {
+	testCases := []struct {
+		name         string
+		ids          []int64
+		updateResult *gorm.DB
+		expectWheres int
+		expectRows   int64
+		expectError  bool
+	}{
+		{name: "mark all", updateResult: &gorm.DB{RowsAffected: 5}, expectWheres: 1, expectRows: 5},
+		{name: "mark by ids", ids: []int64{1, 2}, updateResult: &gorm.DB{RowsAffected: 2}, expectWheres: 2, expectRows: 2},
+		{name: "database error", updateResult: &gorm.DB{Error: errors.New("update failed")}, expectWheres: 1, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			whereMock := mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Update).Return(tc.updateResult).Build()
+
+			rows, err := NewDBNotification(mockDB, new(utils.Snowflake)).
+				MarkNotificationsRead(context.Background(), "102301001", tc.ids)
+
+			assert.Equal(t, tc.expectWheres, whereMock.Times())
+			if tc.expectError {
+				assert.ErrorContains(t, err, "dal.MarkNotificationsRead error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectRows, rows)
+		})
+	}
+}
+
+func TestDBNotification_DeleteExpiredNotifications(t *testing.T) {
+	testCases := []struct {
+		name         string
+		deleteResult *gorm.DB
+		expectRows   int64
+		expectError  bool
+	}{
+		{name: "success", deleteResult: &gorm.DB{RowsAffected: 3}, expectRows: 3},
+		{name: "database error", deleteResult: &gorm.DB{Error: errors.New("delete failed")}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockDB := new(gorm.DB)
+			mockey.Mock((*gorm.DB).WithContext).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Table).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Where).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Limit).Return(mockDB).Build()
+			mockey.Mock((*gorm.DB).Delete).Return(tc.deleteResult).Build()
+
+			now := time.Now()
+			rows, err := NewDBNotification(mockDB, new(utils.Snowflake)).
+				DeleteExpiredNotifications(context.Background(), now.Add(-time.Hour), now)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, "dal.DeleteExpiredNotifications error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectRows, rows)
+		})
+	}
+}
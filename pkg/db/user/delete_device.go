@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// DeleteDevice 注销学生名下的设备，条件中带上 stu_id，避免注销其他学生的设备
+func (c *DBUser) DeleteDevice(ctx context.Context, stuID, deviceToken string) error {
+	err := c.client.WithContext(ctx).
+		Table(constants.DeviceTableName).
+		Where("stu_id = ? AND device_token = ?", stuID, deviceToken).
+		Delete(&model.Device{}).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.DeleteDevice error: %s", err)
+	}
+	return nil
+}
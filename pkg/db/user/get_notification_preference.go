@@ -0,0 +1,44 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// GetNotificationPreference 查询学生的通知偏好，未设置过时返回 nil
+func (c *DBUser) GetNotificationPreference(ctx context.Context, stuID string) (*model.NotificationPreference, error) {
+	pref := new(model.NotificationPreference)
+	err := c.client.WithContext(ctx).
+		Table(constants.NotificationPrefTableName).
+		Where("stu_id = ?", stuID).
+		First(pref).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.GetNotificationPreference error: %s", err)
+	}
+	return pref, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
+)
+
+func TestDBUser_ListDevicesByStuIDs(t *testing.T) {
+	type testCase struct {
+		name           string
+		stuIDs         []string
+		mockDevices    []*model.Device
+		mockError      error
+		expectQuery    bool
+		expectingError bool
+		expectedLen    int
+	}
+
+	testCases := []testCase{
+		{
+			name:   "success",
+			stuIDs: []string{"102301001", "102301002"},
+			mockDevices: []*model.Device{
+				{StuId: "102301001", DeviceToken: "token-a", Platform: "android"},
+				{StuId: "102301002", DeviceToken: "token-b", Platform: "ios"},
+			},
+			expectQuery: true,
+			expectedLen: 2,
+		},
+		{
+			name:        "empty stu ids skip query",
+			stuIDs:      nil,
+			expectQuery: false,
+		},
+		{
+			name:           "db error",
+			stuIDs:         []string{"102301001"},
+			mockError:      gorm.ErrInvalidDB,
+			expectQuery:    true,
+			expectingError: true,
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockGormDB := new(gorm.DB)
+			mockDBUser := NewDBUser(mockGormDB, new(utils.Snowflake))
+
+			queried := false
+			mockey.Mock((*gorm.DB).WithContext).To(func(ctx context.Context) *gorm.DB {
+				return mockGormDB
+			}).Build()
+			mockey.Mock((*gorm.DB).Table).To(func(name string, args ...interface{}) *gorm.DB {
+				return mockGormDB
+			}).Build()
+			mockey.Mock((*gorm.DB).Where).To(func(query interface{}, args ...interface{}) *gorm.DB {
+				return mockGormDB
+			}).Build()
+			mockey.Mock((*gorm.DB).Find).To(func(dest interface{}, conds ...interface{}) *gorm.DB {
+				queried = true
+				if tc.mockError != nil {
+					mockGormDB.Error = tc.mockError
+					return mockGormDB
+				}
+				if res, ok := dest.(*[]*model.Device); ok {
+					*res = tc.mockDevices
+				}
+				return mockGormDB
+			}).Build()
+
+			devices, err := mockDBUser.ListDevicesByStuIDs(context.Background(), tc.stuIDs)
+			assert.Equal(t, tc.expectQuery, queried)
+			if tc.expectingError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "dal.ListDevicesByStuIDs error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, devices, tc.expectedLen)
+		})
+	}
+}
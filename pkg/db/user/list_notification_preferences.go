@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// ListNotificationPreferencesByStuIDs 查询多名学生的通知偏好，未设置过偏好的学生不在结果中
+func (c *DBUser) ListNotificationPreferencesByStuIDs(ctx context.Context, stuIDs []string) ([]*model.NotificationPreference, error) {
+	var prefs []*model.NotificationPreference
+	if len(stuIDs) == 0 {
+		return prefs, nil
+	}
+	err := c.client.WithContext(ctx).
+		Table(constants.NotificationPrefTableName).
+		Where("stu_id IN ?", stuIDs).
+		Find(&prefs).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListNotificationPreferencesByStuIDs error: %s", err)
+	}
+	return prefs, nil
+}
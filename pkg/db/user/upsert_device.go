@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// UpsertDevice 登记设备，device_token 已存在时更新归属学生、平台与版本
+// 同一台设备换号登录后旧账号不再收到该设备的推送
+func (c *DBUser) UpsertDevice(ctx context.Context, device *model.Device) error {
+	id, err := c.sf.NextVal()
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpsertDevice: NextVal error: %s", err)
+	}
+	device.Id = id
+
+	err = c.client.WithContext(ctx).
+		Table(constants.DeviceTableName).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "device_token"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"stu_id":      device.StuId,
+				"platform":    device.Platform,
+				"app_version": device.AppVersion,
+				"updated_at":  gorm.Expr("CURRENT_TIMESTAMP"),
+			}),
+		}).
+		Create(device).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpsertDevice error: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// UpsertNotificationPreference 保存学生的通知偏好，已存在时整体覆盖
+func (c *DBUser) UpsertNotificationPreference(ctx context.Context, pref *model.NotificationPreference) error {
+	err := c.client.WithContext(ctx).
+		Table(constants.NotificationPrefTableName).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "stu_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"disabled_types": pref.DisabledTypes,
+				"channel":        pref.Channel,
+				"quiet_start":    pref.QuietStart,
+				"quiet_end":      pref.QuietEnd,
+				"updated_at":     gorm.Expr("CURRENT_TIMESTAMP"),
+			}),
+		}).
+		Create(pref).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpsertNotificationPreference error: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// CreateAppVersion 写入新版本，同一平台、渠道、版本号已存在（包括已回滚的版本）时返回错误，不覆盖已有记录
+func (c *DBVersion) CreateAppVersion(ctx context.Context, v *model.AppVersion) error {
+	id, err := c.sf.NextVal()
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateAppVersion: NextVal error: %v", err)
+	}
+	v.Id = id
+	v.Status = constants.VersionStatusActive
+
+	err = c.client.WithContext(ctx).Table(constants.AppVersionTableName).Create(v).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return errno.NewErrNo(errno.BizLogicCode, "该版本号已发布，请使用新的版本号")
+	}
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateAppVersion error: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// CreateCloudSetting 保存一份新的云控配置，历史配置保留用于追溯
+func (c *DBVersion) CreateCloudSetting(ctx context.Context, content string) (*model.CloudSetting, error) {
+	id, err := c.sf.NextVal()
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateCloudSetting: NextVal error: %v", err)
+	}
+	setting := &model.CloudSetting{
+		Id:      id,
+		Content: content,
+	}
+	if err = c.client.WithContext(ctx).Table(constants.CloudSettingTableName).Create(setting).Error; err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.CreateCloudSetting error: %v", err)
+	}
+	return setting, nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// GetLatestCloudSetting 获取当前生效的云控配置，尚未保存过配置时返回 nil
+func (c *DBVersion) GetLatestCloudSetting(ctx context.Context) (*model.CloudSetting, error) {
+	setting := new(model.CloudSetting)
+	err := c.client.WithContext(ctx).Table(constants.CloudSettingTableName).Order("id desc").First(setting).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.GetLatestCloudSetting error: %v", err)
+	}
+	return setting, nil
+}
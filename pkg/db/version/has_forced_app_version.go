@@ -0,0 +1,36 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// HasForcedAppVersionBetween 判断版本号在 (fromCode, toCode] 之间是否存在要求强制更新的生效版本
+func (c *DBVersion) HasForcedAppVersionBetween(ctx context.Context, platform, channel string, fromCode, toCode int64) (bool, error) {
+	var count int64
+	err := c.activeAppVersions(ctx, platform, channel).
+		Where("code > ? AND code <= ? AND `force` = ?", fromCode, toCode, true).
+		Limit(1).
+		Count(&count).Error
+	if err != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.HasForcedAppVersionBetween error: %v", err)
+	}
+	return count > 0, nil
+}
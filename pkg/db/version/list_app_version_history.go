@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// ListAppVersionHistory 分页查询某平台、渠道下的全部版本（包括已回滚的版本），按版本号从新到旧排列，同时返回总数
+func (c *DBVersion) ListAppVersionHistory(ctx context.Context, platform, channel string, pageNum, pageSize int) ([]*model.AppVersion, int64, error) {
+	var total int64
+	if err := c.appVersions(ctx, platform, channel).Count(&total).Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListAppVersionHistory count error: %v", err)
+	}
+
+	versions := make([]*model.AppVersion, 0)
+	err := c.appVersions(ctx, platform, channel).
+		Order("code DESC").
+		Limit(pageSize).
+		Offset((pageNum - 1) * pageSize).
+		Find(&versions).Error
+	if err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListAppVersionHistory error: %v", err)
+	}
+	return versions, total, nil
+}
+
+// ListAppVersionsBetween 查询版本号在 (fromCode, toCode] 之间的生效版本，按版本号从新到旧排列，最多 limit 个
+func (c *DBVersion) ListAppVersionsBetween(
+	ctx context.Context, platform, channel string, fromCode, toCode int64, limit int,
+) ([]*model.AppVersion, error) {
+	var versions []*model.AppVersion
+	err := c.activeAppVersions(ctx, platform, channel).
+		Where("code > ? AND code <= ?", fromCode, toCode).
+		Order("code DESC").
+		Limit(limit).
+		Find(&versions).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListAppVersionsBetween error: %v", err)
+	}
+	return versions, nil
+}
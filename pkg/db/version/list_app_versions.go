@@ -0,0 +1,52 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// ListActiveAppVersions 查询某平台、渠道下最新的 limit 个生效版本，按版本号从新到旧排列
+func (c *DBVersion) ListActiveAppVersions(ctx context.Context, platform, channel string, limit int) ([]*model.AppVersion, error) {
+	var versions []*model.AppVersion
+	err := c.activeAppVersions(ctx, platform, channel).
+		Order("code DESC").
+		Limit(limit).
+		Find(&versions).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "dal.ListActiveAppVersions error: %v", err)
+	}
+	return versions, nil
+}
+
+// appVersions 某平台、渠道下全部版本的查询条件，每次调用返回新的查询，避免 Count 与 Find 共用语句
+func (c *DBVersion) appVersions(ctx context.Context, platform, channel string) *gorm.DB {
+	return c.client.WithContext(ctx).
+		Table(constants.AppVersionTableName).
+		Where("platform = ? AND channel = ?", platform, channel)
+}
+
+// activeAppVersions 某平台、渠道下生效版本的查询条件
+func (c *DBVersion) activeAppVersions(ctx context.Context, platform, channel string) *gorm.DB {
+	return c.appVersions(ctx, platform, channel).Where("status = ?", constants.VersionStatusActive)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// UpdateAppVersionStatus 修改版本状态，用于回滚
+func (c *DBVersion) UpdateAppVersionStatus(ctx context.Context, id int64, status string) error {
+	err := c.client.WithContext(ctx).
+		Table(constants.AppVersionTableName).
+		Where("id = ?", id).
+		Update("status", status).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpdateAppVersionStatus error: %v", err)
+	}
+	return nil
+}
+
+// UpdateAppVersionRollout 调整版本的灰度比例，版本内容保持不变
+func (c *DBVersion) UpdateAppVersionRollout(ctx context.Context, id, rolloutPercent int64) error {
+	err := c.client.WithContext(ctx).
+		Table(constants.AppVersionTableName).
+		Where("id = ?", id).
+		Update("rollout_percent", rolloutPercent).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "dal.UpdateAppVersionRollout error: %v", err)
+	}
+	return nil
+}
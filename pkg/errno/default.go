@@ -43,9 +43,14 @@ var (
 	// internal error
 	UpcloudError = NewErrNo(BizFileUploadErrorCode, "云服务商交互错误")
 
+	// limit
+	RateLimitError = NewErrNo(BizLimitCode, "请求过于频繁，请稍后再试")
+
 	// redis
 	RedisError = NewErrNo(InternalRedisErrorCode, "缓存服务出现问题")
 
 	// jwch
 	EvaluationNotFoundError = NewErrNo(BizJwchEvaluationNotFoundCode, "请先对任课教师进行评价") // jwch 未进行评测
+	UpstreamThrottledError  = NewErrNo(InternalNetworkErrorCode, "教务处访问繁忙，请稍后再试")    // 出口令牌桶耗尽
+	UpstreamBreakerOpen     = NewErrNo(InternalNetworkErrorCode, "教务处暂时无法访问，请稍后再试")  // 熔断器打开
 )
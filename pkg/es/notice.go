@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package es 封装业务侧对 Elasticsearch 的读写，日志写入见 pkg/eshook
+package es
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/bytedance/sonic"
+	elastic "github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+// noticeIndexMapping 使用 ik 分词器：写入时细粒度切分，查询时粗粒度切分
+const noticeIndexMapping = `{
+  "mappings": {
+    "properties": {
+      "title":        {"type": "text", "analyzer": "ik_max_word", "search_analyzer": "ik_smart"},
+      "content":      {"type": "text", "analyzer": "ik_max_word", "search_analyzer": "ik_smart"},
+      "url":          {"type": "keyword"},
+      "published_at": {"type": "keyword"}
+    }
+  }
+}`
+
+// NoticeDoc 是通知在索引中的文档结构
+type NoticeDoc struct {
+	Title       string `json:"title"`
+	Content     string `json:"content"`
+	URL         string `json:"url"`
+	PublishedAt string `json:"published_at"`
+}
+
+// NoticeHit 是一条搜索命中，高亮片段中的关键词使用 <em> 标签包裹
+type NoticeHit struct {
+	NoticeDoc
+	HighlightTitle string
+	Highlights     []string
+}
+
+type noticeSearchResp struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Source    NoticeDoc           `json:"_source"`
+			Highlight map[string][]string `json:"highlight"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// EnsureNoticeIndex 在通知索引不存在时创建它
+func EnsureNoticeIndex(ctx context.Context, client *elastic.Client) error {
+	res, err := esapi.IndicesExistsRequest{Index: []string{constants.NoticeIndexName}}.Do(ctx, client)
+	if err != nil {
+		return fmt.Errorf("es.EnsureNoticeIndex: check index failed: %w", err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	res, err = esapi.IndicesCreateRequest{
+		Index: constants.NoticeIndexName,
+		Body:  bytes.NewReader([]byte(noticeIndexMapping)),
+	}.Do(ctx, client)
+	if err != nil {
+		return fmt.Errorf("es.EnsureNoticeIndex: create index failed: %w", err)
+	}
+	return checkResponse(res, "es.EnsureNoticeIndex")
+}
+
+// IndexNotice 以通知 id 作为文档 id 写入索引，重复写入会覆盖旧文档
+func IndexNotice(ctx context.Context, client *elastic.Client, id int64, doc *NoticeDoc) error {
+	body, err := sonic.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("es.IndexNotice: marshal doc failed: %w", err)
+	}
+	res, err := esapi.IndexRequest{
+		Index:      constants.NoticeIndexName,
+		DocumentID: fmt.Sprint(id),
+		Body:       bytes.NewReader(body),
+	}.Do(ctx, client)
+	if err != nil {
+		return fmt.Errorf("es.IndexNotice: request failed: %w", err)
+	}
+	return checkResponse(res, "es.IndexNotice")
+}
+
+// SearchNotices 在标题和正文中检索 query，标题权重更高；startDate、endDate 为空时不限制发布日期
+func SearchNotices(ctx context.Context, client *elastic.Client, query, startDate, endDate string, pageNum int) ([]*NoticeHit, int64, error) {
+	rangeCond := map[string]string{}
+	if startDate != "" {
+		rangeCond["gte"] = startDate
+	}
+	if endDate != "" {
+		rangeCond["lte"] = endDate
+	}
+	boolQuery := map[string]any{
+		"must": map[string]any{
+			"multi_match": map[string]any{
+				"query":  query,
+				"fields": []string{"title^3", "content"},
+			},
+		},
+	}
+	if len(rangeCond) != 0 {
+		boolQuery["filter"] = map[string]any{"range": map[string]any{"published_at": rangeCond}}
+	}
+	body, err := sonic.Marshal(map[string]any{
+		"from":  (pageNum - 1) * constants.NoticePageSize,
+		"size":  constants.NoticePageSize,
+		"query": map[string]any{"bool": boolQuery},
+		"sort":  []any{"_score", map[string]string{"published_at": "desc"}},
+		"highlight": map[string]any{
+			// 片段中的原文先做 HTML 转义再插入 <em>，避免通知正文中的标签被客户端渲染
+			"encoder": "html",
+			"fields": map[string]any{
+				"title":   map[string]any{"number_of_fragments": 0},
+				"content": map[string]any{"number_of_fragments": constants.NoticeSearchMaxHighlights},
+			},
+		},
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("es.SearchNotices: marshal query failed: %w", err)
+	}
+
+	res, err := esapi.SearchRequest{
+		Index: []string{constants.NoticeIndexName},
+		Body:  bytes.NewReader(body),
+	}.Do(ctx, client)
+	if err != nil {
+		return nil, 0, fmt.Errorf("es.SearchNotices: request failed: %w", err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, 0, fmt.Errorf("es.SearchNotices: %s", res.String())
+	}
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("es.SearchNotices: read body failed: %w", err)
+	}
+	var resp noticeSearchResp
+	if err = sonic.Unmarshal(raw, &resp); err != nil {
+		return nil, 0, fmt.Errorf("es.SearchNotices: unmarshal body failed: %w", err)
+	}
+
+	hits := make([]*NoticeHit, 0, len(resp.Hits.Hits))
+	for _, h := range resp.Hits.Hits {
+		hit := &NoticeHit{NoticeDoc: h.Source, Highlights: h.Highlight["content"]}
+		if titles := h.Highlight["title"]; len(titles) != 0 {
+			hit.HighlightTitle = titles[0]
+		}
+		hits = append(hits, hit)
+	}
+	return hits, resp.Hits.Total.Value, nil
+}
+
+func checkResponse(res *esapi.Response, op string) error {
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("%s: %s", op, res.String())
+	}
+	return nil
+}
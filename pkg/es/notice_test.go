@@ -0,0 +1,123 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package es
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	elastic "github.com/elastic/go-elasticsearch/v7"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *elastic.Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		// 客户端首次请求前会访问根路径做产品校验
+		if r.URL.Path == "/" {
+			_, _ = w.Write([]byte(`{"version":{"number":"7.17.10"}}`))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	client, err := elastic.NewClient(elastic.Config{Addresses: []string{srv.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestEnsureNoticeIndex(t *testing.T) {
+	type testCase struct {
+		name          string
+		existStatus   int
+		createStatus  int
+		expectCreated bool
+		expectingErr  bool
+	}
+	testCases := []testCase{
+		{name: "IndexExists", existStatus: http.StatusOK},
+		{name: "CreateIndex", existStatus: http.StatusNotFound, createStatus: http.StatusOK, expectCreated: true},
+		{name: "CreateFailed", existStatus: http.StatusNotFound, createStatus: http.StatusBadRequest, expectCreated: true, expectingErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			created := false
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodHead:
+					w.WriteHeader(tc.existStatus)
+				case http.MethodPut:
+					created = true
+					body, _ := io.ReadAll(r.Body)
+					assert.Contains(t, string(body), "ik_max_word")
+					w.WriteHeader(tc.createStatus)
+					_, _ = w.Write([]byte(`{}`))
+				}
+			})
+			err := EnsureNoticeIndex(context.Background(), client)
+			assert.Equal(t, tc.expectCreated, created)
+			if tc.expectingErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSearchNotices(t *testing.T) {
+	var reqBody string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.URL.Path, "/"+constants.NoticeIndexName+"/_search"))
+		body, _ := io.ReadAll(r.Body)
+		reqBody = string(body)
+		_, _ = w.Write([]byte(`{"hits":{"total":{"value":21},"hits":[{"_source":{"title":"关于考试安排的通知",` +
+			`"url":"u1","published_at":"2024-09-01"},"highlight":{"title":["关于<em>考试</em>安排的通知"],` +
+			`"content":["期末<em>考试</em>时间"]}}]}}`))
+	})
+
+	hits, total, err := SearchNotices(context.Background(), client, "考试", "2024-09-01", "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(21), total)
+	assert.Len(t, hits, 1)
+	assert.Equal(t, "u1", hits[0].URL)
+	assert.Equal(t, "关于<em>考试</em>安排的通知", hits[0].HighlightTitle)
+	assert.Equal(t, []string{"期末<em>考试</em>时间"}, hits[0].Highlights)
+	assert.Contains(t, reqBody, `"from":20`)
+	assert.Contains(t, reqBody, `"encoder":"html"`)
+	assert.Contains(t, reqBody, `"gte":"2024-09-01"`)
+	assert.NotContains(t, reqBody, `"lte"`)
+}
+
+func TestSearchNoticesError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"error":"boom"}`))
+	})
+	_, _, err := SearchNotices(context.Background(), client, "考试", "", "", 1)
+	assert.Error(t, err)
+}
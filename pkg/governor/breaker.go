@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package governor
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker 是单个上游主机的进程内熔断器
+// 统计窗口内失败次数达到阈值后打开，打开期间所有请求直接失败；
+// 打开结束后的一个统计窗口内视为半开状态，期间任意一次失败都会立即重新打开
+type breaker struct {
+	mu           sync.Mutex
+	threshold    int
+	window       time.Duration
+	openDuration time.Duration
+	failures     []time.Time
+	openUntil    time.Time
+	opened       bool // 打开后尚未被 allow 观察到关闭
+}
+
+func newBreaker(threshold int, window, openDuration time.Duration) *breaker {
+	return &breaker{
+		threshold:    threshold,
+		window:       window,
+		openDuration: openDuration,
+	}
+}
+
+// allow 返回当前是否允许访问上游，closed 表示熔断器是否在本次调用时由打开变为关闭
+func (b *breaker) allow(now time.Time) (allowed, closed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if now.Before(b.openUntil) {
+		return false, false
+	}
+	closed = b.opened
+	b.opened = false
+	return true, closed
+}
+
+// open 将熔断器打开到 until，用于同步其他实例的熔断状态
+func (b *breaker) open(until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until.After(b.openUntil) {
+		b.openUntil = until
+	}
+	b.opened = true
+	b.failures = b.failures[:0]
+}
+
+// recordFailure 记录一次上游故障，返回熔断器是否因此由关闭变为打开
+func (b *breaker) recordFailure(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.threshold <= 0 || now.Before(b.openUntil) {
+		return false
+	}
+
+	halfOpen := !b.openUntil.IsZero() && now.Before(b.openUntil.Add(b.window))
+	if !halfOpen {
+		valid := b.failures[:0]
+		for _, t := range b.failures {
+			if now.Sub(t) < b.window {
+				valid = append(valid, t)
+			}
+		}
+		b.failures = append(valid, now)
+		if len(b.failures) < b.threshold {
+			return false
+		}
+	}
+
+	b.openUntil = now.Add(b.openDuration)
+	b.opened = true
+	b.failures = b.failures[:0]
+	return true
+}
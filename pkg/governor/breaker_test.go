@@ -0,0 +1,143 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package governor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker(t *testing.T) {
+	base := time.Date(2024, 9, 1, 8, 0, 0, 0, time.Local)
+	window := 10 * time.Second
+	openDuration := 30 * time.Second
+
+	type step struct {
+		offset  time.Duration
+		opened  bool // recordFailure 的返回值
+		allowed bool // 记录失败后 allow 的返回值
+	}
+	testCases := []struct {
+		name      string
+		threshold int
+		steps     []step
+	}{
+		{
+			name:      "open after threshold failures",
+			threshold: 3,
+			steps: []step{
+				{offset: 0, opened: false, allowed: true},
+				{offset: time.Second, opened: false, allowed: true},
+				{offset: 2 * time.Second, opened: true, allowed: false},
+			},
+		},
+		{
+			name:      "failures outside window are dropped",
+			threshold: 2,
+			steps: []step{
+				{offset: 0, opened: false, allowed: true},
+				{offset: 11 * time.Second, opened: false, allowed: true},
+				{offset: 12 * time.Second, opened: true, allowed: false},
+			},
+		},
+		{
+			name:      "failure while open is ignored",
+			threshold: 1,
+			steps: []step{
+				{offset: 0, opened: true, allowed: false},
+				{offset: time.Second, opened: false, allowed: false},
+			},
+		},
+		{
+			name:      "half open reopens on first failure",
+			threshold: 2,
+			steps: []step{
+				{offset: 0, opened: false, allowed: true},
+				{offset: time.Second, opened: true, allowed: false},
+				{offset: 35 * time.Second, opened: true, allowed: false},
+			},
+		},
+		{
+			name:      "closed after half open window",
+			threshold: 2,
+			steps: []step{
+				{offset: 0, opened: false, allowed: true},
+				{offset: time.Second, opened: true, allowed: false},
+				{offset: 45 * time.Second, opened: false, allowed: true},
+			},
+		},
+		{
+			name:      "disabled",
+			threshold: 0,
+			steps: []step{
+				{offset: 0, opened: false, allowed: true},
+				{offset: time.Second, opened: false, allowed: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := newBreaker(tc.threshold, window, openDuration)
+			for _, s := range tc.steps {
+				now := base.Add(s.offset)
+				assert.Equal(t, s.opened, b.recordFailure(now))
+				allowed, _ := b.allow(now)
+				assert.Equal(t, s.allowed, allowed)
+			}
+		})
+	}
+}
+
+func TestBreakerOpen(t *testing.T) {
+	now := time.Now()
+	b := newBreaker(1, time.Second, time.Second)
+
+	b.open(now.Add(time.Minute))
+	allowed, _ := b.allow(now)
+	assert.False(t, allowed)
+	// 较早的截止时间不会缩短熔断
+	b.open(now.Add(time.Second))
+	allowed, _ = b.allow(now.Add(30 * time.Second))
+	assert.False(t, allowed)
+	allowed, _ = b.allow(now.Add(time.Minute))
+	assert.True(t, allowed)
+}
+
+func TestBreakerClosedTransition(t *testing.T) {
+	now := time.Now()
+	b := newBreaker(1, time.Second, time.Minute)
+
+	allowed, closed := b.allow(now)
+	assert.True(t, allowed)
+	assert.False(t, closed)
+
+	assert.True(t, b.recordFailure(now))
+	allowed, closed = b.allow(now)
+	assert.False(t, allowed)
+	assert.False(t, closed)
+
+	// 只有打开结束后的第一次放行报告关闭
+	allowed, closed = b.allow(now.Add(time.Minute))
+	assert.True(t, allowed)
+	assert.True(t, closed)
+	allowed, closed = b.allow(now.Add(time.Minute))
+	assert.True(t, allowed)
+	assert.False(t, closed)
+}
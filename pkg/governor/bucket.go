@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package governor
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// tokenBucketScript 先检查共享的熔断标记，再从令牌桶中取出一个令牌，时间取自 Redis 服务器以避免各实例时钟不一致
+// 返回 {结果, 毫秒数}：结果为 1 表示放行，0 表示令牌不足（毫秒数为预计等待时间），-1 表示已熔断（毫秒数为剩余熔断时间）
+var tokenBucketScript = redis.NewScript(`
+local open = redis.call('PTTL', KEYS[2])
+if open > 0 then
+  return {-1, open}
+end
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+  tokens = burst
+  ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call('HSET', KEYS[1], 'tokens', tokens, 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, wait}
+`)
+
+const (
+	bucketAllowed     = 1
+	bucketBreakerOpen = -1
+
+	scriptResultLen = 2
+)
+
+// take 从上游主机的令牌桶中取出一个令牌
+func (g *governor) take(ctx context.Context, host string) (int64, time.Duration, error) {
+	res, err := tokenBucketScript.Run(ctx, g.client, []string{bucketKey(host), breakerKey(host)},
+		g.cfg.Rate, g.cfg.Burst).Int64Slice()
+	if err != nil {
+		return 0, 0, errno.Errorf(errno.InternalRedisErrorCode, "governor.take: run script failed: %v", err)
+	}
+	if len(res) != scriptResultLen {
+		return 0, 0, errno.Errorf(errno.InternalRedisErrorCode, "governor.take: unexpected script result %v", res)
+	}
+	return res[0], time.Duration(res[1]) * time.Millisecond, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package governor
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"syscall"
+
+	jwchErrno "github.com/west2-online/jwch/errno"
+	yjsyErrno "github.com/west2-online/yjsy/errno"
+)
+
+// yjsySystemError 是研究生系统返回“系统发生错误”页面时 yjsy 给出的错误
+var yjsySystemError = yjsyErrno.SystemError.WithMessage("教务系统内部错误")
+
+// IsUpstreamFailure 判断错误是否由上游不可用（超时、连接失败、5xx 等）引起
+// 只按错误类型和错误码判断，会话过期、未评教、页面解析失败等业务错误不计入熔断
+func IsUpstreamFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, jwchErrno.CookieError) || errors.Is(err, jwchErrno.EvaluationNotFoundError) ||
+		errors.Is(err, yjsyErrno.CookieError) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, yjsySystemError) ||
+		errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	// jwch/yjsy 把请求失败（含 5xx）统一包装为 HTTPQueryErrorCode，但页面解析失败共用同一个错误码
+	var jwchErr jwchErrno.ErrNo
+	if errors.As(err, &jwchErr) {
+		return jwchErr.ErrorCode == jwchErrno.HTTPQueryErrorCode && jwchErr != jwchErrno.HTMLParseError
+	}
+	var yjsyErr yjsyErrno.ErrNo
+	if errors.As(err, &yjsyErr) {
+		return yjsyErr.ErrorCode == yjsyErrno.HTTPQueryErrorCode && yjsyErr != yjsyErrno.HTMLParseError
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package governor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jwchErrno "github.com/west2-online/jwch/errno"
+	yjsyErrno "github.com/west2-online/yjsy/errno"
+)
+
+func TestIsUpstreamFailure(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "jwch cookie error", err: fmt.Errorf("wrap: %w", jwchErrno.CookieError), expected: false},
+		{name: "yjsy cookie error", err: yjsyErrno.CookieError, expected: false},
+		{name: "evaluation not found", err: jwchErrno.EvaluationNotFoundError, expected: false},
+		{name: "context deadline", err: fmt.Errorf("get scores: %w", context.DeadlineExceeded), expected: true},
+		{name: "yjsy system error", err: yjsyErrno.SystemError.WithMessage("教务系统内部错误"), expected: true},
+		{name: "yjsy http query failed", err: yjsyErrno.HTTPQueryError, expected: true},
+		{name: "jwch http query failed", err: jwchErrno.HTTPQueryError.WithMessage("502 Bad Gateway"), expected: true},
+		{name: "jwch html parse failed", err: jwchErrno.HTMLParseError, expected: false},
+		{name: "yjsy html parse failed", err: yjsyErrno.HTMLParseError, expected: false},
+		{name: "yjsy other system error", err: yjsyErrno.SystemError, expected: false},
+		{name: "connection refused", err: fmt.Errorf("wrap: %w", syscall.ECONNREFUSED), expected: true},
+		{name: "dial failed", err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("no route to host")}, expected: true},
+		{name: "url error", err: &url.Error{Op: "Get", URL: "https://jwch.fzu.edu.cn", Err: errors.New("EOF")}, expected: true},
+		{name: "timeout text only", err: errors.New("timeout"), expected: false},
+		{name: "business error", err: errors.New("invalid term"), expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsUpstreamFailure(tc.err))
+		})
+	}
+}
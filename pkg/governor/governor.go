@@ -0,0 +1,193 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package governor 对访问教务处（jwch/yjsy）的出口请求进行全局治理
+// 所有服务共享 Redis 中按上游主机划分的令牌桶，并在上游持续超时或 5xx 时熔断，避免把压力继续打到教务处
+package governor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+)
+
+// 上游主机
+const (
+	HostJwch = "jwch.fzu.edu.cn"
+	HostYjsy = "yjsy.fzu.edu.cn"
+)
+
+// UpstreamNoticeAttachment 教务处以外的通知附件下载统一计入该上游，附件地址来自通知正文，不能按任意主机划分熔断器和指标
+const UpstreamNoticeAttachment = "notice-attachment"
+
+const breakerSyncTimeout = time.Second
+
+// Config 是出口治理的配置参数
+type Config struct {
+	Enabled          bool
+	Rate             float64 // 每秒补充的令牌数，<= 0 时不限流
+	Burst            int64
+	MaxWait          time.Duration // 令牌不足时最多等待的时间
+	FailureThreshold int           // <= 0 时不熔断
+	FailureWindow    time.Duration
+	OpenDuration     time.Duration
+}
+
+type governor struct {
+	cfg    Config
+	client *redis.Client
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+}
+
+var instance *governor
+
+// Init 初始化全局出口治理，client 为 nil 时只保留进程内熔断
+func Init(cfg Config, client *redis.Client) {
+	instance = newGovernor(cfg, client)
+}
+
+// Acquire 在访问上游前调用，熔断打开或令牌桶耗尽时返回 InternalNetworkErrorCode 错误
+// Redis 异常时放行请求，避免治理组件本身成为单点故障
+func Acquire(ctx context.Context, host string) error {
+	if instance == nil || !instance.cfg.Enabled {
+		return nil
+	}
+	return instance.acquire(ctx, host)
+}
+
+// Report 上报上游返回的错误，超时和 5xx 等上游故障会计入熔断器
+func Report(host string, err error) {
+	if instance == nil || !instance.cfg.Enabled {
+		return
+	}
+	instance.report(host, err)
+}
+
+// OpenDuration 返回熔断器每次打开的时长，未启用出口治理时返回 0
+func OpenDuration() time.Duration {
+	if instance == nil || !instance.cfg.Enabled {
+		return 0
+	}
+	return instance.cfg.OpenDuration
+}
+
+func newGovernor(cfg Config, client *redis.Client) *governor {
+	return &governor{
+		cfg:      cfg,
+		client:   client,
+		breakers: make(map[string]*breaker),
+	}
+}
+
+func (g *governor) breaker(host string) *breaker {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	b, ok := g.breakers[host]
+	if !ok {
+		b = newBreaker(g.cfg.FailureThreshold, g.cfg.FailureWindow, g.cfg.OpenDuration)
+		g.breakers[host] = b
+		metrics.GovernorBreakerState.WithLabelValues(host).Set(0)
+	}
+	return b
+}
+
+func (g *governor) acquire(ctx context.Context, host string) error {
+	b := g.breaker(host)
+	allowed, closed := b.allow(time.Now())
+	if !allowed {
+		metrics.GovernorRequests.WithLabelValues(host, metrics.GovernorBreakerOpen).Inc()
+		return errno.UpstreamBreakerOpen
+	}
+	if closed {
+		metrics.GovernorBreakerState.WithLabelValues(host).Set(0)
+	}
+	if g.client == nil || g.cfg.Rate <= 0 || g.cfg.Burst <= 0 {
+		metrics.GovernorRequests.WithLabelValues(host, metrics.GovernorAllowed).Inc()
+		return nil
+	}
+
+	deadline := time.Now().Add(g.cfg.MaxWait)
+	for {
+		result, wait, err := g.take(ctx, host)
+		if err != nil {
+			logger.Errorf("governor: take token of %s failed, err: %v", host, err)
+			metrics.GovernorRequests.WithLabelValues(host, metrics.GovernorError).Inc()
+			return nil
+		}
+		switch result {
+		case bucketAllowed:
+			metrics.GovernorRequests.WithLabelValues(host, metrics.GovernorAllowed).Inc()
+			return nil
+		case bucketBreakerOpen:
+			// 其他实例已经熔断，同步到本地以免重复访问 Redis
+			b.open(time.Now().Add(wait))
+			metrics.GovernorBreakerState.WithLabelValues(host).Set(1)
+			metrics.GovernorRequests.WithLabelValues(host, metrics.GovernorBreakerOpen).Inc()
+			return errno.UpstreamBreakerOpen
+		}
+
+		if time.Now().Add(wait).After(deadline) {
+			metrics.GovernorRequests.WithLabelValues(host, metrics.GovernorThrottled).Inc()
+			return errno.UpstreamThrottledError
+		}
+		select {
+		case <-ctx.Done():
+			metrics.GovernorRequests.WithLabelValues(host, metrics.GovernorThrottled).Inc()
+			return errno.UpstreamThrottledError
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (g *governor) report(host string, err error) {
+	if !IsUpstreamFailure(err) {
+		return
+	}
+	metrics.GovernorUpstreamFailures.WithLabelValues(host).Inc()
+	if !g.breaker(host).recordFailure(time.Now()) {
+		return
+	}
+
+	logger.Warnf("governor: breaker of %s opened for %v, last err: %v", host, g.cfg.OpenDuration, err)
+	metrics.GovernorBreakerState.WithLabelValues(host).Set(1)
+	if g.client == nil {
+		return
+	}
+	// 将熔断状态写入 Redis，其他实例在下一次 Acquire 时即可感知
+	ctx, cancel := context.WithTimeout(context.Background(), breakerSyncTimeout)
+	defer cancel()
+	if err := g.client.Set(ctx, breakerKey(host), 1, g.cfg.OpenDuration).Err(); err != nil {
+		logger.Errorf("governor: sync breaker of %s failed, err: %v", host, err)
+	}
+}
+
+func bucketKey(host string) string {
+	return fmt.Sprintf("%s:bucket:%s", constants.GovernorKeyPrefix, host)
+}
+
+func breakerKey(host string) string {
+	return fmt.Sprintf("%s:breaker:%s", constants.GovernorKeyPrefix, host)
+}
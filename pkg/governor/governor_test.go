@@ -0,0 +1,150 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package governor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+)
+
+func TestAcquire(t *testing.T) {
+	type takeResult struct {
+		result int64
+		wait   time.Duration
+		err    error
+	}
+	testCases := []struct {
+		name        string
+		results     []takeResult
+		expectedErr error
+		breakerOpen bool
+	}{
+		{
+			name:    "allowed",
+			results: []takeResult{{result: bucketAllowed}},
+		},
+		{
+			name:    "allowed after waiting",
+			results: []takeResult{{result: 0, wait: time.Millisecond}, {result: bucketAllowed}},
+		},
+		{
+			name:        "throttled when wait exceeds max wait",
+			results:     []takeResult{{result: 0, wait: time.Second}},
+			expectedErr: errno.UpstreamThrottledError,
+		},
+		{
+			name:        "breaker opened by other instance",
+			results:     []takeResult{{result: bucketBreakerOpen, wait: time.Minute}},
+			expectedErr: errno.UpstreamBreakerOpen,
+			breakerOpen: true,
+		},
+		{
+			name:    "redis error lets request through",
+			results: []takeResult{{err: errors.New("redis down")}},
+		},
+	}
+
+	defer mockey.UnPatchAll()
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			calls := 0
+			mockey.Mock((*governor).take).To(func(_ *governor, _ context.Context, _ string) (int64, time.Duration, error) {
+				r := tc.results[calls]
+				calls++
+				return r.result, r.wait, r.err
+			}).Build()
+
+			g := newGovernor(Config{
+				Enabled:          true,
+				Rate:             10,
+				Burst:            10,
+				MaxWait:          100 * time.Millisecond,
+				FailureThreshold: 1,
+				FailureWindow:    time.Second,
+				OpenDuration:     time.Second,
+			}, redis.NewClient(&redis.Options{}))
+
+			err := g.acquire(context.Background(), HostJwch)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, len(tc.results), calls)
+			allowed, _ := g.breaker(HostJwch).allow(time.Now())
+			assert.Equal(t, tc.breakerOpen, !allowed)
+		})
+	}
+}
+
+func TestReportOpensBreaker(t *testing.T) {
+	g := newGovernor(Config{
+		Enabled:          true,
+		FailureThreshold: 2,
+		FailureWindow:    time.Minute,
+		OpenDuration:     time.Minute,
+	}, nil)
+
+	g.report(HostYjsy, errors.New("invalid term"))
+	g.report(HostYjsy, context.DeadlineExceeded)
+	assert.NoError(t, g.acquire(context.Background(), HostYjsy))
+
+	g.report(HostYjsy, context.DeadlineExceeded)
+	assert.Equal(t, errno.UpstreamBreakerOpen, g.acquire(context.Background(), HostYjsy))
+	// 熔断只影响对应的上游
+	assert.NoError(t, g.acquire(context.Background(), HostJwch))
+}
+
+func TestBreakerStateGauge(t *testing.T) {
+	g := newGovernor(Config{
+		Enabled:          true,
+		FailureThreshold: 1,
+		FailureWindow:    time.Minute,
+		OpenDuration:     time.Minute,
+	}, nil)
+	gauge := metrics.GovernorBreakerState.WithLabelValues(HostYjsy)
+
+	assert.NoError(t, g.acquire(context.Background(), HostYjsy))
+	assert.Equal(t, float64(0), testutil.ToFloat64(gauge))
+
+	g.report(HostYjsy, context.DeadlineExceeded)
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauge))
+	// 熔断期间的请求不会改变状态
+	assert.Equal(t, errno.UpstreamBreakerOpen, g.acquire(context.Background(), HostYjsy))
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauge))
+
+	// 熔断结束后的第一次放行将状态置回关闭
+	g.breaker(HostYjsy).openUntil = time.Now()
+	assert.NoError(t, g.acquire(context.Background(), HostYjsy))
+	assert.Equal(t, float64(0), testutil.ToFloat64(gauge))
+}
+
+func TestDisabled(t *testing.T) {
+	instance = nil
+	defer func() { instance = nil }()
+
+	assert.NoError(t, Acquire(context.Background(), HostJwch))
+	Init(Config{Enabled: false, FailureThreshold: 1, OpenDuration: time.Minute}, nil)
+	Report(HostJwch, context.DeadlineExceeded)
+	assert.NoError(t, Acquire(context.Background(), HostJwch))
+}
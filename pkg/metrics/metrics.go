@@ -0,0 +1,195 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics 集中定义服务暴露给 Prometheus 的指标，所有指标都注册在默认 registry 上
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "fzuhelper"
+
+// 限流结果
+const (
+	RateLimitAllowed  = "allowed"
+	RateLimitRejected = "rejected"
+	RateLimitError    = "error" // Redis 异常时放行，单独统计
+)
+
+// RateLimitRequests 记录 API 限流中间件的判定结果
+var RateLimitRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "ratelimit",
+	Name:      "requests_total",
+	Help:      "Number of requests checked by the api rate limiter, partitioned by route, key type and result.",
+}, []string{"route", "key_by", "result"})
+
+// 出口治理结果
+const (
+	GovernorAllowed     = "allowed"
+	GovernorThrottled   = "throttled"
+	GovernorBreakerOpen = "breaker_open"
+	GovernorError       = "error" // Redis 异常时放行，单独统计
+)
+
+// GovernorRequests 记录访问教务处前令牌桶和熔断器的判定结果
+var GovernorRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "governor",
+	Name:      "requests_total",
+	Help:      "Number of outbound jwch/yjsy requests checked by the governor, partitioned by host and result.",
+}, []string{"host", "result"})
+
+// GovernorBreakerState 为 1 时表示对应上游的熔断器处于打开状态
+var GovernorBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Subsystem: "governor",
+	Name:      "breaker_open",
+	Help:      "Whether the circuit breaker of the upstream host is open (1) or closed (0).",
+}, []string{"host"})
+
+// GovernorUpstreamFailures 记录被判定为上游故障（超时、5xx 等）的错误次数
+var GovernorUpstreamFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "governor",
+	Name:      "upstream_failures_total",
+	Help:      "Number of upstream failures (timeouts, 5xx) reported to the governor, partitioned by host.",
+}, []string{"host"})
+
+// 请求结果
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
+
+// HTTPRequests 记录 API 网关处理的请求数，status 为 HTTP 状态码
+var HTTPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "http",
+	Name:      "requests_total",
+	Help:      "Number of http requests handled by the api gateway, partitioned by route, method and status code.",
+}, []string{"route", "method", "status"})
+
+// HTTPRequestDuration 记录 API 网关的请求耗时
+var HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "http",
+	Name:      "request_duration_seconds",
+	Help:      "Latency of http requests handled by the api gateway, partitioned by route and method.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"route", "method"})
+
+// RPCRequests 记录 RPC 服务端处理的请求数，result 仅反映框架层错误，业务错误见 ErrnoResponses
+var RPCRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "rpc",
+	Name:      "requests_total",
+	Help:      "Number of rpc requests handled by the kitex server, partitioned by method and result.",
+}, []string{"method", "result"})
+
+// RPCRequestDuration 记录 RPC 服务端的请求耗时
+var RPCRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "rpc",
+	Name:      "request_duration_seconds",
+	Help:      "Latency of rpc requests handled by the kitex server, partitioned by method.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"method"})
+
+// ErrnoResponses 记录返回给调用方的错误码分布，成功响应不计入
+var ErrnoResponses = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "errno",
+	Name:      "responses_total",
+	Help:      "Number of error responses, partitioned by errno code.",
+}, []string{"code"})
+
+// 缓存查询结果
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+// CacheRequests 记录缓存命中情况，db 为 Redis 库编号
+var CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "cache",
+	Name:      "requests_total",
+	Help:      "Number of cache lookups, partitioned by redis db and result.",
+}, []string{"db", "result"})
+
+// 教务处接口较慢，耗时分桶为 50ms ~ 25.6s
+const (
+	upstreamBucketStart  = 0.05
+	upstreamBucketFactor = 2
+	upstreamBucketCount  = 10
+)
+
+// UpstreamLatency 记录访问教务处（jwch/yjsy）的耗时，op 为调用的接口
+var UpstreamLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "upstream",
+	Name:      "request_duration_seconds",
+	Help:      "Latency of outbound jwch/yjsy requests, partitioned by host and operation.",
+	Buckets:   prometheus.ExponentialBuckets(upstreamBucketStart, upstreamBucketFactor, upstreamBucketCount),
+}, []string{"host", "op"})
+
+// Umeng 推送任务结果
+const (
+	UmengEnqueued = "enqueued"
+	UmengDropped  = "dropped" // 队列已满被丢弃
+	UmengSent     = "sent"
+	UmengFailed   = "failed"
+	UmengRetried  = "retried"  // 发送失败，等待重试
+	UmengDead     = "dead"     // 重试次数耗尽，移入死信
+	UmengSkipped  = "skipped"  // 任务已发送过，幂等跳过
+	UmengDeferred = "deferred" // 该优先级当天配额已用完，顺延到次日
+	UmengDelayed  = "delayed"  // 免打扰期间入队，延迟到免打扰结束后发送
+)
+
+// UmengTasks 记录 Umeng 异步推送任务的处理结果
+var UmengTasks = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "umeng",
+	Name:      "tasks_total",
+	Help:      "Number of umeng dispatcher tasks, partitioned by result.",
+}, []string{"result"})
+
+// UmengQueueLength 为 Umeng 异步队列中等待发送的任务数
+var UmengQueueLength = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Subsystem: "umeng",
+	Name:      "queue_length",
+	Help:      "Number of tasks waiting in the umeng dispatcher queue.",
+})
+
+// UmengDailyQuotaUsed 为当天已使用的 Umeng 推送配额
+var UmengDailyQuotaUsed = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Subsystem: "umeng",
+	Name:      "daily_quota_used",
+	Help:      "Number of umeng requests sent today.",
+})
+
+// UmengDailyQuotaLimit 为 Umeng 每日推送配额上限
+var UmengDailyQuotaLimit = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Subsystem: "umeng",
+	Name:      "daily_quota_limit",
+	Help:      "Daily quota of umeng requests.",
+})
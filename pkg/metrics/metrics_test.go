@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/stats"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+func TestRecordErrno(t *testing.T) {
+	type testCase struct {
+		name        string
+		code        int64
+		expectDelta float64
+	}
+
+	testCases := []testCase{
+		{
+			name: "success code is ignored",
+			code: errno.SuccessCode,
+		},
+		{
+			name:        "error code is recorded",
+			code:        errno.InternalNetworkErrorCode,
+			expectDelta: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := ErrnoResponses.WithLabelValues(strconv.FormatInt(tc.code, 10))
+			before := testutil.ToFloat64(counter)
+			RecordErrno(tc.code)
+			assert.Equal(t, tc.expectDelta, testutil.ToFloat64(counter)-before)
+		})
+	}
+}
+
+func TestWorkqueueProvider(t *testing.T) {
+	name := "test-queue"
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: name, MetricsProvider: WorkqueueProvider},
+	)
+	defer queue.ShutDown()
+
+	queue.Add("a")
+	queue.Add("b")
+	assert.Equal(t, float64(2), testutil.ToFloat64(taskQueueDepth.WithLabelValues(name)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(taskQueueAdds.WithLabelValues(name)))
+
+	key, _ := queue.Get()
+	queue.AddRateLimited(key)
+	queue.Done(key)
+	assert.Equal(t, float64(1), testutil.ToFloat64(taskQueueRetries.WithLabelValues(name)))
+}
+
+func TestRPCTracer(t *testing.T) {
+	type testCase struct {
+		name         string
+		method       string
+		err          error
+		expectResult string
+	}
+
+	testCases := []testCase{
+		{
+			name:         "success",
+			method:       "TestSuccess",
+			expectResult: ResultSuccess,
+		},
+		{
+			name:         "error",
+			method:       "TestError",
+			err:          assert.AnError,
+			expectResult: ResultError,
+		},
+	}
+
+	tracer := NewRPCTracer()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := rpcinfo.NewRPCStats()
+			rpcinfo.AsMutableRPCStats(st).SetLevel(stats.LevelDetailed)
+			ri := rpcinfo.NewRPCInfo(nil, nil, rpcinfo.NewInvocation("svc", tc.method), nil, st)
+			ctx := rpcinfo.NewCtxWithRPCInfo(context.Background(), ri)
+
+			ctx = tracer.Start(ctx)
+			st.Record(ctx, stats.RPCStart, stats.StatusInfo, "")
+			time.Sleep(time.Millisecond)
+			if tc.err != nil {
+				rpcinfo.AsMutableRPCStats(st).SetError(tc.err)
+			}
+			st.Record(ctx, stats.RPCFinish, stats.StatusInfo, "")
+			tracer.Finish(ctx)
+
+			assert.Equal(t, float64(1), testutil.ToFloat64(RPCRequests.WithLabelValues(tc.method, tc.expectResult)))
+		})
+	}
+}
+
+func TestStartServerWithoutAddr(t *testing.T) {
+	stop := StartServer("")
+	assert.NotPanics(t, stop)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/stats"
+)
+
+// rpcTracer 在 RPC 请求结束时记录请求数和耗时
+type rpcTracer struct{}
+
+// NewRPCTracer 返回用于 Kitex 服务端的指标采集 Tracer
+func NewRPCTracer() stats.Tracer {
+	return rpcTracer{}
+}
+
+func (rpcTracer) Start(ctx context.Context) context.Context {
+	return ctx
+}
+
+func (rpcTracer) Finish(ctx context.Context) {
+	ri := rpcinfo.GetRPCInfo(ctx)
+	if ri == nil || ri.Stats() == nil {
+		return
+	}
+	st := ri.Stats()
+	start, finish := st.GetEvent(stats.RPCStart), st.GetEvent(stats.RPCFinish)
+	if start == nil || finish == nil {
+		return
+	}
+
+	method := ri.Invocation().MethodName()
+	result := ResultSuccess
+	if panicked, _ := st.Panicked(); panicked || st.Error() != nil {
+		result = ResultError
+	}
+	RPCRequests.WithLabelValues(method, result).Inc()
+	RPCRequestDuration.WithLabelValues(method).Observe(finish.Time().Sub(start.Time()).Seconds())
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+// StartServer 在独立端口上暴露 /metrics 供 Prometheus 抓取，addr 为空时不启动
+// 返回的函数用于在服务关闭时停止监听
+func StartServer(addr string) func() {
+	if addr == "" {
+		return func() {}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(constants.MetricsPath, promhttp.Handler())
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: constants.MetricsReadHeaderTimeout,
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("metrics: serve on %s failed, err: %v", addr, err)
+		}
+	}()
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.MetricsShutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.Errorf("metrics: shutdown server failed, err: %v", err)
+		}
+	}
+}
+
+// RecordErrno 记录一次错误响应的错误码，成功响应直接忽略
+func RecordErrno(code int64) {
+	if code == errno.SuccessCode {
+		return
+	}
+	ErrnoResponses.WithLabelValues(strconv.FormatInt(code, 10)).Inc()
+}
+
+// ObserveUpstream 记录一次访问教务处的耗时
+func ObserveUpstream(host, op string, start time.Time) {
+	UpstreamLatency.WithLabelValues(host, op).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// 任务队列指标以队列名称区分，由 workqueue 在队列的生命周期内自动维护
+var (
+	taskQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "taskqueue",
+		Name:      "depth",
+		Help:      "Number of tasks waiting in the task queue.",
+	}, []string{"name"})
+
+	taskQueueAdds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "taskqueue",
+		Name:      "adds_total",
+		Help:      "Number of tasks added to the task queue.",
+	}, []string{"name"})
+
+	taskQueueLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "taskqueue",
+		Name:      "queue_duration_seconds",
+		Help:      "How long a task stays in the task queue before being processed.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"name"})
+
+	taskQueueWorkDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "taskqueue",
+		Name:      "work_duration_seconds",
+		Help:      "How long processing a task takes.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"name"})
+
+	taskQueueUnfinishedWork = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "taskqueue",
+		Name:      "unfinished_work_seconds",
+		Help:      "Seconds of work in progress that has not been observed by work_duration.",
+	}, []string{"name"})
+
+	taskQueueLongestRunning = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "taskqueue",
+		Name:      "longest_running_processor_seconds",
+		Help:      "How many seconds the longest running task has been processing.",
+	}, []string{"name"})
+
+	taskQueueRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "taskqueue",
+		Name:      "retries_total",
+		Help:      "Number of task retries caused by failed executions.",
+	}, []string{"name"})
+)
+
+// WorkqueueProvider 将 workqueue 的内部指标导出到 Prometheus
+var WorkqueueProvider workqueue.MetricsProvider = workqueueProvider{}
+
+type workqueueProvider struct{}
+
+func (workqueueProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return taskQueueDepth.WithLabelValues(name)
+}
+
+func (workqueueProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return taskQueueAdds.WithLabelValues(name)
+}
+
+func (workqueueProvider) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	return taskQueueLatency.WithLabelValues(name)
+}
+
+func (workqueueProvider) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	return taskQueueWorkDuration.WithLabelValues(name)
+}
+
+func (workqueueProvider) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return taskQueueUnfinishedWork.WithLabelValues(name)
+}
+
+func (workqueueProvider) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return taskQueueLongestRunning.WithLabelValues(name)
+}
+
+func (workqueueProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return taskQueueRetries.WithLabelValues(name)
+}
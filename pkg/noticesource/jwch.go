@@ -0,0 +1,90 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noticesource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/base"
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+	"github.com/west2-online/jwch"
+)
+
+// jwchSource 教务处教学通知
+type jwchSource struct {
+	account  string
+	password string
+}
+
+// NewJwchSource 教务处通知列表需要使用默认账号访问
+func NewJwchSource(account, password string) NoticeSource {
+	return &jwchSource{account: account, password: password}
+}
+
+func (s *jwchSource) Name() string {
+	return constants.NoticeSourceJwch
+}
+
+func (s *jwchSource) DisplayName() string {
+	return "教务处"
+}
+
+func (s *jwchSource) Interval() time.Duration {
+	return constants.NoticeUpdateTime
+}
+
+func (s *jwchSource) FetchPage(ctx context.Context, page int) ([]*Item, int, error) {
+	if err := governor.Acquire(ctx, governor.HostJwch); err != nil {
+		return nil, 0, err
+	}
+	start := time.Now()
+	list, total, err := jwch.NewStudent().WithUser(s.account, s.password).GetNoticeInfo(&jwch.NoticeInfoReq{PageNum: page})
+	metrics.ObserveUpstream(governor.HostJwch, "GetNoticeInfo", start)
+	if err = base.HandleJwchError(err); err != nil {
+		return nil, 0, fmt.Errorf("get notice info failed, page=%d: %w", page, err)
+	}
+	items := make([]*Item, len(list))
+	for i, info := range list {
+		items[i] = &Item{Title: info.Title, URL: info.URL, Date: info.Date, Raw: info}
+	}
+	return items, total, nil
+}
+
+func (s *jwchSource) FetchDetail(ctx context.Context, item *Item) (string, error) {
+	info, ok := item.Raw.(*jwch.NoticeInfo)
+	if !ok {
+		return "", errors.New("jwch notice info is missing")
+	}
+	if err := governor.Acquire(ctx, governor.HostJwch); err != nil {
+		return "", err
+	}
+	start := time.Now()
+	detail, err := jwch.NewStudent().GetNoticeDetail(&jwch.NoticeDetailReq{
+		WbTreeId: info.WbTreeId,
+		WbNewsId: info.WbNewsId,
+	})
+	metrics.ObserveUpstream(governor.HostJwch, "GetNoticeDetail", start)
+	if err = base.HandleJwchError(err); err != nil {
+		return "", fmt.Errorf("get notice detail failed, url=%s: %w", info.URL, err)
+	}
+	return detail.Content, nil
+}
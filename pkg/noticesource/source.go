@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package noticesource 定义通知来源，教务处和其他校内网站的通知统一通过 NoticeSource 抓取
+// 每个来源拥有独立的解析方式和同步间隔，同步任务按来源分别调度并通过 url 去重
+package noticesource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Item 来源列表页中的一条通知
+type Item struct {
+	Title string
+	URL   string
+	Date  string // YYYY-MM-DD
+	Raw   any    // 来源的原始数据，教务处为 *jwch.NoticeInfo
+}
+
+// NoticeSource 通知来源
+type NoticeSource interface {
+	// Name 来源标识，写入 notice.source
+	Name() string
+	// DisplayName 展示名称
+	DisplayName() string
+	// Interval 同步间隔
+	Interval() time.Duration
+	// FetchPage 抓取第 page 页（从 1 开始）的通知，同时返回总页数
+	FetchPage(ctx context.Context, page int) ([]*Item, int, error)
+	// FetchDetail 抓取通知正文 HTML
+	FetchDetail(ctx context.Context, item *Item) (string, error)
+}
+
+var (
+	mu      sync.RWMutex
+	sources []NoticeSource
+)
+
+// Register 注册通知来源，名称重复时返回错误
+func Register(src NoticeSource) error {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, s := range sources {
+		if s.Name() == src.Name() {
+			return fmt.Errorf("noticesource.Register: duplicate source %s", src.Name())
+		}
+	}
+	sources = append(sources, src)
+	return nil
+}
+
+// All 按注册顺序返回全部来源
+func All() []NoticeSource {
+	mu.RLock()
+	defer mu.RUnlock()
+	return append([]NoticeSource(nil), sources...)
+}
+
+// Get 根据名称查找来源
+func Get(name string) (NoticeSource, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, s := range sources {
+		if s.Name() == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
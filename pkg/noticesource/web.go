@@ -0,0 +1,269 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noticesource
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/antchfx/htmlquery"
+	"github.com/antchfx/xpath"
+	"github.com/cloudwego/hertz/pkg/app/client"
+	hertzconfig "github.com/cloudwego/hertz/pkg/common/config"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/governor"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+)
+
+// WebConfig 通过 XPath 解析的校内网站通知来源配置
+type WebConfig struct {
+	Name         string
+	DisplayName  string
+	ListURL      string
+	ItemXPath    string
+	TitleXPath   string
+	LinkXPath    string
+	DateXPath    string
+	ContentXPath string
+	Interval     time.Duration
+}
+
+// dateRe 兼容 2024-12-01、2024/12/1、2024.12.01 和 2024年12月1日
+var dateRe = regexp.MustCompile(`(\d{4})\s*[-/.年]\s*(\d{1,2})\s*[-/.月]\s*(\d{1,2})`)
+
+const defaultLinkXPath = ".//a"
+
+// webSource 校内网站通知，只抓取列表第一页
+type webSource struct {
+	cfg     WebConfig
+	listURL *url.URL
+	client  *client.Client
+	item    *xpath.Expr
+	title   *xpath.Expr // 可能为 nil
+	link    *xpath.Expr
+	date    *xpath.Expr // 可能为 nil
+	content *xpath.Expr
+}
+
+// NewWebSource 校验配置并预编译 XPath
+func NewWebSource(cfg WebConfig, c *client.Client) (NoticeSource, error) {
+	if cfg.Name == "" || len(cfg.Name) > constants.NoticeSourceMaxNameLen {
+		return nil, fmt.Errorf("noticesource: invalid source name %q", cfg.Name)
+	}
+	if cfg.Name == constants.NoticeSourceJwch {
+		return nil, fmt.Errorf("noticesource: source name %s is reserved", cfg.Name)
+	}
+	listURL, err := url.Parse(cfg.ListURL)
+	if err != nil || (listURL.Scheme != "http" && listURL.Scheme != "https") || listURL.Host == "" {
+		return nil, fmt.Errorf("noticesource: source %s has invalid list url %q", cfg.Name, cfg.ListURL)
+	}
+	if cfg.DisplayName == "" {
+		cfg.DisplayName = cfg.Name
+	}
+	switch {
+	case cfg.Interval <= 0:
+		cfg.Interval = constants.NoticeSourceDefaultPeriod
+	case cfg.Interval < constants.NoticeSourceMinInterval:
+		cfg.Interval = constants.NoticeSourceMinInterval
+	}
+	if cfg.LinkXPath == "" {
+		cfg.LinkXPath = defaultLinkXPath
+	}
+
+	s := &webSource{cfg: cfg, listURL: listURL, client: c}
+	exprs := []struct {
+		expr     string
+		target   **xpath.Expr
+		required bool
+	}{
+		{cfg.ItemXPath, &s.item, true},
+		{cfg.TitleXPath, &s.title, false},
+		{cfg.LinkXPath, &s.link, true},
+		{cfg.DateXPath, &s.date, false},
+		{cfg.ContentXPath, &s.content, true},
+	}
+	for _, e := range exprs {
+		if e.expr == "" {
+			if e.required {
+				return nil, fmt.Errorf("noticesource: source %s has empty xpath", cfg.Name)
+			}
+			continue
+		}
+		if *e.target, err = xpath.Compile(e.expr); err != nil {
+			return nil, fmt.Errorf("noticesource: source %s has invalid xpath %q: %w", cfg.Name, e.expr, err)
+		}
+	}
+	return s, nil
+}
+
+func (s *webSource) Name() string {
+	return s.cfg.Name
+}
+
+func (s *webSource) DisplayName() string {
+	return s.cfg.DisplayName
+}
+
+func (s *webSource) Interval() time.Duration {
+	return s.cfg.Interval
+}
+
+func (s *webSource) FetchPage(ctx context.Context, page int) ([]*Item, int, error) {
+	if page > 1 {
+		return nil, 1, nil
+	}
+	doc, err := s.load(ctx, s.listURL.String())
+	if err != nil {
+		return nil, 0, err
+	}
+	nodes := htmlquery.QuerySelectorAll(doc, s.item)
+	if len(nodes) == 0 {
+		return nil, 0, fmt.Errorf("source %s: item xpath matched nothing", s.cfg.Name)
+	}
+
+	items := make([]*Item, 0, len(nodes))
+	for _, n := range nodes {
+		if item := s.parseItem(n); item != nil {
+			items = append(items, item)
+		}
+	}
+	return items, 1, nil
+}
+
+// parseItem 缺少链接、标题或日期的条目（例如表头、分页）会被忽略
+func (s *webSource) parseItem(n *html.Node) *Item {
+	link := htmlquery.QuerySelector(n, s.link)
+	if link == nil {
+		return nil
+	}
+	href, err := url.Parse(strings.TrimSpace(htmlquery.SelectAttr(link, "href")))
+	if err != nil || href.String() == "" {
+		return nil
+	}
+	href = s.listURL.ResolveReference(href)
+	if href.Scheme != "http" && href.Scheme != "https" {
+		return nil
+	}
+
+	// 列表中的标题常被截断，优先使用完整的 title 属性
+	title := htmlquery.SelectAttr(link, "title")
+	if s.title != nil {
+		if node := htmlquery.QuerySelector(n, s.title); node != nil {
+			title = htmlquery.InnerText(node)
+		}
+	}
+	if strings.TrimSpace(title) == "" {
+		title = htmlquery.InnerText(link)
+	}
+	title = strings.Join(strings.Fields(title), " ")
+
+	dateText := htmlquery.InnerText(n)
+	if s.date != nil {
+		if node := htmlquery.QuerySelector(n, s.date); node != nil {
+			dateText = htmlquery.InnerText(node)
+		}
+	}
+	date := parseDate(dateText)
+	if title == "" || date == "" {
+		return nil
+	}
+	return &Item{Title: title, URL: href.String(), Date: date}
+}
+
+func (s *webSource) FetchDetail(ctx context.Context, item *Item) (string, error) {
+	doc, err := s.load(ctx, item.URL)
+	if err != nil {
+		return "", err
+	}
+	node := htmlquery.QuerySelector(doc, s.content)
+	if node == nil {
+		return "", fmt.Errorf("source %s: content xpath matched nothing, url=%s", s.cfg.Name, item.URL)
+	}
+	return htmlquery.OutputHTML(node, false), nil
+}
+
+// load 下载页面并按响应声明的编码转为 UTF-8 后解析，部分学院网站仍使用 GBK
+func (s *webSource) load(ctx context.Context, rawURL string) (*html.Node, error) {
+	if _, err := url.Parse(rawURL); err != nil {
+		return nil, fmt.Errorf("source %s: invalid url %s: %w", s.cfg.Name, rawURL, err)
+	}
+	// 详情页地址来自列表页，按配置中列表页的主机计入出口治理，避免为任意主机创建熔断器和指标
+	host := s.listURL.Host
+	if err := governor.Acquire(ctx, host); err != nil {
+		return nil, err
+	}
+
+	req := protocol.AcquireRequest()
+	resp := protocol.AcquireResponse()
+	defer func() {
+		protocol.ReleaseRequest(req)
+		protocol.ReleaseResponse(resp)
+	}()
+	req.SetMethod(consts.MethodGet)
+	req.SetRequestURI(rawURL)
+	req.SetOptions(
+		hertzconfig.WithDialTimeout(constants.NoticeSourceFetchTimeout),
+		hertzconfig.WithReadTimeout(constants.NoticeSourceFetchTimeout),
+		hertzconfig.WithRequestTimeout(constants.NoticeSourceFetchTimeout),
+	)
+
+	start := time.Now()
+	err := s.client.Do(ctx, req, resp)
+	metrics.ObserveUpstream(host, "FetchNoticeSource", start)
+	if err == nil && resp.StatusCode() != http.StatusOK {
+		err = errors.New(http.StatusText(resp.StatusCode()))
+	}
+	governor.Report(host, err)
+	if err != nil {
+		return nil, fmt.Errorf("source %s: fetch %s failed: %w", s.cfg.Name, rawURL, err)
+	}
+
+	r, err := charset.NewReader(bytes.NewReader(resp.Body()), string(resp.Header.ContentType()))
+	if err != nil {
+		return nil, fmt.Errorf("source %s: decode %s failed: %w", s.cfg.Name, rawURL, err)
+	}
+	doc, err := htmlquery.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("source %s: parse %s failed: %w", s.cfg.Name, rawURL, err)
+	}
+	return doc, nil
+}
+
+// parseDate 提取文字中的第一个日期并格式化为 YYYY-MM-DD，未找到时返回空串
+func parseDate(text string) string {
+	m := dateRe.FindStringSubmatch(text)
+	if m == nil {
+		return ""
+	}
+	t, err := time.Parse("2006-1-2", fmt.Sprintf("%s-%s-%s", m[1], m[2], m[3]))
+	if err != nil {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noticesource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app/client"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/simplifiedchinese"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+const testListPage = `<html><body><div class="list"><ul>
+<li><a href="info/1.htm" title="关于2024年寒假闭馆安排的通知">关于2024年寒假闭馆...</a><span>2024-01-05</span></li>
+<li><a href="https://lib.fzu.edu.cn/info/2.htm">数据库  试用</a><span>2024/1/3</span></li>
+<li><a href="javascript:void(0)">无效链接</a><span>2024-01-02</span></li>
+<li><a href="info/3.htm">缺少日期</a></li>
+</ul></div></body></html>`
+
+func newTestWebConfig(listURL string) WebConfig {
+	return WebConfig{
+		Name:         "library",
+		DisplayName:  "图书馆",
+		ListURL:      listURL,
+		ItemXPath:    `//div[@class="list"]//li`,
+		DateXPath:    `.//span`,
+		ContentXPath: `//div[@id="content"]`,
+	}
+}
+
+func TestNewWebSource(t *testing.T) {
+	type testCase struct {
+		name           string
+		modify         func(cfg *WebConfig)
+		expectErr      string
+		expectInterval time.Duration
+	}
+	testCases := []testCase{
+		{name: "DefaultInterval", expectInterval: constants.NoticeSourceDefaultPeriod},
+		{name: "MinInterval", modify: func(cfg *WebConfig) { cfg.Interval = time.Minute }, expectInterval: constants.NoticeSourceMinInterval},
+		{name: "ReservedName", modify: func(cfg *WebConfig) { cfg.Name = constants.NoticeSourceJwch }, expectErr: "reserved"},
+		{name: "InvalidListURL", modify: func(cfg *WebConfig) { cfg.ListURL = "ftp://lib.fzu.edu.cn" }, expectErr: "invalid list url"},
+		{name: "EmptyContentXPath", modify: func(cfg *WebConfig) { cfg.ContentXPath = "" }, expectErr: "empty xpath"},
+		{name: "InvalidXPath", modify: func(cfg *WebConfig) { cfg.ItemXPath = "//li[" }, expectErr: "invalid xpath"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newTestWebConfig("https://lib.fzu.edu.cn/tzgg.htm")
+			if tc.modify != nil {
+				tc.modify(&cfg)
+			}
+			src, err := NewWebSource(cfg, nil)
+			if tc.expectErr != "" {
+				assert.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectInterval, src.Interval())
+		})
+	}
+}
+
+func TestWebSourceFetch(t *testing.T) {
+	gbkDetail, err := simplifiedchinese.GBK.NewEncoder().String(`<html><body><div id="content"><p>闭馆时间</p></div></body></html>`)
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tzgg.htm":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(testListPage))
+		case "/info/1.htm":
+			w.Header().Set("Content-Type", "text/html; charset=gbk")
+			_, _ = w.Write([]byte(gbkDetail))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient()
+	assert.NoError(t, err)
+	src, err := NewWebSource(newTestWebConfig(server.URL+"/tzgg.htm"), c)
+	assert.NoError(t, err)
+
+	items, total, err := src.FetchPage(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []*Item{
+		{Title: "关于2024年寒假闭馆安排的通知", URL: server.URL + "/info/1.htm", Date: "2024-01-05"},
+		{Title: "数据库 试用", URL: "https://lib.fzu.edu.cn/info/2.htm", Date: "2024-01-03"},
+	}, items)
+
+	items, total, err = src.FetchPage(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Empty(t, items)
+
+	content, err := src.FetchDetail(context.Background(), &Item{URL: server.URL + "/info/1.htm"})
+	assert.NoError(t, err)
+	assert.Equal(t, "<p>闭馆时间</p>", content)
+
+	_, err = src.FetchDetail(context.Background(), &Item{URL: server.URL + "/info/404.htm"})
+	assert.ErrorContains(t, err, "Not Found")
+}
+
+func TestParseDate(t *testing.T) {
+	type testCase struct {
+		input  string
+		expect string
+	}
+	testCases := []testCase{
+		{input: "2024-12-01", expect: "2024-12-01"},
+		{input: "发布时间：2024/1/5 10:00", expect: "2024-01-05"},
+		{input: "2024年3月8日", expect: "2024-03-08"},
+		{input: "2024.13.01", expect: ""},
+		{input: "昨天", expect: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expect, parseDate(tc.input))
+		})
+	}
+}
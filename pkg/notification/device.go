@@ -0,0 +1,135 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	"github.com/west2-online/fzuhelper-server/pkg/umeng"
+)
+
+// devicePlatforms 定向推送时按该顺序逐个平台入队
+var devicePlatforms = []string{constants.DevicePlatformAndroid, constants.DevicePlatformIOS, constants.DevicePlatformHarmony}
+
+// deviceNotifier 按学号查询学生登记的设备，按学生的通知偏好过滤后，每个平台入队一次单播/列播
+// 处于免打扰时段的学生延迟到免打扰结束时发送，结束时间相同的学生合并为同一个任务
+type deviceNotifier struct {
+	db *db.Database
+}
+
+func NewDeviceNotifier(database *db.Database) Notifier {
+	return &deviceNotifier{db: database}
+}
+
+// deviceBatch 同一平台、同一发送时间的一批设备
+type deviceBatch struct {
+	platform  string
+	notBefore time.Time
+}
+
+func (n *deviceNotifier) Notify(ctx context.Context, msg *Message) error {
+	if !msg.Unicast || len(msg.StuIDs) == 0 {
+		return nil
+	}
+	notBefore, err := n.schedule(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("notification.device: %w", err)
+	}
+	if len(notBefore) == 0 {
+		return nil
+	}
+	devices, err := n.db.User.ListDevicesByStuIDs(ctx, msg.StuIDs)
+	if err != nil {
+		return fmt.Errorf("notification.device: %w", err)
+	}
+
+	tokens := make(map[deviceBatch][]string)
+	for _, device := range devices {
+		at, ok := notBefore[device.StuId]
+		if !ok {
+			continue
+		}
+		batch := deviceBatch{platform: device.Platform, notBefore: at}
+		tokens[batch] = append(tokens[batch], device.DeviceToken)
+	}
+	batches := make([]deviceBatch, 0, len(tokens))
+	for batch := range tokens {
+		batches = append(batches, batch)
+	}
+	slices.SortFunc(batches, func(a, b deviceBatch) int {
+		if c := slices.Index(devicePlatforms, a.platform) - slices.Index(devicePlatforms, b.platform); c != 0 {
+			return c
+		}
+		return a.notBefore.Compare(b.notBefore)
+	})
+
+	for _, batch := range batches {
+		channel := batch.platform
+		if !batch.notBefore.IsZero() {
+			channel += "@" + strconv.FormatInt(batch.notBefore.Unix(), 10)
+		}
+		err = umeng.Enqueue(ctx, &umeng.Task{
+			ID:           taskID(msg.ID, channel),
+			PushType:     msg.Type,
+			Title:        msg.Title,
+			Text:         msg.Text,
+			Keywords:     msg.Keywords,
+			Description:  msg.Description,
+			Deeplink:     msg.Deeplink,
+			Platform:     batch.platform,
+			DeviceTokens: tokens[batch],
+			NotBefore:    batch.notBefore,
+		})
+		if err != nil {
+			return fmt.Errorf("notification.device: %w", err)
+		}
+	}
+	return nil
+}
+
+// schedule 按通知偏好返回允许推送的学生及其最早发送时间，零值表示立即发送
+func (n *deviceNotifier) schedule(ctx context.Context, msg *Message) (map[string]time.Time, error) {
+	prefs, err := n.db.User.ListNotificationPreferencesByStuIDs(ctx, msg.StuIDs)
+	if err != nil {
+		return nil, err
+	}
+	byStuID := make(map[string]*model.NotificationPreference, len(prefs))
+	for _, pref := range prefs {
+		byStuID[pref.StuId] = pref
+	}
+
+	now := time.Now()
+	notBefore := make(map[string]time.Time, len(msg.StuIDs))
+	for _, stuID := range msg.StuIDs {
+		pref, ok := byStuID[stuID]
+		if !ok {
+			pref = DefaultPreference(stuID)
+		}
+		if !PushAllowed(pref, msg.Type) {
+			continue
+		}
+		notBefore[stuID] = QuietUntil(pref.QuietStart, pref.QuietEnd, now)
+	}
+	return notBefore, nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+)
+
+// inboxNotifier 将通知写入每名接收者的收件箱
+type inboxNotifier struct {
+	db *db.Database
+}
+
+func NewInboxNotifier(database *db.Database) Notifier {
+	return &inboxNotifier{db: database}
+}
+
+func (n *inboxNotifier) Notify(ctx context.Context, msg *Message) error {
+	if len(msg.StuIDs) == 0 {
+		return nil
+	}
+	list := make([]*model.Notification, len(msg.StuIDs))
+	for i, stuID := range msg.StuIDs {
+		list[i] = &model.Notification{
+			StuId:    stuID,
+			Type:     msg.Type,
+			Title:    msg.Title,
+			Content:  msg.Text,
+			Deeplink: msg.Deeplink,
+		}
+	}
+	if err := n.db.Notification.CreateNotifications(ctx, list); err != nil {
+		return fmt.Errorf("notification.inbox: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package notification 统一的通知发送入口，一条消息会交给所有渠道分别投递
+// 收件箱渠道为每名接收者持久化一份通知，友盟渠道按 tag 推送到设备，设备渠道按学号定向推送到学生登记的设备，
+// 设备推送遵循学生的通知偏好（关闭的类型、免打扰时段），被过滤或延迟的推送仍可在通知中心查看
+//
+// 接收者已知的推送（成绩、考试、考前提醒、考场变更）应设置 Unicast 按学号推送，tag 推送无法逐人应用通知偏好；
+// 其中客户端同时订阅了课程 tag 的推送（成绩、考试、考前提醒）仍需填入 Tags，送达未登记设备的旧版客户端，
+// 登记了设备的客户端会订阅 constants.UmengDeviceRegisteredTag，按 tag 推送时被排除，不会重复收到
+// 其余 tag 推送只用于客户端自行订阅 tag 的广播，例如教务处通知与通知关键词订阅
+//
+// 面向具体学生的推送都应填入 StuIDs 写入收件箱；面向全体用户的教务处通知是唯一的例外：
+// 逐人写入需要为每条通知复制全部学生的记录，而通知本身已持久化在通知列表（GetNotices）中，设备错过推送后仍可查看
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+)
+
+// Message 一条待发送的通知
+// StuIDs 为空时不写入收件箱，Tags 为空时不按 tag 推送，Unicast 为 false 时不按学号定向推送，调用方据此控制各渠道是否投递
+type Message struct {
+	ID          string   // 幂等键，同一 ID 的消息在各推送渠道只会发送成功一次，为空时每次都视为新消息
+	Type        string   // 通知类型，使用 constants.UmengPushType*
+	Title       string   // 标题
+	Text        string   // 正文
+	Keywords    []string // 小米模板参数
+	Description string   // 友盟后台中的任务描述
+	Deeplink    string   // 客户端跳转地址
+	Tags        []string // 推送的设备 tag，多个 tag 之间为或关系
+	StuIDs      []string // 写入收件箱的学号
+	Unicast     bool     // 是否同时推送到 StuIDs 名下登记的设备，与 Tags 同时使用时按 tag 推送会排除登记了设备的客户端
+}
+
+// Notifier 通知渠道
+type Notifier interface {
+	// Notify 投递消息，消息中与本渠道无关的部分应当被忽略
+	Notify(ctx context.Context, msg *Message) error
+}
+
+// dispatcher 依次调用各个渠道，单个渠道失败不影响其余渠道
+type dispatcher struct {
+	channels []Notifier
+}
+
+// NewDispatcher 组合多个渠道，返回的错误为各渠道错误的合并，可以使用 errors.Is 判断
+func NewDispatcher(channels ...Notifier) Notifier {
+	return &dispatcher{channels: channels}
+}
+
+// New 返回默认的通知入口：先写收件箱，再推送到设备
+func New(database *db.Database) Notifier {
+	return NewDispatcher(NewInboxNotifier(database), NewUmengNotifier(), NewDeviceNotifier(database))
+}
+
+func (d *dispatcher) Notify(ctx context.Context, msg *Message) error {
+	var errs []error
+	for _, channel := range d.channels {
+		if err := channel.Notify(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+	dbnotification "github.com/west2-online/fzuhelper-server/pkg/db/notification"
+	dbuser "github.com/west2-online/fzuhelper-server/pkg/db/user"
+	"github.com/west2-online/fzuhelper-server/pkg/umeng"
+)
+
+type notifierFunc func(ctx context.Context, msg *Message) error
+
+func (f notifierFunc) Notify(ctx context.Context, msg *Message) error {
+	return f(ctx, msg)
+}
+
+func TestDispatcher(t *testing.T) {
+	errInbox := errors.New("inbox failed")
+	called := 0
+	failing := notifierFunc(func(context.Context, *Message) error {
+		called++
+		return errInbox
+	})
+	succeeding := notifierFunc(func(context.Context, *Message) error {
+		called++
+		return nil
+	})
+
+	err := NewDispatcher(failing, succeeding).Notify(context.Background(), &Message{})
+
+	// 前一个渠道失败时仍会继续投递后续渠道
+	assert.Equal(t, 2, called)
+	assert.ErrorIs(t, err, errInbox)
+	assert.NoError(t, NewDispatcher(succeeding).Notify(context.Background(), &Message{}))
+}
+
+func TestInboxNotifier(t *testing.T) {
+	type testCase struct {
+		name        string
+		stuIDs      []string
+		mockErr     error
+		expectRows  int
+		expectError bool
+	}
+
+	testCases := []testCase{
+		{name: "no recipients"},
+		{name: "write each recipient", stuIDs: []string{"102301001", "102301002"}, expectRows: 2},
+		{name: "database error", stuIDs: []string{"102301001"}, mockErr: assert.AnError, expectRows: 1, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			var rows []*model.Notification
+			mockey.Mock((*dbnotification.DBNotification).CreateNotifications).
+				To(func(_ context.Context, list []*model.Notification) error {
+					rows = list
+					return tc.mockErr
+				}).Build()
+
+			err := NewInboxNotifier(new(db.Database)).Notify(context.Background(), &Message{
+				Type:     constants.UmengPushTypeExam,
+				Title:    "考试更新啦",
+				Text:     "数据结构考试已更新",
+				Deeplink: constants.UmengExamRoomDeeplink,
+				StuIDs:   tc.stuIDs,
+			})
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, "notification.inbox")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, rows, tc.expectRows)
+			for i, row := range rows {
+				assert.Equal(t, tc.stuIDs[i], row.StuId)
+				assert.Equal(t, constants.UmengPushTypeExam, row.Type)
+				assert.Equal(t, "数据结构考试已更新", row.Content)
+				assert.Equal(t, constants.UmengExamRoomDeeplink, row.Deeplink)
+			}
+		})
+	}
+}
+
+func TestUmengNotifier(t *testing.T) {
+	type testCase struct {
+		name          string
+		id            string
+		tags          []string
+		unicast       bool
+		queueFull     bool
+		expectEnqueue int
+		expectTaskID  string
+		expectExclude []string
+		expectError   error
+	}
+
+	testCases := []testCase{
+		{name: "no tags"},
+		{name: "single tag", tags: []string{"tag-a"}, expectEnqueue: 1},
+		{name: "multiple tags", tags: []string{"tag-a", "tag-b"}, expectEnqueue: 1},
+		{name: "derive task id", id: "exam-reminder-1", tags: []string{"tag-a"}, expectEnqueue: 1, expectTaskID: "exam-reminder-1:tag"},
+		{name: "queue full", tags: []string{"tag-a"}, queueFull: true, expectEnqueue: 1, expectError: ErrPushQueueFull},
+		{
+			name: "unicast excludes registered devices", tags: []string{"tag-a"}, unicast: true, expectEnqueue: 1,
+			expectExclude: []string{constants.UmengDeviceRegisteredTag},
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			var tasks []*umeng.Task
+			mockey.Mock(umeng.Enqueue).To(func(_ context.Context, task *umeng.Task) error {
+				tasks = append(tasks, task)
+				if tc.queueFull {
+					return umeng.ErrQueueFull
+				}
+				return nil
+			}).Build()
+
+			err := NewUmengNotifier().Notify(context.Background(), &Message{
+				ID:      tc.id,
+				Type:    constants.UmengPushTypeScore,
+				Title:   "成绩更新啦",
+				Tags:    tc.tags,
+				Unicast: tc.unicast,
+			})
+
+			if tc.expectError != nil {
+				assert.ErrorIs(t, err, tc.expectError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, tasks, tc.expectEnqueue)
+			for _, task := range tasks {
+				assert.Equal(t, tc.tags, task.Tags)
+				assert.Equal(t, constants.UmengPushTypeScore, task.PushType)
+				assert.Equal(t, tc.expectTaskID, task.ID)
+				assert.Equal(t, tc.expectExclude, task.ExcludeTags)
+			}
+		})
+	}
+}
+
+func TestDeviceNotifier(t *testing.T) {
+	type testCase struct {
+		name            string
+		unicast         bool
+		stuIDs          []string
+		prefs           []*model.NotificationPreference
+		devices         []*model.Device
+		mockErr         error
+		queueFull       bool
+		expectQuery     bool
+		expectPlatforms []string
+		expectDelayed   int
+		expectError     string
+	}
+
+	devices := []*model.Device{
+		{StuId: "102301001", DeviceToken: "token-a", Platform: constants.DevicePlatformIOS},
+		{StuId: "102301001", DeviceToken: "token-b", Platform: constants.DevicePlatformAndroid},
+		{StuId: "102301002", DeviceToken: "token-c", Platform: constants.DevicePlatformAndroid},
+	}
+
+	testCases := []testCase{
+		{name: "unicast disabled", stuIDs: []string{"102301001"}},
+		{name: "no recipients", unicast: true},
+		{
+			name:            "push by platform",
+			unicast:         true,
+			stuIDs:          []string{"102301001", "102301002"},
+			devices:         devices,
+			expectQuery:     true,
+			expectPlatforms: []string{constants.DevicePlatformAndroid, constants.DevicePlatformIOS},
+		},
+		{
+			name:    "all recipients opted out",
+			unicast: true,
+			stuIDs:  []string{"102301001", "102301002"},
+			prefs: []*model.NotificationPreference{
+				{StuId: "102301001", DisabledTypes: constants.UmengPushTypeExam, Channel: constants.NotificationChannelAll},
+				{StuId: "102301002", Channel: constants.NotificationChannelInbox},
+			},
+			devices: devices,
+		},
+		{
+			name:    "skip opted out recipient",
+			unicast: true,
+			stuIDs:  []string{"102301001", "102301002"},
+			prefs: []*model.NotificationPreference{
+				{StuId: "102301001", DisabledTypes: "score,exam", Channel: constants.NotificationChannelAll},
+			},
+			devices:         devices,
+			expectQuery:     true,
+			expectPlatforms: []string{constants.DevicePlatformAndroid},
+		},
+		{
+			name:    "delay recipient in quiet hours",
+			unicast: true,
+			stuIDs:  []string{"102301001", "102301002"},
+			prefs: []*model.NotificationPreference{
+				{StuId: "102301002", Channel: constants.NotificationChannelAll, QuietStart: 23 * 60, QuietEnd: 7 * 60},
+			},
+			devices:         devices,
+			expectQuery:     true,
+			expectPlatforms: []string{constants.DevicePlatformAndroid, constants.DevicePlatformAndroid, constants.DevicePlatformIOS},
+			expectDelayed:   1,
+		},
+		{
+			name:        "database error",
+			unicast:     true,
+			stuIDs:      []string{"102301001"},
+			mockErr:     assert.AnError,
+			expectQuery: true,
+			expectError: "notification.device",
+		},
+		{
+			name:        "queue full",
+			unicast:     true,
+			stuIDs:      []string{"102301001"},
+			devices:     devices,
+			queueFull:   true,
+			expectQuery: true,
+			expectError: ErrPushQueueFull.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			mockey.Mock((*dbuser.DBUser).ListNotificationPreferencesByStuIDs).Return(tc.prefs, nil).Build()
+			// 固定视为处于免打扰时段，避免结果依赖运行测试的时间
+			mockey.Mock(QuietUntil).To(func(start, end int, now time.Time) time.Time {
+				if start == end {
+					return time.Time{}
+				}
+				return now.Add(time.Hour)
+			}).Build()
+			queried := false
+			mockey.Mock((*dbuser.DBUser).ListDevicesByStuIDs).
+				To(func(_ context.Context, stuIDs []string) ([]*model.Device, error) {
+					queried = true
+					return tc.devices, tc.mockErr
+				}).Build()
+			var platforms []string
+			delayed := 0
+			tokens := make(map[string][]string)
+			mockey.Mock(umeng.Enqueue).To(func(_ context.Context, task *umeng.Task) error {
+				if tc.queueFull {
+					return umeng.ErrQueueFull
+				}
+				platforms = append(platforms, task.Platform)
+				if !task.NotBefore.IsZero() {
+					delayed++
+					return nil
+				}
+				tokens[task.Platform] = append(tokens[task.Platform], task.DeviceTokens...)
+				return nil
+			}).Build()
+
+			err := NewDeviceNotifier(new(db.Database)).Notify(context.Background(), &Message{
+				Type:    constants.UmengPushTypeExam,
+				Title:   "考试提醒",
+				StuIDs:  tc.stuIDs,
+				Unicast: tc.unicast,
+			})
+
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectQuery, queried)
+			assert.Equal(t, tc.expectPlatforms, platforms)
+			assert.Equal(t, tc.expectDelayed, delayed)
+			if tc.name == "skip opted out recipient" {
+				assert.Equal(t, []string{"token-c"}, tokens[constants.DevicePlatformAndroid])
+			}
+			if tc.name == "push by platform" {
+				assert.Equal(t, []string{"token-b", "token-c"}, tokens[constants.DevicePlatformAndroid])
+				assert.Equal(t, []string{"token-a"}, tokens[constants.DevicePlatformIOS])
+			}
+		})
+	}
+}
+
+func TestPushAllowed(t *testing.T) {
+	pref := &model.NotificationPreference{DisabledTypes: "score,teaching", Channel: constants.NotificationChannelAll}
+	assert.False(t, PushAllowed(pref, constants.UmengPushTypeScore))
+	assert.True(t, PushAllowed(pref, constants.UmengPushTypeExam))
+	assert.True(t, PushAllowed(DefaultPreference("102301001"), constants.UmengPushTypeScore))
+	assert.False(t, PushAllowed(&model.NotificationPreference{Channel: constants.NotificationChannelInbox}, constants.UmengPushTypeExam))
+	assert.Equal(t, []string{}, DisabledTypes(DefaultPreference("102301001")))
+}
+
+func TestQuietUntil(t *testing.T) {
+	type testCase struct {
+		name   string
+		start  int
+		end    int
+		now    time.Time
+		expect time.Time
+	}
+
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 9, day, hour, minute, 0, 0, constants.ChinaTZ)
+	}
+	testCases := []testCase{
+		{name: "disabled", start: 0, end: 0, now: at(1, 2, 0)},
+		{name: "same day window", start: 12 * 60, end: 14 * 60, now: at(1, 13, 0), expect: at(1, 14, 0)},
+		{name: "outside same day window", start: 12 * 60, end: 14 * 60, now: at(1, 14, 0)},
+		{name: "overnight before midnight", start: 23 * 60, end: 7 * 60, now: at(1, 23, 30), expect: at(2, 7, 0)},
+		{name: "overnight after midnight", start: 23 * 60, end: 7 * 60, now: at(2, 2, 0), expect: at(2, 7, 0)},
+		{name: "outside overnight window", start: 23 * 60, end: 7 * 60, now: at(1, 12, 0)},
+		{name: "convert to china time", start: 23 * 60, end: 7 * 60, now: at(2, 2, 0).UTC(), expect: at(2, 7, 0)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.True(t, tc.expect.Equal(QuietUntil(tc.start, tc.end, tc.now)))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/db/model"
+)
+
+// DefaultPreference 未设置过偏好的学生使用的默认偏好：推送全部类型、不开启免打扰
+func DefaultPreference(stuID string) *model.NotificationPreference {
+	return &model.NotificationPreference{
+		StuId:   stuID,
+		Channel: constants.NotificationChannelAll,
+	}
+}
+
+// DisabledTypes 返回偏好中关闭推送的通知类型
+func DisabledTypes(pref *model.NotificationPreference) []string {
+	if pref.DisabledTypes == "" {
+		return []string{}
+	}
+	return strings.Split(pref.DisabledTypes, ",")
+}
+
+// PushAllowed 判断是否允许将该类型的通知推送到学生的设备，收件箱不受偏好影响
+func PushAllowed(pref *model.NotificationPreference, pushType string) bool {
+	return pref.Channel != constants.NotificationChannelInbox && !slices.Contains(DisabledTypes(pref), pushType)
+}
+
+// QuietUntil 返回 now 所在免打扰时段的结束时间，start 与 end 为距零点的分钟数（北京时间）
+// 不在免打扰时段内或 start 与 end 相等（未开启免打扰）时返回零值
+func QuietUntil(start, end int, now time.Time) time.Time {
+	if start == end {
+		return time.Time{}
+	}
+	now = now.In(constants.ChinaTZ)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, constants.ChinaTZ)
+	minute := int(now.Sub(today) / time.Minute)
+	switch {
+	case start < end && minute >= start && minute < end:
+		// 当天内的时段，例如 12:00-14:00
+		return today.Add(time.Duration(end) * time.Minute)
+	case start > end && minute >= start:
+		// 跨零点的时段，例如 23:00-07:00 中的 23:30
+		return today.AddDate(0, 0, 1).Add(time.Duration(end) * time.Minute)
+	case start > end && minute < end:
+		return today.Add(time.Duration(end) * time.Minute)
+	default:
+		return time.Time{}
+	}
+}
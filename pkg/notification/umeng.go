@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/umeng"
+)
+
+// ErrPushQueueFull 友盟推送队列积压已满，本次推送被丢弃
+var ErrPushQueueFull = umeng.ErrQueueFull
+
+// umengNotifier 通过友盟推送到订阅了 tag 的设备
+// 推送任务写入友盟队列，由队列负责限流、每日额度与失败重试
+// 按 tag 推送无法得知接收者，不能逐个学生应用通知偏好，因此统一在 constants.NotificationBroadcast* 时段内免打扰
+// 消息同时按学号推送时排除登记了设备的客户端，这些设备由设备渠道按通知偏好送达，tag 推送只覆盖未登记设备的旧版客户端
+type umengNotifier struct{}
+
+func NewUmengNotifier() Notifier {
+	return &umengNotifier{}
+}
+
+func (n *umengNotifier) Notify(ctx context.Context, msg *Message) error {
+	if len(msg.Tags) == 0 {
+		return nil
+	}
+	var excludeTags []string
+	if msg.Unicast {
+		excludeTags = []string{constants.UmengDeviceRegisteredTag}
+	}
+	err := umeng.Enqueue(ctx, &umeng.Task{
+		ID:          taskID(msg.ID, "tag"),
+		PushType:    msg.Type,
+		Title:       msg.Title,
+		Text:        msg.Text,
+		Keywords:    msg.Keywords,
+		Description: msg.Description,
+		Deeplink:    msg.Deeplink,
+		Tags:        msg.Tags,
+		ExcludeTags: excludeTags,
+		NotBefore:   QuietUntil(constants.NotificationBroadcastQuietStart, constants.NotificationBroadcastQuietEnd, time.Now()),
+	})
+	if err != nil {
+		return fmt.Errorf("notification.umeng: %w", err)
+	}
+	return nil
+}
+
+// taskID 由消息的幂等键派生各渠道任务的幂等键，避免不同渠道的任务相互去重
+func taskID(id, channel string) string {
+	if id == "" {
+		return ""
+	}
+	return id + ":" + channel
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oss
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/upyun/go-sdk/v3/upyun"
+)
+
+// noticeAttachmentHashLen 附件文件名取原始地址 md5 的前 16 位
+const noticeAttachmentHashLen = 16
+
+// NoticeAttachmentOSSCli 通知附件转存使用的 Cli
+type NoticeAttachmentOSSCli struct {
+	upYun          *upyun.UpYun
+	path           string
+	downloadDomain string
+}
+
+func NewNoticeAttachmentOSSCli(cfg *UpYunConfig) NoticeAttachmentOSSRepo {
+	return &NoticeAttachmentOSSCli{
+		upYun:          cfg.upyun,
+		path:           cfg.Path,
+		downloadDomain: cfg.DownloadDomain,
+	}
+}
+
+// Upload 又拍云上传文件到指定path
+func (c *NoticeAttachmentOSSCli) Upload(file []byte, remotePath string) error {
+	return c.upYun.Put(&upyun.PutObjectConfig{
+		Path:   remotePath,
+		Reader: bytes.NewReader(file),
+	})
+}
+
+// GenerateAttachmentPath 同一通知下同一原始地址总是得到相同的路径，重复转存时直接覆盖
+func (c *NoticeAttachmentOSSCli) GenerateAttachmentPath(noticeID int64, sourceURL, ext string) (string, string) {
+	sum := md5.Sum([]byte(sourceURL))
+	name := fmt.Sprintf("%s%s", hex.EncodeToString(sum[:])[:noticeAttachmentHashLen], ext)
+	remotePath := strings.Join([]string{c.path, "notice/", fmt.Sprint(noticeID), "/", name}, "")
+	return c.downloadDomain + remotePath, remotePath
+}
@@ -26,3 +26,10 @@ type LaunchScreenOSSRepo interface {
 	// GetRemotePathFromUrl 获得远程path
 	GetRemotePathFromUrl(url string) string
 }
+
+type NoticeAttachmentOSSRepo interface {
+	// Upload 又拍云上传附件
+	Upload(file []byte, remotePath string) error
+	// GenerateAttachmentPath 根据通知 ID 和原始地址生成固定的附件路径，返回下载地址和远程 path
+	GenerateAttachmentPath(noticeID int64, sourceURL, ext string) (string, string)
+}
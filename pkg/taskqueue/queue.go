@@ -27,6 +27,7 @@ import (
 
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 )
 
 type TaskQueue interface {
@@ -37,8 +38,10 @@ type TaskQueue interface {
 }
 
 // QueueTask 队列任务，使用指数退避和令牌桶限流
+// 设置 RetryDelay 时，失败后按其返回的时长重新入队，适用于需要等待上游恢复的任务
 type QueueTask struct {
-	Execute func() error
+	Execute    func() error
+	RetryDelay func() time.Duration
 }
 
 // ScheduleQueueTask 定时任务
@@ -57,8 +60,13 @@ func NewBaseTaskQueue() *BaseTaskQueue {
 		// 默认限流器
 		// - 单任务重试采用指数退避策略：初始延迟为 5ms，最大延迟为 1000 秒。
 		// - 整体速率限制：每秒最多 10 次请求，桶大小为 100 个令牌。
-		workQueue: workqueue.NewTypedRateLimitingQueue(
+		// 队列深度、重试次数等指标通过 MetricsProvider 导出
+		workQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name:            constants.TaskQueueMetricsName,
+				MetricsProvider: metrics.WorkqueueProvider,
+			},
 		),
 	}
 }
@@ -106,7 +114,11 @@ func (btq *BaseTaskQueue) worker() {
 			btq.workQueue.Done(key)
 		case QueueTask:
 			if err := task.Execute(); err != nil {
-				btq.workQueue.AddRateLimited(key)
+				if task.RetryDelay != nil {
+					btq.workQueue.AddAfter(key, task.RetryDelay())
+				} else {
+					btq.workQueue.AddRateLimited(key)
+				}
 				logger.Errorf("QueueTask execute failed: %v", err)
 			} else {
 				btq.taskMap.Delete(key)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"go.opentelemetry.io/otel"
@@ -82,6 +83,32 @@ func Test_executeTask(t *testing.T) {
 	})
 }
 
+func TestQueueTaskRetryDelay(t *testing.T) {
+	Convey("failed task with RetryDelay is requeued after the delay", t, func() {
+		btq := NewBaseTaskQueue()
+		btq.Start()
+		defer btq.workQueue.ShutDown()
+
+		executed := make(chan time.Time, 2)
+		attempts := 0
+		btq.Add("retry-delay", QueueTask{
+			Execute: func() error {
+				attempts++
+				executed <- time.Now()
+				if attempts == 1 {
+					return errors.New("upstream unavailable")
+				}
+				return nil
+			},
+			RetryDelay: func() time.Duration { return 50 * time.Millisecond },
+		})
+
+		first := <-executed
+		second := <-executed
+		So(second.Sub(first), ShouldBeGreaterThanOrEqualTo, 50*time.Millisecond)
+	})
+}
+
 func assertHasAttribute(t *testing.T, attrs []attribute.KeyValue, key, value string) {
 	t.Helper()
 
@@ -17,32 +17,28 @@ limitations under the License.
 package umeng
 
 import (
+	"context"
 	"sync"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
 )
 
 // asyncDispatcher 负责异步消费 Umeng 发送任务并执行限流。
 // 设计目标：
 // 1) 异步：发送端仅入队，不阻塞业务线程或主任务队列。
-// 2) 限流：支持最小间隔控制 + 每日配额控制。
-// 3) 单例：进程内仅启动一个 dispatcher，确保全局限流一致性。
+// 2) 限流：支持最小间隔控制 + 每日配额控制，配置 Redis 时配额由所有实例共享。
+// 3) 优先级：考试提醒 > 成绩通知 > 运营推送，配额紧张时低优先级的任务顺延到次日。
+// 4) 可靠：任务持久化在队列中，发送失败按退避重试，重试耗尽后移入死信，并通过幂等键避免重复发送。
 type asyncDispatcher struct {
-	// ch 为任务队列通道，元素是“发送函数”。
-	// 发送函数返回 error，便于统一记录失败日志。
-	ch chan func() error
-	// interval 为相邻两次发送的最小间隔。
-	interval time.Duration
-	// dailyLimit 为每日最大允许发送次数。
-	dailyLimit int
-	// dailyCount 为当天已发送次数。
-	dailyCount int
-	// lastResetDate 记录上次重置日期，用于跨日清零计数。
-	lastResetDate time.Time
-	// lastRequestTime 记录上一次实际发送的时间，用于间隔限流。
-	lastRequestTime time.Time
+	// queue 为任务队列，配置 Redis 时为持久化队列，否则为进程内队列。
+	queue taskQueue
+	// quota 为推送配额，配置 Redis 时为集群共享配额，否则为进程内配额。
+	quota quota
 }
 
 var (
@@ -50,110 +46,194 @@ var (
 	dispatcherOnce sync.Once
 	// dispatcher 为全局单例实例。
 	dispatcher *asyncDispatcher
+	// queueClient 为持久化队列使用的 Redis 连接，由 Init 设置。
+	queueClient *redis.Client
 )
 
+// Init 设置持久化队列与共享配额使用的 Redis 连接，需在服务启动时、首次推送前调用。
+// 未调用或 client 为 nil 时使用进程内队列与配额，进程重启后未发送的任务会丢失，多个实例的发送量也会叠加。
+func Init(client *redis.Client) {
+	queueClient = client
+}
+
 // getDispatcher 获取全局 dispatcher 单例。
 // 首次调用会完成初始化并启动后台消费协程。
-// 该方法为内部使用，外部通过 EnqueueAsync 入队即可。
+// 该方法为内部使用，外部通过 Enqueue 入队即可。
 func getDispatcher() *asyncDispatcher {
 	dispatcherOnce.Do(func() {
-		dispatcher = newAsyncDispatcher()
+		dispatcher = newAsyncDispatcher(newTaskQueue(), newQuota())
 		// 后台消费协程：串行处理任务，确保限流语义正确。
-		go dispatcher.run()
+		go dispatcher.run(context.Background())
 	})
 	return dispatcher
 }
 
+// newTaskQueue 优先使用 Redis 持久化队列，初始化失败时退化为进程内队列。
+func newTaskQueue() taskQueue {
+	if queueClient == nil {
+		return newMemoryQueue(constants.UmengAsyncQueueSize)
+	}
+	q, err := newRedisQueue(context.Background(), queueClient)
+	if err != nil {
+		logger.Errorf("umeng: init redis queue failed, fallback to in-memory queue: %v", err)
+		return newMemoryQueue(constants.UmengAsyncQueueSize)
+	}
+	return q
+}
+
+// newQuota 配置 Redis 时使用集群共享配额，否则使用进程内配额。
+func newQuota() quota {
+	metrics.UmengDailyQuotaLimit.Set(float64(constants.UmengDailyLimit))
+	if queueClient == nil {
+		return newLocalQuota(constants.UmengRateLimitDelay, constants.UmengDailyLimit)
+	}
+	return newRedisQuota(queueClient, constants.UmengRateLimitDelay, constants.UmengDailyLimit)
+}
+
 // newAsyncDispatcher 创建一个新的 dispatcher 实例。
 // 参数：
-// - queueSize：队列缓冲长度。
-// - interval：发送最小间隔。
-// - dailyLimit：每日最大发送次数。
+// - queue：任务队列。
+// - quota：推送配额。
 // 返回值仅在 getDispatcher 中使用，避免重复创建。
-func newAsyncDispatcher() *asyncDispatcher {
-	queueSize := constants.UmengAsyncQueueSize
-	interval := constants.UmengRateLimitDelay
-	dailyLimit := constants.UmengDailyLimit
+func newAsyncDispatcher(queue taskQueue, quota quota) *asyncDispatcher {
 	return &asyncDispatcher{
-		ch:              make(chan func() error, queueSize),
-		interval:        interval,
-		dailyLimit:      dailyLimit,
-		dailyCount:      0,
-		lastResetDate:   time.Now(),
-		lastRequestTime: time.Now().Add(-interval),
+		queue: queue,
+		quota: quota,
 	}
 }
 
-// EnqueueAsync 将 Umeng 发送任务放入异步队列。
+// Enqueue 将 Umeng 发送任务放入队列。
 // 特性：
-// - 非阻塞：队列满时立即返回 false，不阻塞业务线程。
-// - 安全：task 为空时直接返回 false。
+// - 非阻塞：队列积压达到上限时立即返回 ErrQueueFull，不阻塞业务线程。
+// - 幂等：task.ID 相同的任务只会发送成功一次，为空时自动生成。
+// - 延迟：task.NotBefore 晚于当前时间时，到点后才进入队列。
 // - 单例：内部确保 dispatcher 只初始化一次。
-// 用法：将实际发送逻辑包装成闭包传入，例如：
-//
-//	umeng.EnqueueAsync(func() error { return umeng.SendAndroidGroupcastWithGoApp(...) })
-func EnqueueAsync(task func() error) bool {
-	if task == nil {
-		return false
+func Enqueue(ctx context.Context, task *Task) error {
+	if err := task.validate(); err != nil {
+		return err
+	}
+	if task.ID == "" {
+		task.ID = newTaskID()
 	}
 	d := getDispatcher()
-	select {
-	case d.ch <- task:
-		return true
-	default:
-		return false
+	if delay := time.Until(task.NotBefore); delay > 0 {
+		// 延迟发送的任务与待重试的任务一样暂存在等待集合中，到点后自动移回队列
+		if err := d.queue.retry(ctx, task, delay); err != nil {
+			metrics.UmengTasks.WithLabelValues(metrics.UmengDropped).Inc()
+			return err
+		}
+		metrics.UmengTasks.WithLabelValues(metrics.UmengDelayed).Inc()
+		return nil
 	}
+	if err := d.queue.push(ctx, task); err != nil {
+		metrics.UmengTasks.WithLabelValues(metrics.UmengDropped).Inc()
+		return err
+	}
+	metrics.UmengTasks.WithLabelValues(metrics.UmengEnqueued).Inc()
+	metrics.UmengQueueLength.Set(float64(d.queue.size(ctx)))
+	return nil
 }
 
 // run 后台消费循环。
 // 该循环串行读取队列并执行任务，先限流再发送，保证顺序与配额一致。
-// 注意：此方法应仅在后台协程中运行。
-func (d *asyncDispatcher) run() {
-	for task := range d.ch {
-		d.wait()
-		if err := task(); err != nil {
-			logger.Errorf("umeng async task failed: %v", err)
+// 注意：此方法应仅在后台协程中运行，ctx 结束后退出。
+func (d *asyncDispatcher) run(ctx context.Context) {
+	for {
+		task, err := d.queue.pop(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Errorf("umeng: pop task failed: %v", err)
+			time.Sleep(constants.UmengQueueBlockTime)
+			continue
+		}
+		if task == nil {
+			continue
 		}
+		metrics.UmengQueueLength.Set(float64(d.queue.size(ctx)))
+		d.handle(ctx, task)
 	}
 }
 
-// wait 执行限流等待。
-// 逻辑顺序：
-// 1) 跨日判断：新的一天重置 dailyCount。
-// 2) 每日配额：超过 dailyLimit 则等待到次日零点并重置。
-// 3) 间隔限制：确保相邻发送间隔不小于 interval。
-// 该方法在后台消费协程中调用，因此可以阻塞而不影响业务线程。
-func (d *asyncDispatcher) wait() {
-	now := time.Now()
-	if !sameDay(now, d.lastResetDate) {
-		d.dailyCount = 0
-		d.lastResetDate = now
+// handle 发送单个任务并根据结果确认、重试或移入死信。
+// 重试与死信写入成功后才确认任务，写入失败时任务留在队列中等待再次投递（至少一次）。
+func (d *asyncDispatcher) handle(ctx context.Context, task *Task) {
+	done, err := d.queue.done(ctx, task.ID)
+	if err != nil {
+		logger.Errorf("umeng: check task %s done failed: %v", task.ID, err)
+	}
+	if done {
+		metrics.UmengTasks.WithLabelValues(metrics.UmengSkipped).Inc()
+		d.ack(ctx, task)
+		return
 	}
 
-	if d.dailyCount >= d.dailyLimit {
-		nextDay := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
-		time.Sleep(time.Until(nextDay))
-		d.dailyCount = 0
-		d.lastResetDate = time.Now()
-		// 当前 wait() 调用已在此手动完成跨日重置，后续直接进入间隔检查即可，无需再次执行 sameDay 判断。
-		d.lastRequestTime = time.Now().Add(-d.interval)
+	if !d.reserve(ctx, task) {
+		return
+	}
+	if err = task.send(); err == nil {
+		metrics.UmengTasks.WithLabelValues(metrics.UmengSent).Inc()
+		if err = d.queue.markDone(ctx, task.ID); err != nil {
+			logger.Errorf("umeng: mark task %s done failed: %v", task.ID, err)
+		}
+		d.ack(ctx, task)
+		return
+	}
+
+	metrics.UmengTasks.WithLabelValues(metrics.UmengFailed).Inc()
+	task.Attempts++
+	task.LastError = err.Error()
+	if task.Attempts >= constants.UmengMaxAttempts {
+		logger.Errorf("umeng: task %s failed after %d attempts, move to dead letter: %v", task.ID, task.Attempts, err)
+		if err = d.queue.dead(ctx, task); err != nil {
+			logger.Errorf("umeng: move task %s to dead letter failed: %v", task.ID, err)
+			return
+		}
+		metrics.UmengTasks.WithLabelValues(metrics.UmengDead).Inc()
+		d.ack(ctx, task)
+		return
 	}
 
-	now = time.Now()
-	elapsed := now.Sub(d.lastRequestTime)
-	if elapsed < d.interval {
-		time.Sleep(d.interval - elapsed)
-		now = time.Now()
+	delay := retryDelay(task.Attempts)
+	logger.Warnf("umeng: task %s failed (attempt %d), retry in %v: %v", task.ID, task.Attempts, delay, err)
+	if err = d.queue.retry(ctx, task, delay); err != nil {
+		logger.Errorf("umeng: schedule retry of task %s failed: %v", task.ID, err)
+		return
 	}
+	metrics.UmengTasks.WithLabelValues(metrics.UmengRetried).Inc()
+	d.ack(ctx, task)
+}
 
-	d.lastRequestTime = now
-	d.dailyCount++
+func (d *asyncDispatcher) ack(ctx context.Context, task *Task) {
+	if err := d.queue.ack(ctx, task); err != nil {
+		logger.Errorf("umeng: ack task %s failed: %v", task.ID, err)
+	}
 }
 
-// sameDay 判断两个时间是否在同一天（按本地时区）。
-// 用于每日配额的跨日判断。
-func sameDay(a, b time.Time) bool {
-	ay, am, ad := a.Date()
-	by, bm, bd := b.Date()
-	return ay == by && am == bm && ad == bd
+// reserve 为任务申请配额，间隔未到时阻塞等待。
+// 该优先级当天的配额已用完时，将任务顺延到次日重新入队（不计入重试次数）并返回 false。
+// 该方法在后台消费协程中调用，因此可以阻塞而不影响业务线程。
+func (d *asyncDispatcher) reserve(ctx context.Context, task *Task) bool {
+	for {
+		wait, exhausted := d.quota.acquire(ctx, task.priority())
+		if exhausted {
+			logger.Warnf("umeng: daily quota of %s tasks exhausted, defer task %s by %v", priorityNames[task.priority()], task.ID, wait)
+			if err := d.queue.retry(ctx, task, wait); err != nil {
+				logger.Errorf("umeng: defer task %s failed: %v", task.ID, err)
+				return false
+			}
+			metrics.UmengTasks.WithLabelValues(metrics.UmengDeferred).Inc()
+			d.ack(ctx, task)
+			return false
+		}
+		if wait == 0 {
+			return true
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return false
+		}
+	}
 }
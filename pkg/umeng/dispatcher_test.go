@@ -17,6 +17,7 @@ limitations under the License.
 package umeng
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"testing"
@@ -40,32 +41,15 @@ func resetDispatcherForTest() {
 }
 
 func TestNewAsyncDispatcher(t *testing.T) {
-	type testCase struct {
-		name            string
-		expectQueueSize int
-		expectInterval  time.Duration
-		expectDaily     int
-	}
-
-	testCases := []testCase{
-		{
-			name:            "UseConstants",
-			expectQueueSize: constants.UmengAsyncQueueSize,
-			expectInterval:  constants.UmengRateLimitDelay,
-			expectDaily:     constants.UmengDailyLimit,
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			d := newAsyncDispatcher()
-
-			assert.NotNil(t, d)
-			assert.Equal(t, tc.expectQueueSize, cap(d.ch))
-			assert.Equal(t, tc.expectInterval, d.interval)
-			assert.Equal(t, tc.expectDaily, d.dailyLimit)
-			assert.Equal(t, 0, d.dailyCount)
-		})
+	q := newMemoryQueue(constants.UmengAsyncQueueSize)
+	l := newLocalQuota(constants.UmengRateLimitDelay, constants.UmengDailyLimit)
+	d := newAsyncDispatcher(q, l)
+
+	assert.NotNil(t, d)
+	assert.Same(t, q, d.queue)
+	assert.Same(t, l, d.quota)
+	for _, ch := range q.chs {
+		assert.Equal(t, constants.UmengAsyncQueueSize, cap(ch))
 	}
 }
 
@@ -78,42 +62,47 @@ func TestGetDispatcher(t *testing.T) {
 
 	assert.NotNil(t, d1)
 	assert.Same(t, d1, d2)
+	// 未调用 Init 时使用进程内队列与配额
+	_, ok := d1.queue.(*memoryQueue)
+	assert.True(t, ok)
+	_, ok = d1.quota.(*localQuota)
+	assert.True(t, ok)
 }
 
-func TestEnqueueAsync(t *testing.T) {
+func TestEnqueue(t *testing.T) {
 	type testCase struct {
-		name       string
-		task       func() error
-		prepare    func()
-		expectEnq  bool
-		expectRead bool
+		name        string
+		task        *Task
+		queueSize   int
+		fill        bool
+		expectError error
+		expectRead  bool
 	}
 
 	testCases := []testCase{
 		{
-			name:      "NilTask",
-			task:      nil,
-			prepare:   resetDispatcherForTest,
-			expectEnq: false,
+			name:        "EmptyTarget",
+			task:        &Task{Title: "title"},
+			queueSize:   1,
+			expectError: errEmptyTarget,
 		},
 		{
-			name: "EnqueueSuccess",
-			task: func() error { return nil },
-			prepare: func() {
-				setMockDispatcherForTest(&asyncDispatcher{ch: make(chan func() error, 1)})
-			},
-			expectEnq:  true,
+			name:       "EnqueueSuccess",
+			task:       &Task{Title: "title", Tags: []string{"tag"}},
+			queueSize:  1,
 			expectRead: true,
 		},
 		{
-			name: "QueueFull",
-			task: func() error { return nil },
-			prepare: func() {
-				d := &asyncDispatcher{ch: make(chan func() error, 1)}
-				d.ch <- func() error { return nil }
-				setMockDispatcherForTest(d)
-			},
-			expectEnq: false,
+			name:      "DelayUntilNotBefore",
+			task:      &Task{Title: "title", Tags: []string{"tag"}, NotBefore: time.Now().Add(time.Hour)},
+			queueSize: 1,
+		},
+		{
+			name:        "QueueFull",
+			task:        &Task{Title: "title", Tags: []string{"tag"}},
+			queueSize:   1,
+			fill:        true,
+			expectError: ErrQueueFull,
 		},
 	}
 
@@ -121,16 +110,25 @@ func TestEnqueueAsync(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			resetDispatcherForTest()
 			t.Cleanup(resetDispatcherForTest)
-			if tc.prepare != nil {
-				tc.prepare()
+			q := newMemoryQueue(tc.queueSize)
+			if tc.fill {
+				q.chs[constants.UmengPriorityMarketing] <- &Task{}
 			}
+			setMockDispatcherForTest(&asyncDispatcher{queue: q})
 
-			ok := EnqueueAsync(tc.task)
-			assert.Equal(t, tc.expectEnq, ok)
+			err := Enqueue(context.Background(), tc.task)
+			assert.ErrorIs(t, err, tc.expectError)
 
+			if !tc.expectRead && !tc.fill {
+				// 延迟发送的任务不会立即进入队列
+				assert.Equal(t, int64(0), q.size(context.Background()))
+			}
 			if tc.expectRead {
 				select {
-				case <-dispatcher.ch:
+				case task := <-q.chs[constants.UmengPriorityMarketing]:
+					assert.Same(t, tc.task, task)
+					// 未指定幂等键时自动生成
+					assert.NotEmpty(t, task.ID)
 				default:
 					t.Fatalf("expected task enqueued but channel is empty")
 				}
@@ -139,160 +137,187 @@ func TestEnqueueAsync(t *testing.T) {
 	}
 }
 
-func TestSameDay(t *testing.T) {
-	type testCase struct {
-		name   string
-		a      time.Time
-		b      time.Time
-		expect bool
-	}
+// fakeQueue 记录 dispatcher 对队列的调用
+type fakeQueue struct {
+	memoryQueue
+	isDone     bool
+	acked      []*Task
+	retried    []time.Duration
+	deadTasks  []*Task
+	doneIDs    []string
+	retryError error
+}
 
-	now := time.Now()
-	testCases := []testCase{
-		{
-			name:   "SameDay",
-			a:      now,
-			b:      now.Add(2 * time.Hour),
-			expect: true,
-		},
-		{
-			name:   "DifferentDay",
-			a:      now,
-			b:      now.Add(24 * time.Hour),
-			expect: false,
-		},
-	}
+func (q *fakeQueue) ack(_ context.Context, task *Task) error {
+	q.acked = append(q.acked, task)
+	return nil
+}
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			assert.Equal(t, tc.expect, sameDay(tc.a, tc.b))
-		})
+func (q *fakeQueue) retry(_ context.Context, _ *Task, delay time.Duration) error {
+	q.retried = append(q.retried, delay)
+	return q.retryError
+}
+
+func (q *fakeQueue) dead(_ context.Context, task *Task) error {
+	q.deadTasks = append(q.deadTasks, task)
+	return nil
+}
+
+func (q *fakeQueue) done(context.Context, string) (bool, error) {
+	return q.isDone, nil
+}
+
+func (q *fakeQueue) markDone(_ context.Context, id string) error {
+	q.doneIDs = append(q.doneIDs, id)
+	return nil
+}
+
+// fakeQuota 按顺序返回预设的申请结果，用完后总是允许发送
+type fakeQuota struct {
+	waits     []time.Duration
+	exhausted bool
+	calls     int
+}
+
+func (q *fakeQuota) acquire(context.Context, int) (time.Duration, bool) {
+	q.calls++
+	if q.exhausted {
+		return time.Hour, true
+	}
+	if len(q.waits) == 0 {
+		return 0, false
 	}
+	wait := q.waits[0]
+	q.waits = q.waits[1:]
+	return wait, false
 }
 
-func TestAsyncDispatcherWait(t *testing.T) {
+func TestAsyncDispatcherHandle(t *testing.T) {
 	type testCase struct {
-		name        string
-		dispatcher  *asyncDispatcher
-		beforeWait  func(t *testing.T)
-		afterAssert func(t *testing.T, d *asyncDispatcher, start time.Time)
+		name          string
+		attempts      int
+		isDone        bool
+		exhausted     bool
+		sendErr       error
+		retryErr      error
+		expectSend    bool
+		expectAcked   bool
+		expectDone    bool
+		expectRetried []time.Duration
+		expectDead    bool
+		expectAttempt int
 	}
 
 	testCases := []testCase{
 		{
-			name: "ResetOnNextDay",
-			dispatcher: &asyncDispatcher{
-				ch:              make(chan func() error, 1),
-				interval:        0,
-				dailyLimit:      10,
-				dailyCount:      5,
-				lastResetDate:   time.Now().AddDate(0, 0, -1),
-				lastRequestTime: time.Now(),
-			},
-			afterAssert: func(t *testing.T, d *asyncDispatcher, _ time.Time) {
-				assert.Equal(t, 1, d.dailyCount)
-				assert.True(t, sameDay(time.Now(), d.lastResetDate))
-				assert.False(t, d.lastRequestTime.IsZero())
-			},
+			name:        "SendSuccess",
+			expectSend:  true,
+			expectAcked: true,
+			expectDone:  true,
+		},
+		{
+			name:        "SkipDoneTask",
+			isDone:      true,
+			expectAcked: true,
+		},
+		{
+			name:          "DeferWhenQuotaExhausted",
+			exhausted:     true,
+			expectAcked:   true,
+			expectRetried: []time.Duration{time.Hour},
+		},
+		{
+			name:          "RetryWithBackoff",
+			attempts:      1,
+			sendErr:       errors.New("mock error"),
+			expectSend:    true,
+			expectAcked:   true,
+			expectRetried: []time.Duration{retryDelay(2)},
+			expectAttempt: 2,
 		},
 		{
-			name: "RespectInterval",
-			dispatcher: &asyncDispatcher{
-				ch:              make(chan func() error, 1),
-				interval:        20 * time.Millisecond,
-				dailyLimit:      10,
-				dailyCount:      0,
-				lastResetDate:   time.Now(),
-				lastRequestTime: time.Now(),
-			},
-			afterAssert: func(t *testing.T, d *asyncDispatcher, start time.Time) {
-				elapsed := time.Since(start)
-				assert.True(t, elapsed >= 15*time.Millisecond)
-				assert.Equal(t, 1, d.dailyCount)
-			},
+			name:          "KeepTaskWhenRetryFailed",
+			sendErr:       errors.New("mock error"),
+			retryErr:      errors.New("redis down"),
+			expectSend:    true,
+			expectRetried: []time.Duration{retryDelay(1)},
+			expectAttempt: 1,
 		},
 		{
-			name: "DailyLimitReached",
-			dispatcher: &asyncDispatcher{
-				ch:              make(chan func() error, 1),
-				interval:        0,
-				dailyLimit:      1,
-				dailyCount:      1,
-				lastResetDate:   time.Now(),
-				lastRequestTime: time.Now(),
-			},
-			beforeWait: func(t *testing.T) {
-				mockey.Mock(time.Sleep).To(func(d time.Duration) {
-					assert.GreaterOrEqual(t, d, time.Millisecond)
-				}).Build()
-			},
-			afterAssert: func(t *testing.T, d *asyncDispatcher, _ time.Time) {
-				assert.Equal(t, 1, d.dailyCount)
-				assert.True(t, sameDay(time.Now(), d.lastResetDate))
-				assert.False(t, d.lastRequestTime.IsZero())
-			},
+			name:          "MoveToDeadLetter",
+			attempts:      constants.UmengMaxAttempts - 1,
+			sendErr:       errors.New("mock error"),
+			expectSend:    true,
+			expectAcked:   true,
+			expectDead:    true,
+			expectAttempt: constants.UmengMaxAttempts,
 		},
 	}
 
 	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			defer mockey.UnPatchAll()
-			if tc.beforeWait != nil {
-				tc.beforeWait(t)
+		mockey.PatchConvey(tc.name, t, func() {
+			q := &fakeQueue{isDone: tc.isDone, retryError: tc.retryErr}
+			d := newAsyncDispatcher(q, &fakeQuota{exhausted: tc.exhausted})
+			sent := false
+			mockey.Mock((*Task).send).To(func(*Task) error {
+				sent = true
+				return tc.sendErr
+			}).Build()
+
+			task := &Task{ID: "task-1", Tags: []string{"tag"}, Attempts: tc.attempts}
+			d.handle(context.Background(), task)
+
+			assert.Equal(t, tc.expectSend, sent)
+			assert.Equal(t, tc.expectAcked, len(q.acked) == 1)
+			assert.Equal(t, tc.expectDone, len(q.doneIDs) == 1)
+			assert.Equal(t, tc.expectRetried, q.retried)
+			assert.Equal(t, tc.expectDead, len(q.deadTasks) == 1)
+			assert.Equal(t, tc.expectAttempt, task.Attempts)
+			if tc.sendErr != nil {
+				assert.Equal(t, tc.sendErr.Error(), task.LastError)
 			}
-			start := time.Now()
-			tc.dispatcher.wait()
-			tc.afterAssert(t, tc.dispatcher, start)
 		})
 	}
 }
 
 func TestAsyncDispatcherRun(t *testing.T) {
-	d := &asyncDispatcher{
-		ch:              make(chan func() error, 2),
-		interval:        0,
-		dailyLimit:      10,
-		dailyCount:      0,
-		lastResetDate:   time.Now(),
-		lastRequestTime: time.Now(),
-	}
+	q := newMemoryQueue(2)
+	d := newAsyncDispatcher(q, &fakeQuota{waits: []time.Duration{time.Millisecond}})
 
 	var mu sync.Mutex
-	called := make([]int, 0, 2)
+	called := make([]string, 0, 2)
 	var wg sync.WaitGroup
 	wg.Add(2)
 
-	go d.run()
-
-	d.ch <- func() error {
-		mu.Lock()
-		called = append(called, 1)
-		mu.Unlock()
-		wg.Done()
-		return nil
-	}
-	d.ch <- func() error {
-		mu.Lock()
-		called = append(called, 2)
-		mu.Unlock()
-		wg.Done()
-		return errors.New("mock error")
-	}
-	close(d.ch)
-
-	done := make(chan struct{})
-	go func() {
-		wg.Wait()
-		close(done)
-	}()
-
-	select {
-	case <-done:
-		mu.Lock()
-		defer mu.Unlock()
-		assert.Equal(t, []int{1, 2}, called)
-		assert.Equal(t, 2, d.dailyCount)
-	case <-time.After(time.Second):
-		t.Fatal("dispatcher run did not finish in time")
-	}
+	mockey.PatchConvey("run tasks in order", t, func() {
+		mockey.Mock((*Task).send).To(func(task *Task) error {
+			mu.Lock()
+			called = append(called, task.ID)
+			mu.Unlock()
+			wg.Done()
+			return nil
+		}).Build()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go d.run(ctx)
+
+		assert.NoError(t, q.push(ctx, &Task{ID: "1", Tags: []string{"tag"}}))
+		assert.NoError(t, q.push(ctx, &Task{ID: "2", Tags: []string{"tag"}}))
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, []string{"1", "2"}, called)
+		case <-time.After(time.Second):
+			t.Fatal("dispatcher run did not finish in time")
+		}
+	})
 }
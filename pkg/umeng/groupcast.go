@@ -30,6 +30,7 @@ import (
 	"github.com/west2-online/fzuhelper-server/pkg/constants"
 	"github.com/west2-online/fzuhelper-server/pkg/errno"
 	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/utils"
 )
 
 func getChannelProperties(title, content string) AndroidChannelProperties {
@@ -99,7 +100,46 @@ func PushByType(pushType, title, text string, keywords []string, ticker, tag, de
 	}
 }
 
+// PushByTags 与 PushByType 相同，但推送给命中 tags 中任一 tag 的设备，同一设备只会收到一次
+// 用于通知订阅等一条消息需要覆盖多个 tag 的场景，tags 数量由调用方控制在 UmengMaxOrTags 以内
+func PushByTags(pushType, title, text string, keywords []string, tags []string, description, deeplink string) {
+	filter := Filter{Where: Where{OrTags: tags}}
+	if err := sendAndroidGroupcastWithGoApp(pushType, title, text, "", description, deeplink, keywords, filter); err != nil {
+		logger.Errorf("umeng.PushByTags: %s failed to send Android groupcast: %v", pushType, err)
+	}
+	if err := sendIOSGroupcast(title, "", text, description, deeplink, filter); err != nil {
+		logger.Errorf("umeng.PushByTags: %s failed to send IOS groupcast: %v", pushType, err)
+	}
+	if err := sendHarmonyGroupcast(title, text, description, deeplink, filter); err != nil {
+		logger.Errorf("umeng.PushByTags: %s failed to send Harmony groupcast: %v", pushType, err)
+	}
+}
+
+// NoticeSubscriptionTag 返回通知订阅关键词对应的设备 tag，客户端订阅成功后需将设备注册到该 tag
+// 关键词可能包含中文，这里取 md5 避免 tag 字符集问题
+func NoticeSubscriptionTag(keyword string) string {
+	return constants.UmengNoticeSubscriptionTagPrefix + utils.MD5(keyword)[:16]
+}
+
+func tagFilter(tag string) Filter {
+	return Filter{
+		Where: Where{
+			And: []map[string]string{
+				{"tag": tag},
+			},
+		},
+	}
+}
+
 func SendAndroidGroupcastWithGoApp(pushType, title, text, ticker, tag, description, deeplink string, keywords []string) error {
+	return sendAndroidGroupcastWithGoApp(pushType, title, text, ticker, description, deeplink, keywords, tagFilter(tag))
+}
+
+func sendAndroidGroupcastWithGoApp(pushType, title, text, ticker, description, deeplink string, keywords []string, filter Filter) error {
+	return sendAndroidWithGoApp(pushType, title, text, ticker, description, deeplink, keywords, groupcastTarget(filter))
+}
+
+func sendAndroidWithGoApp(pushType, title, text, ticker, description, deeplink string, keywords []string, t target) error {
 	channelProperties := getChannelProperties(title, text)
 	xiaomiChannelID, xiaomiExtraProperties := getXiaomiNoticeProperties(
 		pushType,
@@ -112,16 +152,11 @@ func SendAndroidGroupcastWithGoApp(pushType, title, text, ticker, tag, descripti
 	}
 
 	message := AndroidGroupcastMessage{
-		AppKey:    config.Umeng.Android.AppKey,
-		Timestamp: fmt.Sprintf("%d", time.Now().Unix()),
-		Type:      "groupcast",
-		Filter: Filter{
-			Where: Where{
-				And: []map[string]string{
-					{"tag": tag},
-				},
-			},
-		},
+		AppKey:       config.Umeng.Android.AppKey,
+		Timestamp:    fmt.Sprintf("%d", time.Now().Unix()),
+		Type:         t.Type,
+		Filter:       t.Filter,
+		DeviceTokens: t.DeviceTokens,
 		Payload: AndroidPayload{
 			DisplayType: "notification",
 			Body: AndroidBody{
@@ -154,7 +189,7 @@ func SendAndroidGroupcastWithUrl(title, text, ticker, url, tag, description stri
 	message := AndroidGroupcastMessage{
 		AppKey:    config.Umeng.Android.AppKey,
 		Timestamp: fmt.Sprintf("%d", time.Now().Unix()),
-		Type:      "groupcast",
+		Type:      castTypeGroupcast,
 		Filter: Filter{
 			Where: Where{
 				And: []map[string]string{
@@ -189,17 +224,20 @@ func SendAndroidGroupcastWithUrl(title, text, ticker, url, tag, description stri
 
 // iOS广播函数
 func SendIOSGroupcast(title, subtitle, body, tag, description, deeplink string) error {
+	return sendIOSGroupcast(title, subtitle, body, description, deeplink, tagFilter(tag))
+}
+
+func sendIOSGroupcast(title, subtitle, body, description, deeplink string, filter Filter) error {
+	return sendIOS(title, subtitle, body, description, deeplink, groupcastTarget(filter))
+}
+
+func sendIOS(title, subtitle, body, description, deeplink string, t target) error {
 	message := IOSGroupcastMessage{
-		AppKey:    config.Umeng.IOS.AppKey,
-		Timestamp: fmt.Sprintf("%d", time.Now().Unix()),
-		Type:      "groupcast",
-		Filter: Filter{
-			Where: Where{
-				And: []map[string]string{
-					{"tag": tag},
-				},
-			},
-		},
+		AppKey:       config.Umeng.IOS.AppKey,
+		Timestamp:    fmt.Sprintf("%d", time.Now().Unix()),
+		Type:         t.Type,
+		Filter:       t.Filter,
+		DeviceTokens: t.DeviceTokens,
 		Payload: IOSPayload{
 			Aps: IOSAps{
 				Alert: IOSAlert{
@@ -223,17 +261,20 @@ func SendIOSGroupcast(title, subtitle, body, tag, description, deeplink string)
 
 // Harmony广播函数
 func SendHarmonyGroupcast(title, text, tag, description, deeplink string) error {
+	return sendHarmonyGroupcast(title, text, description, deeplink, tagFilter(tag))
+}
+
+func sendHarmonyGroupcast(title, text, description, deeplink string, filter Filter) error {
+	return sendHarmony(title, text, description, deeplink, groupcastTarget(filter))
+}
+
+func sendHarmony(title, text, description, deeplink string, t target) error {
 	message := HarmonyGroupcastMessage{
-		AppKey:    config.Umeng.Harmony.AppKey,
-		Timestamp: fmt.Sprintf("%d", time.Now().Unix()),
-		Type:      "groupcast",
-		Filter: Filter{
-			Where: Where{
-				And: []map[string]string{
-					{"tag": tag},
-				},
-			},
-		},
+		AppKey:       config.Umeng.Harmony.AppKey,
+		Timestamp:    fmt.Sprintf("%d", time.Now().Unix()),
+		Type:         t.Type,
+		Filter:       t.Filter,
+		DeviceTokens: t.DeviceTokens,
 		Payload: HarmonyPayload{
 			DisplayType: "notification",
 			Body: HarmonyBody{
@@ -256,7 +297,7 @@ func SendHarmonyGroupcast(title, text, tag, description, deeplink string) error
 	return sendGroupcast(config.Umeng.Harmony.AppMasterSecret, message)
 }
 
-// 通用广播发送逻辑
+// 通用发送逻辑，广播、单播与列播共用
 func sendGroupcast(appMasterSecret string, message interface{}) error {
 	postBody, err := json.Marshal(message)
 	if err != nil {
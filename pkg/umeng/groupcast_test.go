@@ -87,6 +87,73 @@ func TestPushByType(t *testing.T) {
 	}
 }
 
+func TestPushByTags(t *testing.T) {
+	var filters []Filter
+	mockey.PatchConvey("send the same or filter to android, ios and harmony", t, func() {
+		mockey.Mock(sendAndroidGroupcastWithGoApp).To(
+			func(pushType, title, text, ticker, description, deeplink string, keywords []string, filter Filter) error {
+				filters = append(filters, filter)
+				return assert.AnError
+			},
+		).Build()
+		mockey.Mock(sendIOSGroupcast).To(
+			func(title, subtitle, body, description, deeplink string, filter Filter) error {
+				filters = append(filters, filter)
+				return nil
+			},
+		).Build()
+		mockey.Mock(sendHarmonyGroupcast).To(
+			func(title, text, description, deeplink string, filter Filter) error {
+				filters = append(filters, filter)
+				return nil
+			},
+		).Build()
+
+		PushByTags(constants.UmengPushTypeTeaching, "title", "text", []string{"text"}, []string{"a", "b"}, "description", "deeplink")
+
+		assert.Len(t, filters, 3)
+		for _, f := range filters {
+			assert.Equal(t, []string{"a", "b"}, f.Where.OrTags)
+		}
+	})
+}
+
+func TestWhereMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		where  Where
+		expect string
+	}{
+		{
+			name:   "single tag",
+			where:  tagFilter("jwch-notice").Where,
+			expect: `{"and":[{"tag":"jwch-notice"}]}`,
+		},
+		{
+			name:   "or tags",
+			where:  Where{OrTags: []string{"a", "b"}},
+			expect: `{"and":[{"or":[{"tag":"a"},{"tag":"b"}]}]}`,
+		},
+		{
+			name:   "and with or tags",
+			where:  Where{And: []map[string]string{{"app_version": "1.0"}}, OrTags: []string{"a"}},
+			expect: `{"and":[{"app_version":"1.0"},{"or":[{"tag":"a"}]}]}`,
+		},
+		{
+			name:   "not tags",
+			where:  Where{And: []map[string]string{{"tag": "a"}}, NotTags: []string{"b"}},
+			expect: `{"and":[{"tag":"a"},{"not":{"tag":"b"}}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(Filter{Where: tt.where})
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"where":`+tt.expect+`}`, string(data))
+		})
+	}
+}
+
 func TestHarmonyGroupcastMessageJSON(t *testing.T) {
 	tests := []struct {
 		name                string
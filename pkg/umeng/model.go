@@ -16,6 +16,8 @@ limitations under the License.
 
 package umeng
 
+import "encoding/json"
+
 // 具体定义查看友盟官方文档：https://developer.umeng.com/docs/67966/detail/68343
 // UmengResponse 公共返回结构
 type UmengResponse struct {
@@ -28,12 +30,13 @@ type UmengResponse struct {
 	} `json:"data"`
 }
 
-// AndroidGroupcastMessage Android广播消息结构
+// AndroidGroupcastMessage Android广播消息结构，单播与列播复用该结构，仅目标字段不同
 type AndroidGroupcastMessage struct {
 	AppKey            string                   `json:"appkey"`
 	Timestamp         string                   `json:"timestamp"`
 	Type              string                   `json:"type"`
-	Filter            Filter                   `json:"filter"`
+	Filter            Filter                   `json:"filter,omitzero"`
+	DeviceTokens      string                   `json:"device_tokens,omitempty"`
 	Payload           AndroidPayload           `json:"payload"`
 	Policy            AndroidPolicy            `json:"policy"`
 	Description       string                   `json:"description"`
@@ -77,7 +80,8 @@ type HarmonyGroupcastMessage struct {
 	AppKey            string                   `json:"appkey"`
 	Timestamp         string                   `json:"timestamp"`
 	Type              string                   `json:"type"`
-	Filter            Filter                   `json:"filter"`
+	Filter            Filter                   `json:"filter,omitzero"`
+	DeviceTokens      string                   `json:"device_tokens,omitempty"`
 	Payload           HarmonyPayload           `json:"payload"`
 	Policy            HarmonyPolicy            `json:"policy"`
 	Description       string                   `json:"description"`
@@ -125,13 +129,14 @@ type LocalProperties struct {
 
 // IOSGroupcastMessage iOS广播消息结构
 type IOSGroupcastMessage struct {
-	AppKey      string     `json:"appkey"`
-	Timestamp   string     `json:"timestamp"`
-	Type        string     `json:"type"`
-	Filter      Filter     `json:"filter"`
-	Payload     IOSPayload `json:"payload"`
-	Policy      IOSPolicy  `json:"policy"`
-	Description string     `json:"description"`
+	AppKey       string     `json:"appkey"`
+	Timestamp    string     `json:"timestamp"`
+	Type         string     `json:"type"`
+	Filter       Filter     `json:"filter,omitzero"`
+	DeviceTokens string     `json:"device_tokens,omitempty"`
+	Payload      IOSPayload `json:"payload"`
+	Policy       IOSPolicy  `json:"policy"`
+	Description  string     `json:"description"`
 }
 
 type IOSPayload struct {
@@ -172,5 +177,25 @@ type Filter struct {
 
 // Where 结构体，表示 where 条件
 type Where struct {
-	And []map[string]string `json:"and"` // 多个条件
+	And     []map[string]string `json:"and"` // 多个条件
+	OrTags  []string            `json:"-"`   // 非空时在 and 中追加一个 or 条件，设备命中其中任一 tag 即可收到推送
+	NotTags []string            `json:"-"`   // 非空时在 and 中逐个追加 not 条件，命中其中任一 tag 的设备不会收到推送
+}
+
+func (w Where) MarshalJSON() ([]byte, error) {
+	and := make([]any, 0, len(w.And)+1+len(w.NotTags))
+	for _, cond := range w.And {
+		and = append(and, cond)
+	}
+	if len(w.OrTags) != 0 {
+		or := make([]map[string]string, len(w.OrTags))
+		for i, tag := range w.OrTags {
+			or[i] = map[string]string{"tag": tag}
+		}
+		and = append(and, map[string]any{"or": or})
+	}
+	for _, tag := range w.NotTags {
+		and = append(and, map[string]any{"not": map[string]string{"tag": tag}})
+	}
+	return json.Marshal(map[string]any{"and": and})
 }
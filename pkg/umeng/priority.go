@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import "github.com/west2-online/fzuhelper-server/pkg/constants"
+
+const percentBase = 100
+
+// priorityNames 各优先级的名称，用于拼接队列的 key
+var priorityNames = [constants.UmengPriorityCount]string{"exam", "score", "marketing"}
+
+// priorityOf 按推送类型确定优先级，未知的推送类型按最低优先级处理
+func priorityOf(pushType string) int {
+	switch pushType {
+	case constants.UmengPushTypeExam:
+		return constants.UmengPriorityExam
+	case constants.UmengPushTypeScore:
+		return constants.UmengPriorityScore
+	default:
+		return constants.UmengPriorityMarketing
+	}
+}
+
+// priorityLimit 返回该优先级当天最多可以使用的配额，低优先级用满后剩余的配额保留给高优先级
+func priorityLimit(dailyLimit, priority int) int {
+	switch priority {
+	case constants.UmengPriorityExam:
+		return dailyLimit
+	case constants.UmengPriorityScore:
+		return dailyLimit * constants.UmengScoreQuotaPercent / percentBase
+	default:
+		return dailyLimit * constants.UmengMarketingQuotaPercent / percentBase
+	}
+}
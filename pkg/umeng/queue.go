@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+// ErrQueueFull 推送队列积压已达上限，本次任务未入队
+var ErrQueueFull = errors.New("umeng: push queue is full")
+
+// taskQueue 推送任务的存储
+// 配置 Redis 时使用 Redis Stream 持久化，至少投递一次，由 done/markDone 保证幂等；否则退化为进程内队列，重启后未发送的任务会丢失
+type taskQueue interface {
+	// push 入队，积压达到上限时返回 ErrQueueFull
+	push(ctx context.Context, task *Task) error
+	// pop 阻塞读取下一个任务，优先返回高优先级的任务，一段时间内没有任务时返回 nil
+	pop(ctx context.Context) (*Task, error)
+	// ack 确认任务处理结束（发送成功、已安排重试或已移入死信），确认前任务不会从队列中移除
+	ack(ctx context.Context, task *Task) error
+	// retry 在 delay 后重新投递任务
+	retry(ctx context.Context, task *Task, delay time.Duration) error
+	// dead 将重试耗尽的任务移入死信
+	dead(ctx context.Context, task *Task) error
+	// done 判断任务是否已发送成功
+	done(ctx context.Context, id string) (bool, error)
+	// markDone 记录任务已发送成功
+	markDone(ctx context.Context, id string) error
+	// size 返回等待发送的任务数
+	size(ctx context.Context) int64
+}
+
+// memoryQueue 进程内队列，仅在未配置 Redis 时使用，每个优先级一个通道，出队时优先取高优先级的任务
+// 任务只会被当前进程消费一次，因此不需要幂等标记；死信只记录日志
+type memoryQueue struct {
+	chs [constants.UmengPriorityCount]chan *Task
+}
+
+func newMemoryQueue(size int) *memoryQueue {
+	q := &memoryQueue{}
+	for i := range q.chs {
+		q.chs[i] = make(chan *Task, size)
+	}
+	return q
+}
+
+func (q *memoryQueue) push(_ context.Context, task *Task) error {
+	select {
+	case q.chs[task.priority()] <- task:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+func (q *memoryQueue) pop(ctx context.Context) (*Task, error) {
+	for _, ch := range q.chs {
+		select {
+		case task := <-ch:
+			return task, nil
+		default:
+		}
+	}
+	select {
+	case task := <-q.chs[constants.UmengPriorityExam]:
+		return task, nil
+	case task := <-q.chs[constants.UmengPriorityScore]:
+		return task, nil
+	case task := <-q.chs[constants.UmengPriorityMarketing]:
+		return task, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (q *memoryQueue) ack(context.Context, *Task) error {
+	return nil
+}
+
+func (q *memoryQueue) retry(_ context.Context, task *Task, delay time.Duration) error {
+	time.AfterFunc(delay, func() {
+		if err := q.push(context.Background(), task); err != nil {
+			logger.Errorf("umeng.memoryQueue: requeue task %s failed: %v", task.ID, err)
+		}
+	})
+	return nil
+}
+
+func (q *memoryQueue) dead(_ context.Context, task *Task) error {
+	logger.Errorf("umeng.memoryQueue: task %s dropped after %d attempts, last error: %s", task.ID, task.Attempts, task.LastError)
+	return nil
+}
+
+func (q *memoryQueue) done(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+func (q *memoryQueue) markDone(context.Context, string) error {
+	return nil
+}
+
+func (q *memoryQueue) size(context.Context) int64 {
+	var n int
+	for _, ch := range q.chs {
+		n += len(ch)
+	}
+	return int64(n)
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+)
+
+const (
+	taskField = "task"
+	// claimInterval 两次接管超时任务之间的最小间隔
+	claimInterval = time.Minute
+	// promoteBatchSize 每次从重试集合中移回队列的最大任务数
+	promoteBatchSize = 100
+	// noBlock 读取队列时不阻塞
+	noBlock = -1
+)
+
+// promoteScript 将到期的重试任务从有序集合原子地移回队列，多个实例同时执行时同一任务只会被移动一次
+var promoteScript = redis.NewScript(`
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, item in ipairs(items) do
+  redis.call('ZREM', KEYS[1], item)
+  redis.call('XADD', KEYS[2], '*', ARGV[3], item)
+end
+return #items
+`)
+
+// pushScript 在队列未满时写入任务，长度检查与写入在同一脚本中执行，多个实例同时入队时也不会超过上限
+var pushScript = redis.NewScript(`
+if redis.call('XLEN', KEYS[1]) >= tonumber(ARGV[1]) then
+  return 0
+end
+redis.call('XADD', KEYS[1], '*', ARGV[2], ARGV[3])
+return 1
+`)
+
+// redisQueue 基于 Redis Stream 消费者组的持久化队列，所有实例共享，每个优先级一个 Stream
+// 任务在确认前保留在消费者组的待确认列表中，实例退出后由其他实例在 UmengClaimIdleTime 后接管
+type redisQueue struct {
+	client    *redis.Client
+	consumer  string
+	lastClaim time.Time
+}
+
+func newRedisQueue(ctx context.Context, client *redis.Client) (*redisQueue, error) {
+	for priority := range constants.UmengPriorityCount {
+		err := client.XGroupCreateMkStream(ctx, streamKey(priority), constants.UmengConsumerGroup, "0").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return nil, errno.Errorf(errno.InternalRedisErrorCode, "umeng.newRedisQueue: create consumer group failed: %v", err)
+		}
+	}
+	hostname, _ := os.Hostname()
+	return &redisQueue{
+		client:   client,
+		consumer: fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+	}, nil
+}
+
+func (q *redisQueue) push(ctx context.Context, task *Task) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "umeng.redisQueue.push: marshal task failed: %v", err)
+	}
+	stream := streamKey(task.priority())
+	added, err := pushScript.Run(ctx, q.client, []string{stream}, constants.UmengQueueMaxLength, taskField, data).Int()
+	if err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "umeng.redisQueue.push: add to %s failed: %v", stream, err)
+	}
+	if added == 0 {
+		return ErrQueueFull
+	}
+	return nil
+}
+
+func (q *redisQueue) add(ctx context.Context, stream string, task *Task, maxLen int64) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "umeng.redisQueue: marshal task failed: %v", err)
+	}
+	err = q.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: stream,
+		MaxLen: maxLen,
+		Approx: maxLen > 0,
+		Values: map[string]any{taskField: data},
+	}).Err()
+	if err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "umeng.redisQueue: add to %s failed: %v", stream, err)
+	}
+	return nil
+}
+
+// pop 依次尝试：移回到期的重试任务、接管超时未确认的任务、按优先级非阻塞读取，最后阻塞等待任一队列的新任务
+func (q *redisQueue) pop(ctx context.Context) (*Task, error) {
+	if err := q.promote(ctx); err != nil {
+		logger.Errorf("umeng.redisQueue.pop: %v", err)
+	}
+
+	if time.Since(q.lastClaim) >= claimInterval {
+		q.lastClaim = time.Now()
+		if task, ok := q.claim(ctx); ok {
+			return task, nil
+		}
+	}
+
+	streams := make([]string, 0, constants.UmengPriorityCount)
+	for priority := range constants.UmengPriorityCount {
+		task, ok, err := q.read(ctx, []string{streamKey(priority), ">"}, noBlock)
+		if err != nil || ok {
+			return task, err
+		}
+		streams = append(streams, streamKey(priority))
+	}
+	for range constants.UmengPriorityCount {
+		streams = append(streams, ">")
+	}
+	task, _, err := q.read(ctx, streams, constants.UmengQueueBlockTime)
+	return task, err
+}
+
+// claim 按优先级接管其他消费者持有超过 UmengClaimIdleTime 仍未确认的任务
+func (q *redisQueue) claim(ctx context.Context) (*Task, bool) {
+	for priority := range constants.UmengPriorityCount {
+		messages, _, err := q.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   streamKey(priority),
+			Group:    constants.UmengConsumerGroup,
+			Consumer: q.consumer,
+			MinIdle:  constants.UmengClaimIdleTime,
+			Start:    "0-0",
+			Count:    1,
+		}).Result()
+		if err != nil {
+			logger.Errorf("umeng.redisQueue.claim: claim idle tasks failed: %v", err)
+			return nil, false
+		}
+		if len(messages) != 0 {
+			return q.decode(ctx, streamKey(priority), messages[0]), true
+		}
+	}
+	return nil, false
+}
+
+func (q *redisQueue) read(ctx context.Context, streams []string, block time.Duration) (*Task, bool, error) {
+	result, err := q.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    constants.UmengConsumerGroup,
+		Consumer: q.consumer,
+		Streams:  streams,
+		Count:    1,
+		Block:    block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errno.Errorf(errno.InternalRedisErrorCode, "umeng.redisQueue.read: read group failed: %v", err)
+	}
+	for _, stream := range result {
+		if len(stream.Messages) != 0 {
+			return q.decode(ctx, stream.Stream, stream.Messages[0]), true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// decode 解析队列中的任务，无法解析的消息直接确认丢弃并返回 nil，避免被反复接管
+func (q *redisQueue) decode(ctx context.Context, stream string, message redis.XMessage) *Task {
+	raw, _ := message.Values[taskField].(string)
+	task := new(Task)
+	if err := json.Unmarshal([]byte(raw), task); err != nil {
+		logger.Errorf("umeng.redisQueue: drop malformed task %s: %v", message.ID, err)
+		if err := q.ackMessage(ctx, stream, message.ID); err != nil {
+			logger.Errorf("umeng.redisQueue: %v", err)
+		}
+		return nil
+	}
+	task.ref = message.ID
+	return task
+}
+
+// promote 将各优先级到期的重试任务移回对应的队列
+func (q *redisQueue) promote(ctx context.Context) error {
+	now := time.Now().UnixMilli()
+	for priority := range constants.UmengPriorityCount {
+		err := promoteScript.Run(ctx, q.client,
+			[]string{retryKey(priority), streamKey(priority)},
+			now, promoteBatchSize, taskField).Err()
+		if err != nil {
+			return errno.Errorf(errno.InternalRedisErrorCode, "umeng.redisQueue.promote: run script failed: %v", err)
+		}
+	}
+	return nil
+}
+
+func (q *redisQueue) ack(ctx context.Context, task *Task) error {
+	return q.ackMessage(ctx, streamKey(task.priority()), task.ref)
+}
+
+func (q *redisQueue) ackMessage(ctx context.Context, stream, id string) error {
+	pipe := q.client.TxPipeline()
+	pipe.XAck(ctx, stream, constants.UmengConsumerGroup, id)
+	pipe.XDel(ctx, stream, id)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "umeng.redisQueue.ack: ack message %s failed: %v", id, err)
+	}
+	return nil
+}
+
+func (q *redisQueue) retry(ctx context.Context, task *Task, delay time.Duration) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return errno.Errorf(errno.InternalServiceErrorCode, "umeng.redisQueue.retry: marshal task failed: %v", err)
+	}
+	err = q.client.ZAdd(ctx, retryKey(task.priority()), redis.Z{
+		Score:  float64(time.Now().Add(delay).UnixMilli()),
+		Member: data,
+	}).Err()
+	if err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "umeng.redisQueue.retry: schedule task %s failed: %v", task.ID, err)
+	}
+	return nil
+}
+
+func (q *redisQueue) dead(ctx context.Context, task *Task) error {
+	return q.add(ctx, constants.UmengTaskDeadKey, task, constants.UmengDeadLetterMaxLength)
+}
+
+func (q *redisQueue) done(ctx context.Context, id string) (bool, error) {
+	n, err := q.client.Exists(ctx, doneKey(id)).Result()
+	if err != nil {
+		return false, errno.Errorf(errno.InternalRedisErrorCode, "umeng.redisQueue.done: %v", err)
+	}
+	return n == 1, nil
+}
+
+func (q *redisQueue) markDone(ctx context.Context, id string) error {
+	if err := q.client.Set(ctx, doneKey(id), 1, constants.UmengTaskDoneExpire).Err(); err != nil {
+		return errno.Errorf(errno.InternalRedisErrorCode, "umeng.redisQueue.markDone: %v", err)
+	}
+	return nil
+}
+
+func (q *redisQueue) size(ctx context.Context) int64 {
+	var n int64
+	for priority := range constants.UmengPriorityCount {
+		n += q.client.XLen(ctx, streamKey(priority)).Val()
+	}
+	return n
+}
+
+func streamKey(priority int) string {
+	return fmt.Sprintf("%s:%s", constants.UmengTaskStreamKey, priorityNames[priority])
+}
+
+func retryKey(priority int) string {
+	return fmt.Sprintf("%s:%s", constants.UmengTaskRetryKey, priorityNames[priority])
+}
+
+func doneKey(id string) string {
+	return fmt.Sprintf("%s:%s", constants.UmengTaskDoneKeyPrefix, id)
+}
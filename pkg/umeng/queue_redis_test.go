@@ -0,0 +1,231 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/bytedance/mockey"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+// newTestRedisQueue 基于 miniredis 创建队列，consumer 区分同一消费者组中的不同实例
+func newTestRedisQueue(t *testing.T, mr *miniredis.Miniredis, consumer string) *redisQueue {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	q, err := newRedisQueue(context.Background(), client)
+	assert.NoError(t, err)
+	q.consumer = consumer
+	return q
+}
+
+func pendingCount(t *testing.T, q *redisQueue, priority int) int64 {
+	t.Helper()
+	pending, err := q.client.XPending(context.Background(), streamKey(priority), constants.UmengConsumerGroup).Result()
+	assert.NoError(t, err)
+	return pending.Count
+}
+
+func TestRedisQueuePushAndAck(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	q := newTestRedisQueue(t, mr, "a")
+
+	assert.NoError(t, q.push(ctx, &Task{ID: "marketing", Tags: []string{"tag"}}))
+	assert.NoError(t, q.push(ctx, &Task{ID: "exam", PushType: constants.UmengPushTypeExam, Tags: []string{"tag"}}))
+	assert.Equal(t, int64(2), q.size(ctx))
+
+	// 高优先级的任务先出队
+	task, err := q.pop(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, task)
+	assert.Equal(t, "exam", task.ID)
+	assert.NotEmpty(t, task.ref)
+	assert.Equal(t, int64(1), pendingCount(t, q, constants.UmengPriorityExam))
+
+	// 确认后从待确认列表和队列中删除
+	assert.NoError(t, q.ack(ctx, task))
+	assert.Equal(t, int64(0), pendingCount(t, q, constants.UmengPriorityExam))
+	assert.Equal(t, int64(0), q.client.XLen(ctx, streamKey(constants.UmengPriorityExam)).Val())
+	assert.Equal(t, int64(1), q.size(ctx))
+}
+
+func TestRedisQueuePushFull(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	q := newTestRedisQueue(t, mr, "a")
+
+	stream := streamKey(constants.UmengPriorityMarketing)
+	pipe := q.client.Pipeline()
+	for range constants.UmengQueueMaxLength {
+		pipe.XAdd(ctx, &redis.XAddArgs{Stream: stream, Values: map[string]any{taskField: "{}"}})
+	}
+	_, err := pipe.Exec(ctx)
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, q.push(ctx, &Task{ID: "overflow", Tags: []string{"tag"}}), ErrQueueFull)
+	assert.Equal(t, int64(constants.UmengQueueMaxLength), q.client.XLen(ctx, stream).Val())
+	// 其他优先级的队列不受影响
+	assert.NoError(t, q.push(ctx, &Task{ID: "exam", PushType: constants.UmengPushTypeExam, Tags: []string{"tag"}}))
+}
+
+func TestRedisQueueClaimAfterConsumerDied(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	now := time.Now()
+	mr.SetTime(now)
+	dead := newTestRedisQueue(t, mr, "dead")
+	alive := newTestRedisQueue(t, mr, "alive")
+
+	assert.NoError(t, dead.push(ctx, &Task{ID: "task-1", Tags: []string{"tag"}}))
+	task, err := dead.pop(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, task)
+
+	// 持有时间未超过 UmengClaimIdleTime 时不会被接管
+	_, ok := alive.claim(ctx)
+	assert.False(t, ok)
+
+	mr.SetTime(now.Add(constants.UmengClaimIdleTime + time.Second))
+	claimed, err := alive.pop(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, claimed)
+	assert.Equal(t, "task-1", claimed.ID)
+	assert.Equal(t, task.ref, claimed.ref)
+
+	pending, err := alive.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: streamKey(constants.UmengPriorityMarketing),
+		Group:  constants.UmengConsumerGroup,
+		Start:  "-",
+		End:    "+",
+		Count:  1,
+	}).Result()
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, "alive", pending[0].Consumer)
+}
+
+func TestRedisQueueRetryPromotion(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	q := newTestRedisQueue(t, mr, "a")
+
+	task := &Task{ID: "task-1", PushType: constants.UmengPushTypeScore, Tags: []string{"tag"}, Attempts: 2}
+	assert.NoError(t, q.retry(ctx, task, time.Hour))
+	retry := retryKey(constants.UmengPriorityScore)
+	stream := streamKey(constants.UmengPriorityScore)
+
+	// 未到重试时间时留在重试集合中
+	assert.NoError(t, q.promote(ctx))
+	assert.Equal(t, int64(1), q.client.ZCard(ctx, retry).Val())
+	assert.Equal(t, int64(0), q.client.XLen(ctx, stream).Val())
+
+	// 到期后移回对应优先级的队列，重复执行不会重复投递
+	members := q.client.ZRange(ctx, retry, 0, -1).Val()
+	assert.Len(t, members, 1)
+	assert.NoError(t, q.client.ZAdd(ctx, retry, redis.Z{Score: 0, Member: members[0]}).Err())
+	assert.NoError(t, q.promote(ctx))
+	assert.NoError(t, q.promote(ctx))
+	assert.Equal(t, int64(0), q.client.ZCard(ctx, retry).Val())
+	assert.Equal(t, int64(1), q.client.XLen(ctx, stream).Val())
+
+	popped, err := q.pop(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, popped)
+	assert.Equal(t, "task-1", popped.ID)
+	assert.Equal(t, 2, popped.Attempts)
+}
+
+func TestRedisQueueDispatcherHandle(t *testing.T) {
+	type testCase struct {
+		name         string
+		attempts     int
+		markDone     bool
+		sendErr      error
+		expectSend   bool
+		expectDead   int64
+		expectRetry  int64
+		expectDoneID bool
+	}
+
+	testCases := []testCase{
+		{
+			name:         "SendSuccess",
+			expectSend:   true,
+			expectDoneID: true,
+		},
+		{
+			name:         "SkipDoneTask",
+			markDone:     true,
+			expectDoneID: true,
+		},
+		{
+			name:        "RetryOnFailure",
+			sendErr:     errors.New("mock error"),
+			expectSend:  true,
+			expectRetry: 1,
+		},
+		{
+			name:       "MoveToDeadLetter",
+			attempts:   constants.UmengMaxAttempts - 1,
+			sendErr:    errors.New("mock error"),
+			expectSend: true,
+			expectDead: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			ctx := context.Background()
+			mr := miniredis.RunT(t)
+			q := newTestRedisQueue(t, mr, "a")
+			d := newAsyncDispatcher(q, &fakeQuota{})
+			sent := false
+			mockey.Mock((*Task).send).To(func(*Task) error {
+				sent = true
+				return tc.sendErr
+			}).Build()
+
+			if tc.markDone {
+				assert.NoError(t, q.markDone(ctx, "task-1"))
+			}
+			assert.NoError(t, q.push(ctx, &Task{ID: "task-1", Tags: []string{"tag"}, Attempts: tc.attempts}))
+			task, err := q.pop(ctx)
+			assert.NoError(t, err)
+			assert.NotNil(t, task)
+			d.handle(ctx, task)
+
+			assert.Equal(t, tc.expectSend, sent)
+			// 无论发送结果如何，任务都已确认并从队列中删除
+			assert.Equal(t, int64(0), q.size(ctx))
+			assert.Equal(t, int64(0), pendingCount(t, q, constants.UmengPriorityMarketing))
+			assert.Equal(t, tc.expectDead, q.client.XLen(ctx, constants.UmengTaskDeadKey).Val())
+			assert.Equal(t, tc.expectRetry, q.client.ZCard(ctx, retryKey(constants.UmengPriorityMarketing)).Val())
+			done, err := q.done(ctx, "task-1")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectDoneID, done)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"context"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+)
+
+// quota 推送配额，控制相邻两次发送的最小间隔与每日发送次数
+type quota interface {
+	// acquire 为一次发送申请配额，成功时计入当天的发送次数并返回 0；
+	// 间隔未到时返回需要等待的时间；该优先级当天的配额已用完时 exhausted 为 true，wait 为距离次日的时间
+	acquire(ctx context.Context, priority int) (wait time.Duration, exhausted bool)
+}
+
+// localQuota 进程内配额，仅在未配置 Redis 或 Redis 异常时使用，多个实例之间互不感知
+type localQuota struct {
+	// interval 为相邻两次发送的最小间隔。
+	interval time.Duration
+	// dailyLimit 为每日最大允许发送次数。
+	dailyLimit int
+	// dailyCount 为当天已发送次数。
+	dailyCount int
+	// lastResetDate 记录上次重置日期，用于跨日清零计数。
+	lastResetDate time.Time
+	// lastRequestTime 记录上一次实际发送的时间，用于间隔限流。
+	lastRequestTime time.Time
+}
+
+func newLocalQuota(interval time.Duration, dailyLimit int) *localQuota {
+	return &localQuota{
+		interval:        interval,
+		dailyLimit:      dailyLimit,
+		lastResetDate:   time.Now(),
+		lastRequestTime: time.Now().Add(-interval),
+	}
+}
+
+// acquire 逻辑顺序：
+// 1) 跨日判断：新的一天重置 dailyCount。
+// 2) 每日配额：该优先级可用的配额用完时返回 exhausted。
+// 3) 间隔限制：确保相邻发送间隔不小于 interval。
+// 该方法只在后台消费协程中调用，因此不需要加锁。
+func (q *localQuota) acquire(_ context.Context, priority int) (time.Duration, bool) {
+	now := time.Now()
+	if !sameDay(now, q.lastResetDate) {
+		q.dailyCount = 0
+		q.lastResetDate = now
+	}
+
+	if q.dailyCount >= priorityLimit(q.dailyLimit, priority) {
+		return untilNextDay(now), true
+	}
+
+	if elapsed := now.Sub(q.lastRequestTime); elapsed < q.interval {
+		return q.interval - elapsed, false
+	}
+
+	q.lastRequestTime = now
+	q.dailyCount++
+	metrics.UmengDailyQuotaUsed.Set(float64(q.dailyCount))
+	return 0, false
+}
+
+// untilNextDay 返回距离次日零点（按本地时区）的时间
+func untilNextDay(now time.Time) time.Duration {
+	nextDay := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+	return nextDay.Sub(now)
+}
+
+// sameDay 判断两个时间是否在同一天（按本地时区）。
+// 用于每日配额的跨日判断。
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+	"github.com/west2-online/fzuhelper-server/pkg/logger"
+	"github.com/west2-online/fzuhelper-server/pkg/metrics"
+)
+
+// quotaScript 先检查当天已发送次数是否达到该优先级的上限，再抢占发送间隔租约，两者都满足时计数加一
+// 返回 {结果, 数值}：结果为 1 表示可以发送（数值为当天已发送次数），0 表示间隔未到（数值为租约剩余毫秒数），-1 表示配额已用完
+var quotaScript = redis.NewScript(`
+local count = tonumber(redis.call('GET', KEYS[1]) or '0')
+if count >= tonumber(ARGV[1]) then
+  return {-1, count}
+end
+local interval = tonumber(ARGV[2])
+if interval > 0 and not redis.call('SET', KEYS[2], 1, 'PX', interval, 'NX') then
+  return {0, redis.call('PTTL', KEYS[2])}
+end
+count = redis.call('INCR', KEYS[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return {1, count}
+`)
+
+const (
+	quotaAllowed   = 1
+	quotaExhausted = -1
+
+	quotaResultLen = 2
+	// quotaCountExpire 计数 key 的过期时间，覆盖当天即可，多留一天避免跨日边界提前过期
+	quotaCountExpire = 2 * constants.ONE_DAY
+)
+
+// redisQuota 所有实例共享的配额，计数与间隔租约保存在 Redis 中，水平扩容后总发送量仍不超过友盟的每日上限
+// Redis 异常时退化为进程内配额，避免推送完全中断
+type redisQuota struct {
+	client     *redis.Client
+	interval   time.Duration
+	dailyLimit int
+	fallback   *localQuota
+}
+
+func newRedisQuota(client *redis.Client, interval time.Duration, dailyLimit int) *redisQuota {
+	return &redisQuota{
+		client:     client,
+		interval:   interval,
+		dailyLimit: dailyLimit,
+		fallback:   newLocalQuota(interval, dailyLimit),
+	}
+}
+
+func (q *redisQuota) acquire(ctx context.Context, priority int) (time.Duration, bool) {
+	now := time.Now().In(constants.ChinaTZ)
+	result, value, err := q.run(ctx, now, priority)
+	if err != nil {
+		logger.Errorf("umeng.redisQuota: %v, fallback to local quota", err)
+		return q.fallback.acquire(ctx, priority)
+	}
+	switch result {
+	case quotaAllowed:
+		metrics.UmengDailyQuotaUsed.Set(float64(value))
+		return 0, false
+	case quotaExhausted:
+		return untilNextDay(now), true
+	default:
+		// 租约刚好过期时 PTTL 可能返回负数，至少等待 1 毫秒后重新申请
+		return max(time.Duration(value)*time.Millisecond, time.Millisecond), false
+	}
+}
+
+func (q *redisQuota) run(ctx context.Context, now time.Time, priority int) (int64, int64, error) {
+	res, err := quotaScript.Run(ctx, q.client,
+		[]string{quotaCountKey(now), constants.UmengQuotaLeaseKey},
+		priorityLimit(q.dailyLimit, priority), q.interval.Milliseconds(), quotaCountExpire.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, errno.Errorf(errno.InternalRedisErrorCode, "run script failed: %v", err)
+	}
+	if len(res) != quotaResultLen {
+		return 0, 0, errno.Errorf(errno.InternalRedisErrorCode, "unexpected script result %v", res)
+	}
+	return res[0], res[1], nil
+}
+
+func quotaCountKey(now time.Time) string {
+	return fmt.Sprintf("%s:%s", constants.UmengQuotaCountKeyPrefix, now.Format("20060102"))
+}
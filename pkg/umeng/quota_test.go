@@ -0,0 +1,242 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+func TestPriorityOf(t *testing.T) {
+	assert.Equal(t, constants.UmengPriorityExam, priorityOf(constants.UmengPushTypeExam))
+	assert.Equal(t, constants.UmengPriorityScore, priorityOf(constants.UmengPushTypeScore))
+	assert.Equal(t, constants.UmengPriorityMarketing, priorityOf("unknown"))
+}
+
+func TestPriorityLimit(t *testing.T) {
+	assert.Equal(t, 100, priorityLimit(100, constants.UmengPriorityExam))
+	assert.Equal(t, constants.UmengScoreQuotaPercent, priorityLimit(100, constants.UmengPriorityScore))
+	assert.Equal(t, constants.UmengMarketingQuotaPercent, priorityLimit(100, constants.UmengPriorityMarketing))
+}
+
+func TestSameDay(t *testing.T) {
+	type testCase struct {
+		name   string
+		a      time.Time
+		b      time.Time
+		expect bool
+	}
+
+	now := time.Now()
+	testCases := []testCase{
+		{
+			name:   "SameDay",
+			a:      time.Date(now.Year(), now.Month(), now.Day(), 1, 0, 0, 0, now.Location()),
+			b:      time.Date(now.Year(), now.Month(), now.Day(), 3, 0, 0, 0, now.Location()),
+			expect: true,
+		},
+		{
+			name:   "DifferentDay",
+			a:      now,
+			b:      now.Add(24 * time.Hour),
+			expect: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, sameDay(tc.a, tc.b))
+		})
+	}
+}
+
+func TestLocalQuotaAcquire(t *testing.T) {
+	type testCase struct {
+		name            string
+		quota           *localQuota
+		priority        int
+		expectWait      bool
+		expectExhausted bool
+		expectCount     int
+	}
+
+	testCases := []testCase{
+		{
+			name: "ResetOnNextDay",
+			quota: &localQuota{
+				dailyLimit:    10,
+				dailyCount:    10,
+				lastResetDate: time.Now().AddDate(0, 0, -1),
+			},
+			priority:    constants.UmengPriorityMarketing,
+			expectCount: 1,
+		},
+		{
+			name: "RespectInterval",
+			quota: &localQuota{
+				interval:        time.Hour,
+				dailyLimit:      10,
+				lastResetDate:   time.Now(),
+				lastRequestTime: time.Now(),
+			},
+			priority:   constants.UmengPriorityExam,
+			expectWait: true,
+		},
+		{
+			name: "MarketingExhaustedFirst",
+			quota: &localQuota{
+				dailyLimit:    10,
+				dailyCount:    7,
+				lastResetDate: time.Now(),
+			},
+			priority:        constants.UmengPriorityMarketing,
+			expectWait:      true,
+			expectExhausted: true,
+			expectCount:     7,
+		},
+		{
+			name: "ExamUsesReservedQuota",
+			quota: &localQuota{
+				dailyLimit:    10,
+				dailyCount:    9,
+				lastResetDate: time.Now(),
+			},
+			priority:    constants.UmengPriorityExam,
+			expectCount: 10,
+		},
+		{
+			name: "DailyLimitReached",
+			quota: &localQuota{
+				dailyLimit:    10,
+				dailyCount:    10,
+				lastResetDate: time.Now(),
+			},
+			priority:        constants.UmengPriorityExam,
+			expectWait:      true,
+			expectExhausted: true,
+			expectCount:     10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wait, exhausted := tc.quota.acquire(context.Background(), tc.priority)
+			assert.Equal(t, tc.expectWait, wait > 0)
+			assert.Equal(t, tc.expectExhausted, exhausted)
+			assert.Equal(t, tc.expectCount, tc.quota.dailyCount)
+		})
+	}
+}
+
+func TestRedisQuotaAcquire(t *testing.T) {
+	type testCase struct {
+		name            string
+		result          int64
+		value           int64
+		runErr          error
+		expectWait      time.Duration
+		expectExhausted bool
+		expectFallback  bool
+	}
+
+	testCases := []testCase{
+		{
+			name:   "Allowed",
+			result: quotaAllowed,
+			value:  1,
+		},
+		{
+			name:       "WaitForLease",
+			result:     0,
+			value:      1500,
+			expectWait: 1500 * time.Millisecond,
+		},
+		{
+			name:       "LeaseJustExpired",
+			result:     0,
+			value:      -2,
+			expectWait: time.Millisecond,
+		},
+		{
+			name:            "Exhausted",
+			result:          quotaExhausted,
+			expectExhausted: true,
+		},
+		{
+			name:           "FallbackOnRedisError",
+			runErr:         errors.New("redis down"),
+			expectFallback: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			var priorities []int
+			mockey.Mock((*redisQuota).run).To(func(_ *redisQuota, _ context.Context, _ time.Time, priority int) (int64, int64, error) {
+				priorities = append(priorities, priority)
+				return tc.result, tc.value, tc.runErr
+			}).Build()
+
+			q := newRedisQuota(nil, 0, 10)
+			wait, exhausted := q.acquire(context.Background(), constants.UmengPriorityScore)
+
+			assert.Equal(t, []int{constants.UmengPriorityScore}, priorities)
+			assert.Equal(t, tc.expectExhausted, exhausted)
+			if tc.expectExhausted {
+				assert.Greater(t, wait, time.Duration(0))
+			} else {
+				assert.Equal(t, tc.expectWait, wait)
+			}
+			if tc.expectFallback {
+				assert.Equal(t, 1, q.fallback.dailyCount)
+			} else {
+				assert.Equal(t, 0, q.fallback.dailyCount)
+			}
+		})
+	}
+}
+
+func TestQuotaCountKey(t *testing.T) {
+	now := time.Date(2024, 9, 1, 8, 0, 0, 0, constants.ChinaTZ)
+	assert.Equal(t, constants.UmengQuotaCountKeyPrefix+":20240901", quotaCountKey(now))
+}
+
+func TestMemoryQueuePopByPriority(t *testing.T) {
+	q := newMemoryQueue(1)
+	ctx := context.Background()
+	assert.NoError(t, q.push(ctx, &Task{ID: "marketing"}))
+	assert.NoError(t, q.push(ctx, &Task{ID: "score", PushType: constants.UmengPushTypeScore}))
+	assert.NoError(t, q.push(ctx, &Task{ID: "exam", PushType: constants.UmengPushTypeExam}))
+	// 每个优先级的队列相互独立，一个优先级积压不影响其他优先级入队
+	assert.ErrorIs(t, q.push(ctx, &Task{ID: "marketing-2"}), ErrQueueFull)
+	assert.Equal(t, int64(3), q.size(ctx))
+
+	var order []string
+	for range 3 {
+		task, err := q.pop(ctx)
+		assert.NoError(t, err)
+		order = append(order, task.ID)
+	}
+	assert.Equal(t, []string{"exam", "score", "marketing"}, order)
+}
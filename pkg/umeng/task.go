@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+const (
+	taskIDBytes   = 16
+	backoffFactor = 2
+)
+
+// allPlatforms 按 tag 推送时依次发送的平台
+var allPlatforms = []string{constants.DevicePlatformAndroid, constants.DevicePlatformIOS, constants.DevicePlatformHarmony}
+
+// Task 可持久化的推送任务，进程重启或由其他实例接管后仍能继续发送
+// Tags 非空时按 tag 推送到三端；DeviceTokens 非空时按设备推送到 Platform 一端
+// 三端分别发送，Sent 记录已发送成功的平台，重试时跳过这些平台，避免重复推送
+type Task struct {
+	ID           string    `json:"id"` // 幂等键，同一 ID 的任务只会发送成功一次，为空时入队时自动生成
+	PushType     string    `json:"push_type"`
+	Title        string    `json:"title"`
+	Text         string    `json:"text"`
+	Keywords     []string  `json:"keywords,omitempty"`
+	Description  string    `json:"description"`
+	Deeplink     string    `json:"deeplink"`
+	Tags         []string  `json:"tags,omitempty"`
+	ExcludeTags  []string  `json:"exclude_tags,omitempty"` // 按 tag 推送时排除命中其中任一 tag 的设备
+	Platform     string    `json:"platform,omitempty"`
+	DeviceTokens []string  `json:"device_tokens,omitempty"`
+	NotBefore    time.Time `json:"not_before,omitzero"`  // 最早的发送时间，用于免打扰期间延迟发送，为零值时立即发送
+	Attempts     int       `json:"attempts"`             // 已失败的次数
+	Sent         []string  `json:"sent,omitempty"`       // 已发送成功的平台
+	LastError    string    `json:"last_error,omitempty"` // 最近一次失败的原因，便于排查死信
+
+	ref string // 任务在队列中的位置，例如 Redis Stream 的消息 ID，用于确认
+}
+
+var errEmptyTarget = errors.New("umeng: task has neither tags nor device tokens")
+
+func (t *Task) validate() error {
+	if len(t.Tags) == 0 && len(t.DeviceTokens) == 0 {
+		return errEmptyTarget
+	}
+	return nil
+}
+
+func (t *Task) priority() int {
+	return priorityOf(t.PushType)
+}
+
+func (t *Task) platforms() []string {
+	if len(t.DeviceTokens) != 0 {
+		return []string{t.Platform}
+	}
+	return allPlatforms
+}
+
+// send 向尚未成功的平台发送推送，返回各平台错误的合并
+func (t *Task) send() error {
+	var errs []error
+	for _, platform := range t.platforms() {
+		if slices.Contains(t.Sent, platform) {
+			continue
+		}
+		if err := t.sendTo(platform); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", platform, err))
+			continue
+		}
+		t.Sent = append(t.Sent, platform)
+	}
+	return errors.Join(errs...)
+}
+
+func (t *Task) sendTo(platform string) error {
+	if len(t.DeviceTokens) != 0 {
+		return PushToDevices(platform, t.PushType, t.Title, t.Text, t.Keywords, t.DeviceTokens, t.Description, t.Deeplink)
+	}
+	filter := Filter{Where: Where{OrTags: t.Tags}}
+	if len(t.Tags) == 1 {
+		filter = tagFilter(t.Tags[0])
+	}
+	filter.Where.NotTags = t.ExcludeTags
+	return sendToPlatform(platform, t.PushType, t.Title, t.Text, t.Keywords, t.Description, t.Deeplink, groupcastTarget(filter))
+}
+
+// retryDelay 第 attempts 次失败后的退避时间，从 UmengRetryBaseDelay 开始翻倍，不超过 UmengRetryMaxDelay
+func retryDelay(attempts int) time.Duration {
+	delay := constants.UmengRetryBaseDelay
+	for i := 1; i < attempts && delay < constants.UmengRetryMaxDelay; i++ {
+		delay *= backoffFactor
+	}
+	return min(delay, constants.UmengRetryMaxDelay)
+}
+
+func newTaskID() string {
+	b := make([]byte, taskIDBytes)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
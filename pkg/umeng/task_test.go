@@ -0,0 +1,115 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+func TestTaskSend(t *testing.T) {
+	type testCase struct {
+		name            string
+		task            *Task
+		failPlatform    string
+		expectPlatforms []string
+		expectSent      []string
+		expectError     bool
+	}
+
+	testCases := []testCase{
+		{
+			name:            "send tags to all platforms",
+			task:            &Task{Tags: []string{"tag"}},
+			expectPlatforms: allPlatforms,
+			expectSent:      allPlatforms,
+		},
+		{
+			name: "skip platforms already sent",
+			task: &Task{
+				Tags: []string{"tag"},
+				Sent: []string{constants.DevicePlatformAndroid, constants.DevicePlatformIOS},
+			},
+			expectPlatforms: []string{constants.DevicePlatformHarmony},
+			expectSent:      allPlatforms,
+		},
+		{
+			name:            "record failed platform",
+			task:            &Task{Tags: []string{"a", "b"}},
+			failPlatform:    constants.DevicePlatformIOS,
+			expectPlatforms: allPlatforms,
+			expectSent:      []string{constants.DevicePlatformAndroid, constants.DevicePlatformHarmony},
+			expectError:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockey.PatchConvey(tc.name, t, func() {
+			var platforms []string
+			mockey.Mock(sendToPlatform).To(func(platform, pushType, title, text string, keywords []string,
+				description, deeplink string, tg target,
+			) error {
+				platforms = append(platforms, platform)
+				assert.Equal(t, castTypeGroupcast, tg.Type)
+				if platform == tc.failPlatform {
+					return assert.AnError
+				}
+				return nil
+			}).Build()
+
+			err := tc.task.send()
+			if tc.expectError {
+				assert.ErrorIs(t, err, assert.AnError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectPlatforms, platforms)
+			assert.Equal(t, tc.expectSent, tc.task.Sent)
+		})
+	}
+}
+
+func TestTaskSendToDevices(t *testing.T) {
+	mockey.PatchConvey("send device tokens to its platform only", t, func() {
+		var platforms []string
+		mockey.Mock(PushToDevices).To(func(platform, pushType, title, text string, keywords []string,
+			deviceTokens []string, description, deeplink string,
+		) error {
+			platforms = append(platforms, platform)
+			assert.Equal(t, []string{"token-a"}, deviceTokens)
+			return nil
+		}).Build()
+
+		task := &Task{Platform: constants.DevicePlatformIOS, DeviceTokens: []string{"token-a"}}
+		assert.NoError(t, task.send())
+		assert.Equal(t, []string{constants.DevicePlatformIOS}, platforms)
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, constants.UmengRetryBaseDelay, retryDelay(1))
+	assert.Equal(t, 2*constants.UmengRetryBaseDelay, retryDelay(2))
+	assert.Equal(t, 4*constants.UmengRetryBaseDelay, retryDelay(3))
+	assert.Equal(t, constants.UmengRetryMaxDelay, retryDelay(100))
+	assert.LessOrEqual(t, retryDelay(constants.UmengMaxAttempts), constants.UmengRetryMaxDelay)
+	assert.Greater(t, retryDelay(1), time.Duration(0))
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+	"github.com/west2-online/fzuhelper-server/pkg/errno"
+)
+
+// 友盟消息的发送类型
+const (
+	castTypeGroupcast = "groupcast"
+	castTypeUnicast   = "unicast"
+	castTypeListcast  = "listcast"
+)
+
+// target 推送目标，groupcast 使用 Filter，unicast/listcast 使用 DeviceTokens（多个以英文逗号分隔）
+type target struct {
+	Type         string
+	Filter       Filter
+	DeviceTokens string
+}
+
+func groupcastTarget(filter Filter) target {
+	return target{Type: castTypeGroupcast, Filter: filter}
+}
+
+// deviceTarget 单个 token 使用单播，多个 token 使用列播
+func deviceTarget(deviceTokens []string) target {
+	if len(deviceTokens) == 1 {
+		return target{Type: castTypeUnicast, DeviceTokens: deviceTokens[0]}
+	}
+	return target{Type: castTypeListcast, DeviceTokens: strings.Join(deviceTokens, ",")}
+}
+
+// PushToDevices 按 device token 推送到同一平台的设备，token 按 UmengMaxListcastTokens 分批发送
+// 与 PushByType 不同，发送失败会返回错误（多批失败时合并），由调用方决定是否重试
+func PushToDevices(platform, pushType, title, text string, keywords []string, deviceTokens []string, description, deeplink string) error {
+	var errs []error
+	for batch := range slices.Chunk(deviceTokens, constants.UmengMaxListcastTokens) {
+		if err := sendToPlatform(platform, pushType, title, text, keywords, description, deeplink, deviceTarget(batch)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sendToPlatform(platform, pushType, title, text string, keywords []string, description, deeplink string, t target) error {
+	switch platform {
+	case constants.DevicePlatformAndroid:
+		return sendAndroidWithGoApp(pushType, title, text, "", description, deeplink, keywords, t)
+	case constants.DevicePlatformIOS:
+		return sendIOS(title, "", text, description, deeplink, t)
+	case constants.DevicePlatformHarmony:
+		return sendHarmony(title, text, description, deeplink, t)
+	default:
+		return errno.Errorf(errno.InternalServiceErrorCode, "umeng.sendToPlatform: unsupported platform %s", platform)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package umeng
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/west2-online/fzuhelper-server/pkg/constants"
+)
+
+func TestPushToDevices(t *testing.T) {
+	manyTokens := make([]string, constants.UmengMaxListcastTokens+1)
+	for i := range manyTokens {
+		manyTokens[i] = fmt.Sprintf("token-%d", i)
+	}
+
+	tests := []struct {
+		name        string
+		platform    string
+		tokens      []string
+		sendErr     error
+		expectTypes []string
+		expectError bool
+	}{
+		{
+			name:        "single token uses unicast",
+			platform:    constants.DevicePlatformAndroid,
+			tokens:      []string{"token-a"},
+			expectTypes: []string{castTypeUnicast},
+		},
+		{
+			name:        "multiple tokens use listcast",
+			platform:    constants.DevicePlatformIOS,
+			tokens:      []string{"token-a", "token-b"},
+			expectTypes: []string{castTypeListcast},
+		},
+		{
+			name:        "split tokens into batches",
+			platform:    constants.DevicePlatformHarmony,
+			tokens:      manyTokens,
+			expectTypes: []string{castTypeListcast, castTypeUnicast},
+		},
+		{
+			name:        "return send error",
+			platform:    constants.DevicePlatformAndroid,
+			tokens:      []string{"token-a"},
+			sendErr:     assert.AnError,
+			expectTypes: []string{castTypeUnicast},
+			expectError: true,
+		},
+		{
+			name:        "unsupported platform",
+			platform:    "windows",
+			tokens:      []string{"token-a"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		mockey.PatchConvey(tt.name, t, func() {
+			var targets []target
+			record := func(tg target) error {
+				targets = append(targets, tg)
+				return tt.sendErr
+			}
+			mockey.Mock(sendAndroidWithGoApp).To(
+				func(pushType, title, text, ticker, description, deeplink string, keywords []string, tg target) error {
+					return record(tg)
+				},
+			).Build()
+			mockey.Mock(sendIOS).To(
+				func(title, subtitle, body, description, deeplink string, tg target) error {
+					return record(tg)
+				},
+			).Build()
+			mockey.Mock(sendHarmony).To(
+				func(title, text, description, deeplink string, tg target) error {
+					return record(tg)
+				},
+			).Build()
+
+			err := PushToDevices(tt.platform, constants.UmengPushTypeExam, "title", "text", nil, tt.tokens, "description", "deeplink")
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			types := make([]string, 0, len(targets))
+			for _, tg := range targets {
+				types = append(types, tg.Type)
+			}
+			assert.Equal(t, len(tt.expectTypes), len(types))
+			for i := range tt.expectTypes {
+				assert.Equal(t, tt.expectTypes[i], types[i])
+			}
+		})
+	}
+}
+
+func TestUnicastMessageJSON(t *testing.T) {
+	tg := deviceTarget([]string{"token-a", "token-b"})
+	data, err := json.Marshal(IOSGroupcastMessage{
+		AppKey:       "ios-appkey",
+		Type:         tg.Type,
+		Filter:       tg.Filter,
+		DeviceTokens: tg.DeviceTokens,
+	})
+	assert.NoError(t, err)
+
+	var got map[string]any
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, castTypeListcast, got["type"])
+	assert.Equal(t, "token-a,token-b", got["device_tokens"])
+	// 非广播消息不携带 filter
+	_, exists := got["filter"]
+	assert.False(t, exists)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	htmlDropBlockRe = regexp.MustCompile(`(?is)<(script|style)[^>]*>.*?</(script|style)>`)
+	htmlTagRe       = regexp.MustCompile(`<[^>]*>`)
+	blankRe         = regexp.MustCompile(`\s+`)
+)
+
+// HTMLToText 去除 HTML 标签、反转义实体并合并空白，用于从通知正文中提取可检索的纯文本
+func HTMLToText(s string) string {
+	s = htmlDropBlockRe.ReplaceAllString(s, " ")
+	s = htmlTagRe.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+	s = strings.ReplaceAll(s, " ", " ")
+	return strings.TrimSpace(blankRe.ReplaceAllString(s, " "))
+}
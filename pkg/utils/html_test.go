@@ -0,0 +1,43 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLToText(t *testing.T) {
+	type testCase struct {
+		name   string
+		input  string
+		expect string
+	}
+	testCases := []testCase{
+		{name: "Plain", input: "期末考试安排", expect: "期末考试安排"},
+		{name: "Tags", input: "<p>期末<b>考试</b></p><p>安排</p>", expect: "期末 考试 安排"},
+		{name: "Entities", input: "A&amp;B&nbsp;&lt;C&gt;", expect: "A&B <C>"},
+		{name: "ScriptAndStyle", input: "<style>p{color:red}</style>正文<script>alert(1)</script>", expect: "正文"},
+		{name: "Empty", input: "", expect: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, HTMLToText(tc.input))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// HTMLLink 正文中保留下来的超链接，Href 已解析为绝对地址
+type HTMLLink struct {
+	Href string
+	Text string
+}
+
+var (
+	// sanitizeDropTags 连同子节点一起丢弃的标签
+	sanitizeDropTags = map[atom.Atom]bool{
+		atom.Script: true, atom.Style: true, atom.Iframe: true, atom.Object: true, atom.Embed: true,
+		atom.Form: true, atom.Input: true, atom.Button: true, atom.Textarea: true, atom.Select: true,
+		atom.Link: true, atom.Meta: true, atom.Noscript: true, atom.Title: true, atom.Head: true,
+	}
+	// sanitizeAllowAttrs 允许保留的标签及其属性，不在表中的标签只保留子节点
+	sanitizeAllowAttrs = map[atom.Atom][]string{
+		atom.P: nil, atom.Br: nil, atom.Div: nil, atom.Span: nil, atom.Hr: nil,
+		atom.B: nil, atom.Strong: nil, atom.I: nil, atom.Em: nil, atom.U: nil, atom.S: nil, atom.Sub: nil, atom.Sup: nil,
+		atom.H1: nil, atom.H2: nil, atom.H3: nil, atom.H4: nil, atom.H5: nil, atom.H6: nil,
+		atom.Ul: nil, atom.Ol: nil, atom.Li: nil, atom.Blockquote: nil, atom.Pre: nil, atom.Code: nil,
+		atom.Table: nil, atom.Thead: nil, atom.Tbody: nil, atom.Tfoot: nil, atom.Tr: nil, atom.Caption: nil,
+		atom.Td: {"colspan", "rowspan"}, atom.Th: {"colspan", "rowspan"}, atom.Colgroup: nil, atom.Col: {"span"},
+		atom.A:   {"href", "title"},
+		atom.Img: {"src", "alt", "width", "height"},
+	}
+	sanitizeVoidTags = map[atom.Atom]bool{atom.Br: true, atom.Hr: true, atom.Img: true, atom.Col: true}
+)
+
+// SanitizeHTML 按白名单清洗教务处通知正文，去除脚本、样式和事件属性，
+// 并将 href/src 解析为基于 baseURL 的绝对地址，非 http(s) 协议的地址会被移除
+// 返回清洗后的 HTML 以及正文中所有保留下来的超链接
+func SanitizeHTML(raw, baseURL string) (string, []HTMLLink) {
+	base, _ := url.Parse(baseURL)
+	nodes, err := html.ParseFragment(strings.NewReader(raw), &html.Node{Type: html.ElementNode, DataAtom: atom.Body, Data: "body"})
+	if err != nil {
+		return "", nil
+	}
+	s := &sanitizer{base: base}
+	for _, n := range nodes {
+		s.render(n)
+	}
+	return strings.TrimSpace(s.out.String()), s.links
+}
+
+type sanitizer struct {
+	base  *url.URL
+	out   strings.Builder
+	links []HTMLLink
+}
+
+func (s *sanitizer) render(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		s.out.WriteString(html.EscapeString(n.Data))
+		return
+	case html.ElementNode:
+	default:
+		s.renderChildren(n)
+		return
+	}
+
+	if sanitizeDropTags[n.DataAtom] {
+		return
+	}
+	allowed, ok := sanitizeAllowAttrs[n.DataAtom]
+	if !ok {
+		s.renderChildren(n)
+		return
+	}
+
+	s.out.WriteString("<" + n.Data)
+	for _, attr := range n.Attr {
+		if attr.Namespace != "" || !slices.Contains(allowed, attr.Key) {
+			continue
+		}
+		val := attr.Val
+		if attr.Key == "href" || attr.Key == "src" {
+			if val = s.resolve(val); val == "" {
+				continue
+			}
+			if n.DataAtom == atom.A {
+				s.links = append(s.links, HTMLLink{Href: val, Text: strings.Join(strings.Fields(nodeText(n)), " ")})
+			}
+		}
+		s.out.WriteString(" " + attr.Key + `="` + html.EscapeString(val) + `"`)
+	}
+	s.out.WriteString(">")
+	if sanitizeVoidTags[n.DataAtom] {
+		return
+	}
+	s.renderChildren(n)
+	s.out.WriteString("</" + n.Data + ">")
+}
+
+func (s *sanitizer) renderChildren(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		s.render(c)
+	}
+}
+
+// resolve 返回绝对地址，无法解析或协议不安全时返回空串
+func (s *sanitizer) resolve(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return ""
+	}
+	if s.base != nil {
+		u = s.base.ResolveReference(u)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ""
+	}
+	return u.String()
+}
+
+func nodeText(n *html.Node) string {
+	var sb strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return sb.String()
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The west2-online Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeHTML(t *testing.T) {
+	type testCase struct {
+		name        string
+		input       string
+		expectHTML  string
+		expectLinks []HTMLLink
+	}
+	const base = "https://jwch.fzu.edu.cn/info/1039/13000.htm"
+	testCases := []testCase{
+		{
+			name:       "KeepAllowedTags",
+			input:      `<p style="color:red" class="x">期末<b>考试</b><br></p>`,
+			expectHTML: `<p>期末<b>考试</b><br></p>`,
+		},
+		{
+			name:       "DropScriptAndEvents",
+			input:      `<div onclick="alert(1)">正文<script>alert(1)</script><iframe src="https://a.com"></iframe></div>`,
+			expectHTML: `<div>正文</div>`,
+		},
+		{
+			name:       "UnwrapUnknownTags",
+			input:      `<font face="宋体"><o:p>安排</o:p></font>`,
+			expectHTML: `安排`,
+		},
+		{
+			name:        "ResolveRelativeLink",
+			input:       `<a href="/system/_content/download.jsp?id=1&amp;t=2" target="_blank"> 考场 安排.xls </a>`,
+			expectHTML:  `<a href="https://jwch.fzu.edu.cn/system/_content/download.jsp?id=1&amp;t=2"> 考场 安排.xls </a>`,
+			expectLinks: []HTMLLink{{Href: "https://jwch.fzu.edu.cn/system/_content/download.jsp?id=1&t=2", Text: "考场 安排.xls"}},
+		},
+		{
+			name:       "DropUnsafeScheme",
+			input:      `<a href="javascript:alert(1)">点击</a><img src="data:image/png;base64,xx" alt="图">`,
+			expectHTML: `<a>点击</a><img alt="图">`,
+		},
+		{
+			name:       "EscapeText",
+			input:      `a &lt; b`,
+			expectHTML: `a &lt; b`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, links := SanitizeHTML(tc.input, base)
+			assert.Equal(t, tc.expectHTML, out)
+			assert.Equal(t, tc.expectLinks, links)
+		})
+	}
+}